@@ -0,0 +1,69 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/util"
+)
+
+// ParseServer parses "identity:signingkey", both in hex.
+func ParseServer(s string) (util.GenesisServer, error) {
+	var fed util.GenesisServer
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) != 2 {
+		return fed, fmt.Errorf("Expected identity:signingkey, got %s", s)
+	}
+	fed.Identity = strings.TrimSpace(parts[0])
+	fed.SigningKey = strings.TrimSpace(parts[1])
+	return fed, nil
+}
+
+// ParseBalance parses "FA...=amount", with the amount in factoids.
+func ParseBalance(s string) (util.GenesisBalance, error) {
+	var b util.GenesisBalance
+	parts := strings.SplitN(s, "=", 2)
+	if len(parts) != 2 {
+		return b, fmt.Errorf("Expected address=amount, got %s", s)
+	}
+	b.Address = strings.TrimSpace(parts[0])
+	if !primitives.ValidateFUserStr(b.Address) {
+		return b, fmt.Errorf("Invalid factoid address %s", b.Address)
+	}
+	amt, err := primitives.ConvertFixedPoint(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return b, fmt.Errorf("Invalid amount for %s: %v", b.Address, err)
+	}
+	b.Factoshis, err = strconv.ParseUint(amt, 10, 64)
+	if err != nil {
+		return b, fmt.Errorf("Invalid amount for %s: %v", b.Address, err)
+	}
+	return b, nil
+}
+
+// ReadBalances reads one address=amount per line.  Blank lines and lines
+// starting with # are skipped.
+func ReadBalances(r io.Reader) ([]util.GenesisBalance, error) {
+	var balances []util.GenesisBalance
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		b, err := ParseBalance(text)
+		if err != nil {
+			return nil, fmt.Errorf("Line %d: %v", line, err)
+		}
+		balances = append(balances, b)
+	}
+	return balances, scanner.Err()
+}
@@ -0,0 +1,97 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// GenesisGenerator writes the genesis file of a CUSTOM network.  Point
+// CustomGenesisFile in factomd.conf at the file on every node, and start them
+// with the same -customnet name.
+//
+//	GenesisGenerator -customnet mynet -out genesis.json \
+//		-identity 38bab1.. -key cc1985.. -feds 38bab1..:cc1985..,888888..:9a5b1c.. \
+//		-blktime 600 -exchangerate 1000 \
+//		-balance FA2jK2HcLnRdS94dEcU27rF3meoJfpUcZPSinpb7AwQvPRY6RL1Q=1000000 \
+//		-balances balances.txt
+//
+// The balances file has one FA...=factoids per line.
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/FactomProject/factomd/util"
+)
+
+type balanceFlags []string
+
+func (b *balanceFlags) String() string     { return strings.Join(*b, ",") }
+func (b *balanceFlags) Set(v string) error { *b = append(*b, v); return nil }
+
+func exit(err error) {
+	fmt.Println(err)
+	os.Exit(1)
+}
+
+func main() {
+	var balanceArgs balanceFlags
+	network := flag.String("customnet", "", "The custom network name, as given to factomd with -customnet")
+	out := flag.String("out", "genesis.json", "File to write the genesis configuration to")
+	identity := flag.String("identity", "38bab1455b7bd7e5efd15c53c777c79d0c988e9210f1da49a99d95b3a6417be9", "Bootstrap identity chain ID")
+	key := flag.String("key", "cc1985cdfae4e32b5a454dfda8ce5e1361558482684f3367649c3ad852c8e31a", "Bootstrap public key, hex")
+	feds := flag.String("feds", "", "Comma separated identity:signingkey pairs of the initial federated servers, the bootstrap identity and key if empty")
+	blktime := flag.Int("blktime", 600, "Seconds per block")
+	rate := flag.Uint64("exchangerate", 1000, "Factoshis per entry credit")
+	balancesFile := flag.String("balances", "", "File of FA...=factoids lines to fund")
+	flag.Var(&balanceArgs, "balance", "FA...=factoids to fund, may be repeated")
+	flag.Parse()
+
+	if *network == "" {
+		exit(fmt.Errorf("-customnet is required"))
+	}
+
+	g := new(util.GenesisConfig)
+	g.NetworkName = *network
+	g.NetworkID = hex.EncodeToString(util.CustomNetworkID(*network))
+	g.Timestamp = time.Now().Unix() / 60 * 60
+	g.BootstrapIdentity = *identity
+	g.BootstrapKey = *key
+	for _, arg := range strings.Split(*feds, ",") {
+		if arg = strings.TrimSpace(arg); arg != "" {
+			fed, err := ParseServer(arg)
+			if err != nil {
+				exit(err)
+			}
+			g.FederatedServers = append(g.FederatedServers, fed)
+		}
+	}
+	g.DirectoryBlockInSeconds = *blktime
+	g.ExchangeRate = *rate
+
+	if *balancesFile != "" {
+		f, err := os.Open(*balancesFile)
+		if err != nil {
+			exit(err)
+		}
+		g.Balances, err = ReadBalances(f)
+		f.Close()
+		if err != nil {
+			exit(fmt.Errorf("%s: %v", *balancesFile, err))
+		}
+	}
+	for _, arg := range balanceArgs {
+		b, err := ParseBalance(arg)
+		if err != nil {
+			exit(err)
+		}
+		g.Balances = append(g.Balances, b)
+	}
+
+	if err := util.WriteGenesis(*out, g); err != nil {
+		exit(err)
+	}
+	fmt.Printf("Wrote the genesis of network %s (ID %s) with %d funded addresses to %s\n", g.NetworkName, g.NetworkID, len(g.Balances), *out)
+}
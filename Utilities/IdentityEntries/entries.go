@@ -0,0 +1,318 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/FactomProject/btcutil/base58"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	. "github.com/FactomProject/factomd/common/identityEntries"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/util"
+)
+
+// Where all Identities register
+const MAIN_FACTOM_IDENTITY_LIST = "888888001750ede0eff4b05f0c3f557890b256450cabbb84cada937f9c258327"
+
+var identityPrivateKeyPrefixes = []string{
+	IdentityPrivateKeyPrefix1,
+	IdentityPrivateKeyPrefix2,
+	IdentityPrivateKeyPrefix3,
+	IdentityPrivateKeyPrefix4,
+}
+
+// ParseIdentityKey accepts either a human readable idsec key of the given
+// level or a hex encoded 32 byte private key.
+func ParseIdentityKey(s string, level int) (*primitives.PrivateKey, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "idsec") {
+		raw := base58.Decode(s)
+		if len(raw) != 39 {
+			return nil, fmt.Errorf("Invalid identity key length %d", len(raw))
+		}
+		if hex.EncodeToString(raw[:3]) != identityPrivateKeyPrefixes[level-1] {
+			return nil, fmt.Errorf("Not a level %d identity private key", level)
+		}
+		check := primitives.Shad(raw[:35]).Bytes()
+		if !bytes.Equal(check[:4], raw[35:]) {
+			return nil, fmt.Errorf("Invalid identity key checksum")
+		}
+		return primitives.NewPrivateKeyFromHex(hex.EncodeToString(raw[3:35]))
+	}
+	return primitives.NewPrivateKeyFromHex(s)
+}
+
+// ParseECKey accepts either a human readable Es key or a hex encoded 32 byte
+// private key.
+func ParseECKey(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "Es") {
+		return primitives.HumanReadableECPrivateKeyToPrivateKey(s)
+	}
+	key, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("Invalid entry credit key length %d", len(key))
+	}
+	return key, nil
+}
+
+// IdentityKeyPreimage is the type prefix followed by the raw public key.  The
+// identity key recorded in the identity chain is its Shad.
+func IdentityKeyPreimage(key *primitives.PrivateKey) []byte {
+	return append([]byte{0x01}, key.Pub[:]...)
+}
+
+func IdentityKeyHash(key *primitives.PrivateKey) interfaces.IHash {
+	return primitives.Shad(IdentityKeyPreimage(key))
+}
+
+func timestampBytes(t time.Time) []byte {
+	ts := make([]byte, 8)
+	binary.BigEndian.PutUint64(ts, uint64(t.Unix()))
+	return ts
+}
+
+func sign(key *primitives.PrivateKey, data []byte) []byte {
+	return primitives.Sign(key.Key[:32], data)
+}
+
+func newEntry(chainID interfaces.IHash, extIDs [][]byte) *entryBlock.Entry {
+	e := entryBlock.NewEntry()
+	e.ChainID = chainID
+	for _, extID := range extIDs {
+		e.ExtIDs = append(e.ExtIDs, primitives.ByteSlice{Bytes: extID})
+	}
+	return e
+}
+
+// mineChain iterates the last ExtID, a nonce, until the chain ID starts with
+// 888888 as required for identity chains.
+func mineChain(extIDs [][]byte) [][]byte {
+	nonce := uint64(0)
+	for {
+		n := make([]byte, 8)
+		binary.BigEndian.PutUint64(n, nonce)
+		extIDs[len(extIDs)-1] = n
+		if bytes.HasPrefix(entryBlock.ExternalIDsToChainID(extIDs).Bytes(), []byte{0x88, 0x88, 0x88}) {
+			return extIDs
+		}
+		nonce++
+	}
+}
+
+// NewIdentityChain builds the first entry of a new identity chain from the
+// four identity keys.
+func NewIdentityChain(keys [4]*primitives.PrivateKey) (*entryBlock.Entry, error) {
+	ics := new(IdentityChainStructure)
+	ics.Version = 0
+	ics.FunctionName = []byte("Identity Chain")
+	ics.Key1 = IdentityKeyHash(keys[0])
+	ics.Key2 = IdentityKeyHash(keys[1])
+	ics.Key3 = IdentityKeyHash(keys[2])
+	ics.Key4 = IdentityKeyHash(keys[3])
+
+	extIDs := mineChain(append(ics.ToExternalIDs()[:6], nil))
+	e := newEntry(entryBlock.ExternalIDsToChainID(extIDs), extIDs)
+	if err := verifyIdentityChain(e, keys); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// NewRegisterFactomIdentity registers the identity in the main identity list.
+func NewRegisterFactomIdentity(identity interfaces.IHash, key1 *primitives.PrivateKey) (*entryBlock.Entry, error) {
+	rfi := new(RegisterFactomIdentityStructure)
+	rfi.Version = 0
+	rfi.FunctionName = []byte("Register Factom Identity")
+	rfi.IdentityChainID = identity
+	rfi.PreimageIdentityKey = IdentityKeyPreimage(key1)
+	rfi.Signature = sign(key1, rfi.MarshalForSig())
+
+	list, err := primitives.NewShaHashFromStr(MAIN_FACTOM_IDENTITY_LIST)
+	if err != nil {
+		return nil, err
+	}
+	e := newEntry(list, rfi.ToExternalIDs())
+	if err := verifyRegisterFactomIdentity(e, identity, key1); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// NewServerManagementChain builds the first entry of the server management
+// subchain of an identity.
+func NewServerManagementChain(identity interfaces.IHash) (*entryBlock.Entry, error) {
+	sm := new(ServerManagementStructure)
+	sm.Version = 0
+	sm.FunctionName = []byte("Server Management")
+	sm.RootIdentityChainID = identity
+
+	extIDs := mineChain(append(sm.ToExternalIDs()[:3], nil))
+	e := newEntry(entryBlock.ExternalIDsToChainID(extIDs), extIDs)
+	if err := verifyServerManagementChain(e, identity); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// NewRegisterServerManagement links the management subchain to the identity,
+// and is placed in the identity chain.
+func NewRegisterServerManagement(identity interfaces.IHash, subchain interfaces.IHash, key1 *primitives.PrivateKey) (*entryBlock.Entry, error) {
+	rsm := new(RegisterServerManagementStructure)
+	rsm.Version = 0
+	rsm.FunctionName = []byte("Register Server Management")
+	rsm.SubchainChainID = subchain
+	rsm.PreimageIdentityKey = IdentityKeyPreimage(key1)
+	rsm.Signature = sign(key1, rsm.MarshalForSig())
+
+	e := newEntry(identity, rsm.ToExternalIDs())
+	if err := verifyRegisterServerManagement(e, identity, subchain, key1); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// NewBlockSigningKey is placed in the management subchain.
+func NewBlockSigningKey(identity interfaces.IHash, subchain interfaces.IHash, signingKey *primitives.PublicKey, key1 *primitives.PrivateKey, t time.Time) (*entryBlock.Entry, error) {
+	nbsk := new(NewBlockSigningKeyStruct)
+	nbsk.Version = 0
+	nbsk.FunctionName = []byte("New Block Signing Key")
+	nbsk.RootIdentityChainID = identity
+	nbsk.NewPublicKey = signingKey[:]
+	nbsk.Timestamp = timestampBytes(t)
+	nbsk.PreimageIdentityKey = IdentityKeyPreimage(key1)
+	nbsk.Signature = sign(key1, nbsk.MarshalForSig())
+
+	e := newEntry(subchain, nbsk.ToExternalIDs())
+	if err := verifyBlockSigningKey(e, identity, subchain, key1); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// NewBitcoinKey is placed in the management subchain.
+func NewBitcoinKey(identity interfaces.IHash, subchain interfaces.IHash, level byte, keyType byte, btcKey [20]byte, key1 *primitives.PrivateKey, t time.Time) (*entryBlock.Entry, error) {
+	nbk := new(NewBitcoinKeyStructure)
+	nbk.Version = 0
+	nbk.FunctionName = []byte("New Bitcoin Key")
+	nbk.RootIdentityChainID = identity
+	nbk.BitcoinKeyLevel = level
+	nbk.KeyType = keyType
+	nbk.NewKey = btcKey
+	nbk.Timestamp = timestampBytes(t)
+	nbk.PreimageIdentityKey = IdentityKeyPreimage(key1)
+	nbk.Signature = sign(key1, nbk.MarshalForSig())
+
+	e := newEntry(subchain, nbk.ToExternalIDs())
+	if err := verifyBitcoinKey(e, identity, subchain, key1); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// NewMatryoshkaHash is placed in the management subchain.
+func NewMatryoshkaHash(identity interfaces.IHash, subchain interfaces.IHash, mhash interfaces.IHash, key1 *primitives.PrivateKey, t time.Time) (*entryBlock.Entry, error) {
+	nmh := new(NewMatryoshkaHashStructure)
+	nmh.Version = 0
+	nmh.FunctionName = []byte("New Matryoshka Hash")
+	nmh.RootIdentityChainID = identity
+	nmh.OutermostMHash = mhash
+	nmh.Timestamp = timestampBytes(t)
+	nmh.PreimageIdentityKey = IdentityKeyPreimage(key1)
+	nmh.Signature = sign(key1, nmh.MarshalForSig())
+
+	e := newEntry(subchain, nmh.ToExternalIDs())
+	if err := verifyMatryoshkaHash(e, identity, subchain, key1); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func milliTime(t time.Time) *primitives.ByteSlice6 {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(t.UnixNano()/1e6))
+	ms := new(primitives.ByteSlice6)
+	copy(ms[:], b[2:])
+	return ms
+}
+
+// Payload is a signed commit and its reveal, ready for the V2 API.
+type Payload struct {
+	ChainID      string `json:"chainid"`
+	EntryHash    string `json:"entryhash"`
+	CommitMethod string `json:"commitmethod"`
+	Commit       string `json:"commit"`
+	RevealMethod string `json:"revealmethod"`
+	Reveal       string `json:"reveal"`
+}
+
+// NewPayload commits to the entry with the entry credit key.  If newChain is
+// set the entry is the first entry of a new chain.
+func NewPayload(e *entryBlock.Entry, newChain bool, ecKey []byte, t time.Time) (*Payload, error) {
+	reveal, err := e.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	cost, err := util.EntryCost(reveal)
+	if err != nil {
+		return nil, err
+	}
+
+	p := new(Payload)
+	p.ChainID = e.GetChainID().String()
+	p.EntryHash = e.GetHash().String()
+	p.Reveal = hex.EncodeToString(reveal)
+
+	var commit interfaces.BinaryMarshallable
+	if newChain {
+		cc := entryCreditBlock.NewCommitChain()
+		cc.MilliTime = milliTime(t)
+		cc.ChainIDHash = primitives.NewHash(primitives.DoubleSha(e.GetChainID().Bytes()))
+		cc.Weld = primitives.NewHash(primitives.DoubleSha(append(e.GetHash().Bytes(), e.GetChainID().Bytes()...)))
+		cc.EntryHash = e.GetHash()
+		cc.Credits = cost + 10
+		if err := cc.Sign(ecKey); err != nil {
+			return nil, err
+		}
+		if !cc.IsValid() {
+			return nil, fmt.Errorf("Built an invalid chain commit")
+		}
+		commit = cc
+		p.CommitMethod = "commit-chain"
+		p.RevealMethod = "reveal-chain"
+	} else {
+		ce := entryCreditBlock.NewCommitEntry()
+		ce.MilliTime = milliTime(t)
+		ce.EntryHash = e.GetHash()
+		ce.Credits = cost
+		if err := ce.Sign(ecKey); err != nil {
+			return nil, err
+		}
+		if !ce.IsValid() {
+			return nil, fmt.Errorf("Built an invalid entry commit")
+		}
+		commit = ce
+		p.CommitMethod = "commit-entry"
+		p.RevealMethod = "reveal-entry"
+	}
+
+	data, err := commit.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	p.Commit = hex.EncodeToString(data)
+	return p, nil
+}
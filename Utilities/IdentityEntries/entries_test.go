@@ -0,0 +1,93 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+func TestManagementEntries(t *testing.T) {
+	key1 := primitives.RandomPrivateKey()
+	ecKey := primitives.RandomPrivateKey()
+	identity := primitives.RandomHash()
+	subchain := primitives.RandomHash()
+	now := time.Now()
+
+	entries := []*entryBlock.Entry{}
+	e, err := NewRegisterFactomIdentity(identity, key1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries = append(entries, e)
+	e, err = NewRegisterServerManagement(identity, subchain, key1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !e.GetChainID().IsSameAs(identity) {
+		t.Errorf("Register Server Management should go in the identity chain")
+	}
+	entries = append(entries, e)
+	e, err = NewBlockSigningKey(identity, subchain, primitives.RandomPrivateKey().Pub, key1, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries = append(entries, e)
+	e, err = NewBitcoinKey(identity, subchain, 0, 1, [20]byte{1, 2, 3}, key1, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries = append(entries, e)
+	e, err = NewMatryoshkaHash(identity, subchain, primitives.RandomHash(), key1, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries = append(entries, e)
+
+	// factomd ignores keys with a timestamp more than 12 hours off
+	if _, err := NewBlockSigningKey(identity, subchain, primitives.RandomPrivateKey().Pub, key1, now.Add(-13*time.Hour)); err == nil {
+		t.Errorf("Built a signing key entry factomd would reject")
+	}
+
+	for i, e := range entries {
+		if i > 1 && !e.GetChainID().IsSameAs(subchain) {
+			t.Errorf("Entry %d should go in the management subchain", i)
+		}
+		p, err := NewPayload(e, false, ecKey.Key[:32], now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		raw, _ := hex.DecodeString(p.Commit)
+		commit := entryCreditBlock.NewCommitEntry()
+		if err := commit.UnmarshalBinary(raw); err != nil {
+			t.Fatal(err)
+		}
+		if err := commit.ValidateSignatures(); err != nil {
+			t.Errorf("Entry %d commit has a bad signature: %v", i, err)
+		}
+		if commit.EntryHash.String() != p.EntryHash {
+			t.Errorf("Entry %d commit is for the wrong entry", i)
+		}
+	}
+}
+
+func TestParseIdentityKey(t *testing.T) {
+	key := primitives.RandomPrivateKey()
+	parsed, err := ParseIdentityKey(hex.EncodeToString(key.Key[:32]), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !parsed.Pub.IsSameAs(key.Pub) {
+		t.Errorf("Parsed the wrong key")
+	}
+	if _, err := ParseIdentityKey("idsec1234", 1); err == nil {
+		t.Errorf("Parsed an invalid idsec key")
+	}
+}
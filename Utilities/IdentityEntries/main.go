@@ -0,0 +1,145 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// IdentityEntries builds and signs the entries needed to create and manage a
+// server identity without a running node.  Each entry is loaded, by the same
+// state code factomd loads identities with, into a state that knows only the
+// identity, then written out as the commit and reveal V2 API calls that submit
+// it.
+//
+//	IdentityEntries identity-chain      -ec Es... -key1 .. -key4
+//	IdentityEntries register-identity   -ec Es... -identity 888888.. -key1 ..
+//	IdentityEntries management-chain    -ec Es... -identity 888888..
+//	IdentityEntries register-management -ec Es... -identity 888888.. -subchain 888888.. -key1 ..
+//	IdentityEntries signing-key         -ec Es... -identity 888888.. -subchain 888888.. -key1 .. -signingkey hex
+//	IdentityEntries btc-key             -ec Es... -identity 888888.. -subchain 888888.. -key1 .. -btckey hex [-level 0] [-type 0]
+//	IdentityEntries mhash               -ec Es... -identity 888888.. -subchain 888888.. -key1 .. -mhash hex
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+var commands = []string{
+	"identity-chain",
+	"register-identity",
+	"management-chain",
+	"register-management",
+	"signing-key",
+	"btc-key",
+	"mhash",
+}
+
+func usage() {
+	fmt.Println("Usage: IdentityEntries <command> [flags]")
+	fmt.Println("Commands:")
+	for _, c := range commands {
+		fmt.Println("   ", c)
+	}
+	fmt.Println("Run IdentityEntries <command> -h for the flags of a command")
+	os.Exit(1)
+}
+
+func exit(err error) {
+	fmt.Println(err)
+	os.Exit(1)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+	cmd := os.Args[1]
+
+	fs := flag.NewFlagSet(cmd, flag.ExitOnError)
+	ec := fs.String("ec", "", "Entry credit private key (Es... or hex) paying for the entry")
+	var keys [4]*string
+	for i := range keys {
+		keys[i] = fs.String(fmt.Sprintf("key%d", i+1), "", fmt.Sprintf("Level %d identity private key (idsec... or hex)", i+1))
+	}
+	identity := fs.String("identity", "", "Identity chain ID")
+	subchain := fs.String("subchain", "", "Server management subchain ID")
+	signingKey := fs.String("signingkey", "", "New block signing public key, hex")
+	btcKey := fs.String("btckey", "", "New bitcoin anchor key, 20 byte hex hash")
+	level := fs.Int("level", 0, "Bitcoin key level")
+	keyType := fs.Int("type", 0, "Bitcoin key type, 0=P2PKH 1=P2SH")
+	mhash := fs.String("mhash", "", "New outermost Matryoshka hash, hex")
+	fs.Parse(os.Args[2:])
+
+	now := time.Now()
+	ecKey, err := ParseECKey(*ec)
+	if err != nil {
+		exit(fmt.Errorf("Invalid -ec key: %v", err))
+	}
+
+	key := func(level int) *primitives.PrivateKey {
+		k, err := ParseIdentityKey(*keys[level-1], level)
+		if err != nil {
+			exit(fmt.Errorf("Invalid -key%d: %v", level, err))
+		}
+		return k
+	}
+	hash := func(name string, value string) interfaces.IHash {
+		h, err := primitives.HexToHash(value)
+		if err != nil {
+			exit(fmt.Errorf("Invalid -%s: %v", name, err))
+		}
+		return h
+	}
+
+	var entry *entryBlock.Entry
+	newChain := false
+	switch cmd {
+	case "identity-chain":
+		entry, err = NewIdentityChain([4]*primitives.PrivateKey{key(1), key(2), key(3), key(4)})
+		newChain = true
+	case "register-identity":
+		entry, err = NewRegisterFactomIdentity(hash("identity", *identity), key(1))
+	case "management-chain":
+		entry, err = NewServerManagementChain(hash("identity", *identity))
+		newChain = true
+	case "register-management":
+		entry, err = NewRegisterServerManagement(hash("identity", *identity), hash("subchain", *subchain), key(1))
+	case "signing-key":
+		pub := new(primitives.PublicKey)
+		if len(*signingKey) != 64 || pub.UnmarshalText([]byte(*signingKey)) != nil {
+			exit(fmt.Errorf("Invalid -signingkey, expected 32 bytes of hex"))
+		}
+		entry, err = NewBlockSigningKey(hash("identity", *identity), hash("subchain", *subchain), pub, key(1), now)
+	case "btc-key":
+		var k [20]byte
+		b, berr := hex.DecodeString(*btcKey)
+		if berr != nil || len(b) != 20 {
+			exit(fmt.Errorf("Invalid -btckey, expected 20 bytes of hex"))
+		}
+		copy(k[:], b)
+		entry, err = NewBitcoinKey(hash("identity", *identity), hash("subchain", *subchain), byte(*level), byte(*keyType), k, key(1), now)
+	case "mhash":
+		entry, err = NewMatryoshkaHash(hash("identity", *identity), hash("subchain", *subchain), hash("mhash", *mhash), key(1), now)
+	default:
+		usage()
+	}
+	if err != nil {
+		exit(err)
+	}
+
+	payload, err := NewPayload(entry, newChain, ecKey, now)
+	if err != nil {
+		exit(err)
+	}
+	out, err := json.MarshalIndent(payload, "", "\t")
+	if err != nil {
+		exit(err)
+	}
+	fmt.Println(string(out))
+}
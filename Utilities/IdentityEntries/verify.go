@@ -0,0 +1,86 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/identity"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/database/databaseOverlay"
+	"github.com/FactomProject/factomd/database/mapdb"
+	"github.com/FactomProject/factomd/state"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// The height entries are loaded at, so the heights the state records can be
+// told from the unset 0
+const verifyHeight = 1
+
+// verifyState is a state that knows only one identity, with its level 1 key
+// and management subchain if given, so an entry can be loaded into it by the
+// code factomd loads identities with.
+func verifyState(chainID interfaces.IHash, subchain interfaces.IHash, key1 *primitives.PrivateKey) (*state.State, *identity.Identity) {
+	st := new(state.State)
+	st.Logger = log.WithFields(log.Fields{"utility": "IdentityEntries"})
+	st.DB = databaseOverlay.NewOverlay(new(mapdb.MapDB))
+	id := st.Identities[st.CreateBlankFactomIdentity(chainID)]
+	if subchain != nil {
+		id.ManagementChainID = subchain
+	}
+	if key1 != nil {
+		id.Key1 = IdentityKeyHash(key1)
+	}
+	return st, id
+}
+
+func verifyIdentityChain(e interfaces.IEBEntry, keys [4]*primitives.PrivateKey) error {
+	st, id := verifyState(e.GetChainID(), nil, nil)
+	state.LoadIdentityByEntry(e, st, verifyHeight, true)
+	if id.IdentityCreated != verifyHeight || !id.Key1.IsSameAs(IdentityKeyHash(keys[0])) || !id.Key4.IsSameAs(IdentityKeyHash(keys[3])) {
+		return fmt.Errorf("factomd would not load the identity chain")
+	}
+	return nil
+}
+
+func verifyRegisterFactomIdentity(e interfaces.IEBEntry, identity interfaces.IHash, key1 *primitives.PrivateKey) error {
+	st, _ := verifyState(identity, nil, key1)
+	return state.RegisterFactomIdentity(e, identity, verifyHeight, st)
+}
+
+func verifyServerManagementChain(e interfaces.IEBEntry, identity interfaces.IHash) error {
+	st, id := verifyState(identity, e.GetChainID(), nil)
+	state.LoadIdentityByEntry(e, st, verifyHeight, true)
+	if id.ManagementCreated != verifyHeight {
+		return fmt.Errorf("factomd would not load the server management chain")
+	}
+	return nil
+}
+
+func verifyRegisterServerManagement(e interfaces.IEBEntry, identity interfaces.IHash, subchain interfaces.IHash, key1 *primitives.PrivateKey) error {
+	st, id := verifyState(identity, nil, key1)
+	state.LoadIdentityByEntry(e, st, verifyHeight, true)
+	if id.ManagementRegistered != verifyHeight || !id.ManagementChainID.IsSameAs(subchain) {
+		return fmt.Errorf("factomd would not register the server management chain")
+	}
+	return nil
+}
+
+func verifyBlockSigningKey(e interfaces.IEBEntry, identity interfaces.IHash, subchain interfaces.IHash, key1 *primitives.PrivateKey) error {
+	st, _ := verifyState(identity, subchain, key1)
+	return state.RegisterBlockSigningKey(e, true, verifyHeight, st)
+}
+
+func verifyBitcoinKey(e interfaces.IEBEntry, identity interfaces.IHash, subchain interfaces.IHash, key1 *primitives.PrivateKey) error {
+	st, _ := verifyState(identity, subchain, key1)
+	return state.RegisterAnchorSigningKey(e, true, verifyHeight, st, "BTC")
+}
+
+func verifyMatryoshkaHash(e interfaces.IEBEntry, identity interfaces.IHash, subchain interfaces.IHash, key1 *primitives.PrivateKey) error {
+	st, _ := verifyState(identity, subchain, key1)
+	return state.UpdateMatryoshkaHash(e, true, verifyHeight, st)
+}
@@ -0,0 +1,54 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// RemoteSigner holds a server's signing key in its own process.  factomd is
+// pointed at it with ServerSigner = remote and ServerSignerSocket in
+// factomd.conf, and it refuses to sign two different messages for the same
+// height and minute.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/FactomProject/factomd/signer"
+)
+
+func main() {
+	keyFile := flag.String("keyfile", "", "File holding the hex encoded server private key")
+	network := flag.String("network", "unix", "Network to listen on, unix or tcp")
+	address := flag.String("address", "/tmp/factomd-signer.sock", "Socket path or host:port to listen on")
+	flag.Parse()
+
+	if *keyFile == "" {
+		fmt.Println("A -keyfile is required")
+		os.Exit(1)
+	}
+
+	local, err := signer.NewLocalSignerFromFile(*keyFile)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	server := signer.NewSignerServer(local, signer.NewGuard())
+	err = server.Listen(*network, *address)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-c
+		server.Close()
+	}()
+
+	fmt.Printf("Signing for public key %x on %s %s\n", local.GetPublicKey(), *network, *address)
+	server.Serve()
+}
@@ -0,0 +1,16 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package constants
+
+// HasAPIScope is true if scopes allow scope.  Admin allows everything.  It is
+// here rather than in state so the API, which state imports, can use it too.
+func HasAPIScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope || s == API_SCOPE_ADMIN {
+			return true
+		}
+	}
+	return false
+}
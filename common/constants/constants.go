@@ -175,3 +175,12 @@ const (
 	IDENTITY_PENDING_FULL                          // 6
 	IDENTITY_SKELETON                              // 7 - Skeleton Identity
 )
+
+//---------------------------------------------------------------------
+// API credential scopes
+//---------------------------------------------------------------------
+const (
+	API_SCOPE_READ   = "read"   // Blocks, entries, balances and status
+	API_SCOPE_SUBMIT = "submit" // Commits, reveals and transactions
+	API_SCOPE_ADMIN  = "admin"  // The debug API and control panel actions, and everything else
+)
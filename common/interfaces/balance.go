@@ -0,0 +1,16 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package interfaces
+
+// BalanceInfo breaks an address balance down by how far along the
+// transactions behind it are.  Factoid balances are in factoshis, entry
+// credit balances in entry credits.
+type BalanceInfo struct {
+	Confirmed     int64 `json:"confirmed"`     // As of the last saved block
+	Pending       int64 `json:"pending"`       // Including transactions acked into the current process list
+	HoldingSpends int64 `json:"holdingspends"` // Spent by transactions in the holding queue, not yet acked
+	HoldingCount  int   `json:"holdingcount"`  // How many holding transactions spend from the address
+	Available     int64 `json:"available"`     // Pending less the holding spends
+}
@@ -0,0 +1,35 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package interfaces
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ConfigReload is what rereading the config file did.  Settings are named as
+// they are in factomd.conf.
+type ConfigReload struct {
+	Applied         []string `json:"applied"`         // Changed on the running node
+	RestartRequired []string `json:"restartrequired"` // Changed in the file, but only used at startup
+	Errors          []string `json:"errors,omitempty"`
+}
+
+func (r *ConfigReload) String() string {
+	str := "Configuration reloaded"
+	if len(r.Applied) == 0 && len(r.RestartRequired) == 0 {
+		str += ", nothing changed"
+	}
+	if len(r.Applied) > 0 {
+		str += fmt.Sprintf("\n  applied: %s", strings.Join(r.Applied, ", "))
+	}
+	if len(r.RestartRequired) > 0 {
+		str += fmt.Sprintf("\n  needs a restart: %s", strings.Join(r.RestartRequired, ", "))
+	}
+	for _, err := range r.Errors {
+		str += "\n  " + err
+	}
+	return str
+}
@@ -0,0 +1,24 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package interfaces
+
+// ECRateInfo is the entry credit price, the policy that sets it, and the
+// changes to it we know about.  Rates are factoshis per entry credit.
+type ECRateInfo struct {
+	Policy     string          `json:"policy"`
+	Rate       uint64          `json:"rate"`
+	Predictive uint64          `json:"predictive"` // The higher of Rate and any scheduled change
+	Upcoming   []*ECRateChange `json:"upcoming"`
+}
+
+// ECRateChange is a scheduled price change, or a proposed one still
+// gathering votes.
+type ECRateChange struct {
+	Rate             uint64 `json:"rate"`
+	ActivationHeight uint32 `json:"activationheight"`
+	Scheduled        bool   `json:"scheduled"`
+	Votes            int    `json:"votes,omitempty"`
+	Needed           int    `json:"needed,omitempty"`
+}
@@ -0,0 +1,35 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package interfaces
+
+// FaultTrace records one fault of a federated server, from the moment we
+// marked its VM faulted to the promotion of an audit server (or the fault
+// being cleared), for the debug API and the control panel.  Times are unix
+// milliseconds.
+type FaultTrace struct {
+	DBHeight      uint32            `json:"dbheight"`
+	VMIndex       int               `json:"vmindex"`
+	ServerID      string            `json:"serverid"`      // The federated server faulted
+	AuditServerID string            `json:"auditserverid"` // The audit server nominated to replace it
+	Reason        string            `json:"reason"`
+	Negotiator    bool              `json:"negotiator"` // We were responsible for negotiating this fault
+	Started       int64             `json:"started"`
+	Ended         int64             `json:"ended,omitempty"`
+	Outcome       string            `json:"outcome"`
+	Signatures    []*FaultSignature `json:"signatures"`
+	Events        []*FaultEvent     `json:"events"`
+}
+
+// FaultSignature is a vote for the fault, or the pledge of the audit server.
+type FaultSignature struct {
+	Signer string `json:"signer"` // Public key of the signer
+	Pledge bool   `json:"pledge"`
+	Time   int64  `json:"time"` // When we first saw it
+}
+
+type FaultEvent struct {
+	Time  int64  `json:"time"`
+	Event string `json:"event"`
+}
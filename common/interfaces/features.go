@@ -0,0 +1,16 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package interfaces
+
+// FeatureActivation is a protocol feature and the block height it activates
+// at on the network we are running.
+type FeatureActivation struct {
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	ActivationHeight uint32 `json:"activationheight"`
+	Active           bool   `json:"active"`     // Active at the current leader height
+	Overridden       bool   `json:"overridden"` // The height comes from factomd.conf
+	Never            bool   `json:"never,omitempty"`
+}
@@ -0,0 +1,37 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package interfaces
+
+// MempoolInfo is a snapshot of the messages held waiting on an ack, a commit
+// or a process list, and of the limits on them.
+type MempoolInfo struct {
+	Count           int               `json:"count"`
+	Bytes           int               `json:"bytes"`
+	MaxCount        int               `json:"maxcount"`
+	MaxBytes        int               `json:"maxbytes"`
+	MaxPerECAddress int               `json:"maxperecaddress"`
+	MaxPerPeer      int               `json:"maxperpeer"`
+	Evicted         int               `json:"evicted"`
+	Refused         int               `json:"refused"`
+	Messages        []*MempoolMessage `json:"messages"`
+}
+
+type MempoolMessage struct {
+	Hash      string  `json:"hash"`
+	Type      string  `json:"type"`
+	State     string  `json:"state"`  // holding, acked or waiting on commit
+	Reason    string  `json:"reason"` // what it is waiting on
+	Age       float64 `json:"age"`    // seconds
+	Size      int     `json:"size"`
+	ECAddress string  `json:"ecaddress,omitempty"`
+	Peer      string  `json:"peer,omitempty"`
+}
+
+// Rejection records why a submitted entry, commit or transaction was dropped.
+type Rejection struct {
+	Reason string `json:"reason"`           // insufficient balance, replay filter, bad signature...
+	Detail string `json:"detail,omitempty"` // the validation error, if there was one
+	Time   int64  `json:"time"`             // Unix time
+}
@@ -0,0 +1,52 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package interfaces
+
+// ProcessListInfo is a structured copy of a process list, for the debug API and
+// the control panel.
+type ProcessListInfo struct {
+	DBHeight      uint32                `json:"dbheight"`
+	Complete      bool                  `json:"complete"`
+	CurrentMinute int                   `json:"currentminute"`
+	VMs           []*ProcessListVM      `json:"vms"`
+	Requests      []*ProcessListRequest `json:"requests"` // Outstanding asks for missing messages
+	DBSigs        ProcessListDBSigs     `json:"dbsigs"`
+}
+
+type ProcessListVM struct {
+	Index        int                   `json:"index"`
+	Leader       string                `json:"leader"` // Chain ID of the federated server leading the VM this minute
+	LeaderMinute int                   `json:"leaderminute"`
+	Height       int                   `json:"height"` // Messages below this height are processed
+	Synced       bool                  `json:"synced"`
+	Faulted      bool                  `json:"faulted"`
+	Messages     []*ProcessListMessage `json:"messages"`
+}
+
+type ProcessListMessage struct {
+	Height          int    `json:"height"`
+	Type            string `json:"type"` // Empty if the message is missing
+	Hash            string `json:"hash,omitempty"`
+	Minute          int    `json:"minute"`
+	Serial          string `json:"serial,omitempty"` // Ack serial hash
+	LeaderTimestamp int64  `json:"leadertimestamp,omitempty"`
+	Acked           bool   `json:"acked"`
+	Processed       bool   `json:"processed"`
+}
+
+type ProcessListRequest struct {
+	VMIndex  int   `json:"vmindex"`
+	Height   int   `json:"height"`
+	Wait     int64 `json:"wait"` // Seconds
+	Sent     int64 `json:"sent"` // Unix milliseconds
+	Requests int   `json:"requests"`
+}
+
+type ProcessListDBSigs struct {
+	Received     int      `json:"received"`
+	Needed       int      `json:"needed"`
+	DiffSigTally int      `json:"diffsigtally"`
+	Signers      []string `json:"signers"`
+}
@@ -19,3 +19,53 @@ type IServer interface {
 
 	IsSameAs(b IServer) bool
 }
+
+// KeyRotationStatus reports how far along a change of this server's block
+// signing key is.  SigningKey is the key used for the current leader height.
+type KeyRotationStatus struct {
+	CurrentKey       string   `json:"currentkey"`
+	SigningKey       string   `json:"signingkey"`
+	PendingKeys      []string `json:"pendingkeys"`
+	Scheduled        bool     `json:"scheduled"`
+	NewKey           string   `json:"newkey"`
+	ActivationHeight uint32   `json:"activationheight"`
+	LeaderHeight     uint32   `json:"leaderheight"`
+}
+
+// IdentityStatus is everything this server knows about a single identity,
+// built from the identity chains and the admin blocks.
+type IdentityStatus struct {
+	IdentityChainID      string              `json:"identitychainid"`
+	Status               string              `json:"status"`
+	IdentityCreated      uint32              `json:"identitycreated"`
+	IdentityRegistered   uint32              `json:"identityregistered"`
+	ManagementChainID    string              `json:"managementchainid"`
+	ManagementCreated    uint32              `json:"managementcreated"`
+	ManagementRegistered uint32              `json:"managementregistered"`
+	MatryoshkaHash       string              `json:"matryoshkahash"`
+	Keys                 []string            `json:"keys"`
+	SigningKey           string              `json:"signingkey"`
+	AnchorKeys           []IdentityAnchorKey `json:"anchorkeys"`
+	IsAuthority          bool                `json:"isauthority"`
+	AuthorityStatus      string              `json:"authoritystatus"`
+	KeyHistory           []IdentityKeyChange `json:"keyhistory"`
+	PendingChanges       []IdentityKeyChange `json:"pendingchanges"`
+	Eligible             bool                `json:"eligible"`
+	NotEligibleReason    string              `json:"noteligiblereason,omitempty"`
+}
+
+type IdentityAnchorKey struct {
+	BlockChain string `json:"blockchain"`
+	KeyLevel   byte   `json:"level"`
+	KeyType    byte   `json:"keytype"`
+	SigningKey string `json:"key"`
+}
+
+// IdentityKeyChange is a key change recorded (or about to be recorded) in an
+// admin block.  For the key history, Key is the signing key that was replaced
+// at DBHeight.
+type IdentityKeyChange struct {
+	Type     string `json:"type"`
+	DBHeight uint32 `json:"dbheight"`
+	Key      string `json:"key"`
+}
@@ -23,6 +23,25 @@ type Signer interface {
 	Sign(msg []byte) IFullSignature
 }
 
+// SignPosition identifies what a server signature commits to in the process
+// lists.  Kind is the type of message (ack, eom, dbsig), and Part counts the
+// signatures made for one message, as a DBSig signs both the header and itself.
+type SignPosition struct {
+	Kind     string `json:"kind"`
+	DBHeight uint32 `json:"dbheight"`
+	Minute   int    `json:"minute"`
+	Height   uint32 `json:"height"`
+	Part     int    `json:"part"`
+}
+
+// IServerSigner signs on behalf of this server's identity.  The key may live
+// in this process or elsewhere, and a signer is free to refuse to sign two
+// different messages for the same position.
+type IServerSigner interface {
+	SignAt(pos *SignPosition, msg []byte) (IFullSignature, error)
+	GetPublicKey() []byte
+}
+
 type ISignature interface {
 	BinaryMarshallable
 
@@ -4,6 +4,10 @@
 
 package interfaces
 
+import (
+	"crypto/tls"
+)
+
 type DBStateSent struct {
 	DBHeight uint32
 	Sent     Timestamp
@@ -18,6 +22,13 @@ type IQueue interface {
 	BlockingDequeue() IMsg
 }
 
+// IBatchQueue is a queue that can take a group of messages in one go, without
+// messages from anyone else ending up in between them.
+type IBatchQueue interface {
+	IQueue
+	EnqueueBatch(msgs []IMsg)
+}
+
 // Holds the state information for factomd.  This does imply that we will be
 // using accessors to access state information in the consensus algorithm.
 // This is a bit tedious, but does provide single choke points where information
@@ -31,6 +42,7 @@ type IState interface {
 	Clone(number int) IState
 	GetCfg() IFactomConfig
 	LoadConfig(filename string, networkFlag string)
+	ReloadConfig() (*ConfigReload, error) // Applies what it can of the config file to the running node
 	Init()
 	String() string
 	GetIdentityChainID() IHash
@@ -72,7 +84,10 @@ type IState interface {
 	GetRpcPass() string
 	SetRpcAuthHash(authHash []byte)
 	GetRpcAuthHash() []byte
+	AuthenticateAPI(authHeader string) (user string, scopes []string, err error)
+	GetAPIRateLimits() (readsPerMinute int, readBurst int, submitsPerMinute int, submitBurst int)
 	GetTlsInfo() (bool, string, string)
+	GetTLSConfig() (*tls.Config, error)
 	GetFactomdLocations() string
 
 	// Routine for handling the syncroniztion of the leader and follower processes
@@ -125,6 +140,16 @@ type IState interface {
 	PutNewEntries(dbheight uint32, hash IHash, eb IEntry)
 
 	GetPendingEntries(interface{}) []IPendingEntry
+	GetMempoolInfo() *MempoolInfo
+	GetFactoidBalanceInfo(adr [32]byte) *BalanceInfo
+	GetECBalanceInfo(adr [32]byte) *BalanceInfo
+	GetProcessListInfo() *ProcessListInfo
+	GetRejection(hash IHash) *Rejection
+	GetFaultTraces() []*FaultTrace
+
+	// Protocol features gated by block height
+	IsFeatureActive(name string, dbheight uint32) bool
+	GetFeatures() []*FeatureActivation
 	NextCommit(hash IHash) IMsg
 	PutCommit(hash IHash, msg IMsg)
 
@@ -265,6 +290,7 @@ type IState interface {
 	GetEntryRevealAckByEntryHash(hash IHash) (status int, blktime Timestamp, commit IMsg)
 	GetEntryCommitAckByTXID(hash IHash) (status int, blktime Timestamp, commit IMsg, entryhash IHash)
 	IsNewOrPendingEBlocks(dbheight uint32, hash IHash) bool
+	IsChainCommitPending(chainID IHash) bool
 
 	// Used in API to reject commits properly and inform user
 	IsHighestCommit(hash IHash, msg IMsg) bool
@@ -280,6 +306,7 @@ type IState interface {
 	ExchangeRateAuthorityIsValid(IEBEntry) bool
 	FerEntryIsValid(passedFEREntry IFEREntry) bool
 	GetPredictiveFER() uint64
+	GetECRateInfo() *ECRateInfo
 
 	// Identity Section
 	VerifyIsAuthority(cid IHash) bool // True if is authority
@@ -287,6 +314,9 @@ type IState interface {
 	VerifyAuthoritySignature(Message []byte, signature *[64]byte, dbheight uint32) (int, error)
 	FastVerifyAuthoritySignature(Message []byte, signature IFullSignature, dbheight uint32) (int, error)
 	UpdateAuthSigningKeys(height uint32)
+	GetKeyRotationStatus() *KeyRotationStatus
+	GetIdentityStatus(chainID IHash) *IdentityStatus // nil if the identity is unknown
+	GetIdentityChainIDs() []IHash
 
 	AddAuthorityDelta(changeString string)
 
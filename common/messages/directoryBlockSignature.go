@@ -223,7 +223,11 @@ func (m *DirectoryBlockSignature) SignHeader(key interfaces.Signer) error {
 	if err != nil {
 		return err
 	}
-	m.DBSignature = key.Sign(header)
+	sig := key.Sign(header)
+	if sig == nil {
+		return fmt.Errorf("%s", "Signer refused to sign directory block header")
+	}
+	m.DBSignature = sig
 	return nil
 }
 
@@ -171,6 +171,9 @@ func SignSignable(s Signable, key interfaces.Signer) (interfaces.IFullSignature,
 		return nil, err
 	}
 	sig := key.Sign(toSign)
+	if sig == nil {
+		return nil, fmt.Errorf("%s", "Signer refused to sign message")
+	}
 	return sig, nil
 }
 
@@ -46,6 +46,12 @@ function updateHTML() {
   } else if($("#indexnav-more").hasClass("is-active")) {
     // Detailed Tab
     updataDataDumps()
+  } else if($("#indexnav-pl").hasClass("is-active")) {
+    // Process List Tab
+    updateProcessList()
+  } else if($("#indexnav-peers").hasClass("is-active")) {
+    // Peers Tab
+    updatePeerManagement()
   }
 
 }
@@ -74,6 +80,8 @@ $("#indexnav-main > a").click(function() {
     $("#transactions").removeClass("hide")
     $("#local").removeClass("hide")
     $("#dataDump").addClass("hide")
+    $("#processList").addClass("hide")
+    $("#peerManagement").addClass("hide")
   }
 })
 
@@ -83,9 +91,275 @@ $("#indexnav-more > a").click(function() {
     $("#transactions").addClass("hide")
     $("#local").addClass("hide")
     $("#dataDump").removeClass("hide")
+    $("#processList").addClass("hide")
+    $("#peerManagement").addClass("hide")
   }
 })
 
+$("#indexnav-pl > a").click(function() {
+  if (jQuery(this).hasClass("is-active")) {
+  } else {
+    $("#transactions").addClass("hide")
+    $("#local").addClass("hide")
+    $("#dataDump").addClass("hide")
+    $("#processList").removeClass("hide")
+    $("#peerManagement").addClass("hide")
+  }
+})
+
+$("#indexnav-peers > a").click(function() {
+  if (jQuery(this).hasClass("is-active")) {
+  } else {
+    $("#transactions").addClass("hide")
+    $("#local").addClass("hide")
+    $("#dataDump").addClass("hide")
+    $("#processList").addClass("hide")
+    $("#peerManagement").removeClass("hide")
+  }
+})
+
+// Lists the connected peers, the bans and their history, and the special
+// peers that were added. Changes go through ./post, and the answer says if
+// they were denied or failed.
+function updatePeerManagement() {
+  batchQueryState("peerManagement,peers", function(respRaw){
+    obj = JSON.parse(respRaw)
+    pm = obj[0]
+    peers = obj[1]
+
+    peerRows = $("#pm-peers > tbody")
+    peerRows.empty()
+    if (peers != null) {
+      peers.forEach(function(peer) {
+        if (!peer.Connected) {
+          return
+        }
+        con = peer.Connection
+        row = $("<tr></tr>")
+        row.attr("class", formatQuality(con.PeerQuality))
+        row.append($("<td></td>").text(con.PeerAddress))
+        row.append($("<td></td>").text(con.ConnectionState))
+        row.append($("<td></td>").text(con.PeerQuality))
+        row.append("<td><a id='pm-quality' class='button tiny' delta='-100'>-100</a> <a id='pm-quality' class='button tiny' delta='100'>+100</a></td>")
+        row.append("<td><a id='pm-ban-peer' class='button tiny alert'>Ban</a> <a id='pm-disconnect' class='button tiny alert'>Disconnect</a></td>")
+        row.find("a").attr("peer", peer.PeerHash).attr("address", con.PeerAddress)
+        peerRows.append(row)
+      })
+    }
+
+    reputationRows = $("#pm-reputations > tbody")
+    reputationRows.empty()
+    if (pm.Reputations != null) {
+      pm.Reputations.forEach(function(r) {
+        row = $("<tr></tr>")
+        row.attr("class", formatQuality(r.score))
+        row.append($("<td></td>").text(r.address))
+        row.append($("<td></td>").text(r.score))
+        row.append($("<td></td>").text(r.invalidmessages))
+        row.append($("<td></td>").text(r.usefulmessages))
+        row.append($("<td></td>").text(r.latency == 0 ? "-" : Math.round(r.latency / 1e6) + " ms"))
+        row.append($("<td></td>").text((r.uptime / 3.6e12).toFixed(1) + " hours"))
+        row.append($("<td></td>").text(new Date(r.lastseen).toLocaleString()))
+        reputationRows.append(row)
+      })
+    }
+
+    banRows = $("#pm-bans > tbody")
+    banRows.empty()
+    if (pm.Bans != null) {
+      pm.Bans.forEach(function(b) {
+        row = $("<tr></tr>")
+        row.append($("<td></td>").text(b.address))
+        row.append($("<td></td>").text(b.reason))
+        row.append($("<td></td>").text(new Date(b.created).toLocaleString()))
+        row.append($("<td></td>").text(formatBanExpiry(b.expires)))
+        row.append($("<td><a id='pm-unban' class='button tiny'>Unban</a></td>"))
+        row.find("a").attr("address", b.address)
+        banRows.append(row)
+      })
+    }
+
+    historyRows = $("#pm-history > tbody")
+    historyRows.empty()
+    if (pm.History != null) {
+      pm.History.slice().reverse().forEach(function(e) {
+        row = $("<tr></tr>")
+        row.append($("<td></td>").text(new Date(e.time).toLocaleString()))
+        row.append($("<td></td>").text(e.action))
+        row.append($("<td></td>").text(e.address))
+        row.append($("<td></td>").text(e.reason))
+        row.append($("<td></td>").text(formatBanExpiry(e.expires)))
+        historyRows.append(row)
+      })
+    }
+
+    specialRows = $("#pm-special > tbody")
+    specialRows.empty()
+    if (pm.SpecialPeers != null) {
+      pm.SpecialPeers.forEach(function(p) {
+        row = $("<tr></tr>")
+        row.append($("<td></td>").text(p))
+        row.append($("<td><a id='pm-special-remove' class='button tiny alert'>Remove</a></td>"))
+        row.find("a").attr("address", p)
+        specialRows.append(row)
+      })
+    }
+  })
+}
+
+// The zero time means the ban does not expire
+function formatBanExpiry(expires) {
+  if (expires.indexOf("0001-01-01") == 0) {
+    return "Never"
+  }
+  return new Date(expires).toLocaleString()
+}
+
+function managePeers(method, fields) {
+  postState(method, fields, function(resp){
+    obj = JSON.parse(resp)
+    if (obj.Access == "denied") {
+      $("#pm-error").text("Denied: the control panel must be readwrite and you must log in as an admin")
+    } else if (obj.Error != "") {
+      $("#pm-error").text(obj.Error)
+    } else {
+      $("#pm-error").text("")
+    }
+    updatePeerManagement()
+  })
+}
+
+$("#pm-ban").click(function() {
+  managePeers("ban", {"address": $("#pm-ban-address").val(), "duration": $("#pm-ban-duration").val(), "reason": $("#pm-ban-reason").val()})
+})
+
+$("#pm-special-add").click(function() {
+  managePeers("addspecialpeer", {"address": $("#pm-special-address").val()})
+})
+
+$("body").on('mouseup', "#peerManagement #pm-quality", function(e) {
+  managePeers("adjustquality", {"peer": jQuery(this).attr("peer"), "delta": jQuery(this).attr("delta")})
+})
+
+$("body").on('mouseup', "#peerManagement #pm-ban-peer", function(e) {
+  managePeers("ban", {"address": jQuery(this).attr("address"), "duration": $("#pm-ban-duration").val(), "reason": $("#pm-ban-reason").val()})
+})
+
+$("body").on('mouseup', "#peerManagement #pm-unban", function(e) {
+  managePeers("unban", {"address": jQuery(this).attr("address")})
+})
+
+$("body").on('mouseup', "#peerManagement #pm-special-remove", function(e) {
+  managePeers("removespecialpeer", {"address": jQuery(this).attr("address")})
+})
+
+$("body").on('mouseup', "#peerManagement #pm-disconnect", function(e) {
+  queryState("disconnect", jQuery(this).attr("peer"), function(resp){
+    obj = JSON.parse(resp)
+    if (obj.Access == "denied") {
+      $("#pm-error").text("Denied: the control panel must be readwrite and you must log in as an admin")
+    }
+    updatePeerManagement()
+  })
+})
+
+// Draws each VM of the process list as a row of messages, one cell per
+// height. Processed messages are solid, acked but unprocessed ones are
+// outlined, and holes we are waiting on are red.
+function updateProcessList() {
+  resp = queryState("processList", "", function(resp){
+    obj = JSON.parse(resp)
+    $("#pl-dbheight").text(obj.dbheight)
+    $("#pl-minute").text(obj.currentminute)
+    $("#pl-complete").text(obj.complete ? "Complete" : "Building")
+
+    timeline = $("#pl-timeline")
+    timeline.empty()
+    if (obj.vms != null) {
+      obj.vms.forEach(function(vm) {
+        row = $("<div class='pl-vm'></div>")
+        label = "VM " + vm.index + " <small>min " + vm.leaderminute + " ht " + vm.height + "</small>"
+        if (vm.faulted) {
+          label += " <small class='rank-red'>faulted</small>"
+        }
+        row.append("<div class='pl-vm-label' title='Leader " + vm.leader + "'>" + label + "</div>")
+        msgs = $("<div class='pl-vm-messages'></div>")
+        if (vm.messages != null) {
+          vm.messages.forEach(function(m) {
+            cell = $("<span class='pl-msg'></span>")
+            if (m.type == "") {
+              cell.addClass("pl-msg-missing")
+              cell.attr("title", m.height + ": missing")
+            } else {
+              cell.addClass("pl-type-" + m.type.replace(/[^A-Za-z]/g, ""))
+              cell.addClass(m.processed ? "pl-msg-processed" : "pl-msg-acked")
+              tip = m.height + ": " + m.type + "\nhash " + m.hash + "\nminute " + m.minute
+              if (m.acked) {
+                tip += "\nserial " + m.serial + "\nleader time " + new Date(m.leadertimestamp).toISOString()
+              }
+              tip += "\n" + (m.processed ? "processed" : "not processed")
+              cell.attr("title", tip)
+            }
+            msgs.append(cell)
+          })
+        }
+        row.append(msgs)
+        timeline.append(row)
+      })
+    }
+
+    $("#pl-dbsigs").text(obj.dbsigs.received + " of " + obj.dbsigs.needed + " (diff sig tally " + obj.dbsigs.diffsigtally + ")")
+    requests = $("#pl-requests > tbody")
+    requests.empty()
+    if (obj.requests != null) {
+      obj.requests.forEach(function(r) {
+        requests.append("<tr><td>" + r.vmindex + "</td><td>" + r.height + "</td><td>" + r.wait + "</td><td>" + r.requests + "</td></tr>")
+      })
+    }
+  })
+  updateFaults()
+}
+
+// Lists the recent faults, newest first. Hovering a row shows the
+// signatures and events with how long after the fault started they came.
+function updateFaults() {
+  resp = queryState("faults", "", function(resp){
+    obj = JSON.parse(resp)
+    faults = $("#pl-faults > tbody")
+    faults.empty()
+    if (obj == null) {
+      return
+    }
+    obj.forEach(function(f) {
+      tip = ""
+      if (f.events != null) {
+        f.events.forEach(function(e) {
+          tip += "+" + ((e.time - f.started) / 1000).toFixed(1) + "s " + e.event + "\n"
+        })
+      }
+      pledged = false
+      if (f.signatures != null) {
+        f.signatures.forEach(function(s) {
+          tip += "+" + ((s.time - f.started) / 1000).toFixed(1) + "s signed by " + s.signer.substring(0, 16) + (s.pledge ? " (pledge)" : "") + "\n"
+          pledged = pledged || s.pledge
+        })
+      }
+      sigs = (f.signatures == null ? 0 : f.signatures.length) + (pledged ? ", pledged" : "")
+      outcome = f.outcome
+      if (f.ended) {
+        outcome += " after " + ((f.ended - f.started) / 1000).toFixed(1) + "s"
+      }
+      if (f.negotiator) {
+        outcome += " <small>(we negotiated)</small>"
+      }
+      row = $("<tr></tr>")
+      row.attr("title", tip)
+      row.append("<td>" + new Date(f.started).toLocaleTimeString() + "</td><td>" + f.dbheight + "</td><td>" + f.vmindex + "</td><td>" + f.serverid.substring(0, 16) + "</td><td>" + f.auditserverid.substring(0, 16) + "</td><td>" + f.reason + "</td><td>" + sigs + "</td><td>" + outcome + "</td>")
+      faults.append(row)
+    })
+  })
+}
+
 function updataDataDumps() {
   resp = queryState("dataDump", "",function(resp){
     obj = JSON.parse(resp)
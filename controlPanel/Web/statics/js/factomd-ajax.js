@@ -24,6 +24,24 @@ function batchQueryState(item, func) {
   req.send()
 }
 
+// Posts a method and its fields to ./post
+function postState(method, fields, func) {
+  var req = new XMLHttpRequest()
+
+  req.onreadystatechange = function() {
+    if(req.readyState == 4) {
+      func(req.response)
+    }
+  }
+  var formData = new FormData();
+  formData.append("method", method)
+  for (key in fields) {
+    formData.append(key, fields[key])
+  }
+  req.open("POST", "./post", true)
+  req.send(formData)
+}
+
 $("#factom-search").click(function() {
   $(".factom-search-error").slideUp( 300 )
 })
@@ -37,4 +37,8 @@ $("td > #entry-content-body > a").click(function() {
   td = jQuery(this).parent().parent()
     td.find("#entry-content-body").hide()
   td.find("#entry-content-summary").show()//slideDown(100)
-})
\ No newline at end of file
+})
+// Switch an ExtID or content between UTF-8, hex and JSON
+$(".entry-data-encodings > a").click(function() {
+  jQuery(this).closest(".entry-data").attr("encoding", jQuery(this).attr("encoding"))
+})
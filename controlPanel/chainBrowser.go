@@ -0,0 +1,243 @@
+package controlPanel
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// The chain browser lists the chains with recent entries and pages through a
+// chain's entries, showing their ExtIDs and content decoded.
+
+const (
+	ChainEntriesPerPage = 25  // Entries on each page of a chain
+	ChainScanBlocks     = 100 // Directory blocks scanned for each page of recent chains
+)
+
+// EntryData is an ExtID or the content of an entry, decoded for display.
+// Text is only set if the data is UTF-8, and JSON if it is a JSON object or
+// array.
+type EntryData struct {
+	Text    string
+	Hex     string
+	JSON    string
+	IsText  bool
+	IsJSON  bool
+	Default string // The encoding shown first: json, text or hex
+}
+
+func decodeEntryData(data []byte) EntryData {
+	d := EntryData{Hex: hex.EncodeToString(data), Default: "hex"}
+	if utf8.Valid(data) {
+		d.Text = string(data)
+		d.IsText = true
+		d.Default = "text"
+	}
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, trimmed, "", "  "); err == nil {
+			d.JSON = indented.String()
+			d.IsJSON = true
+			d.Default = "json"
+		}
+	}
+	return d
+}
+
+// getEntryLinks fills in the blocks an entry was recorded in, and the commit
+// that paid for it and the entry credit block it is in.
+func getEntryLinks(holder *EntryHolder) {
+	hash, err := primitives.HexToHash(holder.Hash)
+	if err != nil {
+		return
+	}
+	dbase := StatePointer.GetAndLockDB()
+	defer StatePointer.UnlockDB()
+
+	if eblockMR, err := dbase.FetchIncludedIn(hash); err == nil && eblockMR != nil {
+		holder.EBlock = eblockMR.String()
+		if eblk, err := dbase.FetchEBlock(eblockMR); err == nil && eblk != nil {
+			if dblk, err := dbase.FetchDBlockByHeight(eblk.GetHeader().GetDBHeight()); err == nil && dblk != nil {
+				holder.DBlock = dblk.GetKeyMR().String()
+			}
+		}
+	}
+	if commit, err := dbase.FetchPaidFor(hash); err == nil && commit != nil {
+		holder.Commit = commit.String()
+		if ecblockMR, err := dbase.FetchIncludedIn(commit); err == nil && ecblockMR != nil {
+			holder.ECBlock = ecblockMR.String()
+		}
+	}
+}
+
+type ChainPage struct {
+	ChainID string
+	Head    string
+	Page    int // From 1, newest entries first
+	Entries []EntryHolder
+
+	HasNewer  bool
+	NewerPage int
+	HasOlder  bool
+	OlderPage int
+}
+
+// getChainPage gets a page of a chain's entries, newest first. The chain is
+// walked back from its head only as far as the page needs, so long chains are
+// not loaded whole.
+func getChainPage(chainIDString string, page int) *ChainPage {
+	chainID, err := primitives.HexToHash(chainIDString)
+	if err != nil {
+		return nil
+	}
+
+	dbase := StatePointer.GetAndLockDB()
+	head, err := dbase.FetchHeadIndexByChainID(chainID)
+	StatePointer.UnlockDB()
+	if err != nil || head == nil {
+		return nil
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	// One entry past the page tells whether there is an older page
+	want := page*ChainEntriesPerPage + 1
+
+	var hashes []interfaces.IHash
+	for keyMR := head; len(hashes) < want && keyMR != nil && !keyMR.IsZero(); {
+		dbase = StatePointer.GetAndLockDB()
+		eblk, err := dbase.FetchEBlock(keyMR)
+		StatePointer.UnlockDB()
+		if err != nil || eblk == nil {
+			break
+		}
+		entries := eblk.GetEntryHashes()
+		for j := len(entries) - 1; j >= 0; j-- {
+			if !entries[j].IsMinuteMarker() {
+				hashes = append(hashes, entries[j])
+			}
+		}
+		keyMR = eblk.GetHeader().GetPrevKeyMR()
+	}
+
+	p := new(ChainPage)
+	p.ChainID = chainID.String()
+	p.Head = head.String()
+	p.Page = page
+	// Past the start of the chain, show its oldest page
+	if last := (len(hashes) + ChainEntriesPerPage - 1) / ChainEntriesPerPage; last < p.Page {
+		p.Page = last
+		if p.Page < 1 {
+			p.Page = 1
+		}
+	}
+	p.HasNewer, p.NewerPage = p.Page > 1, p.Page-1
+	p.HasOlder, p.OlderPage = len(hashes) > p.Page*ChainEntriesPerPage, p.Page+1
+
+	start := (p.Page - 1) * ChainEntriesPerPage
+	end := start + ChainEntriesPerPage
+	if end > len(hashes) {
+		end = len(hashes)
+	}
+	for _, hash := range hashes[start:end] {
+		entry := getEntry(hash.String())
+		if entry == nil {
+			continue
+		}
+		getEntryLinks(entry)
+		p.Entries = append(p.Entries, *entry)
+	}
+	return p
+}
+
+type ChainActivity struct {
+	ChainID    string
+	LastHeight uint32 // The last directory block with entries for the chain
+	LastTime   string
+	EBlocks    int // Entry blocks for the chain in the directory blocks scanned
+}
+
+type RecentChains struct {
+	From   uint32 // The directory blocks scanned, newest first
+	To     uint32
+	Chains []ChainActivity
+
+	HasNewer  bool
+	NewerFrom uint32
+	HasOlder  bool
+	OlderFrom uint32
+}
+
+// getRecentChains lists the chains with entries in ChainScanBlocks directory
+// blocks, starting at a height or, if it is empty, the newest block, with the
+// most recently used first.
+func getRecentChains(input string) *RecentChains {
+	DisplayStateMutex.RLock()
+	height := DisplayState.CurrentNodeHeight
+	DisplayStateMutex.RUnlock()
+	if height == 0 {
+		return nil
+	}
+	newest := height - 1
+
+	r := new(RecentChains)
+	r.From = newest
+	if h, err := strconv.ParseUint(input, 10, 32); err == nil && uint32(h) < newest {
+		r.From = uint32(h)
+	}
+	r.To = 0
+	if r.From >= ChainScanBlocks {
+		r.To = r.From - (ChainScanBlocks - 1)
+	}
+
+	chains := make(map[string]*ChainActivity)
+	dbase := StatePointer.GetAndLockDB()
+	for h := r.From; ; h-- {
+		if dblk, err := dbase.FetchDBlockByHeight(h); err == nil && dblk != nil {
+			for _, block := range dblk.GetEBlockDBEntries() {
+				id := block.GetChainID().String()
+				c, ok := chains[id]
+				if !ok {
+					c = &ChainActivity{ChainID: id, LastHeight: h, LastTime: dblk.GetTimestamp().String()}
+					chains[id] = c
+				}
+				c.EBlocks++
+			}
+		}
+		if h == r.To {
+			break
+		}
+	}
+	StatePointer.UnlockDB()
+
+	for _, c := range chains {
+		r.Chains = append(r.Chains, *c)
+	}
+	sort.Slice(r.Chains, func(i, j int) bool {
+		if r.Chains[i].LastHeight != r.Chains[j].LastHeight {
+			return r.Chains[i].LastHeight > r.Chains[j].LastHeight
+		}
+		return r.Chains[i].ChainID < r.Chains[j].ChainID
+	})
+
+	if r.From < newest {
+		r.HasNewer = true
+		r.NewerFrom = newest
+		if r.From+ChainScanBlocks < newest {
+			r.NewerFrom = r.From + ChainScanBlocks
+		}
+	}
+	if r.To > 0 {
+		r.HasOlder = true
+		r.OlderFrom = r.To - 1
+	}
+	return r
+}
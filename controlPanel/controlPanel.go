@@ -1,8 +1,6 @@
 package controlPanel
 
 import (
-	"crypto/sha256"
-	"crypto/subtle"
 	"encoding/json"
 	"fmt"
 	//"io/ioutil"
@@ -154,8 +152,14 @@ func ServeControlPanel(displayStateChannel chan state.DisplayState, statePointer
 			}
 			time.Sleep(100 * time.Millisecond)
 		}
+		tlsConfig, err := StatePointer.GetTLSConfig()
+		if err != nil {
+			fmt.Println("Control Panel could not load its TLS certificates and will not be served:", err)
+			return
+		}
 		fmt.Println("Starting encrypted Control Panel on https://localhost" + portStr + "/  Please note the HTTPS in the browser.")
-		http.ListenAndServeTLS(portStr, tlsPublic, tlsPrivate, nil)
+		server := &http.Server{Addr: portStr, TLSConfig: tlsConfig}
+		server.ListenAndServeTLS("", "")
 	} else {
 		fmt.Println("Starting Control Panel on http://localhost" + portStr + "/")
 		http.ListenAndServe(portStr, nil)
@@ -220,6 +224,10 @@ func postHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	method := r.FormValue("method")
+	if isPeerManagementMethod(method) {
+		w.Write(managePeers(r, method))
+		return
+	}
 	switch method {
 	case "search":
 		found, respose := searchDB(r.FormValue("search"), *StatePointer)
@@ -241,6 +249,7 @@ type SearchedStruct struct {
 	Content interface{} `json:"item"`
 
 	Input string `json:"input"`
+	Page  int    `json:"page"`
 }
 
 func searchHandler(w http.ResponseWriter, r *http.Request) {
@@ -260,6 +269,7 @@ func searchHandler(w http.ResponseWriter, r *http.Request) {
 		searchResult.Type = r.FormValue("type")
 	}
 	searchResult.Input = r.FormValue("input")
+	searchResult.Page, _ = strconv.Atoi(r.FormValue("page"))
 	HandleSearchResult(searchResult, w)
 }
 
@@ -278,12 +288,17 @@ func factomdBatchHandler(w http.ResponseWriter, r *http.Request) {
 
 	items := strings.Split(batch, ",")
 	for _, item := range items {
+		if item == "disconnect" && !controlPanelAllows(r, constants.API_SCOPE_ADMIN) {
+			continue
+		}
 		data := factomdQuery(item, "", true)
 		batchData = append(batchData, data...)
 		batchData = append(batchData, []byte(`,`)...)
 	}
 
-	batchData = batchData[:len(batchData)-1]
+	if batchData[len(batchData)-1] == ',' {
+		batchData = batchData[:len(batchData)-1]
+	}
 	batchData = append(batchData, []byte(`]`)...)
 	w.Write(batchData)
 }
@@ -302,6 +317,10 @@ func factomdHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	item := r.FormValue("item")   // Item wanted
 	value := r.FormValue("value") // Optional argument
+	if item == "disconnect" && !controlPanelAllows(r, constants.API_SCOPE_ADMIN) {
+		w.Write([]byte(`{"Access":"denied", "Id":""}`))
+		return
+	}
 	data := factomdQuery(item, value, false)
 	w.Write([]byte(data))
 }
@@ -354,6 +373,27 @@ func factomdQuery(item string, value string, batchQueried bool) []byte {
 	case "dataDump":
 		data := GetDataDumps()
 		return data
+	case "processList":
+		DisplayStateMutex.RLock()
+		info := DisplayState.ProcessListInfo
+		DisplayStateMutex.RUnlock()
+		if info == nil {
+			return []byte(`{"vms":[]}`)
+		}
+		data, err := json.Marshal(info)
+		if err != nil {
+			return []byte(`{"vms":[]}`)
+		}
+		return data
+	case "faults":
+		DisplayStateMutex.RLock()
+		traces := DisplayState.FaultTraces
+		DisplayStateMutex.RUnlock()
+		data, err := json.Marshal(traces)
+		if err != nil || traces == nil {
+			return []byte(`[]`)
+		}
+		return data
 	case "nextNode":
 		// Disabled
 		index := 0
@@ -384,6 +424,8 @@ func factomdQuery(item string, value string, batchQueried bool) []byte {
 	case "peerTotals":
 		data := getPeetTotals()
 		return data
+	case "peerManagement":
+		return getPeerManagement()
 	case "recentTransactions":
 		RecentTransactionsMutex.Lock()
 		defer RecentTransactionsMutex.Unlock()
@@ -784,7 +826,8 @@ func doEvery(d time.Duration, f func(time.Time)) {
 }
 
 func checkControlPanelPassword(response http.ResponseWriter, request *http.Request) bool {
-	if false == checkAuthHeader(request) {
+	_, scopes, err := StatePointer.AuthenticateAPI(request.Header.Get("Authorization"))
+	if err != nil {
 		remoteIP := ""
 		remoteIP += strings.Split(request.RemoteAddr, ":")[0]
 		fmt.Printf("Unauthorized Control Panel client connection attempt from %s\n", remoteIP)
@@ -792,29 +835,16 @@ func checkControlPanelPassword(response http.ResponseWriter, request *http.Reque
 		http.Error(response, "401 Unauthorized.", http.StatusUnauthorized)
 		return false
 	}
-	return true
-}
-
-func checkAuthHeader(r *http.Request) bool {
-	if "" == StatePointer.GetRpcUser() {
-		//no username was specified in the config file or command line, meaning factomd control panel is open access
-		return true
-	}
-
-	authhdr := r.Header["Authorization"]
-	if len(authhdr) == 0 {
-		return false
-	}
-
-	correctAuth := StatePointer.GetRpcAuthHash()
-
-	h := sha256.New()
-	h.Write([]byte(authhdr[0]))
-	presentedPassHash := h.Sum(nil)
-
-	cmp := subtle.ConstantTimeCompare(presentedPassHash, correctAuth) //compare hashes because ConstantTimeCompare takes a constant time based on the slice size.  hashing gives a constant slice size.
-	if cmp != 1 {
+	if !constants.HasAPIScope(scopes, constants.API_SCOPE_READ) {
+		http.Error(response, "403 Forbidden.", http.StatusForbidden)
 		return false
 	}
 	return true
 }
+
+// controlPanelAllows is true if the request's credentials have the scope, for
+// the actions that change the node.
+func controlPanelAllows(request *http.Request, scope string) bool {
+	_, scopes, err := StatePointer.AuthenticateAPI(request.Header.Get("Authorization"))
+	return err == nil && constants.HasAPIScope(scopes, scope)
+}
@@ -182,6 +182,73 @@ func TestSearching(t *testing.T) {
 	}
 }
 
+func TestChainBrowser(t *testing.T) {
+	InitTemplates()
+	s := CreateAndPopulateTestState()
+	StatePointer = s
+	DisplayStateMutex.Lock()
+	DisplayState.CurrentNodeHeight = s.GetHighestSavedBlk() + 1
+	DisplayStateMutex.Unlock()
+
+	// Find a chain with entries
+	var chainID, eblockMR, entryHash string
+	for h := uint32(0); entryHash == "" && h <= s.GetHighestSavedBlk(); h++ {
+		d, err := s.DB.FetchDBlockByHeight(h)
+		if err != nil || d == nil {
+			t.Fatalf("No directory block %d: %v", h, err)
+		}
+		for _, ebhash := range d.GetEBlockDBEntries() {
+			eblock, err := s.DB.FetchEBlock(ebhash.GetKeyMR())
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, ehash := range eblock.GetEntryHashes() {
+				if !ehash.IsMinuteMarker() {
+					chainID, eblockMR, entryHash = ebhash.GetChainID().String(), ebhash.GetKeyMR().String(), ehash.String()
+				}
+			}
+		}
+	}
+	if entryHash == "" {
+		t.Fatal("No entries in the test state")
+	}
+
+	for _, c := range []struct {
+		search SearchedStruct
+		want   []string
+	}{
+		{SearchedStruct{Type: "chains"}, []string{chainID}},
+		{SearchedStruct{Type: "chainhead", Input: chainID, Page: 1}, []string{entryHash, "page 1 of"}},
+		{SearchedStruct{Type: "entry", Input: entryHash}, []string{chainID, eblockMR}},
+	} {
+		content, err := searchfor(&c.search)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range c.want {
+			if !strings.Contains(string(content), want) {
+				t.Errorf("Searching for %s %s does not show %s", c.search.Type, c.search.Input, want)
+			}
+		}
+	}
+
+	// ExtIDs and content are shown in every encoding they can be read in
+	entry := entryBlock.NewEntry()
+	entry.ExtIDs = []primitives.ByteSlice{{Bytes: []byte{0xFF, 0x00}}, {Bytes: []byte("<id>")}}
+	entry.Content = primitives.ByteSlice{Bytes: []byte(`{"a":[1,2]}`)}
+	db := s.DB.(*databaseOverlay.Overlay)
+	db.InsertEntry(entry)
+	content, err := searchfor(&SearchedStruct{Type: "entry", Input: entry.GetHash().String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"ff00", "&lt;id&gt;", "3c69643e", `encoding="json"`, "&#34;a&#34;: ["} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("The entry does not show %s", want)
+		}
+	}
+}
+
 // Trip some code that is sometimes tested in other unit tests. These lines don't have
 func TestRequest(t *testing.T) {
 	LastRequest = time.Time{}
@@ -24,11 +24,11 @@ type staticFilesFile struct {
 
 var staticFiles = map[string]*staticFilesFile{
 	"css/app.css": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xacY\xddn\xa4:\x12\xbe\x0eO\xe1\xd5h\xa43\xb3\r\x03tӝ\xa6ur\xb1:\xbb/q4\x17\x06L\xb0\xc6\xd8ȘIg\xa2\xbc\xfb\xca\xc6\x06\x03\x86\xee쬸Hp\x95\xeb\xcfU\x1f\xe5\xeao_\x01G-\x12-\xf8\xfa\xcd\xf32V\xbc\x827\xe0\x01\x00@\x06\xf3\x1fϜu\xb4\xf0sF\x18O\xc1\xa78\x94\xcfE\x91KF\x85\xdf\xe2_(\x05ѱ\xb9^\xbcwσ\xe0\r\x18\xde}~:\xc3\xf8\x02\x04\x87\xb4\xc5\x023\x9a\x02\x04[\x04\xc2 Jڞ\xed\x02\xde=\x98V\xec'\xe2\xd6N\xf4\x98%q$i^\x15\xed@\x15\xef@\xb5߁\xea\xb0\x03U\xb2\x03\xd5q\a\b\xcc\x10\xd9\x01m\xae\xd9xޟ\x93\xf3\xa3\xdcXE\xe0mn\xe5>\x96V\x0e\x8b/\b?W\"\x05\x94\xf1\x1a\x92\x9e \xd0U\xf8\xca\xe0\x92\xf1:\x05]\xd3 \x9e\xc3\x16I\xef\xaa\b\xb45$DK\x9e)u\xc7\xc4%\x942\xaa\xe4y\x01\x87\xf4\x87\xcfQa\xb9P\x1c\xe2\xc7X\xb9\xd0S\x9f\x19\xb1\xc9e\tQ\x18Zd\x8e\x10\xb5\xe8q\x06\x938S\xa1\v\xb2N\bFw\xc0\xfcŴ\xe9\xc4\xdf\xe2\xb5A\u007f\xb6]Vc\xf1]{\xe2:\xe8\xc7\xc39)/\x13G\x8f\xf99ϊ;\x02\xa55\xf7\xe7j\xf4\x9b\xb7\xa5\x15\xc3\xf9;\xec8D\xa7\f\x1e\x94\xbf\xbd\x98\x00\x12ą\x9b9.\xa22*/\x8et\x98l\xde\xd2w\x8a\xe3S|\xbaX\xf1.U0-\xab\xa5\xebߧ~ \xc8\xf3j\xba\x86j\x88\xc9t\xa9\x81m\xfb\xc2x\xf1}\aZDP.v*\x8c\x90#\xa8\x0f\xa2\x81E\x81\xe9\xb3OP)R\x10qT_f\a$\xbd,\xe5\xa3\t\x8c\x17\x88\x9b\x94\x1aW|\x0e\vܵ)x4I\x98\xb1\xab\xdfV\xb0`/6\xb7q\x13B(\x0fn\xeeeZ\xb2\xbck]\xbe:(\xbd\xc7\x0e\xc2\u0de1\xf5ޛ7\x13\x83\xfe}\x91\x92\x12IJ\xf9\xac{<\xf7\xec\xddS\xf0\xa0EՐ?c\xeagL\bV\xa7\xe00\x01\x81)~\xa5\xa9\xff\x82\xb2\x1fX\xf8\xca|\xbf!0G\x15#\x85Lܩ\xff\xeb\x9cV5&\xa5|.Z\x95x%(\x05X@\x82s\x99T\xa9_\xb3_[:\xe6\xf4\xfb%\xdf\x14\xfd\x1b\xb2\xfd\xba\xbd\x1d\x1e'\xd3\xdd:\x82~\xabL\x80\xc6$\xc4J^\xcbR.\x89<\xfb\n\x17\x05\xa2\n\u007f\xec\xed\xcb\xca]#\x0fe\xbc\xc6`jz\x8d>$\xbaĖ\xa9\xbd\xe1\xdc-_\xa7\xa8\x13\xf4\xa0|.\xd3\\w\xa1\x9a\x80Y;\x11ax\xa7;5\xa7/\xb0 \b\xbc\x99\x8a\xb0PƉ0\xae\x98/\xe3=\x95\x9e\x96\x98\xb7\xc2\xcf+\xac>[\xbd&\xe3\xfe\xc8\xf6\x04M\xf5O\x16\xff\x86\x1cC\xbf\x87\aT\xfc)x\x87\xbe\xcf\xfc3_\xa69BO\x85/\x11^\x86\xf5$\x9f\xad\x9d\xc0\xee_$&\x0e\xe4\x9cQ\x81\xa8\xf8@\xb0\x1bH\xd5\xf9jL\xef\x03\r\xc2>\x14\x02f\x04\x01Q!X\xdc\xf7\r^\xc2\xde\xe6\x17ؖ/*\xd3\xd0َ/ \xf0\xb0\xd9\x1c\r\"\xfb\x8ek\xd5\xe4\xd5o\xd3T\x80\xe0)\x15U\x9f&\u007f\xa0\x9f\x88~qW\xc2\xfe(\x1f+bz7\x102\xbb\xe6\xe6\x8fl%cb\xddJ\x9d\b[V*\x01\xa2\x98\xb5z\xffC\xe0\xee=*\xe9\x92]<;0\x12\xaaYU\xcdڄ Q%\xfc\x82\vQ\xa5 \n\xc3Ϫe\xf1\xbco_A\u07b5\x82\xd5@!l\xdf\xe1\a%\xcc\x05\xab\xfd\x1e\xed|\xc49\xe3\xe0\xcd{\x18J\x15\xfc\x03\xd7\r\xe3\x02Rq\xf1\x1e\xb4\xd4\xe4\x146\xd7\tE6\xe7\bJT\xff'\b8{\x19q\xc5|iU\xbf--\xf9DX\x0eI\xef\xd0\x0e\xd8o}\x8a\xce\xd7\xe4!\xcf\xd7\xfa\x135N\x9e\xa49R\xf6o\t+p\xdb\x10\xf8\x9a\x82\x8c\xb0\xfc\x87-N\x17\xce\xf4@\f\xb1\xcf\xc1\xc2Mԉ3;1s6\xfb\xc3\xd4l\xa3g,\x86\xf8\xcbR\x91\x9bj4\xd9\xd4Q\xd5cxK\xd3~S\xd3~S\xd3\xde\xd2\x14\xddRt\xd8Tt\xd8Tt\xb0\x15E7}J6U%\x9b\xaa\x12[Urӫ㦪㦪\xe3DUrK\xd5iS\xd5iS\xd5i\xa2\xea\xe0\xaa\x1as\xe7\u007f\x98ՃĄ\xab_i@\x8b\x8f\xaa\xe7z0\r\x80\xff\x9a\x826猨\xaf\xc3'\x05o0\x97\xd7\xfcv\xa8\xf4\xe5\xe2P\xa3.\x92.U\x17iZ\xfeQ4\xd4\xff\xff]\x85\x03\x14\xd6\x04\xdd\x0eZ\x12\x86[Ak \xfd\x8fDc\\\xb4k\x983aq \xcf\x18\x94cbb\xe2\x14;\x03\x11\xa7\\7\x94D\xa7\xe4>\xc1\xfb;\x04O\x90\xe3x\xa7Ň;\x04\x1f\xd6,\xfe7\x15\x1c\xa3\xad\x00\x0f\x1c\x9b\xf1M\xe2ӆ\xd0ex\x17R\xddѽW\xec\xfe\xb6X;\xb6\xe7\xf3(\xf5/\xccQ.\x18\u007f\xfd\x97Lэ@\xcc\x197㱷\xf3mM\xc52,k:\xd6>c{\xad\xa4\x80\x02\xfe\xd5Ս=3y\xa81]\xd4ڤ\xe7\x0f\xc3p\xa5\xfc\x1eT\xbbV\xc2\x1a\x93\xd7\x14Ԍ\xb2\xb6\x81\xb9j\xc9>\xa1kC\x18G\\\x17\xfb[\xff\xd7'\xf0\x95u\"\x05%\xbe\xa2B\x195cT]\xe9xC\x82\x9d`n\xb6\x95\xf6 Q\x9d\x9bn\xab&#\xa1\x14\xc4qs\x05!H\xf4\xa8B3\x05}\x1bg5\x85\x825)\x88L\xeb\xd5\"\x05[\xeeQ\x88\xea\x11FYO\xaa\x8f\xd3C\xd5'`\xb6>\r\xed\x1d\xa6\xbe\xb14\x0e\xf5\xe1\x1b\xaea\xe0:\xf6\x91\xa1\x9e\xb8\x8eLA\x8d\x04ǹۚ\xf8\xd1\xcd\x1c4\x9c=sԚ\xd1\xd0\xf0A\n\x0e\xfbS☑\r}~t\x92\xcf\xe5\xc3\xf3\x83u\v\x86\xff\xfc\x1a\x89ጜ\x83\xf2\xfd9\x8a>$,\xa8Q\x81\xbbze$p\xdc?\x86\xa5\x1d\xf0\xdb\xf2\x88:5\x97\xb0$\n\xa3\xf0C\xc2\xe6ﾬB\xed\xfe\xe4\x1a\xd2_xƻ\x8e\xfa\x97@\x81\xfe\b?\uf01f\x84\x9f\xbf\\\xee\x1f\x9f/-\xec+H\xcfs\xe7\x13\x06\xeb\xb2\xce\x191W\xf0\xc9u\xa9\x1fw\x98%\x93{\xfd\xea\xbb\x17\x14]\xdd\xf8\x02foҜ\xe5\x88T\x19I\x18\x14\xa9\xf2\xba_\x98w\x00*&\x98\xa2\x01\x99\xa2\x85w\x81\x9d\xb8\xf3IѴ\x8c\x14 )\xb3\xa4[\x10\xd3\x11\x1a\x98\xfeY\x85#\x02\x05\xfe\x89$g\xd9\x11\xd2\xe6\x1c!\xea\xb3FR{\xde_>\xa6\x05\xba\xa6`\xbfR-/\x15\x16z\xb2\xa0͎\a\v\xb9z\x8f\x92\xe1\a\r\t1\xa1y\xcb;\xde\xca\b5\fS\x81\xf8\x8a|L+ı\xf0ށ\x17\xc0\xace\xa4\x13\xc8_\x18+q\xccx\x05\f\x9b:\x18\x85\xb976\f\xb8\xbc\b\xd8\x13\bƭ\xb3\x88ė\xfe\xa0'\xf7\xe7\t\xd2XK\veV@\xf4K_\x0e\xea\xed\xdd\x03\xc0\v(\x13~\xa9Ba.\xdb.IV\xa4A\x12~\xb6\"\xadޔS\xb8\xcd\x19\xa5(\x1f[\xe1sh\xee\xd8\rB\xdc/:\x0euX\xe6Ǣ>Ԓ\a7[Զ\x9fr\xad\xd29\xca\x11\xfe\xd9\xffd\xb5\xe4\xf9o\x00\x00\x00\xff\xff\xbcg\xeeIM\x1c\x00\x00",
-		hash:  "0ac97184badb3ed10d956c6cdaa5c692615e83f85aebf93dc268604a17da953f",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xac9ݎ\xa3:\xd2\xd7\xcdSԧ\xd6Hg\xe6\v\x19 !\xe9\x10\x9d\xbeX\x9d\xb3W\xbbڕ\xf6\xf2h.\x1c0\xc1jc#\xdb\xe9NO\xd4ﾲ\xc1\x84\x1fCҚ\x15R\xa7q\x95\xeb\xbf\xca\xe5\xe2\xfb7\x10Xb%\xe1\xdbw\xcf;\xf0\xec\x1d.\xe0\x01\x00\x1cP\xfar\x14\xfc\xc42?唋\x04\x1e\xa3@?{\x03\xce9S\xbe$?q\x02\xe1\xa6:\xef\xbd\x0f\xcfCp\x01\x8b\xbbJ\xb7;\x14\xedA\t\xc4$Q\x84\xb3\x040\x92\x18\x82e\x18\xcb\x1am\x0f\x1f\x1eJ\n\xfe\x8aEg'~:\xc4Q\xa8a^\x11.\xa0\x88\x16P\xac\x16P\xac\x17P\xc4\v(6\v\xa0\xe8\x80\xe9\x02\x1aq\xed\xc6\xddj\x17\xef\x9e\xf4\xc6\"\x84\xcbP\xcaU\xa4\xa5l\x17\xdf09\x16*\x01\xc6E\x89h\rP\xf8\xac|#p\xceE\x99\xc0\xa9\xaa\xb0H\x91\xc4Z\xbb\"\x04Y\"J\x1b\xca\x03\xa6n\x9b\xb8\x882\xce\f=o)\x10{\xf1\x05\xce:*d\xeb\xe8)2*\xd4\xd0#\xa7]p\x9e#\x1c\x04\x1d\xb0\xc0\x98u\xe0\xd1\x01\xc5\xd1\xc1\x98ny8)\xc5\xd9\x02\xec/a\xd5I\xfd\xa5\xde+\xfc\xbb<\x1dJ\xa2~\xc0e\xd2\xd1O\xeb]\x9c\xef{\x8an\xd2]z\xc8\xee0Tù\xf6\xeb\x02\xfaoc)Z\xff;\xe4X\x87\xdb\x03Z\x1b}k2KD\xb1Pn\xe4(\v\xf30\xdf;¡\xb7y\x8e\xdf6\x8a\xb6\xd1v߱wn\x8cّZ\xab\xfe\xa3\xaf\aF\"-\xfak\xb8D\x84\xf6\x97*$\xe5\x1b\x17ُ\x05HLq\xaa\x16ƌH`\xd48\xa2BYF\xd8ѧ8W\t\x84\x02\x97\xfb\x81\x83\xb4\x96\xb9~\x1a\x00\x17\x19\x166\xa4\xae+\xbe@\x199\xc9\x04\x9el\x10\x1e\xf8ٗ\x05\xca\xf8[\x17۪\x89\x10Ҏ\x1bj\x99\xe4<=I\x97\xae\x0eH\xad\xb1\x03\xd0\xeama\xb5\xf6\xf6\xcdڠ~\x1f\x85\xa4\xae$\xb9~\xa65\x1ej\xf6\xe1\x99\xf2А*\x918\x12\xe6\x1f\xb8R\xbcL`]\x9d'r\xf5\xc3K\x12\xff\r\x1f^\x88\xf2\x8d\xf8~EQ\x8a\vN3\x1d\xb8}\xfd\xa71;\xd9\x18\xe7\xfa\xd97\xac\xd4;\xc5\t\x10\x85(IuP%~\xc9\x7f\xce\xf1\x18\xc2\xef\xa7|\x93\xf4/\xd0\xf6Ky\xdb<N\xa4\xbby,\xeb\xad:\x00*\xb8t\xfc>\x8ek\x9d\xca9վ/H\x96a\xb6\xf7\x06\xdbǙ;\x05n\xd3x\n\xc1\xe6\xf4\x14\xbc\rt\xb8\f\xe5\r\x86j\xf9M\x88:\x8b\x1e\xd2Ͼ\x1f뮪\xa6\xd0A\xf6HX\xdc\xfe\xce\x06\xd3WDQ\f\x17\x9b\x11\x9d*\xe3\xac0.\x9b\x8f\xedݧ\x9e\xe4DH\xe5\xa7\x05\xa1Y\xcbɪ\x7fE{F6\xfb{\x8b\x7f!A\x90_\x97\a\x9c\xfd\xae\xc4\t\xff\x18\xe8gO\xa6a\x85\xee\x13\x1fWxm֭~\xe6vB\xb7\x7f\xd15\xb1\x05\xa7\x9c)\xcc\xd4'\x8c]!f\xfc\xdb\xd4\xf4\xda\xd0\x10ԦP\xe8@1\xa8\x02\xa3\xec\xbe3x\\\xf6fO\xe0.}U؆\xae\xab\xf8\xa8\x04\xaeg\x9b\xa3\x96d\xddqM\x8a<y6\xf5\t(\x910U\xd4a\xf2\x1b~\xc5\xec\xab;\x13V\x1b\xfd\xeca\xb8\x1b\x94\x8e\xae\xa1\xf8W\xb4\x9cs5-e\x13\bsR\x1a\x02*\x83˯\x1a\xee^Wi\x95\xbaɳ\x80+\xa0\x18dՠMX\xc6&\x85\xdfH\xa6\x8a\x04\xc2 \xf8bZ\x16\xcf\xfb\xfe\rғT\xbc\x04Sa\xeb\x0e\x7f\x99\xa3T\xf1ү\xab\x9d\x8f\x85\xe0\x02.\xdeC\x9b\xaa\xf0\x7f\xa4\xac\xb8P\x88\xa9\xbd\xf7\xd0P\x8d\xb7Au\xeeAts\x8e\x91\xae\xea\xff\x0fK\xc1\xdf\xe02<iM\xbf\xad%y\xa4<E\xb4Vh\x01ݷ:D\x87k\xda\xc9õڣV\xc9\xed6\xa8i\xff\x12\xb1\x8cȊ\xa2\xf7\x04\x0e\x94\xa7/]rM\xe2\xf4\x1db\x81u\ffn`\x138\x03\x8fY߬\xd6\xd5\xd9\xc5\xe7\x9a\f\xd1\xd71#7\xd4r\xeaB\xaf\xac\x9e\x82[\x9cV\xb3\x9cV\xb3\x9cV\x1dN\xe1-F\xebYF\xebYF\xeb.\xa3\xf0\xa6N\xf1,\xabx\x96U\xdce\x15\xdf\xd4j3\xcbj3\xcbj\xd3c\x15\xdfb\xb5\x9de\xb5\x9de\xb5\xed\xb1Z\xbb\xb2\xc6\xde\xf9\x1f\x06\xf9\xa0k\xc2\xd9/\x9a\x82\x16mL\xcf\xf5`\x1b\x00\xff=\x01\x99\nN\xcd\xe9\xf0h\xca\x1bJ\xf55_\xb6\x99>^ls\xd4\x05jR\xd5\x05\xea\xa7\x7f\x18\xb6\xf9\xff?g\xe1(\nS\x84n\x1b-\x0e\x829\xa3U\x88\xfd]Wc\x92ɩ\x9a\xd3CqT\x9e\xabQ6\xb1\xb5\x89\x93절8\xe9\xbaKI\xb8\x8d\xef#\xbc\xba\x83p\xafrl\xee\x94x}\a\xe1\xf5\x94\xc4\x7f2%\b\x9e3p\x8b1k\xdf8\xda\xce\x10\x1d\x9bwD\xd5m\xdd{ɮn\x93\xed\xdav\xb7\xbbR\xfd\x83\b\x9c*.\xde\xff\xa6Ct\xc6\x10C\xc4Y{\xac\xba\xf16\xc5bl\x96)\x1eS\xc7تa\x92!\x85\xfe8\x95Uwf\xf2P\x126ʵ^\xcf\x1f\x04\xc1D\xfa=\x98v-G%\xa1\xef\t\x94\x9cqY\xa1Դd\x8f\xf8\\Q.\xb0h\x92\xfdR\xff\xfa\x14\xbd\xf3\x93J 'g\x9c\xeda\x8ch\xba\xd2\xeb\r\t\x9d\x14w\xa3M\xb4\a\xb1\xe9ܚ\xb6\xaa7\x12J \x8a\xaa3\x04\x107\xa3\x8a\x06iY\xb7q\x9d\xa6P\xf1*\x81ж^\x12\x9b\xb2\xe5\x1e\x85<\x05=ZϦ\x8fk\x86\xaa\xcf`\xb7>\xb7\xed\x1da\xbe\x954\n\x1a\xe7[\xac\"\xec\xf1\xd0}d\xd0L\\\xafH\xcb\x12+AR\xb74ѓ\x1byY\t~\x14X\xda\xd1P{ -\u05ebm옑\xb5}~\xb8\xd5\xcf\xfe\xd3\xf3\x83i\t\xda\xff\xfc\x12\xab\xd6G\xceA\xf9j\x17\x86\x9f\"\xb6,qFN\xe5\xc4H`\xb3z\n\xf2=|\x86\x1e\xe5o\x13\xc4\xe20\b\x83O\x11\x1b\xbe\xfb:\v\x1b\xf5{\xd7\x10\xb3ҹ\xeb\x98\x7f)R\xf8\xb7\xe0\xcb\x02\xfc8\xf8\xf2u\x7f\xff\xf8|,a\x9dA\xcd<w8a\xe8\\\xd6\x05\xa7\xf6\n\u07bb.\xd5\xe3\x0e\xbbdc\xaf^\xfd\xf0\x96٩\xac|\x85\x0e\x17\x00\x80\xf1\x88\xd4\bI9R\x89Ѻ^\x18v\x00\xc6&\x84\xe1\xb62\x85#\xed\x96\xdd\xc0\x1dN\x8a\xfaid\n\x92\x11K\xab\x85\b\xbb\x96\x06\xde|V\x11\x98\"E^\xb1\xc6\xccO\x94\xcaT`\xcc|^ih\x8d\xfb\xd3',\xc3\xe7\x04V\x13\xd9\xf2V\x10\xd5L\x16\x1a\xb1\xa3VBa\xdeø\xfd\xa0\xa1KL`\xdfғ\x90\xdaB\x15'La1A\x9f\xb0\x02\v\xa2\xbc\x0f\xf0\x96\xe8 9=)쏄\x85\xcbU+\xb0h\xc61\xa6\xe6\xde\xd8\xd0\xd6\xe5\x91\xc1\x9eay\xdd:\xb0H\xb4\xaf\x1dݻ?\xf7*MgiĬc\x90\xe6\xa5N\a\xf3\xf6\xe1\x01xKƕ\x9f\x1bS\xd8˶\x8bR\xc7\xd2\x10\a_:\x966oF)\"S\xce\x18N\xaf\xad\xf0.\xb0w\xec\nc\xe1g'\x81\x1a\xb3\f\xdd\x02\x1f\r\x0e\xa9栲\x9erM\xc2\x05N1yř\x1bG\x8f\x1c\xfe-x\xaa+\xc8?\x88T\xa0H\x89u.\xe8\xd1\xc3cE}\x8a\x8f\x98e\xe3YA}\xb5s\ft:\x9b\x96\x15\xf5Ky\x1c\r0\x1b\x13h\xf0k\xd9\xed\xe0s\x8a\xcf{@\x94\x1c\x99O\x14.e\x02)\xaeEu|\x14hI\xb4\xa3\xd9&\"\x9a\xb3NS\xf3e!\b{1\xeeu\xccc\xeb\xed%\x96\x12\x1d\xb1\x1c\x8bbH\xbc\tT%\xa0\xff\xda=F\xa9~)!\xccT\x90NEiO^\x9bwmt\xb6g\x99\xad\x19auv\x96\x96\xa8\xbfn\x10ArJ\xb2\xf6k\xad\xd7J\xe4W\xb5\x1f\x8d\xab]\xa3\xb9z\xc3U\x05\x1f\xa5/\x13\xc8\xe6\x10\xa8\x90\xc0Lu7\x94DJ\u008en\xfa\xf6Ch\xa3\x83\xe3\xfbhME\xff\xe8\xe9\xbb\xff\xe7\xbf\xfe\t\x97!\xfa\xf5S\xf2H\xad\xe1Ʊ\b\xa3\xcd\xed\x96~\x13\xfb\x1frdH\x9d\x04\x1e\xf3\xbf~\x8f\x9d\xe6?C\xcc\xd1X\xb4\x04\xff;\x00d\xc5!e\xb9\x1f\x00\x00",
+		hash:  "23019386a386fd3e4047cb272b45a0a0eda01d6ebfcc9c64ab96f9e3f9bccb6a",
 		mime:  "text/css; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  7245,
+		mtime: time.Unix(1792387183, 0),
+		size:  8121,
 	},
 	"css/font-awesome.min.css": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xcc}M\x8f\xe4\xb8\xd1\xe6ݿ\"w\x06\xde\xe96J5%eV~T\xc1\xeb\xd9\x0f\x180`c\x0f\xf6a\x0f{\xa1\xa8P\x8a\x9d\x14\xa9!\xa9\xac\xcan\xf4\u007f\u007f!\x89AQYAy^`\x0e\xafa\xd8\xd5\xe4C\x8a\x1f\xc1`0\xe2!\xf3\xe7?\xfd\xb7?l\xfe\xb4\xd9\xfcU+\xb7\xf9\x9fo`u\v\x9b\xdd\xe3\xfeq\xbb)o\x9b_*v\x853S\xd5m\x93m\x1a纗\x9f\u007f\xae\xb5rl\x02>\n\xbd\xc96\xbfD)c]\u007f\x17\x1c\x94\x85T\x91\x9f\xa5\xcf\xff4|\xf4e\xf3Ͽ\xfd}\xf3\u007f\xff\xfa\xf7M\xfe\x98?l\xfe\xf7?\xff\xf9\xb2\xf9\xc7\xdf\xfe\x85\x95|\xfe\xc3\xe6O?\x8f_\xc8j\xc6\xe1\x9b\xff\xab\x15\xf2\xf6\xf2\xd3P\u07b7\xf9\xa7Wk\xf8Ko䧟\x1e\x1f\xc7\x0f\xda\xf8\xb3\xd9\x1b\x94\xc3?\x1fA\xbb\xbf\\\xff<v\xf0\xa7\xcf\xff\x892?\n\xa8\xc5\xfb\u007f\x0fE7\xb56-s\x9f~\x82\xb6\x84\xaa\x82*\xd3\x1d(w\xeb\xe0\xa7\xcf\x0f\xff\xbe\xca7]\xd7\xc5_>\xd66\xa6\xff\xe6\x1a\x12\x15\xfc\xa6\xf2\xceQŝ\xe9\xe17w\xc2^\xcfXŏQ\xbe\x81s/\x99\x89j\xb5\xd7\xf3O\x9f_ǩ{\x03qn܋\x1ar\xe4\x94d\xddM\x82O\xf9\xfeX\xb3o\x95\xb0\x9dd\xb7\x17\xa1\xa4P\x90\x95R\xf3\xcb\b\xf5\xa0\xcd\xf2\xff\xf2]\xf7\xfes\xbe\x89\x84\xc1\xd7+\xbe\u008bP\r\x18\xe1^\x1d\xbc\xbb̀\xaa\xc0\bu~a\xbdӯCG.\xc2e\x13\xba\xd5\xda5c\x9er\x82I\xc1,T\xafY\xab\xbffھ\xdfcΆ\xdd,g\x12\x86\x06g\xf2\xfcm\xfeb\xfe\xb8\xf5\xff\x81\xf6u\xec@3\xf5\xf9\xf1\xf0\f\xed\xeb\x15\x8c\x13\x9cɌIqV/Y\xfe\xfcǱ\x8e\xe2=\xaa\xa3\x80vL\xdcƉ[\x9f\xb8\x8b\x13w>\xf19N|\xf6\x89\xf5۷7Q\xb9\xe6%\u007f,\x8eχ|W\x9c\xa0\x9d\x86b\xfa:\a\xe5\xc0\x8c\xd8^~\xebXU\tu\xce$\xd4\xee\xe5\xe9\xb5e\xe6,\xd4\xf4\xaf\xe21\xdfMU\x8c\x9d\xb2~ֲAV^\x94V\xe0\xeb\xf8\x1fR|\xeb\xb4\x15Nh\xf5b@2'\xae~\x8c\xa2\fVZ-{\a\xafc\xdd٢\xf2\xa9\xc1\x8b$\xa7\xbb\x97ſ\xc9\x0eH\xe1\xa7b\xaa4\u007f\x9cz|\xdc\xfb\xc1(\xb5\xa9\xc0`'_\x1e\vh7\x8f\xc5\xf3\xf0\xbf\xf901S\xfe\x8b\xd5RT\x9bǧ#\xb4\x9b\x1f\x01\xc0\xa7g\x86U\xa2\xb7/\x8f\xb9\xaf\xae\xeb\xa5\x1c\xc7\xe6[-5s/ßs\x86\x19f\xdc\xe7\x8c\u007f\x0fY\xcbb~x\xcd$\x1b~r\xef*\x88\xa7`\x82\xa4jO5\xe7\xf1\xdf}p\xf5k5\xcbl'\xd47\\)L\x89\x96\x8dS\xe8s6\x85\xdd\bU\v%\x1cl\x06ag\xe6\xf5\xb7\x80p\xa4,\xacԝGŬ\x83\xce~:~~\xfdm\xb0\xef\xbf`\xb5\x17\xb8Ն\xb5`7ؙ\xa7?\x86o:Ô\x1d\xb4ԋю9\xf8\xf4T\xc1\xf9\xf3+\x9d\xfc=\u007fZ+\xba}>х}\xc6\xf7\xef\xbf\xfc\x17j\xcb0\xfeSZvz\xfa\x96\xb56\xab\x85t`^~\xe8\x8c>\x8b\xea\xe5\xff\xfc\xbf\xbf\xb5\xec\f\xff\xc2\x1a\x1e\xff!\xb8\xd1V\xd7\xee\xf1\u007f1+\xf8\x98\xfbi\xacBh\xf5\xe7\xfc\xf3\x0f\xaf\xc9朦\xae\f_I\xe5%\xd2\xe3v\xe6\xc7ߣ\xa1\xc5ZC\xf3\xe3JK13\x95\x11\xb7\xb58\xfc\x1emݮ\xb5\xb58\xac\xb4\x153S\x19\xd3\xf6 E\x975ڈ\xaf\xc3\xe6-\u007f\x87\x06?=lZa\x8c6\ty\x18\xb7\xccOY\xfe\xb0\xc9\xef\x1b\xbeȢ\x93\xe7F\xe3&\xfa{\xc8\xc3ojr\xfe\xb0\xc9\x12M\xf6Yt\xf2\xf7\x17\xa3\xb5\xdb,V\xdbÇ\xb4\xfcH$\x16\x878\xf1n\xae\xees\u0080\xf8\xc1\b[\xb2u\x8c_>nɯ\xa4\x8d\xe5\xf7^h_\xbd\xc9Rܙ0\xc5G\x03\xa6\x15U%\xa3oe\xf9\xfb\xc3\xfc\x8f\xe2=\xb5\xeb?\xf9\xaf\r\x8a,\xb1\xa3c}\xdf\xe2&x\x83\xee\xfb\xe2\x1b\x1f-'\xa1\xae`,|\xe3Zj\xf3\xf2c]\xd7c\xf2Y2k_J\xa8\xb5\x19\xf2\x94\x03\xe5^~\xf8\xff\xf5\xd3\xd3\xd3\x0f#\xa0\xed\xad\xe0$ \x9f\x00\x16\x98\xe1\r\x89(&\x04\xa8+H\xddA\xa6I\xd4vB5\xc0\x8c#\x01\xbb\x1f\xb0s\x86\xcc\u007f\x9e\xf3\x13\x9f\xd8O\x88\xde\x02]\xc3aʯ\x85l\xc9\xfc\xe3\x94\xef\x9aL2s\x06\x12sB\f\x99\xcb\xe6\x1a\x84\xa5\xbbYN\x10\xde\x00\xbf\x90\x00>\x01\f\xb4\xfa\x8am\x18E\x8bKm\x17\tN\xb4@\xcfj\x15OZ\xd6ɞ\x86\xc1\x02\xd6\nE\xe3r/$\x9d~\x03\x93\xe9\xba&A((⬘$\x11^P\xce\x10fx\xea\x97>\x93p/1\xce0\xdb\xd03\x9e{\x99itK\xceV\xfe\x1cf<!\x96\xb9\x97\x19>(\x82\x04ċ\x8dѬ\"\xf3\xbd\xd8T\xfaM\xc9\x14Ƌ\r3F\xbfe\\\x18>4h,B\xc2\x19\t\xef;\x12\xec%J\xa8R\xbf\x93\x00/Q\x83\xe2\v\x95\x91\xc0\xea\x87x3\x9f,\xe6h\xa2\ft\xc0H\xa9\xce\x01\x85\xb66`ɵQx\xf9\x18OQL\x92\xd5\x14^B\x86\xc9 \xf3\xbdHԒ\x91\"S삒\xa9\xbaF+zu\x14^*\xaeZ\xf6-\xa4\xe4\xb9\xd8/P\xa9\xa9*\x0e\v\x18=E\x85\x17\x91_\r\xd7\x15)\xa9\x85\x17\x90\x92\xa5!\xa8\\\x12}/C6\xddk/\x05\xa5\xd6\xf4\xd8Vs~\xcb\f\x8d\xf1\xd3\xdc\x19\xa1\xe8\t\xac\xfdrb-\x18F!\xb6^\x97\x8c~\r*?\xc7fHr%m\xbd\x88\b\xc7$\xbdcmQo\f\x9b\xab\xdf=)\xd8.\x82M{2\x85\xf2\xb22n\xd2\xd3\x11\x91B\xedcԴ\x95\x93\xb8C\x8c3ɦ\x1dcؗ\xde:Q\xdfH\xe0i^Sd\xbe\x17\x99\n*P\x8b\xa5\xac{\x17%-\v\x05}\x92DxQ\xba\x8a\n\xf4\xda\\{\x91\xea\x1a\xedt\xfcu1آqB'\xb8\xebMB-mQ\xea@qA\xee,;\xb4bX\x97\r\xb2K\x8f\xfe\u038b\x16\xab\x86!%\x11^\xb8\\B\xbcw^\xb4\xa0\x12\x8bќZ\x96\xd9_{\x96\xea\xc4\x0e͛f\x80\xac\"\x9f#\va\x1d\xb9\x8f\xb6\tr\xd1\xef\x0eh4A\x97\x95\x8c_ޘ!\x97\xd5\u038b\\ͬ[\a\x06E\xb5\x82a\xf3\x86C\xe6{\t\xebXoIU\xb7\xe3\xd8lM\xea\xd3]\x85J\xc4$\xdb\x00Q\x87Vp\xcfO\xd1\b\xad\xe1\xbc\xf0\xc0\x17\xe0\xa4l<\x17aڮF\xa7u\xc5\xf3v\x89Kj\x81\xe7\x1d\x8ebo\xfd\xb6M¼\xb8\x8c\xd6\xdb\x1an\x8f\xb2\xdd\xc2*\xee\x10\x8b\xdf\n\x0e\xf7\xb4\x1e\xecp\xceY\x83\x9eP\xa3\xd4z\r\xe6\xc5f8\xb6چ\tC\x8a\xf4s\xf9\xb1\x1f\xf4\xf2x\xe6\x1f{\x92@\xe2\xde\xc7\xc8=\xfe\x19bs,5\xb1\xfb\xa7\x18\x95\x9c\xd6}\x1e\xc3h{a_Ę\x94\xe9\xb1ߢ\xd6\x13\xf2Nt\x1f\x82\xaa!\vzɂ\xf7\x8e)R\xda\xf7\xa8\x83t\xdb\x19\xa0\x8f\x8e\xfb\xfd,\x9fd\xfe!\x12L\x12\x80ۜu`\x84%-\x8e\xfd\t\x9b\xca%\x9b\x1c\xa0+\x12\xb4\xf7\x12t\x16\x89)\xf2\xb2#\x81\x916ߞ\xe3y!1p^N\xe0FgC\xc8άd\xb4\x1d|\xf0b\xf2ƌ\x12\xea\x1cOX\xdcIg\x04Sg\xba\x9b\x87<\xe8WE\x03P\x171\t\xaa\xa2\xcf\xd3\a/=\x86\xa9J\x93\xe7\xe1\xc3.\bA\x9b\xb0\x04\x0e\xa8|\xd8Y\x01\x8d\xd8/\xd5\x1d-\xf0\x87\xc3\x12\x95\x12\xf9\xc3\x11\xcf\x19\xee\r\x12\x1f<\xe1F\xab\xbbN\xa8s\xc6\x13\x0e\x87\x03\xc3MDV\xb4\xc1p(c\xc4\x18\xe9$a<ކ\xb3+\x89\xa9\x16\x18Z2 \x9c\x012\xde0㲅\xd1\x14\x92\xa9\xb2G/U\xeeM8\aƛ\r$2Ǎ\x91\xc3`\xeb\xafA\x8bؚ\x1f\x06ݐ\xea\xf3\xe8E\xe9\x02\xe4v\u007f\xdc\xcd\xe7~{w\xf0'\x15\xc3\xf1y!x4\x06\xb7\xb3\xa6oK\x9b<\x1c\x1f\x0fw\xb0\x94d\x1d\x8f\x91{\xa9a\x92\xd4\x0f\xc7S\xe4Ģ\xb7\x92#\x9b\xdd \x83\x95MbP\r\tu\x81J\xa8\xb5)\xe0Q\xfb3\xc7\xe8s\xf1\x11\xf5һ\x03\xa3\x98\x1ck&\x81\x10\xb5N\x90\x03qBI2\xbak\xc8\xf9<\xe5\xa8e]ӗ+\x8d?y\xf9黔_\xe4\xb4E\x85\xdcjE\x0f\xe8i\x17\x0e\x11\xb4\xb2;\xa1cp\xc5R>헇\xdb\x04\xea\x10}j\xad_\xc7\xc5b#!\xa7\xbbUVǂ\x8f\xa9dI\x16\x8f.\x89\xf0\xe2ӫ\x94\x9f䄖\x8f\x19N*\x83\x06\xa4G\x1f\xcdh\x80\x85\xe1`\xe8\r\xff䅧\xa9*z\xfc\x98\x17\x9d\xb2\x97\xb2ц\x14/\x86\xe7{\x90\xe4Q\xaeF\xf3\x18\x8c\x13\xb5\xe0̑S\xc0Н\xccT\x95鴵\xc5v\v\\\xcavc\xcf\v\x18\xadK\xd8~\x01Ji\x12v \x9cw\xc9\x0f\x1f\tp\xba7\x94\x1b1\xd1Xʅ\x98l\xb2\x97\xa8\xb3\xd4%=\xdc^\xa0\xde\f(:\n\xc0*t?\xd9\v)=\f\x82/6\xb1fJ\x14\x1f#\xa0\xe6\x8c>\x1a\x96\xf9b#M8\x12Kt5\x1b\x1d\x86\xe7\x01c\x03d\xf3\xf8\x13Z ,\xe8\xc5\aT\xd2d\x81<x\x8e{ru\xf1\"8+iۖ\xa3\xa8\xf7\vg\x82\xe5\xc2Z\x9dhe0ɺ\xdbB\xa1\b\t\x96^\x94\xfc\x19\x0f\xdb\x1d\x18.\x05)-܋\xb6eːC-u\xd7\xdd\x12\x15\x1fb\xbdK\"\xbcp+v\x15\\\xab\xa5+y\xa2\x9d,\xed\x1b\xbaϑ\x93+\xebI\xa5\xc1Y\x04\xd14\xa4\xc4\xfd݈\v\xb8\xc6\xe8\xfeL\n2\xe7\xa8]+0R\xd0{\x0e\x0f\xd2^\xd2&:\x87`\x18\xd3^\xca*l\xb3=\xad\xc3+4\xf2\x85r`\x80vXU\xc5\x1dhe6*/ng\xad\xcf\x12&\xb7\xc2\nz\xf7\x01M\xc2\xf0\x00\xa0\x15m\xfcUh\xff3\x03.\xa9\x80\xaaC\x8c\xa25Zu\x8c1)\x85Z\x9dbTR\x93V\xe8kвo\x15ݵ\xb0\xcdZm\xdcr\x87\x1cR\xc82\xe8\xb8\xd2f\xd1ه9\x11,-\x0fUTr\xa9\xb3\xc6$\x96(\x06\xcb\xe0+\x85\x81\xa7\xa5\xb9Ib\xf2E\xe8'\x1a\xdd\a\xbf\x18H\x1d\x00\x18\xb0\x81s\x88\xf9\x8d\x05\xce\xec\n\xe4:\x04/\x85\x15\xb3M\xa9\xef<\x16\x8e\xf1F\xb7\x90\xd8\x1f`y\x1e\xa5\xb5\x12ܝ\x1d\x12\xa8\xfd\xac\x9b\x9b\x85\x12\xd2\xf4\x86\x02\xa8섃\x96\x91\xf2\t^>\xfb\xb64 %\xe9\x11\x87\x13*c\xeb\xeeb\xbb\xa2\x8b\x87cY*\xa8\xb7s\xe3\xca^\x96\x89N\x95\xc1W\xd20Eǯ\x81G\xbb\xd6j\xc8\x12\xaa\x18\x996\xe1\x01\xe6\xa8{֒\x90z\xf6\xb1\xbaF[\x9e\x90\xd3\x1a#Ƚp\xa9\xed\xbf.f\xf31a\x84\xe2\xa1U\xd75\xd0u\xec¾+\xc1\x90Z\xab\x8e\x03\xc7c\x84\x88\xfcV\x8dǊ^ȑBJ\xa3\x0e\x18\xa5\xb6\x9dp,\xd1\xee\x1a-\xc1\xb6\xec%S\x9cn\xba\x97\x9f\x16\xaa\x8b \x05\xb5F\xaf\xc6 +`\xb2/\xb4\x97\xa4.q\x1c\xe9\xb5V{9iV\xb6\x88\xba\x8a\x1c\xd4+0\xf4\x9e\xaa\xf3h\x82\xf6e\xda\x1eΑ\x8f\xb2\x00\xa7\xb4x\x8e\xe4\x94\x05\x9a\xdc=rd\xa9,\xa0\x89\xed(G\xb2\xca\x04N\xb6u\x17\xa3ҍ|\x8ea\x89\xd6헭K4\xeb\x80\xe1<{\xa1##9rX$\xebR\x88Sd\xba\xd0\rf\xb8\xa7\x97\x83\xf8\xc7\a\xef\x879\x9d,\x89䖴\xaf=G~˯\xbdv+c[Ũ\xf4آ?\xa3\x13J\x91\x92\x9c#y%\xe95Α\xb92\xfa\xd1\rt\xf2vGz\x907\xb2X\xb1p\x83\xd0'\x91\x1cY,\xe8C$1\xbb\x0f~\xc6\x04\x10]\x1dm\x8aВ#\x1b\xa56\xfa-UKP\"M\x02\x80.\b\xd6BGj\xfd\x1cI'\x17\xb8\x8d\xdbV\xa2\">\xf35\x12\x88*B\x8c\x81\x1a0@\u007f\x110^oZA3\x8c\xf2\x02皦P\xe4Ň\x99Θ\x94\x1ff;J\\\x16/\xee|\x84\x19\xb4\x9d[H˜U\xf7˚\xe7\x1cr \x90\xda\"5\x9f\x02\x00\xe3\x01\x97D\xeeB\x98\x8c\\\xe0\xc5\xf3<\bY\xadI&G\x8e\xec\x96^E\xe7ۇp\x00\xceJ\xa3/\xa4\xb7;G\xc2\v\xc6\xfdH\xccq\x0e\xf8\x91\xf9\x1f\xa3:$\f}\xa9}\a\xc6r#:r\x89!\xf3\xc5\xf6\xe5\nȋ\"\x18F\xd3\x03s$\xc0t\xfd\u05ef\x83\xda\x13\xc0i!£\x9d\x18f \xe1\x95̑\xe72\xa3\x92!\xa1\x1c9/\xb6\x11@\xb2^\xf2\xed]@\x87\x96!d\xbe\xd4\xc2@\x06\xefN\xa8s/lCw\x17\xf9/F\xf3\v\xbd\x0flCp\xe7\x9dW\xe4\x04m\xef\x82;\xebή|{ \xe1I\xed\x8e<\x98;<\xbd\x85\"\x17\xe6\x0e\x9c\xdaK\x91\x1aӸV>\x93\x00\xdcˬݒ\xf9\x18\xf5Q\xbc\xd1\xf4\bW\xb1\x9f6\xb5A \xbb\xa5쥴t`1G~\vH):+\xe8\xf8Q\x8e\xfc\x96\x80\xba\x92(/JƮ\x18m92]\"\xce \tC\x12\x95H\t\xd1nAOX\xf9\xe0\xfe#\x8e\x96r\xe4\xb4H\xb8\x82L\b\x03\xd2Y&LJ\x06v\xa7\x8fL\x1b\x12\xc7b\x02\xd2\x1a\xb0$\xe20kx\xfe\x91\x14D\xe2\xaa9\x1cO\x13\xb9s\xe4\xbb8}\xbe3!\x1ffw\b\x0ekrD\x90\r\xe3kY\xfa!\xee\xea\xa0G\x1ey2\xbe\x86\x0f\x1cһJ\x92k\x1f\xe94ЛE\x14\x14zr\xad!\xab\xe6\\ҭ\xda!GW\xca%\xf9\xb8\xb7\xa4\xc2EV\x8d\xe9;X\x18\xbfB\xd1\xdfGM\xa8\x96\xc6c[\xc6\xff\xbc\xc1bR\xbet\xa4a\x89\f\x1c\xd3\xcf\xceũ6\xfd!\x85\x8c\x10\xe5\xc8\xcdy[:]/\x864(\x90\x9eS\n\xc7\xf5\xd2\xf9]:N\x96`\xf3\xf1\x98\xcc/\xef\x8e\xcf$(\xf6\x951\xd95,\xe1\xe4ʟ\xab\x0fȄ\x1b-G\xaa\xce\x04mu\xafR\xae\xb3\x1c\xf9:14U-\x92vF\xac\xea[0\x82'\xeb-\bl\xb2\xe2\xed\">M\xaf)\xe4\xebxPj\xf1\"i\xe7\xa6{חk\xfa\x04\xa9;\x1eIB\xbc\x10\xbe\xcf\x1c\x95e\xfeq\xce_\xfb\xd2i٦\x04%0G\xe6NetG\xd3\xd8\xf3}\xf0\xe03~\xc9\xf4\x15L-i\v\x19i<BY\xc7Ά\xb5$(\x9c<\x04\xbf\x90k\x1a\xe9<\x8c6~\x90\xc8S\nW\xf6\xa9\xed\x0f\xa9:\x01\xb42V\xc8\xdaq}[J\xb2E\xc8ٙ\x10kU\xed\xf04\xa1\xce\xeb,\xb2\x1c)<\x11\x94\x96Bd\xf2D\xc0\x94\xa1\x87t\x9e\b\x9aT\xf4H\xe9a]G+\x13$\xf4\xbc\tU\xd14\xd7\x1c\xa9<LUF\vR\xa1\x1ff\xceEO\x8a\x17\xb2x*#ʲL4\x05\x15\xd1\xe5\xd6\xd1\x00\fr\xeaޤg'\x90u\fHI\xda8\x81\xa4\x03-\xa3\x9b\x82ܜd\xfe6x'\x9cXl\xe3gÜ\xe8\xe8e\x88T\x1d\xdb'|\a\xc7\x10\xef\xd1)D %\xf3F\\\xe9\xb6\x1d\xd0\xe4%U\vRq\xae\xe4\xc9\x1598o J\xba\x01\f9b\xca\xd0G\xd8c\xe0\x1f\x9fA\n\xfa\x96H~䱺Y\xf1\xa9\xe7Ǌ\xbc\xad\x93\x94\xf8#\x90\xf8\xd4b:-m\xb2\xfb\xd0̝A\x95\xac%G\vȭ\xba琳\xf3\xd6\x00H\xde0A*\"\xe4\xed\\E\vzE\x0f!y\xc7\xf5\xe6\"l\x93I\x11n\nL\x01\x1f\xd2\xfd\x9e#\xa5'\xf2,'Z\x8bq\xeb\x8eq\xc8l\xd3;G/\a\xa4\xf6XIS\xa8r\xe4\xf4\x84[\x8c+\x9dB\tԦJ\xb1cs\xa4\xf1\xe8\x0e\x14\xad\x91N\xe5\xbcK\t\xd7GN\x10\x1f\x0e_\xfaez%\xae`\xacp\xf4\x88q\\\x97\xc61\x93}\x88\xac\x9d\r\xabzO\x9f%\xc3W9\x92\x81n\xac\xd1\xf4XC\x1c\x11\xa6\x10\xc8\xff1PUdt\"G\xf6τX\x19c\x16\\m\xc3V\a}\xb7\xc6\xfb͑\r\x14\xa1I\x18\x9e\x02@\n.4\x19\xd1Α\nT\x893\xa9\x9d\x90\x05\xd4\t\xa8\xb2Nt`\xb2\x8e\x1cP\xe4\x01E\xc0\x84\v\x00I@\x95\xe9;ڱ\x89ğ/Z\xb7dd1G\xbe\x8fd\xea\xdc3ZQ!ͧf\xe4\x0e\x88$\x1f\x8cf\x91\x18<\x896\x82\xf6T!ͧc\xa4i\x86\x1c\x1f\xdbiz\x8e\x90\xdf\xc3\x13v)2{\x86|r\xfe\xca-F\xb6\x9aD\x14-/w\vȊ\x18\x96\xe1\xd21\xd0Vd\xb9\x8f\x00k\x15\xe1\x99\x0e\xf8-!\xc4%\x1aB\xbdӋ\x10\v\xaay\xb2P\xa0;\x94\xcb@\xfa\xbb \xe1,X\x1et\x1b\xca0=\x89;gy\x89v\x12\\\x05S\x8e\xe6#\xe7e8\xb2\xf5\xaaJQ\xa4\xf2\x12\x90\f\xe0XIG|s$f\x8dgɮJ\xb8ϑ\x8d5\xa2\x06Ŝ\x80\x15\x11\f\xde9\xc8\x04n\x1b\u007fT\xbf\x81\xe9\xb4HP\x0er$e\xd5>Z\xe6\xf4\x92\xb8=\xa5\xdf\xddp\x9bs\xc6\xcbp\x89\x9a\xe30\xf4W\xd1\x11\xa5\xbd\x99\x95(\xbf\x8fʏSA\xd5\xd0WB'\xca\x1f\xa2\xf2\xad\xbe\n\xaa\xfd\xd3-@\xba\xfc1*?\xc6#h\xd8\t\xad\tڗ\xce\xd9\x1cѠ9\xf89һ\xbe\xd8z|\x98\x81\xc4p<\x02Ԑ\x95\xba\xbf-i~Cb\xaf?&Zv]\x12\xd5l\xdfu3\xffg\x06\x9a\x84\xceD\xb2X\xb0\xf4\x94v$\x892G\xd6\xd8\xd2H2`\x85u\x912\xf3\xa9%ɰɑY\xb6\xbca\tmG_jɫ\x99ν\xa2\xc0\xaa9\xdaIf\xfb\x05s˸nK\xa1\x98\xd3w\xe7\xd3\xc9\x01ƉĆ\xf1\v\x98L\x01}\xb8\xab\xc2E]\xc5azJ\x8d6\xfc\x91\x8b\xf6\xeb\xafd\xee\x1e\xcf\r\xbca\x8b\xc9{\x03\xf1N\xb2\xa2r\xa4\xa5YP\vkj\xe4R&\xaf\xdf\xe4\xc8T\x1b\x8a-\x17LT\x90^\nH_k\x84u\x9a6\x8e\x03um\x12'\xd7$\xda^\xcew\xe1\xe9\b\x10\x92\xd5:f\xd8ٰ\x8e\x14\xc9\xc0K\x93\xa2\xa2\xa9\xb39r\xd0&/v\xc2\xc6A\x12Z\x00\xad\xc8\x1a\x84;\xe0-\xe9ބ\xe0a\xe3\x1cLV\xb2\x88\v4\xe9\xa5ޕ:\xa1ڑ\x80\xe6hC\x1aIf\xa5P\x9a\xf7\x92\xe6\xa4\xe60\x1fP\xc8%\x8f\xfc\xb2q\xd4R\xf7\xf0r$\x95\xb97\x91P\b\xc8)\xbb\x81$mL\xe4\x93\rkg\x92-\xba\xcf\f](5i\x16 s\x8c39\xf4\xd9\xd1\xf1,\b\xf2rK\x98\xa9\xc8\x19\xf3\xa4\xd17&\x13\x14\x13$\x8dq\x9e]\x85%\rZ$\x8dq\x9e\xb5\xe3\r\xc1\xc4-\x86\x1cic\x9cg\x95\xb0\\_iiG\xe6\x18\xe7\x19k\x814\x80\xc3\xdd\x03\xbe\xd2\xc7\xc0\x1e\xe3#\x97\x98\xf6\x03!\u007fl\xa4\xa9%㾁>\x16P\xf4\xf4!\x81l|\t\x85\x04\x1cgZx\xd2\xed\x80\xdc1F\xe7\xb2pw\xb12\xba\xeb\x12\x83\x18<'\x835T\x9a>\xd1\x1e<I\b㚊\xdd2\xce.\xf48\x05\xd7\t\xb0\xe4\xe5\xb6\x1c\x19d\x9d\x80$\xa8x\x9aY\xaek(|\x85\x84YW\xb7$\xa2\x88\x11i-U I\xcc\xfbe\xe8gD\n$\x89!J\x91 \x14\x17\x91:\x1d\x14O\x81jh\xc9l/\"B\xbf7\xda\xd2]?\x06\x8e\x19\xc8ċ\x11\x05\xb2\xc38's\x91\x90\xd1\xc0\x05\x96\xec\x96\x06~]\xa6\bI7\xb4D\xf2\x11S\x1d#\x1f\xdd(\x9e\xc2A\xf4fAJV\xd15\x85\xa0\xa8R\xc0]\x05\xa3\xe3\x86D\xc2\xcc9N\x9c+\x8b<\xbcKb\xfa\xb6\x04\x1a\x93\x87\xebê##o\x05\x92\u0086v\xdb\x1b\x89\xd8\x06\xb2\x87qV\xa8\xb2\x97\x17\x12\x87\xaeX\xd1v\xf26\x9c\xc9\xc9\t\v\x94\xb0ˍ9\xc9ȑ\xcag\x0e\xbeK1\xf9\x8b\xfc\x10\x81VC\x06Ex\xf1H\xb0V\x93\x17ȋ<\xdc\xc2%/\x9c\x14H1\x1bY\xc3\x16\xb8\x01\xbaw(-\xdai\x93\\\x1byp\xd5\x1a\x00\x97]\x05\xbc\x91\xb0*\xba\xbdY\xd2/\x19\x15H3\xbb\x02}k\xbd\x989d\x86\xceG\xc7<\x18ޓ\x16\\\x81\\\xaf\xf1҆\r{\x91\xf7\x882e\xcfヽd\xd1]\xd8\n\x10\x96\xb0\xb8\x8a\xf0\xcc\xd1\xd0\x13\xcfx%q\xfb\xb9Ck\xb0C\\]\xb2\xf7Ǩ2댾Е\x9d>\xc0H\xb6J\x81\x14\xb0\x18H\xea\f$\x81)\xe8]b\xe88\x9e\x8bƛ=\xb4߶@\x16X\xb8\xb8\xa9\xebZpA\xda\x02\x05\x92\xbc\xe6\xeb7\xa4\xb0#\xbf\xeb\xadaβ\x8e\xc6\x04\xc5ah\x03\xa6@v\u05f8dR\xab\x18Y]#(\xf5>\\\x81̮F\xbb\xa5\xd2.I\xeee\x81<\xaf\xab`\x11C`\t\x99m\x94\x04\xe0\x18Xzod\xf0\xa9\xd8\xce\xe4vѓ{3\x92\xb6n|q\xa8\x8cΜd\xa9\x12]\xf1N\xa8\xac\xd5\xca&$\x04)]\xba\x03\x95x5\xa0؆\xab\xd6\x1dT\xc2Ae--\x1a\xe12\xbfs`n\xd9n\xe9۟\x12#\x8e\xe8\xa20\xf2\xbd\x10\xb7\xa5\n\xbb\xc6\x00d\x83y\xe2\xe8\x83Y\x81\x840,QP\xd5$\xee\xba\x17\xc8\x13C\\N\x15\xf6_'\xcbo\x97埨\xf21\u007fvYz\x87\x8a\xbf\xb7\x90\x8dη\xc4g\xbc(\x8b\x8c\xf7\xc6\xd2\x02\x80\xdc2]~\x01\xee\x16\x97B\x97\xb8\xc3\x02\u05eb42\xbc\x16 \xf8\xe5\x96)M\xdeR.\x90`\x16\xc1H\v\xbf@\x86\x19\xe7\xd9\x17N\x1a\x16H-\x1bO9\n\x8c\u0378\xa4M\x90\xdd|\xc5Gѭ\n\xaf\xdd\xc8\xc9\xfd\xcd\xe9\x18r\x81\x9c\xb2F\xf7f|A\x94n<\x92\xc6f\xd8BV\xe6d\x9b\xf0\x16\x17H\x1a\x9b\xa1\x05]CJX\x91-6#\xb7t\x05@\x9b+H\x1f\v@\x12\x14\xdf%?\x1bV.\x9d\x0ec\xb2I=#Y<\xc7W̭\xd3\x1dQ:}\x84/\x9e\xe3\xcb\xe7xE8\x01=DP)\xbe\xa6\xe8\xf9\x05\xd2Ħ:\xbbt\xd3O\x11ί\xc5\x04\x92\xc5H`<!\xef\xe1\x11'\xc3*H\xbc6X<\x87\x97P\xcfbP\xd9\xf4ބ\xe40n`|\xe2w\xbc\xf9\xa7\x15=\x83\x18¤\x9c6\x05\x12\xc2\xce\xe7t\xa8\xb1@&\xd8p\xf4g\xd5U$T\x0e\x92\xc0t\xa5\xf4e\x10(%.\x82\x04n\t\xe0ʩs\x1f\x1eSqY\x97\xa2n\x17H\x05{\x13\x171\xecS,#-\xe2}\xb8\xf6]3C7/P\xb6\r\xfd\xc6j\xb1?Τ\xf3\x9adn\x15H\x02\xd3\x1d\xfdP`\x81\xec\xafQ\xb2\x14\xb8aw\x95\xda\xd0\x1a\x1fy`\ueeb0\x9bA\xc2UX\xfa&A\x81\x8c\xb0!\x8d\x91\x12\x89t\xb0秧\x8e\xee\x04\xb26Z\xf6\x95\xfe\b\x12\xc2\x02I\u007f\xa4/\x90_CZX\x80N\xb4g\x1a{\xcf\xfd\x9f^>\xa3\xb1\xdb;\xecDm\xa6\xb1\xbb\xf0\xcecoi\"F1\xbf\xef\xd4\rF.\t\xd9\xcf\x10+\xce\xf4\xc2CB\xd8\x00\xa2\x1b\x13\xce\r\xe4~\x8b\x1c0\u007f\xab\x97\x0e\xb4\x14\av\x8fJ|\xac\f\xda\xfe\xebW\x12\xc0#z\v\t\xc0-T2~ɜ W\xc6L\x06SN\xf0\x84J:.8\x13\x19\x93\x82\fp\x15\xc8\b\x83\x8a\x8c\xed\x17᭦\xf9ɗ\xd4\xc1\x10\xa9a\\W\x02:\xba\xed\xc7`\u007fU\xe4j@\x1eX=\x92g\xa7\x9f\x9f!q\xe1Mn\xd2VA&Xgt\xd5s\x975\xbd\xa2\x9b\x8c\xe2!\xdeS\xc1\xe4\x02\x89a\x96\x1bQ\xd2\b\x16\xbd;\xb9\xa2\xe3\x8f\xe5G\x1c-I\xc7\xe8\xa1ʵ\n\xab\x0f\xb0D}p\xf7xYI>\xf6[\x9c\x9e>\xe0lb+@\xe2W\xc3l\xe3\x12\xb5\xa1\xa9/{pZ\xd3N9\xa4|\x05PF\xcehx\xae\t\f\xa7\x1f\x8d+\x90\xddu\x16N2\xba\x12\x8c\xd5u%\x9cS7;\v\xe4t\xbdu\xb56-\xb9\xba\"V\x97\xa0\xb7\x1e$syz\x15\x93\x19\xe3<\xe1\x1b@N\xd7̍K-1\xe4v\xdd=\x8eIO:r\xb7J)h\xeb\x14\xa9YS\xa4\xbe\x82\xe9\x96[b\xabC\x9a\x96\u007f\x90z\xc82Z&\x9f\xcf*£M\x86\tI\x8b/\xb2\xb6\x98\xb5\u008e6\x96\x1c\f25R\xbfo\xd6\x01=\xf8\xc8\xe1bVN;{g\xc0\xdd=y\xc8Fz<SӋdH^\xa2\xe0˪\xf1\x15\t`\xb5\x9ag\xcb[҃\xbd[\x8fn\xbe\xbb\xafU\xf4\xbb\x8f\x05\x12\xc3\xceRT\xf4\b<G\x80\x8cn\xd2~~Z\xed\ueccb\xbe\x91e\x03_\xfa-K\x1b1H\x13\xbb\nV\xd1\xdb\x12;ň\x15+\x12\xd9bV\xb1.\n\x87/1\xe5\x12\x93\x9dS\x91\x05$\x8e\x05\xe4ʇ\xab{R\x1c\x89\noE\x19\xeb\xb2\xf8\xa5\xa0\x05\f\xc9d7\xcd\xe8\x86!\x99\xcc5Ђ\xb0\xb4t\x87\xb7\xa2\xa2wq\x16\xaa\xfc\xe1>w\xcd%\x88\xf4\xb3zA\xe1\x18\u007f\xa8ce\x9b\x1c\x19i\xd6dZ\xc9\x1b\xf1\xcb!\xfe'C\xbaw\xfc\x81\x92\xe1O\xfcE/\xfcŲ\x97lH\r\xf7\"\x1aQU\xa0^\xc7\x17\x9f\fp\xf7\xe9\xe9a\xe3\xff\xfb\x19\u007f\xf4\xeb)|4\xab5\xef\xed\xf8\x9a\x11\xe3\xc3\x12\u007f rƿ\xe6\xe6Yǜ\xe0\xbeq\xe3/\xcb\xf9֍\u007f\xfbF=\xcd-\x1a$\xbb\x9405i\xc0|\xff\xc3\u007f\x04\x00\x00\xff\xff\xa0\xd0\xc6\xe4\x87q\x00\x00",
@@ -45,11 +45,11 @@ var staticFiles = map[string]*staticFilesFile{
 		size:  77364,
 	},
 	"css/searches.css": {
-		data:  "#search-table {\n\tword-wrap: break-word;\n}",
-		hash:  "2dda63910ab5f40799b54baf89852a5f040b6b5a9581035b353f84fdfd5ae99a",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\x94\x92\xc1N\xeb@\fE\xd7\xcdWX}\xbb\xaa\xd3v\xf3$\x94\x88\x1d]\xf0\r\x88\x85\x931\xcdЉ\x1d\xcd\x18%\x05\xf5\xdfф\x06\x95\xd0\"\xd8:'\xf6\xb9W\xf3/\x12\x86\xaa6\x8a\xa5'x\xcbf\x9d\x04k\xba\x80m\x0ee ܛ4(\xb2c\xb6^\xc0\x965\x1c`\xdb\xeb\xfd]\x04d\v\x95\xb0\x12\xeb\x12b-\x1d\x83c\x10& \xae\xc4:\xde\x01* \xa8k\b\x16\xeblE\xe9ocQ1\xddi0\xec\x1c\x9bRT\xa5\xc9a\xb3\xfaOM:s\x8e\xb5aP\xb2.\xb6\x1e\x0f9\xb00\x15٬\xab\x9d\x92\x89-V\x94'f\xd0-N\xea\x83\xf4\xe8\x8e\xde\x17\xe3\xa9\x1c6\x93\xfd\x0f\xa3\xe8\xed\\\xa9\xd7\xf9#\x9c}4i\xb4\xbc\x82\xd7\xd4O\xe8\x9a\xfak\xf0s\x14\x9e\xd0i\xf4%Y\xe9\xa5\xdaO\xf4̸\"\xc2\xd0ؓ\xb0\x9a\xe8^)\xb5uC\xcdg2\x13ܮ\xd6\xcb\x1d\xfe\x9c\xf1\xec\xc27\xf0\xf7\xd9/o\x19\xb8?try\xcb\t\x1c\xe3w\xf4\x11\xb5\x14o\x8bl\x96L\x8d\xa5J\x02\xaa\x13\xce\xe1\x85-\x05\xef\xd239f\xef\x03\x00h\xe2\xa7\x1b\xdc\x02\x00\x00",
+		hash:  "0c26a0bc9fa40977448323c693b7f3dae323de92e3295e81010b57251e2035b2",
 		mime:  "text/css; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  0,
+		mtime: time.Unix(1792390030, 0),
+		size:  732,
 	},
 	"fonts/FontAwesome.otf": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\x9c\xbby|SE\x170<\xb7I\xeeMg\xb2tI\x906\xb9\t \xa0,J\xd3,m\x01\x95UPV1\"\xfb%m\xd3&4MBr\xbb\xd2ҽ@S\nmSJ)\xa5e\x11\x91}\x13\x15Q\xfb\xb8\"\xb8S\xd7\xc7\xdd\xc7}{Tt\x92N\x8a\xdf\xef&\xe0\xa3\xef\xfb\xfd\xf1\xfd>B\xee93w\xe6\x9c3g\xcev禋\xac\xd6E\x00\x82j \x02#f\xde}\xf7\x88Ͽ\xdc{\x06\x00p\x14Po\xdf:{\xf1\xf4%@\x03\xc6\x03\xea\xed\x01\x00@ڢ\xfb'\xa5oN/_\x0e\xa8w\xc4\x00\x80\xb59\x856\xef\xf4\xcd5\x1f\x01\xea\x9d\\\x00Db\x87ݖK?wh\x00P\xef;\x00\x00\x16\x87\xc3nS\x8a`\x00P\xef\xf7\x01\x00F;\n\xf9\xd2\xccG\x86\xfd\x02\xa8\xf7\x9f\a\x00\xdeYh+\xf5\xc6\x05\x17\x03@\xfdG\xa0G\xbbm\x85\xf6;\x93ǎ\x02\xd4\u007fd\x00\x88\xe5^\x8f\x9f\a\"\x00\x00\xf5\x8d\x16\x000\x82\x02b\x11\xa0(Jv\xb7\xc7\xcdO/\xb1\xfb=\x85v\xa1=3\x94\x00\x06\xf7Q\x83\xfb\xe3\x06\xf7\x8bB)\xe2\x80L\x14\x90\x89\x9bH\x876\x9e\xd2ҔD\xd7\a\x1e\xa8\xb6\xfc\x19\b\xfc\x85\xc8\x18m\x9aG\xa9M\xebL<\xce\x02\xea\xc23Iq[\xe2\x00\x05h\x80@\"H\x01:0\nL\x04i\xc0\x04\xa6\x82;\xc1l0\a,\x00V\xb0\np\xc0\x0e\x1c\xa0\x10\xac\ae\xa0\x12Ԁ\xad\xa0\x03t\x83>p\x04\x9c\x02\x8f\x83~\xf0<\xb8\b\xde\x02\x1f\x82\xaf\xc1\u007f\xc1\x1f`\x88\x02\x94\x98\x92R\t\x94\x9a\xd2Pz\xeafj\x1ce\xa2\xee\xa4\xe6RV\x8a\xa3\\\x94\x97\xda@m\xa2:\xa8\xdd\xd4>\xea(u\x9a:G\x9d\xa7\x9e\xa1\x9e\xa7.RoS\x9fS\xdfQ?Q\xbfQ\x98\xba\x16\a\xe3\x86\xc5i\xe2n\x893\xc5\xdd\x117'nI\xdc\xf2\xb8\xbc8O\\Y\\cܶ\xb8\xae\xb8\xfdq\xc7\xe3\xce\xc5\xf5ǽ\x1c7\x10\xf7i\xdc\u007f\xe3\xaeƅ\xe2\"\"\xb1H.J\x11\xe9DcE\x13EFQ\x96h\xa6h\x91h\xa9(G\xe4\x11\x95\x8b\xb6\x88v\x89\xf6\x8bN\x88.\x88.\x8a.\x8b^\x17\xbd+\xfa\x8f\xe87QD̈\x15b\x8dx\x94x\x92\xd8,\x9e\"\xbe[\xbcX\xfc\x80x\x85\xd8.\xf6\x8b+\xc5\xf5\xe2fq\x9bx\xa7x\xbf\xf8\xa0\xf8\x11\xf1)\xf1\xa3\xe2~\xf1%\xf1\x15\xf1\a\xe2/\xc4W%\xb4D-\x19+\x99 1H,\x92\xa9\x92ْE\x92\a%\x9c\xc4!)\x94\xac\x97\x14K*$U\x92:\xc9VI\xab\xa4[\xb2Or@rPrFrA\xf2/ɋ\x92˒\x01\xc9\xe7\x92_%Ct\x1c-\xa3U\xb4\x8e\x1eG\xa7\xd1&\xfa\x0ez\x16\xbd\x98^F\xaf\xa2m\xf4:\xba\x88\xae\xa1\x1b\xe8f:Hw\xd1\xfb\xe9#\xf4\x13\xf4\v\xf4+\xf4\xbb\xf4'\xf4\xd7\xf4\x8f\xf4/\xf45\x86f\x14\xccM\f\xcb\xdc\xcaLd\xd2\x19\v3\x87Y\xce8\x982\xa6\x8eiav0}\xcc\xc3\xcc1\xe6\f\xf3\fs\x99y\x9b\xf9\x94\xf9\x9a\xf9\x91\xf9\x95\x89HeR\x8dt\x8c\xd4 \xb5H\xa7H\xef\x94Ζ.\x90> ͑\x16H=\xd2R\xe9&i\x87\xb4K\xbaOzHzT\xfa\x98\xf4Y\xe9e\xe9\xeb\xd2\u007fK\xbf\x94\xfe&\r\xc73\xf1\xc3\xe3u\xf1\xa3\xe3\xcd\xf1s\xe2\x97\xc6\xe7ǻ\xe2\xbd\xf1\xc5\xf1\xd5\xf1\x9b\xe3\xbb\xe3\x8fƟ\x8c\u007f\"\xfe\x99\xf8W\xe3ߋ\xff4\xfe\xbb\xf8\xab\xf1C\x90\x82\b&\xc1T8\x16\x8e\x87\xb7\xc14h\x84\x16\x98\x05\xa7»\xe0\fx7\\\x049\x98\x03\x8b`\x00\ue10f\xc0c\xf0\x14|\n>\x0f/\xc27\xe1G\xf0?\xf0\x178\x88\x00\x12!\x06\xa9\x91\x06\x8dEf4\x03݇l(\x0f\x15\"\x1e\x95\xa1jԀ\xb6\xa1\x0eԍz\xd1C\xe8\x10:\x8aΠs\xe8q\xf4\x14z\x0e\xbd\x84\xdeF\uf8dfЯ2 \x13\xcb\x12d*\xd9H\xd9\x18\xd98\xd9m2\x83\xcc,˒\xdd!\x9b.\x9b+\x9b/[,\xb3ʖ\xc9V\xc9\xd6\xcare\x0e\x99K\xe6\x95\xf1\xb2RY\x85\xacZ\xb6I\x16\x90m\x93\xb5\xcb:eݲ^\xd9~\xd9ò#\xb2\x13\xb23\xb2\xc7dOʞ\x91\xbd({U\xf6\xa6\xecm\xd9\xfb\xb2\x8fd\x9fɾ\x94}+\xfbQ\xf6\x8b\xecwYX6$\xa7\xe4R\xb9R>L\x9e*\xd7\xc9\xc7\xc8'ʍ\xf2\f\xf9\x14\xf9]\xf2\x99\xf29\xf2y\xf2E\xf2\xa5\xf2\x15\xf25\xf2ly\x9e|\x9d\xdc-\xf7ɋ\xe5\xe5\xf2\x8d\xf2Zy\xa3\xbcI\xde\"\xef\x90w\xc9{\xe4{\xe5\x0f\xc9\x1f\x91\x1f\x93\x9f\x92?*\u007fB\xfe\x94\xfc_\xf2\x17\xe4/\xcb_\x95\xbf)\u007f[\xfe\xbe\xfc#\xf9g\xf2/\xe5\xdf\xca\u007f\x94\xff\"\xff]\x1e\x96\x0f)(\x85D\x11\xaf\x90+\x12\x15jE\x8a\x82U\x8cT\x8cQ\x8cSܦ0(̊,\xc5\x1d\x8a\xe9\x8aي{\x15\v\x15K\x14K\x15+\x14k\x14ي<\xc5:\x85[\xe1S\x14+\xca\x15\x1b\x15\xb5\x8aFE\x93\xa2EѦءإأا8\xa88\xac8\xae8\xad8\xa78\xafxZ\xf1\xac\xe2E\xc5%\xc5k\x8a\xb7\x14\xef(>P|\xac\xf8\\\xf1\x95\xe2;\xc5O\x8a_\x15\u007f(\x06\x15הqJZ\t\x95\ne\x92r\x982U\xa9S\x8eR\x8eU\x8eWޮLWZ\x94\x93\x95w*g(\xefVޫ\\\xa8\\\xa2\\\xaa\\\xa1\\\xa3\xccV\xe6)\xd7)\xddJ\x9f\xb2XY\xaeܨ\xacU6*\x9b\x94-\xca6\xe5\x0e\xe5.\xe5\x1e\xe5>\xe5A\xe5a\xe5q\xe5\xa3\xca\v\xca~\xe5\xf3ʋ\xcaו\xef(?P~\xac\xfc\\\xf9\x95\xf2;\xe5O\xca_\x95\u007f(\a\x95\xd7\x12\xe2\x12\xe8\x04\x98\xa0HHJ\x18\x96\x90\x9a\xa0K\x18\x9506a|\xc2\xed\t\xe9\tY\t\xd3\x12\xe6$,Lx aUBNº\x84\xf5\t\xa5\tU\t\x8d\t[\x13\x82\t\xdd\t\xfb\x12\x1eI8\x99\xf0X\xc2\xd3\t/$\xbc\x92p%Ⴤ\xcf\x12\xbeI\xf89Ꮔ\xa1Dq\xa21\xf1\xce|\x97\xcd\xef/,\xf2;s\xfcv\x9b/\xc7aw\x17\xdb]\x1e\xaf\xdda\xb7\xf9x?o\xf3\t_\xce^\xe8\xe5ˊ\xfcv_\x9e\xd3U\xc8;8\x97͗o\xe7\x1d\x02\xe6\xf4\xf3\x9e\x02\x9f\xbd\xd0Sl/\xf7x\n9\xa7;\n<E\xbc'/\xcf\xef\xccw\xdb\\9\x9e|\xdeg\xf3;\x1c\x9eB{\x9e\xd3e\xe7l.\x9ew\x16\xda}\x1e[n\xae\xa7\xc4\xed\xf2\xd8r\x85\xbe\x1bx\x91W\xb8:\xddٞR\xaf\xcbV\xc6\xe58}9.\xbb\xcf\xee\xb5\xdbx\x9f=\xcfg\xf7;\x04\xb6\xc2\x14\x97'\xa7 \xcfe\xcb\x17\x12\x93\xd7\xe1q\xdb\xfd\xc5\x1eWQ\xa1\x9d\xf3\xe4\xe5]\xc7\x04\xaa\xd7\xd1\"\xefz_\x8e'מm\x8b\x02ޖ\xcf\xdb\xf2\xfd\xd9\x1eO\x81\xf0-\xb4\xf9\n\xbc>\xa7\x9bϱ\x15\xda}\xb6<\x8f\x9b\xcf\xf6\xb8r\x9d\xbc\xcd\xe5\xcc\xe1\xed\xa5<\xe7\xb0;\xf3\x1d|\x14-q\xe6\xf2\x0e\x9b˙\xef\xe6\\\xf6<>\x86\xe5\xd8ݼ\xdd\x17\xc3}\xc2\xd0\x18\xba\xae\xc8\xcf;\xf3\xca\x04\x99\x9d\xee\\\xbb\x9b\x8fN\xb9\x8eF\xc7\xe5\xd9r\xec\x82F\xb8bg\xae\xdd\xe3u\xe6\xf0E\xc2r\xdd9NW\xa1\xcd\xcb\t\x92\xd9}\xb6\\\x81\x10\xeft\xf3\xf6\\'\xefw\xd8|\xf6\x1c\x87=\xa7@P\xbd\x9f\xb7{\xb9l[NA\x89͗\x9bg\xf3\xf3\u007f5n@A\x93^[\x91\xdf\xee\xe7=\xde<\x8f﯁\xd7\xf1(\x85\xeb\xb8}\x9d=\x87\xcfq؋}\x9e\xd8\xf2n\xe0Qa\xbd\xae\"?'\xecl\xa1\xd3}\x1d\x8b\xed\u007f\x14\xf5\x14D\xc1\xfa\"\xbb\x9fwz\xdcцӝ\xe7\x89\"\xfe\x1c\x9f\xdd\xee\xf6;<\xfc\xf5\x19\xb1\xad\xf5\x14\\G\xb2m\xee\xeb\x98\xcd\xe7\xf3\x94\xc4T\x1b\xc5bꌢE\xde\x18\x1466\xaa\x05\xc1\x10|v\xbf\xb3\xdc\xce\xe5\x15\xb9\\\xd7Q\u007f\xa1\xcd岗\xe6\xb8l\x85\xb6\xbf$\xc9w\xe6\xf1.\xbb-/\xcf\xe9\xb3\xdb\xcb\xec\x9c\xc7kw\v0\xc7\xe5\xf1\xdbKl>\xb7ӝ\x1f\x1d\xe8u\xd9\xdc\xf6\x1c\x9b\xcb\xeeε\xf9|6w\xae\xa70\xc7SXhw\xf3\x85\xb6|\xb7\xfd/\x8d\x14yo`\x828>;_b\xb7\xf3~\x87\xc7\xeb\x15(\xe5\xd8||\x9eǕk\xf7\xc58\\\xc7\x05\xaeץ,\xb6\xfbxg\x8e\xed\x86\xd0\x0e\x8f\xcfY\xeeq\xf36W\xb6\xcd\xc7\xe58l>\x9e/q\xf2\xbc\xdd\x17\x95J\xb0\x13\xc1T\xa3\x8d\x98\x99r>;\xef\xf3\x14\xd8\xcbr<\xf9\xfe\xeb\"\xfayGQa\xb6\x9f+\xf2F]-\xd6\x10\xe4\x13\x9aQ\x87v\xd8\\yQ\x17\x8f\xb9vt\xe3\x8ax\x97\xd3]`\xcfu\xc6T\xe5-\xf2;\xbcN\xb7\xbd\x94\xb7\xfb\xdc6\x17'܌m&\xef\xf3x\x1de\xf9N\xdeQ\x94\x1d\x1d\x1asب?\xda\v=\xee\xa8#FM3F\xfd\x86s\xc5Zѻ\xd1i\xd7WvcQ1\x82En\xc1\xa7s|\x82\x8d\v\xfa\xcb\xf5\xf9\xfd\x8e\xdc\xdc\xec\"\x97\xcb\xe1\xf1\xb9\xb3\xed.W\x8e\xa0\xb3<g\x8e\x8d\xb7;l\xeeܘmD1\xc1^\xa2H\x917\n\x845\xc7\xec\x89\xfb\x9fA\xfd\xa3#:\xf7\x1f=E\xde\u007f4\x05\x12\xf9.O\xb6\xbd\xc4gw\xe78x\x9b\xbf\xc0\x9f\xe7t\xf1v_\xb6\xcfi\xcf˱\xf9\xed\x82\xd1\xc5,\xdb\xed\xe1\xed\xeb\x8bl.\xa7;\xcf\xe9v\xf2e.\xbb\xdf\x1f\xed\xc8\xf7y\x8a\xbc\x82\x06s\\\x9e\xa2\xdcl\xbb\xad\xc0\xee\xcb)\xe2s<\xde2\xaf\xcd\x1b5\x0f\xa7\xd7o+\xb6\vz\xe1\xb2]6w\x81\xcf\xee\xf1\xe5\xda}E.\x8f\xcb\xcf\xfb\x9c\x05v\xde\xe1\xf3\x14\xe5;\x8aܹv\x9f\xcb\xe9\xb6\xf3\xb6l\x97\xbdЖ\xef\xcc\xe1}E9\x05^!0\xf8\xec~\xfe/$f\xf1\x1eO\xbe\xcb\xce\xfd\xe5\xb4\u007fk\x17z\xdc\xf6\xb2\x1c\x9b\xcf\xce\xc7\x14\x15Ŋ\xbc1\x18\xd5T\x14\x8b\xa9H\x88\xa0n\xbf\xdf\xe3\xe3\x85o\xcc\xf9\x04\xa4\xc8{#eD\xf7\xff\xba\x05\x15\xb9s=.{\xbe͕k\xf3;\xb2=6_\xeeu\xd3\x14\x06ݰR\x01\xcf\xf6\xb8x\xbf\x93\xb7\x17ڼE\x85\xd9>\xbb\xcbe\xf3\xda\xfc\xbc\xdd%0岋\\\xd9\xf6\xd2\x1c\x87͝o\x8fj\x8e\xbb\x91#b\xad\x98\xe1\t\x89\x89+\x14\"\x18\xef\xf0\xf8s<^\xbb\xbf\xc8\xc9\v;#XK\x8cc^\x9eݞ\xe7\xf1\xe4F\xf3O4\x82\v̋\x9c\xae\\\xa7;\xdf\xe1\xf1{\x850o+\xcc.r\xd9\xdc9\xf6B{n\x81\x93\xcf\x13d\xb0\xfb\xb8uv>\xdbn\xf79b.\x98\x96g\xcf\xf5\x14e\v\x06\xe2\x164)(\xea\x1f\x1dQ}\xfd\xa3\xa7\xc8\xfb\x8f\xa6\xb0\x86\xff\xcd\xfdۤ\x1b\xa3\xff7,\xd7\xee/\xe0=^\x97\xcd\xcb{\xbc\xd1\r\xe7\v=\xd9\xc2\x1abN\x16\xb3Ԩ\xbd\xac/\xf2\xf01\x8a1,J\xd1\xefu\xba\xddv\xdf_\x19\xd4u\xc3mm\xae\u007f\x04\xa6\xbf\xb5\x85\xe0$4\xed\xa5^\xc1\x85\xa2\xda\x13v%6\xc8_\xe8t\xd9\xf3|\x9e\x12w\xa1ݑo+\xb4{m\xb9\x05\xf6\xb2\xe8\x16\v\x898JH\x80Q\xff\xb7\xfb칼\xddW\xe8\x8cV\x01\xb91\t8\x9b\xcb\xf5W\x10\x8a\xc5\x04\x97''\x16\xa3\xa3>\x97\xe3\xf3x\x85\xd1B:*(r\v6ťgd\xfd-\x96\xfb\x8b\xbcv\x9f?\xc7\xe7\xf4\xf2\xfe\xa2\xec\x18¥g\x1a\xbdE\xe5\xe5\x82n\x9c\xf6\x1c{\xa1S\xa0#h\xe9\u007f\x98P\x16\xf8\x1dN\xbb+\xf7Fh\x8f\xf1\x17\xd2\x01g/\xe5\x9d\xee\xfc\"\xa7\xdfa\xf7\xf9<9\x05v\xbe\xd0V\x9a\x93\xeb\xbe\x11\xe0\xfd7\x92\xfd?:b\xde\xf1\xf7\x9e\xff\xa5\x84hS\xd8F\a_\xe82\xe7\xf8\xfdF\x9b;\xc7\xe1\xf1\xc5b\\\xcc\x02].\xbf\xbd\xccnw\t\xfe\xef\xf4\xff-\x01\xfc\xd5u#Ep\xc64c\xb4\x1e\x8a\xc6NgT\xc0\xbf2pt\x9f\xa2!7\xda\xe7\xb2\x17\xdb]\x82{DaԻ\xa37\x85\xa1\xd1\xc8\x1a\x9dc4\xa4\xc7\x12h4\x9dx\n\xbd6\xbf\xff\xc6f\xff\xb5\xe9\xbc\xc7\xcb\x19\r\x19\xf6\"_~\xb6\xb7ȟ\xebt\xfb\xd6y\xcb|E\xd9\x05\xbe\x92l>Gp\xa8\xbf\x9c*\x1a\x11\xb2\x85\x1d\xf6:l\xd9v\x9e3\xa6g\xfd\xd5\xc7\xf3>gv\x11o\xf7\xff\xdf=Q˺\xde\x1b\x8dz\xffh\bw9\xa3\xd1\xc4\x19\x8d\xe62O\x11_\x94\x1d\x93\xf8:^\xeat\xe7\x97\xde\xc8\xdb7\xee\v\x8a\xca\xf5y\xbcٞR?o\xcb)\xb8\x11E\x9cn?o\xcb\xf7\xd9\n\xf3\\Μ\x02\x9f-םg\xc80d;\xf9\xec\"A\xa11\xdd\x16\x15f\xbb|\xb1k\xb4\xc3\xe5q\xe7\xff-\x1d\xfc\xad)\x04\xa0\xbf\x1a\x82m\xfc\xad\x19si\xaf\xd7e/q\xbas=%~\x9b;\xd7\xe7q溜\xee\xa2\xd2\\\x9f3;\xdbe\xf7\x17\x94y\xedy\x9e\"\x9f\u007f}\x91\x10l\x85\x00\xe8ɳ\x17\xda\\\xd1o\xbe\x93\xe7\x9d^\u007f\x91\x9b3Z,By\xee,\xb6g\x17\xe5\x17\x17\x94\u061d\xd9\x1e\x9fPE\xb89cFzt\x89܍5r\xc6\fSL\x84\x1b\x99\xcc\x15\x8b\xe7\x9c1Ò\xeb\xe1\xff\xdem\xcc\xc8,v\x16\xda=\\L\x00Θ\x99\x16K\x15\xb1\xa6\x873f\xa6s\xc6L#g\xcc4q\xc6L3g̴p\xc6\xcc\fΘ\x99\xc9\x193\xb3\x8a\xdcλ\r\xd3\xd3\xf2\f6\x03g\xccJ\xe7\x8cY\xc6<\x83\xcd\xc4\x19\xb3̜1\xcb\xc2\x19\xb328cV&g\xcc\xca\xe2Li¸lΔ\x96ΙҌ\x9c)\xcd\x14\x9d>\xc3\xc0\x99\xd2,\x9c)-\x833\xa5er\xa6\xb4,\xcedH\xe3L\x06\x03g2\xa4s&\x83\x913\x19L\x9c\xc9`\xe6L\x06\vg2dp&C&g2ĸ\xcfL\x8b\x01\x03gJO\xe7L\xe9FΔn\xe2L\xe9fΔn\xe1L\xe9\x19\x9c)=\x933\xa5gq&c\x1ag2\x1a8\x931\x9d3\x19\x8d\x9c\xc9\x18\x13`V\x8c\xc2,C\f\b739\x931F~\x969\x06,1\x90\xc1\x99LF\xced2q&\x93\x993\x99,\x9c\xc9$\xf4er&Sl\xc2\xec4\xced6p&s:g2\x1b9\x93\xd9ę\xccf\xced\xb6p&s\x06g2gr&s\x16g\xb2\xa4q&\x8b\x813Y\xd29\x93\xc5ș,1a\xee\x16\xba-\x9cɒ\x91g\xc8\x13\xba\xb38SF\x1ag\xca0p\xa6\x8ctΔa\xe4L\x19&Δa\xe6L\x19\x96<C^\x0eg\xca\xc8\xe4L\x19Y\x9c)3\x8d3e\x1a8Sf:g\xca4r\xa6L\x13g\xca4s\xa6L\vg\xca\xcc\xe0L\x99\x99\x9c)3\x8b3e\xa5q\xa6,\x03g\xcaJ\xe7LYFΔe\xcaK\x174\x9ee\xc9K\x174\x9e\x95ə\xb2\xb28sZ\x1agN3p\xe6\xb4tΜf\xe4\xcci&Μf\xe6\xcci\x16Μ\x96\xc1\x99\xd329sZV\xb1]\x88Bf\x83\x813\x1b\xd29\xb3\xc1ș\r&\xcel0sf\x83\x853\x1b28\xb3!\x933\x1b\xb28sz\x1agN7p\xe6\xf4tΜn\xe4\xcc\xe9&Μn\xe6\xcc\xe9\x16Μ\x9e\xc1\x99\xd339sz\x16g6\xa6qf\xa3\x813\x1b\xd39\xb3\xd1ș\x8d&\xcel4sf\xa3\x853\x1b38\xb31\x933\x1b\xb38\xb3)\x8d3\x9b\f\x9cٔΙMF\xcel2qf\x93\x993\x9b,\x9cٔ\xc1\x99M\x99\x9cٔř\xcdi\x9c\xd9l\xe0\xcc\xe6t\xcel6rf\xb3\x893\x9b͜\xd9l\xe1\xcc\xe6\f\xcel\xce\xe4\xcc\xe6,\xcelI\xe3\xcc\x16\x03g\xb6\xa4sf\x8b\x913[L\x9c\xd9b\xe6\xcc\x16\vg\xb6dpfK&g\xb6d\xe5\xa5[\xd2\xf2҅q\x19\xe9y\xe9¸\f\x13g\xce0s\xe6\f\vg\xce\xc8\xe0\xcc\x19\x99\x9c9#\x8b3g\xa6q\xe6L\x03g\xceL\xe7̙FΜi\xe2̙fΜi\xe1̙\x19\x9c93\x933gfq\xe6\xac4Μe\xe0\xccY\xe9\x9c9\xcbș\xb3L\x9c9\xcb̙\xb3,\x9c9+\x833ge\xe6\xa5g\xd8\x05\xfbH\xcfL\x8b\x01\x03gIK\xe7,iF\xce\x12\xf3\xa3\xf4Ls\fX8KZ\x06gI\xcb\xe4,iY\x9cŐ\xc6Y\f\x06\xcebH\xe7,\x06#g1\x988\x8b\xc1\xccY\f\x16\xceb\xc8\xe0,\x86L\xceb\xc8\xe2,\xe9i\x9c%\xdd\xc0Y\xd2\xd39K\xba\x91\xb3\xa4\x9b8K\xba\x99\xb3\xa4[8Kz\x06gI\xcf\xe4,\xe9Q\x13O\x9f\x1e\x93a\xba!\x06\xd2c\xc0\x18\x031a\xa6Ǆ\x99n\x89\x81\x8c\x18Ȍ\x81\xebT\xa6\xc7\xc0\x8c\x18\x98\x19\x03\xb3b`v\x14̈1\x9a\x11c4#\xc6hF\x8cь\x18\xa3\x191F3b\x8cf\xc4\x18͈1\x9a\x11c4#\xc6hF\x8cь\x18\xa3\x191F3f\xcf\xf4xˢ\xb1z\xc4,[\xb1}\xc4\x1c\x9b;\xb7lDz\x9a\xc1r\xfb\x88\xe9.\u05c8X\x1d5\xc2g\xf7\xdb}\xc5\xf6\xdc\xdb\xffv4M\xbd\x1a\a( \x03\xd9 \x0f\x14\x83\x8d`\vh\a;A7\xf8\x04|\x03~\x00\xff\x05a0DQ\x14C!j<u;e\xa1\xe6Gτ\xab\xa8F*@\xb5Q\x9dT7\xd5K\x1d\xa2\x8eR'\xa9\x01\xea=\xea\xcb8&\x0e\xc5%\xc4M\x88ˌ\xbb+nfܼ\xb8eqk\xe2\xb2\xe3\xaa\xe2\xda\xe2v\xc4\x1d\x8c;\"J\x17\xdd#z@\xd4+zXt\\tV\xf4\x84肨_\xf4\x9e\xe8Cѧ\xa2\xefE?\x8b\xae\x8a\xc2\xe2\xe1\xe2\x11\xe2\x89b\xa3x\xb2x\x9ax\xb6\xf8\x1e\xf1\x02\xf12\xb1M\x9c/\xde(\xfe\xb7\xf8S1\x91P\x12\x89$^\xa2\x97L\x92\xb8%%\x92\x8d\x92\x06\xc9\x16I\x8bd\xaf\xe4\xb0\xe4\xac\xe4q\xc9S\x92g%\xbf\xd1w\xd13i\a]F\xbfH_fT\x8c\x86\x19Ɍa\xc63\xb73\xe9L\x063\x8dy\x80\xe1\x98\x1c\xc6\xc1\x04\x98\x13\xccc\xcc\a\xccg\xccW\xccw\xccoR\x91T*\xd5Ho\x96N\x94\x1a\xa4\x19\xd2Y\xd2{\xa4\v\xa5V\xe92\xe9*i\x83t\x8b\xf4i\xe9\xf3\xd2\xc1\xf8\xb4\xf8\xa9\xf1\xae\xf8\r\xf1\xc7\xe3Oǿ\x10\xffV\xfc'\xf1\xff\x89\xff.\xfeW(\x87\x89p\x04\x1c\a\xcdp\x0e\\\x019\xb8\x0e\x16\xc2b\xd8\b[\xe0C\xf0(<\x05_\x80\xdf\xc1_\xe0\xef\x90\xc0?\x91\x18!\xa4B\x93ѽ\xe8\x01\xb4\x1c\xadFnT\x82*P/:\x88N\xa3'\xd0K\xe8U\xf4\x0e\xfa\x00}&\xa3dr\xd9\x04\xd9|\xd92\x19/\xab\x97\x1d\x88\x9ek\x9e\x95\x9d\x97=#{IvY\xf6\xa6\xec]\xd9/\xf2\x04\xf9$\xf9B\xf9\x83\xf2\\\xf9z\xf9Fy\x83|\xbf\xfc\x84\xfcU\xf9;\xf2\x8f\xe4_\xc9\u007f\x91\xff!\x1fR\xc4)$\n\xb5B\xaf\x98\xa4\x98\xa2\x98\xa6\x98\xab\x98\xaf\xb8_\xf1\xa0®\xa8Rt)z\x14\a\x14G\xa2g\x87O*\x06\x14_(~SʔÔ#\x95c\x94\xe3\x95iJ\xb3r\x9ar\xa1r\x95r\x9d\xb2XY\xa9lTnU\xeePv+\xfb\x94\x87\x94'\x95\xe7\x94\xcf+_Q\xbe\xa5|W\xf9\x95\x12'\xc8\x13R\x13F'\x8cK\xb8=\xc1\x9c05z\x9a\xb7 !;\xa1$\xa19ao¹\x84\x17\x12\xdeO\xf8>a(\x91ILHT'\xb2\x89\xa3\x12\xc7&NH\xbc3q~\"\x97\xe8H,L\xf4%\x96$nLܔ\u061c؞\xb8+\xb17\xf1T\xe2s\x89o'~\x9d\x18N\x12'\rK\xd2&ݒdH\xcaJ\xba3if\xd2ܤ\xe5I\xb6$oRE\xd2֤=I\xfb\x93\x0e%\x1dM:\x9fԟ\xf4b\xd2;I\x1f&}\x95\xf4ߤ\xabI\xe1d\x90\xcc$˓\xd5ɩ\xc9#\x92oI\xbe-ِlI\xbe#yf\xf2\xdc\xe4\x85\xc9\xf7'?\x98\xbc*ٖ\xecL^\x9f\xbc1\xb9)\xb9+\xf9P\xf2c\xc9/&\xbf\x99\xfci\xf2\x17\xc9_'\u007f\x9f\xfcK2Qū\x94*Vu\xb3j\x9cj\x92ʤ\x9a\xac\x9a\xa9\x9a\xabZ\xacZ\xa6\xe2Ty\xaa\x02\x95WU\xa9ڢڡگ:\xa9zJ\xf5\xbc\xea\xa2\xea\x15\xd5\x15\xd5\xfb\xaaOU_\xaa\xbeS\xfd\xac\xba\xaa\"jF\x9d\xac֫ǩ'\xa9\xa7\xa8\xe7\xa8\x17\xa9\x1fP\xafR\xe7\xaa]j^]\xa1\xaeUoV7\xab[\xd5;\xd4\xdd\xea\x83\xea#\xeas\xea\u007f\xa9/\xaa\xaf\xa8?V\u007f\xab\xbe\xaa\xfes\x18\x1c\xa6\x18\x96<l\xf80v\xd8(\xf58\x88oc\a\xadcX\x85VrA+\xaeW\x93W\xf0+\x92\x93y\xd3\x0f/\xd5\\S\xf5\xb2\xdap?\xb3\x04괒iwB\xdc\v\xfb]o\xe7\x9d\xd4E\x16\xe3\xcd\xea\xdd\r\xbbk\xdb\xea\xf1\xab\xe4\xd5\xe1m\xf5\xc1\xf2\xd6riDF\x87T\xb8\x1fj\t\x98\x0f\xc3\xc1\x88\xe9\r\x16\xa9GBD\xfa\xc9f\xb2\x99\xf4\xe3\x89P\x87\xf9[\xd9{\xe1ax\x84\xd5!\x1a\x1b\xb1\x1a\xab\xb1Q\x8f\x88\x91\xa8\x89\x9a\x18\xf5\xa8\xac\xb2\xb2\f\r\x1b\x01\xd5\u0604\xf5\xac6ԟ\a\xafY\xb7C-3\xad\x95\xc5&&y\xf00ԇT\f\xee\x81ɑHP\x9c\xfc\x01Q\xd1\xc9\x11<\x15jC\xfd\xb7\xc3A\xab\x8d\xd52Ӱ\x93\xc5*F\x11\x9aJ~\xc0?D\xa6\"\xe6v\x16\xd1\x06\x16\xf5\xb2\xc4*,\a\x19Y\x1d*[\vQ\xa6Ё&\xb3zt\x8f \xb1u6\x1b\n\x12\xeb=\x10\a/\xab\xa7\xc3y\xf4\x1cV?o\r\xbcL\xa3\xb5\xecq4\x87\xd5\x13\xeb\x1a\x88\xd4w\xb1\xa1\u0c3bX\\\a\xd5KY|'\xd4aխ\xac \xb8n\x1e]V\xd9գ\xbf\xcc\xf4tu\xf5\xe8.\xd3=]\x95ezl\x15\xdf\xc3\"\xba\x80ES!\x19\xb8\x1db\xab\x8d\xc5\x03\xab!2B\xa2\x9a\xc0\xa2\xe3\x87\x0e\x1dGc!\xb1\x8e\x86\xe8\xb8\xfb\x90\x03͂z\xc4L\x83\xc8\xc9\xe2>\xf60\xd4!\xda\x00\x11S\a\x91:ʄ\xa8b\\\xb0*\xc6\x06\xab\xae\xf3Q\x899\x88ԣYt\x1bԆ\xab\xb3X-]=\x91E\xea\xdd\x15\xbb\xcau\xf3\xe8\xf2\x8a\x8ar\xfd<\xa6|W\xc5n\xddez\xf7\xae]\xbb\xf5$\x88\a\xee\x81h\x01\xd4#\xe1.\xba\xc2FX\xfc\v\f\xb1\x92ֆ\xdamu\x9aںO\xd9P^$\xaf\x13\"\x0f\xc4V\xf1\xf5\v\xc2#1\xd3Ƣ\x95P\x8f\x0e\xb0#?3\xef\x85H\xd2\xd0\x1a\xdcԮi\x0fb#D\xef\xc3bV\x87\x98[!\xba\x9f\xc5\xc3\xe9\x97Y\xac]\x89\x13\xef\xf8Q\x87\xdbp\xbb\xe476bՒ\x11\x13\xa0\xba\xa7\xb2\xabLG\xac\xb4\xb0,=\xb1~\xc4\"\xf5\xad\x10\x91\xe1\x13r\xe1\xf7\x13J\xd9\xef\xbfg\xd2\xe0\x044\x8a%A\x14\xec}\xf4\xe7\x9eԭM-\x9b\xb7\xeaڪF\\j\xd44lq:\vs=\xb9)5\xcd5\xcd555M5\xb2ѻj\xa6\xfd\xb4Q\xd38b^C\x95~\xf3\x96\xa6\xcd[R\xcb\x1f\x1d\x1b,\xd1Um\xa8)\xab+\x93\x96l!\xeaʊT2\x82\xa9\xc0\xea\xca\xde-\xba\xf6Ҏ\xf2\xce\r\xd2\xda\x12\xdb\xd8\xf2\xd4-͛[\xb6\xe8\xea;\xff\x98\xb7]\xb3\xf3\x9d\x9fwu\xe8;\x9a:\x9a:::\x9a;d\xa3+:\u07b9e\xa7\x06\x91\xdf\xc9\x19r\x86\xfc.@|7\x8b\u007f\xc7\x02@A\xc1\xe8\x99\xd9/\xdc\xf7\xa6\ueebe\xb5\xe1i1\x8d\x13kL\xe5Z\xba?\xa6\xf4Ѐ8:\xe2\xda\xc0?\a\f\x0e\xbc\xc0\n\xb3L\xac0t\x16\xd4+\xdea\xb5!@\xe7\xc0ePK\x03\x11\"\xaa\xf9\x90B\xb3\x96,\x99\x85&\xb2\n\xf5m\x105\xb4\xea\x96?\xb5\bF\xf2\"y3\xa1\xb0Q\vا\x9e\xaa\x87\xc8qȍvB\x1dZ\xc7j\x19\x80\xad\xeaY,R/d\xd1n\xf8\x0eD!\x15V\xa1pP+\xeeS\xbfǒ\xcf\xc8g\x12\xb2\xcb\b#\xaa\t,\xdeE\xe3\xcf\xc8gO\xb3\xe878hųX\x84+\xd8j\xd68\xf3\xca\x17],zk\xc9\xf3\xb3V\xb1\xa8/\xf0,\xdc\u03a2\xb1p\xdeh\x88\xe86\xa8G\x97\x17\xb1\x88\x99\vѿ \x1e\x89\xb3Y2R\x87Կ\x9b?\x1b9\x9fա/f^1\xea\xd0wP\x17\xb1\x86\xac=,\x16\xb3\xfa\x885b\xadc\aU\xabY<\x85\xc4\xe1[\xc9\x1d\xdf\xe8v\xadh_\xf6`*\x993\x89d\x91)D\xa1#b2\x89p\vH\x81\xc6]\xb8u\xab[\x9f\x85\x13\xf1\xe4\xe5\xf8\x1e\xcd\xc5\x17[;\x9e\xd3\u007f\xbc\xf8۵\xff]\u007f\xb0\xfeP\xe3#\x9b\xba\xb7\xecڹ\xadG\x8aEp۞\x8d\x9d\xd5;s\x1f\xe3N;?\x96\xa2/\xae\\\xf9\x02\xada\xaf\xa9\xa6\xb1x$ԇ\xac\x11k\x90ŷ\nX\xc8z\n\xa2\xc9,6\xb1h\x15;\v\xd1u\x10\xe1 \x1b`\xe6\xb3\xfa\x17\x05\xcf>\x01u\xd1\x06\xc2&|\xf5\"\x8bUX\x15R\xe17 R㻉\x12O\"3\xce\xea\x0e\x9e\xedz\xf2\x02na\x17..\xcaY\xad\xc3֩d\x11N\"\xb9\x9ao\xd8\xca\xe6\xca\xe6\n=1\xcc\x18K\xd2ɌT|\x81\xd5\r\x0eЧ\xa1^\x91\x01\xf1Y\x06oa\x05\xbf\x0fN`Q\x01\f\r\x9c`\x91\x96\x01\xc88s\xa6\x11\x1d\x82\x91\xe0\xc7lD\x15\xb3\xa3P\xd0ŢM\xb0\xa6:P\xc3C$\xa9\xaa\xaa\xdfP\x8e\x17\xb3\x88\x1e\xcb\"\x12 \xab\x11^}\x88E\x98\x17\x85\x83X\r#S\xc9\x0f\xe4\x87\xc8\xd4\xc8T\xfc\x03\xf9\x01w@\xfcCh\xeaxV\xaf\x1d\xfcT\x8c'\xc2\x1b!X\x8f\x06\ap/;\xa4\xa2\x1bXr3\x99\xad\xbf\xa6b\xc8t|?\x19\x81\xeb\x1bt\xc4I\xd6HJ\x8a\xbb\x1c\x874\x83g\xe9C]\xc7{\x8b\xf5ĉ\xd7Hp}+\xbe\x1f\x8f\xc0\xd3S\aU\f\x9eMV\xe3\x9bI@\x87\xe8GX\xb4\x86\x8dXc*ƣ\xc9h\xf2)\xf9t&\xc44\xa1\x17\xb0\xf8S\xfc\xa9\xd0\xf74\x8b\x96\xae]\xbbt\xe9\xdas\xfd\xfd\xe7\xf0op\xedR\x1dR\xe3B\xa2\xc2È\x91\xdc2\x92H\x88\x8e\f\xfb\x8e\xd0x̋/\xf6=\xf2\xb0n+}s\xe9\x82[\x88L\xe3^\xdf\xdaV\xa2\xafn\xa9\xdeV\xbb-\x97H\x86w6v5v7Jk\x1f:Y\xff\xb8\x06\xd7\xe28\xec\xc1k\xf4H\b\xba\x88px8\xb9\ag\x93x\xfd*2e\xe6\xa2\xf9\xb3*\xca\xca+\xb6\xfb\xa4\x81@\xa0)\xb5h{φ\xde歁\xad\x9a\xd3\xdbO\xb7\x9d\xe8\xc0\xd3\x1f\xc3i\x1fᛰT\xdax\xfe\xc2\xe6\v\x9aP]h\f>\x8d\xff\xd0+\\\x10\xb3\xac\x1eQx\x1c+Bt\x17\x8b0\x83G\"/\xd4#I/D$XŢ46\x14\x8c\xa8|\x10\xb5o\xc6K \xb9\x82\xafHZ\xeb\xeb\xb6\xd5j\x84 \x8eʺ*1\xc5v\xf5\xe8\x11\xcf\xea\x11\tb\x15\xc2\xdba\xe4l\xe8\xec3,9\x1b\xb12\x06\xa8\x17\xdc-d%g\xe9\x97a\xe8l\xe8쩨\x05\x12\x9e\x16\x94\xa4?\x82\xdfa\xf1\x118\x8f~\x9f\xd5\x0fYq7ԅT4\xfe\x04\"IgՆ\xb6r\r\xc2O\x909x&y\x86\xcc$\x99Dw\xc7T\xdd\xfbSI&֑\x99\x1a\xf2\f\x99Cf\x92'\x10-\xa8\\\x8f\x84\xc0\x82\x04Q\x16ð\x8a\f,\x86\bk١ɕ0\x1fnc\x87&?ɢ\x91f\xf3Ht\x84\xc6\xd3!\x19\xa0\x89r\xecX\xa2\xd4_\xb32\x888\xc84\xbc\x87EXE\x05\x04\x0f@8\xb0\x15\xa2\xf2\x8a]\xbb\xf5\xb1\xf4Ry=\xbd\xbc\xf5\xfc\x92Y\bo\xc6\xfd(X\xdfV\xabC\xeaG \x12O\x17\x9cE\x0f#y\xa1\xbc\xe1\xd8\x00\x97/\x1f\x8ee0\xc4F\xd8\xe1\xf8\x17\x88e0\xe5\xa9\xe5Xǆ\xf2By\x12\xc4d@DT\xf8\xbbw\xd9\xf0S\xa1Cj\xf2\xd6X\xfc\x1eC\xbaC5\x12,\xa3\xf1$\xf2\x8a$\xb0o\xf5\x9eպ\xde\xc7\xf6?\x19H\xc5\x06\xfc\x8a\x04\x8b\xe9\x90\r\xf5A\xbc\a\"g&\x97\x9b\xcbUo\xdcX\xb5\xadT\b\x03\xe5-]U\xbb\x9a\x9b\x03͚G\xb6\x1d\xda~\xb0\xed\xbf\x0f}{\xee\xe3\x17\xa55Ͻ\xd8pQ\x13J\xc1\xaf\xe32|\x02\xe1\x05\xe4\x00YN\x1e\x93\x10\x15\x99\x82w\xd0x\x12n\xe9\xe8\x0ev\xb7v\xa6\xb4\xb4mk\xdb\xda&m\xa1\xb7ַԷ\xd6\xef\xb7\r\xc7F\xb2]\x82\x9bi4\x15\x0eYq\x16\f[WC4\x1f\x86U\xea\xd0\x14\xf2#\xfe12\x85,'\xf7O%)d\xa4\x8e\f\xc3\xea\xb2=\x9e\xed|M\x8a\xbff\x03\xbf>5b\x15\xb6\x9aFj\xc2\xe4c\x86\xfe\x1db3\xbe\x00Q\xe8k\xb2\nW\xe0U\xf8.\x1a\xaf\xc0\n\f\xf0\xecO\x06\x1a6\xf7\xe9H\x0eC\x14M\x92\xf1ek\xe6\xeaɭ\xb8\x8c)$\x15\x12\xb22\xf25zVȩ\x9b\xdb6\xb56\xb4\x0e\xad\b\xaf\x18\xee;PR\xebHEj!@\xf3,\xae`\xb3!RϾ\xef\xbeٳ\xef{\xe1M\xbc\x00\xa2 k`Q\x0fk\x80z\xe4p\xbb\x1dx2D꩐\xf0\x84\xa7\xbfd1Ox\xe6\xaeX1\xc2c\x9eF\xea\x97X\xb4[\xd3\x1e\xdc\xdaҮ\xdf\xd7ѽ\xef\xa1T\xa1^\x1a\x88\xd6K\x1b\xd9k\x03\xb4\x89\x1d\x1c\xd8Ȣ\xbfE\xc8H0\x14\xf4\xb2h<\xfb\xcf\xf0\x13\x9a\x8a\x1ef\x05\x939{\x04\xf3x3\xbe\x90\x8a\\\xac\xe2\xf5\xa89,\x12L\x1d\acf\xf5.\xc4*\x11\xba\x9e6P;\xfc\xf8\xe3\x8f3\xff\xcf+\xc2g]\xf0k\x88hA\x0e2\x9c\x04\x99v6\xac\xa2\xdffI0ZZ \xf5\x05\x1c߲\xad\xbd\xb5\xb59\x05\xbfIoo\n6\xb4lz\x92\xc4\x0f\x0f\xf1\x05KX\xf22=\x1bO\x91\xa0ס\x90(\x91\xfaC\x16Mf\xa7\xb2z\xa4>\x04\x11VA\x11\x9a*d%\x1d\"\xc7I9)'Ǒ\xbaﲤ\xa7uO\xd7nM\xe8*\xdd\xd3QY֠/\x9e')k(\xad,\xd7D\xae\xd2e5]=\xadz\x84\x1f\x83\xc1\xb6\xfaک\xaf\xdc\xfb\x81\x00Ѽ\xd1\x10\xa7Ҥ\x9d\xb4!f'\x8b\xe8 DkX\xed`5-(^\xaf\x1d\xac\xc6\xc5pSC\x83~\xf9\xf2\x99\x10\xe1\x1dP\x84ԡ\xf1\xf8c\xf2qd|d<\xf9\v\xe2\x8fC\xe3\x85~\x01\xea\xd1A\x16\x8f\x87:!\r\xbd\xccF\x06zYb\x12*H\xeb\xedB:\xe8l\xe8ޝ\xba\xbb\xb5\xbb\xb3S'У\x02\x88\x9c e\xa4\x8c\x9c@\x1d,\x85\xb0r\xec\u007f\x89\x12I6\xb7\xb7oi\xd7\xec\xd8Ѳ=\xa8\xdf\xdd\xd1\xd9ݲ\a\a\xe7\xc3\xe1\xdb\x0e\xd4\xec/\xde%E\xf8~x\x8c\x9d@\xe3ס\x1e\xe1ס\x0e\xff\x87m\v\xea1\xcf\xe0qP\xf7=-,MOx&\x14DL\x88\xc5rI\xd7\xf6`gW*V1\x1d\x8d\x1du\xad\xba\xba\xd6ꭵ\x9b#\x88H\x87#l\xc5y\xd0\xcdF\x06FCD\x86\x11\xc5D\x92\xe4ҏ\x8adH<4I\xb8\xe5\x16\x92\xa0\x8f\xa8F\xc3\uf468\x8a\r\xab\xf0v\x88\u007fg\x9fa\x89\nuv4om\u05f7nkަ\xd9\xccT\xd766\xd6\xd5\xd4U\xa5D$\x91\x87$\x86Ї\f\"\xa9\xd3\xd9\xd7Y\xf4\x1aԡ\x15\x90\x8cD\xf8|ԴF\xdeˢ\x1e\x1d\xb6\xd2=]\xe8[6\x80\xdc\x0e\x1dQ\xa1\xf2\r\xf5UUh\x04\xfe\x18\x86\a.\a\xb0*)p@\xa8\xccp0\xac\xc2\xc1d\a\xa2\xdfǯl\xed\xd8\xd2\xd1О\xd2\xd6P\xb3\xbdVCx\xbabcM\xe5&\xfdd\xf2\xa1\x04\xa9\xf1wLH\x853%\xe45&bŻԑ[\xf1\xf4P\xf4K\xa6K\x106~8iS\x8b>Xݼ\xa1\xaej\xe9\x1d\xc3\x1b\xebj\x1bk\x9aj\x9aj\xb7շI\x03\xe8IV;8\r\xaf\x81\x8a\x8d\x9d\xbb\x1b\xba5ݻ[wv\xeaQUՆ\x86rM\xf9\x86\xd6\xce*=zh\xc3>\xbeC\xe7\xef\xf04\x96\x95\"\x1c\xa4\x028\x88h\x9cWN\xc48\x81\xacҐ{\xc9h\x92Ev\x93=\xf8&2\x19\xbb\xf0t4\x1d\x12+mb\xb1\xb5\x00\"\xe6\xe0\xa2{:\xef\xd1\x10\x8a\xe4/##\xc9H\x9c\xbf\fS\x98\xc2\xf9O\xe3\x91BT\xc6.\x96\xa8\x18D\x82OAAEi\x10_\x858\x105m|\x16\xe2/!\xc2\x1ex\b̈́O-_\xc0\xa2\xe3\u00a0)P\xd1g\x83\x01\\\x0e\x85(\x1f\xc4# \xeae\xb5\x83ч>\x14\xedQ\x05n\xfcG\xe1\x15\xe1\x15\xad\xad\xdbZ[\xdaR\x10QMc\xd1\u038d\xbd\x81\x9d;S\x10\xb3\x9eE\a\x0e\x04\xce\xf8\x0e f\x16DKX2@\xdf\xc2Nb\xf5\xd8\x01Ѱ\xc0&\xdcHn\xc5w\xe8\xd6\xd2XK\x1c[Ȋ@j`3َH\xfcĉ$\x9e\xc4O\xfc\x0e\xc7\xe3\xf8\xefb\u05c9$^\x87\xd4s!z\x83\x1d\xb4\xde)8)V]d\xd1\x046\x14\x9cȢ@\x15\x8b\xc8H\xc2 }GUu\xdb\x06\ryb)\xf9\x99\x14\x93\x8c?&`\x93>|\x8dF\xf8\xab\xe8RTx\x87\x00\x11\xbe\x17^\xb32Ĉ\xd5D\x8d\x8d:\xa4\xfe\x0f\x0eKv\xb4\xf4vwj\xc2A\xba\xb3wCu\x8b\xfe?$,Â\xa7\x96\xbf\x8c\xeb\x85Pp\x01\xf3d3>[\xa0Cj+DGY\u070f*\xf1\x17\xb0\v\x1d?\xe4v |\n\x12\x1e\xf3\xcc\xdd,\xe2;\x8b{\xabt\x8d\x1d;6\xef\xd0\xec@j/\xcf{uci/\xbf\xef\xa0\x1eI\xca\xcaj|\xbeT_\x87\xaf\xa7L\x87\xf0\xafl+~\x95m\xa8\xea\x146\x8a\x04\x8d\x10\xe1\x13\xb8,\x9a\x8bl\x90\x8aJ\x1a\x8c}\xd0xHx\xa4>\x0eiD\x05\x06\x83\xa2\xc1 \x92\x94\xf5\x1c\xa89\xa0Ax@0wu\x16\x14\x1e$\x99I\xecD\xa8Cc\xd8A+3\x0e\xa2\xbe\x0f4x\x15\x8b\xcf\xe0\xdf\x11\xb3\x12\xea\xf0\x00\xbe\xc4\u038bn\xa3\x1a\xf3\xf8\xa2\x90ޅ\aX>\xa2B\xf8\xcc\xef\xd3\xe8\xf3x\xb4\x04o\xa2ϓђi#\xf1Y:\xaa\xe8\xd8G彑\xf6\x17\n\xd1\xfa]\x88\xb6\xb3U,\x8a>\xe8\xe9\x90ϗ\x13\xf0\x1d@\xa9\xb7\xb3\xa8\xb5m[{0\x15QQ\xb1O\x93_B\xd7־\xaf\xeb\xad.\xda\xc9k\xf8\xa2\x8d%\xd5zd\xd3\xf9JJ\x8a\xcb|W'\r߱\xb3\xb3k\xe7\x0ei\xdf\x01!\xa0\r`SH\x15u\x00\x93\x96\xee\xc7&\x11\"\x9a\xb6\x92]\\7\xb7/g_˾\xb6\xbd\xed\xd2\xd6\xe3MH}\xbf5\xf7މ\xab/^y\xfb\xb1\xe7\xdf\xfc\xf7k\xa6,]\xe4\x0f\x14\x1e\xa0\xbf\xb8\xf2-\x8e\xd3\x0f\xce\xc5kXE\x8e.\xf9\x9b\xaf\xabs\\\xc7O\xeb1͜>|\xec\xcc\x19Ĭ\xef\xe6\xf7\xd7\xe86\xb7\x05\xb7\xb4k\x84\xaa\x1f\x11\x13][\xdf\x16\xc4b\x88MѸ\x86p\x00\xaf&\xab\xf1\xbbP1\"SK\x1b\xf1e\x16}\x8f\xe5\x82\xc1\r\x06\x057\xf2\xe3\x97XԷK(\x00\t\x83\x02\u007fs\x84\xeb\xeep\xa3\\\x1a\x1a\xc0O\xb0H=\x9b&\xe5\xe1\xf1\x12b\xa1I\xf9\xd0x\xc9\xec\x91\b\xcf£\xf1h<\vM\x11\xeaB5\x9e\x0e\xd1jH\u03a2Ѐ\xda\x00uh\xf0z\x16D\x11\x95\x11\xa2c\x95G\xf9\xfd:D\xef\xdeUQ\xaeG\x83Bɕ\x8a\xc5\x01,ނźښ\xe6Z\x8d\xad\t=\x96\u007f\x82\xe3\xf2\xf39\xeeD\xfec\x8f\x9d8\xf1\x98\x0e\xad\xbd\x83\xfc\x12\xb9\x96\x9b\x1a`H6>\x95\x853\x10\xf3\u007f%ck\x8f\xf5(\xa7\x0f\xa9\"A\xc9\xfc'\xdf\xcb\xfd\xb7\x06\xe1n6\x1f\x86\xcfb\x03\xfb\xb7m\x17>QCT\xe1'Y\xb4\xbd\xb3egWj\xff\xdasK\x116\xb1z\xa2bpP\xb8\xf5\f\xac\x81\b_e\x0f\xa1/\xae\xcc4\xa2\xb0 h\xd5\xc6@IgU\xca\x13\xab;\xabz\x03;;S\x10\xceg\xc3\xd6%,b\xa6\xb2\x95\x15\x8d\xb5պ\xc8\xd1\xc8t\t\x8aL!?\x92\x1f#S\xf0\xe3\x10\xe1\xb7\xc8\x0e\xbc\x83\xbcE\xde\";\xc8\x0e\xf2\x16\xaa\xe9ٳ\xa9Wӻg[G\x8f>d\xc5<z\xf9\xa9\xa7^F\u0601\xa7\xa1\x03\x90F\xe5\xf83VG\x06Ȁ\xa4\xa1a3\xc2'a\xdaWh\x1a$g\x19\xb4xڿ\xf4\x9d\xe5\xc1\xb2\x1d\xe5\xd2\xea\xb6\xf6\x06\xf4\x06\xd4\xd2\xd3Ж\x9a\xad5\xadu)\xf5\xad\xa8^\b\xbfmB\xf8M\xdd\x06\x11\x9e\fu\xa8\x06\n\xaa\x176\x15W@D\x06\x18\x03D\xf8,>+\x90S\x9f\x10\n\r\xd5\x01H!<\r;\xb0\x03a\x9b\x10*\xd5\xc7X\x84?\x83:D\x97U\n[D\x05\xf2\xa1\b\x19\xf1\x97,j\x0f\xb6\xb4\xb6\"2\x8d8\xd0|\xa8F\xb3\x9e_\xf2\x16\"\xa3\xf1h\x84KX\x1c}\x00\xd8\t\xc3\xc1-\xd1`\xe6a\xa3\x9e\xe5aQ>\x14k\a\xc1|8\x1f2\x88q\x1e\xf4\x1e\xd3a@\x1f;x\xf0\x98\x1ea\x16OH\xc5\x13\x16cv\u2bfa\x13\x88s\x9c\xd5\x17\x1e\xdbW\xfa\xb4\xe6\xe9\xbd{\x1eA\xa9]\xed\x1d]-\xba\xaf{_\xbbt\xfa\x13)\x8a=/b\x95\x8bE\xbb\x88\xba\xabd\xab\xae\xae\xa7\xa6\xa7\xaa[\x8a\x9ef\x15\xa5P\x8dj\xa0\x1a\xb5\u0087\xa1\x0eI\xf8\xfd\x98\x82\xfb\xf6\xe9Q\x83\xb4\xa1\xa1nS\xad\x86\xa8\xe8\xf2J\xb4\x84\x9d7\x1e\"\xf5\xe4&I$\x99\xd9Y~`CO5\"<3\x16\"\xccӷB\xd4\xc0\xe2\xd7Y\x84\xadkX\xa1\xd8\xc0A\xc4\x10\xe5\u007f\xc7b\xa5\x0e\xe1\xf7a@\b]*\x9c\r\x11\t\xc42\xce-lYeW\x0fꂿ\u007ff\x1e\x89\xa2Յ\xc3}\xe88\"h\xbc\x81\x88\x89\xe8\x874\x8c\xae\x87\x98\xd2X\t\x18\x8c\xe5\x9ah \xd174l\xaa\xabM\xf5\xe31d4\xda\xfe\xc7\xe5\xb6N}\xcb\xd6\xe6\xad-\xa9\x88\xcb?\x115\xfb\xc7N\xe4s\xa8r\x9d3\xd5م\x98;XD[!\xa2\xfb\xcf\tO<T\xe0\x00\x14\xa1\x8dU\x81\xe2\x9dUh\xb7fw7\xc2A\xfa8«q\x00\x95\xe9\x885\xba\xe7\x05,2\x8f\x18i\xfa\xec\x8f\xdf?\xff\xfc\xf7?PD\x15\nJ\xca\xf7\xd6\x1e|8\x15i\x02\f\xa6\xb1\xf8\xc9s\xff\xd6#]K]\xed\xd6Z\x8d\x01\"\xe6^Vw\x19\x11\x96誫\x9bJ;6\xa6\xa0\x92\x02=)g*\xea\xeb+tH\x9a\x9c\x00\xa4\x00\xa0\xb0\xe0\xd7³\xcc\xe7P\xa8\xf7\xe9|L\xd3H\xd2\xd3\xdcݵ[\x13\xf3\xb3 \x85\xe8\xddݕe\xcdz\x94G\x98\x934\xaa\xab\xdd\xdcЀڠ\x0e\x85\x84\xa5O\xa0k\xeb\x91nh\x80Fd\x15\x19\x8dG\xe1\a\xb1\x151\xdb\x04\xa3;~\xa8-\x18t\xb79\xf4H}ڳ/o\x85=wE.\xd2i\xc5\xfd\x05\x10\x911\xe4W\x9e<\x94\xbaq+\xc22|\x1b\x16\xe1\xddX\x86\xc6=\xf8\xd8\xf1l\xfd#N~\x0f*),\xd5?R\xb6\xaeסA\x98\xa7\x02\x98\x1f\x1c\xc0<\xa2_\xbb\xb8h\x8e\x1e\xab\x9a\xd5\b?\x05\x15\x92\xce\xcen\xd4WL\x05\x10V\xd1ǅ'\xd7 \xa2\x00\x05\x00\xa2\xc0\b\x00*\x01\xa8\x03\xa0\t\x80\xad\x00t\x01\xf0\x10\x05\x0e\x03jӟq\x9b\xfe\x14mj\x8c\v\x8a\x800\xd4\f@\x16\x00\xcf\x01\x80\x01\xd5\x01\xa8N@u\x01\xaa\x1bP=\x80\xea\x05\xd4^@=\n\xa8\xc7\x00\x15\x01q&\x107\x1f\xc4\xfd\x0eD\x1a \x9a\aD{\x81\xe8[ 6\x03\xb1\x15\x88\xf7\x00\xf1\xf7@2\x05H\xce\x00\xc9\x10\xa0\xc7\x01\xfa\x10\xa0\xbf\x06R=\x90\x06@\xbc\x1aď\x01\xf1V\x10\xff'\x80k\x00\xec\x03\xf0G\x80\x16\x01\xb4\x05\xa0\x9f\x80\xeca \x97\x02\xf9\\ \xbf\f\x146\xa0\xf0\x00\xc5)\xa0\xec\x06\t+@\xe2\n\x90\xb8\x1a$>\x04\x92Z@\xd2^\x90\x9c\f\x92\xab\x81j\x19PO\a\xea7\xc1\xb0S\xe0\xa6\xed\xe0\xa6\v\xe0\xa6O\xc0p\x04\x86\xb3`\xf8\x020|#\x18~\x1a\f' \x85\x03)\x9f\x80T\rH\x9d\x04R\x1f\x05\x9aZ\xa0]\x00X\x06\xb09\x80\xdd\x00\xd8\x0e\xc0\xfe\v\xb0\xbf\x00]2\xd0i\x81n\x16Э\x04:\x1f\xd0\xed\a\xba/\x81^\a\xf4+\x81\xbe\x1a\x8c\xb8\t\x8cp\x83\x11?\x82\x91\x93\xc1\xc8O\xc0\xa8\xa9`\x94\x1b\x8cz\x0f\xdc<\r\xdc|\x12\x8cւ\xd15`\xf4\x0f`4\x01c\x92\xc1\x98\xed`\xec\\0\xf6wp\xcb\x03\xe0V\n\x8cӀq\x87\xc0x\x1b\x98`\x02\x13>\x06\x13\xa7\x82\x89\x010\xf1%0\xf1Op\xdbIp{\x1a\xb8}!\xb8\xfd,\xb8=\x04&\xa5\x80I\x0e\x90&\x01i:\x90\xb6\x0f\x18\x16\x82\xf4\xef\x801\t\x98&\x00s%0\xef\x00\x968`\x99\x03,\x97A\xc6\x14\x90Q\a2^\x04\x99~0\x99\x06\x93\xed`J\x13\x98:\tL\xcd\x03S\xdb\xc1\x1d\xc3\xc1\x1d\xff\x02w\"pW'\xb8\xeb\x12\x98f\x00Ӿ\x02\xd3+\xc1\f\x00f\xf4\x80\x99}`V3\x98}\x00\xdc}\x01\xcc\xf9\x06̝\x05\xe6\x1e\x03s\x87\xc0=\xf7\x82\x85\x89`a\x13X\xf8\x0eX4\x1c,r\x83\xc5\xf1`1\x02\x8b\xe5`\xb1\x12,\xbe\x04\x96\x8c\x02K<`\xc9%`=\x00\x1eX\b\x96\xde\f\x96\x1e\x03K\x1f\x05K_\a\x0f\xde\f\x96\x99\xc1\xf2\xd5`\xc5e\xb0\xd2\x00V\xbe\x04V-\x01\xabu`\xf5k`\r\x04k\xf6\x01.\vps\x00\xf7\x00\xe0*\x01\xb7\x17p_\x01.\x04\xd6J\xc0\xdad\xb0\xf6 \xb0\xdd\x06l\xab@\xf6<\x90\xfd>Ⱦ\x06rց\x9c\xcf@\xee}\xc0\xce\x00{-\xc8\xdb\x00\xf2\xde\x00\xf9#A\xbe\x0f8F\x02\xc7e\xe0L\x02N/p\xbe\x00֍\x06\xeb\xb2A\x81\x16\x14\x9c\a\xaeu\xc0\xf5\x0e(\xe4A\xe1\x87\xc0-\x02\xee\x89\xc0m\x03\xeeR\xe0n\x06\xee>\xe0\xfe\x17p\x0f\x02O<\xf0\f\a\x1e\x1b\xf0\xbc\t\xbc\x00x\x97\x03o6\xf0V\x00o'X\x9f\x05\xd6\xcf\x00\xeb\x1f\x05\xbe\x1a\xe0\xbb\b\xfcn\xe0\xf7\x02\xbf\x0f\xf8\x9f\x03\xbc\n\xf0i\x80\u007f\x16\x14=\r\x8a\xbd\xa0\xa4\x12\x94\xec\x06\xa52PZ\x05\xcahP\xb6\f\x94]\x00\xe5k\xc0\x86\xb5`\xc3\x1e\xb0\xe13P1\x17T\x1c\x01\x15\u007f\x82ʯ\xc1F\x03\xd8x\tT\x8d\x00U\x13A\u0557\xa0\xda\a\xaa/\x83\x1a\x06\xd4L\x035\x15\xa0\xe6,\xa8\x1d\x0ej\x1d\xa0n\x1a\xa8\xf3\x83\xba3\xa0\xee-P\xf7#\xa8w\x82\xfa\x17@\x83\a4\x04@\xc3\v\xa0q\fhl\x00\x8dO\x81Mw\x82M;\xc1f5\xd8|\x0fؼ\al\xfe\rl\x99\x03\x9aƂ\xa6\xb3 P\x02\x9aU\xa0\xf9(ؚ\x06Z\x16\x80ms\xc0\xb6\x16\xb0\xed'\xb0}\nؾ\tl\xbf\x00Z)\xd0z\vh]\b\xda\xe4\xa0\xed\r\xd01\x06tn\x01;\r`\xe7G\xa0k\vؕ\x04vU\x83\xee\x17A\xf7\x97`\xf70\xd0c\x01{\x16\x80=\x0f\x83^\x00z\x17\x83\xde>\xd0\xfb\x13\xe8\x9b\n\xf6\xd2`\xef,\xb0o\x068x\x18<\xfc!8t\x10<\xb2\x01<\xd2\x0f\x0e/\x00\x87[\xc1\xe1\xcb\xe0\xc8[\xe0h.8\xba\x1f\x1c[\x0e\x8e/\x05'\xae\x81\x93\xd5\xe0T\x168\xb5\x03\x9c:\rN}\x05NO\x02\xa7\xef\x00\xa7?\x03g\xaa\xc0\x99\xf7\xc1\xd9\xd1\xe0\xd1\xf1\xe0\x9c\f\x9c\xdb\x0e\x1e\xbb\x04\x1e\x9f\n\x1e\u007f\x06<\xb1\r\x9c\x17\x83\xf3\xeb\xc1\x93\x1ep\xe1>p\xe1#\xf0\xd4F\xf0\xd49\xf0\xf4B\xf0\xf4\x0f\xe0_,xv%x\xee\r\xf0\xc2i\xf0\xe2\x14\xf0\xe2oॅ\xe0\"\x03..\x04\x17/\x81\x977\x80K\x05\xe0\xf2T\xf0J&xu<x\xed6\xf0Z\rx\xedu\xf0z:xc\x18x\xc3\x06\xae\xa8\xc1\x955`\xe0[\xf0v\rxG\nީ\x00\xef\\\x00\xef\x1e\x01\uf542\xf7\xfe\r\xdeo\a\x1fL\x00\x1f\\\x05\xff\xbe\x02>l\x05\x1f^\x05\x1f=\x03>\xf9\x16|6\x05|\xfe\b\xf8\xfc8\xf8\xa2\x12\xfc\xc7\x03\xbe\\\n\xbe<\t\xbe\xba\t|\x95\a\xbe\xda\x04\xbe>\x01\xbe\x19\r\xbei\x01߄\xc1\xb7M\xe0\xbb~\xf0\xbd\x17\xfc0\x1d\xfc\xf0$\xf8q\x12\xf8)\x05\xfc\xd4\x04~\xfe\n\xfc\xb7\a\xfc\xb2\r\xfc:\x06\xfc\x96\f~{\x1b\\\xfd\x06\x84\x1e\x01\x83m\xe0\xcf\x00\x05\x86\xa8\xb8\xaf(ѽ\x94\xe8\x13J\xbc\x9c\x12\x87)\x9a\xa6\xe86\x8aQP\xcc\x19\x8ay\x8d\x92\xc6S\xd2ET\xbc\x85\x82r\n\x96Q\xf0_\x14z\x8e\x92\xf1\x94|\x15\xa5\x9cC%\x98\xa8\x84\x87\xa9\xc4^*)\x8eRӔz-5,\x81\xbai,u\xd3\x17TJ?\x95\xfa\x00\xa5\x99KiJ(\xcd\xf3\x94\xd6N\xb1\xc7)\xddK\x94\xfeWj\xc4\x06j\xa4\x8e\x1aYN\x8d|\x98\x1a\x95J\x8dZD\x8dz\x99\xbay\x0f5z\x1f5\xa6\x96\x1as\x91\x1ak\xa6\xc6>I\x8d\xfd\x88\xbaEL\xddBS\xb7H\xa9[fS\xb7|E\xdd\xfa\x155n\x1e5\xee\rj\xfc\xb3\xd4\x04\x195\xa1\x90\x9a\xd0KM\xf8\x92\x9aXDM\xac\xa7\x8c\x85\x94\xf1]\xcat\x8c2k(s:e\x9eK\x99\xd7Pf'e>OY\x8cT\xc6\x02*\xf3E*\x93PY+\xa9\xc9>j\x8a\x8a\x9a\xb2\x9a\x9a:\x82\x9a:\x9f\x9aZCM}\x9a\x9a\xfa\x1f\xea\x8e)ԝ\x15\xd4]EԴ\xffR\xd3?\xa0fZ\xa8Y6j\xf6W\xd4݇\xa89wSs\xfa\xa9\xb97Ss\xb7S\xf7\xae\xa3\xe6\xddE\xcd#\xd4\xfc\x1ajA%\xb5\xf0SjQ\x1f\xb5\xd8A\xddg\xa1\x96,\xa0\x96\xb4R\xf7\x8f\xa6\x1ex\x83Z\xfa\x1e\xf5\xa0\x9dz\xb0\x9ez\xf0#j\x99\x96Z6\x9bZ\xd6E-O\xa4\x96\xf7S+\x18j\xc5zj\xc5Yj\xa5\x82Zy\x98Z\xf9.\xb5\xea\x18\xb5\xba\x97Zs\x1b\xb5\xa6\x98\xe2\xac\x14w\x90Z{\x17eK\xa2l\x1c\x95}\x9a\xca\xf1S9[\xa9\x9c\x87\xa9\x9c\x17\xa9\x9c\xef\xa9\\?e/\xa3\xf2\xe6RyoP\xf9\xb7Q\xf9')\xc7h\xca\xf1*\xe5\xdcG\xad\x9bB\x15\x98)\xd7]\x94\xeb\v\xca\r(\x0fEyWSޗ\xa9\xf5\xbfP|\n\xc5_\xa4\x8a\xceQ\xc5\xcfQ\xa5\xf7Peg\xa8\xf2ש\r\x9fP\x15\xcdTe\x0e\xb5QAm|\x9f\xaa^B\xd5\xd0T\xdd\x11\xaa\xee\v\xaa\xfe~\xaa\xfe\x01\xaa\xfeA\xaa~9U\xbf\x92\xaa_M\xd5sT\xbd\x8d\xaaϡ\xea\xedT}\xfeП\x01,\xd6J\xf4XLa1\x16\x87\xacX,\xc2<\x16\xab\xb5\x92\x11!\xabv\xf0ӈ\x95!b\"V\x87M\xf3\xe1\x90I;\bh\x85v\xf0mň\x95,\x86ZIP\xa4\x95\\\n\x95\xab\xc9\x1e|;ރo\xbfx\xbc\xef\x03\xc7Ŕy8\xceqqj\xdfBG\n\xd9Cn\x93\xe0\x97\x98C\x90\xa8>f\xc9K\fكo[x|j\xb1ca\xca<\x12\xe7X\xd87\xf5\xa2#\x05\xef!\xb7\x93=\xe4\xf6\x85\x8e\xe2\xa9\xc7\x17\xa6\\&q\xc7\x17~P|\xf1x\nރo\x93\x90\x97\xf0a\x16\xbf\xc4\xe0=䶋\x8e\x0f\xfa\x8e_L\xb9\x8c\xe3\x8e_,\xfe`\xe1\xf1\x14\x05\xf9\xb3\xaf\x18\x17\xb1\x11SȄ_f\x89\xb5\x97\x8d\x98\"&&z\x12\x1e{\xef\x1b2ELo\xb0\xd8z'\f\x99B&f\x1e\xab\x18\xaa\n\xe0\x81p\x1a~\x9c\n\xa9\xf0\x80(l\xc5G\xd5{\xcbz\x8at\xa4\x9e.*++ғz\xa6\xa8\xa7l\xaf\x0e\xd7\xd3{{z\xf6\xea\xf1\x1b\xe1\x0fո\x8c\xd4\xe3\xbb\xc8:\xb2\x96L4\x919\x04\xe8\xaa˶\x15\xedވ3I\xe2\xf0\xc6\xf6\xf6\xcdA͎\xce֎v\xfd\x8bo\xef\u07ff\u007f\xb7t\xd7\u07b3]\x8fk>\x1cX~\xff\xbc\x82uw:\xf4\xc6\x1c\"\xbb\x99\xc8S\xd70E]\xce(\xed}\xbb\x1fګ\x0f0\x87N\xbe\xf1\xe21\xddw\xfdX=\x80\x97\xa4*\xfa\xfe\xf6!\u007f\x86\x82!\x15.b\xb5d\xdam\xacV\xdc\u007f'T\xf4)\xb0\x8d\xa5\xc2A\x1c\x14i\xe9\xfdZ\xc9\xdd\xea\v\v\x82y:\xed`5\x9d\xb7\xa0v\x85{\x9f\xbb\xb7D\x1f\x96\r\xc9$CA2\xc0\xb4\xb3\x83уӁp\x90\x0e\xcb\xc22Io\xef\xbeC\x87R\x15}\xc5\x14\xae\x808\x1b\x8a\xe6C\xadd\x84Zx諩m\xda\\\xab\x1b\x1a AI\xceFO\x9e-\xf5\xdaJ\x9c\xc0\x86\x973\xe1 \xae\x91D\x8e\xe1\x04V1\"\x80\x83\x83*,g\aU8(*\x85a\xeb\x1d\xb0\r\xea\x15}D\x15+\xe7\xc3A<\x8f=\x00\x8f\x1cg\xb5\x83Ӷ\xb0\xe1 \xd3\xe7\xdfWt\xa0\x04\xdfL\xe2\xf0h\"\xc6z\"\x95\xce\u007f\xe7\x9b;\u007f\xd34\xd3\xcd\xf4o\u07fcw\xe9\x1d=\xd6c)\x1e\x8d\xc5\xf8f\x1cw\xa0w\xdf\u07be\xfdҡ 3xBl\xc9\"\xa2{\x97\xe9H\xea(\x92:\x92\xa4\x8c\x94\xae]:a\xd2lM\x13\xddDϞ\xf0\xf5\xb9\xa5z\x92\xf2;I\xfd\x9d\xa4^\x95\x1a\xdeZ\xf2\x13\xa6SgA\x1dn\x80\xa7\x1a8\x9f\x1e\xabN\xaa\xd7\vb\xd0ƙ3\x8d\xfak\x03x\x13T\xf4-\x83Z\x06\x88\x06UD\xa5\xc6\x0f\xc2=,\xa9\xc7^\xb2\xba\xbd^\x1f\x16G~\x92Tuv7tk\x14x5{\x00\x0e\xcaՏ\x14t\xfa\xb7\xe9\"M\x03\xa4(\xb4R\xb2\xa7\xb6|/\xafq\xc0\xd0\x03\xa4ZR\xdf\xd9\xd5إ9Թ\xff!<\x1b\xe2լV\xbc>tH}%\x92\x15\x91\xe3\x95\x11\x05Y9\x10\xca\"/\xe2\xa7B\x93_\xc6\xf7\x85\x92\xf0}\x91\xa4\xd0\xe4\x05\x12\xbc\xfe\xa5\xff/T\xf7\x1dĳ!\xf9s\x19̇\xa2|\x88oR\xe3\xe2\xa5\xf8g\xf2\x84\xceEn\"z\xb2\x8c,#7a\xbd\xeb\xaf\xc30\v\x06\xe3\xb1Q\x1f\xbeFc#\x06?`\x8b\x9e\f\f&\xaa\x97\xc2\xd3P\xa7\xc0\xe3p\x12{\xe3\x00\x1e\a\xf1*\x18R\xcds\xc2\xc1\xe0P\xf0\vx-\xa8\x15W\u007f\x0e\xf3\xa1\x96T\u007f\x0e#\xaap\xf0\v\x18RETN\x88Uؤ^\xcf\xe2mP;ؿ]x\xc2\xc67\xc1\x11}\xc5\xf82\x1b\x0e\x86\x82\xea\x1a\xb6\x14\x8ak\xd8AUDu\x03W\xe0f\x18\nⳋa\xd8*\x9et\xfd\x05ԐJ+\xee\x8fZ\x98\x8e\f\xd07|s鹵\xfd:<@\xf7\x9f;\u05ef\x17\x06\xeec\xa3\x03\xf7\xb1ѩ\xff\a\xa5\x1a\x88\x1f\x85\u05ec7f\xe31PK\x80Vܿ\xf8\xff\xe5N\xd8*\xb6\xfe\xb3G\xd1ץ\x15\x1f\xa0\xb0\\K+DZz\xd3\xe0\x1a\xf5\x81\x9a\x03e=:\xa2\xc5\xda/\xe1\xd0\xe8\xa11\xe4<~\xf2KH\xb4D\x8b3Y|\x8d\\\xc3\x02:\x9d=p\xa0\xa3\xa7G\x8f\xb5X\x1bv\x84\x1d\x92\x9e\x9e\x8e\x03\aR\x15\xe4ϖb\n\xc7i\xc5\aDZI\x12^w\x83\xdcy\x1c\xfd\\\x1f\xf6w\x1e\xe4\xbc\xf0\xc1\xe7\xff\xc7\xe3\xfc\xf5\xaeL\xf6\x9f\xac\xa2\x14\xc8\xf9\u007f\xf0\xbe\xc1Wp?;\x1b\x12\x1c0\n\x82\xaa\xc8`\x10?\x11=\x9d\xa4\xaf\xffz\x85\xf0\x84gb\xbfb\xc1<\xe1\xe9>Vw\xb9\x00b\x1e\xf3\xf8\"\x8bUG\ue007\xa1\xfe\xef\x94\x04WV}\x1a\xa5\x13\x19\xb8\xfe\xfb\x97\xd0@\x01\xfck,\x89\x1d\xdb\t\xb1\xe82Ty\xa3\xaf\xc1\xf0&\"\xc6<y\x10\x8f\xd5\xdd\u007fnr\xb5m\xc9\xda\xfb;'?g\x93\xe2a\xe3\xde!\xe3\x88%\xf5}6z\b\xfd%Ėw\xf08<\xecG\xdds\x8fv~\xb8\xf69\xdb\xf3Sv,Y+%\x0f\x92\xb1DLx\xb2)\x15?\x0e\xb1\v\xea\x86T\xe1\xa0\xfa\xcd\xfb^\x98=\xfb\xbe\xfbf\xeb\x86b\xef\xc4\xf1\xe7l4\xb6D\xf9cճP\x84\x83\xdf\xdf'\xc8=\x80ǰd\x00'\xc1\x90\x80E\x04lЊǰ\u05ec8\tj%\xfdx\x8c\xe0\xfa\x1f\xb1\x8a\x15\xec`0\xac\xf2\xb0\xa1 \xa6Ի\xab\xba\xcb[td\xde\xc35G+\x8f\x96\x1fI9v\xfe\xe4cǞ\x90noki\v\xa6\xb6oiol\xd5\xd9^\x9c\xfd\xf2\xd2\v\xd2\xd6\xfa\xdam\xb5\x9aZ\xe1a]OF\x8dp\xef*\xectu\xa4x\xf0|\xc9\xee-\x1bv\x96i\x88\x8f.\xab\xacڰI\xbfaQý\xf5\v]5\xae\x8d\xee\n\xa2\xfbcxk\x9d0\xd1I4d\xec:\xbf\xbe\xb1~S}mj\xed\xb6\xba\xd6z\xdd\xc95'\xd6\x1c[\xb9\xa1pcaM\x01Y\xe8\x19^\xb1\xb9\xaa\xac\"\x95\xf8\x98\xf2\xce\r\xbb7\xeb\xf0<o\x87\xb3k\xdd\xee\x82\x14\xe7\xaa<ιZ\xdaX\xbf\xb9\xbe6\xb5nk\xdd\xf6zݣ\xf7\xbd\xb9\xa0\u007f\xa5\xb4\xbe-\xb8)\xa8\t\x06[Z\xdb\xf4x\xe4\x1f\x87*\x1e\xa9:\\\x93\xf20\x99/)\xdfڽ\xb1G\x83}tOWg\xf76}\xf7K\xad\xaf\xb4]<\xdcqx\xe7\xa1]XG\xc0\xf0\xc6va\xe61\xac\xc1\xb7\x1cٯo\x8d.8\xb8)\xd8Ц\xcb{<\xff1\xe7\x85\xeeC\x9d\x8f\xec8\x82\x17<<\xbc\xbb\xa5\xb3g\x97`\xe368\x18\x8c\x9ea\xac\x85\xd7\x0f\v߀\xa1`\xf8\xac\xba\t\xe2`h\xe0o@EnU\xaf\xf4z\x1a\xf1\x97l\xa3\xf7\xe1'\xf5\x83s\aU\xd7\xe62C\xa6\xc1\x8bo\xb1\x17J\x16\x9dY\xaa\x11\\\u007fQΊ\x12\xfdl2EB^~\x0e^\x9bO\x93\xb8+\x86/tx\x19;\xa2\xaf\x98*\x858(ʇ\xa1\xb3ꭁ\xadM[u\x91+!w\xe4J\xc4-\xd9ҴeKj\x84\x9f\xc0\xe2\xa3\x10\a#*|\x06b\u007f\xe8\t\xf5\x8e\x9d[\xfa6\xee \x1e|\xef\xf0\xd0D7K\x1ed\xc8f\xbc\xd6v|N\xc0\xe6H\x19ʉ|\xbf\xb1zK\xf1\x8e\x8d)/O\xdf\xdeо\xa9\xbd)%\xd8\xd4\xdeҶ=<7\xf4\xf6\xf0\xf0\xdc\xc8ے\xc6ֶ-\xed\xd1\xf7\xc6\xed\xcd\xed۶\xb7\xbe\xfc\xf6p\xc5.v0\xf8\a\f\a?cG\xb2!\x13-\xd8>\x1f2aU\xe8l\xec\x14%t\x16\xabB&̋\x06U\x83<>͒\xab\x84υ\xd8\x01C\xa6\xef\xd57\xb3x\\_1\x1e\xc0V;K=\xa5e\xb4\xa2\xc1\xefC'\xd5\x1b\x9a+\xaa*4d3]Q\xd1\xd9ݬ\xdf\xc6\xec\xc7\x03\xf8#\x16/ğ\xb0\xfbɀd\x13\x13\x0eD\xf2\xd5g\xaa\xcfT\x9c\xd0Ẽ\x87$\aj\xbc{\xbd\x1a\xf25\xfe\x84\xddE\xac\x92\xb2捕e\x1a2\x99.\xdb\xd8\xd5Ӭ߅\xad\xf8#\x96|M{\xbdE\xbe\x1a}\xc4|퐤\"\xbf:''\xd5\xddΝ҅\xadty\xa50\xae\x17\a\x056\a\xf0'l/\tJʚ++\xcb5a+}\xaa\xfd\xb1C\xd1\b\xf01[\x03q9\x8b\xad\xe7a$\x97\x16\x02\x93$?oe\xc92Ͳ\x95\xbd'\xf2\xf4D\x83\xb5\x92\x88\xfdyV+\xe9\xbf\x13\x92\xf7\a\xe5\xea}5|\xf7z\r\t\x86\xac4\x9e\xccF\xac$\xc8\xe0\xe7`\xc8\x1a\xb1\x0eǐ\rYC\xd6==\xdb\x1f\xaeٟ\xa2X\aC&\x1c\x14T\xa5\xca\x1c\xbc\x1a\xba:\x00\xf1U\xb7\xa0.+\xb3\xb1\xaa\xae\xaaQ\xdfXղ1X\x83\a\xc8\xc0\xf0oa{{Ԣ\xaf\xe0+\x12|\x1fK\xae\x86\x06\xcc\xf0\x1f\x14~\rߪ\xde\u07b9ygm\a\x19\xc0\x03\xc3[\xeb\xebZ\xea4uu\x9b\x1b\xea\xf5\xe4\n\xb9\"i\xa8\xdfTW\x9b:\x06\x06\xb0\x95\\\xfd\x88\xc5W\xb1\x95\xd9\xd9\xd9\u07b9]O~\r\xf5\x99a_\xf1\xe0\x00\xb6\xbe\xcb\x0e\xb6\x87\x06\xd4\x13\x89UB\x06\xe8\x89\xd8*!o\xd0[\xb77\xb7nݎ\x1d\xa1\xfa\xe1\xe1e4vD\xea\xb7667lmL\xc1\xcb\v\xd5'\xaa\xfb+\x8f\xe9Hm\xe8\x82\xe4he\xceC\x0e\xcd\xd0\x00\xed\xc8Y\xbf\xaeROj#\x17$\x95\xce\xea\xa5\xf9\xa9\x91b\\\f\x1f\x82Zq_X\xa5\xdeW\xd5W\xb2C\x179\x8b\aJ;}\x81\x92ꔒ\xaa\xaab>uH\xc5`\v\xbb\xa3\xa5\xb5C\x1f:\x8b\a$\xbd;:\xfb\xf6\xa5bհ\x9bY|\x05\x06p0\xf9\xe4|\x88\x8f\xb3XƒaD=\x1c\xa7\x90\x91\xe4\xfe\x0f\xc8r\r\xaeaq\x19v\xfd\x17\xcf\xc2c\xf5\x1d;\x03{\xaav\x10\x16놷\xd6\u05f6\xd4jj\x9bj75\xd6KI\xf28r\xcbLb\xd4`\x15\x8bo\xc2\t\xcbM\xdf\xea\x93\xdf :\xa2\xc3\x16\x18\xb2\xae\x16\x98`7L>\xa9\x95|\x1d\xe2ջ+w\xe17X\xba\xa2\xa6\xb6\xb2Q_\x9eSe\xf7\xe7J\xc9\xc4\xdbI\xd6$r\xb7\x86\xa8I\xe2S_ܦ\xc7,fO\xc1\xdb`\xc4j\x84\x11+\x93\xfc\xc6\xcb\x10\xab\xb1ZB4x\x14\xbe\xefN\xfc\xa0&$\xc1\x8f\xe3\x00\xfe\xe3S}\xf3\xd6@\xcb\xd6\xd4\x03\xe1\xb3\xea\xf7\xa2\xf2Kp*\x19E\xee\u007f\x9f,\xd3D$\xe4I\xd2D\xb0Y\xbf%\xb0yKjyWE\x8f\x0e\xaf\xa4wu\x04\xbb\xb6\xebw\x9f\xe9<\xb5\xff\xb4\x14O\xfc\x06g}\x8d\xef\xd6`5N\\a\xfcN/H^\xc7\n\x82\xf7\x05\xfezWq\x8b`\xaf*QH\x85\xaf\x8eb\xf1\x80x$\x8c^JaĪ>'\xe07.\xaa!Ս\x02t\xe0\x8dh6p\xb3C\x03\fV\r\x0e\xfc\xdf%a\xe4Ͼb\xad\xb8\x1f[)l\x13j\x0e\xab\b\x0f\x84\x83jle\xae\xb2\xc4\xca\xe0\x81\t\xd8\xc0\x16`+\x8d\x9b\xc8\x1a\xbc\x864\x91&\xb2\x86\xac!M:b-x\x13*\xfaV\xb2\xf8\x19\x88\a\xf0q\xd6ɮ>\x90}\x9a\xd7i\x89b\r\xbc\\\x00\xb5\xe2_\x99\xd3\xfb\x0e\x9c}\"U+韷\x86\xe5\xc8\x1dDK\f\x1a\x97{{\x97G\xff\n\xd1\x13\xe53Ġ!\xa3\xc8m\xf7\xcf#I\xfa\xf5\x1bݕ\xfe\r\xd2\xd2ʪr\u007f\xeaP9cd;;ZZw\xe8\xde\xc6:<\xe5\n6b\xf7\x17d\x04\x9e\xaf\xc17\xe1\xc4g/b\x89\xfeЮ}]'S\x15#n\xac \x80\xadx\x00\xef\x10\xa4\xb1\x8a\x0e\xc0\xb0N\x1d\x9a\x14I\xc4?G\x1e\x8c<\x18I$?G&\x11\xaaw\xe4\x99[u\xbd+\xf0\x82u\x12B\x1d\xfai2F\x9a\x82\xe9lh`\x11;o<,`&d.\xbd\x83\xd3\xcf锔\xbe\\s\xf9\xb5T\xfc,~\x06\xef\xc6\xdf\xe2o\xf13d7yvnͼ\xd2\x05\xba9U\x92\xf7\xb9\xcc\xfe\t\x9a#L\x13<\xc2`\xf4Ӈ\x98:\xa4\xc7\v\x8e\xf6^\x90\x9c\xf9\xa9\xf7wL\xa5*\xaeU\x1dъ\xfb\xa9@8(\xcac\x15\x91?\xa3-!\xa5\x88\xf2ؐ*tV\xfd\xf3\xc2\x1fo;\xa9\xdbVW\xdb\\\xa7q\xb8=\xf9\xfa\xbb\xb0\xb4\x8fȉ\x9cH\xfb\xeeJ\xcd?\xe49\xde\x1el\xde֮\xfb\xf6\xd4\xc5\x1f~NU\x8c\xd0J\xe6\x94\b\x04\xeee\xff\u007f\x11\xc0\xc1a\x98#\n|\x1fY\xfc\x01~\x9b\xf5t\x97\xee\xab\xd2\x1f\xac<P\xb5\xbfL\xfa\xd3\xcc\x17\bC\x14\xa9Dq\x1fan\x9d\xa9\xf3\x97\xf9\xaa\xbc\x95R\xbejC\xa9'u-{\"\xfa#\x19\x1d^\xfc\x01V\xe0\xfb0\x17\xa5\xf5\x12\x99\x87\x0f\x93\xe3X\x8e\xdffݻ6\xec\xaf\xd4w\xd5u7\xee\xaa{\xb8\xe2\xe1\xf2\x87J\xa58\x93\xc8\u007f \xf7\x93\xb5\xa9d\xedxr?\xc9$r\xdd\xfaRO\xb9\xa7\xa2\xa2nCce\x9d\xd4_Y\xb1\xc1\x9djd\x831\xda\x02\xa1y\xf80~IȽ\x01\xac\xc6\xc9X\x85?\x86\u007f{W\xf7\xc8\xdf\x1b\xaa\xe4OB\xf8(T\xe3W =\xa8\x12\v\x00gǚׂגա\xe4\x90*\x92\xccDT\xe1v5>\n#*&\xa4\x1a\x16C\x86T$\xa8\x1eR\r\xa9\xc2*\x1aW\xc4\xe6\b啚\xa8\x88\x8aN\x1e1\x1c\xab\xe8\xb0J\x1ckmO\xb3\xc1\x88jH\xe8\xcfĪ\x90\x8a\x16\xf8\xbe\x06C*z(\x88\x8f\xb1\xd7Ʉ\x83a\xd5\r\x92\x8a\xbe\xbe\xe2\xbd\xc5I\x81K\x87\x8f\\:\x8c\x93\x0e\u007f\xf7\xf2w\x87\x8f\x1c>r\xf8\xdbK\xdf^\x12\xfe\x1d\xbe\xf4ݷ\x87\x8f\x1c\xbe\x94\\\xf5g\xff\xa5a\xa1\x82@\xc8\x19r\xa4\x16\x17\a\x8a5\x11g \xb2.\xe2L\xed\xeb\r\xec\xd5\\jV7\xc2\xea\x9a@5\x0f;\x83\x81\x1d\x9aok\xd9\x1d\x1d\x81\x0e\r6Բ\x9dA\xfc0,y\x06\xee\xed\r\xf4jp\xd2\xf5;\x97\xfe\a\x93\xfb\x00\xb81\x1a&/\xef\xaf\u07b7'\xd0\x17m\xec\xbb>E\x98\xdb\xf7?\xe4\xdbZ\x18\x1br\xdf&X\xe0\n\xb8x\x98\\\r\xfa\x8f\x1c\x0e\x1c\xd6\\\xdat]\x8c\xe4\xbb\xef\x021Q\x9a\xd4!g \xb4.\xe4\xfc\x9f\xdc\xceȺ\xd4(m\x85\x1b*\xf087\x14\xf4]\xc8\x0eq\xe15\x92\xa7\x97\x8d?3KCx\xfa\xad\x1fr\x96=\xad\x0fsCk$\xfb\xfc]뜩\xce\xcau<\xaf\x8b\xac\x8b\xac\x938\x8e=\xe4\u007fFs\xech\u05fe\xfd\xfaPU\xa8\x1aO\x83\x8a\x1168\xa8\xc2\x1f\xb3ѓ\x9a\v\x9f\x88\xb4t\xfe\xe09uEoq\x17\x1fl.\v\x944\x94\x92\xfek\x9e\xe1\xf9\xd5k\x8b\xf34\xd7&\xd1ֻ;_(\xd1\xd7\xedm\x0e\xb6wJ;\x83\xdd-;5\xe10\x8d\x93\x1f:\x86o?\xaf\xc7\a\au]\xe7\x1bί?\x91r\xdcg\xeb[\xa5\x19\xdaJ\x97\x977n*\xd77\xb7\x90\xe4\x13u\x84\xb5-H\xd9\xc0\xfbK6\x95n.\xeb(\xdfRް4g\xa2\u007f]EIQM=߰\xa1\x83\xdfRܸʑ\xee]]SVZ\xd9X*m,\xef(j,\xad~\xd09\xb9p\xf9\x06oq\xf9&\xff\x96\xaa\xe0Ʀ\rM\xfeM%[J*\xed\x01\xae{\x8d\xf4擘\x9a\xfc\x8bfp\x12\xfd\xd9\x17\xaf~z@\x8f\x1f\x1e\xd4u=Q\u007f\xdew\"\xe5څ-\x1f\xb0\x99$\xf8\x01\xab\x88T\x8d\xd2J\xbe\xa5\x025P\xa4\x157k%\xd5\x1b\xe0\b\xa2\u008b \xae\x80T\xf4E\xa1G\b\xb3\x82N\x83\x83*\x12d\xae\xa9\xc2*uX\x15V\x11\x13\xb3\x04\xea\xb0)\xa2\xa2qp\u07bfᰫ\xa6\xcfG\xe9\xe6\xe1 \xdd_8`?\xa5#\x13\xf1D\xc9)\xfb\x8cG\x96j\x86L\xbdl\xe4\xec<f\x94\xc94J\x1f1E\x9f\x90Lx,\x8bMѧ#\x05\x1e\xd7\a\xa9\x80\x96\xa9\x16\rZ\xc3W\xd57\xfeF\xe1\xc6oIn\xfc\xf5\x026\x125V\x13\xa3>l\r\x99Ԅ\xa7\x97a\xad\xe4U\xdf\x1d\xff\x9a\xad\x89\x04\xe9\xd9w<p\x8fO\xbf\x8ch%\x84\xa7W@\xfd5\x15\xf3\xff\x10\xf6&\xf0Q\x14\xdb\x1e0\x98\xf4\xcc\xd8^s\x95\xe9Ƥ{\xba;\n\x82\n\"HDE\x11p\x01%\xac2\xec[ؗ@\bIȞLf2\xfb\xbet2\xd93Y @\x02\xb2D\xd95\x80\b\x1a\x15Ԩ\x17\x97{\xf1\xaa\xa8\x17w\xd1\xd33g\x86\xfb\xfdz\x02\xbe\xfb\xbe\xf7\xbe\xf7\xcd\xfc~\xd3\xddէN\x9d\xaa\xe9\xaa>\xa7\xea\u007fN\xe5\x93\xfczR1\x8d\x13\"\x14|\xc3Ŵ\x1a\xac\x18!W\xef\xfb\x91\x97\xa59p\xdb G;\xa9\xde\x1f\xde\x18YK\xab\xb3\xdf\xc7{\xcf\xe3C\xaf\xe1H\x95\xbd\xb0\xd0^\xc8\xdew\x9f\xdby\x9f\x10}-6\x84~\xebMw\xf5[\x82\xab\xb6\xd9W\v*\xb8\xb5\x00T\xacSt\x8a\"\xd3\xech\xb27\xf1/\x06p\xb8\xfbó\x9f\xa1n\xc7Y^\x9ao#\xeaW\x01\x8d'\xd73\xeb\xe6\x1b\xb3M<\u07b5\x95\xb0n\xb3o\xdb\xc6x\xe0.\xb8\xab\x1a\xee\x025\xa8K@͊\u0380\xc84Y\x9b\xacM\xfc\xd2Ƈ|\x9bv\x94U\x97\xed6\xd6/\xfae\xc3\x1f\xcb\u007fS\xa9\xf7O\x84g\xa7\xc0\xd4I\xf0\x1c\xae\x94\xf2\x92\xad~\x8b\xdf\xe2W\xcdWLR`Wl\x03\xea\xa3ѕ8\xe0\xde\xe6\a\xb3RlO\x12I\xf8oL\xf8.\xfc\xc8\x0f\xd2\xf1_\aA.\xbc'\xad\x86\x8f1\x00_\xab%\xe9\xb5::\xf7\xac\b\xb3.^s\xfe\xfe;S\xd5\xf1\x8ac7\v\x8f\x00S\x89\x0f|+t\xcd\x0fL\x9b\xc1\xa0\x15\xef\x1b\x8d\x85K\x96\xd44\xad\xe0\xcb\xc2\xe5\xb4\xfa\x9a\xbd\xd5!\u007f{\x9c==\u007f\xb9W-\xfd\\\xf9\xf3ό\xff\x95W|\x87Yx\x02&?\x8f\v\xb0\x04\x87\xcf\xc1U\xeb\xd79}\xeb\x85\xe8\x81\xeb\xb7\xd3~\x98\x02/\xd4\xc3\xd4/\xbf4}\xc9\xc2Qx\a\x14\xf0=\xac\xc3\t@㋯\bpw/hޅ\xa9\fԠ\v\x06`>\xaf\xbe\xb6|\x99s9\x8bz4\x14[\xd0 x\x1e#\xd4R\x97\x05\x86\x1b\xeb\xb2TmKm\xd7gog\x9e\xf7\x0e\xd90\x9b\x9f\xb1m\x9a=\xbd\xc4\xf6`rRLw\xf7?\x1a\xf2\a9\"\xa2:\x03\a%z\xbf\xfcg\xddW\xac:t\xe1\xa2\xdd~Q\xb0\xbf\xfeF\xe1\xeb-!w\v\x1b\xe8\xf2u\xb9\xbafT\xcd\xf0Ϭ\xb57&[^>\x01K\xaa\x8e\xf7@U/\x04\x8fC\xb0\xeau\xef\x1b-\xa7.\xec쫾\xd8\x16\xd8\xe5\xdf\xed٭2\xdd}\xb7\xfen\x16\a\xe3]U>\xa4\x85¡\xe7\xf4\xef\x06NoIQg\xe4(\xddO`\xd6\xf5\x8d\xe5S\x8d\x1b*\xd6\xe5e\xcd\xda<\xa52\xbd@\xa5\x0e\x15\x8f#\x92B\xf8W\xf0DD\xf0\xe0\x83@\xe1\x1dp\xe7 \a\xdc&%Cu8\b\x94z\x9fFq\x11(\xfa\x89\x88\xf8\xa9\xe2pmǾlA\xfd.\x0e\x82q\xd9\xfbV8\xb27\xa4\xe0 \x1cGlȮ\xddz\x98\xfd\xf4\xba\xf8\x84bE\xd9\xd6\r;\x05\x18\x84\xe3vn8\xecع/\x05\x06\xc18B\xbdo\xdfβ\x8e\x15\xac&\xac\xd7$\xfe\x1b\x0eq*u\xd39\x8f\x03\x96\x1e\xefR\x85v\xfdѰ\x9fm\x80\xbbܥ\xb5B\xb0\xcc\xf2L\x11\x83\xb7*q\xb9O֖\x98\xe9nl۾\xb8\x8b\x84\xbdV\xd8k\x87\xbd\xbcz\x9f\xfd\x88\xe7\x98\xf7\xb0\xaa\xfa\xeb\u007fY\xae\xb0{Z\xbd\xdbB\x82\xcb\xd6\x06\xf3\xb9\x83\xfb \x9b#\xd6f牫Y<\xaax\xe6Y\xe73\xf0\x06\x99\xb4\x92\xd4$\xfe\x13\xe8\x81r\xad\x1a\xc1\x97\xf0\x99\x86\xd0\xc1!\xee\x9c\xd7\tˎ\xedQշ\x81\xae\xe5\xe5\x9b\x02\xd4\x15\x1bQ\xb7\xaa\xd4[Zƌ\xf5G\xb7\xe4\xcc\xed\"a\x9c\r\xc6\xd9\xe1Q~\x81\xd2\x0e#\xfbKO\xa9\x85\xdb\xe1\x1e#\xfc\x95\xad:\xee\xb4\x06\x84j\xd9$68m\xed0\x9f;\xf0\x12ds\xe5E\xf9e\u06dd)\"6bȄ\x8d\xf0\x06\xa9I\fj0\x85^V\x9fZ$\\\x17\x15\x8d\xf5\u007f,ۀ)x[~6_\xaa\xab(.`\n\xaa\x8b\xebt|\xfeNH\xc1\xdb\xf6\xb1GK\xfeh\x14\"\xa2\xa2\xa8$\xf5\xe8>H\x81\xdbB;\xf9\xba`uC3\xd3\\\xd1P\x1a\xe4C٘\x02\xb7m`\x93VqQ\n&\x90\x1a\x1c\xf08\xa7'Gr \xcaW\x91\x01\x8fs\xd3ɑ7\xefU<\xce\x15\x92˸\x1b\xb4שǹ\b%\x93J\"]L\xc6\xd3b\xd4\xe3\\\x98\x92I$1\xf1\xc1\xffb\xd7\u007fu\x93ݍ{\u007f\xb2\x93\xc4\xc4g\xff\xe3')\xe48M\x0et\x80\x98\x00\"\xe4\xd1\xf0WN\xeaK\xec\xffm\x94\x99h\x88It#)\xf5%\xfe\xdc\xff\x93\xb4\x89\x93\xa8H\x1e\xbd\x80\x8ccv\xba\xb1\x9b0\x99\xac\x86J\xa6\xd2m\xf0\x99x\x88'\xf8Dk\x80\x9d\xc0\xad'Q\xbb\x90\xdb\xc4\xc1\xb5\xb0\x96\xee\xc7\xd3wC7\xe13\x19ܕ\xecTN\x88\xf6m!\xa1\x91\xe3!\x9e\xeas\x8b\x01FC\x84\xa2y\v\xb9P(\x1ffp\x9a\xc4\x10ݏ\xaatW\x1a\\\x95lq\x89\xa9\\'D\x87G\x87\x11\xf0\xb8\xf2~2\xa65r\xb1\xff\x00\x00\x86\xb5\xf7r0^)\r\x8f\x0e'ʃ\xf5\xa63\xf28\xbf\x8f\x04mD\xdb?%\xda.\x1b\x15a*\xa2\xcd\xe6\"b\x94\xa2\xa3iRZT\x8c\x8a\x98\x06iq\xf8\x11jדʫ\xb0\x97\x8b#\xb548I\xb9\x9b\x04\x17)\xab\xdc?\x92\x90\x96Io\xe3\x1c\x9ap\x8fr\x15\xa9\xc1I7gJ\xe1I.\x01\xfe=8\x15\xde%\xffP\\\xb9\x02:\xd4\x11ҳ\xe1K\xb4\xc9f2\x9b\xf9\xe8\xc1\xe8A\xc2l6\xd9Ll\xb9A\xac\xf1\xb9|^\xaf \x1d\x94\x0e\x12^\xaf\xcf\xe5ckDC\xb90\x0f\xb4t\xac/\xd6G\xc8\")\xc3}\xe1>\x02\x9a\xa1\x8f\x86-\x1cꠜh\xcb\xddT\xbb\x91ݸ\xa9,7O\xc0\xf1\xf8\xb8\x94\x16M\x83\xf10^\xd6\x1cb\xbaB\x12n\xe7\xe0K.!,F\xa8\xad\x9c\xac\xb2n$\xbf\xe5f\xfa\xa7\xef\xd0\xf2\xd2\xc0X\x1d\xb1\xa2\xf9h\xf6q\xf6\xf8ѝ\x87C\x824 \\G\xec8\xed\u007f\xeb<\x93\xb4\x89L\b\x8bW\xe9)\xa4p\xbdO\x19\x16\xa5n:\x95\x8c\xe9\x1c \xa2.\u007f\x90\x03*Ɉ\xa8\x9e\xad\xf6J\xa2D\xd1\xd3H^\x1dj\x0f\xb4\x05\x9b\xf9\x9a\xe6\x9e\v\xa1wU\xd5\xee*o\x80\rT\x99+\xdcB\xe8\xf9\x9e\xa95\x05\xaa`A 7\x87Qϖ\xb5͟\xb7|\xb7\xf0S~᧸\t\xda\xc6C\xab\xea\x15ݪP&\x9b\xb9:?\xa3\\\x18\x8f\xad\xb8\t\xdb\x16>\xa1Rg,zb\xf3\x83\xc3\x18\xf5l|\t\x8a\xb0\b^\x82'8>\xa9\xdf\x1a\xbc\x1aI\xeb\xff\xeb$\xf1\xaa\xd4\rTB\xe4/p\x8d\x9e{u\xc4\x19\x05\xa8@\x05#q$\x01\u007f\x93~\xa6E\x83\xbd\xd4`\xc0_\xf1\xd7d\x83\xa1\xd4n\x10\r\xa2\xb3N\x14\xe1W\xf85Y\x14뜢A\xf5i\xf4\n]SY\xad\xf3\xf2:o\xb9M\xafOOO6\x99\xac\x95\x95\f\x0e\x82g\xc9p\x9f\xa2\xae\xcef\xa9\x13\xaa-A\xb7(\x9e8\x91\x1c\b\xb8\xablն*\xa3h\xf0\xa8\x1a֭\x0e\xacfc}\xe9\xe4\x88H\x1a\x1dK\x8b\xa5\x11\xf2ì\f\xa7\x85\xd3\b\x18\r\xa3\xe9\xfd\xfb\x1do\xae\xdb/\xeb \xc9\xeb\xf6\xcfp\xac[\xf7\xd0C\xc9rw\xf8\xe6\x1b\")5\x8e\x86\xea\xe0`}\xf8\x14P\t\xebI\xe90]c\xe8\x17\xa5\xccb0\x94\x94\x16\x95\x17\x18T\x15&c\x85\x8e\xc1M\xf0,\t\x89\n\x9f\xd7\xe5\xf2\n\aZ\x8f4\x9flPչ\xabk\xea\x99\xc6\xd2\xdab\xde\xe1p\xc0B\xf2/\xf7` \x9d\x04}\xd8BC*\a\xff$\x93\xfb=H*\r6k\xa5\x90\x17\xf7O@-\xa6)c\xa9\x8f\xe2y\f\xe1R\x18\x8b\xdb\xfdz\xa1\xd2We\xaeb=\x1e\xa7\xd3#\xd4\xf8\xc4Z[\xedf(n\x83\a>\xfbV\xf5\xd5%H}\x19\xb2YHC\xadb[}^\xab\x9e\xb7\x06D[\x80m\xa8\xf7\xd5\x04\x05\xe8\"oV\x05\xa8i\x05\x9d\xa4|T\xffM}d=)\xfdz\xa3i]\x16\x8b\xd3\xc2VVZ\x8dF!=\x9d\xb8\xd1\xc0~\xe5\xd8g\x9e\x81\x06N\xfd\xb7\xb1\xcf|\xf0\xa5\x10\xeeS\xe6q\xfc\x9f-nw\xbb\xed\xee~\xf7\xc2\x13'\xfa[=\xe5\u007f4;\x94\x84\x8fӝ\x9d\x8e\x93\x99\x9dx\vܒ\x9cٹȑ\x99\x19ccl2\xd6C}\xffe\nނ\xb7df:\x16uf\xa6HOG\x9f\xee\xcc<\xe9\xe8\xecL\t\x9f\x0e\x9f&\x92\xe0-2,\x82\xf8\v\x17\xa5$\n(%<D\xc2\xef\\\xbf\xe7\x1a\x8a(Vr@E\xa9(\x05\x94\xe2\x8d\xf8\xca\bR\xca1d\xdc\xfd\xa9\x92\xecw0\x03\x11\xc4\x03\xe4\x83$R\xd0E\"\xa5x\x93\xecOI\x8a\xe9@$\xe5Q4\xb2^C\xe4ҭ\xf1\xa9\x88\x15dR\xe8F\xb2F\xf1\x01\xd8\xc9\xf0bXA\xb6ʇ[\xc9T\x10IH\xd2(\x14\t\x1a\xc5ܛ\xf7b\x8bc\x8b?'S\u008bc\x8b\x89\xf6\x9cfq\x1f\x13\xa7\xc5\u007f\xa3F\xa3\xb8c\xa0CC\xe8\x124\xc4\x06\xe9wzO\x83\xa3cs\x83&r1\x9c\x9f\xbc\xb9!\xa7t\x03\xa3\x89LRnh/\xdd\xd3\xc0'-榓\x1ab\xd2r.*~@J\xa2b<'\\\xa74D\xc5̈́e\xdc\r\x8a\u007fp\xb2\x18\x0e\x8dB\x01\xe5dl1,'ËË!\x95K\x89-\x0e/&\xfa\xbdPb\x8b\xe1VN\xae\xc8t\xb9\xb67\xe8ڸxr\xbc\xde\xf1E\xca#\xa8\xa4\xdbn\xa2\xe4\xb1\f~\xe34\x8a[\x12j\xa5StN\x8eX\xb0\x8f\x05\x13\xb9Oln\xcf\x11dιdJX\xaf\xc1\xdb\xe9\xb7IM\xa4\xa2L6\n\xee\xd5(\xa6\x0f\x84Q\x91P\x82&1\xa0I\xfc@~\xaaq<\x8cO\xce%cű\xe2\xe4\xcfɰ|h\xcf9\xe4ho\x97\x87\xbedH\xe5\xa2\x1dRG\xb2\xd4!u\x1079|\x1d\t%D\xbe\t\x0f\x97\xb3Ǌ\xc3\xc5ɹ$\x8e\xc7\xf1ɟ\x93RG\xb4#9\xda\x11\xed\xf8\x9cL\x81\xf18>@\x86\x8b\xc3\xc5D\x12R\xd0\x17\xa1\xa0o\xa0\xa3\xff\x98 \x8f\xf6\x9b8\xf9\x89P\xac᠒T\xc2K\xf2+ZT,\x95\x9f\x17\xe5M\xc4l_/\x9dJ.$\xff[\xa6\x98\xa8ؐ\xb5k\x9f\x00\x14\xb4q\u007f\x12-\xe64\x89e\xd2\xc3t\xb1\xb1X\xa7\xe3ǌ!t\xbabpq\xf8\x02\xbc\x80/\xe0\v\x84NWb,f\x8b\x8b\xfdA\x9d0\xe6kB\x174640\r\xfe\xfa`\x90\x878\r\xbc '\xd6ˉ\r\xc1 \xff\xf5\xd7D0\xd8\xe0\x87w8\xa3.(\xf4\x13\xc0\v\xf0+\xe7o`\x1b\x1a䴯\xc7\x10A\x9d\xbf\xb8\x98)6\x96\xe8t<\xbe\xd0_\x96\x9cXR\xccHwA>\x9dJ\"5\x9d\x1c\xe4\xb8L\xaaC\x1a\"1\xfc\n|JF\x0fE\x0f\xc9\xc2\x19\x8bْ\xb84x\x1d\xae\xff\xe9\x8f\"\x17}\xe5kx\x94\x84|̗FJ#\xe0Q\xf2\x8a\\\x90\xaf\xb8\x98\x01/\x8e;Ʃ/\xfeD\x86H\x10{\xc3Z\x10?\xe6\"\x14\xa4\xd1_s\xa0U\xc2\x01\x12\xb5J\x10\xc3i4\x8e\x1c2gb\x06\xbf4;kq\x1e\xe3P~ͽ\xa4\xfc\xfe\xfb\xbf\xbf\xdfɟ\xefع\xe35\xe6\xf0\xec\xee\xf4\xf5\x9bt\xbab\xbe\xb0\xac\xa0h\xe9,U\xa5Io1\xb0F\xa3\xcbc\x160\xf9\xa7\xf2*K\xa3X\x9b\x02C\xbe\x80\xa4\xf7\xe0Q\x16&\x81\x169L\x17$\n\xb5t\xaa\\|\x1e\xf4A\x1eP\x90\ai\x1fs\x11\x11\xd2\xe8'ɨv\x14\ti+\xb9\xab\xd07\xa2\xff\f\xfa\x96\x91Q\xf1\xaar\n\x87iJ\xa4\"\x94,iZ\\\xd24e\x98\x8a\xa6ѩ$|\xc9\r\fSr\xffM\xdc+\x89t\x06\x0eE\x05*ph\x86\n\x06z8\x1c\xa8ġ\xaf\xa0\x02\x14\x190T\x05\x03\xa1\x93ā\x8aW`((@\x01C_Q\xe1\xc02\x12\x06*ah\x06(P\xf1\n\x0eU\xe1\xc0\xc79\x18\xa8\x80o\xe00\x87C\x14\x8b!\x13'\xc0\x04\xcc<\xa1\x82!\x1e\x0e\x87(1s1N\xc0\t\x8b1S\x85C\x1e\xe7`\x88\xe2\x04f\xc2\x04\x9c\x00\x99\x8bU8\xa4\x8c\x84!J\xc8<\x01\x13`\xc2\t\xc8T\xc1\x90e\xdc\x0e2\U000b9d0c\x06\x81D\x0e\xb8d\xe0\x80\x03\x81L\xc1a0\fƐ)\xc8\xc9_\xe0\xe2\xe7\xc3p\x18\xfc\x85L\x01\xeeF\xaa|.\xa7\xf1\\<\x9b\x9c\xc4s0\f\x86\x11\x90\t\xe9\xf4\x10n\a\xa9I\f\x84\xd7\xcb\xdd\xe9\xde_\xe4\xceԁ\x1d\xc98\x1c\x86\xe7\x92)\xf7\xde+w\xa5cx,@J\xe3\xa5\xf1\x04\xdc\xfd\x18=\x84C\n\xf2\"\x97\xe0'.AC\xa4\x86\xef\xa2\xf14Zц=8\xf0ޮ3\x8f\xf0as\xd8D\x9c\x1e\xd79\f\a2\xd7K\xf05z\xee\x17\x9b~\x81\x04\x06N\x81\x15l\xd0\x03\t\xbfn\x9a\xf3\x05/m\x88.\xa1\xa5\t\xf8=\xfc\x10}2\x8ez\xff!:!\xfa\x14|\x8f?@\x15\t?HO\xf1I\x1a\"\xbd``\xaf\x86\xe8I\x88\xafT^X~\xee\x19>\xa6U\xc0Q8J\xb4\xb5\xd5v\xede\xf6\x96u\xe5\xb6\xf1\x93>$\xdar3o\xe8O\xb9BL\x88\xf1D\x1edp\x9bZ\xf3\xf8\xb0\x10K\x85\xfd\xdcG\x1f\x13m\xad7s\xb4\xf2x\x14\x8e\x11a\xad\xe2\x83#\xe7.\b\xfd\xe58\xe4r4D\x8f\xd4G\xef-\x97ibBX\xb8\xa9\x97e\xca|'M\"r\xf3\xe0\x1c\xb7\xa9-\x8f\x87\xa3x\x8c\x88i\x15\xcf,\x9f5U\x1e-\xa6\x1e\x99\xf5\x01\x1f\xd6*\xf0(\x1e%r\xe5\xb2\xdbr\xf9\x8f&\x11ym\x9d0\x96km\x13\xc2qnm5]{\xe3ӟ\x93\n\x06\xf6h\x14\x83\x134\x8at\xe9MY\v\xbcYZ\x8d\\\x8b\xf2\xdc<\xb9\x16B\x9cSf?\xa7\xdcV\x18H\xb6\xb5\np\f\x8e\x121\xad\xf2\x99s\xcb/\xf0@).\x9c;\xf2\x81\x10\xd6*\xe1\x98\\r\x9c\xa8M\xf8\xe8#\xa2\xb5\xad\xb6S.k\x92\x86\xe8\xfd\xb3\xac\xb0V.k\xd2GD\xebM}3/O\xc0c(7\xc6\rt\x8f\xacp\x1f\x83cDk\xdeM\x8a\\a\xf2\xa4\x1bu\xeaoO\"O.\xa6\xbfR\xa9\xb2\x86\x1aBJ\xa3\x984\xd0\x01\x9b\x12t\xf2\xa0\xe0%\xa3\xa2$\xbe&\x8f\xb1yJ\xfc,:r\x0e\xfe\x80\x82\xb0\xf2\xee\nTb\"\x83\xa9\xefa\xfa\xebH\xf1\xd5yՙu\xf9*\xbd\xdfo\xace\x9b\x83\xf5M\xf5\x96F{\xbd`\xed\xb6uw3\xd26x\xe9\xef\xd23<䡨x\x93\x94DI~\x11/\xe6\xc2\x16)\xcfD\x86[\xc2\xd7\xec\\\x12*5ĕ\x815\x1a\xe2JBX\x94\xfa\xec\\\xd8\x12\xb9f\"\x93\xe4A\xeaj8M\x1e\xa4\xfeO\x1fk\xa04x7]TVZ\x1c\x8fePZ\xd7(\xc0\x8b\xcaƺ\xba\xb8\u007fZCmi\x91`\x83Qt\x99\xbd\xb4\xa2\x88\xc5\x19\x8a\xc2\xd2\xeaZ\xa18<\x90\xf0\xbb\xfd~\x0f\xef\xf5\x89n\x91\x85l\x85(Z\xcd>\xc1oq\x1b\x8dL\x92#\x8e\x9e\tr\x03\xc1\t\xd4U\xd0@\x1fh\xaeʺm\x1a<Oo\xc8*\xde \x97\xb5!\xaba\x9fpF)mP\x82\x16\xfaP\xab\x8cnP\xceUF\x97\x86/\xd3\xf33^\xe9YB\xeem+*\xaf\x96\xed\xf6G\bI\u007f\x95\x9e\x9f\x911\x9f\xc7\xf9\n\xb8\x13\x1e!j\xaa\xdb\x1a\xf7\xb2qԼ\xf4\x17\x14_\xe4\xa2\xd3\x15p\xc7O?\xc1\x1dp\xc7\xf0\x9f\xf0\x8e\xe7gl]\xbcF@\n\x1fG\n\x1e'N\xac\x99\xd1\xf1<\xdb\xef\xca\a[H!:\xfd\x01\x12\xfb\x86\x92W\xa3i\x17\xb9H\xdfS\xa4\x94\x06˹\xa4P(?B\xc1?\xbc\x9c\x9e\x84O8\xb4\xe3C\xd3\xf0Q|\x81_\xb2|Œ\xc5+T\xba\xf22c\x11\v=\\\xa3\xbf6X#@\x00J\xa0\x10\xee\x83?X\xf9\x95)\x89\xb0\x98\xbe\xbc\x0e\x8e\"\xf1h\xf6҅Z\x1e\x05L\x8e>\xff\t\xae\x9c\xaa\xc2\xfd\x0f\xa2\x0f\v\xf0}f\x83{\x83o#\xef)\xd81\xdf0\a\x17\x8f\x1f\xc2\xe4\xfbMb1\xbflg\xd3\xe6\xb7\xfey\x0e2N\xb2\x87\xaeN/9.l\xbe\xf2c\xc9U\x16F\u0088C\x17\x80\x15`\x0f\xf0\xb0\x14\x96\xf7B\xaf\xaa\xa6\xd7\xffv/\x93\x84\xff\xae\xe3\xe0\xfe\xfc\xb87\f\xa6ѓIM\xa4\xe7\x06\xe6ACL\xca$Q\x8c\\\xa3\xe1\nV\xc03\xb8\vԼ\xd3nu\x12{f\xee\x1b\xf5\x1c\x06\xa2ڍQ5\x83W\xa0\x02\x9f\x81]\xa8\xe6\xedN\x8f\x9d\xc8<\xbf\xe1\xdb\xf7  i\xf7Jj&n\x9fD\x12a\xe8@I\vC\x13\xdaI\xa9\x8f\xc6\x06\x9c\x88\xbbp:~\xc8⇸\v\xa6C\x03N\x14\xfe\x05\xc3a0\f\x82џ\xaaVO͟\xf0$\x03'8p\x90\xf9\x97V_\xe0a\xf4\x130\x18\a\xfd\v\x87\xabbk$\x8a\u07b6}\xfb6T<\xf8 *\xe0\x052\xfcU\x94\xa2\xdb+[u!\x1eN\xe3t\xfc\x1aV\"\xb0\b\xf85\xae\xc4\xd38]\xd0\xe5W\xe6\xe509\x81\xbc`>\x8f\xa7a:|\x8d+\x01X\x00\xf8\x1a2\xe0\fL\x17\x82\xa1@k;\x13\xff\xe3\x8c7E\x95^\x83e4>\xfb\t\x0e\av\b<\xf2?\xc4\xc3!\x17\x91\x05գ<\xf4J\xd7oJ4\n\x95\xf0\x02\t\xff\x86\xb9t\xb5\xa9J\xef\xe3\x83\xf9\x0f\x9e-buz\x93\xde$\xcc{\x80\xa8\x9e=\xd37\x8b\x8d\x12\xf8&\xae\xc0\xd7q\xb1P^`\xcc\xc9er\xfc\xb95\x05<(\xf1yx\x14\x1f\x80\xf9cU\xfa\xe2\x8d\xf3J\x18\x9dO_e\xe2u\xa1\u007f\xcdid\xab\xab}U>\xe1\xc4W\xb0^\xba\x1d\xd6J\u007f\x95\x8f)N\x97\xc3\xebb\xf6G\xe7\xd2\x1d\xd6\x0es\a\x8f]Q;\x01\x93\xdf\x01%\x8c\x87\x99\x8c\xa4E\x8a\xdea\f\x95\xb7\xf0\x13\x80\xc6;\xe1N\xbc\xe3\xef\xd311\x19\x88\t\xff\x1a\xf6!*q4\x9aс\xcf\xe1\xe1\x9cɘ\x9c\f\xa7\xa0\x158\xd8\x0e\v\x85\x83G\x0e\x9e\xd8\U000eaaa6\xc5\x1f\xda!\xb7\x8c\xac\xd3R^YMxD\xee\xe7p\x91S\x14\x95\xdd\xe8\xe6\xb5u0\x90+-\x12\xf0E\xa5M\"\xe9r{\xa9\xfeF/\xaf\xaa\x11\x8a\xa5e\x84\xcf]\xe5\xf7ʽ\xdcw\xa3\x97\x9b\xe4^nvW\x9a\x98Li+ݽ=c\xc7RviƶUۅ\x98\xa8\x89Tl_\xe3\\Һ&eM\xeb˹G\xd8\xe9\xa4\xe2\xc8\xcbm\a[\x85փ\xce\xe3\xdb\x0f\xaa\x92\xf0߲\xd6L\x14'h\x88\f\r\x91A\xa7\xf7bZ\xfa\x88\x11\x98\x86iɱ\aa\xacޭ\xb3T\x1aS\x90B\xcah\xb4V8\r).\xbd\xcf \x9a%/v&c\x10\x838\xbf\x87pڬ\x0e+\v_p\u0088\aLF\x8b\xc1iHq\x1a<\x86\x80\x19\xbc8'\x19\xe6\xa0\xd7W\x19\xb0T\xd9S\x9c\x0e\x87\x93\x85FN\xb8z5 \xba\xab\xad\xd5)\xf3a>\x04!\b\x9dQ\xaf\x9cA\xb4\xa5\xd8\xdd.\x87\x8b\xf5\xfb]\x1e\xaf\x00\xd4ժ\x80\xb7\xc6\x1aL\xc1\xb4\xf0tx\x04\x1e\xb9z\xb5\x17\xd2\xd2{S\x92R\xeb8\xe8\ue17e^\xe8\ue3eb\xd1\xd9\xdb\t\x14\xa4\xf5B\x1aP\xfd篑\xf0.IC\x80\xeb\x1d\f~2\x96\x06\xd7h\xe8\x83n\xecS\xf6\x0e\x86>\xe8\xc3>e,M\xa2d\nI\x1bˣe\"m8~\xec\xbf\x0ep73\xf5ӆ\xaf\xed\xe3\xd2m\x1ct_${!\x8b\xc3ne8/\xf6'\xd3~^~\xb2w0\x048\x14\xa5\xbc\xff7\xb1D\xf5~\xc4!uU\xb1\x8dK?BF\xa9\xab \xc8'H)\x17p\xebɷ\xb8\x10P\xfd\x9e\xc5^.<*r\x88\x86{\x86\xe1]\u007f\xc7),楑\x90\xa7\x80\x11\xbdx;\xde~V8\xf4]\xf7\xb7g\xbePE\"\xb1Ct\x90CQ\x89#\xd2\xe1v\xb8}\x8e\xb0\xfa\xc1U\xa3\xb4\x8f\xa8\xb0\x06f@\xfa>8\xc9\xf6G\x839AF\xa8\xc4~\xcaK8\x18CX\x8d\tB\xee\x8b9s\xb2\xe6\xaap\xc0\xdc\xe9\xa31\xe5\xcfB.\xc1`\bA5$\bm\xaf\xb7\x9f\xdduF\x05\x03μu\x05RX\x10\xe5\xd7Q]\xd9\t2\xa9\u007f\xae,L\xd1\xf0G\xf4^8\x18\xad\x8cVF\xef\x8dǱ\xf93l\x06\xff\xbf\x84\xcd\xf0\xe6\x86JZ\\\xff\xbf!3dCQ\xd6\n\xee\xe40\x1d7\x1a22p\xa3\b\xe9\xbcl>\xdf\xc9EG\xc2\xc7xB\x9a#́\x8f\xe1\x844\x92\xbf.\x86\xc5\xe5\x1cl\xe6\xe21g\x0e\x91\x11Q\xa2\xfe\xf3:)\x14\x82\xbf\x91\xb0\x92nowt\xe7\xb4Ǌ\xc3E\xfd\xf6]QrN\x8ecU{\x0e\x8c\xc7\xc7o\xd8xR\xbfm\x17\xed\b\x900\x1e\x1e'\x92B\x99\xf072\xbc\xf9F^\x1c\x0f\x8f'\xe7\x92\xd1~\xabN\xea\xc8%Sp<>\xde\xcf&%\\\x1c+\x8a\x1bxED\x12\xdc\x0f\xaf\x93q\x18\xab\x9e\xec\xa4\xfdր\xc5\xc7c\x1ah\t\xbfI\xef1\xb0\xd1>\x89\x02\xadb<Wg\xae\xd3W\v\xd8'QD\xad\xae(P\xc8\x16\x16U\x96\xe9\x04\xec\x8bR\x84\xbe\xc2\\Z\n\xb39\xd0F\xd3\x14\x98\xa6\xcc1\xaf\xd9\xcfG\xb4\x99d\x98\x92Җs\xa8\x95\xd2\x14\x90\xa6l\xf7\x1e\\\xc7_\xd7*\xa6rFw\xa5\xc7,@\x1aj\x89J_\x95Ed\xa5\xbe(\x85\xdaI\\\xa9\xb7\xb4\xaaB\x00\x99iY\xb0\xd1\xd0\xc465\x8a\xb5A\x01\xe4b\xab\xaa\xbduuLR*\xfc\x9d\x84\xadܟ\x0e\xee\xea\xec05\xf8&`\xee\x06X.\xf2\xdfSx\xf5.?)\x00\x15\xe9[\xceip\x96\xe2\xb8\xde\xf6\xe9\xf3,\x8a\x8a\x00\a\x19\nx.\xd6K\x94l^>i3\xcc\xe6\"\xa2\xe2C\xee\xfaHEUsm\xa8\x95i\xabl5\xb7\xf2\x91]\x90D\xa87tz\xda;:\xe3 \x03\xe8\xe5\xda\xc9H\x1al\xe0bi;\xb8\x1b\x0e\x81\xb2\x8d\xfb\v\a\xf7ˣ\x94rA\x82F\xb9@\xfa\x8c>\xb2\xb0z=\u007f\xbdO1\x16\xc9yS\xe7\b\xf8U4\x95()*\xcd\xcde\x96\xbfZ\xf1\x12\x1f\xe9S|\t\xe4\xa9\vg\x05\xf8JJ%\xea\x1b\xeb\xda\xda\x18\xbc\n\xdf\xc3\x06.ڽ\x83\xabt\x1a*\x19\xaf\x0f\xbe\x96R\x89\xab\x9f\xc1C\xefÝl\xb8O\x91t]\xf7]\xdc\xe8N\bk\xe5b\xbd\xffs\xaa\xe6\xba\b\x1c\xf7\xff1;\x13\x11\x81Zƅ$\x11ā\x0e\x8dr\xc0/\xdcu\x11(\xe5\x1bd\xdc\xfd\xf7\xcfY\x1b\xd8\rOr \x9e&\x13\xc2T\x98\xa2Q\x8c\xcaf\xbeDED\x1a\xc5\xeb\xf2\xb9LOk\xa4\x8a\xf5$R\x9a\xc8\x00\x8dj\x80\"\xd6\u007f;\xd6O\n{9\x145X\x01b\x1cB\xd67\xd0\x01\xdd\tz2\xf20\xbd5c\xfd\xbaUY*\xa7\xc1a00\xb8\a7`_t0ކ\xb7\xec{c\x14o\xabo\xb07\xb0?~\xbd\xf7\xcc\a\xc2C\xae\xa7\xceg\xff]e\xab\xa9\xb1հM\xb5no\xa30\xa2\xe9\xa9˿0N\x87\xd3\xc1w\x96v\xe9\xf7\xd9U/\x1e\xd8\xd8\xdb\xc7\x1c\xa8\xde߸\x87\xff\r)x\xe81xƠ\xb2\xd4\xd9\x1b\xea\x19P}\xf7\x1d\xa8Ν\xceYu\x80?.\x1ei\xecn+Y\xbci\xe5\x8am\xaf\xf8\x0eU\x1fhP\x81\b\xf3S\xb9\xf0,\xa8\x81-P:PC\xdc\t\x95WIi\xd7Q\x0ef\xc3(2\xb4\x8f|Sz\xe5;\xa0ށW\xc9\xde\x1bs\xad\x91\xb4\xb8>\x1a\xd6\xd2\xc39Y\xb3\x84\x93\\\x1e7\x83\x94\x95\xed\xc1\x8b\xc8\xebb\xf4}\x1a\xa8\xe9\xf2p\x19\xa5\xa4\xf7\xe9/\xb9\xebbx0\r\x94D\xc9I\"ji\xa0\xc2\xc9\xca^\xa0\x88\xf0E\xe4\x15H*\xe5\x01\x95\x86\xf8\xa2\x82b)'\xc8Ov-7\x9dT\xec!\x85\xa4\x90\xdc\x05\xc3T?\xaeE>&\x84\xfb\"\"\xdd\xceem\xf5\a\xb7\xf1\xc1m\xfe\xadYL;w\xf7#_\xb4\x90\xbb:\x8c\xba\x1d\xbcn\x87\xb1c\x17\x03\xafr|\xf8\x9b\x98\x96ޅ\x9dP\xbfn?\xdf\x1f\xdb,\uf847\xf2\xb6}7>\x94/\x8c\x18\x81\x00@\\\xba\xd6\x03\x14$3\x90\x85#\xe0\x01܂\x9f\xdd\xf8n\x81\ap\x04dA2P=\x97\xae\xf1ᱱ\xb10\x99;\xd3\xdb{&\x8e\xe9\x150\x1b\xb2\xaf^%\xf2C\x9f=\xb8\x83m\xfd\xe6\x9bV!\t\xee_IZ\xf2\x8f\xe6Ǘ\xaaK\xe3\xeaàB\x12D\xf5S\x11*Lm\xe5\xc2bT\xa4\x9f{qγ\xcf\xcdy\xfd}\x98AF\xc4\xc4?\xaf\xfeɩ\xfd\uf75d\xf3\x9c\x10\xa5\xa2\x8fӵ\xfa\x9a27\x8f#[\r\x1d\xba\x9d\xe5;S:\x0e\xee~i\xd7A\x95\xc7\xe7\xf6\xf9\x99\x0e$a\xc0\xb66\xdek2\xba+Y\xa3\xd1f6\tH>\x9c\x1b̩\xde\x16HɃ\a\x89Z[Yu\x19\x8b3\x15e:}\x99E(_l֚\x17e\x1br\xf49\xe5\xa8\xfc:\xd9\x13Ϙ\x85\x89x{v\x81`6ٌF\xc6\xe86zL|\xe7\xda\xdd\xeb:V\x97\xe7T\xe4TnÇr\x93\xcbm\xfa\xd2r\x06g(˪\xcakm<\x8c\xcc\x15\xb7\x06\xb3k\xb3S\xb2\xd6lY\x97\xb5Ve1YMF&\v\xc8\xd4\x1d\xb9\xbc\xd9\xe7\xb7\x06X\u007f\xc0\xe5\xf5\n@~զk\xaf\xd8Q\x99Ҋ\x0f\x12e\xaeڊZ\x16f*j\x83U\xb5\x1e\xa1\xe6\x84\xf7\xb4\xf7՝\x81\xf6\xaa\xf6\x1aP\x8eI\xb6\xc43\xee\x82DH\xda\xd9,x\xbd.\xbf\x9f\tX}\x16\x1f\x9fy`\xcb\xfe\xad\x87jګ\xdb\x03;\u0876\xe4\x1aWU]\r\x13\xa6\xf0g\x1a:\xb9y\x1c\x9c\xe5\xd4O5\xae\xfc\xc9\xd0\xcc\xc3.R\x93\xe8L\x84NN\xfd\xf4<Nm\x80\xb3\\\xff\r\xf5L\xd8\x15ǒ\xaf'\xc3\"\r/\xe1\x05(\x8a\xa6@\x05\xc9ws\x82D\xa1H\xe7r0\xee\xbb\xdf`(<\xca$\xad$\x1d0\x8btă\u007f\x15\x92p\x0e\xee\x845@\xc1\v@\x8d\x02j,P\xea\x05^\xf5\x82\x10|E\xd2cH^\x1d\xec\x81\x1e.\x9f\x13\ne%\u007f\xdd\xd61K\xed|(\xb7vi&\xa3\xde\xea\x855\xa4\xbb\xc8UX\xc8`ғˑ\xe0\xf1v\x05\x92\xa8\xd8w\x00o\x11\x9a\xe6=\xf4\xd2\\63Lu*\xd4\v\xbc\x9f\xc0m0\xf1\xec\x11\xa1}O۞\x8e]\xdf\xff\xb2\t\x1e\xec}[\x05\\\xeb$\xbcU@r\xc48\xbc\x95\x81k\xf03's呄[\x91\xe7\xf1Y\xc5\xee7\x1bϽǼk9\xbb\xf9<\xff\xfd\xe2\xe1\xfb\x87\xb0\x1b3e\xa3{\xf8\xfd\x9d\xf8\xf0\xac\xf4\xb5\xab6dl\\\xa1R\a{\xb6\xac\x99:f\xcd6\x15\ue53bW\x8c\x82Z\x0e\xba\x15\xea\x05\xa1\x8e϶\xe8w\t\xcb \x19\x92W\xc2=\xacz\x81\xf7[\xc5K\nx\x9al?\\s\xf2$\xd3jm3\xb5\xf0\x9d\xc7\x1b^?\xcb$\xa1\x18\x8f\xad!\xb7M!\to\xc0\x9d\xb0\x16(\xb8\x1d\xa8\xa9\u007f6ͬ\x1e\xf5\xc7V\x10#\xf1@\a\xeaG\xffl\x9bh\x1f=u\xe6\xac\xe7y\xbc\xa6\xc0[\xaf\x8eC\x92\xc1[\xf3&!ǧ\xbf\xdd\x05\x0f>\xf0\xcb\xd6]\xb9{r\xf6\xa8\xce.\xc7\xdb`\xe2\x93l\xa6B=\xab'Lu*\xcf|\xf3R\xd3)\xe1\x00\xdc\x02\x8a}@\xb2@)ԳB\x90\xf0\xe1\"\xbcUP\xcf\xf2\x16\xda\n\xadE\xfc\xcby\xad/.c\xd4\x1f[sM\xb9\xba|~of\xd3\xea\xa5Lf\xd9\xd2\xfc\\^=\xcb{\xcc\xde1f\x1d\x03\x94\x9dV\xcf\n\x9d-~=\xf38\xefkq\xb7\xb52\xeaY='\xcbO\xe6\x1c\xe6]\xb5κZ\xa67\xbfw\xcdIޫT\x1f\xee\x81YN\xbc\v\x06\xe04\x16\x13\x158\n\xef\\\xbb\b\x87\n\xfa\xac\xf1[\xb6\xb2\xd8-\xcb\xf6g#\xeeT\x1c\xdcva\xcc\xee5{W\xec\xcb8\xb0\xfa\x8di\x9b\xf1\xe1\x9f\xeeW\xe5\xb5u\x96\xede\u007f\xfbi\xff\xf7'\x84=\xe7<\xe7\xdea\xde+:\xb7\xe5M\x1e\x9eU\xc8\xed\xc3\x03\t\xb7\x02/$\xc5\xfe\xfd\x98F1v\xa0#ܗ\x10\xee\xd3\x10\x97i\x87r\x03I8\x94I!\r1 \xeeB\x13\x99Dc:\xce\xc0\xa7\xacV>\xfa\xb7\xe8\x99\xe8%\xe9u\xc2\xe6\xc2\x19\xf0\x14\xa43\xb0\x17^|\x03\xd2\xe0~x\x12\x1f\x99\xf1\x1aߖu\xd2ѶK\xf5\xe6k\xf0$<\x02\xf7\xb3\x90\x06/\xceƽ\x02P\x83\xffw\x1f\x17X@&\xa5\xc2{\\B\x98\xbaJ\xef_c\xce\xe1\xb1[ѿ\f\xa3U\xe6q<\xf4)\x8e\x94_\xe8X@\n\xd8\xf7\xdf\xd7So\xdc\x11\"iRw='\xbf\xde\xdc\xe1i߁\x11\xaa\a:@\x0f%\xa0\x86\x120C\t\xe8\x13@\x0fItx\x1a\x94Ħ)\xeb\"\xa9\xf4\v\x8b\xe6\x8d\xe7\x1dv\xe5\x84E\xa7\u07b9\xf0\xdak\x9f|rb\xc1T\xa7 }~=\x95\x96\x94\xca\xef6\xbd\xf5X\xfaڌUż\xa9Ԟ_\xc0D\x93\xb1ġt\x87o\xb5\xbe\xed`\xa0d\x98Mq|\xf7\xe9\x8bp\x87l\xe0+\xeeÍB\xf4\x1e%\xe8#ũ\\\xf4\xdf\xf2P;\x10DHK\x90\xf2\xc2i4\x9c\xe4xI[C\xce \xf9\xa8V\x19N\xc3k4<\x86w\xc0hL\xe6%Q\xe9'e-I\x88Q\x8a\x9b\x9a\x92\x10\x15\x95\x98\x8cw\xe0h|l,'H\xf7)>\x8e\x16\x11%\ue68az\xd6\xe1t8\xeakj\xea]\xfc\xabR\x01!M\xeeǤ\x16\x92p\x9a[OJ\xd7\xe0 \x87}\xf0,\x19\xd6*F\x931m:)Q\x9a\xc4\xd0r.*ވ\x92\xf7,>\x1bK\x8d\xa5ތ\x92\x17N\r\xa7\xcai\xd0\xc59 \xae\x93\f\\O\x02\x95\x10\x87\x0f\xd7sp\r\xae\xd1\xf7\x93اX^3u뺃\xdev\x01\xba\x15P\xca\t1\xad\xb2\x82\xe3o\xdc\bq\x02\xf4\xdd\xcb%\xa5\x86\xc8\x1b\x01&\xa4>\x10\xa5>\xa0\xa8Ǥ\xe5\xe1\xbbi\\ԍ)\xa0\xf9\x81\xb9\n\xa2\x12\xc7tb\xd2\xcb\x0f\U00051de0\x83\xbe\x1fSP\x83\x8bV\xf1/\u007f\xd3\tI0\x86\x01q\x84\"\x8e)\x1c\xc3a\xb7l\xfbD\xfb\x8e\x90\xd8}Ï\x8eR\xe2\x8bP\x8c\xeb\xe0\xedR\xfe\xd0\xc2\xfd[\x1a\xcbT=Kj\x17\xa53\xe9\x99\xe9#F\x8cH\x1f\xc1\xa7\x0f%\xc3}O\x90\xbdʫ#zG\x8c\xc8\xec\xed\xed\xad=\xd9s\x9co\xacݿ\xfbЫ*x\xbb\x0e\x8aa\x1d\xbc\xc8$A\xcb[\xdfև\x97\xc0\xed\x1c<\xb4\x032  \xc9\xfa\xb7T\x0f_\xd1V\xab\xc9i\xf6X<v\x9f\xcb\xedv\xfb\xec^\x8b\xc7\xe24٬*\x87rk7\xed6;LV\x9bʡ\xb4\xda\xcc.\x8b\xd7\xeaq\xf8\xdc.\x8f\xcbks[T\xab\x0e\xd1v\xab\xc5aqZ\\6\xb7\xcb\xedt{\x1cn\xbb\xc7\xe6\xb2\xd8\xe5\f\xa1<\xdaj5\xbb\xacn\xab\xc7\xeeq\xbb].\xaf\xddm\xf1X\x1df\x9b|\xf7\x8bI\xb4\xcdb\xb6\x99\\&\x97\xc5\xeb\xf4\xb8<^\x97\xcf\xe6\xb3y\xccv\x8bʡ|\xd9J[\xcc\x16\x93\xc9\xec\x91?>\xbf\xd7b\xe1\x1dʃ~\xdab1\x9b\xec\xbc\xc9nv\xdb=v\xf9\x96\xd3\xe7\xf4ٜr\x9e\xf0`x\x8f\xc6|\x1c\x8eZ\\1\x95\xdff6\xd4\xd768O_`\x8e\x17\xef)\xaa\xe7AQ\x03\u007fy\x05\x161g\n\x8elz\x89\xf7\xd64\xbe\xb6\xf5}UF\xcd\xfd/8\x98\xe0\xaa\f\xef\nvE\x86Y\xb7Jp\xdc\xffNF9\xbf\xf5\xd9\xc6\x05\xderզ\xf5\x05\xcb\xe72\xb8\x10\xfe\xb2\x02\x14\xe5|iyy\x9eySV\xf9\xa4\xbc埨\xb6\x9eX\xe4q0\x8b\x8ef:\x84\xbd\xa5\xad\xb3\x1d\xcc\xee\x87\xe0\x9e=\x0eƙ\xe1X\xadc\xca\f%ۼ<\xae\xb8\x80\xc3A\v\xf9\f<\xc1A|9\x1d_\xe2A\x94\xf2R\xb9T\xa4n\xfa\x01\xf4\x1e#\xa3\x13\x15\x93\xb3\x9f\x9d\xf7\x98l#<\xf6\xec\xa9\x0f\xb3\x85\xe8\xc4\xff\xc2\xff/\xa5\xabZ\xcd\x1d\x85\x8dQ\xad\xa4M\xae\xd1\x15\xfbJؒ\xe28\xf8B\x1b\xd5\xc28\x12Ĩ\x16\xdaII\v\xa2rGk}[U\xdcwH\x1e5x\x1c\bwi\x12\xf7û$\xf0c \x95\xc4;\x81\xba\x1b(M\xe2Q\xa0\x1e\x03J\xedOU?\x9c\xaa!t\xe1\xc54\xee\xc2\xc5\xf8:֏\xe2\xb3\x1e\xc3D\xe3è\xc0\x84=\xa8X\xb4t\xfbbV='uKIk\x87p\xb8\xe5壌\xda\x1b\xfa,\xe7\x93\xcf\x180\xbd\a]\xb0\x1c\x1e\xe4\xdf8\xfcQ煝\xaa\xbd_|\xbd\xef[VmN\x85ۀX\x02\xb7\x1d<(\x1ed=^\x87\xdd#\xa8\xfd\x93\xc4\x12gA\xe1r\xfd\xf2e̼\xea\xec\xa6%|\xfdj\x11\xff\xba\x94\x01\xcaE\xf7V@J\xdeY^\xedO\xd5u\x1aj_:Ru\xf4\b\xf3۪\xcfP\xc1\xafY\xab_\x8b\xb7\"q\x1coc\xd5\xf6I\x980tݪ{\x05\xb5?\xf5\xc9\xcd\xe3\xd6M]\xa0\xc2\n\x9c\x9a\x86\xef\xe2~FmN}\xbc\xfd\xc9\xf1˶\xaf\\ʨ礎\xfc\xe8\xe1\x1f\xf9\x93\xc7w\x1d\a\x02\x12\xb3@\xc1\xfe\x06\u007f\x15\xf7\xfd(\xc0EH\x84\x12\xf8\r\xbe`\x92\xe0{r=\t'h\xbf\xc3o\xf5\xf3\xc6\xd0▂\xaa\x17;\xe7\xec\x99ۥ\xaaߖ\xb9{\xf3̇\xf1\x85yl\xf6\x86\xf6\xda\\\x01\xa7\xfd\x8c\x83`\xd00\x98\xa6*k\xcbٷ\xf3\x14\xbc\xf0\xd5yfϖΒ\x1d\xfc\xa63\x9b\xcff\xbe\xaeo\xde~\"߯r\x1b\x8d\x0e#\xbb\xfe\xb9\x92\xd2M\xc2\xe2\xfc̹9\xcbU\x99\xb3\vg=ό:\xf0\xe8\x9eq|\xcb̝\xb3\xf7j\xaf\xe1S\xb0\x12W\xc2S\xf7\x9c\xde\xf8F\xf6\xf9\xed\xaa͗\xff\xb1\xf6[\xf6\xddsM\x9do\bG\xda\xcft\x86N\xa8\xea\xba\xea\xdf{\xa9\x1f\x93\xdc?\xe8E\xae\xc1>.> \xf5\xf5#$\xa0\x1b\xd3\xe8\xfbIG\xacO9\x8c\x83\x06\xf2&\xf0F\x1e\x9f\xa2:\xf0r\xfd\xc3\\B!\t\xfb8\r\x0eSF\x9f\x90\x1ex\xf8\xf2\xc3\xe3\xa2OD\x1f 4\x89\x9fDDe\x10\xa8\x12r;\xa9'\xe1*\xbdM\xb7P\x9f˿\xb0\x05\a-\x99\xc2>?sg\xeb\f\x01\xb7~\x8a\x060<\x01[Uyof\x9f\u007f\x97\xb9x\x1c\x06\xbd\xb3\x9b\xafj{5\xb8\x83\xf5\xfa\x1c6\xaf\xd0h\xfa\x10\x87\xd5oUU\x17\xb6\xe4\xe5\xad\xc0\xbb\xa6\xada\n\x1as\xaa\x8a\xf8\x0f1\xf9\xcahH\x9e\xac\xd27\xb6\x175\x1f|\x1b\xee:̶\xb6n\xafh\x12:J`\xd8d_\x91\xcaev\x98\xcc\f\x88\xe1\xc6Ը3\xd2a\x8d\xe2\xbe\x04\x8dB\x1b\x89\xd2sr\xa6\x17M3\xbe{\xe8շ\xda>\x9a\xb1\xee\xb9\xec\xa7\xcbTo\xcd~\xf4\xe0(\x16\xa7\xe2P\xbc\x17\xa7\xe4\xb8s\xbc\xb9\x02\x86j\xb1\x12(\x1c\v\vU[\x97\x94hg1\x98\xf8\xfa}\xff\x9c\xc3\xcfw-\xaa\xcbhS\xd9\x1d6;\x83c \x01\a\xc3}[xcv\x96%\x8b\xcd/p\xb9\v\x84n\xa4\xe0\xb6Qp\x8fU\x95\x86\t(,\xc4\x11lv\x8eӽMx\b\x86\xc0S\xf3!\x9d\x95\xae\xc0%\xa9B\x9aX먵\xd7\no\x1d=\xfd\xfa\xe1^URL\x17\xbe\rN\xc0\x17\xb0k \xfc\no$D\xe6j\x88k\xb4'{T\x00\a\xb0\x98\x8f\x0f!\x8f>\x01\xb30\x80' \x10s\xc0\x1ba\a\xf8\x95\a\xe1\x04\x81Ga\x8e\xe2r\xeb\x1b\xf0W\x1e\xc6(\x92\xc6h\x88O4\x91\xe10y\x90\x03\xf6@Eo\xf8Iأ>\xf2ߖ@\x04u!~\x03\xd9\xd8\rg\xef\xe5\x0f\x1akۚ\x9b\xec\xe7z\x19\anZ\xb6\x1c8ޱ\xe1\xabE\xad\xbc\xe3\xb9\xe3\x1f\xd9y\xc7Ǧ\x97\x9f\xe6\xdfE\x12\x12\xf7\x1en\xf6\x9fh\xde\xdbҬ\u0089M8\xf5\x1d8\xc6\xfcc\xf7\x85\x13\xa7\xf8\xca\xe6m\x97^\xfe]\xd5\xdb\f\xc3\xefs0\r\xe7\xdf\x11\xdff\xdf~\xc7P|^p\xc0\xf0\x1f{\v\xf8\x97\xef\xde6\xa1\xb2@ub\xde\uea4f2x\x1c\x9f\x9f\x86\x13\v\xf9\x8eܶe\xcf\f\xb5?\x96\xc3\x14\xd4\xe6\x1e\xf4\xf3p\xf6\x17Ȇn\xf8\x86Q\x1fy\x80\xe3\xa3ۮ\u007fI\xdb,\xf2\xc0lr[\xbd.\x8f\xcb\xedu\xfal>\xab\xdbl\xb3\xa8\xf6l\xa4-\x16\xa3\xcb\xec5\xfb\xec~\x8f\xdb\xe3\xf1ۼf\xaf\xc9i\xb4XU\xbb\x97\xd3\x16k\xa5\xdb\xec7\xfb\x1d\x01\xaf\xdb\xeb\tX=F\xaf\xd1Qi\xb6\xa8^^I[\xcc\x06\xa7\xd1o\xf4\xd9D\xaf\xc7\xe3\x15\xed~\xa3\xcf\xe42X,\xaa\xd7sh\x9b\xc9`\xadt\x1b\\&\xbf\xcb\xe7\xf2\x89\xee\x80M\xb4\xfa\x8c6\x93\xea-3m2\x9a\r\xbc\xden\xf4\xfa\x19\x9f\xdf+\xf2\xa23`62g\xabh\xa7\xc9d2\xd8\f\xb6J\x8f\xddg\xf7\xfb|\xa2Kt\x06,N\x93\xea\xbf|9\xc2\"h\xe3\xee(\xeddDK\xc3\x1d$\x8f\xdaX\xda{\xa4ԧԒ#@\xab\xb8ơ8\x82\xeb]\xc6\xc1\xfdp\x17\x17\x11;8\x10\xe3\x0e2\x1a\xc5e\xa0I\xf0\xfc\tG\xf3q2K\x81ӄ{\x90\xa25\x8aI\x90\xc5a\x9e&\\\x01y\xe0\xe048\x80\x06\x17)]k'\xa3ה\xbbIM$\x15(Z\xb6`QTȦ;\xbd\x96\x8bR\ny\x98\x92\r\xf2lN\x12\xf1\xaft1l\x1c\x05\xcbp$\x8c\xc0\x110\x12\x97}\x8b\x1b\x1bT6\xbb\xc3Ɩ\x94\x98**\x842\x9d\xb1\xb8\x88AV\xb9\xa9=\xbb\xcb\xca\xc3\xd9,0\xa3y\x17\x9eUmr\xef\xcc\xe9b\x81U46\xf8k\x83B\xb0\xc6\xd7`oTI\xa2\x8d.\x82_q8|\x8e.p\xa2\x13\\\xf89\f\xc7_\x1bUv\xbb\xc3.s\xd5\xe9\x84r\x9d\xb1\xa4\x88\xc1!\xcaM;\xb6\xee\xb5\xf1ҥ\x8d\xd2\xea\xe8\xea\xae\xe8%\xd5FWǶ\xbd,\fQ4\xd6\xfbk\x82B\x8d̵A\x95\xe4\x00\n\x12!\r\x12\xe3f\xb9\xac'\x85\x8d\x90v\xb5?%!\xd2\x0e}4l\x8bc\xe3\xb6q\x90\x88i\xf2\x005\xc9\xc6A\xdf\xc58\xbe/\x8b\xc3>\xa5\x06\x1f\x96\xf2h\x18#\xe5\x13\rަ\x9aF6ܪhl*/\xf6\n0&\x9aO\xc0\x14L\xa3wV\xb6V\xb6\xf3\xb8H\xfa\x95x/\xef\xf9\x13\x93\xd9X\xabb\xf2\xf3\x8b\x9f\xcb\x13pQ\xf4W\xa22\xa72/\x9b\xc1>o\x9c\xeb]d(\xc2\xc2\xed\x03a&\xd9NF(Z\xa2\x94\xbbHLC-\xf6a^\x94b\xa3y\xebH\xd4zIHR<\x87ۿ\xc0)gq\xca7\xe3\xa0\xda\xf5&$\xdd\xfd\xf71\x9f\xbd\a\xca\t\x9fv\x83\x00\xb7\x1d\x82\x9f\xb2\xe0\x10\xae\x80)x\x9f\xca\x1f\x0f\x94\xbf\x87|\xff\xc5ן\x15\x90\x8a\xfeLc-,E\x1f\x10\xe8\xefPA\xab\x12\xfc\x1d\xe0\x03%\xd4\xc2RUR\b\xb6\x93k9\xa0>\xfd\x14\xb4\xd0\r\x94\xfa\x9d\xc8PL\xa3\xe1\x02\xf9)\xc7\xc7FA7\rSa\xf9?a'H\fА\x04s\xa1\x0e\xea \t\xe7\"\x8d\xd2?q'L\xc5\xe5<L\x1b\x8c\xf7\xc1\x9d8\t\xf2\xa4\x02\x06\xb48\x16\xd6a\xe3A\x1e\n9^\xbdW\xd6 \xcb\xdc\xe5^\x9d\x80\x8dkp,\xaeC-\xa3~'Z\x80\x930\x0f\xef\xc3;\xf9\xfe\xc0\xd7Z\x85\xfa\x1fx7(Q\t\x03d\xc5\xfd-n1\xa7!2\xc2\x14\r<\xb0M\x8d\xfe\x9d\xa6\xf6\x14c{As\x8e\x0fg\x1fO\ue059\xfev\xe3\xce\xc2Ɣ\xa2\xa6l\u007f\x8e\x11g\xceO^\x02\xb3Mq\x82\x94m\xfemƢBdQHF\x16\x84~\x92\x94\x1cSA\x81)g\t\xceNƙ=9\xfelcQaJa\x911۟Ӄ3\x93q\xf6\x12\x99\xc0\x98\x93b\xca\xf1g7\x15\x01\x8fl2\xf2\xc8\xca$\xbe\x9c\x14\u007fNsA\xbb\tf/I\x9e\x8f3\x8dq\x92\x94\xc6\u009d\xc6v?\xcc\xecI>\x8e\xb3}9M\x05;\x8c)\xed\xa6\x9d\xfe\xc6&`\x81O\x06\x16y\xa2\xac\xa6\xa9\xb2\x89\xf5\xf9\x9dN\x9f\xd0\xeeon\xf6\xb5\x1f\x87\xd9\xc90s~\xbbQ&L\x91\xabfl\x9f\x0f3\x93a\xf6\xf1v_s\xb3\xbf=\xc5\x17\xaf\x9a*\tR\xe2\x81\xc8\xc3i@\x85d3[\r'\x81Ro\xdd\x0f_\x91\xb4\xfa\xa5\x8b39u\xc1\xfe\xf5\xa4\xd4GO\x99={\n\x8f\xffP\xf4\xcf>\xc0\x1aR\xbdu\xff\x8dه\xbf<\xb5\x14\x13y\x9c\x8f\x13\x81\xc2g\xfa'\x1d~\u007f\xff\xeb\xbe^\xa1\xfb聣]=5\xa1`K\xb0E\xd5Z\xfd*\xa8:Xu\xc1\xfe\xcb\x1fe\x8e\x10\x90\x1a1m:#\xf5\xc1\xcfܟS\x0eQRQ\xd7\xed\u007f\xe50\x03\x13\x90\xfe'\x8e\xe5\xd5/]D&\x03'\x8e\x9a#lY\xbaq\xd5\xfaU\xaaMYS\x1fY\"\x9b\xc9K9A\xbdu\xff\u0379\x86\xb7~\\\xb7\xfd\xbc\xb0\xf6\xb7\xdf7C\"\vO\x93\xa1\xfd5\x87\xdc/\xab`\x82\x02RA\x05\xb7B\xaa\x10\xaf\xebIP\x8f\x02j*Ы\xfb+\x1d\xf7q\xcazLCd\x00E\xab\xf7-\xc4g\xf0)\xa4p\t\x8b\tO\x9f\x00RPg\x1dir6\xb9\x1b\xf9\x95\xady\xaf\x1fe\xda|m\xc1\x10\xaf\xde\xfa\xd8\xc6\xce\xc2Cǘ\xce\xdac\xa16\xb9U\xa6\xcc~\xe3\xe2\x85\xf3\xe7\xde\x15\xa4>\x85:\xff\xb1\xb7F\xbc\r\x14s\xf5\xa3\xce\xcb;O\"\x91\x17\xe4\x83y\xc1\xbc\xda\xed]\xf3\x0f,\xeb^\xa6z\xba\xf7\xfd1\xbf\xb3\x9d1J\x01b\xef\xa7r\xe3B#\xa9\xde\xfa\x18v+\x96L\xfd\xa2+KX\u07fd\xb1{\xcb1շs2`\"2,\x8eE:\r'\xac\xf0gԭ\x12\xa2\xa4\x02y$\xf1V\xe41\x15T(\xd7\f&(\xdc+kV\x87֩\xd4YGle\xf6\xd22&=\x94~h\x11\xaf\u07b7\xd0n&p\xae\x1d\x98!0\x9d\x85[\xaeu\xee\xffEPg=\xd6r\xfe\xc7\xfdo\xb1\xd0\x1d\x9f\x01\xad\xfb\x9f\x8f\xc0HN\xfdM\x86\x9eL\xff\x94\f{i\xf57\xb3q>R8\x11\x9fa2cT\xa7\xf2\xf71\xef?\xdd\xcb/\xeb^v`~W~\xcd\xf6\xe0\xf6\xa0*\xaf\x1aU\xafv0\x97;?\xbaʫ\xaf\\\x06\xea\xed\x11o\xb1R\x9f\xf2\xdds\xe7/\xf0\xea\x9f2.\xbe1{\x8a\x80\xffP\xaa!#\xd4v\xac\xb6\x93=v\xa8pc\xa7\x10\f\xb5\xf9\xdaX\xf57\xb3\x8f\xbe\x9e\xb7\xb2Up76\xb9\x9aX\xb8\xfd\xe8D$\x04\xa0\xbc4\xcc\xc0\x04\xa0q\x8e\x8d?\xb8(=\x94Ζ\x95\xdame\x82\x1af\xaf\v\xad\xaeY\xe9V\xc1\x04%\xa4⭠\xc2T\xe4\xf1V$\xe3\x0f\x91\xb2nU\x86\u007f\x05\x8b\x13\xd2\xe2\x8e5\fL\xcc\xf8v\x8eplK\xf7\xc6\xee\xf5*\xf5O\x19]Y_L]\xc2`\xb7R\x964\xc4\xf1aJn\a\xe8V\xbe\xb5\xffǖ\xf3\xfc\x81\xdf\xf6\xfc\x0e\x89\x8c\xdc.\xff\xcb\xe32\x92S{\xfa\xbb\x04&,~\x1aI^\x1d\xdcUX\xe8t\x17\t+\xf3^\xcc[\xc6\xe6\xe6\xfa\x82\xf9\xc2\xc6\xccՅK\xd9M+ꚷū\xfd\xd2~\xb9\x03M\x9du\xfe]^\xeaSN\x9f6\x02)vD\xe6G\x97w\x02q\xb25(\xb4\x06[\x83-\xb5=]G\x0f\x1c\xedV\xf5\xf5~\xfd\xfe\xefL\xa7R}a\u007f\x98\xcaT\xa8=\xd9\xf8\fP\xf8\x14.a\x90\n\x8b\xf4%\xf2\x10\xa9\x0e\xee\x824NP{\xf6\x83\x18\xf3\xd2pK\xe6\xb5u\xbf\xf0\xdbϯ\xfb\xf1-\x06\xba\xe3\xfb\x98\x04w\xc5(\xb9\x97`\xb7r\xc9#S7e\xf1\xab֯ڸt\x8bj\xd4\x1c\x9c\x98\x81\f\x83c\xbfD\x1a\x9e|\xf9\x98\xaf\xfe\xa0\xdch\xc8íH\x02\x0f\xa9p+\xa8 \x95W{\xb2a\x82\xf2\xa0\xe7h\xed\xde&\x95\xfa\xc2~[m\x9d\xbd\x96\xed\xed\r\x1d:)x\xed\x84\xfa\xa5\xfd0\xd7\xfe\x1b00\x9dIB\xea29\xc8\x01\x9eH-\x88\xea\xe7\xf5$l\xe2\xa2v\x05\xcc\xc19\x84\xfaHP\xe7+)f\xd4\xcfC\x90\x1c=\x1a\xf6\x93x\x1d\xae\x8f\xber\x85\xbbr\x05\xae\xc3u`\xc8\x1b\xe0\xc0\x1b\x88A9\x93dW\xa8\x8f@\x11\xd7\x1b\a\v\xc6y\x8b\x91Z\xf0\xa8\x17j\b\"L\xc1\xa7\xe4\xe8\xd1x\x1d\xaf\x13\xea#\xfd\x91\x90\xd5\v\xfbc!\x8f\xbe\xf2'^1\x0eb\x9c\x03s\bY\x92\x978ɮ\xc090'^\xec\xe3\xf1\xf0\x1bWF\x13\xfd\xa2\x81g\xb0\xfaH*\x19\"\xe5\"\xe2\xb8D\xaf\x86H\f\xd3\xf1b\b\x9d\xae\x04\\\x1c\xf6sR\xae\xe1$\xbbR\x96\x11\xac\xdc\x15N\xae\xc0\x95+\xc0\x902\xbf\x9bܼ\xf6c\x9c\xfa۟H\xa4\xfa\x1b$\xceq\xb6\xccQ\r\x9f\x92\xb2أG\x83\x95\x93\x9b!.ͣ$\xf4\xd7y-\x19\xb5+e\x91\xfbۤ\x9f\x99\xf3Of<XOý\xa0\xb6\x89\xc0\xd5\xc1\xa0\xbaƦ\x86\xba\xa30\a\xd3`\xe0\xa8Zǎ?\x1a\x9c-{a\xd8 \a\x1c\x87\xe7\xba>\xf9\xdc\xd0r\xacc\x87x\xbc9\xf0Z~3\xa8D\xf5\xe7\xc3\xf7V\xfc\x9f\x88#\xf5?\x86נּ\x9d\xd1F\xba\xda\xdf(\xd6Z\xaa\xad\xa2\xa5ܠR\xff0|o\x85\xc9b\xf0\x18m\xa2\xad\xc6b1\xec(\xddXT\xecR\x05\xec9\xa5N\xc6`\xd69\x8c\x86ʊ\xea\xc2R\x8f\xa9<ϯ/\xab\xd6m/\xae\xa8\xd3[\xbc\x15z\x93\xc7\xe44\x19-.gPﶫ\xcc6\x8b\xc5\xccz\xcb+\xca\f\x95\xfaʼJ\xab\xad\xa8X\x97\xd3iT9\x1d\x9b\xecN\xc1\xa5\xf3nȯ\xd1{M\x1d\xd6jk\xd0\xee\xa8\f\x96\x97ڪ+\xfc\xaa\xb8\xe4\x15\xbe\x12\x9b\xc9WZQ\xe1\xb66X*\x03:\xbbլ7\xb8*}\xe5U[\x8bK\xfd*\xa7\xc7bs\vV\x9d\xd1o\xb2\xf4Kl4\x9b\xf4&sMNY\xae3[UP\xb3\xa1\xa9\x82\x17ͻm\xa2\xa7\xd6g\xab\xf6\x06\xbd5>_\x9d\xf3\x15צ\r\xe6\"k\xb9\xbdԦSټ^\xa7\x97\xf5\xb8\xbdnƧo\xb6\xfa\x82~]\xad\xc1U\xa6*sՔ\x16\xb2e\xf5[\xcd.\xa1\xba\xa0\xb1\xb2\xb9JUi\xdd^a\xe3\xf5\xb6\u0090\xa7\xdan\xb1Z\xad6}\xb0\xd8g\b\xda\xdc\xd9\xdd>W\x99ikU\xb9?\xbfںk\x9b\xa5\xc8j6\xe6\aTe^]U\x99\xbfD\xdc*\x9a\x9aM\xf5\xae&C\x9d\xaa\xcaT\xd4\\\xce\x06\xf3v\xbb\xadBE\xcd\xea}\x81f\x97{{\xc0\xe2\xf4\xe8\x9d\x15^\x83W\xef3\xf8\xf4\xd5Eu\xdbm\xdb\xcb-\x9e&K\xc0XS\xe80ת\\N\xa7\xc3\xe5t6\xe4\xd4z\x82eՎ\x90\xbe\xd6PS\xea+\x17\xcd\xee\xf5Gk\xdc\x05f\xfd1wP\xe5\xb09\x9c\x8c'h|\xb3\xdeYa\xb7\xeet{]\x9e\xbaR\xa7\xcd\xe7\xf6\x05l~k\xa0\xc2o\xf4Y]\xabw\xd8\xed\x1e\xbf\xddo\xf2\xaa\nK\xabv\xe9\xdd\x16\xa7\xc5m\xf1\x9c\xfd泿]\xedQ9}\x01G\x80m\xb4U\x95\xd6\x18\\^K\xc0\xeau\a\x82.\xbfKtָ\x02\x16\x93h\f\x18\x03v\xbb\xd5f\xb7U\x88\xf9:\xbdEUi1\x9a+\xadf\x9b\xd5aq\xca\xff\xd9\xe7\x15\xbb\xeb\xf7\xd4vU\xfb,>\xa3h4y\x1dy.\x8b\xaa\xba\xb4\xad\xaa\xce~\xc4d\xf8\xcbP\xbf\xdeQ*\x1aD\xb1\xbc\xca'\xba<\x8d^\x9f\xdb\xef\xd5\xf9\xad.\xbdo\x8d\xbb\xa9\xd5\x1fh\xb7\x04\xcc*\xf5\xe7#^\xad\xa86\x05+J\xf5~{\x97\xc1\\\xae\xf2\xb9\xbdb\x80\x15\xed\xae|\x8f\xb96\xa7\xed\xa4\xc7V\xe4\xdf+\x8aV\xbf\xb5:\xb7\\.ز\xaf\xa2Z\xef\xdc^\xe0,s\x964\x95\x97\xaaԟO\xdd[a\xd0[J\r\x86\xb2\xb2Bg\xb1\xcboߞg\xb3\x98\x8c\x96J[\xa5M\xef\xaa0\xeb\x1b\xbc\xe2f\xa3=\xe4np4\x1b\xfb9ԚC&_\xa9\xc7\xd7d\xf5ق&g\xb9\xbb\xc2SZ\x93\xd3\x10p\xbbZ\xaa*}*\xf5\xe7w\xed\xab\xa8\xf4\x1b\x1a\x03U\x81\xea&\xb7\xb7V\x15hʹ60U5n{\x90\xaf*m\x0e\xfa\xf9*\xdfq\xbdh\x12M\x1d\x95.C\xbf\xd1\x12\xef`\x01\xa3\xbf\xb4\xcc\"\xbav\xe8\x8bL>\xb7\xd7\xe6\xf3\xf8=\x1e\xbfhv٫[|v\xd1\xee*\xf7\x98<\x86j\xabǠ\xf2\x17[KZM~[S\xb5\xbdT\xe5\xf1\xb8\xdcN\xc6Y\xe6+ip\xa9\x1c\x8eB\x9b\x957\x19J]\x06\xb3՞\x9bﶖ\xd7\xe5;\x8a-E\xd6b[\xb1\xb1\xd2Ri1\x19\xad\x16s\x91\xd9^\xe5\xf2V\a|\xfd}\b\x1f\xbd>\x90\xb6{\xedUN\xc6^\xdfPm\xaf\xabp[\xab]AWC]0X\x1d\xacv\xb7Zk\xcdm\x8evK\x9b\xd3\xd4\xe0rWz\xfc.\xd1\xebWy|~\x97\xcf[\xe5\v\xb5\x06˪t9\xe55\x15\x9e\x80%\x18\b\xd47V\x17ԛ\x1b\xcc>SM\x85\xc5\xd3\xecw9UM\x9e@E\x8d\xbeV\xdfb\xb4\x15T\xd9|ր5`\xa9v\xf9L\x0f=\x80IS\x91\xcbU%\xa5\xa2N\xa3\xc8\x1c\x18\xd2(6&HT\xefj.\x9c,=F\xe3]\xaf\xddsm\x01\xdc\x15\x9b\x10\x9b\x90|\x13\xcf\xf9;\x0e\x80\xfd\\\xf8\xa9\xf0SD8I\x9aJ7\xeb\xea\n\n\xb0\x14\x87\x15n\xe2q\xde\x1f\x04\xe4)\xe1(L D\x9f\xbf\xba\x9a\t\x9aD\xbd\x9f\xef\x9e9\xb2\xe5i\x16\xd4\x1ć\x89\xf0W\x98\xf2\x96P\xdd\x19\xec\xeeb\x92\xfe#\xc4\xc3\xcd\xef%2\xee\x95\x15\x11eK\x94\nSH)\xaf\x8b\x92H\xc3\n.J\xc9\xc7\xfe5e\xa0b}\xf4\x1e.,&\xde\xf8I\xc2\u007f#\xb5\x9e\x84zNC\x94h\x12/݈X\xa2\xc1\x01\n8CJ\xfa\xa8>9Z\xac\x84\xbbI\x1e*\xc9\x1b\xee`\xe1b\xa5\xa4\x97\xf47#\x97\x84B\xf9\xfd\xb1\xd1\x06B)y\x85\xa44a\n\xf6q@I\"R\xca0\x15\xa3\xe8X\x1a\xa6M\xe2x\xec\xfb\x88\x84\xb4XZ4\x0f,dL\x94\xf2h\x14C\xa4\",J\xd7\xe8m\x1c^\xfb\x8a\xc3k\xcaUd\x94\xd2q\xca$٠I\x88\x14\xd00\x14\x87\x12\xba`\xbd\xf1\f'i7\x93Q\xadb[^I\xae^\xd0\xe7y\xb76\x15\xc9w\x93\x11\xe4/\x00\f\x85\xa1q\xa1ZS\xda\xe2\xb5\xe8\x0f\xc4\x01\x8b\xb9x\x90q\xb8A\xd7\xc2\xfdo\xbb\x8f\x00IB\v\x97\f\xf1\x0f\x02\x0eš\xf1H #9I\xab\x84\xbfp\xd8_\x12\x00q#\xda\x1c\xdc\x0f\xb7p\x1aeE\x82\xf4u\x98\xa2\x17|\x94\xf5\xf5\x15\xa6\xc5\xd5\xe2n\xe1K3VT.Cv\xc8\xe5|\x16\xd5nl\x1f\x8f\t\xdf-\xf9I\x00F\xf1\xee\xa7\xdf\x1d\xf9ͭ\x8a\x9c\x89\xdd\xf9g\x84\xb2\x8dx\a\n\xb8\x14\x97\xe2\x1d l\xfc\xaf\be\u007f<\xd0\x1f\xae\x1f\xd2\xfe\xf8\x1e\xc6\t\xd7EMbl\x11\x19\xd6F\xa9\xfe0eR\x1fn\xa7?sC;\xaa\xf9\xfc!\xe3\x90e\x97\xad\b\xd4e\b\xee\xed\xae\xedۙ\xd1Yc\x16L\xe2\u007f\xb3~\xb7\xfc\xdd'T\xc0(~\xfa\xee8$\bHI\xbf/\"\x93R1\x11\xe6\xe1<\x8d\"\xe3z\x1a\xcc\xc3G\xfa\xe7M\x06Ճ\x16\xb5\xfd\x89s\xf1a\x98\xab~z\x80\xda0@C\xec\x97m\xfeoH\xa2\xb8(ǐ\xc3\xe6䈍E\x02\xae\x82\xd5DQ\xa3\xbe\xbd\x9d\xd9\x1dl\r5\xf3\x9b\xba:ּʦs\xea;\a\xbc\xba\xa6cS\x17\x1fjn\xad\xdeŶ\xb7\xeb\x8b\x1a\x05X\x83k\x88Ƣ\xaa\xdcmLlnx\x1e\xbdE\x97\x97_\xc0wm\xdazp!+\x9b\xbc\v\x0fn\xed\xda$\xe4\x17\xe4\xe9\xb3n\xf0W?=\x00\xd7\xc0\x1a\x98O\xb6W\xb5\xdf\xe4\xb2\nW\xcb\\rr\x98\x88:6\x8f\xfe[\xd6\a\xdaӼLF\x9c\x9a7y\xf7D\xf6\xc9əs\xb5\xc2\xd8/\x893\xa7;?\xfa\x84\xf98\xabo\xde)>.\xa9zd\xc5\xe9yO\xef~\x8aUk*&>\x9d5o\x9e\x80\xc3q\x181o\xfe\xe6\xc9\x13\x99\xa7v=sZ\xcb\xc7e\x9cw\xea\xc3-\x1f\xb3\x9f|\xd8y\xe6\xb4\xf0\xe5Xb\xae6sғ\x8c\xbae\xc0\xc4]O\x9f\x9a\xc7\xc7\x05О\xea\xdb\xf27V=\xb2\xe2\xe3\xbe]\xa7N\t0\x1c\x86\x11\xa7z\xf6|\xf81\x93t\x03\xd3S\x91\xa0QVH\x14\r\xd3P\r#\xf1\xa9C\xfc\x8eCu\xc7^\x057\x97>'o\xcd\n\x1ef=\x85\xe9p\x17n\x8a\xef\x85U\xea,\x13p\xe4\xb3w\xe3C8\xf5?\xf7\xc2J\x8dG`\x872\x0e^#5\x04|IG\x1dὄ4\xa4W\xf9!\x17\x15\x15\x01\xae7:D\x11u\xc4\xf6\x12xۤ\x9e\a\xe0n\xb6\x90T\xc0\xdd=\xdf\xc3m\x93\x84Xsx#ݼ\x87د\x94\x86\x00\x15\x1d\xa2\\\xa7l\xde\f\xed\xd1\xc9Dl\xa9\"\x1e!,\xad\"\x1f\xaeAZ<P\u007f|\xb3\x81AN\x18+ia\xac\xb3\xb7W6\x9b'E\xb4a\x8a\xee\xe1\x9e\xe4\x04\xe8I\xa7%\x8ftOc\x8d\xd8b\v\xa5X[ʛ\n\xab\x90\xeaM\xf6\x1a\xf4.=[Qa5\x1b\x84ؔ\xe8]x\xcb;\x16\x97\xc5eq\xa6\x88{<]\xd6.ïS~\xc4[.\xaaN\x8d\xbb\u007f\xf7\x83옉\xd9\v\xe6\n/.Ț\xe8\x9f \xde{\xf1>\x18\xf8\x9cj\xde\xe5\x1f\xb7|Ǟy\xb5\xba\xf9\xb0\xe03\xf8\x8d\xa2\t\x92\x9eNVW\x0e\xc0\xa4\xa7\r&\xa3\xd1dHi^\xb1\xb0z.\xfb\xe0}[\xe6\x8d\x13p\xe0sS\xee3\xdck\x9c\xb0k\xe2\xeb\xf3U\v\xcf|\x9c\xfd5\xab\x9e5\xe0\xbb\x1fv\x9f\xba,\xc0-\xef]\xfcA\xfc\xd5\xdde\xe92\xec\xf18=.\x8f\vny'9<%z\x17a\x16\xab\xad\xd5lU\x95\xcb+\n@\xf56U\xd54\xb9[R\\!CKQ\x8dJ\x83K\xc3\xc3\xe8\xdcm\xabt\xcbXuۀ\x99\xcf5\x1f\x9c)\xe0\x80\vk\u007f>\u007fFն\xa3;x\x94=\xf7~\xc1\x9a\xf3\x02\f\x98z`\xd8̹\xaaq\xb12z\xcd\xccg\vf\xb1\xea;z\x96\xad\n\xb6m\x13f\x9e9\xf03\f\xb8\xa0:x\xfe\xbd\xe6\xf3\xec\xd1n]\xee\x0e\xe1\xfcܵ\xc3p\xc0T\x15L\x91´(\xba\\.\xa7\u06dd\x12\xf0\xfb\x02\x81\xc0\x8e\x1dɟ,-\xab$\nt\xe5\xf9yL\x85\x12(\x1cOcڈm;*\x03\xa6@e \xc5\xea\xb29mnC \x19Ү\x12@(â\xf4\fP鱼h\x1f!]\x8bRt\xf4\x13\xf8\xd4\xeb\xf2:ݞ\x14x\x0ex\xa9!:\x92H\n\xc1 \xae?h!5\xa8\x1f\x97\x91NJ\x14P\xea\x1f\xf7k\x14!\xd8\xcbE\xd3\x14k\x16\x15\xe7\xad\x11\xe03\xa5\x1afk\xc9h\x9ab\xfe\xe4\xcd\xeb\xd6\tёёĺu\x9b'\xcfgb\xf1\b\x11\xea\x1f\xf7K\xdd\xd8\r\xc38Mb\x8fRKF\xbbq#\xad\xfe\xf1\"\xbe\x86\xaf)\xf1\xbb\xf0D:~&U\xe2\xcft\u007f>\x14\xa3b\x98\x8a\x87\r\x95\xd2$*\x9a\xa6T\xff\xb8?L]\xbfF_\xa7\xe4t\x85\x1aF\xf7\xdfR\xca\xef\x9d;@\x1d\x19\f4\xb8ᎁ\x1e\xa0\xc0\x0eT8\b\xea\x04\rQ\n#\xe8/\xd7]\x9e\xfe&\x1f\xbd$]\"\x0e\xad\x99\xd7:\x97\xc5[\x1ey\x04oѶj\x0f\xad\x11\xa4Q\xd1Q\x84\xc9\x17\xb0\x04\xd8\xd0믇\x02p\x99\x8c\x8e\x92F\x11\xa5\xf5\xfaP\vspWǁ\x96\x16Ci=/]\x8a^\"\x0e\xacm_\xa0e\xc6M\x9f>n\xde\xfc\xf6\x03k\xf9XC\xb8\x91Xr|\xeb\xdb\x17\x18\xb8\x1bn\x01%\xdcs\xe1\xed-K\x8f\xf3ү\xd1\xdf\xe2\\E6t\xe6L?\xd7_\xa4_\x89\x0f\x9e\xbe\xbf\xfb^\x167\xe0\x138\x017\f{\xf9\xfe\xf7\x9f\x11\u008d\xb1\x06bƛ\x97\xd7~\xc9\xc2h\xb8\rn\x83\xd1\xf1ջ\xb8S\xa4\xdc\xfe\x12u\x9e\x93D\xb8HJ\xd4`\x89\n\x8bQ\xf9\xed;XJSj\xc9X_3\x17MCJ\xee\xe1\xd1x[Kq\xef\x00e\xf3\xc1\x92\xb2Z\x01\xf6\xe2^\xa2\xa6\xbcaU!s\x9dRF\xc5H\xda>\x0e\xb5\xfds\xac\xa0\xed\x9fb\rS\x99\xf4\x8dp\x1d]\xd0u#\\\xc7\xf54`\xb8\xf8F\xa4q\xc0i\xe4Z*\xe7\xf8\x98\x04\x8a\xa4.O'AK\x17\x93a*\xf1\xc6OR\xc8\xf11\xd9[\xe0\xe5\xd6rٜ$&\xde؏p=\x19M\xa3'\x93\xf0+\x99&\xff\xed\x8agIA\x13\xa9\x88\\\xcb\xe6\xfe\x97;a\xedK\xdc\u007f^'\x85P\x84\x810\x1c\xc4\xfb\u007f\x00\xea\xc7] 6\xc1@\xa0~\x1c\xd4\xf9{\x13ܶ\x01\x06㭠\x1ezM=\xadB\xba\xf3Q\xfa\xe2\x8aמ\xaf\xe7\xff\x05J\xe2K\xfc\x02G\xfe\xaeT\x9fJ\xedQ\xaa[R;\xbb\\\x9d\xac]\x99\xb1f\xd3\xc2\xf5\xcbv\xccM\xeev\xf7Z\xe72\xab\xd7\x15oX\xbb\xaa\xb9=\x9b\u007fq,\xa1>\x95\xba\xfcHo\xfe[\xeco_\xbc\t\xb7\b\xae\xb0\x92\xbeG\xa1\xbe%u\x16\xaa\x95\xeeK\xb0\xa5\v\xeec~|\xe2\xf4\xa8\x89S\xd7L\xd9\xc4_\x9eMt4\xecڽ\x8f\xed\n\x15m\x14\x1e\xc5\xfc\xad8\x83)r\x17\xb9\x8bx\xb8\xae\xd0\x10zL\xfb\x9e\x94\xab\xf91\xad\xbe\xa5\x02\a(\xd4w\xdc!\xddw\xb7\x125\xa8~\u007fbSCs]uK\x8aK\xa7\x18o\x1f?\x9eIU\xa8\xa9I\xf7\xc1\x97\xca\xf5$v\u007fO\xcam\xa0\xbe\xe3\x0e\rN\x82:\x0e\xb5\xcax\xb8.\xb9=Ԫ\x8a\t\xf1`\x8c\xbfK{\x80\n\x0f\x85D/\xa7Q\\\x0eS\xb4&<\xe9\xd1\xf8\x0e\x96\xa3I\r&\xf4\xd2\xe1\x12Evcf\xc3\xfa\x86\xa9\xbb_lYR\x8b\xf7t\x8fze\xc5\x11Պ#ۏ\x9e`ζ\x9c8r\x90?x\xe4|\xf3\xc7\xec\xa7\xefo\x99\xf9\x86\xb0w\xfd\xbe\xa9{\x87\xd5ڒ\xffa\n\xba\tw\xbd\xab\xae\x96y\xc5|\xde\xf0\to|\xcdxȸcr\xdb\xec\xfa\x15\x01Up\xb2ֿ\x96\xc5\xc9x\xd7\xcaq\x0f\v\x0f\x8f[~/&1\xb3\xea\xa7wN\xe3\xeb\xb2\x1a\xb2\x9brU\x92\aE\xfa\xe2֣\xb9-\x85\xaaS\x8bw.\x98\xcb,+\xc9ؼ\x9a߲zU\xd6\xc2bUѢ\xa5\x86\x95\xec\xd2\xe5\xfe\xba\xd5ª\xda\rMYmYm\xd9{r\x0fV4\x16\xbf\xba\xee\xf2\xbd\xb6\xe4\x15\xca\x02\xcb\x06\xd7\"\xde3¶\xa0\x92)WVx\x8c\x1e\v_U\xd9j8X\x01\xa9\xab\x93\xf3?|\xc7v\x9a\xfd\xfb\xbb\xfe\xda\x1e\xa1\xa7\xe6d\xeb\xc9\xdd'\xf6\xbc\xb4\xb3\xad\xb5\xab\xabuo\xdd~U\xc7Ś\xf7?`\xbal{\xcc\x1d|\x92\x03\xac\xa8\x02U\xe4\xe4wc\xbe}\xe4\xcbA\xb0\x01\x8e\x84\xf3!\xf1\xf1?\xd4.\xf5\xbc\xe3\x1a\xe2n\xfasۉ\xb2\xfa<Uu\x89\xefY\x1c\xc4\xe0\x17ʡ\xde'\xab\xb5|\xdd\x13\x1d\xd3\x0e-:\xb4\xe8ܪO6\xab6\u007f\xf2\xafR\xb8\x85\xfd\xferuK\x9f\xd0\xd7rn\xf7\x89\xfd\xc7\x0f\x1c\xe8\xdeq\xb8awmwիU\xafV\xfdb\x86\x11*\xb5\xbbLJ\xaalt0\xf6v[s\r\xd3\xe4\xab\x11E\xde\xeb\xdd\xe9=\xe6\xdf\xe5\xec\xf0\xee\xf4\xab\\AW\x95Ȉ\xb6\x06K;_\xe4\x9an\x9cf\x9a\xeaN\xc1\x95Uģ\n\xdb#\xb5֟\\\xbb\x9c;t-y)\xb5\xa5\xfe-\xee\f\xcf\xecd\xf5\x8e:̯1\xe3Tf\x88qb\xd9b^\xbf\xa1xͶպ\x82\xed\xf9\x9b\xf36\xe7n*\xd9P\xa1*\x9c\x9dn\x98\xcaΙ]\xd79KXՒ\xb9'\xef\xe0\xe6S%\xefZ>+\x83\xaed;\x14\x11u\xf6\xa66\xe6\x92\xe7\xb0X\xc57\xd4\xec\b\x1cb_\xe9v\xfb\x0e\t\xa2\xd2\xd6\xeb\xac%\xec\xa5Y\xf6\x95l\x86}\xb1m\x9eM\xa5\xae\xb2i\xe7\xda_dK溼\x0f\n\xee5k\x9d\x1bزr\x87\xbd\\p\x8f%\xd4\xf3\x82\xd6:G\xa3]\xa5!.j\":\xfaIR\x13\x99\xa4P\xbb&\x92\xbd+9\rѳ\x8c\x8c{\x9fÙ~\x14L|\xc8\xfaB\x1e\xaa\xd2rH\x89J\xac\xe6\xa2bX\xbc\xb1\x8fnߍ}t\xb57\xf6\xd1\xed\xeb\xdfG\x17\xb5JI\x8cRU\xa4LZ%\xe7\x1a\xcd\x01\x05\xfd\xc1\xe2zJI\r\x11\x8f};L\xa3X;p\xa7F\xb16A\x93\xb832\x8bN\xfd\x03\x8e\xc2\xd1?R\t\x185\xb8\xc1T\xaf\v\xf2\xb8\x18\x16\x13A]\x89\xaf\x98-\x8e\xafWi\"\x11M$B\xe8t\xa6\x92b\xa6\xd8W\x12\xd4\xf1\xb0\x18\x17\xc7AX\xf0\x0e\xe7\v\x06\x05\r\xa1\xd0\x10\n\"\x18\xf4\xd570\x9a\xc8Qx\x11\xee$\xc1\x8b\xc9\xf4,X8\v\x17\xe2B\xf9x\x0e\x17\x9e\x83\x85\xb0\xf0\x1c\x11Ή\x8e\x83;\xc9X~\xf8a\xb8\x93L\n\xc1]\\\x98\x02jЍ\xcd|@\x84\x1fH5@\x19w\xb2\xdfTڭ\xf4\xf8\xea\xdcn\x1e\x16\xc0\x02\xc2\xed\xae\xf3yXxX\xa1\xfe\tEe\x84\x8a\x8a'9\x10#}˹\xeb\xe2X2\x1e\x19`\xfd\x92\x82\x9c\x1cA\r(˩\xd5V=\xf9,\x03~n\U000a5a93B\xaf\xe2?Vi\xa8\xff\xb88yI\xffa\xfcu\xd1\x1b\x1e\x03O\xc0]q,\x98\v\xee\x87\xfb\xc1\v'a6x\xfb\x9d1\xffk\xdf@\xbc\x00s\xb0\x15\xda\xf06\xdev\xca\xf9v\xedY\x95\xa3\xee\xcd\u007f\x80\xc4\xe7\x9cp\xf49\x18P\xbcs\xf9\xf3\xf7\xf6Ο\xfe\xdc\xf2{\xf2\x1e\xe0\x1f/<\xf7§,\f\xfb\x01f\xc16\x98\x80\n\x18\x84\xab\xb0\f\xefŧq\xfe\xf3\xcdK\xf7\xac\x13j\x8b]&s\x81\xa5\xacr\xa3\xa5\xdc\\Pb,\xf6\xabR\x0f/\x022\x8e\xef\x85\xe9P'\r\x80c0\x0f7b\x01\x9e\xc6'q;:\x1e\x9bp\xe4\xd5m\xfcr|ΰ\xd1\xc1\x94\xcc\xde4;k\x99*\xff\xbd\x0f*>f\xe3sz\u007f\v\xdf\x0f\x8f\xc3`\xb8\x9d\x83\xfb\xe0>p\xc21\x98\t\xe2U\x12\xd2\x15\xa6ӆ?Z\xbe\x91E\xfe;\\\xe3sO8>p0\x90\xf8\xf6?>\xbbص`Ɣ\x15C\xf2F\xf0\xe3\vϽ\xf0\t\vC\xbf\x87\x19\xb0\x15\x9e\x88\xef\xf7\x96\x81\xc58\x14'\xa2\xf6\xf9\xe6e\xbb\xd7\t\xb5%.\xa3\xb9P\x16\xd9Zn.\x8c\x8b|\xf7\xe1E\xa0\x82\xc1\fl\x86i\x10\x84\x18\xbc\x02sq\x1dn\xc7Wq<\xe6\xa2\xf5\xf1\xa7\x0e\xff?\x8c\xbd\t|\x13e\xd78\xda\xdaf&\xef\xbcZ\x95\xcc`\x9b\xc9̔Ev\x11\xa4\x88\bh\x15\x14\xb0\xb2\x1ae-\xfbҲ\x94\x16\xd2}K\xd2=\x93\xb6I;I\x17\xba҅\xa5\xb4,V\x16\x05\x01-\xa2V\x90%\xa2\xaf\x82/\n\xe2R_\x05A\xcf$gR\xee/)\xfa\xfa\xdd\xfb\xff\xee\xbd?Jf\xe6\x99\xe7\x9c\xe7\xe4\xc9\xccy\xce9\xcfYލ\xe7\x97\xe3T\x1f\xc9I\x13_\u007fv\xc3R\xb5;\x16FS\x9d\xd4o߮\x9du\x8e\x1f4D\xb53nў\x17X\f\r\xc7\x00Ԣ\xf6\x0f\f\x80P\x18\xd5\x05\x81\xef\x97\v\xb8\x19B\t\x11zɒ\x94\x92䭠\xa6\xe4\xb6pN\xde=\x1av\xc0y\xb0\xe1:X\a\x1e\xd8\xd1O\x846\x1d\xf12\xac\xd3\\ѩj\xddA\xcc;\xf9\xfbs\xf7\xf3\xde\x19\"\x8169\x82\fO\xc3@\\\x8b\x87X,\xc1\x15\xb0\xda_\xc5\xccw,\x81A?\xcd^pJ\x80%\xf0\x9a\n\x1eD\x1a'^\xc2Ŭ\xe6\x982\x1c\xaf\xe0\x15e\xb82\x1c\xae\xe0\x15y\xb8<\t\xda\xe1\xb8<Ap\x03\x840\xb8\x16\xd6\xe2:\\\x8bkq\x1d\xacŵ\xb0\x0e\xd6\xc2Z\"\x04\x86\x8b@\xf7@\x84\xdc\xe9;Ё`\xe7.v\xf9\x9f\xf0\f*\xc8-\xc94\xd3\x034t\x16\x91\xe9[3\x8cے\x9e\xfb*\x14\x86\x01\xa7\x02B\xd1\x03\xedwi\x93|J\xcb\x03\x90\x85\xebp\x1d>\x80Y\xf8\n\xbe\x02\x0f`\x16\xf8\xbe\xe8\x03\x90\x05\xaf\xf0\xb2\x84\x12\xb3\x98\x12th$J)^\x96\xc8W8!\x85\"\x92(^\x91H\xa0ݮe\x9c\xce\x1d0\x8eҡq$\x97\xcdEs\xdelp\xc9.\x88\xe4ܮDΟ\xe3\xc1\u007f\x05.f+\xd7{\x0fh\x8f\x14\xd8-\xbb\x82ܴ\xc7\xc5|\xc1)zE\xdfW\xcbW\v\x03(^\xd6+z\xd8\xfbg?Qv\x05\xc9.\u007fuDE/\xebOp\xbd4\xf9\x11\xd5W\xb08$|%\xe5wk\x14\x81v\xd30\x87\xa2\x9f\x81\x88\xfe\xbb9\xcf]7\xadC#\xe9\x96\xda:8\xe5n\n\xe5\x93H\t\xf0g\xd9\xf6\xf3\xabu\xf7\xf7\xfd\xfdL˛\xadSE&C$'\xeb\x139\x99\x0e\xde\xcay\xb3\xa3\xfc\xf4\xfa\x1aB\xfe\xba\xed\xf6Q\xdfෝ\xcc\xe2\xdc\xe7\x98\xcd\x1bb\xe3\xd6o\xc5I\xa8\xc2\xc9H\xe0\x04\xa4Գ&\fz\x1e\x03\xfd\xd9\xd71p\xd0\xe7\x1fM\x10p\x02\xfc\x13'\x83\n\x9f\x05պ\xe6\xd8]\x9b\xf7\xaa\xbd\x1dP@y\\\xc1\xd7_\xbc\x14\xc1\xfb\x18u\xf8\x1b\x13\x86\x8f\xb9\x85\xaa\x8bK\x04x\x12\x03`,\x06\xc0\x93\x18\xa8^\xf6\t<\xf0\xe4\x15\u007f\x16\xf8+\xf0\xc0\xf7G>\x11\xe0I\b\x84\xb1\x10\x00c @}\xfdïoA\xb06\xc4\xfd4T\xe2$\x18ܯ\x0e\xbev\xff\x02W5\xf1p\xdb3\x88\xc1\xaf{\xb7\xc1\xd7D\x83N\xf5(\xa3\xd9\xff\xb3\xe5\xa5\xcfP\xc5b\xf0\v/\x8c\x18u\xf1y\b\x12\x8aE\x02\xc8K\x9f\xffd\x11t\xc1%\xde\xcd\ft\xe1\xd30\x107\xf3\x9ax|yʰ\xe9+\x04\xcd\xfeb\x18\x8cW\t\xb1ttAo\u007f^\x84\xab\xf2qrwɮ\xf2F\xfe\x83\xa6S\x1f\xded\xe1\x9f\xef\x8c\xc10Ai\x85\xabd\b\\\xa2L\x14H\x9a\xfd\x9a\xf3\x879\xcd~\xa0(\u007f\x0e\xd6.Js\x1e>\xe74\xfbq\x1a\f\x81MC \x95\x85M~\xc11[G\xbc\x1f\xf8\xa6\x8ex?HG\xf4\xf4\x87\xcd\x1cރ{\xf7\x03\xa8\xd7nJ\x8f\x8f\x17\xe0w\x0e\xfaA?\x95$\xd9**\xb5\x95\xf9\x15f\x89\u07fc\xbbq\xcbQ\xb6e\x87\xa3\xb6N\xd8\xd7\xfeξS\xf7\x93\x88+\xa3\xe4Q\xaa\xdaԭ\x8e\xad\xec\xeb뗬\x8f\x15RSL\x86\x04m\xf4\xce\xc4ݛyɜm\xcbb\xb3\xb2\xf3\xcdf\x01o\xe1-\x95ٜ\x9f\x9d\xa5ͲeKf~\xf7\xe6ĝ\xd1l\x82\xc1\x94\x9a\"\xac\x8f]\xb2\xfe\xf5\xfb\xf5\t\xe5Q\xca(x\x81;\xb5\xef\x9d}\xedBm\x9dcG\x8b\xf6\xe8\x96\xc6ͻy\xb3T\x91_\xc9VV\xd8$I\xf0\xd1\a9\x94*>>}\xd3Zml\x95?\x86\xfd\x9e\x12\xf0W(\xf7\x1f\x10\xe0\x8f\xe4\xf6?\xa9Q\x1c쥦r\xa0\x97\xf5S99RId>Y\xd6>1\xe9\xd9\xf8\xc7\a\xce\x1a\xdc\xfax묻\xad\xbf5^9\xffޗ\xf6[r\xbfP\xa9\xbd\xa3\xa1ݬ\x16\xc9vs}LN\xacZ~\x1foO弴\x9b\x9e\xca\xc9z\xd4O\xe5\xc0\xa5\xe8\x19٬\f\x91\x87(fŬ\fQ\x86(f\x8c<\x86\x93\xbe\xc7\xc1|jE\xbd\xb1\x91թ҈\xc6zgm\x85\xf0=<~\f\x9e\x85\xc8\xfb1\xb8\x12Dq\x1eZ\x17ltR\u007fF\xc0\xf1\"\xe9\x0f\x82;\x88\u007f\x94\xf8sI\xf5\xd5+x\x8fs록\x83O\xa0\x06\xa7\xe1\x1a\\\x83\xbe\xe3'\v\x16ڤE\xc2\x1b\x8e囒3\x93\xb2\x92\xb2\xc3:\xfe=\xe2}\x15ҟ\xbd\xf0;<\xa0\x855P\x03\xd3\xe0\x13\x1e\xe8`0RB'\xf7gp\x81\xe0\xfeX\x87[\x98\xbf\xa2\n\xb6q!\xde{(5S\x81\xa2G\x13\xe4\xf9\x87g\x19#\x99\xb3\xedYlA\x81\xc5R (\xe1\xc3\xe1yy\xb5\xaa\xc4^l\xb7kw$\xd7m\xe5E\xbcB&\xa5f\xa5\xe4\v\x18\xed=[\x98Y\x9aQa\n\xcb-\xaf(p\xb2\xa5\xa5\xa2\xb5T\x90G\xdc\xc2E\xdec\xf9I\x95I\x8d\xb9a\x16\x9b\xcdR\xca\xd6nwT\x97\v\xf2~]p^Yua\x8d\xb1\",\xa4Av\xf9\x98\xa6\xbe/ %\x83\xa2\xe3\xb39脱\xdcH\xd0\x13\xbb(E\x02}\xcfs\x14\xb8H=\x85\xae.\xae\a\xf5\x8a\xf45\x87\xfa\x91\xf7\x1d\u007f\xfd\x85\x80\xc1u\xbf\xcbH\xd0\xcb\x12\xeaGvq\xffK\x93\x8f_\xe9T\xe1\x10\x11(\xd3p\x95\xf2\xd0\xee\x85LYRRi\"\x9b\x98T\x90\x9b$(_\x903fϞ1c\xf6\x87\xe7&sc)\x14\xe0\x1f\xf8\x0f\x10@\x80\u007f\xc0?@\xe0ݒ|\x9c)\xf1\xe7\xfe\x96C\xc6**ؠ\xa8Y\x85R\x02q\x83\x12\xf2\xa4`)\x12\x8b\n\xb5\r\x9cTVj/\xe3O\x9d>u\xe0k\xf6\xa7\xab`\xe1*\xd9*h\xa1\xae|\xf0\x13\xfb\xd3\x15\xb0pUl%\xb4PW?\xf8\x89\xfd\xfa\xd4\xda\xd7O\vyee\x05\x12;\x85\xf3J\ue34co`~8\xd9`m\xb4644Z\x1a\x1e\x1c\x1c\x02+\xfd\x01\xe1\x19\x94\xdcW\x8f\x8e\xbe\x9f\xea\xde/E\xba%\xa4\x99\x14J\xfeS\xad\x8d\xf0҄\x97֩\x1a:8\xaf\xbe\x88\xeb>O\xb9}\nl\x14\t\x927\x82\x81\x13$\x9c\xc0\x13*\x88E\xfa\xbe6\xeb{q\x8d\xcel)\xab<\f\xae\x90z\xaa\xd7\x05ZN\xee\xf4g\x9d\f\xf1f{:\xbb!\x02\xc2)\x18\x04\x1aH\x01ͳ\xfe\xd8X\xcdM\x8d\xc7m\xf0\xe8\x19\b\xc2ǎ\x8d\x9e\xce5p'\xff\x88\u007f\xb9\x81\x9bF\xf1\x10\xd1?*a\xc6\xca\xc5|JbbR\xb2a\xda\xd3s\x86\x9aP\xa5\xc6\x14\xc2\x04\xaa9\xbfN\xbb\x96\xbc#\xb1!\xa5Q\xbd\xf2X¹n-,\x85\xe5\xf0 \xcc\xe457a&,\xc7\aq\xa9\x00t\u007fh\xc6\n\x98\x86v\xb4c\x05N\xc3\xe6\xc1\xedO\x9d\u007f\x9e\xd7x\xce?\u007f3\xbc<\\\x1d\x97\x96\xbaq\x83v\x9bd؞Ƨ\xa7\x99\r۴\x1bj6\xefL\xe5s2\xf3\xd3ҵ\x9a\x9b\xaf\xbc\xbb\xe94?\xaf\xe5\xf4ڏُO\x1f8\xdd\"\x9c~w\xcf'\xdb\xf3kr\xaa\x84\x9d\xb55\xbb\xf7j\x9b\xcc;\xd2k\xf8\xed5Ҏ&\xed\xdeԶ\xb8\x1a\xbe\xfc\x8f\x9b\u007f\x9c\xff\\}\xfe\xf3\xf6\x1b\xbfiC\x1a\x80\x86n\xdfB\x03w\x19\u007f\xf2*\x9d\xa7\x01\xf4DmF\x15\x14p9fmNI\x8e=\x8f\x87K\xe8R啕\x17HlmUu\x8d\x00z\x9dj\x05|ȹ\r\xc1\x15\x9c\xce\xd3@Fp:\xd5\n\xd4\x13\xfe\xf0Y\xbf\xb4`\xe8\xcb\xdei\xa2仌\xb9 '/\x97\xc7;xG\x95\x97g.\xcca\xcd9%\xf6<\x01\xef\xc2]U\x9e\xbdP*\xd7\xd6TU\xd7\xf2`\x80\xbb\x84ob\xfd\x96\x88\xbb\xfe2\xafn\x1a\r\xe9\x9c\x0f\xa1\xf4'Bw\x04\x93\x96\x99\x91ʣ\x01\xef\x12\xbb)\xbf\xfd\xe2.\x1aH\x9f>!\x95\x96\xdb\xcb\x04\xb8\x03wTv\xbbTR\xceJ\xe5\x85yv\x01\xee\xe2]\x95=\xafĜ\xa3u\xd3ؙ\xce\xe1=\xec\xdb\xdd8M\xf5\x95>D\x03\xe8\xfd?\xf8\x1d\x99\x06}\x1d\xa5\xf9\xdd#\xcdf`\xc9j8\x87\xe6\x13\xfc\xae\xe9\xb9S^\xd4~\xb9\xec\xd8\xd3c\xb4\xf0\x04\xf5Wq\x04x\x82z\x85\xe3\xbbɽ\x85{\x13[y\rΜ0hm8\x1b>\xe8\xc0\xd9\tBb|\xe1\x86\rZ\\N~\x1e\u007f\xe2\xc5g\xb5\x9a;\xfe\xf8\xb8߯\xbc\xeb\xfa\xa2\x95\x87\xe5d\xa7\xbds\xfb\x01\x1e\xcd\vV\xe39\\\xa2\xed+\xa3\xcb\xf7\xd9\xe5{`\xc2\xdf\xeb\x86ཕ\x14l\xe1\xc0\x95\xdcF\x81t\x97\xea+\x82\xaa9\x1e\xa0\xe95\x9a(\xb7\xeb+\xaa?l\xa0&\x9c|\xe9r<\xaf\b\xb0\x9a\x82P\x126\xb7\xc2$\x88\x80\u05f5\xb2DN\xe6\xf2\xf2-\x96|\xfe`\xe2\xca\xd6%l2\xd75\xff\xe2\x8e\xc62g\x9d\xa0\f\x81Ք<\x844\xd65\xe6\xee`\xfb\x1c֖\xac\x8c_\x93(h\x8e\aX\xf3\xf3\xady\xecX\xca\x1f\x92\xf1:L\xc2\b\xd8\x1c\xcfc(\x891\x10\x8d\x91\xbb8\x88\x84\x18^\x16HM\xaf\xf1r\xeb\xc9\v\xff\xf6=O6\x1fg3\xf4q:\x03t\x06\xb9i\x1fK\xef۳\xf1\xde\xed\x1f\x05k\xb8n\xc2︌\xf4\r\x0ei\x1d\x06x$R\xa6=\x9d\x10\xc3E\xe90\xa0\x1bns\r\u007fs\x80swA'\xf3\xbfy\xb6\xfd\xe5\xd6\xc6\xff\u05ed\xed\xff\xe0\xd4\xf6?]\xda`x\x83\xdf\u007f\xd2\xf7?\xb0\x99\xeas줙f\x8e\x8f\x92\xe9n\xe2\xf7o\xc6\x0f\x10tn\xa3Wb\x92(\xde\xe4O\x17\xdb\xed\x96\x18\x88\x81\xb3\x14\xc6\xf0:4\x8e\xa3\xbc\x068\xea[\xae\x8eSm\xdd\xe4AJ\b\xc1{\xf0\x00\a\xafpnZ\xa7:\xd9\xc7ޔ\b\xf2\x06\x15\xb7+\xe6\u007f\x9ezi\xf2\xd9\x13/~\x1e\xcf{\xeba\x0f\xe7\xae'?o=ẢuKnz\x19\x87\x12\x01\x93(\xc1۹\x8c\x02\x83\"\x11\xf7ˋ~̡\x04\x8d\x14\xd0\u007f\xab\x88\xd0+a\x04\x135\xf8\xcfh\x97\x11\x94ۀ\x06ƫ\x9f\xc0\xf9[P\n\xfe\xeb<\x04\xef݇\xbf\x9f\xbd\xfd\xbfU$h0\xfc\x99\xc1]\xea\x9eI\xb9%E\xcf\xd4dT\xa7\xf1QDZFF\x9a\x10E\xa6Ug\xd4\xf0\xddDMuu\x8d\x80\x12\xb8^\xe2\xfe\u007ft\xfa\xff\xb8\xfd\x147\x8d\x12P\xd2y\xae\xc1\f\xeeU*\x85\xd2y\x02H\xa0uD\xe42N\x87>\x11\xdfm\x1cə\xa8h\xceO\xb9o=H\xe2@\x82\x14\nV\xfb\x89\xd7\xc0\xeb\xff\x93\xf4\xa78\x8dz\xda\x14\x0e%o'\xcc\xe0|\xd2\xfaF\xff:\xa1\u007f\x8e\xd2\xe4\xaa\xda@\xf2\t\xea\n\xad\vn\xf0g\x1a\xe8Az$\xb9\x9b\x8a\xea\xb3}vC\x1c7\x12\xe8\x9e\xf3\x94\x86\xd2w\xf7\xd9A5\x05/\xc9R\xdb2\x0e]\xd0Ih\xe2\xf7\xe8)E_\xcfa'\x94\xf9cc\xfb\xa8\xf3\xfb\xfb\xba\xef\xdbe\xff\xa6\xf7\xd3\rԟeUE\x904\xee\x06\xc8\xe4\xa6\xfei\x04\xb0\xdam\xce\x12\x1b,\x80\x05\xa1\x9a?N\xdaJ\x9d\xa5v\xab\x1a\x9eB\x89\xf0Њ4\x95\x03\xda\x1dq\x8a\x83\xf1\xfe\x9c\xf7\x92\xdc\xc9h\xdc\r\xfe\x14\xa1\xe3(ta\x04\x19\xb3\xd8o\x16\xf8\xe3\xa4\xdf.\xb0uk\xf2\xe2\x18\xad\x12A\x96s\xe07\x04\x10H\xc2@\x18\bD\x99\xdf4\x90D\xdd?\a}4\xd7\xd0\xe0O\x06\xe7#\xb4\x9f\bR\x0fD\x80\x9d\x13!\xa2\xc7G\xa4\xd1D\xc9\x11\xa78\xcd\x1fF\x18\xcfi\xdcFԓ\x9a?\x8c^\xdam`\uefff\x1a\xb7\xb1\x97\xee\xbf\x11\xd6pm\x84.\xb8\xc1\xf7\x0eG\xdc\xe00\xc2\xf7\x0e\xbbH\xb8\xeb\u007f\x877\xea0\xa0\xcd\x1f\x87.\xb6\x81\x1e\xa4\x8d\xbe\xbf\x1bT[?\x1f\xff\xd6\xecՑF\xd9ňb\x1bvv\xf3x\xb7\r\rݪ(\x02\x8f\xc2]\xd5y\xf8\x81\x1bI`\x04\x11\xb5\xb1[\x89 4W0\x82@=\x18T#\x89\x8d\x1bU\xa8o\xdb\bt\x0e\x89\xfam*\xa4I\xd0oS\xe5 \xbdq#\xeaɶ\x8d*\xcd\xde\x1e\x02|}!B\x89\x88\xda\xd8M@\x04\xd1\x03?p/\x13\xe0C\xddM\x80\xa1\x1b\ueda9\xa0\xb3[l\x13\xfd1\xf4\xfe*:\x81\xbe_\xa9/\xbc<c\xbd\xf8\x1c;m~\xd7EA\xf4o\x83kARh\xc2\xe3{ؑ\x06\xbd\xca\xddIv\xb7m\x04\xbd\xaaWOlDZ\xa5\x18|\x87\xee(\x15\xba|\x1c1\x12}\xdc\xd0\xf9\xbb\xe8d\x91F\xbd\n\xf5PO\x85\xf8\x03)\xf4\xcc\x1a\x9f\f-\xa1\x8b\\\xc3y\xe8\xb5\x14\xbad\t\\k\xa9^\x9a\x04\xc9\x13\x11\xce\xf9\xbbm\xe0Ѕ.\xc2\xd7\x17]\xfe\xacv.p\x11K)t\x81\x8b\x84TN\xee\f\xe7\xbc\xd9_x\xd2\x02\x8fx\x16\a\xb9I\x88`>\xa5\x14N\xf6\xff˥\xca\v\xa4\xe5\x9cL\xf7\xff\u007f\xb4\x85\xf4\x01Uz\x16\a\xc9\xdf\xcb.&\x8b\x92\xe9\xfeYT\x88r\x0f\x06x\x16C9\x05\x06f:%\xd3\xc1\xd3\xfdm/\xf8\xdb\xdc\x11\xcc\f\x9f\x9a:\x83\v\xe9\xbd\xe7\x1fS\xfe2\xc8Mz\f\x8cTP\xbe\x9c\xfb\xfb \xf7;T\xca_\xf6\xe1\xef\xe6\x16-R\xe5\xe5\x15\x98s\xb49\xa5f{\x1e/s\n\a\xb7\xa9\xfbm\xe6R\x9fLq|Q\x85\u007f\xb4F\xf9K\x1f\x05w\x99\xbe\xc1O\xf9/ݝ\xcc\f.\x04\x86\xaf\xa7\xfe\x96\x186\x9b\x93;;8(\xa6\xdc.\xf8\x85\xf2g\x80\xf5\x97\x92p\xfb\xad\x83\xee\x93\x19\x94\xdcIDDM_\as\xfd\x05\xeb;\xa2.]\xd3ʝ\xf0\x18\x05\xc3Ş\x91\xa0\x8f\xea\xee\xf6\xd7J\xee\xe7{\x03\xfc\xb91\x97\xc9\x11 1\x1e\x97\xdd\xc7\xca\x05\xae\u05f5\x8e\xf2\xeam\xd4F\xf7]f\x1a\xc5{\\\x10\xc7y\xf5\xe4n\xaa\xd7U\xe4\x97p\f\x8c\xce}\x92\xc0\x88\x91\xc4\x1b\x97V\\\xe7uD$q\xfdҡ\x93B\x0f\xa9y\xb77x$\x03K88\xc1\x85(\xf7\xc4n\xa0\xbba#\a[\xb8 \xc8\xe4Vs2\r\x11\x1d\x9c\xd7U\xc4y\xf4\xe7}\xdf`1\xe5ue\xf8N\x1e\xa3z\xb3\xc5w \xe2\x1d\xb7\xd4\xd3\xe6\xfb\xba=\xb2\xab'H^\x034\xb3|\xfd\xfa\xe5\xf0\x01\xc7\xc3\x1a\x1f\xb0\"\x15qn\xfdyJ\x96\xfa\xc8B\xbd\xfb\x86o\x8d\xe4a\t'@\xe7\xf6˜Ok\xe8\x95\xfajR\xcb\xd2\xc7\\\v\xe5\x96t\xc1\r\xe3\xa9\xf0\x86$\u007f\x0eZ\u007f\xae<\xb7$\xbbj8\x0f-\xd35}u\xe4\x02\xe1\xfa\x9fwt\xc1'\xb7\xfb\x14\x06\xfdv*\xa4\x01\xf5 -\x02\t\xf2A\x92o&\xf7\xeb\x86\x1c\xaa\x99\xd2yf\xdfo҃\xa41\x04\xc8;` \xe3\x13\x1b^\x9a7\u007f\x9ao\xe4\x8b]\xa7/\xf0r>\xaeb\x9e\xe7\xbcz\xb7\x9ey\x9e\xd3\x05\x1fT\xf2\x99\x8b\xf3\xba^Zʽ\xd45\xef\"|\xcb\xf5.\xf4\xa40ק^\x1ag\xe4DJ\xf3b@\x15\xa7\v.S\xf2}0\xb4[\xcf\xc0{\x14\xaf\xd9\x1d\x00\xff\xa6\xe4V\\\xc5\xfc\xc4\xe1#C\x87\xe0ð\x99\xe25\xf1Fx\xf8֯\xf0\xc8\xfd\xe4'4\x13N\xddO`\xa6#F\xf8S\x98\xc9\x11\f\x04\x1b\x81\\\xf9;\x0f\xffAA\x19u\x1a\xaf\xb0h\x00\x89\xf8\x82S$E\xfa\xbb\x15HR\xa4w}K\x8b\x81\x94\xa7¾g\x94\x84UE\xab\nW\t\x96\xf4\x82\xd4tmJEz}UY\x99\xa3\x8c\xafnp\xb69w\xa8\xbb\x80\xbe\x00Q\x10\xae\r\tG\xfa\xa7@\x11\f\x10\xe1\x13\xa0#\xc0\xe0\x93t\\\fL\xa7\xdct\u007f\x98NAD\u007f\xfc\x00\xf7.\xc5B\x1e'\x13X\x88{߆\x0f\xe0\x89\x1f\x80\x85\tg\xbb\xb3͟\b9'N\x14\x9edO\x9e()?!Hg?\xae8\xcb\u0084\x1f\x90\xc5'\x040\xc04\x06\x86\xac\x06a$\xa8\xf8\xdaSRW\x97\xf6r\xda\xe5\xd8Oy\x1c3d\xc6h\xfc\xa7\xd08f\x94\xe3\tv\xd0 \xe3\xb6!\u008f\xf8\xe0\x90\xf3\xf8\x14\x1b;=-2R;_\x9aW\xab\xe7Gb\xf0j\xe4q\x88\x16\x83\x9c\xf8\xcfw\x87\xf0\xb8\x038\x991@\r\v\x95p\xaf\x05\xea!T8~\xdb\t\x14\x04\xf9\xab\x99\xd1\x1e\xfa\x0fN\xee\xab%\x1e\xf4\xaa\x8f#\xf8\x13\x96\xc1o\xd4\x1b+\x0e\x9d\x14<z\x98\xee{G>\xf6=x@\xbb\xf5\xff\xe7\xcc#\xfe\xe4\x14>\x8e\xea\xc75\x87\x82\"\u007f\x10\xcc\xef\xf2m&Üe6\xf2\xf86\xdeS\xad\x8c}i\xc3\x1bl\xaf\x8bH\xdcT\xb6\xb3Z\xaa\x94*\x04x\x1b\xee\xa9:\xdb/\xec=\xc9z\\D\xe3\x9e\xdc-\x82\x0eE\x98\xc0\x8c\t_8m\x9e\x80\x12~\xaar\xff\b\xd39\xd9\x05\x1fs\x18A\xcaE8\x81i\xca\xdfaj\xe0\xbb7O\xdf3\x9dE=D\x10\xf0\x02\xa5t\xfeE\x95\xc7E|\a\x81'.t\t\xf0\x0e\xdcS\xb5\xb6\u05fdu\xb8\xaf\xb0#\xd2P\xc1\xc9\xfaO8]p\x9c\xbc\x92q\xb6\xa7tlp\xc6:ק\x18c\xd5\xe1\x1b\x86M\xc5`\x16\x83\x87]\xfac\x83`l__\xb7\xc1\x18kL\x89qƪq\xfcq\x18\x8a\x010\x85\x85)0\x14\x02`\xfcqA1\xcb\xd3\xc7s+)Y\x0f+)?B8\xc3\xc0\xf8E8\x14\x02p\n\x8bSp(\x06\xe0\xf8E\x821\xb6.\xa6ݸ\u05f8\xaf\xceٮ\xfec\xef/\x97 \x98\x85\xe0_\xa6\x86\xef\x15\x9c\xb1\xfbRڝ{\x9du\x1d\xc6v\xb5b\x96\xbf\x19\xcf!\rG\xb8O\xb8\x14J\xd6/\xe3\xbc4Qι\xe9Q\x9c\"\xc9\xfa\xf1\xfey\xd6GA\xa7\x1c\x01\xfa~\xa2O\x19;\t\x92\xe6\x88\xdb%\xeb\x99\xc9\x14\xeaQOhR\x92)У\x9e|\x9e\xd2\x1c\x01z%\az\xd0\x13\xa3(\x1e\xe8h\n\xf5\xa0'_\xe6\x90&eW\xd4T\u007f\x1a\x17F\x93\xf2\n\xb5\x87\xe25G\xda8\x01|\xba\xb6&\x05b}\u05fd4\xf1\x03\xf7W\xe6\xe7\xfe@\x1d\xfb\xf2;\x01\xe2\x89\xc9\xdf\x01\xb5\x18\xfa\xb30\x89\x13\xfc\x1a\xdfT\xbfx\x894H\xbe\xd5s5\xf7Wu\x94\xde6\xa3L\xcb\xc1\x8c\x94[f\xe6_\xf2\xf5\xba\v\x95\x1c\x1a\b8\xc1\x8d$\xcde\xb9\x12\x0f\x86\xcf(\xa4\xe1\xee\x9f}<\x92\x12\x01\x95\x9cf@@\x80\xf7\xee\xdf\xfa\xb9\xef~Fy\xff\xd6\xef\xbf0\xbfQ\x9a\xad\x91\x01\xff\xb7\x961\xb6\x80ߨ\x97(E\x92\xff\x82\xf0\t=\x95\x9c&\xfe\xf1\x80\xbf\xce\xc7\xfc3\x008\ns1\x98\xfc\x9f\x14\x01\xad\fftn\xa3\x87\x86|Χ\xac\xd7\xfa\xe4Rb)'\xf4\xfa#*\xf29\u007fn\x14h\x02\xba\x02h9\x01\xe8\xf2>)\xf0]\u007fl\x11\xadY\xd6L\xc9\xd9̆Y\xf8\xe0\\\fb\xd7ĖWm\x11\xb6T\xa9N\x86\x0f}\x17G\xb3\x9a\xaf1\f'/\x98\x8d\xa1\x82\xdcCv\x83\x1a\x9e\xfa\x18\x18\x16\x04P/|\xe3\x0f!\xf1\xd01\xd3Ipq\x8aB\xea<'ݻ\x19ͲX\x8e\xd7d-mYu \x91\xd7a\xc8\v\x9c\xe6k]\xf0o$\xa8(\xd8Ci\x96\xc1ZJ\xf3uJvvR\xa2֛Fj\xb2\xe0i\xae\xa2\xc4\xee\x10 \xd3ws$\xa5\xf9\xba\xc1Y\xd5ؤ\xfd\x93\xe2G߫\x94\xc7\x00]\x06\xcc\xdfi\xde\xe7v\xc9{\x19|Ą\x83\xa3Q\xfd\xba\x1af\x91@v\xc1\xd0c\x10\xe2T\xa3\x9e\x94\x9f\x0e\xc6G\xf2q\xe0\x02\xa4f\xaba\x1e\t\x0f|\b\xc3>\x80~v5.\"\xdd.e\xf5_\xdf7v\xb3\xdd\x19/\xc0\\2\xab4˖eWw!\x89\x8f\x9c\u0091yyb.\v[\xc9\xe2\x9a\xed\xd6\xed,\x8c\xfc\xf1\xf5y@\n\x95y\x95\xf9\x95\x05j4\x90\xa0]\x05\xe3\xce\xdd<\xa2\xc6WI\xf9\xf9\xe01\vq\xdcs\xa8=\xa0\x86\x85d^sKQ+\xb88M?\x9c\xe7\x9b\x15\xe8eb\xb9\xbfM\x88o:4\xaf\x80\x8aҘ`\x0f\x05k\xa9\xbf\xa6\xe3\xcf\xc9м⛎\xb7`$u\u007f2|\xbc/\xb0RGl\v\x92\xaf\xca\xc7\xf7s\x9en%z>\u05eb\x9f\xc0y\xf4\x10\xca\xc1p|@\xa7Z\x1axDG\x0e\t\x92\xbd\xc01 \xf8E\x9fP\x9f\xec\x03\x02\x15\xb6\xe88\x8c\xa5\u0094u\xca:x\x90\n\x93}G\x9e;~\\%_\xf7\x9c`l5\xa6\xda4۬\x9a\xd0t[\xaa)?M\xf9\x87\xce\xf3{h~\x9a#\xb5&\xff\xa3\xb4\xd0\xed\xf9\xb5\x0e[\x8d\xfc\x0f\x9d\ua7e1\xeeǽ\xc2~\uefe98\r:\xd5+\xb0!\xc8Mˋ\x19e\x80<\x00x*L\x1e \x0fT\x9d?I\xa5Pm\f\fN\x85u8\x12\x9e\xe4\xb1\x1f0\xb8ȅ\xbb\xea\xd4P}\x92\x82R\x126\xa6\x01\xbf\xf0ڨ[\x18\u070e3\xe2\xb0Œ!eV\xe7\xab-%%b\t[\xe1\xb4;mBY%\x8c\x85\x0f`\x8d6\xc4_3u\x87\xfc9\xb3xi\xc5\xcc3\bx\x11&\xcfݣ\xf2, a\x1c^\xde=\xe7\xac\t\xe4\x05*XP\xc1\x1c>\x94\xf9\xd6\x1cI\xb1\xb8B\xfd\x05S[3ӪRY\x91LM͌\xcf\x120QY\xa2X^\x9cc\xce\\yhE\x98\x1c\x80\u007f\x8cW\nU\xcbs\xb7'\xeca\x9dU\xa5\xe5Ղ\xfc:\x18\xfd\xc5Us\xaa\xab\n\x9c\xec\x9e\xed-\x87s\x85\xf1r!܃?T\u007f\xf7\x17M\xd5\x0571\x95\xcd\xf9\xbb\x93\xebԕ\xfe=\\\x93\xa9(\xdf\xecӻ\xbctBV\xe6沸\xb0\xb8\xb2\xe6\xe4ݬ\xecR\\\xc4\xe6\xfa\xad\xbbry\x8b\xcdf\xb1\xb1M\x8d\x95\x8dN\xc1M\x9b(UH8tQ~\xb9F>\x05\x12#\u007f/\u007f\xafR\xbe'\xbd\xf4\x11\xff9)\x9f\xf2F\xcc\xe7\xd0\xe0\xfe\x9e\x00\x11,\x15\x95b}veXVE\xb2\x98\x95\x8d\x16\x14C\xbd߃\x81\x98A\xf9\xebxN\xe2\xc0\xe0\xbd\vU\x94\xfb.\x1a\x88J\xce_\xc6\xf3!*ě\x8dt\x8f.\xf8dO[O?\xb1G\x04\xbd\xd8\xe3c+\xfa\x1eM\x0ftv3\"\x97B\x05\x8b\x9c\x9bF\xbaoAՀo==y\b\xeeP+\xde\x10z\xfa\xef\xa3xMO37\xedମ\rB\x11\xae\x1d\xb0\x1cg\xcf]\x9c\xf1z\xda\u00adj\r\xc8yd\xd7ރ\x1f]\xd4jz`\x03\xe5\xef\xc4{?$50\u007fÚYӴ\x03\xbe\x19\xdfH]\\\xf3\xd1\xfc\xbd|\v\xf9\xf3\x89&\x18w\x9c\x87\xe7N\x81\xf4U\tx\xd5}\x90~\x9fL\xfd\xdc$9\xa2[\xd6wÍ>wL\xb8\xf1\xe7\x95&\xdd(GA\xedY\n\xa6\xac\x82\xa9\x1c\x1a\xe4N\x18\xc8i\x8cF]\xf0-\xa4\x99/6_z\xfd=\x1eo\xc0\x8d\xb4\x9a\x94\xca䊰T́V\xdcy\xdf\xe9\x12\xbe\xa7֯\x8f\xb2\xae\xdf\x17\x86-Њ9\xa9\x15ɕ)5ia\x9at#|\x8b\xd7\xef\xfb4~q\xe9/\x87\xc6\xf7N\xed\xbe\xf4\x85V\xf1\xbac\x18̓\x01\xd9\xf5Yui5\xf8-\\\x0f\xed\xf3\xa2|n\xea_.\x94\xfa\xd77O}N\xfb\xdc\uea67\xf4<\xdc\xc0\x1b5iuY\xf5\xd9>̵\x90\x8b\xad\xd0\noPM\x8e\x16\xb6\xa5\xc9\xef\xc2ق\xad\x90S\xabr?4\x01\x06rJ\xa7\xdc\tS9y$L9\xebSOD\x9f\x8c\xa6\aW\xa0\xe8\xa6}r\xdaa.Jv\x11\xf0(7\x8e\xe3\xbb\x15:\x8a\x88\x89\x8b\x8b\x11\x90\x86a\x1c\xd2:U\x00\x03\xcfr\xa8\x1fɁ\x14\xcd\xf5\xdekH\n\xfc\x8f<7H\x96\xa0\xd3\u007f\xc30\x92\x83\a(a7tp\x1d\xd6-\xbbbXt\x111[\xe2b\xac\xc2N\xaf\xa4\x8a\xb1\xee\x8e\xeb`\xe1\x1c\xb7{W\x87U\xd8\xe9\x96T!\"L\x927C\xbf\xf50\x19z`H?\x9d\xea5\x18\x87\x04\xf4\xd3ti\x9a/R\x9a.XD\x11\x9a/p2p\xe4[\x9c\xfbQ\xf7\xb7\f\xf6\x9b\x84\x19D\x15\f\x82k\xf5\x90\xab\x85\xb0!\xd7\xf0\xf1\xa7go\xdc\x00z\xae\xbd\xf6\x00{\xf2\xed\x98\x05\x82\xa6\vz\xb8\x94\xe2L\xbe$^%;\x89\x10\x94\xa0\x1f\xf4\x87I\xf02\f\x81\x1c\x98\xdcO\xa7z\xfd\xfe@.\x8d|\x91Ҹ`\x11\xe5\x1b\x85x\x8bsk\x16\xfd5\xc8`\xdf 9\u007f\r\xb2)\x16\xf4\xdc\xdeڃ}\x83@\x0f\x97j\xcd\xe0K\xfb\x86\xf0\x8bx}\x96X\xd8\xc21^\x89\x80\x1b2\xadrK\x84{\xa2{&s4)z\xd7\"\xd6+\x11\xebbҷl\x13z\xe9^i\xc3ڜ7\xea\xa3â\x1b\x8e\xc6\x1dg\xdd\x12\xb1\xbfc\xfb\xce&\xc1C{\xa4\xbd\a\xcaO&\x1f\r\v\t\xafIjI\x02\x84\xa8~r\"Di\xe2\x9b)\xf9\x15\xe6\uef1f\x91\xe4Q\xa3\x1f\xf12\x1b\xb3\xa9\xb1\xc9 \xc4\xed\xc9;\xfc\x96\xf6\xaa\xf3j\xe5\x15\xbe\xd8Q\xf6AI\xa9\xba\x98\xd4\xec\xcfC\x02\x89\x04$\xd9i/\xec\xbc*\xc0\x03\x10\xd4\x06!Z\b\x1c\xff\xc1\xe3\x03F\xcc\xf3\xe3\xf8Y\xff\xb26&q\xd36\x03\x1f\xb7iy\xdeJv\xe2Dg峂\xa9xnYA\x89Z\x13\xdf+\x91\x96\x12\xb1\xd8\xc2W\x16\xd5\x16\xd6\x15\xd5\x16\x86e]\xb9j\xbcʾuؾk\x8fд\xa3qO\xc7\xf9\x11\xef\x81F\v\xe4ϧ\xef\xfe\xf6\xcd\\\f\xe41\x04\x836\xe2\x03ډ\x9fm\xb9x\xe7N\xbd\xe37\xde\xeat\x8aN\xd6FZ\xbf\xd8x\xf9_\xda\xcfv\x1f\xff\x8a\x87\a\xda0\bBX\b\xfc\xe0\x9b߄\x10\xe5\x1e\xd20\x94\xa2\xe3S(\xb7\x8b\x99\xcc\xd5P$\x8c\xa10\x1a\xa2A\xe4\x81&\xef\xb7p\x178\xf4;\xd8O\x83F\x0e\xa7\xc1$\xff\xf67N\x83\xc18\x18\xa6\xc1:\xee\xbe=8\x14\x1e\t\x84S\x14\xfd\f4\xc9\xd7\x19\xf3\xbe\xbc\x03\a\xb5\u007fby\xbezj\xebL\xfe\xd6-\x95\xe7\x17\xf9\x17ߊ5\xf1j(\x8c\xa5t8Z\x87\xa3C\xe1A\xea\xea\xc4\xd0ʬz\xb1\xb2\x12ʠ,\xf4\u008a\xaf⮙\xd5\x17\xb8?\xe1gS/\xbcT\xd76\x9b\xef\xe9Qe\xb7\xef\xcf=\xc0\xc2:N\x00\x9a\x04/xU\xca#\x10\xca@\xc82\xa4n \xcf\xc2$\xbf}\xd8\x1d\xe8\x0eT\x85(\xd9}\x11\xf3\x1e\x17\xe8\x83<.\xd9\xc5\xe0\xe3H\xe3c\xe1\x13\xf8I\xafN\\\xba0^\xad\vN\x90\xf5^\x9a\x04\xbd[Z\xc6\xf5\x99R%R\x99(\u007f\x83u\xcdF\xbe\xbc@*\x92$\xa9\xb8\xfc\xc1\xc1\xcdN\xf9\x1b\xa8\x93'jC«9\x8f\x94\xc6\xc19j \x85\xf7R\xfcrfO?\xe8\xec\xe9\x81\b\xa4{4\xfbeI\xa7\x8a\\\xcdy\xe86\xc6Q \xe5\xd8y[^nq.[T$Z\n\x05E?R\x95Yh\xcc\xca\xd0\xe2{\x84Tokء\xed\xd3;7:⪷\xf2\x9a\x1f0\xfa\x95\xa1\xf8F\xbe)}c\xe5\xda=\xe9\xea|\xc9Q\xe4`w\xec\xf0\xad#\xc5V `\vİm\x18D`\xff\xd5O`\b\x0e\xd2z;a\x18\xe7\xee$\xe1I|\x00\x18\x1c\xb2\x9b\xbf\r\x12\x99\xe1̪*\xe4e\xfdH\xd5_YYmvA\xb3\xbf\xdc^*9|lؠ#V\x04\xb6\xe9\x88\x15A:U\x83\xc7ň\xbeE\xa4\x93\xa9M\xafJ\xc5[\xf8\xda$,\xc6\x12~\xfe\xbc\xb93\xe7LS\x1eN\x0e5YLyyf4(thnvq\xba\xc3\xd8\x1d\x15\xdaW\xb9\xa7\xa4D\xb4\x96\b\xb0\xeb\x1a\xb4c\xfbӸ\xab\xd0X\x92]n\x0e\xeb\x8eR\xfdH\xd9m\xd6\x12\x9b \xd3`\x90\xecv\x87\xd5\x11V/?|\xf1\xcc\xd9\x0fNw\x85\x81\t\xcc\xdfC\x02\\\xebK8<\v\xbe\xea'ꈯ\xf0K\xf8RsMG\\p\x972;!4\x0e\xc2&\xfdG\xfd\x9f\xc9\xf8\xf0\xfbȲ:\f&\xe0\t\xfc\n\xf6Qp\x90\xf0}N!\xf6\xed\xceN\xaa\x11j\x93l\x1bsb\xf0e\xaf+\x14\xbe%4\xe7\xe1\x19w\xa9\xbfXC\x89\xa7\x01J>\xe7<\x03`\x00\x03s8e\xb1\xbc8\x14xJ\xf6\x1f\xe7R\xb7\x1f\x0f\x85\xa7(<\x83gB\xe1\f\x9e\x81\xa7\xa80\xb9Q\x1e\xe0\x0fa\xfc\x13ֽ\xb2\x0f\xf6\xf1\xdb>H8\x03gB\xf1\f\x9c\xf1\x89@\xb7o\xc3\\*L^\xac,\xf6C\x06\x1e\xe9+\xf2\xe0\a\f\xd2\x05\x17\xcb~@\xec\x03\xc130\x87\v{\xfc\xb6_vZ,/\xfe;(4\u0080\xbfúO\x81\xe9\xef\xf4\u07be\xed\xa3\x16\xfa\xe8\x843>\xd0ۏ\xf7\x01.\xf4\x11{O6^\x87\x97a&L\x85\xb7a;\xe7\xe9\xf5<\xcfx\x9f&\xaaq\xa6\xca\x1dJ,R:\x11\x87\xa9,\x05$\xf6\xfe\x9a\b{T0\x95\xa8\xc4pX\xf8\x06,:\xd9\b)*E&\x96\xca{T\x1e5\xa1\xc3|x\x9b\x01Z\xe7Y%_ħ\xe5\xcb\xf04\xd0:U\x9d*\xa4\xc1\x1d\x04\xc7\xe158\x1eX\xa1#~\b\x82\x9d\xc0Ps\xf1\xb8J\x17|\x84X\x8b\xaf\xa9t8\x9d\x98w\xffz:~\xaf\xa4\xe1x\xe5Y\x18\xbf\v\x86\xaa\xf081\re\xf7l\xacw\x1f\x81z\xa8ө\xc6\xfb\xf0\xd1~\xb3\x8dOi\x1c&\x1b}:\xa4\x14\x05z\xd9\b\xc34\x1fz\xf4:U\xc0j\xce\xed\xea\xf5\tEw\x89\xbf\xd7\x06\xfeuh\xe8X|\b_\x18\x87\x8b\xe3\xd5n\x16\xf4\xe4X\x0e\xde\xf6\xe9\x91\x13\x88\xcf\x0f\x9f\xb8\xfa\x13\x94p\x8b\xa9Q'&~\xbe\x9c\xc7\t\xa4\xe62\xea\xc7QH\x93\x9a\x0fGs\xa0\xf7\xb2\x04.n\xc5\x17\xae\x8f\x85\x87~\xfd5Tr\x94T\xe6W\xa8+\xf2\xb3˳X\xbcKh\\C(\xbcKf\x95gW\xe4\xf3\x96\xd2RK\xa9?\xeb\xf1\x0f?\xa8p\x1e\x8c\x85\x0e|\b>b\xe1#\u007fU\xcey0V\xf8a\xb4*\xaf\xac\x1cJ(\xcdO\x15\xb6\xf2\x8aJm_\xddXU\xe4\xdf\xea\xc6z:\xdd\x12\xe35\x80\x8b8G\x8d溉g9x\x84\x12е\xf1~Ҏ\x90\xfb\xdb\u007f\xb4[\x82\xa1\x9c[\xf2'@\xf6Hnz\x11\x05t\xff\xeb~e/\x96\xfa\x9b\xde;\x89\xe3\x81\xee\xff\x06\a\xcf\xf9T\xe8a\x1cޓ%\x99\x86\xed\x9cL\xbb;\x99\xd7\xfc\xa3\xfe\xf7\x10\xa2\xf38\xe0\x0e'\xfb+\xe4\xfan\xbb\xf5\xc1\xf7?B\x1a(\xcf6x+P\x04\xc9w\f\x82\xeb\xdcT\x0e\xee<\xc1X\xcd\x05\xe6<8\xc0U\xd6X\x84\fX\x89\x13a\"\xae\xacV\xa7Y\xb3\xe0 e\x97J%\v|\x9c\x06\x16\xb4\xd4\xe0\xc7j\x99\xb60VsaN.\x1c\xe0\xaa|\x00\xf28\xbc\t7\x95q>\x80L8H\x95\x95\x958,rM\x9a<Q\x99X\xa3Ԩ\xe1-\xcfk\xe1\xdc\xe2\xff\xf7d\xfa2\xed\xedf\x94Nt\xa9\xf2L\xc6B#k4\x96\xd8LBrEv\xc3\x0e\xad\x9b&wT4\xd4W\xf0\xf5\xcef\xb1.[\xeeDWh\xbd\xb1\"ɠ5d'%\x1b\xf9\x90\x06\\\xae#\x1c\xf8\x16\xbc\xd5\xef\x14tb\xe7\xfd\v\x8d\xcd#y\xce3\xcay\xe5<\x9c\xc7\xf3\xf2y\xf9\xbcJi\xf2\x8ed\xe0\x9f\xfe\xd8^\xff\xab\xd9+\xf765\xbd%6m\v\xf3\x17\xfc\xf7\xbddMu̱c\xe2\xd5\xc5Ǳ\x1f<\x1a\x1a\x19\x89!b\xe4\xe5\xc8\xcb\"\x84\\\xbe\xac\xd64\xe0\xa3\xf0\xe8\xa2\xe3\x13\xc5ŋ\xc241\xbdB/\xbfh\x918\xf1\xf8\xe20x\x14\xfb]\xbe\f!\xe2\xe5Ȱˑ\"\x86DFB?\xec\x17zl\xf1U\xf1\xf81\x8f\xe0\t\x0f\r\xf1iX\x01\xed}\x06\xf7^\x9a\x80}\x9c\x87&\xee\x9b\xd7\xf1\x1eD\xfbs\x93\xf8V\x19\xfaZ\n\xe56\xcc缮\t\x9c\xdb5\x89\x02Z\xa1\x99\x04\u007f\x9e%\u007fΥ>\xdb\xf3p\xca\xeb\x8a\xf2)\x02\xf7-\xa9A\x9e\x12\xcfD\xc6\xd9XК^\x87.\x99\x0eݶk\x91\xb8-\x0e]\n\x1d\x9a\x9eR\x18\xefHT\x1b\x9c\x8d\x99\xcd,\xe8\xbd4\xba\b\xb3\xa9 7O\xc0\b\xd4\xe7\x98,Y\xb6\xec0\xa3\xad\"\xb7\x82u\xeb'Q\ueee0'\x9b\x1b\xabv8\x85\x10o6\xd4Q~\xfcm\xd0\xc1eg\xe7\x18\xf3\x85|\xa35\xb3\xdc\f\x11\xa8\x0f\xed\xabG\x0f./\x8dzbkbF\xa2Q0\x19Jⷧ\x80oܾ\xa4\x84\xe0\xa3f{]i\xabq\x87\xbaјX\xbd\x95E\xbd\xfb\x0eQ\x9bY\x9dʇ,\xa4 \xces\r\xe2\x82\xdc/\xf41B\xf4\x80\xe7o\x8cp=\xae\x0f\x95\xd7\xcb\xeb\xff\xc6\bM\xd0\x18\xce!\r7\xa8\xf7\xdd\x12\xd4\x04\x8a\u007f\x9e\x05\xc9\xc3\xe4Hf\xc1\x02\xd5k=#\xdf'O\x9cPA\xb5|\x90\xc9-\xcf,2\x9b\xf10\x1e\x0e5\x9b\x8b2\xcbs\xcbr\xaa\x8a%\t\x0e\xc3\xe1PI*\xae\xca)Sc\xab\xf7e\x06;\xa1\x93\x90}\x12|'v\xaa\xba\xbb\xfd\r\xdf\u007f\xbf\u007f\xffG\xe2\xfeua0\nG\xed_'\xceZ\xb7.\f\x8e\xc0\x11?\x01\x1d\x9e4\xbfw\x9e\xff\xd8\xe7s\x90\x90n0\x98\xf8\xc2\x1csQ\x0e\x9b\x96n\xaf\xcc\x16\xa0\x91S\xf6({\xfe\xb6\"\xec\x91\xf7@#\x97R\x9b\xbf\xd3\xd1\x14\xb6ñc{\v+\x1b\x8a(\xc5@\x82$K~\xcc\x11>y&\xc2\xff\xb6ޅ\x88 \x8fI\x9e\xce\xf4\x95\x03\xca1\x17\x97\xe4\n\xdb\x1c\xe9;Z\xb4\x10A\xe2\x9c\x01\x9b_\x9e-\xbc81\x05G\xecƼ\xe2̜̬|uQA\xbe\xa5\x805\x9bK\xcbs\x85\xbcr\x1c\x0f\v Z\v\xe2Y\xb8*_\xe4!\x82lپ\xa3\xc9\xc1\xefp\xec̯M\x81\xbbx\u05f74\xaaa:|\x13\xcem\xa0\xa0\xb3o\a\x11:\xfd\xc6eYπD\x82\x84\xb4\nhy5\xa3H(\xa9\x94\x83$\\\xe7T\xd0\t\x83\x98A\\\"\xa5\vNu\xdff\x1a\x8d\x89\x95\xdbX/MlK\xccJ4\nƤ\xe2\x84*߃\xa0\x0fM\xab\xa835\xb0\ru\x8e\x1a\u007fJk}U]q\x8b\xb1!\fX\xef\xbc\x0e\xaeW߷;\xe1\xd1C\x1c\u05eb'A\xf2\xe8\xc3\xfb\x90\xca\x13\x18gCQK\xa6\xef\x89և\xd6d\xa78\x92ؤ\x14S\x9a?\xfd\xb6>3\xa5(\xc1\x99\x14\x96\xe8l\xccjb\xdd4ѧ\x84\x03\x8b\x15\xff;R\x8f\xcbM3\r\xa6\xba\xb4\n^у+\xa5*\xa18\xc9\x18\x96h\xccJܦ\xf5\xd2\xe4\xb6\xca\xc4F#\xdf`l)\xae\xab\x92\xf5\xe0\n\xad\xa9p\xd45hA\xaf\x18\x98\xc9T\xaf~4\xe5ѯ\xe4<\xfah\xea/\x9c\xbe70\x05\xcaॻ\xf02\xe4BR\xe0/P\x1a\xe4Y\x03\xa9LQy~y\x8e-\xa7\xd4d\xcd)T[\xa6m\x8b`\xf19\x1c\xfc\x1f\x18\x13%ȏ\x11Օ\xce\xea\x12\xa1\t\x82\x8b\xa5\"G^yXY\x9e\xd9fb\x95\xe5D\xd1AK{I\x9bZ\xce \xb6\xdf\xefB\x14K\x85\x8e\xbc\xb2\xb0\xf2<s\xa9\x89Ub\b\x18~\x0e\x06܄\xc9\x17?M\xb3\xb25\xf95\x8eҚC0.\xb4\xb8\xdc\"\x15\x94\xe5\x97\xe5\xdas\x8a\xd5ւ\xf1\x8d\x83X<\x81\x12\xceƽO\t\xc3GPH\f\xa6\xce\x11E1\xe2Z\xebF\xf5L\xa8\xe6P\xd5\x17\xdf\xf1\x13\x81o\xff\x1bJq.|\xc2\u0083?\xb4YK\x85j\x9b\xb4\xbd`\xbb\xda\xcf`6\xe0\x06\x88\x93\x1f\x86\x052\x03\xe9\xfd\x80\x81R\xf9)H\xd2dxl\xf2\xa0\xf9\x1c>Mh\x8e\r\xa1p\xa6\xf2\x14\xa9ɐ\x1f\x86\xd3\xf0\x10\x05\x8f\xcd\xe1\xf04l$n\u007f\xfe)\xa8n}3\xddb\xe5\xed\xf9Ra\xb9U\xb2\x96\x95\xda\xcb~\x03M\xa8\xa3\xd2R\x97\xedT\xdb\xf2\x90\x81\aq)\x8be\xf8*N\xc4\x14\x01\xb7\x8d\"\x87P\xf8\xd8`\xea'E3\xc2G\xe7\x88\xc1\x94g.\x84r\xde\xec\x1f\xb9O\xa1\xec\x17Xr\x17\xecA\x9eup\x87\x81<\\s\x05_\xbd\xc8\xe3\xc0\xf70\xe4\xedߵ7\xaf\u007f\f\x1a\x18\x01\x0f\x85[\xac|y\x9eT\xe8(vX\xcb\xed6\xe9\n\f\t-\xab(\xaa69\vJ1\x00\x1e\xc0\xe5;\xd40m\x0e\x87,\x91\x96\x91\x99*\xe0\f\x12\u05ee\xc6\xc18\n_\xd5b)\x18\xc6\u008ci<\xb0\xaf\x03\xb5t\xa4\x16U\x03\x16\fǗ0\x1c\xb4\xd6\">\xaf\xcc\\b\xb6\xf4\x15\xdf\x1f\x8cl\xa89ۚ\xe6ȶ\xe5\x01\x8dᐵA\x8dS\xc9\xd4\xea\xcc\x1a\x1e\xd8I\x14L%!\xa6\x03\x86\xc0xX\xa1\r\xf1z\x1b\x92<\xcf\xc0\xb30\x18\x9e\r\x14=\xfd\x83<\xfd=\xef\xce\xe7\xf0y\xc2\xf8^\xd2;\x1b;Ր9\x89\xba=\x87\xf3\xbaFP\x18\xd6\xf7\xfb\xc0\xe3\x04\x84\u007f\xb76\U0008080c\x1dA=>\x98\x92\x9f'00\x0e\x1f\x98\x8b\xd3X\f\xf6͐\x86\xbc\xef45\x0f\xa3a\x18\xbe\x04\xe3\xf1uu\x8e]*p\xb0\xb0\x80\xa8t\x969m\x82\xddYT\x95#\xe1H\x98\x85\xe3@\x8f\xc3a\x1c$\xb7\xc2Pxt\x0f\xe8\xd50\xd97\xbf\x19\rI\xd0\v\x93\xae\xc1\xe4@y\x10\x18\x83\xe4_\xfb\xd7T7r\xf05\x1c\x82\x87\xa8\xdf\xe6px\xe8\x1a\x1c\x9aD\xdd!k|\xfc\x1b7\x12pV\xfe\xdc\xe6\x10+\xf2\xa40G\x9e\xc9nd\xf15\";;7;_\xc0הgR\x96'\xaeL[\x11\x96~ \xfd\xcdmo\xe3FyJ\xa83\xcfX\x96\xcd\xe2\x1b\x84јg\xca\x13\xf2Mb\xb6\xcd\x04\x9f)\x97Cq\x03Ts\x83\x06S\xd0\xf9\xf4\xf7\xd4`\xdf\x11\xbf\x86*\n\"\x89\x10%\xbb!\t\f\xa0\a\nbe=\xc4\x06\x82\x04\xe9\xf2I0\x05yvz\x963\xa8\x1d5\x16\a\xf0>.A\x88\xe0\"a\xc0OcQ+\x80\xa9?8p6\xcc\xc0z^T\xf6\x91C(\xef?\xa0\x93\xc3\xe1\x83)0 \x85\x86\x11\x14\xd2\xfes\xbdo\x9cI\x93(\xd0\xcbž\xa7\x97\x9e\xc3)\xc5@\xc1\x0f\x04\xd4\xc3l\x98\x01\x0e\xc1\xa7\xbd\xb8ix\x9bӑ\x01A\xb2\x13~d\x8a,\x85EZK\xb1Xl)\x8e\x84\x93\xa10\x8e\xe8\x00\x9a\xc1Db#\xd0*8B\xc0X\xa4\x99v\xa0U\x17\x88\x0e\x1f\xfb\xfcX\x89d\x8a,E\xf7aJ\x9e\x85\x13\xa1\xf04\xb1\xd7\a\x93F\xc4\xfa`\x8e\x13\xf2C\xe8\x13\x84\xa7\x13>8x\x9c\x18Ca\x10q\x13~.\x91\x8a\x9c~&a\xb2\x99X䈌,Sf\xa10\x1a\x15\x95\x12H\f\aEUU\x90\xed\xc8`\xb1\x1f\x91\x99e\xca,\x10F\xa0\xa2B$\xc6\xfa\xee\x14f\xf9\xeep\x84ɔ\x9f\x9b'\xe4\xe5\x14\x99JL7\xf0\xe7P\f\x9c\xc0\xc1@b/\xd2*\x9c:\x81\x835\x04D(\x8a*\xb3\xa4\xd2T\xc5\xc28\xa2ڟ\xb0\x1b&Ɋ\n\xd6\x12\xf0\xac\xef^\xe9\xfd{U\x95\xfe{\xcf\xf8\xee\xc5B(\xa7d\xe7\xc3\x1c|\x05\xa2\xe0;\x18\rg\xe0\x15|\x1e&\xf4\x03\t\xeap,\x8c\x86\x10\x18\xad\x89\xd2\x05\x97zh&\r^\xc1\xb1\x1f\xe2\xe2\x1a5\xd8p4\xa9y\r\x8b\xc8ի,\x96U\x02\x88\xbe\xeb(\xb4\x91\x96\r\x1b\xc4M\xacHb\x8cU\xa5\x99\x85ϐ\xa0*.*\x12\xb5\x1f\u05ef\xdfh\x17\xf0M\xb2\xd4.Z\xecB~\x9e\x98\xcf*C\xc8\xe4\xc2\xecM+X\xb1\xb8\bU\xc5\xfc&\x8cRi\xa2`)\xb1\u007f\x9fe\x1f\x8b\xe5\xa4F\x84\xd1`#,ou\x8a\aY,%5Q0\x1a\xca\tpV\xc1\x8cOv@A-,x\xf2[\x1cҡּu\xfd\xc8\xfb_eA\x98\x1a7*o1\xf8\x1a~\xd98\x88\x87\x9b\xe4\x1d\xf8r\x17.\x16\xa6\xc9\xf1\f\xbe\x80\xcd\xf5\x11<\x9c!\xbf\x85\xe6V\x9c)\x84\xc0\x03\x9c\x8e\b\b\xf2H\x1e\x89\x917\x11\xe6*s\x85ɡ\x8c\x92G\x85\x9a\x1c\xd9R\xa6\xa4FZ\xb12r\xa7\xf7~>]\x9d\xfb$9\x9f\xe2\xfda\x8d\x9eN\xc5\xd7z\xe8\xa4\x10\xd2\xe7\x1e\xe3{\xbc\x88\x00\xfa\x9aNu\xccs\x84\xf1a\xe9C\x12\xa6l\x927\x91}\xb8\xd5J<>\xe3\xaf\xf6$\xfcoXI\x85\xf6\x1a\x98C\x1c\xe8\x83\xef\u007f\x84\x84\xa3\x04\x84\xbc\x1f\b\x90~\x87\xc1\xf0\x00\x1c\x80\x87!\x94\xf2(@P\xbd5C)M\xaf\x11\xe6q\xee/\xf0\x9fp\x91*2\v\x99E\xaab\xd2\xe9\x84\x19\x14d\x90\xbfq7<\xbd\xb0\x96#4\xdeH\x98\xe9G\xf6_$\u007f\xe2\r\xa5\xee\x00A\xe5\xfc\x89\xca3\x16-\xb0\x96\x83\x99\xdcS\xba\xe0\x1f\xe1\"e1\v\x99\x85\xaa\x12\xd2\xe9\x80\x19\x14\xe9ÔA\xfcƅ@\x18\xe56\xf4\f\xa5| \x9dH\xfb\xe4\xde\b\xca\xed\x82l\xceKGPn\x1a\xb29E\x1fA\xc9z\xc8\xe6P\x8a\xa0@\x82P\x0e\xc2(]\xf0ɍ\xf3\xef\xb7L\xa2\x14\x03\xb8\xfc8\xdc\x112=\xff>\x84\xef}v\xeb\xe7\xdfG\xf4\xd7U\x1f\xfeP\x0e%\xf8\x99\xf3\x93\x1f\x9b\fK(\xd8\x06\v8\xd4\xf4a1\x9f\xb7r\x9b\xdd+?\xa6B|\xf3\xe6\x19\xe8비\xd2\x05\xff\x1b\x16p:4\xf5uZ\x83揩\xdd:U\x8b\x95\v\t\x17wr\x10\x11\x047(f}ܖ\x98\xf5[v\xee\xebعk\x1fDp<D\xc0\x14\n;\xc7Q^z$\a\x9d\xa38\u007f\xa6g\xe6θ\xeb\x83x\xdcM\x94\xb79\x1c\xceFu\xeb\x89]\xa7\xafj\xbf\x10a:\x1a \x98\xe3W\xac̞e\x9c\x9b=\xcf8g͚M\x9bbW\xac\x8f^>o$\xfes]\xa1zC\xe1\x96-\x1b\xb4^\x86\x8cٽ\xa5\xdd\xc2wX\xce$}\x9c\xa8^\a\x01\xc0.\x85Al\r\xf19Q[%\x96\xb2\u05edg[\x0e\xbd{\xe0H͇y\x97\x9b\x9b\xea\xf6:\xf6;\xdf\x14\xdf4\xee\xdf\xfdf\xf5\xdbE\xc7\xd5U\x05\xbb\x8dm|\xfb\xc1\x86\xae\x93ڐp\x0f\r+\xa8 \x9fH\xc3\xec\x8b\xdb\x19\xb3~˖\xf51;\xe3\xf6\xbdIA\x84\"1c)\x1e;/\xfb~\x8dJ\n:\x8b(/Mz\xbc0\x9cq\xb6\x95\xee\xae\xd2\x16\x1f\xcfx{\xf3\x9b\xce\xfd\xe2\x9b\xc67M\xfbS\xf6nm\xb2_N\xfbp\xed\x91\x15\xef\xcel\x19gU\x17d\x8a\xa9\xec\xf3D\x1a\x81\x83\x90]\xba\x0e\x03\x84W\x13\xe7$\xc5XԱ\x96\xcd[b|_d\xc3\xce-{\v\xf9\xfd\x85=\xf8\xcf\xc3\xf3\xf6E\xb7\xafس\xe9\xe0\x1a眊yι\x15\xb3\x0e\xadT\xd7S`\x10a\xfa\x17W7\x9fN8!\x18\x1bM\x8e\x9c65\xec&\xee|{\xfd\x8eU8\x99t:\xf6\x00\xefS\xae\x83 \xfcCP\xbfw\xed̻\x17\x80>'\xe7\xfd\xd4O\x848\x88\xf8컳\xcbnV\x9c\xcb\ued9d\xcb\u007f\xaf\xe2\xac\xf1\x83ҳg\xcf]\x858\xcd>\xd7~\xcfH\xc8d6\x98\xd2S\x8cF\x9b\xd3ȃ\x81t:\x9d6'[\xe7H\xdb \xe0XRS0\xd0\x06\xafB\b\xd3N\xb6;k\x1a\x1b\x9di\xb1|,\xe9}\x14\xea\x18\xccx\xe9_ʤ\xcf\xe4I_\x11\x10\xfd\x1a3\x03\xf7\x12I>\xbe̚\xf3m\x0e\x8b`)\x16\xa5j-옉\x87Ȅ\xe2\xb4R#\x9fb\xaf67\xb0\xcd\xcdջ[\x84\xd5\xe7H\xb0\x81\xc4t\x145\xe5W\xf3;sR\xab\xe2\xd9m\x86̄$\x01\xc6\xcfPL\xe7\xf6\x91\xf1\x8d;2w\xb0\xad\xb5U;\xcb\x05[uq\xf3^-\x94\xd60Ӊ\x04KF\x816\xad\xc0dNg\rƚv\xe1\x13\b\xfb\x9c<\x98\xbb3\xb3\x8eoN\x8d\xaf\xdc\xc0\xc6\xc6gmM\x112S\xf2\xb6\xac\xd1\"C\xae)\x8b\xabJ\xe5\xb7ֵfu\xb0\xed;+[k\x85\xf2\n[eq\xb5\xdaZf\xadj\xd4*\vܟ1\xed\xc6ڤDSj,\x8fc\xc8XGZc\x83\xb3\xa6\x9d\x97w+\xa7\x18M\xcag+\x9e\x16\xc7$O\xe0\xb3\xe6-Y1?W\x9d\x81\x1c\xf2\"r\xac\xffX\x8d\x9c0\xbflɡ\xac\xd3\xea\xe4\u007f\x8b7\xafiE\xf2\x1b\xebͺ\xaf\xf9\xca\x0f\xde9tڮ\xae\x06\x1d\xf0\"p,\xf0\xc0\x8b\x19\xa0\x13\xba\xf2\xdeYQ5W]\xf7\x8c8f\xbcV$\xbd\x05n\x81\xc1\xe8\x17 \x9a\xccݗqbۅ\x8c\xa3iG3\x8e\xaa\xffE\x9c\xc4\x05\xef\xc2\x02\xd5e\x02\x96(\x0f1kr7g\xa6\xf2[S\x12\xb26\xb0\xb1\t\x95ͩBf]\ueb83Z\xe8O\x1e,\xdbUU\xc77\u05f6T\xb6\xb3{[\xb2\xb6\xd6\tU)eqk\xb4؟\x84\x99\xf8 \xf3&\xb99~k\xc6V6)C\xaa\xcd\x15\n*\x8ajZ\xb4p\xf4\x1c6\x93\x9a\a\xd4\x01\xe9b\x8e\xb5\x887Z\xed\xf9\x12[Y\xe5h\xa8\x16`\xdf9\x85 \xe5\x19\xd0\x15\xee\xe3\x11\v\xe1\xf9\x0f.\x03q\xf9\xf2\x8f\xf0\xc2\xd7W\xe1\xf6\x95\xbc\xe4~\xad\xb0\xe9Wxpگg\xbeL\xfbn\xfb\xbfrn\xda\xff\x9d~\xb3\xeaB\xfe͒\xeb7!H\xe3u\xed\xf2,\x87#\xcc\xe1\xac\x1d\t\x19\x19\xb9\x19\x19<\x9e\"3\xaa\xabs\xabٖ\xca\xc6#\x02܄ȗ\x8c\x1d\x9b7g\x18\x96\xf1\xd1ߑ\xbd\x8b\xa1\x86Q\xce\x0e%5\xf2g\v\xe5\xb3\x03\u007f\x84O\x9e a\xa6r\x969E\xaeZ\x1f\x9b\xbc\x91\x8dK\xaah\xce\x11\xf2\xab\vwthe\xf6\x86\x12@&\x89Fk!\x9fY\\\x96[\xc1\xd6\xd6U\xed\xac\x17\xe4\x90\x1b\x8a\x99\x84\x1f`\x18\x13]\xb4!7\x89_oܚ\x12\xc3n\xdcT\xbb\u007f\xb3\xb0\xe4)\xf7\xc8\x1b\xa4\x86\x18\x11\x80\x9b\xc9}\xbb\xf6\xd4\xeee;\x9aS\xd69\x85\xb2\xe4\xe2\r\xd1Z\\D\xc2M\a\x13'&\x17\x99\xf9\xa2\xe4\x9cTc\xba:fۺԥ\xecҘ\x9a\xf6\x04!\xa5\xc9t\xf0\xa4\x16^ OJ\a\xea\x9a\xf9\xf6\x96\x8e\x9a\xa3쑎\xb4\xd8\x16\xa1v\xab\xb4\xf6\r\xed`\x9c\x1eAFW\x1b\xf64VV4\xd9x\xab\xcdZ\xbfO{3\x8bP\xeez\x1eg\xa2\xb3\xb6m\x8e\xcbl:\xca\xc3:\xf2hՎ]\xbb+\r\xd1<\xae#ݫ\xbd9\f\xa8E\bI\x80`>\xf5\xcbWN?'\xa9\xeb\xf1U\x9c-\xe2,\x16g\xe1l1\x19\xa3\x84)\xe6\xa8y)\x93\xd5[1\u0602!\xa8֊$\x92\">܂\xc1|\xed\xe4O\xe6}\x91\xa3N\x82Wa\xb6\b\xb3X\x98\x05\xb3\xc5\x06xU\xf8\xa2\xbc\xfbtݗ\xeaf\b\xb6\xc2\xc3@j{\xd3t\xc1\n3\x1d\xb7O\x83\xed\xaa\x01Dì\xc6W\x1bg\xb5\x8d\xae\u007fIZ\xacƦ_\xa1\x89\x84\x87\xe4A*h\xc0o\x98\x93\xe6\x83)\xcd|\xfb֘\x9ahvYLZ\xecV!e\xaby\xcd\x1bZ\x9cJ\xbe!\xad\xa9K\xe0c\x9b;Ҏ\xb0G;jڛ\x85\xbaf\xe9͓Z\xb8\x0e\xdf1c\x94G\x89\xb8\xe4\x94\xccT6;\xb7\xbc\xaa\xc8\xc7\x01*\x1a\xb4r\xd0\x18\x85'חl\xb3e\xf0[\xa5\x86\xec]\xec\xde\xf6\xfa\xb7\xf6\tzR\xa3\nh\xb8I\xf8\xb7\xa0uD\x12l\xe7\xe4\x1f\xddnF\xb4Xq\x02\x1c\xe0\x1b\xb2S\x9bֳ\x98Cdf\xe7\x9b\xf2\x84\\\x935\xb3,\v\xc6c[\xa8\xc5*\x8a\x16\x16\xed\x8a>7˚e7\x85\x99\xec\xce\xdc*\x16r\x88\x8e\x9a\xa6\xc6J\x01D9\xcaQ#j\xddo\xbb\xfb3\x8e<\xa3-\x93\xc5<b}F\xa2!KP\x9c\xbd\x83\xd36\x8bZQ\xb4\xc0\xf7\xde@>\xc9Y\xb7m\x9f\x0f\xb4\xcais\x94\tv\x87X\x95[\x897d\f\xb5\x88V++7{\x9e-\xab\x14\xabr\x1da!\xa0\xa3`\x9b\xe72l\vr\xbf\xe0\xae`\xc4\xdc,\xd4\xe3\xf7|\x9a93%\x9e\xc5Y\x841\xc7V\x9e/\xe4\x97[\x1cv\a,\x84_B\xad\xa2(\xf2\xf8(l\xcawX\x9c\xb6\xf2\xb02\x9bTZ\xc1«\xc4Ϊ\xd4t\x87\x00\xfd0A\xb4\x88Zw\xb0\x9c\xc7\xd8rDS\xbeIy»>T\x14-\"\x0f\x95J\xbc\xdd$\x9a\xf2s\xc2r\xf2\xcd\x05F\x16_%\xe22붛\x05\xac\x96\r\xa2UԊ\x95;\xe4\x81\xee7\xf8ZGEM+\vs\bgyA\x8eM\x80d\x1c\x16\xee\x0f画l\x9c\x89r\xd3L\xa21)\xc5\xc8+\x12\xbaT\xb9\xa6\xec\xc2l\xd6h\xf2\x9b\x18\x9dY\x8d}&\xc6\xca\xc6z\xa7\xdf\xc4X\x9f%K\xe8\n\xad3:\x93\x12\xb5\xb2\xd4\x1f\x06\x890j\x13\b|\xe2\u007f\xa6\\z©nƭ\x98(\xa2\x81E\x03&\x8a[q\xab\xf0\x84\U00079a49\xc3\xd5\x1bQ\x10q\x14\x0e\xd2\xe2 \x11G\xedA\x81o\x1c\xfe\xaf\xa9?\x1a\xd5[a+$\x8a``\xc1\x00\x89b3l\x15~t\xfe\xebR\xc3\u007f\xd4m \x880\n\x06i\xfb\x9c/\xa8\xc0\xeef*H\x1e\xe2\xbe\xc0\xe0Ml\x92\xb3\xf0\x12\xfc\x04\aT\xb2\x11K\x18\x1c:\x12{`\xbf\xaa\x98\xb4X,VU\x11\x89?\xe1~\x1cڣ\xc2\x00R\xceģ*\vi\xb5Z-*+)g\xc1Q\x95\xf7\xb2.\xf8\x06\xa3\xf8P\xdc\xc4\x1d\xb2\x19\xbaT\xb2\x19\xbb\x18Ō\xa7\xa1\a\x0fșpI\xa5d\xbao1\xe8\xbb2c\x17܄\x1d\xaa\x90O`\\\xa0\xa7\x17\xc6\x05\xe9T\xff\x18\xc9\xc8\x06\x88\xf0\xd2:\xcf*7\r\x11\x8a\x81\x84ir\x03\xe3n\xc2g\xf6\xc2\x18o\x13LT]\x80͌;\x16o\x9e\x83ǽ\xb1\xf0\xbd\n\xb4\xb0\x8e\x91=\x8a\xe36<\xacxd\x87\nށr\x06\xe9\x91r\x82\x97\x01\xbaG\xa5\xc3\u05fd\x17\x19w'\x8c\xf3v\xfa\xebb\x19\xa8~\"\x18(ͯ\x91\x1e\xc9M\x9f\xa2x\xcd\xd7'\xcfr\x02\xf0\xfd!\x0e\xa7\xc14\x8c\xc38\x9c\x86\xfe\xa3\xbfdF\x1c\xc4\xc14\x98\x06q<\x04ȣ\x99wWu.\\\xb8j\xd5\u0085\x9d\xab\xde}\xb7\xb3\xf3]^\xb1@<\x03\xc1\x90+\xad\xbe%td\xb6\xa4\xb5Ʒ\xc6\xd7$TŨ\xdf\x1cb\xc6\\\f\xd6b\xb0\x84\xb9\xab\x87\xf0\x991i\t\xf1\xf1\xf1\xf1\ti1\x99\xea\xd5C0W\xf2{\xdfa\xae\xf9\xcd!BLUBM|k|kZKf\x87z\xf5-\tr!X\v\xc1f\xc8}\xf3\x16_\xd5Q\xd3\xd2\xda\xda\xda\xdaR\xd3Q\xa5~\xf3\x16\xe4\x9a!\x98uK^\x8a\xf9\xddz7\xefw>\x0f\x1e\xde\x02\x81c\xbf\x1b\xfb\x1d\x06\xeeć\xed\xea\x01\xf6\x81\x96\x01\x03\x06Z\a<8\x18\x1f\xce\xc3\xc0-cǎ\x1d\xbb\x05\x03\xf3\xf0au\xde\x00\xeb@q\x808\xc02p\xc0\x83\x8f\xdb\xf1\xe1\x9d\x18\xf8\xdd\xd8\xef\xc6B\xe0\x16x8O\xfd{\xde]\xeb\xef\xbfߵ\xfc\xfe\xe0`x\xd8\x0e\x81;\xbf\xfb\xee\xbb\xefvB\xa0\x1d\x1eV\xdb\u007f\xb7\xdc\xfd]\x1b\xb2\x90\x82Vy2\x84˓\xa1\x95\x8e_\xcf)o{%\x06\x06s\xf2\x87\xfda0\x17\xb2\x98sK\x9e!\fF\xe3\a*y\b\x01Ϡ\x81\x19\x04\x11*\xa5\x8e\x18\x84\x11\xaa'\by\xb9{\xba\xbc\xdc;]\x05\x1f\x81\xe4\xdfa©\xf0\x1d<\n\xe3 \b\x12!\b\xec\xb0\x10\x82\x03\xe5\xef`\xab\x1c\xde\x14\xe4~\xdf=\x91\xf9\u05c8S3V\xf1S\u058b\xa8\xf9*\xe6\xe2\x8a\xdbg\x8d\xffVCP\x03S\x8d!\xa3\xf0\x9f\x18b\xf4\xfd\xa9\u007f͇\x90\x82\x1f\u07bf\xfe\xf5~x\xec\x03\x18\xffD-\x0eTC\x8e|\x90\x995\xebɜgL8,\x05GYqX\xddD\xc7\x18)\xea\xcc\xc1\xf7/8?r\xc0\x9c\xbd\xa0\xb7\xc0\x9c\xcdg\x8d\x97M\x87穻z\xeb\x19k{G\xd7\xd2*<\x81\x8b\x94\xf1\"\xbe\v\x8bR͡y\xe9ۇo\x1f\xde<2\xack8\x84l\xb8\x93\x02\x8bb`\xb3\x13\xa6\xffr\xa7+\x1dBw\xfeG\r3=\xaf3\xb6\x8e\xf2\x83\xdb\xf6\xbcqnB۔\x1d\xb8\xa5\x1b\xd3\xcb1)q\x80)<m\xe8\xba\xc8-C\xd6d\x8fm\xc6\xd5o\xe2\xca\x0e\\\xe9\x98+\xbetr\x95\x1aT\x110p\x1d\b\x19\xb02\x11\xe2\xb6Ü\x1fO\xb4~\xb4\xcf\xd9}\x00\xe2\x0eB\\;lQ\xfb\x9eڙ\x90\u007f\x13\x88\xaf`\xc2W\xf0\n\f\xb8\x023\x03E\xa8\x84_\xbf\x82\xf1_\xc1-\xa8\fr\xdfp\x8ff\xca缸t\xc1\x9eyM\xe2\xf7]\xad\xeawv]X3_\xfb\xd5v&\xeb6\xaa?}b\xe5\x9c\xf8UQ%3K\x9f\xfaz\xea\xf5\xf2\xeb\xe5\xe7\xbf1^WC\xa4\xcc2\x9b:_/_R\x86l#\xf2Vd\xb7-\xcb]\x94\xb3e\xf5\xd1\x15\x9f\xe4~\x90\x03\x01Y\x10h\xf9\xa3ꃲs\xf6\xc3G\xd4oy\xb3\x98\xa4i\x15\xa3\"W\"3\r\xc7\xe6\xe0\xc0\x96\xc8\xcaqM\xab.\xa6\x9e7^̾`\xab\b\xad\xb0\xc3&\x1c\b'E\xd8\b\x03\xc3\xdaM[Wm\xd3B\u007f\xf7Lf+\x8e\x8bǱ[plN\xf4\xf2\x84\xb5\xc9\xc8NǱF\x1c\xb6}\xa8sp\xeb\xb3\x1f\xad\xdd-\x1e\xcfyk+\f\x8f\x83\xa1\xa90B\xeav\xed<\u0560\xbe\xd4\xf2E\xe5\x17e\xf0\xf4v\x98\x94\aOEw\xa5\x9d1\x1cXR\x93\x98\x1fW\xb0M\r5\xbf\x86s\xdel\xf7-\xb0\x05~\xea\x99\x1b\xe4\x99\x06\xab\x99M\x19s\xb3\x96[Ԩ-L\xf8\xf9\x82V\xae\x86o\xc1\x86\xdfȓI\xcc-\x10E\xa1 \xb7 \xc7\"db\xff٘\x82\xb61j\xdc\b\x93\x95N\x12g\x03\x875p\xcf\xceCH1\xe8\xde\xdd}D\xed[u|\xfa\xb5.8\xfe2c\x88\x1d\xb8\xe6\x89\"5\xb6\xe5\x0f\x84\x150H+?\x8d\xfda\"\to\\x\x1b\xa69\u007fQ\x97Ym%%\xacX\"\xc2,\x1e\xbf\x04\t\xdf@\x872\x90\xd47\xbcp\xbb\x84o?\xe2\xa8h\x12>\xc2(\x95<س;\x9c\xeb\xcdF\xbd\x8e8\t\xf3\xb9 \xb7\x1dLLE\xbe\xb1,\x8bE\x83.\xf8\x1a1\x83\xd2a8\x1a\xc8,\xa3\u007f\xc3#\xbb8\xab\xdc\x04\xa0Сyv\xff\x86\xc7BJ*/\xb5\xdby\xf8M\xa6\xcb\x1dŕ\xf9\x15a!\xbd\xd9(\xfd\x85ϳ\x93\xb1U\x14U\xe68\x10d:Ԟg.5\xfb\xa0\xcc9\x05yy<\xfe\xa6\xd09\xa6\xa2,[vX\xb6͙[ɂA\x87\xe1D%\xa7\v\xbe\x06\x06\xb2\xd2Y\xee\xb4\t!\xf01խ#N\x06esn\xc3|N\x87\xe1` *s\x9cF\x1b\x9fm\xcb*2\x99\x15\x1a\xff\b\xcd\xcb+0\x9b\xb5\x10D\xf5\x857\xc84\xfe\xe6ȩ,\xaa\xb0\x85U\xd8ʜ\x95Z\xf0}\x1dx\x88\xf2a\x13}\xd8t\xc4I\xf7c\f\x04QRAy\x9e\x9dWh\xf8\xcdT\x9eU\x9c\x9d\x1f\x96\x9d\x9fk\xccҢ\x8f\x14r\x92\x8f\x144\x10Y\xe5Fg>_\x91_Y\xec\x90d\x1a\xfe\b\xb5\xdbK%I\x1b\x82\xf7\xc0N\xe9T\tA:U\x02|\xc6̭}\xe3\xad\xe8\xb3\xea賆\xcbW\xb5\x9f7\x9e?\xf4\x11\xdf\xd4q\xe0\xe0\x89ñoŴ\xc74\xa9\x0f͚\xd68\x89]</;q\xa6`\x98\xb9\xc4\x18\xc7\x1a\xa2\x1d\xf5/\v\xf5/\xcds,f\x91|b\xc9\xc8H!r\xc4Z|\x04\x9f\xd0\xe23\xc7q\xd2\xcf\x18\xc9c\xe4/\xbfD\xfc\xc6\xee=\xec\xdc\xf1\x9e\xd0x\xea\x1d\xe7\x9bl绦\xc4\x0f\x84\xa43G\x8d\xed\xec\xb5o>\x81Ǿ\x11N\u007f\xfb濛\xbfT+\x0f\xea\x82\x1dL\x99\xc5^\xa6\xb5؋ʊ$T\xc7OX\xbchꢩ\t㋆\xbd]\xdan\xdfQ\xb6\xafb_Ӿ}o\xbd\xb5\xe7h\xfdqu\xdd1\xc7\xd1NmHx^\x12ts\xee\xe7\xe4VF\xf4>\xa7\x9c\"\xdd\xcf=\xa1ҩ\xb6\x06{\x9f{B\xa5|\xe4~\x8e\xf4l\x98\xc0\xf4\n\xde\xe1\x84G\xc0GU\xcdTp\xaf\xef\xe8\x1d\xeb\x11\xc8\x10\xbc\xe7\xa1`R\xa0\b+!\x11V\x02\x0f\xab\xc0\x00+\x83d\x93\xe7\x00\x93\x94V\xdb\xd0PS\xdb\xd0X\x93\x9a\x94\x94\x96\x9a(\xc8\xcf\xf6O\xeckkl\xe8kK\x12\xbc'\xf1Uf~t\xf4k\x82\xf2\"9\xffhtWב\xa3]\xbc\xfc\"\xf9~\xf4\xd1\xd7xϑ\x14\xa6w\x84w41\xfb\xd8\xe2\x0f\xf9\xcf\b+&\xab\xe6\x1f\x8d~\xbf\xeb\xe8\xd1.\x1e\x92\x81\xc7\xe4>\xa0\xbe\xeb\xcb\xe4\x99c\xc7>\x140\xc3\xf3>s\x11\x1e+-+\x92rK\xc3rK\xccE\x05\xb9S14\xb4u\xf6\xf4\xea\xa9\xec\xd4\xe9\x19\U00073169\x10ZPf.\xce-\f\xcb-(2\x97\xe6^\xc4\xc7Bq\xc0\xd4Q\xc8\xe38\xadg,\x01\xe3F\x01\x8f\xe1\x97x9A\xc9`\xba\xa2\x8f\xcc\u007f-z\xd9|\xbe\x8f\xca>\x02BP:\xb6\xbc\xf3\xed\x86\xe4\x1e\xf8\xa6F>~\xeep=\x90\xf5\xc5\xf5\x99չ\xbb\xdfiȨ\xedW\xb6;\xb9Nl\x83\xc1?\xfe|Czg\xe3\x1eGg\xec\x81O\xbei=Ѿ\xbd\xe3\xf4\xbeF\x98\t\x13\xcc\xd5\xf0h\x83\xa6\ue54f~v\x8f\xf4\xb8\x99\x8d\xc5E\x06\x87U\xeḓ-\xae\x17D\xf2\xdbL\xc6Qj\xaa\x8f\xcbR\x8bd\xad͚\xe2(Q\xe3\x1d\xe5y\xa6\xbc0ߜ\x9f\x96\x97\x99\x99\x9doU\x8bdfq\xad\xa3\xacR\xaa*\xaa\x95\n\xd5\xee\x1eDf\xd5^|\xd8^8I\x9d\x98^\x94\x9e%h\x86\x05\x04\x8cY4\xf7(R\xf9QjM\xff\x80\x0f\x03\n^K\xd7缶\xd9Z\xd2 \xedQk\x1e\bx$@j\xad\xdckm\xb3\xee.\xac\xcd)͵\x19\xcd\xe9\t\xb1\xfa\x17\xb6\xbd\x9c3\xdd\x1cU\x16{\xc0X\x99\x9cVTX\x98_ev&\xab5\x01c\x88\x80\xed[\xaa\x97\xafM\xb6\xde\xdah\xdf\xf0\x91Z$5\xffP\x0f<\xdf]&}\xbai\u05f6f\xe4oǼ\x99Vk\x90\xcc\r\x89\xefd\x9co=f\x93J\v\nR\xd5~:\x12\a~:\xbe\xfa\xb0s\xb7\xe9\x94\xfd\xb0Z\x13\x10\xf9b\x80\xe3\xf3\xf30tO\xf9\xd2\xeb\xb7?\xb5|a9~\x04\x83\x8el;\x9e\xf0\xde\xe7\xff:\xd0\xe8\xdcYQ\xe4\xf0\r\x18\x1ci>2zȄµ\x96L)\xa3j\xf9\xa1c\xeb\xcfV\xec\xb29l{*\xdb*:\xea:\x1cjM\xa1:po\xb3\xbd\xa8\xced\xcb/\xc9\x11s\x8d\x19\t\xd32\x12s\xe3̛rV\x16G\x15W\xef\x8en\xc9*+\xad\xaf\xa9uH\xcd\xf6\xca\x06uq\xc9\xf6\x9aJ\xa9\xb4D\xfc\xd7\x1a\xabo\x06\xc2\x1f\x89\xb5\x9a\x12\xac\t\xea\x84\xe2\xaaD\x89\xad\xb5\xa6%\x9b\n3\xf2\x93?.\xd9]ܶ\xfd\xfd7-\xfb,-\xcb,S\xd4Y\xa7\xeb\xea\x93\xf8\xaa\xccY0\nx\xe7\xbe\u070e\xec\xaa\t\xf99U\xf3\x12s\xe3\xcdi\x8e\xed-\x8e\x16\xd3Y}\xc1k\x85\xe5ê\x8b\xaa\x8b\x0eķ櫿M\x8bZ䜭\xd6<\x12`\v\xa8\x9c~\xa4\xb6\x13_)7\xb6\x9b\xf6b\xf8.|tՓ\x17ʷ[\x9bl\x8e}-߯\x86\xa0\u0557\r\x87\x13we;\x8e\xc6\u007f\x10Q\x15\xa5\xae}\xbab}\xfcX\xf5\xf6܃i\xa9Z\xaf\xda\xfd)SX$ZxMdpa\x80\xd9R^Q,H\xc5\xf9\xf6\x02V$\xf3\x8a\x8aj+\n\xedE\x15\xa9\x05[\xd5\xf2\x04\\Ĕd\x9aӳ\x8b\x93K\x97\xbeӰC\xadQ\a\x06\x04\x88\xa4F\xfd@@@YYir]\xf6vSUYi\x8a\x1a_\x86X\xa6\xd0TP\xac-\xaaΩ\xca)V\x8bd^q\x81\xbd\xd2j\xb36d[\xb6\xa9OA&\x93o4\x94\xe4\x17\xa5\xe4楚\xcbL\xe5\xd5R\xa9S-\x92%\x0eSC}\xb1\xba\xbc<7\xa7\\\x90r+\xf3,\xf9j\xf9#\x9d\xe7}fK\xed\xf4\xba<\xe8\x9f`(\xc1\x87.m\xfb*\xfe\xf82\x1c\xbeP\xady$0 `xl\x9ai\xca+\x93g\"\xd5\xd2\x00\xbc\xd3e\xff \xf5c\xf3\xee\x827\xed\xad\xceS\xbb\xb6\xefZX\xbb\xc1\xbe\xb6\xbbP\xad\x99\x1b\x1c\x10\xf0kA\xfd\xad6[CI\xe3\x87\xe9\xffI\xbd;\v&N\xfe\xb7Zc\f\b\b\x9eyaՇ\t\xdf&~\x96}\xb1\xf4\xbc\xfd\xfabxx\xca\xe9\xc5\xfb\x9e\xab\u007f٢^\xb3./c\x8b\x80\xeaW\xd3\xc7\x16\x0f.\x1eY\x82\x0f8\ae-\x99>f\xc5k\xf3\xe6\xc7a\xd0\xda\x11\xc9\v7l+\x9ciI\xb1.\xa9\xa9\xfa\xf4\xc45\f\x05\x06\x97\xa9E\xb2iͷ8\x01G%-̝\xb8or\xfd\xb4\x9a\x17\x9d\xab\x9d[\xcbLR\x91#\xafu\xdf\x01k[q[V\x8d\xb1\xc5\xd8RX/9\xd5v\xa9\xaadGѮ\xc2\x0e{]Ş\xbaꃙ益\xa6\xa7\xadM\xe4\xd7'\xebk\x12\f\x9b\x13:_.\\X\xb8v\xf5\xf2\xda5}\x8fe\xed\x9ak\xf9\xd7S\xa1_\x82+\xfatѵ\xc2\xcak\x10Zr\xd0z\xa0\xf8\x80U}\xe8\x90h9,\\/\xdc\x1c\xdd\x15wl뷎\x86\xfa\x9d\xad\x9fww\xedRk\x02\x8c\x01\x91\xae\xfd0\xe5c|\xf8s\xbf\xcc\x15,_\xbf\x9d\x0e\x84\\\v\xc1\xfdD\b֩\xc2!Xs\xc5#\xedc6L\x1e\x9f2\x86\xc5q\xf8x\xd7\x0fH\b\xa2\x94g\xcf\xe7\xa1\x1f\xb4\xcaa\xa0\x13\xb5\xd6b\xab\x95\xfd\x1es\x0f\xe0R\xc7<5Β\xef09\x85\xe6\"s\xa1Z\xc4?\x90\xc0\xe9x\x89\x87 j\x10\xa8\xa3`4L\xd6j\x86\x95\x89e\xd62\xde\t_\xe4܂\x9bBݺ\xdau\xdbש\xc3!\x86\xd1\\y\x06?\xc5֒4\xf6\x10,\a\x1dL\x81\xa8o\xa4lx\b\x9f\x01\x02\x97\xa9ݑx\x89ٌQ\x83\xf0)\x1c:,\xf7`\f\xbc>\x1eL\x10\xb3\x17\xa2\xe6U\x15\xa8\xbdGݩ\x8c\xc5b\x11\x05\xab\xbfƺ\xfc->\xce\x14\x15\x8eƁ(>\x89\xc1\xb0\n\x1f\x81\t\xa6Ju\xc5\xf1\x13e'Y\xcd`x\x16F\xcdz\x1cH\x01\xb6)\xa9L\x8e\x15\xb7\x1dä8\xb5fa\xbe\xb3\xa0\"ߙٖі\xb1\a*~\xbf\v\xa3\x8a\x8b\xbf\xc7\xe0\xf7pP1>\xa9\x86\xe0\\&\x9c\x8a\x82\\\xcf\x14H\xef\aq\x94\xce\x13\t\x9f\xb8\x0f\xc0\t\xcd5]\xb0C\xde\xcc\xe0r\\\xf8\x04\xce\xc5D\x9c\xfb+<\xfcޞ\xe3\x17\xf8?\x1c8\x1af\xb1\u05fb\x0e\xbeۭw<\xd5(\xe0\x90}*\x1cҁ\xc1g`\xb2\x16\x96C\xf4ex\x01\xe6\xc1k\x91H\xce[\xb3\xf0\x15~D!\x908\x96\x1d\xf7ڶ7\xe6\xfd_\x84\xbd\a\\\x14\xd7\xf77\f\xc2\xec\xac\x1bC\xd4\xddQvfw\x06c\x8b\xbd\x80%vM\xec\x1a\x1b6\xac(\x02R\x04\x11鰻,e\xd9Y\x96\x05\x96\xde\x05)\x82`!v\x8dX\x13K4\x86\x18\x13M,\x89IԔ_b9\xb3{f\xfd\xbf\x9fE\xf3\xfc\xff\xcf\xf3~\x9e\xf7\xfd03g\xee\xb9\xe7\xdc\xc2\xe7\xee\xcc=s\xcf=\xdf\v)\xdf\xc6s\xcfw\x11\xf0~(L]\x82\xb3h\xf8/\f\xa7~\n\xbf\xb7\xf6\x1a[p2\xf7\xcc\x19ZP\xe2\r\xb8!*\u05ecI\u05edcO/\x98\xd4\xe4\xcdԫ\xbd\x9b&\x9d^\xc0\xe9֥\xafYC\x8bJ\xb8\x817\x04\xe5\x993\xb9\x05'ٵ\xd7\xee\x85\xff\xc4\xc8\xef\x1c\x92q\x1e\xa2F\x18\x02\x8b\xe1D\x97\xc3\xdbBף\xb0Оdu\xb3'\xd9\x1fQ1\x99\x91\xbbw1|\x14\x8f\xdf\x04DD\x06\xb0\xfed@C\xc4!\xa8l\xe0y\xb6\xbe>|G:\x97\xbe#\x02\a\x19\x12\xa5\x89\x86\xb8\x88\x10\x86\x8f\xe41x\x93&=8\x9c\xe5w\x04b)\xde`\r\x06\x83\xc9L\xdb\x1f\x92u5\x8dͶ\x99\xf5N\xcdڨ\xd04\x0e\x967P\xc06\x04\u007f\x83}\x18G.\xbe\xc7\xf3\x1cz\x04\x8dZ\xc6\xf1\xfc2\x95\xfd\x18=\xbfuW\x95\x9e\xad\xd2w\xa4\xb5\x1c\x94\n\x13\x84\x84\xbc|\x9e.,0\x19\v\xaba<\xff\x13\x03\x92\x8a\x1fN\x14q\x05\xed\xe0&\\?\x00\xf3\xa4\xbc{ \xceC7\xf1\xbanK׀n\xb7w\xc2e\x99\xd0\x0e\n\xa1\x1d|\x14\x13m\xbe\xe0KM\x91\x89\xd1#ev\xab\xbfZ\x88\xde {m%m>\x82\xe2-צx\xc3u(H\xf0\xb1Y\xef\xabE\x8d\xad\v\xc8Z's\xd3Ʉh\xaa2\xbdRWʢUP\x10\x97\xb6.>9\x93\x11\x15\x92\x99\x8b\xd7-\xddʡUT\x10\xba\xa4\xf4\xb88z\xb9\xac\xb9\xa9\xac\xbe\xc29yxB\x1cCO\t\xf2\xe2(\"]g\xd0j\xbap̱N\xb2\xf8\xcc\xda\xcfY\xf0\x91@\x9a\x9a\x83:\xf0%'\xa9\x8b\f\x85\xe9\x05\x1c\xf2\xc2(\x02<\x8f\x91\x10\x8bO\x88Ȋ\xa6\xc4ff\xa1Z\xf4\xb1\xb5\x1bd\x8e\xb7\x8d\x01߫\x10\xddu\xf8\xba٭\xb6N\xb0\xaa\x1d\nI\x9d\x9a\x13}\xe0\xa9\f^\\}=\x00F\xa9\x85\x80\xabb\x00\x8cR\xdb\a\\\xc5\x17\xf0T\x86\xed\xb6\x17\x06\x99\x9f\xdaV\x02)\x14\xfe-\x84G\x1e\b\x85w\xfe\xf3\xa5\xf2\xd1\xf9\x1f6\x1fY+\x8d/\xab\xdf\xfd\x19\xf3YCME\x19w\xe4\xf4\x0fG\x1e\xae\xf8{^(\xbe\x13\x19(\x15\xc6\xcc\xebڈiUI\xfe\v\xbf\x86\xaf{Cܯq\xb2_\xe5\aT\xc4Y\x9b\x82\xc2[X\x88\x85x\xcbI\xa1\x10n\xc1-\xf8_\xd4\xc9g\xa1\x11\x9b(s\x81\xb10=\x1f\xe7\x81\xc9\x13\xe6\x81)\xd7j*0\x14(\r\x05\xfa\xbcT3\x1e{\xe6\t\x97Ȃ\x8c\x02}.\x9b\x9a\xab\xcb\xd6e\xe2\xb1\x11\x9eNaMY\x04\xafIT\xe2<4\xe1\xb1'\xba\x1c]Vj\xbaR\x9f\x9e\xa1\xd3\xd1x\x89\xc4c\xc3R\r\xfa4\x83NiЙt\xb9\xa90\x0fM\x9eN\xd9t\xbdQk\xd6)ͺ\xfc4\xab\x01\x8e\r\xf3\xc4K\xa4\u03a2\xcbOg\xad\xe9\x05Y\x059p쉧S\x98\xd0\xe7\x16d\x140\x05\x05\x96\xfc\\\xce\xd9.86\xa2 \xb3 ۚ\xab\xccϵ\x14\x14\xd0p\x89\x84c\xcf\by\xa4՜\x9fg.\x90z\xf8\xcb \xfa\xcd\xceex\xd1\x05(\xfa'$S8\x10{\xe0|o\\\xc9\xe0K<\fs\xd4p\x04\x96\x83\x01*\xbf\xe7\xe6\xf5\vĞ(\xa7E\x12:\xb0C \x0f\x1c0綱\xb0\xe9\x01UX\x9b\xd1\x18W\x19W\x19a\x89\xd2J\xb7Ϛ\x9e0\x85\xc1\xb3hhQ\xc3\xc8+\xf0>H\x9es\x95\x15y\x8d\xda:]mtY\x84Q*\x8eM\xc2\xef\xf0\xb1\xe8M\xc31\x19\x9c\x81z\xf8\bj\xa1\x17\xebQm\xb3\x82oח-{\xa7ͷk\x19\xc9*\x81\xb3\xea1j\xb0fɄN8\xaaVٵ\u07b2\u05fe\xc3\xd4A\xb2\xe1\xea\xab*B\xbbQ\xad\xb2\xbb$\xab\x17\xc96\xa8\xbd\xaac\x04\xeb/2\xe8tmVIf\xb8\xa9$\x9b\x85\u038d]{\xf8\x14\xfc_\xd7i\x98\t3\xc1K\xad\xdc%Ù8\xd3\xd3\xf1@\x823a\xe6.\x99\xf2\a\x198\x19\xf3\xf9\xc1\xa8\xa01Z\x92\xaf\x86h\tJ\xf7\x8cm\x9d\xc3\xe2F\x8c\x8d\x8e\xcc\u07baw\x97R[\\\x9aQ\xcaT\x94\xe7\x95\x14s\xb0\x10\xb6\x12<x\xa2'\xccg!B\xfdZA\xc24\xe8\v=x\x0e\xfcp-\x0125x\xa91\x00\xb6y\x1e\xbeU\xff\x04z\xd1\x10=\\-F\v\x9d\x14\xcc\xc6\xfe\xd0\x1fg\xc3I\x19kSH<`HM\x8c\x9do\xd4\xe7\xf5V\x91\x11\xa5\xf2\x9b*2\xd9n\xa2\xf8\xe4\xc3>Z\x16۳\x88\xdd\xe6\xc4T\x9e\xe6\x13R\xf5\xbb\xd9\xf5\xf8\xee\x92\xfe<\x8d\nܱZ\x13S\"\xf5\xdbW\xfc\xf4\x06\r\xc1\xcd?\r\xdfÆ\xe5E[&\x99\x06\x98|\x8c~\xbbB\x12\xa3\x82J\xb3*\rGR\xf7\x84I\xf9\xba-9c\xd8B\xcc^\x13\xc6\xd3\x011s[\a\xc3\x16)\xbf\xec\xef\x8d\xe0\xce\xea\xab\x13\vy\x9a\x8f/ٙ\xc3\xca\x0f`}\x16\xe1\x9f\x14\xc7\xf3I\x89%!\x1c\x84\x88<\x18\xd0\xc2\xd3<\xec\xc2d\xc8-cy \xc9VsE%O\xf3e\x15\xc6\x166\vF~\xbd\x87\xa7\xbf\xaay\x8cӟ͗\xf2\x9dk\xcf\x15\xb2`\xcd!\x801-\x04w\x9eΰ\x86\xdeđ\xd0_*\xbf\x89>0\xa4z\x17\xac\x82B\x90\xc1\x98\x13ϒڥ\x1e\xd5\xe3\xfe\xee\x84n0\x17\xb6\xc2\xc0\xaeXo3\xa1\x11Խ\x05\xaf\xdfaM\xe17\xb0\\\b\x04\xd9K`\xe5\xf0\xc06\x01,Ԏ\r\x8bc7To\xacN>\xdbx\xac\xe1\xe8\xe5\xaacq'bKWEl\x90>\x81w)]|\xb4>>/>?\xa3\xb2\xa0\xa2\xa0\xbc&\xbf\"\xbd\"-7V\x17/\x85Y^\xd4e\xa0g\x02\x8b\x83\x0fb\xbf6\xf4\x9e\xbd\x18{.F\xf9e\xec\a\xfd7H\xe5R-|\xb0\x0ef}s\x19TR\xb8\x06>T%\xae\xc6h\x9c\x8f\xa7år\xcb\f<\xbd\x03\xad@\xc7\xc1\xea8X\r\xd10\x1fN7\xc1\xe9F\xb0\"]\x89\xab\xa5\xf0y&\x05*\x94\xdc\x19\xd0\xccF\xe5'X\x8a[\x1a\xbe6\u007f\x11vtُ蝉\v\xa6IM\xcbw\x96\x1cg\x1b\x1eg~\xbb\xe9\xc8\a\u007fb\xd9@\x8c\xc1\x18\xa9\x1c\x1e\xe0T\x9c:\x04\xe7\xe2(\x1aO\v=q\x9e \x15\x12\xe1\x0e\xb4\xc1nv\xa6͝Z~u|\xed\xd4\x04\xa9\\:#4-6,/47\xb49\xb69ϩȓ\a\xf3\xdb5\ra\ra\xf9\xfe\x9amR\x9eԅ\x95\x87\xec\xd3\xedӵ\x94\x17\xec\xab\xfb6\xf1\xd1\xf2+R\xa7\xc9D\xa1\f\xfa\xe2\xccS8n\xbb\x94'\x03\xf5\xbb\x82\xf2\x03\xf3\x03\x0f\xec:\x90\x0f\x92Zx/\xf8\xfe\xc8\xfb\xa3jP\x1e\x84nR\x9e\xd4\x06UmkӴi\x0eV\x15\xb5I\xc1Mv\x1aF\x87\xc1\x1c\x94A\x1f\xe7+HE\xfc\xf3\xadZ\xe5\x1e\xbe\x9d\xc2\xd9\xe0\x8e\xfda\xea:\xf0[\x8f~\xd8\x1f\xa7\xe2lt\x97.\x01W\xe8\xb5\x1d\x94\f\xd0\xd0k\xffep\xe5D\xbd\x8a\xb0R\xf8\xfa\x13l\xc4\x1bbO\x1a\x97\xdd\xc0\x19@ \xcdB5\xf4\x81ep\x02\x9e\xd2\xf6>XK\t=\xb1\x11n\xe0\xeb\xcfY1\x9eħ\xb0\fO@5\xf6a\x81\x00\xfa\x06̀etWt\x98\x9fU\xee5\x87#\xebz\xdb\xf6<\x90\x1f\x90\xdfT\x11\t\xc2l\n\xbf\x83R<\x8f?\xf1t\xf3OuO\xcb\xfe\x92\xf2\x02?\xa8THf\xf1!\x90\xf8!~\xc7\xd3٭\xfc\xbd\xac\\)\x0f\x89\xc2<q\x1d\x8ca1\x1b\x1f\t\x9eX\xc3ӆt\xec\x9b\xfe\t+?\xc0\xa3\x87\xb0N\x9c\x8b\x1f\xb1m\xe2\x02!\xef.O'\xbc\xbfsh\xd0\xd8\xd9\xd0\v\x17\x82\xbf\xf8>N\x841\xe2 h;\nC/\"\x8dF\xf4\xc7Q\xa81J\xa3b㢢\xaab\xeb8h5\x81\x01|\xe1\x03\xf8L\xca\v1x\x0e6\b\x99\xac\x87\xa8)\x86=\xb7 \xbf\xd3y\xecq\xadV\xb9\xdfvS\xb9?\x836J\x85C\xe7K\x90\xf8i4\xb8\xb0\xc2^\xac\x91@1\xd4\x10\x17$\xe0\xf2\xd3/@p\x82\n\x9eȄ\xbb\xce\v\xe7\x0e\xee\u07bf\xa0\v\xebx$A\x17\xef\xd1\xe8\xce\xcdS\xb9\xdf&=\xe0}\x99MA\x15\xa9\xf1\x05飆\x17\xffF\xb7\x02\x9f>\xfd\xd5\xf0\xbe\f\x8e\xcb\xf0\x05\xf8JʓJ!S\x9d\xaa\xa7\xf5\xe6\xd47\xa1\xb2\xbe~\x1b*k\xac\f|\xe1\x05|\xae\x06\x9fg]Ιv\xabݗ\x9a\xa2NI\xc9\xd4kY\xd1\x17;\x89\x04\x8d.6\x96\x8e)\x88+װ\x82\x93\xa1/,\xce,f\x9c\x0fe\xf4\xf9\xbf\xf9N\xee}멎V\xa1}\x9c̫T\rCb\x04\xeb+\xb5\x9b=\x1f\xfe\xa6\xb0\xa0\x02\x030\x12\v\x188\xa2\x869/\xc0\a&|\xcd%@_\x02c'\xe2p\f\xc3\f\x1a\xbf\x82\",\x82\xaf\xc0\fQ0\x01R\xef\xb0\xc2\xf2\xe7\x14\xfa}\x80q\xc2:\xa2Z\x17]\xb2\x8b\x11\xd7I\x12AA\b\xc3@!N\x96\x04§į\xba\xbb\xdf\xfdҵw\xe6\xe3\x85\x1b\xe7ı\x06]\xe6n\x93\ue663\xca34\xa7!\xaa\x99\x11\xc2$\xa0\x17w\x121\x05{\x92k\xff\r\x89o\x05\x05\xf1f\x96\xe5\v\xed\xae<,\xb4?\x01\x83\x9b\x8a\xe8a\xefCe\xa2\xcbt\xfc\x00\a\xe1R)\xae\xc1a\xa8\x18ҟY\xb0\xf22\xbc\xb3\x91\v\x87n\xe1\xe0\x1a\x0eݤ\xf0NH\xd0\xe5m\x8c~G\xfc\xf6\xb5\xdc\xea/ϝ<{\xaa\xe3\x84\xf2\xeb\xab\xe7\x9b\xdbr\x1e\xe5\x9f]Z\x85\xbdkP]:!\u007f\xbb\x14\x89\xbd{W41{Z۾9\xc1\x1d\xef\xac;o>(\xbd\x9a\x17ռ\x82\x89\x8cL\xd3Dp\x1d0\xf7\xf9#\x18\x99\x01\x92̎Yi8P\n\x06\x88\xf2R\x8b\xff\xc5\xc3n\xdc\x1c\xd3\x1b\xac\x10-\xf8B\xb4<R~ Nf\xf3]\xaeF\x1f\xc9`5\xe6\xa1\x1f\x06\xa09\x81\x13\x12\xffT\xff\xf6\xa7\x1a\xe6/Q\xa3\xcfP\x19\x9a\xff\x90A\xe8ub\xe4\x1f2y\xa4\xf3F\x04\x98.\x93\b\x9ep\x13n\n\x9e]1\xa16\xc8\\a\x83L!\xbe\xf1$\xc7\x17\xf0B\xf2\x81l\xe1P\x19\xbe\xc0\x17\x012x\x81/⺀ƻ|\xdd\xfe?\xdc˅!\xd0y\xb7\f:q\xc5\x170\xf0no^%\xd5ʋ\xe5wl.\x82\x0fe\xffC\xf2M\xf1\x97\x85\xc7\xf3\xa5\xe0\xdb|\xf5j3ݼ\xf0*\xfa6\xb3\x1b\xf2\xe7\x15\xce,\x96\xbe\xfeCrxΈ\xda\xf7\x19T,\xccV\am\xe2\xe4\xc7\xe2\xcd\t9\xc9촬e)\x89t\xe2Ŕ;Y\xac\xfcNf\xb2!!\x9e\xdeT\x12T\xc1^]\b\nɋ'\xb5\x87or\xf6\xe7`\xa1\xee5\x11\xed;\xea7\xf9\xd1~\x91\x9b\xb6\xec`'5\x11\xcd5\xd5-\a\xe8\x031-\xa15\xac\xbc\xf8\xf5\x9fXMm\x9994z \xb3C\xf2\x9b\x18@\xb4\\\xe1y\xeeʵ\xe6\xe6f\xae\xb9\x19|\xc0\xe7\xd93)\xff\xec\x19(X\xd0\u007f\n\xe1\ayZe\x17\x04\x1f\xea\xefߣ\xb7|\xc3\xd9y\t\x8f\xe1\aQ\xff)\x8b\x8aa\xcfxn\xd83\xf4\x01\x9f\xd0fis\xe8\xa2\xe6\x05\f\xbf\x80\x0fcG\x8a\x01\x84\x87\x17\xe4\xca\xeadn*\xe2/\x9b\x89JJ4d$q8q\xc5\xfbp\x13\xc6t\x10\xe7+O~\xf6\x05}w\xdeũI\xc9Y\x99\xc9,\x8e\xf7]\x05\xe3\x88K\xb1+\x0e/b\xa6,\x98?%ќhI\xe2:\xd0\a\xaf\xbc\xbf\x12|\x88˱\xbe\x87\x161\x93\x17\xfe\x9bs\x16\xbdq\xcc*bi\xec\xd6\x15\x8b\xe9\xc9_|r\xb7\xac\xccl)cat\xc7\v\xbc\x8e\xa3\xff͙\xf4\xf9\x92{\xe5\xe59Μ\x11g\xcf\xe1\x04bIչ\x80+\xccݫ_~Wf(\xcb(\xe5|a\"\\yy\x0e?$\x96T\x9d\x0f\xb8\xc2|\u007f\xf5\xfa\xf7eYΜ\x150\x19f\\\".W\xb7\x1c\xbbJ\xff\xb0\xe8\U00087b23\x15K\xa9\xf7\xe1:\\y\xf5\x02o\x10\x1eB\xf4\xd5.os+X݂\xd4ͯۻ\xb6\r\xc3c\xf5\x1b7\xb3y䞄\x8a\xdd{\x92~[\xf8\xc7\xf2\xdf\x16JCN\\\f\xba\xc4\xf0䛿K\x17\xdbZNp\xbf]\xfd\xfd\xe2\x93/jK\xab++\xab\xa5\xa0v_\xbbtI\xc0Nv\xecұ\xcb\xc7.\x93Fm[\xb4a\x03\xb3a\xd1\xf1\xbam\x9c\xf7\xc5\xd1\xe7\xbc/I\xfd\x1bV\\\xea\xa0\xd7\xc9X[\xa7\xe4\xfc\xe1\x8d+\xbb\x82\xed\xb7Cp\x8c\x8a\xf8\xabݕo\x17\f\xff\b;`\xac\x10\xd1\xee\x06r\xe7L\xfe\x02\xcc\xc62(\xc0^\xac\xe8f\xd7\x11\x9b\x87\xed\xea\x87n\xb4\x8a\x90\x1e\xa2\x9e\x86\\\x0f?\xc3\x06\\\r<{\x85\xbe\xd5q\xed6k\xce\xe1\xcd\f\xf4B5.\xb8\x83\xeb\x19\xacA-\xb2\xb8\r\xd5\\E\xb9\xb1\x84\xe1\uf660'dȠ\xc5\xd1\tS\x04\x13\xf6\x80{\x04\xbf%' \x87\xf57[\xae|j\x90\x82<\xf5\xb6\x91\xa7\xf9\xbb&\xe8\xe5\x14\xdc\xe7\xf8\xf2[\xb0\x12ͯ꿽N\x8b\xe6~\x14&8\xc6\x1217;\xb5\xb7\x99\xc7\x0f\xca\x1a\xeerY\xe6\xac\x1cc\x8eT\x98b\xbfA\xe5De\uf320\xa7Ǝ\v@w\x165\x8eI\x04ă\x12F\x02\x0f\ah\xa0\x83\xa1\xd7\x12\xe8\xc6:\xc6\v\xb5ԿaM\xc5Ta\x908HH\x15R\x85AB\x17}\x13\xf6\x94Sن\xb8\x8b\xa1\xf0H&\x84\n\xa1\xd0\xd2E\x04\x85\xa8\x10C\xc5P\xb1\x8br\x1e\xd5\xf0\\\r\xb5\xc2a\xa8s\xb3߂*5֡\x92P\xd9\x0f\x90<\x89_\x1c\xc2\x14\x98\n\x9biX\t\x810\x1e\x0e\x9dfa\x06)\xce_\x85?b*\x1e\xa2\xf14\xbc\xc2,a\xf3\x16\x96'\xa1\x0e\x14\x84m\x8c\xfd\x13\n\xbd\xa6`\x9f\xefq\xd2y\\\\\xa3\xc4\x19\xe4˸gۿۀ\x83'z\n5(!<\xa0\t\xadNSO\x01\x11jW\xb0\x9e\x93\xb9\xd9}\xa1J\xfd\xdaWT\x10\xfe22xߎ\x83\xac\x8ax%9\xb8\xaf\xb1\x95\x03\x05($*\x9b\x16\xad\x94ú\x94\xdc\x16\xb6#\x98[X'\xbbJ\xb6\x865nc\x97ڬhuXQ\xf1\xff\xa6\x12\x151\xf9\xb5/\xa5\xb2\xc9$]J\xa8P\x91Z\x88\x909\xd5<\x84\xa652Uw\x177\x95\xe4O\xdbRJ\xbc\x85\xf3\x89\x8c\xc4ĬD&11ےȉ\xb7`~<\xde$\xb0\x9f\x1eN\"\t/\x19x\t'\xf3\xd4zN\xb0\xda\x14TIjQ\x8a\x85}\xfd\x02^\x10\x99&\x03o0\x18\xf8\xcc\x1e\x03^\xbf\xc0\x17DJF\xaa&\x99N\xb6j\x8a3Xx\xd8\u007f\xb1O8\xf6N\xc7\xee\xcam\xbb#\xb7\xac\xa37\xee\th\x8df\x97\x89\xb3\x8d\xa9\xe6\xd4\xdc4eڛ\x05!_\x89՚\x93\x97\xcb\xe5晭F\xeb2a\xb6g\xeb\x9e=\x87\x8e\xd1'\xa2\x0eo\xaba\xf3\x819\f\xdd.\x1c\xb3y\xe1\x16\xcf\x1c\xa3\x997\x9b\xcd|N\x8f\x01vg\xfd\xc5\x16kQ\t\xed\x01\x05j\xdbhX\xebf\xfbK\xf8\x96\x82\xee\xb0\x19\xba\xc3&x\a6݄\xeeס\xc7]\xe8&u\xfe\xdaR\x03\x032\xb61AA\x16k0\x874\x9aq\f\xa6\xe3\a\x98\x81\x83\xc1\x80\xc3!\x1d\xbd\xc0$\xd5\amK\vd\x02\x83r\xad\xc1\x1c\xf8\xe3\x17\xf0\x11ޅO\xf0\x9b\x14\xc7j\xcf\xdc\xd6VK+\xd3֚\x91\xbe\xbf+b{\x1b܇r\xe1\x1dhs\xe5ᘛ\xcdjK\xa5\xb0\\\x98G\"\x11\x8f\x12܁\x17\x18,\xc5\x10\b\x87j\xa8\x06'-\x85!\u007f-\xf4\xbd\xc0\xc16\xd8@@/\xa4qַ\xb8\x95\x11\xa7\xe23|&N\x15\xa7\xc23|&L\x15\x96\xc0\t\xb8&,8\x93q0\xed\x10\xe7\xd8.\xf1\xa8\x86ƣ\xd0\x0f\xe6\xc2\x10\xd8\xe6*\xbc\x84\xb9\x10\x06s\xdd\xecw\x05\x82\xd2\xe7'\xf3z\xbd40p\x9aƗ\xf1\x9dZt \x88{\xc3JM哭z\xe9\xf2\v\xd0-\xa3\x99i\x86n\x96\v\x178k>_\x92j\x95\xe2e\x98O\x9d\xdez\xd8o\xed֭k\xfd\x0eo=}\xea\xf0\xe1S\xac\xc0\xf59\xbd\xf5\xf0Z\xbf7\xbcS\xa7\x9d<ȁ\x1e\xd4\xfaȭ\x01\xb1\xac%>;6\x8e\xc6\xe5\x18\x82#\xb1\x1c\xcb0\x04F\xc2\xf2\xca\xecʜ\nvkU\xc4\xe1\x93\xf4\xf5\x8e\xb3\xd7\xf7\xefK\x8e\xaea\xef\x9d\a\x976\xe8a\xb9\t\xd7<ͅ\x85\xf9\x85\x06\xd8\xe9\xe7\xd9X\xdaT\xddʜ>\xbc\xc5\xcfo떵~[\x0f\x9f\xe2p\xfd\xb1E\xd8\x06\xeff\x82kн\x15ʚ\x9a\xa6\x92\xfd\xcc\xf5\x8eU\xf39\xb0\x87S\xf5\xb2\xf12\xd6\x03\xee\x1d\x80wa$(`.\x8c\x84ˮ<\\\x86P\x18\t\x8b`$\x84\xc2e7\xfbta\x18\x95\x96\xaf\xe1\xd3\xf4Ұ\xb0y\xda\xf5\xcc\xfay\x85\xfb¸7\xac\xb44^\x93\x97&\xf5;\xf5$\xb3\x81ix\x92s\xea\x14\x97\x97\xc7\x17\xa5\xe5I\xb1\fFS\xb0S\x06\xbf\xf4q^C\xff\xa1\xb6F\a\x87$\xb09\x89ى\t4N\xc0E\xe8\xe94Op!x\u0084\xb2\xecrs)\xbb\xbd|Wk;}\xfe\xf8\xf1\v\x8d{5\xb1\x95\xec͓\xbf\xed\xfb'\xe72T{f[\xady\x05\x06X\xe6ﹷxoy\x13\xf3ik\xb0\xbf\u007fp\xb0\xbf\xff\xf6\xfd\x9f\x1a9\x9c~h%f\xfecx\x12\xf6\xd5:ee\xe5ޢ\x06\xe6\xc2\xf1\r+8\xb8,\xf8y\xa9\xd7Ƞ\x13\x86\xfd\x06C~\x83\xa1\xbf\xc1Px\xecf{e[L-i :Ƚ1\xd5Q;cbv\xb2\xd8Bz\x8d\x1d煮ޏ\xc0\x95\xe5y\x13\xbcK\xc30\xec!\xd9Y\x15\xb3wou\xf5^\x16\xdaȗ\x8f\xef\xbf|\xf9x|?v1\t\x99\x18E\xa1\xab\xb7\x0f\xba\xa0\xcb\xd8G\xafXx\x8f\xbc\x1c\xf1x/\x81\xef\x91;\xabc\xeaި\xf4\x86\xa1$\xbe\xdb\xe57\xf4\x18X\xcaK\x06CF\xaa\x88\x13\xae<܃\xcd\xf0\xfd\xdb\xf3\x9e\x9b\xfd\x84\xed\x1a\x05}?\x81\xd5\x17\tP\x91\x90\x88\xaba1&c\x12\xae\xc1E\x18\xcf:\\\xc8\xe5\x1b6\xaeX\xb1\xe1\xd8\x05\x16J\xf1\x1e\x89e\xbc\x81gQ\a\xbe\xe8\v:\xd0\xc0*X\x06)\xac \x90\xe7\x8f\x1f?\u007f\xfe\xf8\x86\x15\xacP\x8f\xbfQ\xd8@\xaex\xa3x\x9e\x85=$\xae^\x86}?'\xb0\x9aD\r\xac\xc4\xe5\xa0\x05\x1d\xf8\x82/\xe8X\x9e7C\x19큊\xabA\xb2\xab\xae\xbc\xf3\xea\xa6\"\u0382\x0f\x85=\a\x0fƞ\xac\x18m\xf3u\xf8\n\xd1\x12\xe8\xf9\xd7_Г\x15\xa2\x1d\xbe6_1Zr\xd59\r\x87\xa6\xbd0\xe1\a\x98(\x100\x91\xff\xa17\x0f\xfe\xf0\x1el\x16\x88.\xea\xff\x03lv\x12\xf9_g\xe5\x1b]\xe0\x9e\xca\xfd\x17\n\x0f\xe1=\xc7b\xa1\xd1\x1eJ\xca\xc3\\p\xb3\xe32\x1c\x91\xc1!\x98H\x81\xbfc1\xfaK\xec\xa1\xee\xe0/\x91\xafv\xc1\xcd\xe8O\xc2\xe1\x0f)\xc7bR\xfe\xd3Y\xb8\xf7!\xde\xc3\tB\x93}\x96\x04\xfd\xc5&\xa7\xdaa\x98H9\x16\xc3\xdd\x0f\xf1.N\x10\x1a\xed\xb3ȷ9\x1e\xa8\xf8\xd9ֳR\x10`\x9e+\x0f\xc7!\b<!\b\xd6A\x04Ժ\xd9\xf7\t\xc5\xd4C\x98#{\xc0\xe13a\x06\xf5\x00\xe6\xc8\x1er\xc2%\xf1#\n\xe7\"\x83\x03q\xd2\xe6\x80\xdc\xe2`\x16\xb7\x90\x18\xb4\x85\xb0\xf5$\xef\xe6\xdcn;\xff\xf9\x81C{\x8f\x97\x9e\x91f\x17\x17\x9b\x8a\x19\x98\x04Nѹ\xce2\xa6\xff\xab8\xd9? \xaf\xe8\xbf\x15\xef\xc1'\xe4\x9e#\xa7\x8aO10\xf9\xad\xb4`\x87y^j\xa8ʋ9R\x06\xa3\xa0\x0f$\x1f5_\x81<\xe8\xd3[8\x01\x13\xe0?mi\xd0W\xfe\x95\x8b\xca\xfd\x92\xf0\x9c2Vg\x9e\x00ٲ|xG\xb96\x8a\x00\xef9\x87\x9aЏY㟮[Ù\xe3\x9c\x0f\x8eM\x9a-a\xa1\xec\x8d8\"]\x9b\xa9\xd5\xd0\xf2\x9e\xdaY0\xe4\x8a\x17\xbd\x10\x158pQ\x04zìV\x13k*\x83\x05\x87\x89\xaa\x9c\xa2\xf2JzOl\xd9\xee\x14T\x87f\xecf+\xd2\xe2\xf6\xedf\xb4Z\xadA\xcbɇjCS\xa7\x1b[\x96\x88\t\xfd<\xe325\xf1qtLeBu\x830\xf3\xcf\xe2\xf3\xacU\x9b\xa7-\xd4K\xd7~\x05,f_d\xee\x83\xec\xbb\x1bǹ\xc2\xd2\xe2\xc2ʬ\xecu\xe8\xfeqF\x8843\xdc\x14\x1cF'\xf1\x89ىlڞZC\x1d\xf3嵚\xac/\xb9\xd2ΚN\xc3mc\x8bg\x06\xbc\x13R:\xf1\xab̜\fK\xa6%7ǳ \x9b\x80\xcf\xd1\xdd!\xc7q\xe0ˁ\xf43P\x1f\x03\x97/\a\xfd\xfc\xe1W\xb3\xe1\x1d\x94\x81\x1f\x0e\x80\x10,\xd6I/\x83\x86ʳT\x16\x17\xd1\xf2^.\xe5[\x1a3\xf6\xb3\x87\xaaN\x99\x8e\x14\x80\xee\x02L\x01\xf9K\x88\xaa>Zu<\xfbTZ\xaa\xe7\a8+q\x03NA\x05\xba\x8d\xc7>\xbe\xd8g\xadT\xdeS\xbb\xdbD|Kj\x8c~\x86\xa4\xcb\xdbk\x95\v>\xda8{xЦ\x84]\xf1\xbb\xb7H\x9bo|Sx\x9b\xf9z\x9fY[\xcb\xedѕ\xef>\xb4Q*'\\p\xe7\xeb\x11T-\x8e!\xd2\xda?\xcd\xfc\x94\xb9\xffCY\xe3w\\k\xc2\x02\x18\x13\xc2\xcc\xc71!A\xbe\x1c\xeam\fu\x1f\xb7w\x9e$\xe4=\xb5\xe8\n\xb3\ta\xad\xb8\x97J\x96,6\xcdI\\\x9c\x96\xb7\xd9s\x9f\x05fT\xec\xa5-Y\xb9F\v+n\xb0I\xa9<\xad֜\xc2D\xc7h\xb3\xa2\xb9\xdc\x04\x02\x8e\x8avj8\xd9^\xa4\xfb\x91\xb0\xfd\xa9\"nQ&\xd2\xe8\x9cƺge\x1a\r\xd9ل\xc9B\x99L\x84\a\xa6\xfe\xd8\x01\xbd\x17\xdc\x00\x02\xde\xe9\xcd\xc3C\x95{\xcaYy\x9d\xfc\x82\xca=g-\x95\x9df\xd6i4Ry\x9d^?u\xe5\xa8\xd4\xd1z\xec\x99>\xf3\x86\xa6(\xa7<?O\x9ag͵\x142\x05Y|\xa6\x85۹\xffL4\xb80\xe0\xf2\xed\xbe\xba\xfd\x9c\xbc\xb3\xac(W\x97\x9d&\r\x00\x92\xcaKH\xd3%\xa7iҍfM\x8d\xb4F\xa3\xabY\xca,\x8d\x8b\x89\xde\xc1E\xec\xd2\xeau\xc5Ry\x9d\xae\xc4\\\\Y^Y\xdeT\xdfX$-j,ͪg\xea\x1b\xb3R\xaa8\xf9\xa9\xaa\xe4\xa2\xf0\xbc\x04)\x00\xec\xa7V%\x8cغ\x84ݲ4j\xf7\xba\x9cOr\xf0]X1\xd5,5\x0f\xc1\xed\x18\x80S\x98Aa%{\xb6s\xf2\xba5\xad\xbbΙ\xbe\xc9\x06Ox\x0fz\xc2h\x13\xf8e\x83\x1a\xfd\xfe̖\x9a\x1e\xc0x\x98t\x97\x91\xb7<\xd8㽚s>\x88\x84\x95\xf8\x006\xadlaǞ\x1f_3\xfe\xfc\xea\xd6Y\x0f>\xe9\x94\xca\xeb\xb4\xd8=x\x9d\u007f\xc8\xf8\xb9+cfl\x95.\n\xc1\xdfp\xa38\x8f\x16\xe7\vn\xf8\x8d0\\\x18\x06\xaf\xe0\x94\xe0\xcbz\xd4@\x88\xad\x15B]\xe1\x03\b\xb1\xed\x87\x107\xfb9a+\x85\xc3`8\x8eC\x1f\x1c\x86\xc3\xc3\xc3\xfd\xfc&\xf3~\xa7\x94~\xa7\x8dwO\x9f*,\xf4t\xe6j4\xde\xde\xe1س\x11\t\xe5\xe8\x98y\x1f\x0e\xa5Q\xf2\xeb( \xfe\xf8\xe1Ư\xd5,\xb8G\xc0{M?=jj\xf2\x84a0\xdci\xdf\x11\xf8\xc46\x8d\xf2y\x88\xef\xeeC\xb7\x9d\xd2\xdf\x13'\xdc\x1e\xc0 1j\x14J\x86\xdc\xf8\xf0\xd7X.\x18\xbb7`\xdf_G7\x85{\xf2$\f\xc3\xe10\x0e}\x9c\xdaNK\x0f\x87\xc3\xf0\x1d\x8d~\xa7\xf8{\xa7N)O\x9d\xba˟\xf2+\xd2x\xc2p\x18N\xd8\xda^\x1f\xa6\x9eO{0\xb8\x84m\x00\xf70x\xcf\xe7ю&O\xa7\xc2\x1bE\x1c\x0e>\xe8\x03\xc3qxS\xf8)?~\xb2\x9f\x9f\xf2M\u007f4E\x9e\xce\xee\x15j~\x1d\x05\xca\b\x90\xb6)yrD\xe5\x8f\x1f\xff\xc5\x00\xf1\xeb\xaf \xe1\xb2\xec\x02\x05\x92Q\xbf\"\xd1\u007fܴ\xc1\xc9,J\xf7c\x9f\x1d\xa3G\xef\b\xf7t\x16\xeb\xfc\xff\x10<\t]5\x80\x8f\xb31MMoڦ|S\x91F\xe3\xe9\xecHQ\xd1/\xbf4\x02\x15\x02\xa4\xf2\xaf\x92\xef\x1e>\xa7=\xd0z\xd0>\xec\x04Ȯ\xb9\xf2\x87l\v\x8e\n_\x1dr\xb3\xbd/l\xa4\xb2\xf3\xb5\xc7ӳӳ\xf1\x9d\xd3\t\xf9\xc9y\xdbK\x12\xf3\x8e}\xd5p6'?\xaf\xf4\xe4\x13\x93Ŕk\xaa1Z\x8c\x96\x04\xdd.\xbdt\x97~cx\xbc!!sE\xcc\x0e\xdd\x0e\xdd\xca\xc4\xdd\xe9\xbb\xd3c\x8d\x9aL\xa9)!'\x9d\xa7\xcd\x19E)ņ\x92\xcc=\xa6\x02sav\x855;';\xc7P\x94\x93\x93c>W\\\x95[\x9d\xf7}}\x81ѠY\x84\xbdc\xb6Ic\xb7\xe1;8)jGl\xf4\x86剚\x9c\xacF\xb3\xc5\xdcR\xddq\xa3\xa2\xfa\xb3Ϗ_k;'\xe5\xc9<Å\xf5\xa5\xbaRݥ\x90\xe2\xf4\xe2\x8cS\xd1\xd9yR\xf4\x17\x12\xa8b\x83>G\xc7\xe8\x8c\xe1\xfa\xa4\x8c\xe4̰\xd4\x14C\x8aaGNfNf\x8e5\xb8(\xa5fw\x85\xc1j\x94V\xd5\xe5\xe6\x96r\xa5\xb9u\xc5\x05\x95\xd99\x96<C\xb9\xd4\xf6\tR\xd4t\\\f\x9c?\xa1\xc2p\xf1+\xca^F\xda\x16@2\xf1\xba\x9c\x14\x8e>\xa0\x02\x81\xc1\xa0k\x1b\xb1\xdb\xc0}\x10&\xb4\xfc\x15\x06\xf3\x1d\x03\t\xf1o\xfbnJ\xf8\n\xd6\x10bO\x12KU\x98M\x1d\x99\x15\x96\xb0#.$I釒\xf1\x89؋\x19\x84\xbdۏ\x8d\xe1rS\xd67\xa62:\xbd^\x9b\x96\x9d\x99\x9b\xc1}r\x1fV.z\xc9ܗ4\xb6\xe4\xe66rO\xcb\xfe:|\xef\xb3\xc3\xf3=;\xc0\x87\x80#\xf6;\x14\x0e>A\b\xe3\xc9\xe2\xd4|m\xaa\xa3\x1b\x86f\xa5\xb1\xe29\xf0#D\x059~\xc7'\xb1\x06cz\x96>+=-+˘a4H__!\x8d&\xa3\x91֛\xf32\xf2\x99\x02\x9b?d\xe4\x95q\xbc\x89\xb7\x95\xa0\x91킣\x8bv\x9a]\xce\x03\xa2!\xa9\v\xa2\x16ڻ\x18\xed\xa0p\x83vدFE\x92\f\x14\xb9j{'p]\xe1c'P0\x81<\x1b\xf6M\xe0\x01\xd69\x82\x89\x03\x813\xf7\xadb\x1c>Uj\x95\xdd\xc5\"\xb3\xbd\x90,Ps6+\xf9d\xf5\x8fӏ\xb2\x8e\xe8\xd7;g\xa9\xc1\xeaP\xfc{}\x06V\xea\xb5\xc2i6\xa1\xcfM5\xf8H<J\xbb\xa27B_5\xbc\xd3\x05\xff\u07b5u\xed\x1d\x99\xfc\xb3\xff\xa8\x88\x19P\xf5\xbf\x05\xd3\x1e\xae\xae\x93\x85\xc9\x1c\x9d\xd8N͐\xc9gV/<\xa8F_\xf7e2\xf9\x98\xb3s\xd4o\x123d\xffR\xf9\xa6\a\xce[\x05\xbe\x05\xecZ\xf8\x06\xaf\xeb\xea\x1b\xb8\xae\xaboк\xc0\xd7}\xbcZ\xb0\xaa\xecg\xa9\x89j\xe8t\x1f\xaf\x96O\x1b\r\xd6ם\xff\u007f:\x1e\xa2\x06\xd3T\x92\xa5\xae\x82\xa2k\x91\xd2M\xe5^g\x8f\xa4\x0e\x1c\xe4/\a\x1e\xdcv`\t\xbf-\x10\xe30\xceSt\x97`\x1cĽ\xe1(\x03\xb7\xf1K\x0el\x83cx\xcc\xd3q\rF\xa9\x05\xc5UQ\x01\xa3Զk$\x1c\x83c\x04ށ\x89\x06\x99\xd7\x1aY\x9d\xcc\xcdVm?E\x81\xd7P،\x13\x90Z\x86JtG\xaf?p3\x8c\a\xea\"(\xc1\x9dŧb,\x05\x03\xb1;\xacE\x1ft]=p(\x0e\x84\xee\xb8\x06|\xa0ۙ\xff\xfc\xce\nl=\x85Yx\x1f_\xa0\x0e\x89\x87!\xbfs0\x10$?\x03\r\np\x19\x034\xf3\xf7\xf3*\x90\x83\x16\xef\xc3K\xcc\xe2@\x0fA\x14\x8c\xff\x036\x83\x17\xb8/\x03%R8a(nF/t\xbf\x88J\xa08\xe1\xe3\xe7\x14\xfa`w\\\x8b\x03\x87\x9e\x19\x04\xdd\xc0\a\xba\xc3\x1a\x18\xf8\xfb\xea\xbfѕ\xf3x\xady\xa2\xbejS\xb8\xd9|\xedi\x14X\xb1?\xd4\xe1l\x9c\x8d\xfd\xb1\x0e\xad\xb8\xfe\x11\xf6\x05\x8fu\xecF\xc7\x17DpV\xfd\x8eV\xa7\xa9\xdbZ\xdfؚ\xc5m\xb4}A\x80\xc7\xc9G\xd0\x17\xd6\xd3αY's\xe5\x81V\x11\t\xa0p\xb3+FR\xb64Ҧ\x80d\xc2ѓt\xf8\nŔ\xe3\x13ȳu\x9d\x98G\x00\x9dEul?\xb9\xb6\x82u\xf8\x82\x95\xb0$'\x99\x93\x98\xa4$CF2\xe7\xf0E+\xb16~\xfb\xba\x95\xb4\xc3J\xae\u07fb\xb9}7k\xf3\x15\x15\x84\xb6\xb2:\xa3\x9a\xa9\xae\xb6\x14Vr6_AA\xb4\xd7\xec=r\x82\xf6\x10\x9a\xf2b\\\x05+0»oa;\x14ÀT\xdbf8H Վߠ\a\x05U\xa4\xa0\x80\x89\x04V\x92\xa2/\x84P\xe2bX&t\x9d\xb8\x8c\xb01\x8e\xd5ԙ\xf0\xe3\xeb\xaaYq\x0e|B\b\x03\xc83a\xc7\xd7W\xb3\xa2/\xf8\x12y\x89\t\xe6\x04&!\xc1\x90\x96ȉ\xbe\xe8K\xac\x8f\t۰\x86\x16\a\x90\xe2\x1c\xfc\xe4ߔ\x0f\xb9\xae~\xe3\xd1\x18V\xf0\xc5h\x02\xf4j\xb3)۔\x9d\xad\xcc\xce\xca6\x9a\x8dN\xa6'\xe8\xd5\xc4\xd1\xea\xfac'i\x0fhʂ\xa58\x11B`\x01\x8c̓\xe5Y\xb0\xec\x15\x84\xf6\xe6\xa1IX\x05{0\x02\x9a~\x80\x1cy\xab\x8b\x8a\x9c\xa8r\xdfOaO1\x1fz\x92P$N\xa3\xa0\xf7\x95\x99\xf8n!'\x0e\x97@\x8f\xfc\xbb\xb7\xa1\aS&\xfe@\xe1\xe0i\x0fa\x10g\x1b$1\x95\x9a\xca\xcah\u0603ca7\x1e\xc2}\xb8\f\xb7a=\x1e\x80\xb9\xb8\x1a\xdaa\a\xf4\xfb\x03\xb6\xdd`q\xa7d\xea\xae![\xa63*\xfb\x17\xa7)\xf9*-\xfc\x05\xe7H\xf9j\x17\xf0\xb8\xb3\x06)\x94\x8fڊ\x04\x87\xa7\x84\xfd\x14\x9c\x80bR>C\xfb\xd7\xe3\b\x94z\xcd\xde\xfc\x01'\x9e\x13r)\x15*\x04 !\x1eB\xa1\x1bXa\xec\f莣\x8er@t\\\xfa\x01\xe4\xb4|\xff\f\xc8\xc1%\x9fc\x18\xeb!xB8~t\xebO\xf00\xc1\xa2\x9c;\xcd2\x98\t\xad\xd8\n\xd7a\xe6\x19\xe1KP\xc8\xc3\xce\x06\xa9\x1d:э\x02\x06\xbao\xc31S\x02\xd7\xcc\xde\xce=\x8b81\xeb\x03\x1a}\x90\x9e\x8b\xfdY\xb1\xb7\xadA\x02\u007f\xe3-ꋣ\xf5_v./_\xcc9\xad[\t,Ɵ\xa8[\x17j\xef\xde]\xd72\x9d\xc3e\x18.\xb1}\x1bJ\x9d\xf8|\xef\xc9R\x0eX\t\xf6\xf1\xf7\xfe\x04Ռ<\xcf\x05\xe7\xa1;\xbc\vs`:\xbc\x03}a&\xcc\xfd\x13\xfb\xe1\x14\x1d\xaf3\xea8q\x94d\xf4ɕ\xbf\xb2r\x9d\x8bN\xe8F\xcdX\xbb~F\x06\ay\x92\xdbi\x01'\xa62r\xaf\x198\x1e*\xa9i\xf0\xf5\x1dң\x1a\x15\x8f\x05\xc3\xe3\x950Tx\x05C{\xab$!0T~L%\x19i\xff\x84\x02\x06\xbb\x81+\xd2Ƞ+\xba\"\x83\f\xb8\xa2+0@C7p\x05\x86\x95\xdfq\x14:\x12(\xe4\xd0\rݐ\x1b6\xb1\xe3{\u007fV~\xac=\xb1=\xa1=\xf1o\u007f\x90\x9en\xff\xbb\xfd\xef\xb5@\x0el\xef\xff\xa9gNEEv\x05\x03\x1ct\ts\xb6[\xb6x\n\xa7\xa2\x1aU8\xc5I\xbb\x1c\x91\xa6\x80\x1a\xd40\x95\x95\xdfqޢ\x1a\xa7p0\xb4\x8f\xfc\x18\xe4\xe0f،9h\xc6\u0378\x11\xb3\xb3X< \x0e#LZ\x8dI\xc3,Z\x9a\x10\xb6\x86\x13\x950\x86\x10g\x92\x900\x8a\x90\xdf\xc1\x11-\xa0F\x12\xc63\xf01\f\x02\x06f\x94q\xc2|\xf8\x9a\x80\x1c\xd8\f\x9b\xc0\xd2\xf5\xcd\xc8\xe7\xd69!\xe9\xb4\xd0\xe7\x04\xbc\x03>\xae\xc2W\x97`\xd6)\xa1\xaf3\xa5pS\xb9\u007fo\u007f\x97\xf2\xf2\x81[\xeaG>\xfdX\xf1\x95\xf8\x80\x82n\xde?a\xb7\xe4\xa4,C\n\xfbCȽ\xd0C\xa3B'+C&\x8d\n\x98\xdc\x1cR\x12Z\x1cR,]\xd4r}\xf3\xb7\ft\xfb\xe9'\xe8\xc6\xd9U\xb6\xcb\xd4\x10\x19,\x90\x89\xc1$\x9c\xc6w\x88\xda\xc3'\x8aO3\x05V\xdeh\xe5`7\xdc&`m6\x8c\x03\x1fX\xcb\xc0z\x18\x87\xe3p=\xfa\xe18\x1c\x8f\xeb9\xfc\x03-\x04\xaaR\x90\xf4B/\x06\x15\xe8\xf2\v\xbc\xbb\x93\xc3\x197\t\x1cA\xa27PH\x817k\xf7\x1d\xa8F\xde\x16J\x01\x8d.\xe0\x824*ѥ\v1O\t.\xe8\x02J\xa0\xc1\x05\\@\xc9zTg\xc5\x1c\x8cQ\x11kb{\x97\xa9$\x0f䑶;\x82\x1b%~\\6\xad\x88\xa7\xc3Ǯ\xc6n\x87GVn:\xe2\xf7Y\xa60q\x87gvM\xa9\xe0N\x88}\x85\x1f)qv9x\x8d#\xa6`\xaf\xe0E<\xcd\a/:\xb4\x8c\x85\xe5h#\xf8jX\x01\xbb\xa1\x80\x85\xc1\xa3\b{\x1b\xb2\x14\x8f\xd3F\xe3\xaf\xedl\x1a\xcc\xc4 |\r\t\xa2\x9b\x1e&\x12\xf0\x81\xe5V\x1eO\xf3_\xe7\xee\xbd\xc6\xc2R@±\xd7>\x9aZx\xf3\xa3\xdf\a[\xfd,\x8b\x12#6\xe1n\x14<+5\xf0\x15.!`\x87\xd0\xfd\xc4\x17<m돗\xa98-^\xc7\xd5\x04$\x8a\xae\xfb?\xe6\xe9\xc7uУ\xaa\xe3\xfe\xdeOˮD\x1e\x93\"\b\x0f)\xb9e\x16P\x01\x9f\xf34\xbf\xed\xf2\xectV\x1cb\"\xe4\x91<xc7\xe8\x81_\xb38l\x9c0!\x16\"\x85\xee\x84\a4\x81\x02V\xaa\x85h\xc1\x97Ҫ\xbd?\xfa\xfa\xa7h5$\xa9\xd96脑j\x10\xb0\xf3\u007fc\x83\xcf3\nz\x0e\xfe\v{\xb2\x90%sX%\xffQ\xa3B\x02\x9f\xa8a\x93\xdayU\xc0F\xb5\a4\xf1\xb0R\xad\"\xbdl\n\n\xb2d\xa8\x80Oբ/LS\xa3\x82\xfc\x8fz\xa1S\xfc\xaaݺ\x90tRب\x16\x14\xe4\xdb\"Uv/1\xfa\x94\x1a\xd6\b\xd1\xce\xca\xd78\x13\x1e\xd51\xae\xcd*⬛\x8axp\x15,2q\x900\x98\x80x\xb8\xfd\xf6n-ܖ\xc1#Y\x85\x1a\xdc՜\xa8\x10\x15z5\xac\x95\x88\x83\xc4\xc1z5Ŀ\xbd\x11\xb6H\f\v\xf5\xf3\x16\xd0!\xf5\x91\xfbY\xe8\x94쯯o\xb9\xa6\xff\xd2p\x95\x13\xb6lP\vZ\xe8\r\xbd\x80t\xe5Ag\xff\x1e\xfc\xdd\xec\b\xdfS\x11\xbbc\xa3R\xf49y\x1a\xf6K\xe7/\xb4\r\a3\x13?\xd4&N\xe5\f\x9aČT&<\xae\xb6\xae\xb9\xb2t\x8f\x85\xab~\xfa\xa4\xf8\x0f\x06\xa6\xc0\xd0mӀ\xe2*5\x85\xda\b\xfa\x19\x84R!;#\xb7'j\xf3r\xb5\xec7\xd8\vg\x9e\xc6\xe5\xcc\xd0\t\xc9q\xb3\xb9\xcc$}f2\x13\x12Y\xdf\xd2RV\xd3l\xe5j\x1f\xfeT\x01\x14\x03\x1b`\xd5\xda\xd10\x82\xabK*\x88\x8bt\x0eS\x13\xe5\xbf=|[\xa2.'?\x99\xfd\x119\f;\x8e\x01\xcc\xfb\xef\xeb\"\x87r\x06mrF\x12\xb3%\xac\xb9\xfdӽ\xb5\r\xf9\\3\xb8\x82G)(\x19\x88\x01\xdd\xfaA0\x93\xabM.\x8b\v\xa4\xc1\x1f\x87P^2\x87\x06\x15*\x89\x16f\xa8U\xee_Qp\x1e;Ȭ\xac\xc8\b#\x83\xef\xe3\xdeMᄘ#zC\xb3\xa46\x1cϋw\t<\x0f\x1ddvv}\x83\x89\x81\xf7a\xef\xd1&B\xc8\x11\xbc\xb1Y\xb2k\x1f\xe1!4\t\xc2\xd3\xef*nT\xba\n\x87N\x9c\xfb\f\x98\xd3\a\u007fy\U000556ed\x0e&R)F\x9d.\x85\xd1\xe8\n\x8a\x8d\\2\xa4\x97\bUDqaaQq\x816\xc5Ė\x89U\x04\xb4`0e\xdcE\xe8\ri\xe9\x19lFFZ\xa6\x9eI\xd5\xe7\xe4\xa5s\x99\x96\xac\xdc\\\xbako\xa1\x164\xbc\x89\xa7\v\x84oM\xb9D\x81Śoa-fKvn\xae%\xcb\xd2c@~\x86%UG\x17\x89?\x12\xafہ\xa2,FCN\x06\x93a\xc8\xcc0r\t\xa8(\xc7\xdeD\x86ɜia,\xe6\x1c\x8b\x89\xab\x82\xde\xc4u\xd8JY\x8c\x99N\xb1\xcc\xcc\f\x9e\xe7\xa3q+\xb7\a7\x12\xe99\x99\x16KN\x8e\xc5\xc4\xee\x85M\x04䡑\xe2I\x9eĥ\x82\x86\xca˰\xa4\xa7gd\xa4\x19\xd9\x18̪F\x1d\x91n\xb2d\xe42\xb9\x16K.W\a:\xe2&X\xa8|c\xba%\x8dIK\xcf\xd0s1h\xa9F\x03\xa1\xcf\xcd\xc8\xcb˵\xe4\x9b\xd8=` nA\n\x95oL\xcb\xd33\xfa\xb44=\x17\x8b)Uh$R\xf3\xd2\xf2\xf3\xf3\xf2\xac&\xb6\x1a\x8c\x04\xbc\xc6\xf47\xb5~js\xa5\n\xf4\xf9\xa9\xba4\xbd\xce\xc8\xf2<\x1f/\xf6d+\xd0H\xe8Ly\xfa\x02ƚ\x9f_\xc0\xf1\xa4S\xa7S\b\xa4\nS\xf3uZ\xbd^\xdb%\x99(\x06\xb3eh \xb4\xa6|}!S`\xcd/\xe4x\xb2\x12\f\xc4\xf7\x82\x85*2\xa6\x16h\x18\xadN\xa7\xe1\x12Es\x19f\x10\x1a\x935\xb5\x90)\xb2Z\x8bL\x1cOV@\x06!\xc8PK\xfd\xbb\xf4Ǔ\xe0&C?[\nU\xa2-LN\xd6j\x93\xd9d1\xaf\x04\xf5D\xb2\xa9@[\u0094\x14\x16\x96p<Y\nz⾠\xa1ʌڢ$&I\xa3I4r\x1aQ[\x84Z\"\xc9T\xa4)eJ\x8b\x8aJ9\x9e,\x01-\x01~}\x92mrcazA\xaaEi\xceL\xcf\xce`\xf4i\x86\xcc\f.-=3\u0558*5\x90\x1aG\xcf\\잋\x12\"\xc1T\x92\\Δ\x97\x94\x94sζ\x94@\"a\xff\xbd\x92\x821(\a9\x8e\x0e\b\xd4'og!?\x1aC`=\x960\v\x17kv\xfbr\xbb\xf5\xe9\xfa\x04\xfau_2\xbe$\xa5<\x8bu\x1aW{a4ȡ7\x8cf=\xe0\xa5\xfam\xf8+k\x17θ\xcdj\xb3R\x1a\x99\xc3ꮑ\t\nw\x8d,N\xe6\xde\x15Ȏ\xc4~\x1c*H\xec\a\xbf#\t\x05\xb2<5\v\n\x12\xfa\xe1\xef@\x8aSX\xa7\x89\xa4\xe8\b\x89\x81\xba\xb3\rW\x84\xe8;2\xa1\xfcr\xee\xc9\xf0\xb6.K)\xecpW\x84\xfa6k?\xb5\xbc\xa7A𑈅\xe2G\xd4ғ\xab\x1f\x04\xbf(\xbai\xbal\xba\"-\xe0\v\nh\xb9Tj泍f6;\xdbd6\x99s\x9b\r5\x9a\x12\xa9\xbc\xcdZ\xa0\xd7X\x92\x18\xa3$F\x12\x9b\x94\x14\x95\xc6\xc5\xcf/@\xf7ft\xdd5!\xd8{㘭\x93\x82\x16\x84\xad\x91\x9a\x8c\xd9F\x13\x9b\x91\xaf3\xeb3\xb0炕\xd3t\x1b\f\xb19ZKF\x17\x12\xbf\xb1V\xe3\x99a\xb5f\x150\x97\xbf\u007f\b\x1f\xffý:\x00\x83\xf6\x01W*\xddt\xff\xf7\x1dW\x99\xb3\xa5U\x15f\x8e\xe7M<k2\x99\xb2M\xa6\xc2\xda\xf4\x8a\xb4\x02i{\xd4\xf8\xfcyLP@V\x86?\x17V\x11Q\x11U%\x95\xcfЊ\x9d\xe2(\xaat\xff\x89\xeb\xb7/.\x1c\xb4ysx\xb2Tx\xdf>\x9crj\xd2rw\xafܴ\\\xad)ޔ\xc5g\x99\x8cY\x06c\x96\xd1 \x95\xbb\xcf\xc8\xd2\x18\x92\xb3\x12\xb2R\xb2\x92\f\x89\xceB\xf4\xe7\xf9\x93\x05\rR\xb9T\x8a=\x1dC\xa8\xd2\xfa҆\xd2\x06\xa9\xbc\xa7\xb4\xedVõ\xca\xcf\xf7\xcf:\xba\xe4\xd4f\xa9\\\xean\xd5\x17\xa4\x15蝂C\xe2\x87Dx\aKmt\x19e겜\x8d\x99\x19|*\x13\xbe\xb1\xa0\u008f\x03\u05fdq\x19\x16ΣTm\xb7\xbe\x92\t\x93\xf2\xe0\u05f7 V*\u070e\n\n\xcez\x8d\x91\xfc).\xc8I\xccN0\xa5(\xb3c\x88\x1cIN\xb5\xb9\xd8T\x9eS\xa6\x84\x01§\x04H$0@\xfc\xd4)`NQ\x9aҲ\xf5\xe9t\x8e$\xbb\xdaT\x9c\xed\x94\xf9SX@\x8cy\x05g\xbdfKp\xa2c\b\x81\xde\x12T\b\xf1\x19e\xc62C\x89\xd2PKdJ\xf4F\xbdQ\xcff%\x1a\x922\x92P!\xc6{:e&چ\x10\xb3%]\xed\xba#\x13\xbe\u007f>\xe6\xf9\r(\x94\xc9\xe1\r\xc0V\x90c\x1fuC\x02\xab\xa0\r\x97A\x1d1\xf7\x15\x1c\xf1\x9a.y\x89\xde\xf9\xa9\x96\xb4\x9c4e\xb6!\xdb`ʔfK\x8c\xd6ҢJ\xf6\x1f\xf0&f\xbe\x82f\xafy\x12\\\x8d\xad\xe0\x8b{\x89\x1b\xfd$rģ/\xbf\x95x\xc1\xb8\xb4\x82\xf4\xdc\xcc|e\x969\xcbl̑fI\f\xe9Iq\x1an\x00\x8e#n\xf7\xc3\xfd]\xc6|\nL\x06\x02\xaa!\xe3\x8eL\xa8\x84\xa2\x14gk\x1c\xda7͉v\xec\xa3~\x15>\x1b\xf5Jp\x97\x1c?\x9e\x99t\x90\xfb1\xb8c\x02\xba\xd2\xe8\xbab\xe4\x9806%( k3\x83j\x9c\xfc[?\xbc+\x81\x97B\x1fJ\xfeZ\xbbo\xaf\xa5\xa0\x9a\xfb\xb4\xba\xea\xc8y\xfall{P5[\x14\x13\x99\x1b\xcc`\x0f\xac\xee\xaa\xd3_\x86\xd3c\xec\x8a;2\x9b\xd5Y\xddg]\xb5\xa1B줰\x13:\x9doh\u007f\x19!\xbf\x8f\xbe]\xa0Fv+\xe9\xe8\x14\xacT\x89\f\xce\xc98\x0f\xb4\xc2o\xb2\xb7\xa0W\xbdy`Լ\xb3\x8c\xdf\x0f\xd8\x14*w-LW\x83\x02\xad0\xbd\xebC\xc2\xdb\x14%/?\xd0*\x93ؖB1\x85\xd7\xe0\xdaۯ \xaf\x15U\xea\xff\x19;\a\xaado\xbe\x94\x88K\xc1@\x95\xa7\x97\xa7\xe6\xa5\xc1u\xbc\ue657fM\xc8M\x90\x8a=$\xf2~^\x82\x02\xce\xcaT\xe8\xb2H&X\xa1\xafZ\xf4\xb9\xe9l\xbc\x83\xc4\xf3T\x83>\xaar\a3\x05\xbe\x94\xa1B\"\x9f5\xe3\x03|\x14\xb3\x98\xa73\x12\f\t\xf14\xba\x81ˤ.0i\x97{\xe0Va(\xcf(g\xe5\xfd\xbc\xf8\xdd_\x8c\x87ì|\xd6\f\xec'n\xa3>^\xde\xd8\xdaz\xa1\xf1֭\v;\x82\x83\x97\xef\xf8\xd89\x82\x05\x05X\x05\xc5\x1d\x19l\x91-\x04\x05t\x82B~\xba\xeb?&\x9f\xe5h\xc4@JcI1\xe8Rq\x12N\xf6ľ>2\xe8+\x81I8\xf9m\xc8\xd27\x01K\x85v\xb2X_\xa4\xb1\xb0\xc28\xc7l*9\xd9R\xa0\xe1\"j2\x0f\xefi\x90^\xbb\xdb\xfa\xc7s\xfay\xf0\x1f\v\xee\xb2\xd1\r9\x87\x1b\xf6\xd4״g\xd6DH\u007f\x1cx\x1c)\xf4\xa4\xd1s\x03R\x13\a\xb2\x89)\xf1\xfa8\x06\x83\xe0\x03*)1\xb70\x85\x8b\xaa\xcdj\xaf\xdb+mi\xab<~\x8a>\x15w<\xa4\x8d\x8dڛ\xdd^W\xbb\xb7\xb6\xddX\xbbSz~ɾi\x1f\xd2\x1f\x86M[\xb1\x84MIIHK`\xde\x0e\xfb\xae\x8etv=\a\xed]\x1dq(PA\x89\x8a\x1fբ\xe2-\x94\x90\"\xcc9\xe7k\x94\xc9_\t\xda,ʔ\x99i\xcad࡚\xc3\n\xac$Ɛ\xe0$\xe9\xb9\xd6\xff\xee`\xe7\xdb\x0e:\x87\xd7\xc0\x18\xbb\xe7\xff\x84\xad\xf3A\x05\x851ء\xd7\xf2\x89\xf9Z%\xc4`Gar\x95\xa1\xa4Py\xe6`I\xa1\xb9J[\xa2\xc4\x05\x90\t\v \xb3\xb0\xc4P\x95\\\xa8\\s0\xb90֠MVڎ`\a\x851С-\x895'k\x95k\xb6%k\r\xb1\x85\xc9JX\x80\x99\xb8\x003\xb5\xc9\xe6\xd8\x12\xad\xf2̶\x12m\x95\xb9\xb0D\t1Б_ȗ\xe9\v\x95\x0e\x17џ\xba\xa2+7\x94\x17\x9a+\x94\xc0ھ6\x97k\xcb\xe3\xcd\xcaE\x05\t\xe6\x04\xad!^\x89\xac\xe3kCBaB\x85A\xe9!$\xc1V\xe1'0\xbb\xda\xe2\xa1\fvC\xb5\x9b\x8a\xf8ζ\x96\xd2\xf9\xfbg\x062\x18\x88\xe3a\x10\xe4C\xaf\x93\xd7n^;\xb2\fߙ\x17?3e3w\r\t\xf8\xb8\x95\xa7\x0f\xfeX\x05.Oi\xc8\xc7>\xf0\x01\xa6b\x16\xf6ǥ\x98\x8dF 1\b\x96\rc\xa7\xa0\xf7@\xec\u05ef\x1f\xae\x80$\x9c\x05W>\x16\xfe#\xc56\t\xf4y\x9d*\xd0\xd8\xf9\xcf\xe2K\xc1\xa7\xb3\xcf6\xdd?\x019`\x01/8!\xbd\xf8U^\xd9W\x9c\a\x84\xca`\xa4měS\x11\ta\x82\x1b\x0550\x0f\x1e\xe1.\x02\x91t\x8c\x10\x8ePPH\xc2J\xa4\b\x9b\xc41\x81\x02$\xe1\x11\xec\x82\x1a\x9cG8\xa6\xfcBa\x1d,\x80\xae\x13\x17\x10\x8e\xe9\xbfQX\x03\U000c4fc4ANu\x9b\x04oR\xb0\x12(\x02\vI\xbc\x0fQ\x14>\x82]\x04 )\xfc%\x0e\" \xacO]J]L\t\xeb\xb8,\x1c!\xf2S\x92,ILRR\x86>\x85s\\\x16\x8f\x101\xc9)QQ\xb4x\x84\x8c*\x8e\xaaNfmN\x9e\xbe˳\xbb\xb4Ԓ_\xccٜZ\xd5%\xc5uu\xb4\a4\x99 \tއ\fW^%uqS\x91R\x9b\x85*2\x15\x15\xd1p\x1f\xeb\xa0\x06\x1fc&\x06\xa0\x17n\x1c¶\xad[R\xb3\x90\xc1\xd1\xd8\v{\xe2\x88\x1d9\x91\xf9;9|\xf7\xe3I\xd8\a\x87\xd0\x18\xd5\x15\xe2,:\x85\xb5H\xb2%&\x89\xca\xfd\xb8$/Ϙ\xcf@\x14̇9\x10\x01\x1f\x0e\x80\xf7q\xe0eNe\xbf\x86\x95\x14|\b\xea\xa70\x8a\x85k\x17D\x05lgn\x9f?u\xe7\xab\xf9'\xe6/\xdf\x18\x15\xb0\x95\vk\v?\x10\xda*\xbd\xf9Ug\xc3\x1f\f\x8c\x05\xe5P\x9c\x84\x13\xd1s\b\x8e\xe6\xb0s\xbd\xa0\xc0Hf\xce'\xebf\xcd\xfc|\xfd\xad\x8b\xc7\xea\x0e\xb7sMA\xcd\xc1\x8dAҹ\x1f\u007f\x1c6\x84AoT>\x85\xa9\x9c\xc7C\x99]\x01U\xea\xf1\xb2z\x19\x87\nw\xe8\x03\xef\x85,\a\x17\x0eW\xe1*\xa2b\x95o\xc1Rf\xd9\n]\xfc*\x0eW\xc1*\xe2\x02\xb8\xc0{-ЇqLz\xed\xa0\x96\x83K\b\xbc\a}h\xe8\xd3\x02\xef]\x00\x17\x16\x9cZ\xf1\xabt\xbeK\xe9e\x05+*V\xb1B\x9c\xf8\a\x85}\xf0\xbd\x96\v\xe8\u0081\xb3\x8c\xf8\xb3\xe7u\x17\x99K\xe7\n*\xcer]\xf2\xcb\xd1\x05\xdf\v\xc1>\x8c\xa0p\x87\xa1\xb2z5'\xc4A8Uq\xb6\xe0\xfcE\xfa\xa2\xee|\xfcY\xb6K\xf1\x02\xba\xb4\xe0{؇\xc6>!\xf8\xderta=\xaa1\x1a\xa2]\x9b!Z'\x83h7\x95\xe4\x81MA\ts\xb0\x13P\xf4\xf9\x82\xc58\x12\x8e-\x85H,\xc2/h|\x89\x87\xf10\xbe\xfcwK\xc3\x17\x10\tEp\xec\x12\vq\xa4\xe8\xb3\x18;\x11\xc59\xb4\xb8S\xe8+\xf6\x15v\n;\x85\xbeB_a'\xebQ\xad\"\xe6\xc7\xd6\xc4\xf6\xae\x84\x18a\x1f$\t\xfb ^\x1e'?Vi\x1bJ\x89\x19I\x02#N\x14xF(\x12܅w\x84=i\\\xba0\x17\x93a&\xce\aO)\x1fUP\x13\xcb\xf2\xc2\bq\xb7H\xe2\x03\x96\xc7\xf58\x1d\xbb\xc38v0\xa8\xdb!\xf2\xc2?R\xfe\x1a\xb8\xff\xd9\xcc\xca\xe3\x06\x00I\xf0^K\xd7Ndy\vx|\xf3\x9cE\x1f\x98\x8f\xe3p!\xc1\xff\x80\xa4\xc1\x8b\xe5\xe7\xfe\xed}\x90\xed\x8f$\xc1\x03\xe5\xdb6\x86\xe5\xa1\x1f\xd6#\x87\xf3Y\x1e\a\xe24\x9c\asY|\x0e\u007f\v\xbd\x84\x95\xd5UR\x8b9=\xcb\xc8`\x05\xce\xc2AX\xae\xc3CR\x8fj\xb4\x1e=z\x1dzĨ\x88\xf5\xb1-\u05cf\x1e\xed\xcd\x1f=\x02#T\x84\x17\x8c8rT\x0egm\xaf\aR[L[,\x01y\xe5\x0f=qP#*\xbe\x1d\xf1j\xc1r\xcf\xc8}Q-1\xadR\x9e\\\xb6\x1d8\xdc\r<\x0e\x87V\xbdR|*\xfcFE_O\xf9\xfa\x1b\xfa\x9b\xa2\xaf\xf7\\g?l(>T~\xb0\xb2U\xb9\xbc\x85X\x8c\x92 \xf4D\x8evڷ\x9e_\xa0\x84\xbd\xd0\x12זx \xf9\x90\xf2\xc7\x06B\xe5\xfe3Φb\x82\xa3B\"\xc3\x16\\\xf0\x1cᅊ\xe98h\x87T\xdeݥ|\\@ږ\x8c-F\xa5\xbc\x97\xcb|\x12Z\xf3\x81\x87\xe1\xc0\xc1n)n\x13\x1eP\xbb\xafl\xbf\xb0\xf9\x14.\xc6\xf1\x9er\xa96\xf0Ț}K\x8bNB\x9ag^X\x88y;\xb3=Đ\x16ƝĴ\xa5\x9a5a\x81\x9b\x95\xb8\x18\xc6o\xf6۾|\xf7\"%$O&R#3\"\xc2\xe9pK\x845\x92Ť\xc9Ջ\xf6/?⧄\xc50\xfe\xc0\xe63\xe1\x974\xcau\x98N\xa4\xefkɄ\x9fd9\xb9\xfb\xb8u\x90~\xa9\xe8LӁ#JX\x8c㏜\xda\u007f\xa1\xfa\x8a\x12\x93\xee\x12\xd6zKC\x13ݔѐZ\xcfB\xf2]B\xe4m})\x9e\xc4V=\x1aq\x18r\x18s!ĳ\xa6\xb5\xb6\xa5\xbe\xe9\xda\n\xcf'\xaf@\xf1-\fjHx\xe8y(\xbf=\xa7\xdd$\xb5}\xedH\xa0\x80\v\x02\xcf\xc5 a\x97\x87T\x06\x97o+\x0eP~\x18A\xec\x99_\xf4\xd1Lzf\xcaG\xd1\xf3\xd9\x1f#\x92\x03\x12\x03ゔ\x17B\x88/@\xd2\x06\x9e\xc0\xd1\xd8C\x98L!\a1h\x84aؚ/\x9dO\xb6\x1b\xdb3\x0f\xe9\x13\xc6y\u00a0\bPL\u007f\xe2u\xed\xbcg}xmHM\xf0\x85\x16O\x98 N\xa6\xc4\x10\xb1\xb7\xd8[\fqR\xa1\xb7\x10\"\x84\b]\x94\xe5I\xe7\xad3\x8b;ڧ^\xc6\xf2\xe4xY\xd7\a\xbb\x85\xaf@\x0e\x9d0\xeb\xc5S\x98\aY]K6\xbda=.\x81a0\xe9\v\xf0\xbc\f_\x82U\xeex |\x0fqTǒ<\xffM+S}W\\M;\xc2\xcag\xb9\x1c=U\xd4\xc1\x05\t\x81ԉ\xe8O\xd7,\x8fݶzuM\xe89V\xdeϥ\xa3\xaa\xf54\a\x93nS\x89\x19\t\x99\x89\x99\xd2܄\x9c\x84x\xfa\xfd\x05S\x06Ʋa\x19\xf1\xc9!t\xf0\xc9؆\f\xf6Ǥ_\xc2\a\xd3\xd8o\xd8zT\xf4\xc3w\xdb@\xfeͱ#7jX3\xb9\xa3\\S\xdfB7\xe7\x1ej.a\xcd\xe4\xe2\xea-\x0f\xbf\xa0A\xfa\xf8>H\x0e\x1d\xd4ŵ\xb0Ǐ\x17\x97\x1d\xe1\xb0\x17yI\x9c@\x99\xeb\xf8\xba:ZP\x91{\xf8:\xc3\x1e\x16Ԓ\xach>:\x9a\x16\xfb\x92\x91|Dv$+H˨\xf8\x90m\xe9\x81\fz&>\a\x17\x0e³\xb3I\xbdޔƤh\xb2\x8c\x1a\x0e\xba_>AV\x1a+\f\x15,xI2\xb6go\xdfN\xbf\xc0\xee\x92\xf8\x84\xecxf5\xee\x96\x04\xe6o<V[\x9bWR\xc5\xe2\xbb$\n\xb6UԆ\xa0\xcd\xeb\xd6\x05~z\xe2dۑS\xa7Z7\xadg\x05ᤗ\xba\x1a\xad0\x13\x8e\xc0F!\r>\xc1\xe90\xbf\xf7\r،+!\x04\xbbA \xf4\x87-8\x186\x82\v\x84\x80/\x84\xcb\u007f{ \xfcZC\xf9L\x8d\x9f5g@\xe1\xe3\x9f\x1f\x95\u07ba\xf1\xbbƛ]jˤ&\x85\xae\xf0\xf1\x0e\xfa\xec\xc7\xfb͟\xff\xfac\xdb\xdc\x19,\xd4\xc0\x1d*r\xce\xcc\xd8\x19̾\x95\x8b˺\x82`\rD5.\x19t\x1d\xb9\xffl\xe72\xc9\xd5\xd1\xdaEk\xe8\x95՛\x8eG\xb0\x99$\xba\x06͚\x83=h\xdc\x02\xab\x97\xc3t\b\x80Q\xb7!\x9e\x95+\xb4\xd0*~\xd0\x1fF\xd0\x1d\x8dէ\vX\x98\xb4\xef9P0\x95nLo\xd0ճ\xf5\xb9{\xf3\xf6Z\xa5p\xcb1\x99\xca<\xc6\x1f=F\v6\xb2\xdd\xf8\xa9\xa5\x9d\xc5(\x89\xc5߸y3-\xbe$7\xf2\x9b27\xb2\x10%\x11\xfe\x80(jw\xe86m\x0033\xe5\xebK\x9c<\xd5Ep}@64f62\xb0\x1c\xa7\x93r\x83\xf6\\ֹ\xfcs,\xc6J,;L\xe1\xe1\xb4\xfc}\xed\xf4a\xa4\\\xe7\x82\xcbIse\x95\xb9\xaa\xa8(\xab\x98\x91\x1b\xb4\x99Y\xf8\x9c\x94\xaf܌\xb3\xeap*\x84\\\xfe\xbc\xa0\xf1,+v\xaa\xdcc\xa9\xdb+\xbe\x9e2y\xd9\xeci\xd3/\xcd\xf9\x9e\x95o\xf6\xbas\xf1\xabۜ\xc7!\xf5\xcf^jQ#\x90\xffؗ?\xef}\xe1\xf9\x9a\xe7v\xf5s\xf9\x1d\xf9o\xb6\x1f\xedk\xa8W>\x0f\xfby\x8d\x1b\xe7\x15i\xd9Y\xb8\x8b\xf5ߴq\xe5\xc6y\xf8\x1e.D\x0e\xaf\x1c\x12\x17H#\xcd{b\xf7f\x9b\xf8l\xa6\xc1\\]\x97\xd5\x18\x0e\xdf݄Oa\x02d\x1d\xbax\xf8T\xdb!\xe9\xee\xe6\x83)\xed\x8c\xfc\xce\xcbG\x8f^rB/-\x05\xb7\xb1\x04J\xf06~\x83\xa5X\x82\xb7GՌ\xfat\x12[\xbcs\x9fnoq]q\xc1\xbe\x94\xbd\xd2\xed7\xe2;\xef\xd0P\x0e\xa1\x10\n\xe5N\x8a\xa1X\x8e\xe5\xd8E\x83\x02s\xac\xc1lDnd|ZdzDyDC\x9a4\xf5\xc8ь\xa3\f܆\x12(\x81o8\x8f\xea'\xea\n\x95\xe4\x9e\x1b\xec\xb1\xed\xa0p4ʆ\xe1\a\xb8\x9e\x0e\xb4\x86\x97\x84\xb0Y\xb9\x06}>c\x92\\\xcc\xfd\xec\\\xcd\x19\xa9%\xdb\\\x9a\xc7X\f\xa5\x1f\xfd\x8e\xb3n&*\xb3\r\x84\xc1\xc8\x1b\x8d\xac1#Ә\xc1\x04eE'\x84\xa5K\x03\x824\x89+h\xec\x03'2\xbe}\bC*\xbe\xfc\x9c\xddkh˩M\x93n\a\xf2\xcfP\xf0`~\x85\x11\x85\x0f/p\xf9\x85YE\x96\u0084\xa7\xa32\x96\x9f\xbb\xf6E\xebw\xcd?Zr<M&\xdeh\xe2v\x96\x1c\x19~\x889_\t\x1e\xad0v*\xb0\xe3@\xce\x19\xb3\xd3\xf3\xb3k+\xea\xeb鎝\x9f\xed8\xca\x1a-kA\xb3\n\x16\xa1\xe6O\xe5\xfe\x0f\x87U{3H\xa0K\x82\xdf\x00\x0e'O\xc6\xfe\x98\x8c\x8b\xe9\xd8\x04\xed*\xd3NiBL\xfc\xc6\b:ޔ\x90\x97\xc0z@S~\x8c\xad\x1f\x1cy3\xf3\x12\xee\xd8/S+\x83\x82|W\xfa\xb6u\x9c;\xd7\xd6q\xae-ȗ\xb5%\x8a9T`P\x90oPPہ\xb6\xb6\xb6\xb6smAA,j\x85\x1c\xa7\xecJߕm\x1d\xe7:\xda\xceutɾ\xe7\x94\r\fZ\xf9\xafl\xc7\x01\xa7l\xa5Џ\x82c\x8b\xf1:\x84\xa3\xc0\x88\xfd\xf1{\xac\xc5?p\x1d\xb8\xe2D\x98\x8fr\xee#\xdc\x01\xb9 '\xe2\u007f\xf9:\xfe\x17fO\x8d){\x0f\x97tR\u007f\xe6,\r7\xa1\x0e\n\xe0\xf7\xda=F\xc3\x1eV\xf8Y\xbcH\xc1\x11<\x02Z\xfc\x1e\xff\xc0ZԢ3U\x8bZ\xf8\xe3\xeb\xceʊN\x16֡\xab/\xcc'`\x10\xb8\x82\v\f\x84\t\xb4s\xaa\xac\x92\xfc\x97\x9bJ\xf2\xb3\x8axI\xe5\xe9RrR\x18\xad\u0590\xae\xe3T6\xedk\xdf\x18\x8d&ڴK\x99\x1dU\x1a\xb3'U\xe0p\xbc\xa7\xbd\xc3>\xc6\xf1\xe2\xf5Q\x02\xff&#\xcbwե\xb2\x86\xbc|c\x1eSY\x91_Z\xc4A\x06\x9c\x14\xd6\xe2\xe2g\xea\xff\xa3\xe4ؒHSLJW\x99\x9e1\x9a\x94\xe8\xec]o\xcb\fÕ\x9e\xe2\x1argٮ:]WQ\xf9];\x1c\x8b88\x03w\x85Z\f}\xa6Ƨ*\xfb@J\xd4\xc2\x06!\xcav\xd0\xf1\xdc!ǟA\xad\"Jm7\b\x8fj\x99\xd3,\x13:\xc1\xba0\xb67l\x919-O\xf9\xbd\xa3j\x90ɤ\xf2\xdfQ\r,qA\x06ߪ\xe5\xf7\xf0c\x18\x04a\x83 \x9e\x810\x19\xe7P@;\x1cR\xe3\vhW\xcb\u007f_8@&\xbf\a\xc1\xb2\xae\xa0q\n\x9d\f\x14n6+\xb4\xaaa\x9al\x95\x9a\xb3Y\x1d\x8a\xcfe<\xecR\xff%\xabF\x05\xf4Ş\xd0\x13{\x82\\\xb8\v\xdeB=x\xf4\x86\x020۪![\xbeQ%\xc9C\x05\xd5Z\xdc\xde\xf8\b]\xb6\xaci\xe6B\xab\xb2\x1e\xb5\xd0\xf2iF\xfbGD\x9b\xe9\x9f\xfd5\xec\xe9}\xfflzD7%\x1f\x0ebǍK\x1b\xe7\xed\x9d\xe7\xcd\x04%o\r\x1f\xff\xd7\xe1\xd3\xe1\\k\f?0\x906b'\xa1\xe5uF-kHI\xe15\x8cc\x89D^\x99\x92\u009bS8c!_PH\xcb7\x1a\xa1\x93h\xe3\xffi\xadfO\xed\xfb\xd3\xff\xe1\x9b\x02'\x8cO\x9b0jd\xde(&8eˎ1\xafN\x9e\x0e\xe3\x8eE\xa6\xe1\xc8u\xb4\xa3\x834-'\x82\x8d\xe3Bw\xb3~\xdbG\xb5\xf9\xd0\x11\xa5\xdbZ\xd8\a\x0f\xf2\x1e\xfc\xfa[\xdaoLk\xc9\xe1\xa6\xc7(۸\xba\x99\v\xac\xe1\x9f\x1f\xa4M\x02I\x14\xf2\x05\xa6\x02\xd6RVa,gl\x13$e\x15\xa6\x8c2Τ\xe3uZ\xda$2D0?!$\x86]\xbd}\xf0\xc1\xb1\xf4\x8e\xb2\xc0\xfd\xecO?\xe5\xfd\xf4\xf3\xcfi?1] G\x1a(p\x15j \x01\xfaC\x82\x9b\xca\xfd\xb5\x8a\xf8\x88\xda\x1fY\xbf\x9d\x15wIp\x11\x8e\xd5D\xcd\xe0&G\xce^\xb5t\x8atٔ\xf0a\xe8F\x0f\xaf\xf1\xf9t\x1a{t\xf2\xd5%\xf7\x03\xae\x85\xfd\x18\xfb\xbb^*\xa6A\xbdZ\xe5~\x0e\xfa\xabp\x1aԫ\xa1JV\x9dUk\xa9dsʊ\n\xcbr\xa49\xe5U\x86rf\u007f\xfd\xa5\xfd\x9c\a\x0f\nA\xf1\xab\x1a\xa2\xed\x9d\x10\xadx\x00/@A\xe1\vT\xc0\v\t\xbc\x00k\xad\f|\x04\x05\xe5x\x81\n\xdb\v\x89CaSP\x18\x8d\n\x88\x96\xa8\b\xaf\xd7\n\xca\x11\x8d\n[\xb4䵯\x8ap\xa9\x95\xd9\x14\xa2S\xa3\x13\xff\x97\x94M\xe1\xfeV\xd7\xc3O\xadr?+X\xa9\t\xd3BV-\xe7P\x84y\x84\xa9\xd0T\xc4\x17\xf2\x85Ƣ\xc2\x1e\x03\xc1\xc9Yu\xe1N\xc8}\x06428)Ӛ4&-\x87\"\xce#\xce.\x9f\xd62\x81\x81\x932\x9c\r\xfd\xb1?̾\u007f\xa7\xe5\xec\x05\x0e\x9cyF\xadQ\xa3\xa5\xff\xcd\x00\x8d\x8c\xf5\xa8\x96\t\xd3a\x9a0\x1d\xdeUC\xa5L\xf1@'\x83'\x14v\x1f6\f\xbb\xa3l\xd83\xe8\x9eo\xca3峘\x0e=\x88\xe0mkv\xaf`\xb0\xfb\xb0\xa7 \x03ٳ\xa7\xd0\xfd\xfc\x99\xdd\xc1\a9pf\x1a\xf3\x8dy\xf94t\u007f\xf6\fd\xd0\xfd\xd9\x1b\xfda\xd8}Ś\x9a\xb6m,\xa6c\x0f¤7\xa5\xf1z^oL\xd3\xf7\x18\bNN\xdb\xc135\xe7\x99.q\x19\aV[\x95\x97\xba\xda\x1e\x01\x1f\x82\xe2j3\xf8\xf4\xe6\xe1C\x95\xfbI\x18e\xb9j\x81Q\xa8\x00\x1fy\xa7p\xc3>\x97*\tI\f\fIF\xf7\x8dc\xc6lDwi\x946:i'\xa3\xd7g\x9b\xf5\\Hqҁ▋0\x00\xa4 \x85\x01\x17\xa5\xf2\x05-\xc5\a\x92\x8aC\xa4\xf6Y P\x98\x89\x86\xb97\xa2\xea\xb6\xf0QQʅ\v\x89\xf3\x83\xb0{\v\xf6bD%\xde\xc0\x1b\xa2\xf2\xdf\xd8\v\x82\x12n\xc0\rA\t\xbdB\xa0\xfb\x8a\xffpW\xaf\xd6\xd5\xf1\xedQuJ\xf9\xb6\xb97\xc0\x00\x99Ķ\xbf\xa9\xe2\"\xbe:\xa5h\xcc/\x9eo\x00\v5)|lQ\xca/c<\xd3s\xf33\xadL~>x\xcb \x01\x13\xa87\xb0\x86\xa3\x1f{j\x8acxM\n\\S?\x1e\xed)\x9f\xf5D\x96\x9fo\xce\xcd\xe3\xdaa\xe1r\xf50\xc9`\xf5(5\xf7\f\x82\xd4\xe0K}(\x9b\"\x1bF\x8eR\x0f\x97\xb10\xac\xfdm\x01oj\x193\xda\x13\xae\xa9\xa5\xff\xa3\x8e_\x1e\x13\x1e\xe0\xaf\x06*\xa6k\xb7\xfb'2\xe1\xa5-\x80\x82n\xf83\x01 \x01W\xf8\x19\x0fC\x00!\xbcpﲅ\x85\xffR\xd9OP\xe1\xe0\x89\xdfoE\x0eπt\x0e\x115cR\xf2\x04f¤\x92\xba\x19\x1cHo\"\ag\xf0\xfb\xc3\xe1\xe8I\x8c\x00\x85/\x8c\x86i&\xded\xa2\xff\x1e\vi\x10\xbd\x18\xbeEF\xf3\x14\xfa\x82\xea?0\xf6\xb4r\x15\xf6\x84\xc3\xe8\r\x87\xc1{\x15\xf4\x84\xb1kA5\xe8)\xf6U\"]\xb4\b\xbf\x854\x8c\xfe\xfbg\xc2\xf9\x8e\xa6q\x9a/\x8e\x1e\x81\n֣\x9a\xffw]\x01V\xc1\b8\ue705/\xfc\xbf\x1cr{\xa3\xe0\v\xd1\xcb\xd4\xe8+t.S\v\x9d\xe8\xbbL\r\x1e2y\x9a\xcbX\x99x\v^P\x82\\\xd2\xd6\xd8\xd8\xc6\x01%\x16\"E\x065\xee8\xc0\xaa\xdcS\xc4\xf6ej\xf9\x17.\xff\xa7ڣ\x19ce\x82B\xf8\x9d\xfa\x12߅\xef\x1ce\x8er\xec\x81\xdf\xcdcS\x84\xc2\x02\xf2@\xf3U\xf8\xc7\xc4\xc2?\xd9\xcd\xf3\x02i\x9dPH\x8a\x9c\xfb<\x19\xfa\x92\xf2\xb3\x96b\xb5p\xc4\xddY\x85$\xa8qG\x1b+($\xf2\xc8\x0f\xbaj\xf6\xa8\xd6\xc9\xc0\xea*(\xa0\xf3*X\xafB\xa7\"\xd2\xe6\xabr?K\x89S$\xc9)\xa9ə\x1cf;\x1c\x84h\x85\x0f\xd4B'ɓc\xd4\xd0i\xb3Rhu\xf8\x82Ub\xb3\xa2/\x85\xd96\aQ\x92\x99bMf\xc4)\x0e\xabd\xa4\x9a'\xc5N\xf8@\xfd\xba]%\xa9^\xae\xc6v\x98\xa6\x86\x17\xa4\xcd\a\xa2\xff;\xd5\xf5*$]\xdcT\x92\xbb\xf6\x1b\xd4\xdaӓ\xf8\xb5kŁ\xe2\x00OL\x81\x14a\x800\xf0\xf4)\xfe\x87\xb5\xa7\x95kOM\xe2\xd7\xfa\x89\x03ā\x9e8\x14\x86\xa2\x0f\xfa`\x04F\x84\xe1#\x98\x88&%\xbe\xc6\xd7\x04·\xf9$\xbc\x86\xd7`\u0089\xf0h\x1fD@\x84\x12|\xc0ǩ!\f\x14\x06\x9c:\xcd\xdf\xf3;\xad\xf4\xa8V\x11\xb5\xbf\xb9\xbez\xa6\"v?t\xb39l\a(p\x1f\xfa\x18߁\x0e5\xbc\xf3\xfb/\xe0\xc6\n\xc6>\xe0>\xec1\xf6p\xb2z<s\xb2`7Σ0\x1d\xfbb@\r\x12\x96\x89\xe6\xb0\xe0L\xdf\xd8\xd2ز\x98\xaaL\xb3\xc1l0\xc7W\x86\xff?\x8c\xfd\aX\x14W\xf78\x8e\x0f\xc2\xccN&J\x94\xd9!\xb2\xb3;\x83%\xb1&\x96\x90\xd8#\x1a{/\x1b\x83\x15\x1b\xa2\x14Ezg\xe9l\xa1,,\xbdW\x05\xa4\xa8ذ`7\x065\x89\xa2\xc6$\xb6\x14S\x8c\xe5ML\xce\xec\x9e\xc5\xf7\xff욼\x9f\xcf\xfb\xfd\xfe\xbf\xcf\xf3{`\xe7\x9e{\xee\xb9\xe7\x9c{\xcf\xed3\xf7^\xd3:\x1d:\xa6\xa3w\x18&B\u007f\xda\xfc\xbe\f\x9c\x9b\xf6\x83\xa3`\xf9\x8d\xfa\xb3}\x03\xca\xc4\xde12\x98\x90\xc35\x1f\xdf~jն\x944\xdb\x1fm^ {\xfcѝ\xf1\x82\x12ߤ\xc6\u007f4m\x94ػ@\x96\x92\x93\x9c\x93\x92\xb3\xaa}\xbbW\xf3\xda\xee\xa9\xe0<\x12\x96L\xc7ս\xef\x99'\xbbk\xe07:\xd5\x00\xeba\x88\xa2<\xbd$\xbd$\x83\xc6\xc1\x06\\iTh\xf07\xf3\xe4\xbfp\xb5\xf9=Xr\x1b\x9c\u007f\xeb\xfe\x9av\xae\xfa\x01\x8c\x96\x16x\xcb\xe1\x19\x18\xe1-\x98\u007f\x03\u07b2\x03Fx\xcb\xd1\"\xb7|\xc7\xe1[\xd69\xf0\x16%\r\x96\xe6\xfc\x03\xe3\xd7N\xd6\xc1\x14\x06b \t\x81\xf8\xb5\xa5E\xe9\xd4\xd4\xdb&\x83\xb7,\x9e\x9c\xd2\xf2>\x85\x0ex\x88Tb\xb44\x18\x03!P\x06\x81\x10H\xc2ה4G\x9aC:\xff\xa2z\xa0$W9\x9a\x8b!\x9034F\xed\xf1+\xf2+\xf4M\x8e\b\xa6\x97\xcd\xd6\xe9\xf5\n\\\x8fII\x9a\xb4\xb8\x8cdm\x92!)3!'>_ӹ\x12\xc2&\xde(\xac\xcb)/Ϋ\xca)\xd2\xe7\x1b\xa0\x95\x86\x01\xfaL\xbd\x80# \x9b\xcbl\x8bl\n,\xa4?\xd8g0\xe8\x15\xc0\xe0\xe1\x04c,\x1ff\x88\xd0ą\xed\\\x1f\x8f\xc3\nFd\xf9\x1b\x83\xf2b\n\xc3*\xe3\xabut\xb1)\xf3!T\x8b\xd0\xf7\x80^\xafW4\x9a*\xeb3[i\xf35<\xc9e\xd7'WE\x15kLIYX\xfd=\x8d\xcc\x01\x9dA\xafؙ\xef\x9f\x14\x16\x14\xba+÷|W\xe7\x87\x03\xc1\x03\x8b\n\xa3\n\x93\xca\xe2j\xb45\xa6\xcf\x1b`\x04,ϿA\xf7z\x98\xfbr\xa9\xd0\xcfWjK\xafK\xad\x89*\r6N\xabĮ\t\xea\x15QQ\xa9\xa19\xe1\xb4>?Zr\xb2\xde\x11νw\xa4\xa88\xbb$\xe7HQ\xda\x1f\xb4y$\x12\x9c\xbd\xe9I\xbe\x85o\xc2'\v\x9aB\vB\xf5\x91\xf1\t\xc9\xc9\x1a\x9c\x88\xa1\xf4\x8c\xb7u:\xbd\"*D\xe7W\x11X\x11\xd8\x12טG\xeb\xb46\xb5Kr\x1arO\x14\xc0\x85\x9b\x85مy\x15E\xa6*m\x1dm\xff\xc8B\x0er\bW\xe9\xa1\xd16\x98\x93\xc3f\x86\xc5\xe7\xf6\xfb\n\xacr\xab\x1c\xf612\x90\xf7ʹ^9\xca\xcdr\xca\"w\xd2\xf7\xcam}\xb9\xcc~\u007f\xacՄr\xc9DIr\xa7\xff\x17D\xb1\xa4F2QJ\v\xd1\xdb\xc3\xc1\"Ƣ\x06\xb9\x8c\xc5\xe7/ղ:\xc6u\x1c#\xd8\n@nevu\xb5\xc2ҳ\x88y\xd9#\x03\xb9\xad\x9dP\x9a\t\x8aM\xcdA9\x9adl\xe4Ɨ=P\xca\b\x90\xa6\x82\xfb\x8c34J\xffy\r\v&\x87CJ\xd9>\xf9\x03\x8b\xa9\x88\xab\awpo8\x81\xf2\x06\x1f\x1f\x94\a\xad\t\x02wt\xaf\x17\xa1\x06j\xb9Zx\v\x86֚\xe0\x01\xf3\xa7;μ\x88\xc3y\x1c\x8e3\x97\rr\x87\xf3\xaaZ\xa1\xe4\xafg0\x1c\x86\x81ðb\x1eꡎ\xab\x81\xa10\xb46\x0f\x1e00\x17\xe7b\xcdS\xcc\xe61\x1bk\x86\xe3\\\x9c+\xa6\xa6\xa6''+B`(\x0e\xad\x11\n \x1a\xb6\xc3Y\x1e\x02@\x9c\x8er\x98$\xa6\x1bM\xe9y<\xd4B-W\xf3\x1f\xb1\x90\x8dF\xab\x03\x88\xf8\x17\x8f\u007fY\x1dP\xc4l4\xc2yUM^^v\x8eQ\x00o\x88\x81L \xe1\n\x0fW \x13I\xf4\x86\x181͘\x97\x9e\xc7;W\x81\x8b\xcaf\x1e[\x87v\xd66^\x96䮠f\x86\xaa\xe0;\xe6#\x95\xd9d\x95\u007f\xf4\n\xfc?\xfdfS\xaf\x89\x9b\xbd|\xf9lA/\x9b\xbd\xfc\u0097\xa2$\x97\xc1w*\xbd\xec\xcb\v\xcbg\x8bV\xb9\xcc*7\x9b>\xb2\xf1\xee\xe1l\xc6@\xe2\x16\x03&(b\x12\x19-\x83&\x19\xf68\rU\x81\xdc,\xbf\xa5RZ4\xf7TJ\v\xf1\xea\x05\x8c/\xf3\x99*_\xa5\xb4\xfc\x0e\xc5.&%\xf9\x14\x19`\xd8*%\xf9\xcc\xe2\xcc%\\\r8\xab\xeeH\xc9\xc9\xc8\xd4&$oH\xf0\x8e\xf5\xf6{g̈́\x99s'O\x1f\xe1\x8dD\xc8䨉\xe9\x1f\x19h\x83\xd7\x16\xdd\x16>$&>$C\x8cB\xa7(t\xb4?\x9d\xa2\xedOzWzy\xe8\x1e\x1e\xb2\xa9\x96=u-\xb9bf\xc9Yx\x9a]A\xb6\x18\xf7Ե\xf0G(h\x81]@\xc1\xbd+b\xf1\xad\xac/\xe2/\xd1z\x19zB+\a\xa3\xa7\x81\x13\x0e>+\xd4\xcf\x1b^\xb1\x91\xb7\xfeJmݱ\xd3'U\xc4X\xa5e\x06\xb93\xbdzw#\x0fǩ\xbc\xac\xac\\\x83X\xfa\xec,\xfcU|\x9b\xee.\xech\xbe\xc2_\xba\xa43\\\x12\x1f\x1a\x1e\x14~\xbb\x17F\x9f{\x02D\x17\x8c\xa2\xd9e\r\xb7+\xee\xdcU\xd8f\xe5m\xb6Y\xb9\xb4\xd8\xfc\x82\xb3\xbeF\xed\x8e\t\f/\x15a\x1a\x06\x02\x83\xb9\xdf`\x13-\xad\xa0\x16@3:\x83\t?\x82d\xba4!\"?\x82\x97Ρ\x99\x9b\x00[\xd0\t\x02p\x10Dd\xe0\x98X\x1c\xb1\x1b\xdf\x02\x06\x97?@\xaf\xd3\xe8s\x12\xd8C\xf0\xe6>\xe0\xe9\xa7\xe6՜u.\x05\r\xd6Y\xa0\xb3f\xed\xb36\xd1\xd2'\xd4Z\xe9\x04\x06K9\xd8%\x8d\xa5\xcd\xf3\xad=\x1ctn\x85\x03ӡFhH\xd9]\xb1\x8bG\xa4\xc2c#\xc2s\xc4Ӹ\xa4\r\xbd\xcap\x03|\x8cO\xc0\xd9\xeat\xc4JџA\xda~8\nM\n\xfb\xaeX\xe0\x94\xb2\u007f\x83\xdcQ);\"\x1d\xe6\xf4)\xcd\x01\xf0\x8e\x90p69$\xd6\xc7`p\xfb\x04\a\xc7m\xd3+\xf6}\xad\xaf畖uW\xb8\xb9\xb0\x1cG=\xc3E\x9d\x99E\x03\xa1\x8ajk\x8aߜ'^\xc0\xe3$̅\u007fq0\x8b\xc2\xe2^\x8eď(\xe4\xa5\xdc\xc7[\x1f\xbc\xdf\xfa\xae[\xd9x\xf8@Z@\x82\xd8\xeb\xca\xfdav%a25\xa4ו\xc4ɔ\xd9d\xa6\xb8P\x14c\xf3\x02\x8b\xb7\x14|\xacs[\x10\xb8+(\xb05\xfc\xc2N\xf1\xd7\xf5G\xd53\x15ZtC\xd2\x03g\x1ah\xcfY\xa5\xe5[\xc5\x1a\x18Z\xad!\x83O\xb7%_\xe3;\xbb*\x0e\xef\t\xafZ\xd0$.j\x9ax\xca\xf0\x13\r\x03\f \xfb\x0e&\xf2\xdd]q\xf1\xfbDiZ)\a\n\x9b\xa0w\xa9؛i\xd5zE\bN\xd3G\xf2\xfa\x94\xe8]S\x05\x9cI\x81Nz@\x1e*k,<ȃ\x9c\x82\x12\xe8Y\xad\x92\x1ams\ri\xf4\xf1\xb5gVwl>\xb1\x06v\xe0\x80þ\x1du]^\xcf\xde9\x11\xf3\xe9\xeas\xd2h\bu\xd1C:\fm\x87\x81g\xcf\x1c\v8]}\xa1\xe0l\xe9\x853gả\xae\v@\x9f9{4\xe0\U00101b96\x8b\xe0\x03\xe9\xec\xd9a\xddO\xcd\xefI\xcf8\u007f]LX(\x1f\x9fb*\xaa*-h\x12s2\xf5F^\x12۸ڜ\xa2\xe2r\xbe9uG\xba(\x05\xfb\xfeM\x96\x90l'k\x16\xd9\x01\xeeUD\xb6\x8d\x16n\xd4s\x1b#w\xee\x8eL*\xdes\xa8|o]EA\\\x90\xa0\xb4\xac\x85A\x9c\x1a'ha\x029\x99\xf2\xc6\x12\x92\xedOx\x12\xa7\xa9\x16X\x97\x8d\xeb\xc8\x03\xd4uX\xef\x8f\xeb\xc9s\xd4\x11(!a\xb2;\xd7\x11\\\xb5&\"2#y\x97\x90\xe2\xadO\xd7+\xb2Lչ{x\xbb\xa0\xfa\xfc\xb4X\x9bHbY\x86.C\x97\"\x18\x92\x92\xf5\xa9\xfc\xe2m\x81\x9f\x88l\u007f\x82p\xdfQ\x15\xd2TW\x91W^,\xe8\xc2\xc8\x03yz\xbdh(-\xb8\x9a\xd3Dg\x96g\x16\x15(`\xec\x05\xce\x1e;+6\xcc\x10\xcf/\n\xf7\xdf\x12T\x14\xd5\x18'Vk\xb23\x12\x15,\xad!\x9c\xe2\x13Sâc\xb2r\xa2\x84\x94uz\xad^\x91QX\x98\xd1\xc2_\xd4\xed\xa99\xa1؛P\xbe\xabH\b\xc9\xd7f\xe5+\nL\xc6\xf2\x82\x12\x9d\xb6D\xc8;A~\xefjl&\xd5\x14Kx\x12Na\xe8\x92\x11\xa1W\xa4\x87\xa5\a')\x02\f%1{\xf9\xa2\xc2̜\x121\xaf\x93ԥ\xa7\xe9R\xf8\xf8Ԝ\x02c\xfe)\xe3Aq/\x95\xdbE\xc2G3\xb9\xce\xc0\xc6\xf5+7\xaf\x9a\xb9\xb8\xd9ﳊ\x8a\xac\x9c\n\xc1t\x86L\x8c\x88J\x8f\xe0\xfd#\x8a\x9a\xb2Ep\xa4r\xaa2KK\x14 \xde\xe4Br\xe2\xe3\xa3w\x1b\n\xb2\nt\x05\xb4\x17\x952_3O3?#n\xe09\xaa\x12\\s\xea\x9a2\x8c\r\xa6\x9a\xdcB\x1a\x96vp\xf6De%\xef\xcc\x0f\xe1=?Y\xf0\xce\xec\xfd\x1b/\x95\x16e\x1a\xab\xc4\xfc\x8bdRdlz\x1c\xef\x17Y{Pd5s\x88>\xe7\xf7v\x1c\x12Xb\x00A\x94\x16\xa7'V\x8a\xa6\xf3$|0\xccf\x90\xb5\xe1Q6\x83\xb0\x9e\xee\xfd\x89d\xbbUXOw\x9a\xc82\xd5\xe46\xf06\xabd\xe83\xb4)\xa2!9Y\x9f³\x84{\u007fb\xb1o\xe0';*C\x9a\xeam\xe6\x10m\xe6\xc8\xd5\xeb\xc5\xcc\xd2|\x9b9\xb2\xca\r6s\f\xbaȅ\xe6\xc4\xc7G\xed\xa63\v2\v\n\x14\x9fP)\xf3\x13\xe7%\xbcJJ\x15\xb8f\xd75g\xd0v\x999\xaf\x12ĺk\x9c\b\x18t\x9cK\x98NV\xc6F\x9b\xc2\xf9՛\x96\xce\x12Yw\x9aЬj\xd8\xdeQU\x92[Z*dD\x92\xa7\xa9\xc3PB\x9eEW\x0eO\xdbf\x8cx\x06\xdf@\xb2\xe3\x8b1\x82^\xf6\xd5\xf5\v\x8f\x1a\xffE\xaf\xa6\x02ǭ\x9a<g\xf1\x9c˫\xbe\r\xfc\x91^M5\xbeua\xecW\xb3h։ h\xbdl\xce\x18$7\xe3\x1b<\x9e\xc1t\x18\xaf\x82tȀ3\xf0\x06\x90\x9b\xe7\xfc$\xeae\x93<\x97\x8e؉\x8e\xf4Ij\xefӳ\xdfݼvs\xdeY\x8f\xbd\xc3\xe9\x93\xd4Np\\\xfad\xd2-Z/\xfb\xe2' ;\xe0\r\x1e\u0380M\x83\xd3\"\xa4\x9b\xe5\xaf*p\xb7\xf4\xfd\xd5S\x10\x8da?\x8e\x83з\u007f\x9bq\xd3E\xff\xe7\x15\x18|\xad\xf1\xca!\xd8Q\x05\xf9\x15\xe6\xb7\xf7¢\xc3WLI\xf7`T7{\xf3ߞpլ\xe7X\xfa4\xd1\x1c\xdf\x14Z)T\x86\xef\xce\xdd\xc4GcP\x19u\xa8!\xaf\xbcR\xb4\xd1\xd4T\x1555+\x94\xd4\xf3+\x1cK;\x12\xcdae\x9b\x85\x88\xb7+\xa8C\xd5\xe5-\"\xeb4\xd1Si^\xb9\x80[\x13\xb6\xce\xc7_`\xdd\a\x12A\xfe\xdb4\xaby[\xc0hx\xf8+\xf5gϜ\x11b#N\xe6\xe0\xe1\x15|(\x83\xbc\xe7܂u\x1b\xe6\xae\xf1\xad<\x12(\x1c:H\xb2.\x14\xb1\xb3\xa4.\xb6\x89g\x9d\b\xcdުʆ\xe2\xd4\xc2\xf8<1%'>'\xd8D/\xa9\x0e\xaf=\xa68s\xb4\xe3b[KB\xf0\x1ea\x877\xc9҄\xa6\":&/\x82\xdf\x15\x11\x13\x1eU\x90x N\xbc\x19T\x1d\xb2J\x01M㸸\x9d!i\xbb\xf8\xe1\x1f\xde~\xf2\xdb\xd7_=\xd9S\x9b\x1a\xb7G\xfcP\xd6\x10\x14Z\x10\xc0/Y\xeb\xb5pޱ\xb5W\xf7\xd6\x14\xd4\u05cb\x1fʔN_X\x9f\xdb\xfb{\xf3}\xca2\x18\xa6K\x97\xa0\a<\x9e\xd1\xd6ZY\xefOf-7l\xf2\xe4a\x82M\xafa\x93\xbf}\xf6\xec[\xdbo\xf20\x11F\xe3\fn;\x85\x83\xe1W\x92u\xa2\x89\xeb\xd4\x0f\x18\xfa#\x84\x92ש\xbd\xf8\x90\x83\x87\xb0h\x05\xbe>\x03d+Q6\x0e^_)\x83-\xae\xe3\xc0\x8dLB\xb7\x05\xf0\x90\xb2\xf1\xfb\x8d\xbau\xd8w\x91W\x90ߚD\x91\xf5t\"^\xa0;\t\xaf\x9b\xa7q7\x97]\x9a)(%\x9a\x9a\xb9l\xe9\fQ\x89}e3..\xed\x11\x942\x89\xea\xb9x\xe9\xa6\xe8,5Bm\x9b\xef\x85}gם]\u007f\xf6\x93\xb3\xc7a-\xae9w\xe6\xfb\xf7`Dʹ\x95@#\r5\xb8\r\xb6\xa1\xe79\xef\xb3\xfb?_q\xdeE\u007f}\xd6\x1d\x98V}\xe6ʱ\x8a\xee\xee\xd8\xcaΫǯ\xc0\xe2+\xab\xba\x8f\x81\xe3\xd0\xca\x03_,\xbf1\xf7J\xe5\x95\rW\x9b+#\xaez\xc3L\x1cp}\xee\x957\x95ԻW@USsm)l\xc4\xf9W\xae\xd5E|\x9ewu\xc5\xe7\x9d\u007f\xa9ϲ\xb3g\x89~^\x8f\xaa\xe0u\xf3\xaf\xdc\x11 \x8e\"AJ{\xad5\x1c\xbew\x06\x06\x9f\xa5X\x8d\xa6\x8f\x13M\xf7\xc7\xc1'`\x02\xf5\xe5T\x92%R\x06\x12}\x06W\xdd\xd7qO\xff\"1P\x06\xcba\x1cgk\xd4\b\u0093\b\x8e҄\n,\x91\xd1'\x89 \x88\x89_ܧ\xaa˓BD8\x94\xcd\x05\x85\xa7\x85\t\x93m\xad_\u007f\x82 \x88O\xef\xca*\xabӃEl\x92\xb6q\xbb\xeda\x97\xeeR,\x91\xb3Փ \xfaۃ$\xef9\x1cK8\xd16\xf2\xa3@\x1cA\x82|\xd9\x04c8\x96\xe8?\xcb&\r\t\xeaC\\\xf4\x01,\"\aa>\xc5\x12\xeev\x1d\xb0\x0f䓣(\x96p\"\b\xc2I\x834֒_Ru\x97H\u0a7a\xa5$\f\x84\xed\xd4/\xb8\x9d<O\xfd\x02\xdbI\xdc~U&\x9d\xc6\xfd\xdc\xe7k\xab\x96\b,\xd1\xdf\xd3\x16\x0f\xb7Pv\xb0\x0fAL\x1a?\xe5\a\x12\x8b\xb0\x96\xfa'\x10LԽ\x1f\xbf\x19G\xbe\x92\xe0N\x80\xcb#\xea&\xbc\x1e\x05\x0e\"\x1e\x81_8\x1cm#\xd5\u0604\x9f\x81\xe1gq\xf8\t\x18-\xfb'\x1d\xe71\x10k!P\xa6$\xa7Z\xf5\\\xdc\xce\x1d\x19\xfe<N\xad\xa5\xa2B}Sw\xf2\xd6\xfa\xef\xa8\x02\xad!QH\x1e\x8e3\xffE%\xec\f\xd0\x05\xf2\xe1\x14\xbb\xa9\xbf\x83\x03A\x10\xe3\xd1W\xf6\xc9E\xd2\xd6\xf6\xd8\xf2P\xbd\x8cd\xdd_\x81\xf8Xr\x86\u007fQ'\x97\x9f\xb9@B\xfc\u007f\"4\x1c\xd6FT\x89\xd5\xe0#\xdbӚ\x16]+V\xc3\x172\xd6H\x10\xc4 \x82\b\x93\xb1\xcbl\x1ai\x88\xf2\x8a\x96\xec:\xfe\x1f<\xcc\x0e\xa3\xcaj\x1asl\x9d\xe9+\xe6\xd0\xd7<\x99\xeaY\xf6\xe9?\x05y\xc9L\xd1\xca\xcb\xee\xc0\\\x12\xa6Q\xd5\xde$;h\x90\xe78\x82 \x8e\xc8X\xa2\x8f'\xad!\bXC\x1d\x1ey\xf87\xd2LS\xf5\r\xe9Q\x95b\xb5\x8c%h\xc2\x1e\xe4\x19F\xb1D\x1f\x82\xf6$\x88\xf2}\a\xb2\xdbyȤj\xbcI[\xb8\xcd~G`\xf1\u007fh\xeb\xbc\xc9#0\xcf\xea,cg\xdb4]N\xe0\x02j\xc7\x05r9FȂ?%WR\xc1Kȹ\xe35-\vD\xb6\x8f-|\x00\x11\x8a\xcd2v\x86\xc6\x0e?\x94\xe5\xear\x93\x84D\x1d9ܺ\xbfS\xc6\xda\xca\x06A\x13\x11\xebH$\xa9\u007f\x18F\x1e#\xd7\xe3N\x1b7\xb5\x8d\x1b:\xf6N\xa6f^\\\xf2O\x05\xfd\xb4G\xec\xd5I[\xb8n\xb5\xc9K\xc0w\xb1\xf6\x8a-\xa6-g\x89\xa7_\xc8\xd8\x01\xfd\xed9v\xb8$y\xbd\xb8\xe1\xca\x19YkJ\xado\x91\xd0XX\xdaب\xc0Fɗ\xebV\xe7}\"\xe0;\x06\x12k\xbb\x9f|)\xbb\x11\xbbe\xb9A\xbcB\xfdSrN\xcb\xdaRk\xb6\x15\t,c\xaf\x12\xcb\xf6\x16\x9656)\xa4z\xeb\x9f\x1c\x8e2\x90\xff\x94\x9eQ\xe70\x00k!@f\xfd\xd0\x1c\xce\xcd\xc7ګ\x94y\xbeu\x00\a\xae8\xf5\xfd\x8b\x8aꊬ2\x01^?\x8f\xae\xd4\xeaM\xbb\xd5\"r0\xe5\x9b\xe5\x8a\xf0\xa8\xf4\x18\x01]\x96\x83\vu\xe1Hu\xb7\b\xc9'\xb9W\xf9Dl\xdc|\x06\xc95\xc7\x15\xd5\xe5Y\xe5\x020瑣Vo\xfc;\xe6\xd7+\x14\x11\xd1i1\x02\x0eX\x0e\x03\xa8\xe6\xf6\xdc=\x15\"N2\xaf\xe0\xe6\xe32\n)XF\x0eG\x13\x85\x0e`\"GRcp\a\xb9\x93\xfaD\xd3|\xec\xe7˱\x9f\xebċ\x8bdc6\xaf\x1b\xebqp\xfd\x0f\xe2-{\x9dSP\xf5\xf6:\xe7M\x813z\x93\xac\xa7\xc6V\xa6\x96\xfdD9K\x8dR\a\f/\x81ך\x19\xc9\aF[\x02`4\x1c\a9\x1bk\x8e33\xf0\x8b\xaa!\xbc\xfdā\xf6\x13\x82\xb9\x1eFpl\xcea\x9f\xcc\x1dB\x14\x95\x92\x96\x9e\xa2\x13\xa3ݛ\rdW[\xe1%\x11\xfe\xc0\x11\xf0\x8bjOx\xfbq\x1b\xb1Ҳ\x17\xa6C\r\x83}H\x8d.1Y\xc3O\xa7\xa0\x96\xc9\xe7\xc1\x03B\x989TRz\xaeɘi\xca4\x8a\xbb\x827\xc4\xf8\xf2\xd3֜\xba\xf3뭇O\xf6\xb7\xa4\xee\xa8\x11\xa1\x81\x91Ffr\x19z\xadV+`\x9eՉLMOIO\xe1?\xa4RR\x8d\xb9\x85\xd7\u007f.(\x11S\xaa\xa6Ax\x8e\"ט\x9b+ܢ\xf2M)IY\xe2\x11\x1c\xf9'\x8c%\x8dٹٹ\xfcWT\xae1%E\x94\xfa\x9f\xb0\xa9A\x93)\xda\xd4\xd4d~\x12\xc5\u0383Z\xa6\x80\x87\xf7 \x84a3\xfeV%\xcbd0\x8a\xbbB6\xc4l\xb3\xab\"\xb2\xcbl\xda\x1chI\xddQ-@\x03\x03\xb3a8\xb7\xc0\xfa\x8e=-\x89\xfc|*957W4d^\x91<H\xa3\xc1\x98c\xe4oR\xb9\xc6\xd4dQi\xa6\x91\xe7Θv\x187\xf0\x91Tj\xea\xab<\xdag \x8f\xb4\x18O\xe5\x89JY\xa4Td\x1bSH\t\x0f?\x80\x8a\xf1O~>\x06\xef\xc1c\x17=(\xe0\xd7/!\xfe\x04\x14\xb4\xc3ȋ0\x12(\x18\xd1\x04á\x0f\f\x029\xdb\xf5BIM3\xf7㾉<\xe4\x93\x1c\x9a\x11\xb4[`W\x10\x18*K-,\xce(\xe1\xab*\x8a\xaa\v\xc5\xe2\xba\xdc\xd6c\niA\x1bwU\x1bռ\x83\xdf\x11\x150/]\x84>\x94\xd2\xfc\v\xf2\x9cW\xf4\xd6\x1dABH\x80w\xfc\xc7\xfc\xdc\x05U\x95\xdb\xc5r ɐ\x8e\xcf\"\xf7\xf3Me\x05\x95UQE\x9fT\x88\x93\xf6\xaen\xbb\xab8Z\xba\xbf\xb9^\xa8k<Tt\x8a\xbf\xd1\x15\x91Pg\x9b>\x93\xa5\xfe\xeb\xcb\xd6\xf3!qI\xd1\x11\xb5\xf1GbD\xbd\xec^\xe0\xa9\xed\x13\x14p\x1c\xfc\xb9\x0fq5\xb5\xa3(foqUN\xa6I@F\x96\x1e\x1d\x9a\xb2\x95_\xed\x9b_\x19,\ueb0d=p^\x01\xbb\x16\xcb\xca~?\x96W\x0e\x14pU$\xf2\x8fep\x12\x95\\fb\xb2>\x99\xf7\rJ\x0e\x8d\x14\xf1\"\x8e\x94\xfc\xe1MY\xfeJ\xf2`QK\xf5!\xded\xd2i\xf3E\x14e\xed\xf8='\xf9\xc3H\xab\xbfL\n\x80Ln\\\xc4\xdc\xcd\xde\xc2\xfaM\xea\xc4m|xHvn\xa8\x88\xa3d82\x984\xbb\xc9خ\x170\x90\xca[G\xeeo\xdb_~\x98\xefl\x8f\xde\xde 6\xfa\x94,\x99\xa2\xb0n\x87\xe7\x9c\xf7v_o\x81\xf5\x94{oo;r\xa4\xb5\xf5ȑ\xd6\xedޢ\xc5\x15͜F\xb7C\x97\"\xa0\x81\xca\n0\x85\x95\xc6\xd3l\u05cb\xf8\xe2\xcaԽ\xfc\xf1\xc3\x05\x95\xedb\xe9P2x۲\u0605\xfc\xec\x8f\xcb۷\x89l\x15\xbd\xe9`x\xf7}\x05\x8c\xfe\xfa\x17\x90_>\x11\xb6i\x9fм\xa9t\x99\xa7\x02\xe4fq\xb5\n\x1a\xd1\xf4\rx\x85W\x87\xbb\x98M\xdf|\x03j\xb6]I\xb9\x9a=9\xf6\x8bަ\xde\x19$\x0e2\x80\xad.\x0f\xe2\xd9\xf6\x05*QR\a\xa8\xe09C[\x9bz\x9fsߨ\xa0Ke\x89WR\x9e܄ǚ\xe2pC\xbc\xc6\xcd\xfa\x01\xfe\x9bd\x8fj\x18\x92m/*4Tj\x8aigh\x840\x06b\x19\x90\xdb\x05ܳԡ\a\a\x9f3p\x84\x11\xd8S\xa6ܔ\xa4\xa9W\x16|msE\xf6\x9e\xb5E\xda\xc5\xfd\x04\x1f\x1d\x05?0)\x80\x03gX\t%\xb0\x02\xe8a\x83O\nR\xd0˹\x1c<g\xd0q\xe4\xc0\x0e\\p\vG\x83#\x0e\xa0{\xb5\x14\x8e@\xee\u007fi\xfb\xb7\xae\x0f\x95d\x10\xf7J5\x9bf\x035\xcc+\xa5ܜ\xf1\xdfU\x8c\xf9\xd5.m\x17\xa8f\xc0\xc4v\xb1\xf7%\x13xp\x13\x18\xb3z\x93\x8a\xed\xea^\xcf\xf4\xaae\xec}0\xb9N`\xd8.\xb3\xfaCU\xafZf\xf7\xfd\r[;,\xa1\xd7U\xec\xfd\x13\x11K\x0f\xaeⱇZ\xb5t˚\bq6N!\xf1\xf2H\x86\xedZ(c\xef\xbf\xc5<~\xb9\x88\xc2>7\xc6}/\xb0]\xe0\xa5\xdaĀ\a\f6O\x82A\xe0\x01\xee\f̂\tR\x19\x8cD\x15\x8c\x84\x02\x90\xb3/5J\xa7xi3gJ\xceM\x16\xf0u*9%%I\x9c-[\x84ʠ\x8fp9*a\x18\xac\x87U\xe0\n<,\xbd\v\xfdv.\x12f˒rSL\x02۫\x01\xe6&\x03\x0e2x\x0f\v[\x87c-nčX\x8b{a3\xfa@\x03\f\x82\xb0.\x18$X],\xefq\xf0!#\xb2/5\xabT\xc2\xff\xbcm\x16\xd9^\xcd3f\x13\x03KA\xc0\xbe\xc0\x99\xdf\x02\x11F\xdat|\x1bޑ\xe2\xe1mx\x02r\xf6\x9e\x86\xed\xd2X6\x9a39܌\xee\x18\x8d\xeb\xd1u\xc6\x03\x18!\xb0\xf75P\ts`\x12L\x06\x97[\xef\xe3P\x81\xed\xd2H\x9fb?N\xf2\xc6\xcbp\xd9\xea\r\x89*\x98\r3.\x83#(\x85\\cv\x9e!\xcf`\xca\xc8K\xcb\xdd|b\xa0\xa68\"3.\x81~\x17)\x1c\xea\x85\xc3\xfes\x1c\xf3\x9f`sD\xf6\x9eFr\x83l̶\xba\xe1^\f\xda5W+n\x8e)8Sl(ɮ\x10\xa1\xafd1\xe4\xe8\xf22\xb2ܲ3\xd2r\xd3xd\xa8\x94\x94\xc4d\x9d\xa8Y\xa9\x8d$\x1f\xac~\xe7$\xbe\xc6c\r\xee\xc6B\xbc\x81\xb1\xf0\x06\xba\x81?\xf0@ܾsY\xcc\xf2+\xd8U\x11Foo:\x11u\xb98\xc7P\xc4\xc3\n#\x04\xc3k\xd0m\xbf|\f\xd4\x0e\xb0\x89qTR5J\xb2\x86\xc3\x010\x00\xbd\r\xd8\x1f]0\x04\xc3\x06\x0e\xf9\x03\xc61n\xb8\r\xb7A_\xc6\xed\xc5`\xeb3\xeb32$$\xde\u007f\x87\x02\xbde(GS\xf7B\xfby\x9b\xe0M\xb54\x17\xd5֊\xd23\xe9ً\xc1 \xa8`\x1bl\x03\x91q\x1b\xf2\a\x84A\b\xb8@\u007f\x1dx\xdb\xf8\xbb)q\x83\xd2R\xc1\xa1\x1aԔ\xd4#\xf5\x80\x1a\xd5\xe4\xab/\x1c^:*\xa9\xa5P\xa9Z\xb3>\xcc\u007f\xbb\xd8\xebb\xbdM.\xfb\xd8o\xda\x14\x05N\x05\x01\x87´\a7Z\xce\u007f*\xc0\x00\x90A\x89\x14w\a\x86ӵ\x9dU\xa7N+~\x9d\xf0\xf9X\xe1堗\x15\x1c\x86%\xa2ۖ\x0f0\x98\x0eM\n\x8f\v\xe67!\xf7{\xdd6\x11/\xc10\xac\x81\x9f\xe0\x00\xac\x01/؟*\xe8\xaec\x9f\xbd\xa3o\u007f\xf2\xdc\u0379J\x92\x83\xc9\x01\x16\xa9\xe4A\t*\x90s\xf0D\xb5\x1aZ\x19x\xa2\xf2\xe2\xbd\xc2\"\x03w\x88\xdb7yy\x8f\xe0\x87{\x1di\xdd,6n\x0f\xab\xf0\x82V\xc6*OP\xc9\xccj\xa76FX(\xa9\xd1dU\xa3\xe9\xbf݅T\x9dJD\xb5\xecTMEc\x8b\xd8\xdaq\xea\xc8S\xfeI\x17\xecU\xb5\x88\xad\x87N\x1dy\xc2?=\x05{U\xad\xff\x84\x9c\xf2\xde\xde!\x06\xb6VG\x9e\xe2\x95HX:\xb8nF2\xb9\xfe\xfdp\x06\x19c\x95C\x8b\xca*\xc7\x1ek\x8f\xd4cU[{HkO\x1d#s\xae\x02#\xa3\xa4N;\x9aMf\x05g\u0382\x10\x15\xec\x80U\xdf\xc1 \x10ED\xa9\x9d\x93\x0eY\x0f\x936\xefw\xb0\nv(\x16\x82\xfcS\xa6\xd7d6\xc9l\\Q\xae$7\xaePY\xe7R\xbbbw\x84W\x89\xd0\x0f\xfbY\xb7Z\xb7b\x1c\xc4\xd9\xcco\xbdk\xbdk\xb3\x91\xe4!y@\x1cƁ\xa02\xf73\xf7\xb3\x91\x91U\xe1-%{\x14\xceUz\x98h\xae\x83\t\x0ez\x98h\xf9\x04&\xc1\x8f0\xc1QI\xf65\xf7p\xd7Μ\xb8\xd2x\xd8\x10V+\xe8du\x91š%\xbb\x80{:0|oDKP\x1dm\xa0f_^\x95\xf5^\x16\x8d\xc3rPD\x16\x87+4\x06M\xa6F\xb8l\xe5\xa5\xf9\xf0\x01&)\xb0ϘyC\x90\xf2\x04\xe2'o\x01\x1e\xe2\a!\xd2xk0\x8f\x1dh\xc0r\u070f\x9d\x10\x8e\x95p\x16F\x80\xd0\n\xaf=\x17u\x86\xb2\xaa\xd2ڂ:\xb7\t0:lc\xa0O\xc0v7\xbd\xccs\xe1\xf0\x88\xd1<\xfa\xe3\x18P\xc3\x16\xf8\x04f\xc2\nX\x03!\xdf\"\x8dk\xbe\x121\xe5\xe7gV\x01n\xf1\xb0\n&\x83;\xac\x05/\x1c\xff\x03N\xe9\x14=\xab\x16\x95\x05ݥ\xb5\xb9\xc9{\x8c\xf6\xfb\xd6\x06+I\xe6\x0fG%Y$Er8\x04\xfb\xbe\x8d\"\xc6~\x04\xed\xe09O\x80!+\x97\xdfC\x0f\x1e\xddQ\x8e\n\x14q\x148\xa0;\x8c\x87\xbe_\x1c\xfb\xf3\x9cx\t\x1d\x92\xa34\xd1\t1nz\xd9\a\x1f\r\vv\xe71\x1c\x87\xc1r\xf0K\x11\r2ؔ\x02K`%l\xe1\xe1\xb7r\xec\xc2d\xa0\xc5\xf9ͧ\xa7\xdd\xe5o];\xfe\x8dN̑\xe9n~а\xb4\f\x9d[\xe7\x1c\n\x03%\xfd\xf7\a\b\x0eO\x1f\xbe\xb8|$eS\xa7\xa0\x97UD\x95\x04\x97\x84\xbf;k\xa0\xa6*\xa94\xb2\x986P\xa5Q\x01\xba0~\x9d\xcf\xf6\xb5\xe99\x91\x864\xb1r\xe9\xd1\xf8\xb3\xb14\xf4\xdd\x01\xd4B\x1c\xa4\xf8\xc3\xdc\xdf]\x05\x8d\xf0\xa5\xcab\x82\xa5\x8cc$c\x96swT WA+Dc4\xb6\nf\x93\xeb\x1d\x95`u\xa5`,\xbc\xf6/X\x02>\n\xf0y\v\x96\xe0XxM\x90\\\xa9\u007f\bE\x90\xbb\x82\xadY;\x88\u007f\n\xbd&\xea\u007f>ӆ\x83x\x10\xfe\x14\xe1KF|\xf5J\xcab\x02\xb9\x83R\xa6\x01\xb9\xa3Y\xee\xf4\x0f\x81\x00_2\xff0\x10\xff\x17\x03ї\xb1\x9a\xb8\xff\xa5\xd2\x1d\x95\xe8,5\xbe\xda\x01ī\\\xf4\xf6o\x8c\x962\xecm\x8d9K\x92s\xf0\x98\xc1YP\xad\x82Y\xb0Mu\xe2\x98&␈\xcb0\x9b\xdc\xe4߸\xf44\x0f\xd7(\\b\xab\xe3\x8e0\x9cd\u007fҴo\xae\xf7*\\IC\xa7+N\xa2\xe2\x9el\xf8~\xdeէ0p\xa0\x14J\x99{\xacj\x0e\xa6\xfc\xc3L`ok\xbeo\xfb\xfa\xfc\x05\x01\x82\x19\xf6\xb1\x06\x860\"{[cK\xb5\x1e\xd7\xc3z\xd4\xcfY\x10\xb3k\xb9\x80\x95\xd2P\xb2,!\xa2 \x9cG\x13\x95\xa7\x82`\xea\x01\xcaI\xeb\\\x90[\xe5\xafN\xa3g\u007fҠ\n\x17\x92\xad\vgVN\xe3mLX\x15\x84\xc3\x12\x98\x02\x81M\"\xac\xa6*+Le\x85\"\xfc)\xed\xb82\x82\x1c;i9\xbe\x8e\x03\x15\xecO\x1a8\xa1\x02=\xac\x87\xcfU\x82\xb3\x1e\xd4\xf0\x83m\xe4\xf1\x18<\x9a\x19=\x98l\x1d\xbe\x1e\xe4\xacY\x92C\xcfG*\x8b\xc9u\xa8j\xbd\xe4\xcem\xc9\r\xf4\xdd³\u007f\xbd줶\x04\xb6\x1d\xcc\x15oHr\xb2\xd1X_\xdd\xc4[FRM\xf5a\x81F\xf1\x86UNb\xb8$qA\xe1\xe1AB\xaf\x9cbͻê\x1a\x1a\xaa\xab\xeaE\xb3\x9cj\xa8\n\x0f\x12\xa5\xa1\xbd;\xb8\xbbi\x9fF}#\f\x96\u0590\xe0\x11\x89\xfb\x1e\xe3/<\xfe\x82\xfbF\xa1G\xa48Ժ\x86\x8c\x9a\x92\xb2d\x92\xc2\xfa\f\xae12\xe8a\xcc&\x94\xdb\\\x90˜\xa1\x11\xfb\x82't\xc3\xf4\xfb\xe0y\x1f\xa6C7x:\xc0z\x98\xae$\xdd`\xba\xa3\x92<bVs\x9d>\a\xd6\n\xbd+\xa8\xb5>[\u05edۿ\xf5\xb8h^A\x1d\xdf\u007f\xa0S\x04?W\xb4\xe21<\x8a\x88\t\xb8\x19\xfa\xc3R\x94\t\x16w\xea\xf8\xfe\xfd\xc7;}\xf6\xaf\x15_\xfaQ\xb1g\x93\xcf]T\x80\x15\x8e\xc1Q\xb0B<l\xc6\xfe\xb8\x14d\xc2Kwj\xad\x8f\xcf\xdau\xfb}\x8e\x8b\x16?\xaaD\x9d\xb7r\x99B\x92A\x1ew\xdcg\xffZ\x01\x97Q\xb0\x1f]\xf1 \xac\xc1\a\xbcu\xad\x95\xb4R\xd65ˍ+\n\xd4\"\x0e\xb4E]\xb7\xd6\x16\x15\x96S\xb8\x1f\\\xe1 \xae\x81\x87\xbc\xb4F\"%JZs!\xf5\xbc\xe6\xac\bo\xdaT\xe9\x14\x9d\xad\th\x02\x1aD\xe8_\x03}%\x0f\xe8\xb7\f\x9c\xa4Z\xe8;\x19(\x97\x02\xa0ڡ_\x00\xf4\xe9\x86\xd7\x0f\x00-M\x02\x99\a\xf4e\xaf\x86Z\x16+\x9dҸ\xc3ŗ`\xc19\xbe1/ x\x1e\xf2V2p\x92\x88\xafU\x91\xb8\x18\x91kѴf\xb4\xa6\xec\x8e\f\r\r\vNlLث\xd9K\xe3Q\x8b\x9c\xab)ln躀\v|\x8a\x04\xe8\x87\u007f\x90\x99!AY!<\xebDGGi\x93\xe3D\x9f\xe7\xd8\x0f\xae\xffK1\xb5W\xcf5\x1c\xe9l\xb8\xfb+n\x99W/\x98\xc2jV\x84*\xa2\xa2\xc3\"\x04\xd6\xc5s&zAu\x8f\xc8\xf6\xd7\xe0x\xfc\x9e\xebj\xb9\xf7\xd9A\x98\x88\xbe\x1b\xa2\x83\x12\xe2>^}\xe5\xc0N!\xb2>\xbc.\xa2\x8e\x8e\x88\x88\x8f\x0eT`\x1f\x18\x82,\x10_^9ݓ\xab\xcf\xd5\xe6\nE\xa5\xba\xacB1{\xf4\x1e$\x81J\xa6\xb3r\xab\r\x9fn;}Y\xf1\xf4\xf6\xe3\xaf\xce\t{\xaa\x9a\x8b\xf6\x15\xb6\x14\xb5\x95\xd4\xd7k+\xd3+\xe2Ki\xf6jhqBRN$?\x12o\xc7\xc1;\x05\xfa\x8a\xc2\x1c1\xbf\xa0\xd6P\xcc\x03\t\xfbw\u0378/\x1e٢\x1b\xbfR\x81\xefy\xe3p$\xb0\xaf\x80No\xa1|9\x8e\xe6q+\xce\x01\n>\x99*\xc6\xefKhmS\xb0\x8d\x1eH%q\xe8\v\xb3\xf1\x13X\xf1\x91\xc0^\r\r\xbaq+\xf66/\x8d\x83<\xf8L\x1a\n\xa3B@\x89S;\xc4\x0e\xa0\x80\x81{\u007f*X\xc6\xf3g\xf5\x9d\x118\x16\x05\xeb&\xec\xe3-<ہ\xefI\x06t\xe7Y\x8f\ft\xf0\x9a\xf26\x92\xd0\x0f\xbf?\x84\xaf\x8bya!\xb9!\xfc\xa4\xb7ߚ\x14P\xe6]\xb0SDn\xfb\xea\xf7\xd1Ua+\xd2rX\x0f4\xac\x936\xc1:\x98\n+\x1c\xf4\xb0\n\x04\xf0\x87D\xf0>\x0f\xfe\xa0\x81\x8d0\x0eԎ\xe6z\xa9\x8e\x9b\x9a\xec\xbft\x0e\x8f\x0e\x11@\x80\f\xc6\x1f\xfd\xe6\xd6\xe7\x87\xd6|\x90+\x8278\xab\xa0\x1f\xc8\xc1\xb7B\x942]\xa7&\xfb\xd9\xe9\"\xffCw\xed\xf0\x9a\x0f\x8c\"l\xb4\xd1\xf5}E\a\xef\xc0\xeb\x1cV\xc7\xe3k\x8b&\t\t\x11a\xc9\xd1\xf1\xf4\xbaEx,\xeaU\x95\xbcTql\xb1\x18U\x1c\x9e\x17WA/\xb9\x17\a4T+\xa0\xaa\b^\xbb|O(*\xab\xce//\xa2\x8f]\x86Ke\xf0\v\x0f\xbf\xc0\xb1\xe8\xf5\x9f\x89e\tU\x9a\xa2h\xfa\xf2\x84b\xa4\xb1J\x01js&\xa7\x94\b\xa5\x85P\xd2\x04嬇P\x8b\x1aB\x1d\xf4\x10\n\x1e\xff\xf3s\xb4\x84Z\x86rЀ\xc1\x10\x8c\r\x82u\xebHFi9\xfd-\x13\xc9@\x9d\fF\x823:\xe3Hњ\xf2-#\xc9-\xf6\x03\xceG2/\x9dd8\x12\xed!\x02֙M\xd4pƎV\x0fe\xa4\xad\x144@0\x04C\x83\xe8,\xd5\xc1N\a=\xb8>\x815\xbf\xc1\xba'\xdf=\x81\vO\xc1\xc5QI\x8a\xe6\x05\\sd\xa5\xbf\x80\x1b(\xff\x88\b\u007f\x11)\x99\u007feD\xb3\x00\x1b\xa8\xe6\x8a\xcaf\xd1\x1a\x8f4\x17\x9b\x92\x12'\xa8\xa9ؔ\xdc\x12\x11\xa2e\xf6\b#)\xff\b\xf8J\xf51\x15\x9b\xfa76\xa2\xd2_x\x87\U0008b12fTj*\xceN\x9b%\xbb~\xbc\xf3K\x01\\\xa9\xa3\xfb\"\xfd\xca\xc5}\xe5\xfb*\x8e\xf2\xe0F]\xef\\7K\xc4,\x99\xf4\xf017{\xed\xdaY\x02\xae\xa46\x04\x96\xd6G\x8a8O\x16\x9b\x9a\x1a+\xa8\xa9\xb8dc\x89(\xbdkcq]\x802\xea\xfaq[\x9cwe\xe0\"Y9\x90\x0f\xf9\x03\xe5\xc2\xcb\xf3\x14ʇ\fA\xb9\xf8\xf2\xbc\f\xe5\u007f\f\x01\xb9`9O\x81\xfc\x8f?@.:\x1fT\xddVY\x86I\xab8\x1c&\x1d\xc7a\xd6\xe3\xa4\xd4\x1foq\x13ᚕ\xc5k\xa4\x1e\xbbe\xf0\x95\xc5DJ\x8b(\xf8ꥉ\x84PK(\a\xe3\xd1\x15\\q\xbc\xf0RM\xe1xtEW\x1c/\xbeT\xcbp<\xb8\xa2+\x8c\x17,j\nƃ+\xb8\xc2x[G\x8c\x9a;\xf0W\xa3\xd2\xe9\xc4W.z a P\xf0\x06\xd0H=Y\xdaha\x81\x19\xf1\x14\x86\x02\x05}\x80b\x1f\xb6+i\xc2\xccq\r\x915\xc19\x02.\x85\xaf\xc8\xec\xbc,S\xbe\xa21\xb8.\xd0 \xe0\x16\xb0\x90\x99\xc6L\xa3QѼ\xbb. *69^#X\aSE\x89\xb1yQ\xbc͛(\xb2\x9d\x9eVw\xaaHc\xc3\x04\xec\x0e\xf6O\xcdL\xc9L\x13\xd1\a\xffMF$\xc5E\x86j\rZ\xad\x02\xd7\xe2odPZD\xe8.EPEh}\xaa\x00\x9bQ\"\xb5\xa9\xba\xb4\x14;\"M\x80\x8f\xf1{R\x9b\xaaMIQ\xf8\xd7\a7\x95\x97\xe4\x15\xe6\v\xd2\x10*!\xbf4\x19\xae\xaa\xf2\n\xf3Ei(\xc5jr4\xf9%)\xa5|s]}\x93Q\x97\xa3\xcd\x11\xe1c\xf8\x91\xacϩ\xa8iP\x18uF\xadQ\x80M \x91\xec\xc3\xf6\xfa\x9cʚ=\x8a\x97\x81\xbd\a9Sb\\n,\x1f\x1b\x97\x92\x94(\xb2g5h\xa5\xe0W\xf8\x8b\x84!\x14\xfc\x8a\u007f\x91J\xcby\xf3i\x0eV\xc1\x0f\xf9\x86\xfc,\x93\xd1\r\x96\xe2*\x12\x9f\xc9X\xb3'.õi\x99iYi\x067\xdc\nV23G\x9f\x93\xa3\xc8\xd1g\xeb\xb2\x05\xf3Ks\x1d\x97\x98_\x94Z\u0097\x14\x19\xf3\xf3E\xd8GYO\xe1:\\\x01\xebH\xcbm%\xce\xe2\xa6Se_w\xcf\xd5fh\xd3u\xe9n\x83\\\xad[\xa8E\xddp\x02.A\x0eV\x90\xd8\xc7\x1aȥ\xe9ҵ\xe9Z|\b\x8f\aJө\x05\xf8\x19\xf9r\x927\x97jL\xc9N\xcaJ7\x0e\x84\xef\xa5\xd7dh\xd32\xc06\x02\xda-\x83q0\x19.\xe3ͅ\xf3I\xebO\xae\xbalm\xb66\x1b\xa6\xc2쁸\\\x86\x13\xcdW9\x98x\x91\xc4j\x19N\x87\xd9dN^V~\x81A\x97iP\xb0[\tX\xf5%\a\x11w!\x045x\x12\xaf\xe1;0\x8e̖\x90c7\xb5g\x1b\xb2\fY\x06X\x89\xeb\a\xe2\xa6\x19$\x94\xc9,l\x15g\x9dj\xfd\x18w@\x98\xd9\x01BI\xebh\xab\x86\x83\xcf\xf0\x1b<\x81\x97\xb0\x1bn\x90>Y\x1c*q\b\t\xed2)ښ\xc9\x1d\xf6&?\xa0$gI\xc9I}\xa47H\xbdA\xa7\x17\xb2\xd2p`\xaf\x13\xf9\xa7Y䬄\xd5\x19?\x83o\xa4\xf9\xd2\x16\x9b\xd69:7\b\xb0\xf6%uz\xbdN\xa1\xc9J\xccI\x16\x94\xa8\x86\xfe\x1cNX\x06\x13\xa0\x95T\xe2.'l\x93\xe1\x04h#\x95N\x0f\\\xefR\xe8\x8a\x11$|\b'\xb94m\x9a6-\xe3û\x03\x81\x93$\x98\x8e'H]\x9a6-U\x91fH7\xa4\tο\xa8\x94N_\xc0,G\xf3oN\xbdM2\x9c\t\x02\x0e\x87Y\x82\xb9\x8a\x82Y \xc0p\x98)*\xc9S2|\x81\x16\xb27\x9a\xc2Y(\xe0\b\x9c!*\x9d\xbe\xe8M\xe3z\v\xa8%k\xba>\x15%\x8d\xec\xd3\x13\xa7>\x15\xa4H\xea\xd3\x13^KD\xeb4\x99sA\xf8m\x95e\xb2\x92\xf4\xe3\n\xd5+rW\xf0\xd6)\xf8+>\xb6NY\x96\xbb\xach\xb9\b㥩\xf0\x10\x1eIS\xe0}\x1a\xaa\xf1uΚ\x8f\xa3\xadC\xad\xc3q\x84\xb5\xe0\xf0\xe2\xd3\x1f_\\\x0fåRi\x844J*\x81Q\xeb.\xa9\xbb\x16\x1f\xa6{w+-\x9fq\x97w_\x89\xbe\x96\x04\xefI<|\x06W$\x05x$]\x8b\xba\x1at\xd9\x1a\x88㬴\x95\xc1w\xad\xbbh˩\xe5\x9c?\f\xf0\x81\xfe\x1b\xa0_\xd7\xc3\xd6'\xc5ϰ\x0e\x87a\x18\x86\xe3\xdbX\xff{\xc9\xd3\xf6\xef\xcfB\xdf\r\xf0\x86\x0f\xf4\xf7\xa7_ud\x13bAe\xf1\x01\x95\x8b^I\x13l\x90R\xe6\xa3t\x8a\xe4\xbe\xc5!8\xf3\x18N\xe2\x91Gq\xd74$\xc5;H\xa2\xb8\ay\x1e'\xe1\xcc\xf5\x93q\x88ȶ\xe3\x18%\xd2d\x0e\xf5\xc3ݪ\xb6oE6\xe8<\f\x00\xd7\x16\x10x\x18\fo\xae{\xfb\xb9\xf8\xfc9\xbc\xd9\t\x83y\x10\xc0u\xc7\n\x18 \xb2\xed>\xf7\xbf\x89\xfc\x99g\x83z\x05\xd8ϡ\n\xfbm\x9f\x89\xb4\u06034\xf6kE\x15\x8f+\xd0\xd5k\x16z\x8al;\xf4\xb7\x18\xc9S\xe0\x04n\r\xf0\x06\x0f\xee\xf0\xa6\xf7\xc8\xdf\xc5\xdf~\x877\x8f\x80;\x0fo\x80[\x90\x178\x89\xd0\xff\xa5\x91\xbc\x8e\x9e\xe8z\nW\xf0l\xd0\xc1\x16n\x8b\x01\af!\xb7\x1d\xc9\xd9n_\"\x89\\3\x0e\xe4q2\xbam]\x8c\xa3D\xa0,3\xc8\xc3\u007f@\xdf\x1ap\xe2a$\xbc\xbe\xd6\xfd\x85\xf8\xfcw\xa0N\xc0\xd0,[\xae\x8c\x85\xcdJ\xa7\x9f#\x1c`7l\x83\xf5\x8f\xfe\xfe\xff\x18\xf2\x1c\x95\xe4\xb70\x89\v\n\r\r\x12ޥ\x82Bk\x1aD\xf3nٞ\x9a\x9a\x06\xe1g\xaa\xa1&t\x97\x88\xed\xbd\xbba\x82J\xda\x03\xef3xԺ\xc7\x06\xcfx\x05π\t*x\t\xef3J2\x03\x038X\x84,|\x80\xf3\x8e\b\xf9\a\xb3;:\x14p\x16u\xa0ó\x98\x83A8\x033\x96\n\x87W/\xac\x9d\xcf\xe3(|\x03\xdf\xc0Q!Y\xc1\xb9!\"\xba\xcf\x1f\x8b\x83q\x8c\x02\x83a>\u0383݂\xd2\xe9[\nv\xc3|\x98\a\xc1\xa2sF8\xe4\x82l\n\f\xdf\x0e\xb2\xdd?\xf9\x81,\xec\xdb\xdd \xdb\x01\xca9 \x03\xa7\xb6\x93πi\xeb|\xe6\xa2\u007fv\xa4\xdd\x17\xa8N\x90\xd5\xc3pdA\xd6\t\xd4\x04X?\a\xa8\x1a\x18\x8a}m~\x1bʷ\xfd\xc83\xb6\xa9k\x84\xd7\"\xc6Iiɑ\x99\r\xf8\xc8\\\x06\x8fH%\x19$\x1b\xa6\xb4|\xc9\xf5N\xc0\xce\xde\x11\xd0IZ\xc6+ɉ\xb2gNJ˧\xb0^IN\xa4\x94\xe8fM\xe7\xe0\x81\xcaω\x1d\xa9y\x8b\xb0C\xff\xf5`o\xce \x88\xfa]܋\xf5%\xfb\x06w\x92\xd0\xef\x1d\x1b\xd82\xf8\x18\t}\xff\a\xec\xf7.\xf7Ǻ\x92\x96!\xc7H\x98\xba\x9e+\xd9\xf1\xa23v\x1f\xd9Xl\x83\x8eŶ\x90M\xff\x81\x1a\x8b\xb8\x12?;\xf4\xb2E\xe9\x94\bߪ\xe0\x83\xb6\xa3*\xf8\x00N0,A\u007f\xfd\xc0\xeeU\xe2\x1c<\xcf\xddiC\x19\xca\xda)H\x01\x99\x8d\xa4\x8dC\x8ab?\u07b4\xe6\v_\xa0d\u007f2\u007f20`*\xd7\x0e\x9bU\xbew(\x8c\x80\x12\x06K\xff\xef\x87\xf5\xa7)\x1c\xcc\x00wp\x87\x19\xe0\x89\xee0\bg\xa0'\x0e\xc2A\xe8\x893`\x10\xba\x83\xa7\x88\x93\xa4\x01\xdcwϫ\xbf\xb8p(\"\xbcK\b\\NfP-a\x8b\n\xb6\xf1\x01\xb3R7\x883\xa0\x1a\x1cp\x92\xc2\x03\x89\x88y\x8b\xd7\xe47{\tM\x9f\x91YԦ\xaaKI\x87\xf8ƻ\xd9G\xc5\x1f\xb0\x02\x1da\xb4}\xe09HI)\x1c\xf4pKI\xc6\xc2-G\xf3\x1f\x92\x81\xc3\x11\xd8\x0f\xfb\xe1H\x9b\v\xfd`\x04\x8c\x84\xff\xb86\xbc`\xfe\xc3\xc0\xe1H;\xd1\b\x9b\v\xfd`$\x8c\x80\xff\xb86\xbc\x00\xb7\xcc'\xb9\x8bk\x8f/\x15\xac\x1fQ\xcb֬]&\xe2\xfb\xbdi\xf0\xbe\xec\xe2\x9a\xe3\xcbl\xb8\xa5k\xd7.\x13\x95\x96\x13\xb2e\xc7\xd7^\x12\x94\xb2\xe1ԥ\xe3\xc7/چ\x14\a\xa1/\xf6\x85w\xa5\x95@\xfc\b\x83a\xec\x1f.z\xf0T\x92\xae/\xe0\x1cLf\x13\x94d\x81e1\xa7\x97\xe9\f\x06\x9d^\x01E\xb8\x91\xf3ٚ\x96\xe8#fU\x1b\xaa\xab\x15\x90\x8b+a)\xe6\xa23\xba\xac\x1d3YH\x88\x8f\x8f\x8dRD\x94\xc6T\n{\x1e\x02\x03\x1f\xf2\xe0\v\x13\xf0]\xdc!n\x89\u07baM\x11T\x1b\xda \xec?\xd7~\x8e\a\x97'\xa3\x90E\xd5\xdb\x1e\xa8\x10\xad+\xf0u\xf3\x1e\xeb\x8f<ۅ\xf5\x18\x80\x1bp\x0f\xee\x81p\\\x0e\x950\x13V\xdc\x05\n\xde\x14\xcbK\x0f\xb5\xd5)*bJ##}\xb7\xc6G\tS\xb1\x0f\x8eY\x81J\x1e\xb7\xa0\ap\xe0'\xb2\t\xb0\x05\x86\x01\a\x1b\x04\xa9\xe2I\xefAi\x0f\x0f[a*\x8c\x01_X\x83\x13\xe1-\\%:\x03\xa9R:\x9d6\xf7pe\f\xca)X\xc4\xd8\xe6\xbdF\x86\xf5:\xc8\xd8o\xbe\bW\t(\aN\xb5s\xbbh\xed\xf9\x98\x01R\x95Ș{\xb8蔘\x84\x04\x01Mh\"\x13\x12b S\x05\u007f\xaaȄ\u0094\xd22\xc5dUYiJB\xa1\xc0z\xc10|\x9b\x84c2\x1b\x8fc2\x18\x06o\xc3\xef\xaa\xdc2\x1e<Tb\xaf\xfcc\x06\x1aͦ\x9fTJ\x9ap\x84\xedf\x13\a\xb7)8\xdd\xfb\x0e\t\xcb\xdd9\xfc\x97\xb9\x884/\xa1zo\x83\x9cç\x14tHr\x12J(\xc9_\x89\xbe\x1c\x9c6\xbfC\xc2mJ\x89\t \xe7`\xa3-\xac\x81\x82\x8dV9\to\xb9\xe2\xc6W\xb4\xd0a\x95\x93\xf6#y\xcb\x12\"M\x11\xbc\x125TDdRt\x82h\x95[M\x91!\x99۫B\xdd,\xea\x97&2\xbe\xb0,\xa5\x9c//\xcb-*\x14-j\x8b\xa9\xaa&\xb35\xb2\xd6\xed\xd5E!\x0e\xe8\x00\xc3|`\x18:\x80\x83\xde\xf6o\x87`\x97J\x0fr\x17\x88e\xf4 \u007fd{\xb0\xba\xf3l\xeey\xa5\x8c\x8d\xe4\xd8\xc1\xe7'N\x9cx/\x84q\xfb\xffహv\xe2q\xe3\xe0\x1aS\x17|P_W\xe7\xd6ΐ\xec\x82\xf3\xf7&\x92\xc0\xa8\xd8\xdc\xf3\xf7\xeeݛ\xf8㏛\x18\x1b\xb1~\x96\xd2\xfc\xf5~\xae\x9dy\x15\xfa\xbf\x82&N\x9ax7\x84q\x8b\x8c\xb2e9l\x83k5\xad1Ƿ\x14\xbb\xe1\xec\v$t\xc9X\xfd<\x94S\xac\xee(\xc8KThz\xf5ݲ~(\xcael\xea,\x94c\x17\x85\xb3\x97o\x89\x8bY[\xb3\xdd\r\xb6\xe15\x12\x8f\xc9\x16.\xb4\xb3du\xe7m\xcc\xed\x1a\x92\xac~\xd6+-ii\x98\xd2)\x90c\xf5C\xc1\xc6w/\xc8%\xd3+)2V?\v\xae1\x94U\x0er\xab\x1c\xe5\xa4\xf3&\xc6\x01z\x9eH\xd7\u007fq\x94z\\Q\x0eq\xaa\x85\x8fQN\x82\x87U͍\x92ԏmE\xad\x9bl\x96(N\x97\xa4K\xd2&\xa5&\xa7e\xa5'гv'\xa8\xc7!\xd7\x06\xafo\xe2\x13\xa8Mm\xf8:r\xfc\xb8\x84\xb3\xd7w\x8b\xe9\x85i\x19\xa9yZ\x93Τ3\xd9\x0f+ͤsM7\f%\xfc\xf93ɚsb\xae6W\x9f\x9b\x9b\xab\xcf\xed;\xb4\xe0ܙ\xbc\xf3|\xc9\r]\x8aI\xcc\xd4\x1a\xb4\x06--y\xbct\xe4`N\x00T\xe3ܟ\x85\xbb\x10F\xa2\x1fUޕ{&\xed\f} \x0f\xc64\x9fS\\\xc4\x0f\x9b\xb7\xe4\t\xe1\xb7V\x03\xe5u\x91\xae\x89\b.\x0eX\xbav\xf1ҥk//\xe5\x03\x82\xe3B#D\xafe\xab\x91\n\xf7\xa4\xb7$\xe3\x87\xfe\xcb\x14+a\x8c\xff\x81dA\x97\xa8\xd3h\x14\xdey\x1b\x8b7\v\xe8G\xfd\x88A$.\x19\xe7\x8f\r8_\x81\x13\xfe\x1a\x05\x1e\x82\xf9%\x05\x1e\u007f=\x86\t\u007f\xaf\x02\"\v,ĩl\x99PŠ\x1cܙWˁ{4\xac\xbfFI\xf7J\x19\\\x18|\x8c#\xc0\x03W\xb6\x0e\xabx'f\xf2V\x94\x81\xe7@\x98\v\xcd\xcc\xdbTttJB\x82\x88\x14R$\xbbG3\xfb\xc5?kwF* 0, MܒҴ\xfd\x00\xff\xf7*\x1e\xca(\xab\x89b\x0fj\xda\xd6#\xf5\x13\x86\xf2(\xa7\xf2T\xdd\x14\xb8<\xdf<\xe7+1d\x03Y\xb6\xd6+\u007f\x15\xff_\vz_\xe8\xc1D\xb5\xedil\x83Y\xdf\xc08py!^w'Y_\xcd[c\x96\xa2\xaa\x14GӬ\xbf\xa6\x1a\xfd`\x0eN\a\xbf8\x9aݣ\x81\xe6(\x88\x02\x8d?\xb4\xd0J霕\xe6\xfegA\xb3\xb91-y\xaf\x88ðQ\x13a\b)\x88t\x8b,\xacJ\xa8\xe6\xe1\x1a\x05\x8f\x19_\xc6\xf5oJ\xfbJ\xa5\b\xc1\f\fa`\n#:\xdb\xeflsЃ\xdcїq\x05%zw\xe3\x87{\x85\xedF\xbf\xd0\xd4\xed\xa9\xdbk\xfcZSa\xb3\uf155Y\x89\xb4o\"\xaaߵ2\xbc\x95A\xf5\xcf-\x89bV\xe1\x85s\xb0\xb9\xad\xd5X\xb3/\xb55\xb5կf{*\x8d\x1f\xeeD\uf168T\xe0,\xc9a\n\xfc\x04?I\x0e\xdf\xc0,\xc1*\xb7\xc89(g\xacrj\x9dJ\xb4\xcaG\xa9\xba\xadr\xa8c$y\xb7l\x9cj\x94ٔ\xc1Ty\xd9\xf7\xef$2\x92\x89\v\t+\xae҈+\xc1\t߶F\x91A\xc9\xc1\x91\xbbx\xf0bv\x06W4$\v\xb8\xc2<f6\xb6\x90\xbbRvG\xec\xe4\xad$U\x90\x90\x97\x92\xa93;Y\x9f\x0eL(,M-\xe5KK\x8d\x85\x85\xa2\xd9Q\xfa-33/\xb7\xb0\xc8\r\xb6H\x9e\\\x85\ne'\a\x9f\x9e\"JS(\xa0\xa5\xad\xe4\x9eܺʽ|c]dP\x9e\b^\xbd3\x81\x93f\xe6\xd6W\x975d\xb9\xd5e\xd6\xc7\x15\x84\x82#\xee\x1e(\x91\xd4\xe9oN\xbe\x00\x99\xc2ٚ\xf0jMY\xee \x99\x1e\xaa\"\x19\x8b\x9a\x83\x9f\xb1\x13j\xf1\xf9V\x01k\x021\x00C°.\x18\xbf\x84X\xac\x86s~4\x16a\xe8V\x06B14R\x05\xa1\x10*\x1b\xcb`(\x14Q\x97U/M\xae3\x19\x18\xcb\xe0zX\x0fz\xc1\xf9\x18cu\xb1\xba\x90_\x8f\xc0\x01\x9d\xa8\xe4/1\xa0\\\a\x03\xa6>\x11%\x17\x89%\xb1d\x86J\xf2\b`^ʕ\x16\xcd(ƚ\xb0\x89\x89d`\x18#\x9fh\xbe\xaet:Ͻ\xf8\xf4\xee\xe3\x0e\xa0骼\x98\xea0~\t\x15\xaeINH\x13Q\\\x8e\xaa\x91\xf8\x1e\x8f\xef\xa1\xea7\x10\x97\x8b\x85i\x9a\xbcx~\x0e\x15\x16\x13\x16\x9e'v }wԋ%4\xaex\a\a\xa2\x17\x86+`\xacj\xa5\n\x06R\x97U\x10\x8e\x03\xc1\vW\xfc\"`\xeeK\xafQ\x8c]\xe0\x1dF2A4\xe3h1)\xc9\a=*\xa4\x1e\xe1ȓ\xf8A\xa4\x1b\xd6\x04\xd8\x12\x1fnK\xfc\x17\x10\x87\xd5p֏^\xa6\xaaz\xa5\xa6}\x0f\x83$\x87h\x95=\x1ayg\x04\x0e8n\xebS\x958`\xed\xb4\x11\xe2\x8as?>-,\xd2U\xc5\x17\xb8\xad=\x12_\x10\xa1K\x88w{\xff\x9dA\u007f\x91\xe0F\x8de\xb0\xe3=\x06;f\xa8@!\x03Gt\x1a\x8c\xb2\x84xCx\x91\xc6\xed\xb8w\x91\xa6\xd2PX\xe4\xf6\x1c\x1c\xbbV\x91\xd3F\xac\xc5\x01\xa8\xb4\xa5a\x99\xaaJ\x0fr\xa5ӥ\x88\xaf@\xee\"\x99`!\xc3\xfe\xae\xa4^Z\x92\xb90\u0603\xbb!\f\x1b\xeaѽ\x18\xdf\xdc6h֠\xeb\xf8f;\xba\xc7a\xc3n܍aa\xb8\xe7\xffi@Ʉ\xa13\xfeːpn\x1f\xc4Bu0|I\xbftA+\fe\xd8_\xd1y\r\xf6\x99:Y\xc0\x1e\xe49\xf6w8\xac\x12\xd9\xdf@\xcf\xdc\f\xf7\xbd\x027\x99\v\xa8z<T\xf0]0#|*/\xf5X\xe5\x9c\xd5\x04E\xd4/\xf9ߵ\xdc:yk\xf5w;~I\xa4\xbb\xa1?7uFU\xdb\x02\x91\xfd\xfd\xf1\xef\x17`\x8f\xea\xcaM\x98\xad\x82\x11\x98\al$\x03\xf2W\xfbw\xe4.\x06`m\xf9ʼ\U0008273d\u007fz\x11cyѣ\x1a{\x03\xc9c\xd8\xdf\xe8\x16v9\xe0\xfc\x96\x93\x90\vy\x03\xffP}\xab\"ٮ\xd3(\xb3\x9b\xed\xfdH\xac\x0f\xc0@\x8c\x8c\u0086p\xbc\x03\x99X\a_\xc4|\x87\x99`\xc4\xe9p\xe0 d\xb4\xc0\x1aXy\b\xd2\xe1\r\x86\xbd\u007f\xfas\x95\xd5d\xf5\xe0l\fBq\x12.F\u007f?A[\xa1\xad\xa8T@(L\x82\xc5\xe0\xbfOȌ̌\x8cP\xb0\xf7O\xbf\x94\xbbf2\xf0\xb9\n\x16\xc08t\xc0q_\nX\xbd\x067b\x02\xb6*\"\f\x11\x99\x91\x02\xeel\xc4i\xb0\x14\xc2\x15\x16uo\x0f\x87\vp\x1c8\xc0\xb8\xd9\"T\x9f\x80\x8d\x90\x00\xad\x8aJ]\xa5\xb6B\x80\x9d\x810\r\x97b\xb8b\t#8C\xe3&\x068`\xb1?\xf4\xb7ȁ\x05\xce~\xda[\xc1?'\xbf\xd9?\xb9\x009{\xefA\x1d\xf3\u007f\x946\x06\xfb\xf8.\x19#\"u\x1b\x87\xb4\xe3;\xa1h\xf0\xc15\xb8n\af\xae\xc3\xfb\x90\x8c\x1dpm;\xbdRE\xb1\xa7\x1e\xc0@\xd9/\xf9\xdf\xed\xbbu\x92f\xef=\x00j:\f\xd9\x06\xa3k \xb5\r>\xb1\xe5HJ#|\xb4\x06&\x8c\x807\xfe\xceX\xf6\xe4\x83^\xd3\xcb'0\x94\xf9\xc7\xf8\xbd&\xb8ʁ\x9e\xb9\x15\xee\xdb\r7\x99\x8b\xa8\xfau\xa8\xe0\xbb\xd03|*o/\x10\xf7\x1eXz\xacjn\xaagU\xdbB\xf1\xd7\xdf/\xc2\x1eU\xf7-\x98\xad:\xc6\xe0\x0e\xdc\x01W\x19`\x19\xf0D\xcfi\xd3\xfe\xff\xb4\tw\xbe\x06w\x1c\x04\x89\f\xdaf\x16;\xc0\xef\xffn JT\x91\xff4Tf\xb9\xa5?\a\x03\xc1\xc3Vh=`\x12\xf5\xd3\x18[\xd4\r\xb0>\x84q\xc3\r\xb8\x1e\xe2U?\xfdD\xe2$\xf4\xd8ʀ\a\x0e\xa4\xf0\x9c\x1f\xc6bu\x1d~Y\x8du\x10\x80!P\x13H\xc3\xf3\xad\xd0\t\xb5\xf0\xb3\x02N`(d`G\xb3\xb0\xf0e\xce(\xc6\xfe\xeeN\x92\xc3Y\x95R\x16hv\xe0\xb0\x13:_m\xf1u۰\x01\xae\xa8\xdc`1.&\xb1\x13\x0e\xa9\x16\u0087*\x1c(\xf3\x83sX\r\xb1\xf8e0օa\b\x06\x04b\r\x8dϷB-v\xc2\xcf<\x9c\x80\f\b\x85\x8ef\x11\x06\xfe\xddrv\xcap1.\xb61;\xba\xa10\xbeJok\xd4;\xa1\x13\xdcUnJ\xcb\rk\xa2-ɾ\xf6\x161\xc8\xd6.C\x10\x03cUVi\x86J\x92d\x97U\xd6\x1e\xab\xdaF\xa1\x87:\x95\xa3\xc5$\xf5p\xffiyg2\x02\xc8]\xc1~\x178^\xbf\xc4\b\xceU\xceU\xce\xe6\xa5P\x04\x81\x10\xeb\xa0$\a@\xb2\xa3\x92l\x06\x8eQZ\x9a\xa8u>>\xebD\xa5\xa5I\xb6n\xbfO\xa7`\x0e9\xa6\x92x\xaas\xff\xfeNQI\xae\x92u\xfa\xec_'8[\x13\xe0!L2\xff\xdb\xfe\xde{\xb1\xf9\x0f\x88u\xb4Lw\xc27\xbbp%\f\xd9(\xe8rr\xb59\xfc\xbe\x8a\x9f\xeaŦ\xa9W\x81S@\x15l\x84\x15P\x06\x110\x159L\xc4ٳ\xf0\x01FO\x9cu\xe9\xa1\x00.߃3\xb8*\xbe\xde\xd0=\x01\xa9\xd9(\u007f{e\xee\xe2:/a=\xaey\a\x8f\xe3F\xc5\"\xd3b\xe3\"\xc1\x94X\x9c\x03S!:\xf5\xe8m8\xe36\xad\xe3\xcfM\xf7y\xf8\vރ'\xd0\r\xf7\xf1\x00\x04\xe05\xfc\x15\x1fa\xa4\x95\x14\x9d\xdf\x01\x17M\xb8\x92\xa4\xc1\xc5e\x0f\xb8(\x9d~\x06\x176\xd2\x12(]\xe4v\xaf\x1e\x19\xc8'\xa7de\xa7\x88+\xf7\xacٻ\xfe\x88Oۦ3a\x9dtF\x8e6'\xa7\xe6\x8b\xc3\xfb\x15\xf9^\xa0L)\x13\xea\x13\xd3\xf2\x82\xe2\x13\xb2\x12\xf8\xf0\x98\xe4\xb8Xq톐u\xda\xf9t\xb2L7\xa7e\xce\xfe\x95\x0f\x91\xfd\x01\x1da\xc8\x02:\xe2\xf0\xd3M\xc7\xf9\xf3?\xb7\x9d\xab\x11M5y{\xea\x14\xd9z\xa36G(L\xc1\t\x0f\xa2y\xf6(z[\x1b8t\xc6~\xed\xd7\xde\x121\xedï!\x8e\x9c\xf6\xd7&\xe0`\xa0\x02\x9eB+\xb4\xc2S\x9b\x8b\xad\xf8\x14\x9f\xa2\xdd\x15\x94\xe46'\xab\a>\xc2GV\x0fT\xb5#\u007f\x1d_\x13Qi\x1dA\xb2\x91\xd2\xfb\xa0 ٣\x8b\xc1\t\\\xfc\x81\xe5%\x0fx\x04\x8f$\x0f\xc9\x03\x1f\xc1#\xab\x87\xe8\\\xa5\u007f<\xca\xde^~\xc4()\xf7\xc7l\x82\xc6\xe6\xe3\xc0[\xf5\xd2$\x99l\xae\xd4aUsz\x95\xd2\xfc\xc0\xd6\xc7\xff\xc7/\x99\x9c\xf4\xaaǽ=\x9c\xd2LX\xe5\x14ۥIPQ\x16\xb9\x93\xdd\xfb\xdf\u0ae5\xb3\x8e\xc7`z\xfc\xd8\xc1\x97\x01\x93\xa3Ym\x96s\a\x98U\x8chQ\xa3\x9c\x83\x17\xf0)\x83jj\xaeJ\xc4\x17\xff\xdc\xec\xfb\x82\xaaR\t\xa0\xf6g\xec\x17\xb1~\xaa\x025|ʼ\xec\xc1P\x0e\xd42\xf8N\x05!T\xf7\n\xcf\xe6\x0f\xf8\x18MJb\x82\xe89b\xf4:t\xe1\xd1et\xe7\xad\x110Ju\xe9rq\xdb\t\x11\x92\xd0\xf4jן34\xdaFU\xf0\xbfe_\xc6\x1e\x0e2!\x934\x1a\xb3\xf2L\x8a\xbc\x8c\xbcT\xa3\x80JP\x92\xc6\xd4\xe4\xacd>)9#5U\xc4L۟\x8d*59+\x89O~\x85T\xa2\x92LM\xcdHNV$e%\x1bS\x05\xc8|EgG&)\x92_!\xedTFhaLyYF\xa3h\x13\x06v\"c^\x86\x89\xcf{\x85\xb4\v4f\xe5\xe5)Lv\rz\x13 \x93\xc3\xf90\x9f\f\xa9\x8d\xdfע8^U\xbf\xafYȎ\x8č\xfc;\xb1\xd3G\x8dX\x8f\x03x\x1c0\xe2\xd8\xedQ0JURb\xc8,\x16'\u07b3Gاh)\xdaW[k\xeb\x1f\xaa\xc2\xc1\x04\xfdTRO\xb7E\x0e&G\xb0\x99Ԭ\xceV)\xd1\x13L`Z\xc4\xc0\x9f*I\x1d\xc0(Q\x03=\xbbl\xf3t4qJ\xd4Hr\xea cVS\x90\xaa\x82\n\x95\xe8,\xf5\x80\x1a61\xb7U\x8b\x18\xa8T\xa1\x89\xc2۽&\xd2\xeaL\xe1m\xb3\x89D\x13\x05j\xab\x9aD5\xaa\xa1\x81:k\x9b\x04\xdcE\xb54\x86\x02so\x0f\x98\xcd=\xa44\x06\xd4x\x97:\v\xb6y4\xa8QMY{zM\x1c\x12`\"!\x80r\xae\x0e\x97n\x80\x1a\xbeW9\xe8A\x1dɀ\xda1C\x85=J\xcbin\xd0\xfb\x0f\xff\x84S\xaa:\x95(\xf5H=v\xe5L\x91\f\xe5l\x80\x10\xdc\x10.\x1d\x84P\xf8^\xe5\xa2\a\x0f\xa9\xc3~,\x8b\x9c\x8d\xcfP\xf5v(-\x0fN\xa8$\x0f\xa9\x83\xb3vH&\x8a=%uPR\xc7ˎ\x13*\xb0#{$\x93\xd4C9W!\xa3$\u007f\xcbQI\xbfX\x9eq\x06m\x96\xce X\xf3\xc0D\xe6\xc6\xc5\xe5\xc5\xf0A\t\xa1\xc1\xa2\x12\r\xb2\xc0j\xdf=q\x82\xe4\x83qdZ~AF!\xdfR\xb2\xb7I출笿ɤ&\xac \xa5_\xcc?p\xd6\xf6\x97\x0e\xd2b\f\"\x93\x8bK\xd3\xca\xf8}\x155\xfb\xc4\f\xa5S\x01\x97\xa5\xcd\xd4\x1a\x04\xeb~\xa8!\xb3\x93\x932\x93\xf8\xf0\x88\xc4(\x8d\x88_Yk%E\xaf3y\xb0[*6\u007f\x93\xadp>\xa0\x92\xfaqJ\v\xa1\xb4\x10\x8b\x18\xcaY\xaaƬ\xba\x93\xa7\xbc.\x87\x82ߺ\xd3M\xa76\x03\x01\xc3\x0eo=\xe2w(\xfb\xe0\x813_\x1c\xf4\xbd\x05k\x0f\xde?\a\x8b\xbe\xbd\xf7\x9d\xcb\xf7\xa7ow܆\xe1\xd8o_RS\xd6ٶ\x06P\xb5Ð\x0e\x88\xe8\x84\xc0\xe3p\xb7\xab\xa0+\xbb1\xa9\xe5\xfe\xa5\xcd\xfb\xab\x0f\x87~\xcf\xd6\xcd\xfd\xbdw8\xebB\x10D\u007f)\x18E\xae\xa0>\xf6\xb8\u007f\xaa_\xea\x87+3\x03\xec[\x96\x88\xfe\x99!\x87C[\v\xeb\xf2\xf7\xeeͯ\xa2\xd9>nD\x1fw%9\xcdUS_r\xbc)u_ꝕ\x19\x01\xba\x10\xef\xd0\x1d\x85\xbb\xf3w\xeeM\xaa\xa2\x8f\x83\x03\x17~\xb8\xed\xce\xd9\x14\xfaB*\fxo\xbeb\xe3\xf2\x9dKʄ\r\x85\xb3NG\xb5\xd1J\xcb&\xd7\xeaþw\xd4)\xf4\xf2T\xb4\a_\xd8\xf9i\x99p\xb4\xf0\xcb\xd3\xe5m\xb4y\xa7E\xe2\xfe|8e\xf8\xf0\xf7\xa7\f\x1a\xf4\xc17OK2\x8b\xb2\x8a\xc5\xe0\xd2ƨ\x16\xfeT\xc7\xfeN\x91%x\x13\xa1i\xdfݼ\xbd@ȯ\xa9\xcfi\xe0\xe1\x1c\xec\xe4\xe2\xa0\x12qϻ\xb8\x15&\xfd\b\xd3\xfca \xbeE\x9b\x93\x948\x82K3&\xe8\xe3\xd24\xe5\xb1e\xb1\xa59e\xba\x92Tc\x8a1V\x1b\x93\x1c]\x18^\x18\x9eO\x97\xa7\x16'\xe7\b\x1acXzTTxV\xb8>2-.#!%!\x896\xd4\xeak\xeb\x149\xb9\x06C\x8ex\xa5\xe0\xfa\xb1\xb2[9u\x86\xfa\x94F\xcd\xc9M{\xb7\x94fT\a7\x05\x19\x83\x8c\x91!\xa9\x11tZ\xba>\x9d\x8f\xd6\xeeL\x8b\x8b\xd1j\xb5\x19\xba\x8c\x88\xcc\xf0\xac\xf0\xcc\xfc\xdc|cAveKK^\x87\x81\xce\xd6ge)\xd8>\xc4\x00\x82\xb8\x91\xf9]\xe5\xc1\xa3\x9d\xa7r\xbfK\xbe\x9a\xd4\x1dtK} \xa0zMd\xba\x8f6*cEL\x12\xad\xa3X'b,A\xec\x8a\xfdİ9\xc7\xe7\xfbU_\xcd;\x8f\x05\xb0\x1b\xff\x1d\x86mKi6\x97p'\x88\x9e\xe8\xafc\xbf\xd892i\x84\x9f\x87\xf7ʝK|\xd6{\xaf[?\xf0l^W\xc1\xd94\xdf\b\xff\xe0\xc0\x9d4{\x9a \bz\xa7ngv\x94)ƔqA[\x96~`My@\xb8zk\x90g\xf2\x02\x9a\xedo\vN\x9a\x9f\xe7q̋~U\x04\x0en\xf81\xb2GK\xb3D\x0e\xe1I\xe8\xd2\xf5\x19\x19\x8a\x83\xda\xe6\xe4\n\xbf\x82\xf4\xfcTSJ\x846<#B\xab\xcb\xd4fi3i\x96\xf0$\xfa\x13\xf1e;\xb3\xa3l\x1ew\x82&\xd2\xd3\xf4\xe9|jUT\xed\xae\x94\xe0\x94\xa0\xc0\x8c0\x9f\x98\x8d;\x13VӬC\u007fBC\xa4\xee\xd4\a\x19\x83K=\xafo\xb8\x92@kss\xf4F>X\x1f\x12\xa2H0\xc5\xe7\xc6gE\xe6\x84\xeb\xc33\xa2\xcaó\x13R\xe9䴴\xb8(\xc5+5\xc2\x12#\x12\xa2\x13h\x96\x98EL$b\xf2b2SRSS\xf5\xb1\xd9ѱ1q1\t\x91q9\xf1\xfa\xf4Դ\x14cT~tz\xbc6^\x17\xa7\xc5\xd7`\n\xf6\xfd+\x9df\x89\xa1\xc4@\xa2[۽\xaf\xe9\x9c4!J\x9a\x98%M,i\\ٽ\xa3;\v\x88l\x98\x02}3ൿ9\x17g\x14\xa5\x17\t\xac\x83\x93f;\x91_f,O˥q\x8fe\x11W]\x97\xd7\x10$tl,vO\xdf\x1a{\xd2\xef\xfc\xc2::\xbb \xa7\xb2F1m\x18\a\x8eLyV\xb5\xb1\xb0\xf0Fh\xcb\xd4\xec\xa9\xe9\x89)\x89)\xc9\xe1a\xbe\xb1[\x92i\x96\xd0\x10\x1b\x89үc\x1f\xaf\xbd@\x83#\xf3\x8a\xf3\xb6t\xfb\xbe''\xe2\x94w\xcd\xdba\xe3hml\xac.\x9e\xb7ճ>DʷQwwݦuť\xfab^i\xfea\x12\x97~\xa0\xe8\xafC\x87\xe9\xfa\x86\xfa\xe40EhND\xb6FX\x18\xec\xb7\"v5\xdd\xf6\x16Ǻ\x13\x84\x13\x91\x92\x97\x9a\x9f\x96\x9f\xf3\xf5\xbe\xafo\xd4Ь\x89\x19\x9f:\xaf0\xa1:\xb5<\xc3&\xd3~\xf0\xfc\xda屣J\xa7n\xc9\xf3-\x0e\xaf\xa1a\xf6\xc7\\l\xac!3Z\x8c\xfe\"\xffVC\x17\xad\x93\x1d\xad(\xcf-ϭ\xc8v\xdb:3xJ\xd2$\xda\xf2\xc9V\xee\x84\xee\x84\xfex\x86n~0ʖ\x05\x86iRr\xd2k\x12\xaf\xa6]\x8d\xbfJ\xebe\xd8j\xe68\x18\x03,\xba\xe0\x18\x1c\x8b\xaf\xdc1h?\x1aކ\a\x17\x18#Bd/\xcbeE\x15\xe0\x9b\x11\xa9\x1b\xe3\agl\xae\x8c-Ђ\xb3\xa1\x82\xd6ɾ4\\\xd7\u007f\xa9\xa3\xb1\n\xd7rk\xe3\ai\xd7V\x85\xe4\xe7ðܖ\xeb\x99\xd7\xf57\f\xb4N\x96\x1d\x90\x87\xefE'\xd1V\x01\x96sG\xb3\x8ee\x1e5\xd0zY\xd2\n\xed\x90\x14\xff\xf4\xe0\x04}\x84ir[\xf8/\x95=\xb4M:\xa7\xcb\xd2ek\xb3\xf6f\xef\xcdڛe\f\xcd\v/\f\xa7Yw\xc2AC\xb4/\x02\x979\xd9\u007f֨A\x9eߕs!\xa7Q_p\xfcX\xcc\xe7\x86\x13ه\xb2Zu\xc5MّY!\xf9\x91\a\xa7>\xb9\xd9\n\xcetsƾ\xf4}\x19\xb4\xfd0w\x9d-\xe7t\x19:\xad.\x1d\uea34\xb1\xc5\t\xe5)8\vg\xe2\x1c\x1c\x85Y\xb30\xfe+\x9c\x0fC\xf0\x03\x98Y\x99[Z\xa0-\xa7'\xf4\xd2\\\xdc\xfcy\xde\xf3\xb3h\x96&h\x82\x98\x95\xf5\xf6\xa5u7\x12A\x06\x9e\xe0t/\xd8V\xdb\xfa\x10\xc4٘\xe3#\xaaއ;*\x8d.Q\x9b$d\xc1t.\xa9:\xa1>\xae\x8ef\t'\xa2?\xa1=\xa9?i8I\x1b\xa8|cA^^~\xad\xb1\xb6(\xaf$\xb7$\xa10:ߐ\xa5\xcf2d\xc1\x1dU~vAv^\x0eͺ;\xa8\x89*\xfb%,\x0eoÐ\xe9\xa8܄\x03\x97b\xff\x8f2\x0e%\x1cI\xd8G\u007f\x81\x939\x9d^\xaf\x13\f2\x83^\xaf\x17\xc1\xf5\x1bP]\x81\x11\x87\x0fG\x1e\x8a9\x12\x97\x97\xa1\xd7\xe7e\x9d.\xe9\xaa8U\x03\xefn\x04a\x06(\xa7Z\a\xc4[]\xb4V\x97\x12Z\xe9Tu\x8cC\xb7\x1b\xa8\xfa\x14\x87\x1e\x86A7a\xc0sx\r\x1c\xf2\xd2L\xe9\x05\x19t\x86!ݠ\x15\xa2\x13\x13\xe2sc\xf3\xe2j\xe3j\xd3L\x89\xf9I\x05\xa9\xb4\x97\xceK\xeb%\x18\fq!\x9a\xe0\xe40\xb7\x04\xbf\xf8\r\x19\x9bhI\x19ʭ\xfb\xe8\xed\xa5\xb32\xe6g\xcc;\x12w\x95֚t\xf9\x05\x8a\xaa\x87'\x9e\x9c)\xbd_\a\x9e\xe8\xa4A\x19\xed,5Z\x16\xc0\a\x0ez\xfb\x8d\xd5\xe4\x8b`\xee\xd1̛c\xc7Θ1v\xec͙\x8f\x1e\xf5\xf4<\x12z\x13]\x1f\u03789v\xec\xcc\x19c\xc7\xf6\xcc|\xf4\xe8\xa6\rW\xaa$oq\xd0\x1f\x97|\x8e\xbf+\x9d\x12ʔN\xed\xe5\xbdA\xf8-\xee\xb0R\xb4\xf4\x9b\xd9C\n\x86\xb4\x97=\xfc\xcb\x1el<\x06\xddx\xdc~\x8dzw7\x98\xcc\xf2f)\x14\xe4\xdd\xdd\x0ez\x9b\xb7\xbb\xdb~\xaa\x9b\xda\x069Z\xe4J\xf24'yK\xafY\xff\u007fl\xbd\aX\x14\xe7\xf38\x0e\xc2\x167\x06\xcbݚ\xe3\xf6vW\x8d\x9d`,\xd8\x1b\xf6\x82]@\x05\vM\xa5Y\x10\x04\xe9G/G\xe7\xe8H\xb5\x02bÊ\x05c\x12{AQ\xd3L4\xc64\x8c\xa6\x98\xcc\xde\xcd\x1e\xdf\xffs\x87\x9f|>\xcf\xef\xf9?\v\xfb\xee;3\xef\xbc\xef\xce\xee\xce;\xb37\xfbNOy\x93\xbcI\xfe\xb7\x94zJ\x9b\xccps)\xde\xea^<I\x1el\xea`\x83H\xb8\xcc\xcba\xddq\xf8\x8d\x8f\x18)\x8c:\xc2K\x83]\xd8\xf5\xfc\xfa\xfd>\xc7w\x8bH\xce\u2064\x8e\xd7\xeeo9\xaf\x86\xbd\x8cAߝ'\xe1\x95<M\x9e\x06\xaf\xf0\x15\x141\xf0J\x9a6\xd2l\x02\xcba,$2\x82\xc3q\xbe\xf3]N\x97w\xb9o\x1d\x8e\xf3\x86\xb7&%;\x86\x11\xba\x94\x8f\x19I\x0f\xa5\x8cQ\x99\xc1\xbc\x8b\xf5\xf4f$%\xb8\x82\x12\x060\x96\xd0Q\x1d(\x15F\r\xa55\xeaY\x8dAk\xd2C*\x0f\xde\f\v\xd3\x18\x8d\xd46\x8aQ\xc8FWo^C9o`\x14Fl\x01\xa5\xac\xa4\xcc\xde+\v{\x19p\x02\x91\xd7Hm[\x98.WJ!\xeb\x18\r\xe5\x9cσ\x13\xd5\xc0\x88v\x91\x8c\xd9zd\x13\x18\x83^\x83V\xa4B\xd6PZ\x97\x11\xfc;\xb0Qi\xd0w)\xff_\xa8\xa47\xe8e\xfd\xff@\x15\xb2\x86\xd6\x1a]G\xf0r<\xf4\xe0\xf706\x1a\xdbmR\x02ۥ7\xea\x89\xd2\xf8\xe8\xfc(.)1#=Y\fM\x88\x0e\vQk\x8c\x03\xc0\x83\x87\xdbp\x1bV\xcbk˂\x8eE7\x95\xd9üǍe\x15\xc7b\x9b\xecq\x83\xb4\x93\x90\xfa\x91\xc00\xb4\x9d)\xbe\x9c7\xe8a9c\xbd\x99\xb71t\x00\xcb\xdc\x02\xa5\v\x89\vQ\xb5\xc6q\x8e\xf8\xcb\x1cT]ƅ\x9c\v*o\x91\xe0\x0fV\xce8B\x94\x9d\xe06#\xb7P8\x02\xad\x9c\xd1_\xe8F.\x04\xd5\xe5_\x1e\x8a\x8e\x0fA\xb5\x06\x16r\xddl\xfc\xd1\xea1\x8c\x10\xcd\xf6&\xdce$'\nF\x80\xd5c\xf07\x1b\xdc\xe5\xbc\xce\"v\xcbK\xa9\xb7\xcc\u007f*\x03\x98\xff\x82\xf5\xef\x82r\x15\xcd%5\nk\xe7\x15\x1a\xda\n\x8e\xf0\x92Y\xf8\n\xabtgTjЊRX\xfb[\x99\xaf\x87\x198\xb0\x8f\x15(\xbb\xf4\xa4\xa2f\xb8\x15|\xcd+\x9cigP&0\x94\xa2\xc6j\x05Z֧Q\f\xb4\x1a\x80J\xa3\x9e4\xe3j\x18\x16\x94\xa4b\x00m\x85ݍђ]\x1e\x94\xac\u009a\xb62\xa3,\xbd\x98\x91\xd6VΠ\xd4\x18\xb5\xac\x99\x817C*\xf6\a\xac\x00%\xb9\x95\xe9\xafA+\xb8\xcb\x18-\x99\v\x13\x18o\xa6K\x8fJ\x12\x92\x18\x98ɛ\x94F%\xab\x10(-軔&\xbd\xa4D\xbd\xf9\x8aS\x1a\xd4\xcaJ\xf3eV\xcaz\xca\feMf\xbf\xb2\x89\x01=i'\xfd+\x99\x1aƛс\v\xa3\xb3D\xe2\xfeW4f\xb9\xfc\uf44b\xe5\x17\xaf\x82\xfd\xab\x14\xd6΄\xa6\xa7\x95AϾ\x93\x91V\x89\xdd\a\xce}\xc0\\\xac\x18n\xbei\xcd>\xa8\u0560\x01\xa8\xec2\xc3<\xfe\x95^\x8f\xb9V\xa04)I\x05\xc3X\xa47\xb8\x87\xd6Ln>\xfd\x1akg\x8b<\xad\xe6\x82\xd2h\xae[\x11\xef\xe49\xa4G\x0f\xcbb?5\f\xab\xe8\xd1\xc3\"\xb4\x1es\xad\xba\x85f5\xdbʤ\x94\xf4\xac\xb97\xb8ː\n+\xe7\x01h&p\xeec\x96\x9a\xd2\"\xfd\x1e\xe6\vB)\x06[\r2\xcbM٥7\xcfڶf2Ko\xe6q\x0f\xb0rN`\xfa+\x06[\xd5\xc0m\x86R0Vû\xf4\xfd\xe1.C)zh\x95\x96\x1fk\xcc\x17\xc8֨$\x15\x85\xef\x05z3&\xa5\xa5\u007fY)\xeba&\x9f\xc0\xa0\x12f\xf2\x8a|\x0fGo\x865c\xba\x94\xa04+\x00\xcb)Xv\xde\fi\xe7\xc1\x1b\xf5\xd0\xc2z2\x82\xe4dt\x82\x1b\xbc\xc6\xd8\x06j\xde\xe8DZ\x9et\xb9/ժ\xbf\xb2\xff\xf0aX\x02KT\x87\x0f￢o\xa5\xed\xbc͏\xec>\xde|!l4\xc4\x1eг)Q\x89Q\xc9ɸ\x14\x97\xaa\x92\x93\xa3\x12\xa3RhȂ,\n\x9b@ɺ{y\xb9\x8b\xd8ҥL`\x12\x98.\xb3\n1:\xc1\x98\xfft\xa4'\xdb\x1e5\x1c;&\x9a; \x8e\x1dkxԦ\xb6\x93\x0eK\xeb\xc1\r\x17\xc1\x80\xaf\x80md\xa4WW\f\xc3\x1f\xc1\x16\xf0\x854P*b5T\xb5\xd4\xcc6\x87\x1eؖ/d\xa5%f\xa5r\xe8\x88\x03\xc0\x91\xcc\xd3ge劇\xf3\xeb\xf7\x1fUGB\x00\xdb\x1cZ\xbf=OHO6S\x88ȂH\xe6\x15\xe8\xb2rDE\xfa\xa1\xbc\xfa\xbaf\xb5\x06]p\x12\x8b\xbdf\x8d\xc4\x02A\x87\x1f\xe9\xb0W\x93\x00\xb6\xd4\xd6G_\x84}\xc9\xfdt\xe9\xeac\x11\xae\xc3\x1e\xbcN\x196\xe2\x12v\xc0Ρi:5\xbe\x8d\x1e\x06\"\fQÐ7 \xc2\xdbhA\xf7{\xda?;\x05\xb4\xa5\xdc\x0f,\xde79\x9f\xc6\xc1\xc5\xe3\xe7a\x1f5\xf6y>\x0f\x06\x17\vO\xf3\xef\xec\xbf|\x80V\xc4\"A\x19v\x01\xc5*j\xa7\xccY3R\x90\xe3\xa5\x02ɓ\xd4\x17g\xe6s\xcf\xd2nn\x9a\xa9^\xe1\xb5v\xbaVH\xa7\x14\xb1\xcfS\xeex\xceR\x83Ґ\xf3\x98\xd7HV\xa4\xc2Ǘ\x91\x0e\x83\x86\x81W\xd7!\b\x12\xa1\n\x12!HR\xc0+\x1b\xc3kheQl\x1b\x0e\x1c\x8cU\xc3\xd8\xd7\xc0\x81xE0-\xa2\xa4,x\xcd\xe2\xd8\xe1ȡ\xe8&\x18\x16S \xba\xbdF\x0eǪ!\xa8?|\x81\xe5P\x8e_\xe0\x13\xac@K\t\x15X\x01O\xe0\v(\x87\nx\"H\x8ad\x16\xff\xc0\x87x\x1c\xff\x14\xf0\n\x89\xff\xe0Y8\x0e\u007fH\xfd\xe0,T\xc2?\"\\!\xe1Ox\x88\x95r?\x11^\x19\xd4\xeb\xf8\xe7\xcc>ơ\x99\xef\xea\xc8\xe0\x8d\x1d\xf7\x19c\al\xe7-\xebU\x19;\xd8\x10\xbe\xab#\x861v\xe4\xf3\xb2e\xfe쮃\x13(\xd1\xe9\u007f\xc8\xd1\t\x94\x94E\x1f@\x18\xb4\xc0\x0e\xbe\x02l\xfb\xe9n\x19\xf4\xb7\xba_ۛ\x8f\x14o\xb4\x1a\xb2\xcd\xd8\xc1JJ\x93+aғ]J\x83\xeb\xbf\x15\x8d\xed)\xa3\x92\x95\xe2\xc9ь\x1cO>\xde9\xafm\"7q\x9e\xbb\xf3NQ\x8e\x9f\xc8K\xf1d\x95ۙMW\xf6\xd0\x1a\xe3\xdf\xf2[?\x06\x9c\x18E?\xab\xb1\x8c\xa2\xcej\x1b/\xbf\xd5\x10\xef\x91W\xaaΜ\xa9\xbaB\xe3\xa2h\x16>\xe77mݺI4vtٲ:\nV\xf3\xb0\x8b\x17\x14o\xb40\x11\x8e?\x05\x1fQ\x83V\xfd\x15\xfd\xac`5o\x06\xee\xe2\xdf\xc1\x05\xbb\x0f\x19\xb9\xc1\xb4\x96ݕ\x18\x15\xbaSݽ2-l\x9c\t^X-[\xab\xbb\xf4\xa4d\r^P\r\x1b\x9f\b_1\x1f2\xa8\xec\xbf)\xdd+\xc5G\xe8J$SZ\xd2O\x9fQ\x1b\xf5\x1a\xa3\x15ۥ'\x01\xc1\x03\xf6\x82\xe7\xd7\x02D\x91\xe09\x15<p/\xa2\xda\xce\xd2\b\x87\x06\"\xbb\x06\xad\x05ӯ\xe4\x1a\xb0\x0e\x04\x16\x86\xaa\x8d\x9e\x1atf\xbb\x06\x91\x8f\xc0\r\xec\x81\a\xfb+\xb4\xb9\xad\xbd\x1b\xd8#\xff\b\xdd\xe8\xff\xbf\xd1=\x0f\xfa~\xc1\x03\aڴ\x82|\xd0\xf9\xfd\xbd\xe7\x8d\xf4W\f\xea\xc1\xf5Y\xb8\x86p\x06\xd7'\xbc\x86x\xe6\xf2-\xa31\xd6\xddb5\xb62\xb9\a^\xe2AX\x84K\xc0\x0e\xcc\xff\aaQ\x15\xbc\xa45hKV\xe1K8\x88\x8b`\tڡ\xf9\xff .ڃ/i\r\xb1QCZ}\xcb\xd8y3F[P6\x82\xd2\x05\x94\x0e\xa0D[\x18\xc0@X\xa3\x0e\x94\x9d\x8d\xa0o\x84\xb0\xc6\xcen\xe3\xe4E\x98\xe2Z\x98A\x0fG\xf8\xdc\\]f\x9ex7\xb9u\xc5B5\xda5\x9a\x95߭0H\xe6\v\v33\v\x846\xedQ\x0fw5\xf6ml\xa4\x14/\xcc\xe0[\xadk\x96\b\x98I\x85\xa4\x87$\xef\x12\x14\xdfKh%/$\x92w\xa5\x87\x84\xa8wQc\x18\xc1\xa4$\xa1\x8d?\x99д\xfd\x90\x887\xe1\x02\xb1\xbb6\xeeP\x83\x1a\xc2(H\xe6\x05\xc5\xc303\a\x11\x9d\xa9\xc6m\x844\x8b\xfa\x0f\xcbQ\x96\x9e\xaf\x8550\x02(m\x17/\rݸ^T<\f+\v\x0e,\b\xe0\xb0\xdf\xc0\x11\xa8H\x12\xb3RSu)\xf0\x86\xc10j\xd1!\x97\xf3\x1b\xcd\xfd\xc3u\xbc@`\x8f/G\x03)\x18\x94d\x19S\xaf\xab˯\x17ͣ\xb5\x96\x16\x12\x85\xcdyǎ\xaa!\x93\x02\xfb\x81\xd0\x03\xd5\x11\x11\x19\xa9{\x04ŭ\xb0\xdb\x1bg\x1f\x9f\xc6yy\xc5\xef\xde\"*\xae\x855y\xad\xa9t\xe3,kw\xfa\x98\x95\xb0\xe57\x1d\x1d\xfch\x16\xdf\u007f\xfe@\xa9\xf8뙢\xf3\x99\xd1\x16X\xe6\xc6\xc5uKE\xd3\xe0Fi\x1f\xa5\x80g\x90̛\x01\x82\xbc\xaf\x11\xf6Q\xff\xd6R\xa9\x88\x8c\x88\xf4=\xc2Ly\x04\xb11\xd17p\x03g\x9aK\xfan\xaej\f\x15\xe1!\xdf\xfe\xe9g\x0fN\x1e\xdf\x13T/\xc0(\xf4&@OI\xff\xd3v_\xa3a0\xa5\xe84\xb36\x8bd̤\xb5S|D\x05<ˉ\x8d͊\x857\x8c<\x9f\xaa\xf7_[\xe4\xcd\xe1 $\x91\u0081\x10\xc6\x1b\xe6\x92`\xf5\xf3\x17\u007fW\x89O\xa4\xe1Dށ\x9c\x83\a\xd5R*\x05\x03\xb0\xe7?(&\t\x95\x14\xa8,I%U\xb1\xb1\x99鱂\xa2\xf3\xd97>\x93.\x8d\xe1\xecj\xcc3\xd8\xc1\x1dP\xcb\xf4\xd3Y\xee \xf3\xe9\x16\x80\x12\xb2\xcd\xe7\xde\xd8\b-\xa0T\\\xbe`\xfc\f\x95\xec͛\x85\xe5\xd7D\xf8\xe9\x19\x01\xe4\xc1\xaf\xff\x00Fm\x18\r?\xf1\xf2\x10\xd2DC\x12Q\xbc=8?\x98\xf3`\x06\xdeqx\xbdE<\x13E A\xfe̓\x0f\x0f\x03E(#S\x13t;\xb5j\xc5w1\x1f\xb7M|\xea+\xa4R\xfe\x85\xfe\xe5A\x82\xc3\xe9\xaf6\xfeŁ\xd2\xe0ɺR\vϯ\xb8\x1d+\xe0\x8f8\x8aH\x8e\x8dN\x8f斮\xbbx\xe3\xcc\xfe\x93'\xf7\x8a0\xf1\x11!\xed\xc0dvS\x88\xafo\xa4\x80oщHM\x88N\x8b\xe7\x16nh\xbd\xf3\xe4z\xfd\xb5\x03\"\x9c\x06\x15QPV\x91S\xc1Y.\x13\xc6\x18\x92\xd9\xfb$\xda\f!\xb6\xef\x8c\xf2\xf3U\xa3\a\x85\xb1\x03\t\xb9\x91\x9c\xbb\xea\xd3\a\xa2\xe2\xbb\v\r\x8c`P\xde\"\xe5:XOxT\x05\xb6\xb6\xa9\x1f|\xf6i{Eyzj\xb9\xa0\xf8.F\x02\r\xd2\xech\x12n\xc2@\xe2R]\xd3\xe5\xabj\xc5w\x17\xda\xd7^\x9fW,(._h>P{\xea\xac\x1a\xfc\x82ЏZ\xb6\xceu鲵\xe7n\bp\x81j(=\\W#\x80\x06\xfa\x11p\x9dl\xe4E\xd3hj\x85\xef\xdaU\xb1\x02\xfe4\x81\x88[瞲\x86\xeb\x0eERJʷ\x96{My\x9d\x87\x1d<Ę\xcd\xf2x\xa8\xe6\xbbV\x93\xfbx1\x88ZT\xb7\xf8\xd4Z\x01U\xc5`3\x04\xec9\xc3l\xd2\x1b\x95\x84<)\x99\x0f\x82\nF\x90N\x93p\x02?$p\x9e\xa5\xa6$\xe1;\x06\x80\x82\xfe>\xd0w\xc6_\x82|\xdfPK\xdc\xf1\x9c~b\ng(\xd2\x18\xda̎\x8c\xac|\xcc\xc0:\xeahxSP\xa5\x80\xed0\x96\xc8KL\xc8N\xe0\xe4\xcf\x1e3\x8dT\x18/H\xcbɖc\aN\x94\x8a\xb7\xc0\x95\xc8\xd1g\x17\x17\xa9]\x99ѤII.w;\xfd\xb9VL\xabʪ\xaeR\x9fd\f\x1d\x8d\xa6\x0e\xd2{\xcb\x0e\x9f8\xd1\xfft\xe6\xb16\xf5IƸ\x9al9\x1e\x11T/J\xed\xa6Z\xe2\xda䦡C\xd5\xddg\xba\x9c1\xcc\x05\xa5\xa7Y3%\x83RC\xf4\x01\xa5\xe2[ç\xc02\x97\xcen]W\"\xc2$\xd3\f\x02\x14$\f\x91^\x13\x17K\xce\x1e\xbd\xc4\xddj]\xbb4!31#QD\x95|\x86\xd8O*^\xbf\x96>\".\x94\x9c=q\x99\xbbqi\xdd\xd2\xc4\xccČ$Q\xf1-\xd2\xf2\xaeؕE+cV\xda\x0f\x93\x87\x10^\xda\xcd\xdb6q\xa6\xc5\xe4Ν\xfa\xcaݢ\x9c+\xf5&\x02\xf6\xefn9\xadn\xff\xec\x93\xfb\x82\xe2\xf5\xc1\x03\x89Q\xb5\"\\\xc3(\x02\"\xa8Bd\xa5\x03\t\xa7\x85\f}FQ\x91\xfa\xc6\xf9\xb6[\x97\xcd#\x12\x80\x96É\xfd$\x8e4\xd8\x12f7Z\xaf\xbey\xae\xed\xb6`\xb0\xd7\x18\xb4\xec\x9f?\xb4\xbf\xd9'\xfe.\ry\xa7j\xeat\xb5y\xb5\x02\xd0R\b\x91W\x95]U\xa5\x86>\x8e\u007f`\xefR\x01\x88\xdd#\xbfF\x9aC\x1b\x87\x99h\x15\"N\xc2\xdf\aK\xce\x04X\x858<A\x1b\x0e\xdf\x1b1\x1e\xe9=\"\xf6\x8e\x1d\xfb!\xf6V\xefɉȍ\x10\xa0\xb7\xac'\xaeM]\xf9\xd9 N\xf1-\x8aH#\x81\x03&\xb6L\xbf\xb3N\x94r\xe5>D\xe0\x99+\x91\x9fp\x86\xc5\xe4;\xcd\xd5h\x96\xacr*(\xe1\xadYw\xe9\x1b\x83,\x9a\v]-\x1a\xcc\x15\x94\x8a\x97\x95\x8a\x97\xc1\x06%\xb0L\xc7'K\xe7\x88\xf2\xdd\xc6N\xaa\xc1r,\x8ci\xbc\xf5\xaf>^F)\xeeT6\x92\x9e\xcc'-\x9e\xee\x02n\xa3\x16'-\x8ev\x11>\x96m\xdfż\x98|\xde)2ţJx\xc8+~\xaa\xbc\u007f\xf5\xf3\xf6S'\xc3\x03\xf7\t\xe0\x80\x1b\tIIIz\xaa[\x9f{0\xa2|\x86\xaa\xf3\xdbX\xb2\x81\xc3\x01H\"\x8d\x03\xcc\xeaʇ|\xf1ŵg5\xe2O\x92-\xa1x\x19\\\xf5H\xff\xe4\xb1Z\xf1S%l\xa3\xa0ψ\xb7\xd8oO\xa4Y]\u007f\xb2qa\xe3\x1c.  96X<\xb3~پŜ6!3S+\xc2N\n\x06!\x01\xbd\xf0C\xa1۟\n\x92\x94\x8d$\xe8e%\x1bD*\x1eUJ\xcaF\xf3yt\x03$%\xa9\xf8\xa9\xb2ђ\xe4\xadŬ\xd2\xc2\x1a!\xbb[Ʌ\x99U\xdea\xb3\xdeW\xee8\xb8\x99W@\x98\x02\xc34\x04m|\x9fU\xc0ۧ\xbe\x13\xdb>梣3ңE(\xa3r\x19\x0fF@\x82\x8a:C\xbc\xde\xe2pg \xe7\xc1\x04\xe7\a\x17o\x17!\xc9D\x13\xf2\x10*\x9c\x11\f\xa3I`\xfe\xf8\x1aȃ\xe23\xf8\x89(\xbfVx\xf3\xa6\x1a\xe6Q\n\f\xfbk\xe3W\x0e\xa7\x85\x8a\xc0-\x05[8\x05\x04cp\x01kVR\xd1\xe9\xd1ɱ\"\x8e\xc2\x1f\x89۱+\xce/\xe4\\\xc9\a\xf0\xf1#\x98H\x9c\xdc{r\xff\x19\x0eB\x90c\xef\xb4nX\x18\x9f\x16\x9d\x9a \xa2\x13\xbe%|#}C6YtcENEA\x99\b*8M\\;P\u007f\xfd\t\xa7\xc1P@\xd6<ô\xb5\x06zT\t\xb0^\xae#n\x19\x94d\x03\xd3\xc8\vr#5\x10c\t\xf4 \x15\xf8\xca\xd7/j\xfbNq\b\xda\x10\xf7)\xcc2\bDjyzy\x85\xdaxL\x83\x03X\xd3\xe8n\x15\t\xd7)\xe8\a\x1a\xa2\xae\xe6pi\x03\a\x17\xc8\x1b\xe7\xd6.[\xea\xban\x99\x88~A\xa4\x02ނ\x1fy\xf6Tm\xf3\x01q^\xf1\xf5\xb5\xed\xdc\xd5\xcbM\x97\xeaD\x18\b7\x89\xd1Ԛ\x14\xf7\xb8u\xc2\x04\xfc\x89P\xe0\xabU\xb1k}Wpv\xb0\xb1&\xdc\xf0\f\x16Z\xc3S\xf0\xb01\xfcf\fc\xf7ƔE\t\xa6##\x19\xecIEy1\x02\\4\xb5A\x19\x03\x1cYYV\xcb\x1b\xda\xe0\x12UY^V)\x1a\xeb\xa0\x17[\x91\x19[\x1a\xc5\xe1@2N\x9b\xa4M\x151D\xaeL\x8eN\x89L\x8e\xb2O\xa9Lޛ\\\x81;\xa5JU\xb1%\xd4\b\a\x91ѱqљ\xe2\xf4.7\"1')?Y\xe8^\xb2\x1b8\xb2\xa2\xac\xb4\"K<+}\x0f+\xe4\x99D|~qr\t\a\xbd\xc8\xd2ₒ<\x11VJ\xd3\xcf\xc9ψ謲\xb8\n\x0eԤ\xbe\x10\xc61\xf9\x059\x85\x99zK\xa2#p\x92\xfe\x84Ep\x04\x16B\x18\\c$%x@\x1bl\x90\xec\xc1\xc9\xc6x\xc0\xf0\x88\x1d\xb3n\xcd8\x01'\xc0\x11\xe8M\xde?\x98\xac\xad\x16O\xee\xae\xf6Y\xa9\xbe\x8c\xc7\xd9\xf4\xfc\xf4\xbc\xf4<\xfaU\xeb\xf5\xbf\xa0\xb7\x1a4\xf3\xc0\x06\x89SB\xb9\xdf\x06\xfd \x0e\xcfw\u007fr\x06\xea\xa9\f\xfc\t\x1fS\xe0.[\x13ڼ\xe2\x94\x12\x0e>\"\x8b\x8b\xf3\x8b\xf2\xc4|\xbd\xae$\xb5\x88\x96\x87il\x8f\u007f\xcf\x1b\x95\xa60\xf6\xdf\xe4:\x96\xd1i\x886\xb8Ƙ\xf7N6\xd2\x1fp\x95\x85\x1fy\x18\x06C`\x1dм\xeb\xd5I\xe0\xc6\x14e\x14\xa5\xe4&\x9e\x9d\xac*\xd0f\xc4$%\xd0iic\x87\xe1$\xce\xc0\xf47\xd3\x0e\x87!\xb0\x16h\xde\xed\xeaDpc\x8a3\xf4)yf\xda\xfc\xffЎ\x1b\x86\x939\xfc\xc9\xf8\xd9\xf7<8u\xbf\x96\xb3\x83\xc3A\x1a\xc2\xd9\xfa\x96\x86\xd2\xda\x18\xc2\fa\xac\x83\x03\x9c\u0093\xe8\x86nr\x98d\xd9\x1e\xce\xe9\xec\xc4i0\xcd\xe4dr28\x99\x9c\xa0\x89!\xc0\xd5\xe8\xca\xcaJ\xf8\x9e\x91\x95\x9d\x9dx\nN\x82\x1b\xb8Ia\xb2e\x9b\xf3\xd0\xc1\x01\xa6\xe14\x83\x93\xc1\x89\xf8\xcf:\xdb\x06oX\xcc\xca\x1e\x92e\x03\x05*\x9d\x9c|Ob#6B#6vv\xa2+\xac~\xf1\x82\xd0Q\xe6*\xf1=V\xb0\xa8\x00\xc5\xf7/N\xfa\x82\x85\x02\x1a\x1d\x1c\xc0\x15W\x13:j\xdc8BG\x99!P\x0f\xf5\x92\x87\xecA@E\x1a{\x80\x99\xc0\bv\xd2\xe1\xad`\xb32\x1c\xaa\x9e\xbd\x86察}\n\x91\x1f\x82-\xf4\xf8\xb5_\x1e\xd8\xc2\xdc\xe7\xcf`9\x90\xcf`\x15\xd8\xea\xc0\x16\\\x9e=\x83\x15@<\a\x17\xb0U\x1c\xf9\xaa\xcd JG\xd9Kާ֮\xf1\xf6^\xbb\xe6\x94\xf7\xe5K\xa7N]\x164\xb6\x89Y\xece\xef\x965k\xbc}֘\xa1\x97\xcdЮ}\x99\xec\x00\xa7\xf1\x03\a\x8c\u007f\xfe\xcf\xdfϿ\xff\xe7\xef\xef\x9d\x06\n\x1aۄ,v\xc0xx\xc0?w\x1a(t\xb5e\xb2\xe0\x88}\xa0/\x8e\xc2Q\xd8\x17\xfb\xa2#\x8e\x82\xbe\xd8\x17F\xc1(\xe8\x03}aT7w\x18\x85}\xa1\x0f\x8eBGK\x9e\xb8Q\x16\xaa>\xe0((lh+\x18\x05}\xa1\x0f\x8c\x12\x15)Z\xab\xae\xb5\xe9,\x16\xa2/\xfab\xa1\xa0p\xb6\xd2b\x01\xfa\x82/\x14B!\x98\xcb\x02siF\x8a\x86\xd1R;\x8b7\x12Q\x87\xf9xK\r\xed\xf07\x8f\xedY\xc2\x03\x98\x04^'\xc0\x9bS$[9\x837,\xf3\x9b\x03\x93E)Tn73\xf61\xb75w`\xe6)(\x12ӝ\xdf16w\xe0\xd3\xddk\xa2\xad\x15VI7Y\xf8\x00\xd7b\xf5S\xfc\x9c\xc3\xcf\xf1\xf8d\xfc\x00\u05ca\xb25ym\xde\x19\xc7\xe2\xc5\xf4\xfc\x8f|\x90\xc6~jl\x87\"\xd4\xc3}X\b\xe3\x9fB\xff\xb7\x16\xa6\xa7\xd7=\x18Z\xb6\x9d~\n\xbd\xcf_թ\x91}\vN\x93a!\a\xf7\xa1\b\xf4\xd0\x0e\xfd|\x80\x9e\xff\xab\xa8\x98\x14\xaa-\xbe}\xe6\x97k\xed\xb4\x9d\x1c\x0f\xfba\xe7\xef'S!JZ\rQ\xfd`\x19DK\xab Z1͠4\xf4d!ǒZ>ǒ\xac~\x13\xe6X\x92\xd5o\xc2\\\xcc\x01/\xdc\x049\xa2a\xb2-\xf4~3\f\xfb`\xefað7\xf6\x1e\xf6\x06zC\x9f7o\xa0\xb7\xa8\xf8F\xaa6\xeddC.\xcd=\xed8\x8b֡b(z\xa37*~\xd7\t\x8e\x8f\xe6z\x85\xac\xdd4\xe6\xa2\x17\x90\xb3^-\x05u\xd8\xf3\xc49sTC\x86\x8e\x1c\xb1`\x81\x8f\x8fnQ\x8boI\x9c\xea\xa4\xef\x1d]\xcbI\xa8\x86\x1a\xd5\x1fC~\x1bqwA\x8b\x05PR\xaa:yRwǷ\x05\xab\xa1Z5\xfb\xe1\xf7zP\xd5w^\xb7\a\xf2\xd1\xc5ӛ^\xd2vތ\xd4\xf8ʠ\x01\xe5\x13\xde8θ\x82}1\xf7\xc1\xb8qs\xe7\x8e\x1b\xf7`\xee\x8b\x17\x0f\x1e\xbc\x10 \x10\xff\x8fmt\xd9\x1a<\xc5}\xfe&Ǩ\xa5)\x97ר`5\xae:\xb4]\xb7~\xfbv\xfb\xc4\xc4\xed\xdb\xd7\xeb\xb6\x1f\xb2\xf7h\x9d\xd7>\xa7\x03W\xe3jU7$Q\xaf\xda~Hw\xfeС\v\xad\xed\xed\x0f\x1f^\xba\xacZ\x96\xefP9\xff̔\xb6\xad\r\x8d\xb7\xf6\x1e\xbd\xd4pם\xd6\xfd8\a\x86\xc2\xd0\x1f\x1fꄻm\x97\x82\xf7n\xa5=\xe0\xffX\\\x80Cp\b.0\x970\x04\x16\xc0\x02\xf8\xb74\xc3\x05i\x05L\x1a\xc0\xafE\xe5\x12\xb3\x03\xf2\x13\xfe\xa4!\xcf\xe2\x0f\xf0\x83\xa2Fq_C\xf2\x96ȧxr\xc2ܹ\x13D\xb9\x82\x92C%\x85\xdcO\xda%\xed\x94\x14R?)T\x90*\xa8\xe7\vڝ\x04\x93k\xd7\x05v۶\x92\x9apQ\x1e!\xa9\x88\xb0ڸ\x86F\xf5\xed\xb6\xb6ۇ\x0fŇ\xd7\n\xf0\x04o\xc2\x13\xb8I\xd4\xd4\x1e*i\xe0n\xb7\xb9/\x0e\x8a\r\x0e\xdb-\xca\xc3e\x15\x11\xbe{[\xfc6\xceN:\xac!\x9c.x^\xec\xa7\xd3\xf4\xb4Rx)j4\xc4\xe3\xc1\xac\xee\x82N'\xc0ri&4\x1b\x06\xbf\x04\xbf\x13/\xae_\xf9='\xeb\x8cJᕙ-{\xe5\x12Q\xba\x888\xf5\x89\xcc7\x91\r\x93\xe4\xa5]٪\xf0\x8b\xe8-\xad\x0f\xfb\f\x02\xa4e\xaa3\xf7\xbe;\xfdM\x0e}\x81\xf2\xca\xc4d\x1d:ef\xc6g\x85f\xc6A\xdf\xf4sۃfъ\x1aO$\x06\xe1\xd6\b\xbf%\xa0\xf2\xfe}\x01\f\x0e\xbesw\u007f{6}\x9e\x92\x8b\xb2\b\x85W\xb8..Z\xfd \xf3הf\x97K\x8e0^\xeesq\xc8\x12|\x1fG\xe1\t\xb7\x01\xd3`6\x9e7̞\x06\v\x02\x1e\xc3\xfbg\xee\xe7Ҋ\x9a\x8b\xf2\xd7TL\xd6.\xadZ\xe1\xf5K\x16\xf08\xba\xc5\x01\xa6\xcb\x03`\x9e\xac\x81\xe9\xb2Hg\xb6efe\t`\x03\x11\xc0B\x12\xf4\x84Hh5\xb9\xa8\xcc\xd3F\xa7!\x02\xec\f\x83\"\xdevZ\xeb:\r;\xc0\xce\x10\xdfic\\d\xf8\x80m\x0e\xae\v\xf2\x0f\r\x0e\fh\bmj\xaekh\x12\xcaW\xb2\xda=\xb2\x8b<b\x83\x17\xfc!}\xa1*\xa9\x91\x16K\xc3Ο\xa1\xe1\xa2\\ǞO=\x9fxNX\x15G\xa4f&\xe4\x16\xa8\v\xb3\xf2\xf5\xd9\xc2\xd5\x12\x1f\x98=\x10\x1cq\xf8\x1b\xfb\xf8\xe4\x9dkb\xb9\xe4\xd4\xfc\x82\xc2\xc6\xf3\x05E\xe2\x03$\xf1\x83\xe3\xa8\xe6t\xd4ƍ\xa9I\x9b\xc4\xf2EDJVZB2\x97\x9c\x92]\x98!\xc6\xdcŹ\xbe\xf8\xf1\x80!8¾$\xa9mg\xac:9%59ɳ)?A\x98\x8b\xc4fT\xa1Z\xbd>o\x83~\x83p\xaf\x9c\xc8\xcf*JKV'g\xa4$e\b\xf3cN\xe1<\xb0Ə\xc1a\x98}\x89\xfe\xf0\xb52\xae ?%9i\xb3kJ\x82\xb8\x00l\x81\xdd\x02\x1fp\x17.\xe4\x15\xb5\x8a\xd1\xf7\x88\xbc\xccܢ\x02\xae0?31K\xacX\b\xb3O\x83#X\xbd\x86\x91\xf6\xf1\x05\xeb\xebK\xd5\x05\xf9\xf9\x05\x85\xa7\xb6\xa5\x16\t\xf7\xc0\xe6(\xb0\xf0\x81\xfaY\u007fy\x19\x1a\xd1(/3\x97`\x94\x96I\xcb\xe0\xdf\xd2\f\x17:\xfbw\xcf'\xef&/c_\xa8\xe6\xb12\x13\x8b\x91Ag\f\x84C\xf24\xd5}\xf0\x80\xf5\xc0\xc0\x96xC\x94\x9fj&\x8c\xc4\xf7\xe0\x03\x1c\xf3#\x0ez\x8a\xa3O\xa0c&m\x8a\x97G\xb0\x1f\xe3@7\x9c\x8e\x8bԲ+|#SҬE\x02DIk\xd0\xcf\x0f7\x00\xf9\x11,\xb7Ǘ\x06\aBC\x8e\xc5鬜\x1d\x02<:ec4R8\xcf^\x1ea\xb2\x81i\x890\xeb\x18\x8c\xf9\xd1^RA\x18\x8c\x91\x02q\x8c4\x9c6}\x11\xc1\xe2T\xe4\x90é8\x158\xe4`*L\x05\x0e,%r\xc0\xe1T\x8be\xa1!oȧ\xe0\xfd~ƞ\x8d\xd2\xee\xdf\x14\xc7\x14;\x8d\x1c\xeaY\xa9@b\xa5U\x92s\x8a\x98\"9\xe3V\xa8\xc1\u05ff\xc3\x12\x1c\xf1'\x16D`\xd8\xe6\xdf\xe7\x9c\xfb\xf0\xec\x8b\xd9_`\xc98\x9c=\x0e\xe7\xe0\xef\xe8\x8e\xc5\x10\b3b\xde~\xf2\x15\xf4\xa5\u007fN,\xbb\xa2S+\x8e\x15\x9f\rh\x1d\x9f男i\n\xf6\tG\xdb=h\xf5\xfbY A\\\xa8\x8a\x81\x95\x04(\xea\xce\xdf֩\x8f^̹\x1eY\xb4\"\x17m\xb3f\xd7i\u007f\x00\x15\xcc\xf9\xb4\x15G\xa6\xe3\xf2I+\xd1&\xaan@jld1\x1cQ\xe1\x11,\xc0~\xf2C\x18\x8bH\xe3\x13\x18\b\xd70\\\xea\xcfY,$FC|\x1d\xf1\x050y\xbc\x86x\xa4!4,\x8e\x85\x81\xe8\xf7\x1c\xf7dљ\x99\xbaLNN\x92\x87\xc8C\xe4$y\xb7d'k\xa4\x1d\xbb\xc5̲r]\x19\a\x91\xb0\xe59\f\x82\xb1\xe2\xbcA\xd8\xdb\x17?\xe00\x00W\x805LB\x95\xe8\x00\xbd\x16\u008cTp\xa7u\xbaS:!\x0f\xdc\xef\u008cN\xe8E\xc3$P\x81\x99*\x80\x83\x0f\xa0\xb7Ｗ\"\\6\x8df\xa1\x04{@,΅I©\xf93\xeb\xa6p\x1f\x8f\xdf\xe6>S\x84M\xce0\x02\x8b\xf0s5^ÑX\x84\x9bf\tnO\xbe\xdf\xf6\x13\xf7\xd5\xe3ږ{\"̇I`\vqP\xa6\xb6\xab\xc1\xe4\x9b\x06'\xd8i\xe8\x1d\xf1\xf2\x8d\xb5\x0e\x94\x1ar\xca\x19\x9bx^\xeadA\xe3\x0f\xdc\"xO8\xfe\xfc\xd8_\x9djpA\xf1\x06N@\r\xceZ\xbe\n\xfb\bY11\xba\x18N~\x0f\xbf\xc2\x12\xb9\xc7D\x11\xac\xc0\x0e\xa6\xc0\x14p\xbc\x83\xabp:N\xc0@썡\xdb\xdd]\x90\x89^I\xef\x1a\x1b1j\x94\x1a\x9d\xee\x0f\x86i\xd0\x17\xc6|\xfe)\xd8\nn\xd0\x03zo\x87\xde\x1c\xfc\f\x9fA\x10<\x02R\x94\xa7\x92H,ľ.؟CW\x9c\x03\x83\xc1S\xd4\xd8ƓyG\xb2\x9b\x9b\xd5\xf3\fJv^\xc8\xd4]\x8b\x84\x8fa8\x8a@ㄛ\xf4\xd1ǿ\x1e\xf9\x8e\x83\x1e_\xcfG[Qc\xf8\\\x83\x0e,X]\x06\xe5\xb9N\xe1\x06.\x80~\xe8\b\x13\x1dh\xaf\xe9\xc3}\xc7rHο\t\xb4(e\x196\xb0\xe0V\x00\xd3A\x84\x15\x1c,\x87\x19(\xa2[\x81h\n&\xed\xe00|\xc0C\x90孞\x0f\xa3\xd4\xc4\xf3\xd0\xd2\xccC6ct\xa5*\xcb\xcb+\x05\r\xe9\f\x1e\f\xe42]\xaey\x8c!\f\x95\xf8v\"o\xe8 G3\xf8\x16\x94\x860\xf8\x80\xa9\xf1\xe4k_jH\xd5o6 \x00˸\xacY\xe3\"j\x8c?S.\x97\xd7\xdc\x12`\x1f\x89\x1f\xc2\x18\x1f\xd8\xee\x01\x91ˡy\t\x94\xc3ǐt\xecꩋ\xe7O\xd3ƴ\xfe\x1d\xb3\xaf;\x9cCF\xf6\x1f\xa6Z\xfbz\xfa\xb7\xe8\x1841r\xb5\xe7\xae5\xdb\\b\xe6t`\xe7C\xfc\xf5\xf4\xf2+\x1b\xceln\x8fx\xb6\x17>j<\xfeO>\xd8H\x01\xbf\xab\x8a1\xdd\x19W\xa1\n?\xa0a>y\xeb\xf2\xe5[\xa2\x86PQ\xb7\xd6\\v\x11\xecP\xff\x16l\xc1jq8l\x01+)\x06l\xa0\x1fX\xfd\xf3\xe7S\xe8ѯ\xec/\x18\xf1\xd7\xf7\xd0C\x8a\x05\x1b\xe8\r֩\xa5\x8a\xcb{4D\xa4\x96-\xd4\x17T\x045\xd1:*\xaeD[\x98\x98\x9d\x90\x93\x94\x91\x9c\x9c\x92\xa2Z\xbcx\xe6ډ[\xe9-\xe3F\xed\xf9\x88s\x18Uu\xccI\x9cpt\xe6\xa5ŷ\x17\xdc[\xfb$\xf0i\xd0W\t\xd7Ҫ\xe9l]Vf\x96\x90V\x17\x9e\x1d\x9c\x1a\x97ᗒ\xbb\x83\xd6Q\xfa\xc5\xd5K\xf6-k\x19\xf9\xb9\xd3\xe3Y\x8f\x9c_\xacx\xe5C{\xbf\xf9g7\xf4\xe0\xa0\xc7ߵ\xa7~\x17_\xb5|\xff\xf9\xe3Ǵ.77;[\x90\x83%d\xcb\xca\xe3*\xe3K\xf6TE\xe6i\xb5\x8b\x17\xab\x16\xdf!r\xd2ҲS\xb9Ą\x94\xf8x1\"\"5\xb6X{g\x91j\xd1b\"5%=5E\x1d_\xac-H\x11\xb23\bmqq\xaa\x9e+\xcc\xcb\xc9\xce\x16\xf3\xf2\xae^\xcd˻\xb1L\x95\x9c\x9f\x9f^\xc0\x15\x15\xe7\x17\x17\x8b\a\x0eĕ'\x17,\xb9\xa9\xbas\x87\x90\x82V\xb1_G?\xd9\xd6!lk\xf7\xbe\xe1v\xc5\xedʒS\xf3\x0e\xd3\rsf\x94O\xe3\xa6N\x8d\xde6W\\\x15:r\xe9\xea\x15t\xa6\x14a\xb8L\x1eֵ\x94\x95\xeaNm\x13\xbb\xac\xc9\xd0؈H\xd14\x8d\xda} f_\x8apQ[\\QR\x99\x9d\xad\xaa:\xf0\xe9\xe7YY\xf4˗\xf0\xfeE`9`\xa1\u05fa1/\xc5\x1fG_\xc4^\xa8T\xa3b\x1d\xbe?f\x8c\xa0\xb8\xfcv\xecX\xec\xb3\x16\x95\x9cӤ\xba3sDi\f\xf5I\xfd\xb5\x93g\x85\xcb\xe7n\x1e\xbc\xcfݽ\xb1k\xc39\xf1\xdc\xc6\xfd\xcb\x16\xabQ@k\xb4BQ\b\xf1(\xc9\xe0v\x14\xc6oN\x8b\x8b\xf1\xa8K\xc8ˠ\xe3\xce\x1cJ?\xc1\xc1\x1ap\x82q\xb0\x16\xdcq\x1c\x8cŵ\x0ec\xb6,Z(.^\xe2?!c\"\x9dA%\xe80k\x8a:\x93\xdcڲ\xe5F\xe8\xad\xc4}\xbbӶ'Ƨ\xf9\xc6\xebw\xd0Yd\x86~W~\x9cphީ\xa5Wܮ\xb8\xdd\xf4n\xdfF\a?\xfc\"\xe6+\xee\xab/\xca\x1b\x1e\x8a\xed\x87o\x9e\xbar\xe5ʕS7\x0e\xb7Ӈ\x1fV|\xf1\x95:X\xfa\x92]p۹\xf5\xe3\x13\xf4\xa9a\x03jњ\x1b\x84\xb6\xe1['\x8b\x89\xe9\xa9\xc9\x19\t\x99\x899q\xf9\xd1yqe\x01\xfb\x8b\xe8\xc6\xef~\xae|\xc6u\xfe\x1c\xb9\xf9{\xb1}\xfbm\xef\x87K\xe9Լ\xbc\x8c|\xae\xac\xb4\xac\xa4DT|\xb7\xa7\xaa\xb6t\xef\x9e\xcan?\xf9#H\xbc\x1b\x01\x05\x12\x0fK\xe10d\xf6\x83\xbf\xe0\x17x\t/\xe0\xees\xc5w\x1a\xb2\xf75\xd6\x14\x81\u007f\xca\x1b\xe1\x1c\x9e\x83\xf9\x91%\xc1\xb1\a\x82id~\x83\x0e<!M\xe0\xa4\t\x90\r{N6\x88\r'\xc3\xea\xb6\xc5\xd0\xe0\v\x14\x1b\xb1\u007fh\xee\x92\xe4%I\x0e~\xfa0Zq97Q\x9f{:\xf7L.\xd8U\xec\x0f\xdc\x1f\xb0\x18\x9d\xe2C\xe2C*\x92k\xf7Ҋ\xefj*\x8f\x9f\xd9\u007f\x93V\\\xde\u007f\xab\xfcEL5-\v\xd2rVzN\xfd>\x02\xf6\xe0_\xf8\x16\x97\xe3N\\'\xc0\xf9\x9d\xc4m\xf0\x80\x8ft:5|\x94\x80\xbdw\x8a\xa8\xa0pM\xb2\x1c\xe1*\u007f\xa4V|'/\x96.F\xc2\"8*\x14h\v\xe3\x8b\x12h\xdd\xddy?L\x14 \x02\xc2\b\x1d\xb2\xbfC\x0f\xb5\xc9)\x9c\x85\x95\xb0\xf18p\xb9\x02N\xa0\xb0\x03_\xb6\xc2&\xb5\x1dx\xf3\x92\xab%\xd6\xc5\t\xde^\xe7\xff\xf3\xb9\f(\xe1>\xb3\x84\x81f\x1e\x95\x9dd\b\xefr\xd6\xec\x00\x83h>@%\xb5\x86\xdf\xca\x04\xf3\t\fu\xc8\ff!\x83\x1f\xc6\v\xd9|\xd9^Ѥ\x87\x96\xff\xad\xefa4\xe8\xccj\x8cZ\xa3~+C\x9a\x94\xb2+\va˻?w\xf8\u007f?\x00\xfb\xef`\xa2\xf8=\x8c䴊\x97;&\xf2R\xc7T\xa6K/\x87\rb\xc0\x9b7\xea\xa3xc'8\xb0%%\xba\xea\xf8R\\\x01+\xccs\xd7\nK\x02\xb4\x12\xfb\r\xe7\xe2-9\xd0\xecq\x85y\x83\x15\xa0``%\xae\xc0\x95\xb8\x02\xe2xX\x01+a\x05\xae,\x8d\xaf֕\x94؟;Gt\x05a㿌5\xc4\a\xd2\xfd\xee\\j%\x1bΩ\xbaY\xc9+\xe4\x15*\xdc\t;ͬZ\xb0\xc5ܶ\xb4\xc4^\xd2KzB\x83\x01\xa6\x15\x83\x98w\xb6\x93\xe4\n\xd5<\x04\xf3\x1a\x83ցאڏx\x8d\xad\x16\xb4\xbc\xd4\x1b\xb4\xbc\xb1w0cg\x8a\xd7\x10^p\x8d\x810\xd0\xdbHzɕ\xdd\xe4\x95W\xe4#\x9a>\x8ce\f\x1fR\t>^\xa9\x9b8c\x87\xed\xcf<\x1e\x85H\x8c\x84\xa30\x95\x87\x00F\xc40[\x1c\x87,\xb28\x0e.\xf2\xe0\x0eca,\xb8/e\x862v5\xbf\xf0\x06=\xe8m4d\xbb\xc6\xf6\t\x8b\x03a \xb17rW\xd1.N\xd6\xdf\"\x1d\x19\xe0y\xd1E\xee\xa0\xc60\"\xea\x1dxpb\xf6\xed+ڻW\x84\x810\x10Dƾks\x97\x03+\xebc\x19IOI\x1d\x92++w\x80+\xea\xd1U֓\xbbv%DF\x8a8\x10\aB/\xc6\x1e\xcc\xe5,^C8\xc3P\xc6\x0e\x0eWX\xbe\x8e\x94ZnI\x1d\xb7\xa4\x16K\n\x15K\xb2\xcdȒ\xdd\xd9\x11I\xb2\x8c\x99*Y\x86\xcc\xe8\x82h]t\xb2\xbdI\x89z\"41:4D\xad1j\xa9\xf0\xe2\x88\xcax!\xb9\xb8$\xad\x94+,\xcc\xca-\x14%\x192%\x193+\x92+t\x15\x05\xf6\x06%\xe8\x89z}E\xfd~\xf5V\x86\xaa\xd1VG\x95\b\x1a\xa3\x8c\x1c+u`\x14\xa1A\x86\x92; \x8a\x90\xdf\x1a\x8f\xb3R\vl\xeb\x06\xb5\xe06\"\x9e\a/\xd6r\xa4\xb1\xfd\x87\xb2\xe0\xfec\xdfJz\xa8\xe6\xcf0\x1a\xdbg\xfd\xcf0]o\xfb/\xe0E\x8dљ\x8a/\xd0\x16\xa7\b\x92\x1e\xf5DFn^f\x1e7\x9d\xd7\x10mTIr\xb16_\x90\xf5\xa0'\xb2\xd3R\xb3R9\xbb\x9a\x9apk\x83\x12\x9e2\x06\xa5Aɂ+\xf3\xee\xef\x1fy\b\x9c\x90\x93̆\x19\x9e\xc0\u007fp\r\xd8\xe1lX\x8d=\x85\x10\xdc\xea\xbc~Ɋxm|Bn4\r6LnL\xb9\xb6\"\xfbpΡ\xbc\x03y\xe0|\fF|\t$\x90t\xfc\xdd\a)\x1d\x9c\x94$\r\x81\x13\xf0\x8fhW\xd3\xfd\xe9\x90~3o\xdd\x1d\x05s\x95\a\xa5\xf2\x99\xa5ߙ\xbc\xa4\xec\xffn'\xeb%}1\x03\u007f\xcbCज$\xe8(\xcb\x18N\xe2\xdf9\xbc)\x1eu\x1a\xf2\xb5\xb5\xceh\xbe\xdb\xe07V\xfe\x81\x92\xf4p\x81\x90^P\xb2\x1e[\tY\x0f\xad\xac\x8e\x92\xf4\xd8*\xeb\xd1\x02\x97\xf4\xc6\xf7XKE~\xf1_\xe2\x17\xb6\xd2;\xbc\xa5\x9d]\x8d\x86p\x8e\xb0~\xaa!\xd7\xdbh\xc8#\xc6\x1c\xb6\b\xfc\xa7\x815N\xcd\xc3\xf0\x02tD\xc5T,\xa7+O\x9dԟ\xe0\x9e\x1e\xdd0*QL]\xbdu\xf2\x02\xb4\xa2\xd1j\xfeZ\xf7\x00n\x93߷0e\x8b\x18\xdf\x1a\x06}\x83a\xfd6X:\xe39*\xe2\xddR\xa7\xac\x1f\xe88m\xfe\xb49S\xe7~1Bu\xa9\xe3Ծ\x12\xeej\x9b\x13\xcei\x15\xcf\xe0x˟\x13\x8dsn\u007f=\xeeW\x0eF\xc12\x18\f'a\x97\b\x81p\bl`[\x01\x8c\xa5\xedP\t=5\xb6\xf7a\xf9\x13\x1e\xf6\xf3\xf2\x9a\xae7,\xb6\xe3\xa7\xd8\x0e\x9fB\x88\xe1=\f\x81\x8b\x84\xc1\x8e\xc4\x10\xbcH\x98\x89\xc3\f\xcaW#a%\x94X\xf2ڴ@\v\xbc\u007f¬\f\xa1Eq\x19\xf6\xf3&\x1a;\xd8_ɶN\xa2\x91ls ~%K\x02\x9d'\x11\x8a\xef\x1a\x90ܵk\xc0\xa7\x03V\xed\xda\xd7\x00\xa4\xf3\xb7\x04\x16b\u007fvIF\xc0rq*\x95\x14\x9f\\$@4\xb9\x19\x95\x04\xae\x96? #\x92\xd3\xf7f\b0\"\x96\x18/\xbb\xbe\xa0\x14\x97;\n\x88\xbd\xd9y\xf9Uj\xa9\x1fU\x98\xa0K\x10f\x82\xb2\xf3!\t3~l\x87\xd9\xd0\xf3\xed\x0et\x14pv8q++\xba܇\x93\xfe2\xb5\xb3\xf8\xf69ٮ'\xf6f\x17\xe6V\xa9%\x92*K\xd0\xc7\xe5\n\x93j\xeb~'\xe0\xa0\\IF$fT\xa5\vW\x12\t\xbb\x1a\x1c\r\xab`\x12|\bKy\xc3|\x16z\x8c\xfd\x01{\xac^\x1b\xb6y\xab\x80\x9a_p\xf15ܐ\xff\x97\x14\xac\x82\xefg\x11\x93\xef.|\xfa\xedݻ\xdf>]xw\xd2ʵ\x01\x9e\xa1\x82L|\x13\x99\xbb'>-\xd2\x1eiy/\xaeOƅːs\xb4?\xb1em\xfd*\x0e{\x8c\x1d\x8b=\xa6\x9d[p7PLޖ\x16\x14\xa4\xc6\xfb`@\xbd\xe4\"-\x01\x03\xe8\xe1~SZcR\x83p\xbb\xe9\xcc\xdd/\xd5\x1ac\x1dz\xb2O\xef\xde}\xfa\xed݅\x93'-\\8i\xf2»\xdf\n\x86K\b\xecG\x1f\xa1K\xb2\x1b\xe7\x86.\x05\xbf~$Ɣ\xeb\xea\xca\xcb\xca\xca\xeate\xb1\xf4\xb2k\xc0\x86\xff\xc4\xfd\x04l\xcd\xf5kb7\xb0<F\xb7;&\x86N\aov\xd2»\xdf>5\x8f\xb9\x9b\xe7dѮFJ\x81\aRo\xf8\xb5\xdf}\r\x19\xae1zB\x87\xe2\x99\xe2\xfe}C$\v\x8e\xb0\v\xea`\xbbĔ\xd2:i\x80n\x8b\xdc[0\xf5&\xeb\x80;\xf3\xe5\xa7\x0f\xb1\x1e\xfb\xe1t\f\xc9\xc5\xe79X\f\xf3q/\x14\x8e\x80+HI\xfd\u007f\x80\x99\u007f\xd2\xd2\xfb\x94\xe2\x19\x9eE/\xd3\xe4\xe8Tɏ6\x1a`\x05\t\xe3v\xc0Й\xc0\v\xb0\x17\x87c>\f\xc6k\x9c\x9c\x93\x85G\xe5~k䱴\x9d%\x89\xd2\xfb\xdd\x11S\x8d\xb6:FC\xb5\xe5\xf3\xd2[\rXQ\x06\xa5\xacd%K̚e\xbd\x02\x16,K#\x90\x1a\xca\v\x9ay\x8d伅\xc10MO+\b\x03\x917[)\xb5\x06#\x84\x18\x86\xd4Z\xebj5\xc4\xd2}6\x1a\xf2}\xc3o,ΐ\a\xed\x83y\xa0!\x1f\xb65~\xf1\xc8\xeb\xf8l\x11\xc6\xcb߳X\x03\fV\x83\x1dVB?\x18\xf7\x03\x8c\xfb\t\xc6\u007f\xff\xba\xed\x8f\v\xaf/%U\xa8t\x94.#'K\xad\xa3\xe08\x8e\xc56\x98\x87_\xfdחL\x92\x86\xc8C\xa4$\xe8\x84۰\x06\xae\xc1HQG\xe5\x15\x11:\xcatI\x8ec\xe5\b\x99\x938)B\x8a\x90,%\xb4\xc39\x9c\x85\x87a\x94\x88M\xf8!\x01s\x86\xa1˟\xb8\x81\xc3똋\xb9x\x1d\xafC.\xe6\xc2u\xd8\x02\xae\xd0\x13\x96w\x8ap\x12Y\x02\xdbp\x18~\x8a\xab\xf0{άE\\#\xac\x1b5d\x9b\x8d\x86(4\xdcc\xe1\xb9t\x840u\x92\x1f\xa3\x03!\u007fd\x12Yc4Dk\xd0\xdb\x10M\x98^S\x1aۓU\xac\xcc\xca]\xd2!|A\x18^S\xb8\x04h\xb6+\x1a\xa3Q\x92\xef\x11\x86NҮƠ\u007fg\xc3L?\a\xd3\xe1>cPJ\xfb\xd8\xdf\xf8\xfe\xbf\xf1A\xec%\x06mQi\xb0}śK\xb0\xa5jv\xa4\xc6\t`O\x15\x1cj\xc8;|\xbc9\xe6\x18W\xf1Mn\xbd\b[\xa8#|\xa4\u007fLp\x80_\xb9\x0f\xb7cGZ\xcaN\xb1\x92\xaaҗV\xd7X\xd6o۶k\u05f6\xf0҈\xaaDA樸\x1dy\xe1b7\xbfW\xbc\xc9R\xc2$ƔlPB\a\x05\x97x\x01;\f\x96\b\xa7\x9ap\xb8¼\vi\xa6\u007f7\xec`\xb1\x03\\\x13\xf3\xe3\xb2\x12R\xec\x13RR\x12\xe2ը\xeczM5^<\xf0y\xfdU\x1a\xacZ/\x80M;\xf7\x0f\xe9|\xa0\xdd\xfd;\xae\x8c9\xcatG5\r \xd1q>\x8eAa\xa98`\xbd\a\xf6X\xc0}HB\x8f\xedÞ\xe2{ܻ\x80\x06x\xef\xcdS\xe8qH\x04\x99\xbcw\x01{\f8/\xa2p\xe3>\x8e\x01G\xae\xd3\x01\xca\x18\xf0g\xa0c9\x8f\x1d#\x19\a\aj\x9e\a\u0600\x95\xa7\x18zu\xe7\xe7A\x17i\xc3kT\x92\xf1\xf9\tE)Bz^~f\x1e\x97\x9f\x97\x9d\x93'B\a\xb8\x12Ey\xf9\xc5\xc5\xea\xe2\x94\xe2\x84<\xc1\xaeƠ\x84\xda<^c\x1bg(g\x1f/\xbf\xe6,\xe80\x1fk!_G=\xbev\xed\x91\b\v\xfa\xe3,\x1c\x80\x03pv7\x06\x17\x1a\x95\xd2<\x1d\x05\xb3\xc1\x02\x15%_T\xff\xfbsF'8J\x05\xf01䁣\xb5\x0e\x1c5D\a8\xbe\x02\xc7?\xc1\xd1FCn\x93\x16\xb3\x1fN\x980H\xe8j a$\x0f\xa4\xc5z\xa1\xcc\xe5\xbd[;=[\xc5\xc3\xdb.\xe8\x0e\x1f>|Xwa\xdba\xf7ˋ\x8f\xce\xdbK\x83\xd3C\x98\xf0\x0f,\xe4 \x17\xbc\xd0\vs<\x92<c6\x88q\xbe5^-\xb1tFaaf!\xd7x\xb8\xba\xb1H,:\\ؐ\xd7@\xb7Ｔ`\xaa\x1a\x1c\xfb\xc3D\xa4\x81G\xc7&\x01\xaem\xc3RH\xc1{\x1c\x96a4N\xc3\xf4I\"\xaeێ\x1f\xe1t\xf4V\xe3npA\x17\b\x17\x8c\r$\xac\x84Q\xe0\b+E\xc8\xe9\x0fd \xf4p\xf9E\bm\xf6,\xf2\x0f\xf5\x0fM\\_\xefO\x9f\x9f_?e\x92zb\xe8\xd4\xf5\xf3\x85P\u007f\xbdgs\x18\x9dXZ\x9eV\xce\xe9\xa8}\xf5ŵ\xe5\xe2\xad_\x9b\xa0\a\x90j\xd0\xf7\x87q\x1b\xc0q\x1c\xf4\x17B\x8f\xae\xd7\xfb\x87\xfa\x87%x\xd6\xfb\xd3탎c\x1f\xb3O\xa7܌}\xe6\r\x14B\xfd\x8b\xd67\x87Z\xb8\x94Y\xb8\x94Ԗ\x8b/\xe0\x83s\xe0\b\xe3\xd4v\xa8\x87\x1b\x1a\xdb\x1a\xb8l}TC\x9c\xb5\xd1\x10\xdfı\xd0\xcb\xe1\x15\xf6\xc2^#Gb/|\xef\xd5H\xe8\x05\xbd:_\xc1{\x02\xc6\xc2\x11\x16\x96F\xc1\x06\xd9\x17F\t\xf8ɛ\x9d\xdf\xddS\u07fd\x05=`\x88\xa0\x83\xc1\xba\xa6-\x026\xc0\xd2ʋ\x97\x80?\t\xe3a<\x8e\x85xy\xa2<\x11\xa7a!\xe6a.\xf4\x18\ngQ\x10\xe0,\xf2\xa0tC\x1b5\xf6@r\x16:\b:tй\\\x13\xea\xce\xeb\x8e\xe8\xd4M!0\xdel\x9aoék\xd7}+\xf7\x92\x0e\xc1T\xb0;\xdc\xfaR\bo\x89o\xbd\xa0\x86\xb1\xc0@\u007f\xf8\xa8Z\xb0\xf3\xe4\r\xae\xa0\xb41\xb8\xc2[\xf65#\xb9\xf6\u007f\xcdHJha\a0\x81\x16\v\xdab\x15\x85\xa5\xf1\x9d\x10ƾ\xe1ee\xff7\xbc\x9d\ao쀷\xec4F\xee\x18\xc5H\x1d\u07bcԱ\x81\x91;(\xc9\xd5\xd2\xd0<\xdd\x1b;\xba\x97\xe44v\xa4\xf1\xd8\x02a\xac\xd4\x01[\x98\xe5\xbc\xdc1\x92\x91;\x063v5[\x19PZC\fc\x89w!\x9f\x82\x92\xd5\x18\xe6\x93\xfex'#2?\xb2$\xd6>\xaedo\xd2^\x0eR\xf9\xbd{\vKKĒ\xb2\xfc\xbd\x19{iT\xc2\x11\xbe1m\xe7\xbe@\x0e\xf5\xa8$\x173B\xf7r4\x94\xe5\b\xf5T\xe0\xce]Ai\xe2xS\x05\xa1!\x9bH9\xd0\xe8ʂ?\xdca\xd0\xff\x00/\xa0\x9e\x82\\\x1e\xf4\xd4[\xbe\xfb\xb0;0%Lj\x01\xbd\xd4\x01Jk\xa9\x05\xc2̧l\xa3\xa1\xb4\x06\xbd%\x1fh\x04\xff\xe9\xaa\ab9\t\x94i$\xb1\xac\xb8m\xe3\r.\x81!o\xb4\x9d\xbd^,\x02e\x18I\x94\x93\r̃U\x9f\xce\x155\xc6ͦ\x166#S\x97\xc1\x05\xee\xd8ߔ.\x06IN\xe9\xcd\a\x1a\x9as\xec\x9bs\x1av\xe6\xf87\xcaN\xaa\x1c\xff\x9d\xc1\xfe\xe9\xb4\xf4\xf1-\xf6\a\xe6С\xe6\xe6C\xdb\xfd\x05I);\xfdO\x15\xdeʓ\xd9\xc0\xf4\x1d!\x81\x16f\xfe9;\x1br\x9a\x1b%'UNsÁ\xe6\xf4\xe6\xf4\x03\xc1\xe9\xfe\xb4\x06\xd7\x1bN\xb1\x9f\x97]j\xb9\xc6\xc1Tr\x14\x0f'\x19\x98J^\xbb䳢L\xfcY\x1eM\x80\x8a\x1c*-%\xa0g\xc8\xe0\xa7؋éfO%\x98ǩ$\xf6\x1a<\x19{\x86\x88C\xe5\xa5f*\xbb\x1aK`\xa8\x1eZ\xba'\nc\xa8\xa4g\xb1\x80\xbc\x05z\x02\nH\x83+d\xb1\xd9E\x19E)\x85tAJb^\x02\x87\xb7\x80\xe4\xb1\xc0\xbc\xfb\x9a\x8c\x89K\x88M\x17WcXzBNB~\x92}r\xbe>\xb5\x88\x83[\xa4\v\xea\t3>>!6͌\x87,\xfe\x1df:ކ,\xbe(U\xcf\xc1n\xb2\xac\xb4\xa8,W\xfc\x06\xbe1\xf7\xf5\x1fL\x11\aa\xff\x8b\xf9\x9a,/-*\xcb\x11\xafBXNQzQJ\xa1\xfd\u007fF\xd2=ʯ\xc9\xf2\x123\xf1U\b#\xccOe?\ri\v\xfd\xac\xff\xd1\x10#m\fO\xa5\b6\x13/\x12:\nF\xc0\b\x02G\x18t\xac\x8e\xca\u008b0\x1c\x86\x13\xd8\xd8u\x8d\x95\x80\xc2B,\x1c\xf53t\xc0#BGa\a<\xfa\xf9g(\xc4BB\x02J\xf2\x93\xfd\xf0s\xfc\x1c>\xc7\xcf\t(\x84\xcfY\x83\a\xd6\xc12\x99\x939\xac\xc3e&\x0f\x93\a\xd4\xe12\x89\x13t\x94\xc4A\x1d,3x\x88v\xa6\xf8_xi%8\xd8H?\x03\xc1\xea(xE\xc1,t&p\x96\xd4\xcc\xc2+J\xf7\xae:\x10\x81\x05\t$\xf9\xa8t\x8c0yRx\x11.\xa0\x15ZI\xb4ܓ\xd0Q2-\xf7\xb4|{t\x11.\x98ђ\x99\xcclU'1K\x98.\xcb\xfa\xdaz\xf3\xd6Og\x81\x98\xf4\xddU\xc5l\xab\x04\x06\xf4\x17y\x93ޠ\xbf\xc8\x1b\xf5&=\xebΜ`\x04\xc5a\xad\x83\xb1\x83Uh\xac\x1c\x19t\"#\xf6$Dǋ\x8a\xc3ڮ\x16\x8dQK$iS\xe3ba\x05\x0fNduUQE\x89hl\xd9\xca\x10\x85\xc5y\xa5ej\x93\x13v\xb0\ue320\xd0X\x9d\xb0D\x1a,Ր\xbb\xf2\xf8\xbf\f\x0fX\x1d\xa5\xa3\x86\xe2\xf5\xc4\x1dE\xc1\xf5\x91\xf6\x06\xc9\xc4\x10\xf8\xadQ\xc7·]:\u008c\x84o\x8d:\xc2\xf4Z\x92Xm\xc3&\x9d6\x18=dI\xa5\x93|(\xe3?\xfd\xcdxSS\x97\xce \x19\x98\xfa\xbdE\r\x89\a\xed\x87\xc2u\x16=$\xa9\x9b\xd8\xdeLMH>\xa4]\x8d4\x03\x1a\xac\xa5\x96N\x1bIipe!\f\x9c0\x8c2L\xc6gl\xf0\xfcɑN\x1c\xfae\xe2\xd4/\xd1\xfauf\xa7\n\xe810s*\xb8e=\xcfzP\xf0\xfc0\xfd1%\u007f\t\xcfX\xb3;\x82a\x94\xa4od\xa5i\xf2\x10x\xf5Ω|%OC\xc5Q\xecus\x90\xb0\xda%p\xdax5n\x06\x0f\x9c\x01\x190\x05\x06\u007f\t\xd4\x13\xa1*\xb3JWUU\xa5\xab\xea5\x18\xa9'0x:L\xe1 \x03<`\x06l~\x1e\xf8\xd5\xea[\xe2ͷG\xa1\x17(\xd4v\xde\f\xa4\xfc\xfb\x9eG!\r\xeeΣ\v\x1c\xe4I\xe3\xe1\x17\xe0@i#\x95\x18\b\x16\xf3P\ty\xa4\xa4\xb0\xc5<T@\x1eiȔ\x95,\xfe\xa2#Q\t\xbfP\x92\xd2\xd6\xe4\x84J\x83\x93\x99\xc2\xe4\x84\n\x83\x13\t\x9c)\x84\xd5&&j\x05\r\x0e'\xb5\x89\xfabQc\xfb\x05U\xac\xd7\x17\v\x8d\xa4d\xbe\xff\xb7\xc0L\u06023%;\xd0\x13\xbaF\xaaX\x9f\xa8\x1558\x9c2u\x1a\u007fe1\x04F\x12\xd2H\x122p$\x8bK\xdfU\x9eE\xb0O\x17ݙl\xe19yѢ\xc9\x16\xfa\xc9w\x16=\x154\xb6_\x90O\xef\xdcy*\xda\xd5h\x81\xd2\x10=`d?\x18\xc1k\f\x11o5\xb69`\xa7xfT\x1a\xfc\xd8\x1fq\x168a\x05\x10\x13\x80\x98\b\xbd\x97b)\xd8b\x19\xd8\xe2*x\x80\xfd e$,\x9d\x04\xd3q\x11\xf4\xa5u8\x1f>\x00OGA\xf1\x05<Ǳ\xd0\x13\xe3@\x81ɴ\xe2\x19\xbe\x91O\xb2 \xe3\t\xf8\x15އѕp\x04\x8f\xab\xfe\"\xf1\t|M(\xbe\x80\xb5\x19\xd2\n\x8d\xed\xdf\x1a)\x83\x03?ӎ\b\xfc\x15Lx\x82\xb6\x03o\xfe\x168\x19\xf4\x8d\xf0\x03s\v\\x\r\xb1\xd1h\xc3\x16\xfa\x87\a\x04$\r\xdc5|\xf7\bm.\xfex\xcc\xf1\x14Z\xe9\xc3U\xa5\x81Q[\x02\xe3\xe8\xf4\xe4\xa4\xcc$.$\xb4|\u007f\x82\x98T\xbd\xe3a\xc8\xdd(\xe8e\xefMFa\xaf\x90\x85;\xe6$E\xa8B\x12BcB\xb8\xa4\xa4\xac\x9cd1\xb04\xeaXi\x93\xbeFu\n\xac\x8e\xfd\x92\v?\x8e(\x1e^;p_@a\xf8\x91\xc2\xe6\xc2#Gj\x9a\x93\xe8\x00\xf8\x10\xfa\xef\x00%\aJ\xe8\u007f\xf0\b|(6\x17\x1e\t/\f\xa0\xf1O\x182\x87\xb7\xac\xb7#\xd9\xe3=\xb8'\xdb\xcb\xf6x\x0f-%\xdc\xc3{\x92\xbdd\x0f\xf7\xe0\x9ed/\x98\\\rzV\x9a\n\xbf\xe1o\xf2Ty*\xfe[\xc2o\xd2T\bfD\xa3Ҥ\xffo\xb8\x0f\xea\xc1\xfdq\xb8t\f\x02\xac\xef\xc1\x1a\xc3\np\xb7\xd1\x10U\x86\xad\xec\xee\xbam\xc5\xc1\x192\x15\xa1\x02o\x889t\xa4\xe0L\xc2\t\xfb\xe4\x031\xfbC\xcai]\xa6N\x8e\x86\xa3\x82o\xfd\xbaԀ`L\xc2\x00\x95\x97_\xda\xc2\x13\x9bNl\xba\x9bv\xe6$,\x82\xf1*\x18ᄕ؆֑#\xd6͘m\x0f<\nħ7\x9f6\xffȁ\x1b\x8c\xc11\xe8>\xc6\u007f\xd2\xea\xa5\"\xf0\xc8\x13?\xbd\xba\n\x1f\xc0`\xf5\xf3\x88\xa7\xfe\x0f\x05\xf8\xb0\xd0_\x9e\x95\xb6\xf2\xf4\x9a\xcf\xd3\xec3srt9\xdc'\xe7N]\xcd\x13\xcfH\xad9\x87\xb5\x87\xc3k\xed\xd1C\xda\xc0\xbe\xfe\xfa\xab\u05ffM\xfdz\xf8\x88\xa9SG\f\xffz\xdao\xa2\x89\xd2\xe0P\x16\xddp\f\x8c\x01\xb7\x8e\xda{ǯ\x8a(\x82H\xcc\x1e\xe5\x854*\xd4\xd8\x06\xe9\x98\x0eW\xa0\x1f\xf4<\xdd\xf1\x93`An^\xbd l\x0eg~\xe2\x06C\x98t\v6\xc0\xbb\xa7M\xda\xd7\x1d\xbe\xbd\x0f\x946F[\xf8a\x15\xafC'j(\x83N\xdf0\xa07\ffa6.\x81\xfe8I\xd0Q\xf8\x11RK\x90\x9d,&\xc6\xe6\xec)M\x00\xabɪ\xb4\xbc\x82\x8c\x02\xaeH\x9f[\x98/\x1e;WS[[NW\xd4\x1d+n\xe6\xeetx\xadܸe\xcf\xf2\x1d\xe2ܠq~\xd8[\xbd\xe0\x1b\xa6\x91jl\xac\xba|X\xe8hy}\x15\x06\xa9e[ɕ\x85\x19\x8c;/\xfe7\xdb\xcf\x1b\x06\xff/?\\\xda[*\x85\xc2Zk\xd8\a\xf1\xb0\xf2\x8c\xf4k\x83\x8d\xd4Cc{\x83}~\xbf\xfd\xf9\xb3\xf9\xed\xe3\xc7ϛ7a|\xfb\xbc\xe7\xa2\xd4j\xea\xcf\x16e\x14\xa5ꅬ\xac\xf4\x9c\xb4\x82\xb4\xfc\x94\\{y\x9a\xb4\x97\xd0Q\xf5{\x02*ܸe[\x13B\xa3E\x1d\xa5\xcb\xcc@\xb3\xd7}\x0e/\x13\x19e\xd3\xee\x15\v\xba\a\xc5q\xdf\t\xfe\xb0\xeb\x19LJ\x95\x9d\b\\#\xbfΈ֩ӷ\x95\xac=\xb5\xa71\xe6pjK͑\x8a\x13@\x9f\a5\xad{\f\xbe\xcf!GȄ\u007f\xd0\x0eDB[ZP\xa8S\xebJJ*B\x04\xc9F^L\xec\xdf\x04T(X\xa9\xe5a8\x92\x1d\x8d\xf9\xd0\a\xff\xcaN\xccI\xcaI\xb2\xcfґ\x15\x01\x9fy<<\xd5qj\xdd\xdf\xdb\x1fѺ\xf1\xe0\xe6\x00\x85\x82\xe1\xb6\xfc\x03\xab\x1b\x04s\xf0\xba\xa4\x13\x92\xb4\x89:A\xa7ML\xc9\x16\xe0\xa6\x1c\a\x04\xceԩk\x02\xf2\xfd\xabJ\x8a\v\"\xebÛ\xcc.=\xec\x81\xedh\a\xdb\xe1,l\xefg\x98\x00G\x1eA\x0e\xe4B~\xe75\xe8}\x1dz]W\xc4k\xa5,\xe9\x04\v3`o\x16\xee%\f\x9d\xaf\xd9\x0fg\x8dƾ\xc2\x1aIu\x85\x04\xfb[\x93\xd1F\x94\x1fH\xef\xb3U1\xe5{\x04|Fj\x13R\x13SD\xb9͔A쎪\b>\xc8A5Y\\\x9c\xaf\xcf\x17?\xf9\x86\x803K\xc9=\xe51{\x05\b&\xf7\x96\x97W\x89\x86\xbbRo\x16*\xd0\x15\x86c\xa9\x805䞘\x98=\xa2)\x9dR4Y\xed)\x8f\xa9\x12\x14\xf1Z\xa8#\xa1\x18\\a$T\x880:\x93եA*\x0e\x87\xe9\x82\xe2\xa8\u058b\x04\x1e\xfd3\xd0C\xa7֥c\x1ejp\x82p\x89\x84\x8f\xe0+\x06\x96\xc0\x87\xfc\u007f\x00\x8ef\xc0\"3\x80\xfb/\x85\x1d\xfe\x1f\xea5\xe4\x9f\xd6\x06=,\xe6%\x13:\xb2\x01c\xd6m\xd7\n)11\x191\\`\xa0^\x1f$\xe2\xa4\v~o\x16\xc3@z\x1e8D\xc1`\x98\xac\x86ɕ0\xb8\x1d\x1c\x84\xdb0\xf0\xc4\x1b\x98t\x81\xd676雸\xf2\xf2\x8c\x94r\xf1\x90\xf6\xe5\xba\x00u\xa8;\xce\xd0\xfa\n)\xbb2|\xf6D\xd2\xf1q\xe1\x19Q\xeb\xd7\x17\xaf\xe7b\xc33\x13\xe2\xc5踤\x8d)\x11t褸\tNj\xa7\xd2\t\xf5\x93\x84\xfc\x88\u008d\x15qtBIMf\xd9\xf9\xf3\xc5\xe7\xb9ʚ\x8c\xf8R\xb1*2\xdb'\u007f\x17]\xecۆ3B\xd50\xce8\x84\xad\xa8(()\x15[[\x0fܾ\xa7\xbe\xb7\xf3\xb6g\xab\x10\xca\xecڕ\xe9\xbb\u007f\x17]\xbf\xab4(@\x1d\x10\x17\x14\x1a*\x84\xec\xca\xf2ٷ\x8b\xaef`\x11.b+*\xf3KK\xc4W\xaf\xda@\t\xbc\x1axwP\x8e|%\x842O\x19\xfa\xe1\xec\x93\x0e\x83Ճ}\x1d\xe6\xcc\x16\x9e2t\\IEJ%\xf7?\x8d`\b\f\xf9\x1a\xdc\xc0[\r\xdeS\xc1\r\x87\xc0\x90\xee\x86\xd0\v\u07ff\x8b\xe3q\xa6\x1ag.\xc4\xf1\xd8\v\xdf\xff/\x03;i\x84\xae\x11:\x1aA\t\x11\x8c\xb5\x0e\"\xcc~N\x04c\x03\xae\xf06\x87\x91\xf4\xb6\xfe\xbcA/\xebs\x18Y/\xe9\xdf\x014\x866\rzEZ^\xff\xb2\u007f\x8f\u007f>p\t/$X,.^G-\xe1E\x93ި\xdf\xc9\xff/\xc4L\x19iy\xa5\x04\x97\x18\xb0\xecZ@\xd9\t\xca'\x11\xfdtp\x89Y\x06ʟA\x19d\x86+-\x1f\xea\xbc\x06\xa5\xe2\xff\xb6\x81\x12]\xab\x18p\x05\xd7*\xe6\xacd͎\x19\x03I\xcc˗ķp\xb0\x8a1\xac\x81\x0e\xd8\xc9\xc0\x14f6\x83\x8c\xfb\xb2\xa5s\x85\x853f\x8c\xc3\xf1j\x0fFP\xf8\xe5\x8faDE\xf2\xbcq\xfc\t\x06\x88\x8b\x9f\xde|(\xdc\xfb\xea\xcb\x1f`\x8aZ.Ae\x95\xa5\u007f\x16\xdcq,\x8cEwtǱ8\x16\xdd\xcd\xcd`\n\x03}~\xef\x0e[\x1b\x86} \x97\x97\x048ź\x81\xf81\xd8a\xaf\x97;j|Sv\xef\bߑ\xef{0\xfc\xf7\x99_\x0e9\x8b=\xe9\x10\xfd\xbe\x98}\\aaVN\x81\xd8TZy,\xae\x89\x86-߰\xf3a>20\x1aG\x01E+\xd4#w\xd4\xf8\xa6\x86o\x0fߑ\xe7{(\x1c\xd4C\xffD\xcd5\x1cM\x87\x14\xd5\xc7\xec\xe7\n\v\xb2r\nŦҊc\xf1\xdd\x0f\xfc\x0f\x8c\x8be\xa1\x1b\x17pE[p헥\xa1\xffO\xe1g\xac\x94:X\xff\xcc\xc0\x88\xe8\x00z\xe3\xeeM;\xd6q\xc1ۋ\xabw\x8b\t\x85Dhm|\xd3\x11u\x03s\xf1̎\x8d\xb5BE@SDs&-\xdd\x06%\xbb\x9e_\xb7\xe9\xe0\xd9\xddB\xf4\x91\xc0j\xff\xac欦\xea\x8a#\xf4\xd9\xda3\a/r\r\x87\xb4\x11\xb5bQ\x12Q\xbf\xbb$0@-π\x10\xb6\xbe5\xe2S\xcfz\xbai\xc1\xacʙ\x9cb_@`zr\x90x\xfe\xe0\x95\xea\xcf\n\xda\xf2\x8f\xb5&\xb7\xa5\xb4y\x1esO\x1e\x1e?f\xebt\xf7\xc6\xf3'O\x9f=\xder$\xffZ@K`˲|\x9f\x00\x1a'\xa0\xfd\x10\\\x19\x86>8\x1c\xabU\xb8\x19\xdf\xdb\x1e\xa5\xddR\x1el\x0fN\xd8A\xec.\xae\x8b\xab\xe3\x8a\xf4\xd9yE\xa2\xc2\x0f\x92\xa0\x17\xa1h\x82\xd3;\xcb3\xcb2\xcb3\xecuTv\xb6.[\xa7\xd3\xf5\x1a\x04\xbd\x81x\b6mb]k\xf8\xa7\x9eut~|\\N,WV\x9a\x93_\"*\xfcZ\xebj>\rk\xa5\xc1\xc6\x1d\x89\x87؛S\x04\xa7$g\xa6p\xc5\rM\xf9͜\xc2\xef\xfcل\xf0S\xe2\xf9\xb0Ϫ\xeb\xce\xd3\xc65\xe0\xc4\xd6j\xc3Jø\x84Č\xd4\x04\x11\x93\xb0\x17\x9e9\x10\x9b\x15\x9b\x15\x9bm\x9fIfd\xe82,}bo$栍\xbb\x18\xe6Y\xb3\xaa5\x8cN))M/\xe3b\xe3\xd2S\xe2Eϰ\xf0Uu\x9e4ڴ\x011\az\xe7\x17d\xe5s\xda\xe0\xc0\x14\u007fn\xfdƢ\x1aoq}\xddʈ\xb0\xf5\xa1\x9eիZC\xe9\xc0{\x8f\xa2\x9epG\x9ar\n\x1a\xc5\xf5;\xdc\"V&\xbb\xa7l\xf1,p\xa7\x15\xae\x9e\xf9-[[\xb9\xdc\\]V\xae\xa8\xf0{]\xf2\xf2\xe8\x97mA\xeb}\xbd6n\xf6\tHY~ć\x0e\xab:\x10\u007f\x90\xab\xa9-(\xdf+\xc2\x04\xb0\xff\x03Vց\x0f\f\x87j\x95\xc2\x156\xc3{\x87*\x8b\x8f\xc54\xd8\xdb\xd5\x18[:\xad\xa5\x0f~6\xb8\xbe\xb61\xb6\x98\xcd\xfe>C\xdf`o\xec=t\x18\xf6\xf9\x92\x81D\x06\x960\x18\"u\xb0\xf0\x00\x8a\xb1\x18\x1f\xe0\x94\xe9c\x17L\x13\x97\xccY\xb7\n\t\xf5\x01\x1e\xda\xf8)\xfcb\x1e\xc6\u007f\xf1\xe2\xee\x17\u008d\amׁQC\x1f\xe6K\xc6\xdc\x02\x8a၀\xe9&_V~\"?\x89\x8eօTD\xdb\xc34\x9cV\x11\xbd_WQa/=\x91\x9e\x10\x86\xa3\x1a\xdb\xfdltE\x88.*\x1a}\xd1W5z\xf4\xf3ywƝ\x1ef\xff\xe0\xc1\xfe}\x10\v\xb1\x15\x95\xba\xfd\xd1\x15\xf6f\xd7l\x85\xc66\x15VYk\x88\x85\xb0\xc4\xc6\xf0\x8f1\x9f=\x92v\xd8'2*%)Z\x18\xe9\xea\x83\xe2\x87\xeay\xf5\xa3\x9e\x17\x15ge\x15\t\xe5Y:]NY͛\xea75o\xe8\xfc\x86\x82\x86F\xb5\x14%OcS\x92\x92S23tY\x99B\xd1&\xbf\xc2M\xdc\x14\xe7Эs\xc5\xc4\xd4\xf4\xb4\x8cD\xed\xa6\xa4\x8d\xdaM4\x8e\xf4\xc3~\xb8\x18gq\xd8s\xf6\xd7@\xdfh\x01\xbb'\xa7.\x85\xedh\x10\xb33\xf2\xd2\xd2\xd4\x19\xa9\xc3\xd68\xa9w\x96F\x1c\xba{\xf9\xd7Oʅ\xa2c%\xa7r\x8f\xd3Yٺ\xaclu^xF\x1a\xaeA\x02\xac`-,\x85^\xb0\x1b\xd65\v9\xb5u9u\x1c\x8cy6\vǡ\xcd֏\xa2\a\x88\xd9\x19\xc4\xc9\xf6GǞr\xfa\xca\xd4\xf4B\xb12\xf9\x11\xf6\x8cT\xc3\x12\xa9z\x00\x8fZԃ\x874\x1d\xf6h!T\xaa\x06\xef~\xc7 \x1c7\xc2\x13\x90\xa1E\xf1\x95\xe2\xb1!Fc{\x8f\xf5H\xf4H\xf0\x14\x82pT\x10~\x1c\x84\xa3h\xff\xa4ͅ\xdb8Tm\x81\xc1`\x05\x13n@\x108|+\x96\xd5\x1f\xd0\x1f\xe0n\xe5mp\x17\r\xa9\xa6\xc1\xecA\xa4`QH\x91\x90\x17\xbf\xa30\x8eC%\x92+\x97\xa1\x95\xa8\xf8*=\x87\xa8\x87\x89\x9f\xc2(\x98\xaa\x06\x1d\xaa\x80\xc0TAqz\xab\u007fz\x8a\xbf\x88\x9f\xb9\xe32\xfc\x03O\xa8\xf1*\xcc\xc5ip%\xbf17IH\xa4\xd0.z6\x9e\xc6q\xdcƈ\xc6\x16\xe0\xe0㯳\vE\xc5\xe3S\x998\xbe͇ÞKp\xe5HQq\x1e\x17\x80-\xf6\x81\xc5P\rk\xe0\b\xc4^\x13*\uf574\xef\xbdG+\xbe\xca-\xcb\xc9/+\xa0\xaf\x82\rPǠ/w\xffZd\xc2=1?\x93(*-*\x12\xed\x06\x04\x81\xab\xb5\x868\rn6\x86\x87\x86\xbf\xd8\xed\xdb\xe3vE\x8a\x81\x9b\x8a\x97\x9e\v\x86e\xd8W\x15y \xc6g[FFV\x06\x87ێɮ\x9dDVrJV2\xb7\xc1'\xd03ILZ{|\xc3\xc58\xf8\xcbQ%\xef\xbeF\xa4\xa7\xe9\xd29\xcfMAf\x84\xeb\t\x8f+)\xd2^\x17\x95\xbc,\x93X\xb3\xc1oM\xba\xe8\x9a~f\xc3'\x9c\xe4Jbi\x10\xb1.\xc5\xdbw\x9d\xda\xed\x8c\xf7e\xc1bn\xc3\xdbF\xa9\xfe\xea\xe9\xbc#\x8d\x85\xa7\xec\x1fJc\n\x8ekO\xec:d\x8fCaFб-M>\a\xecq\x17\f'J\"#\xf2ùy\xb8\x91\xdd^\x1dѐr$\xa5\xb9\xb0\xb9\xe6PNNNvN\x0e\x0e\x82a*\xffc\xab\x8a|\x83\xbd\x02\x93\x977\xf8dgd\xa5gg@\"\x96\xab\x8en\xceˀ\xed[\b\xc3J\xe9\t{9\xdd\xfb\xcc\x1a\xce5\xd3kC\x8ek\t\x86\xa9\xb2\x03\xd6\xc7E7\f\x85\xb5*t\x93ƅ]\\^\xe3\x11b\xbf!4ne\x95'h\xf0\xa5\nS\xa4I!gWVm\b\xb5\xf7\b\x89^vp=\x94˞*\xac\x82\xb7A\xa7V\x94ym\xb3\xdf\x10\x9c\xb0\xb4i\x13\xc4\xe3mU]]C\xd9\x11\xee\xe0\xfe\xa4\x98Z\x11v\xe1\xf03\xdbO\xf8\x1f\U000731e18\xe3@p\xe9\xe6L_\xfb\xbf\xaf4\xef\xado(<n\xdf\n;\x8aZs/\xa6_\xb0\xb7\xab\xa9\t7\xce\x05?k\x8d\xed1\x18l\x03/`!\x9b\x97\x97\x97\x97\x8f\x13\xa05v\xbf\xa8\xad\x8e\xaa\x8e\xab\xaa?\xb6\xbf\xf9\xe0\x11\b\x93Ǩ\xfe\xc6uDb\x1e\xd8\xfbTr\xf9yم\xf9r?\xc3\xea\xdd'\xc4\xfd\xf5\a\xea\x0e\xd5H\xebMOU\x89\xb9g\xb3\x8b\xb9ڃ\x19y\xb5b^VnN\x9e\xba@\x0e1Z\xc5\xd7\t\x8d{\x9bʏ\x94%'\xab\xa4\x15\xa6\xeb\xb7\xf0=胑DB~qJ\tW\xf9\xb2\xad\xb8Z,\xce\xcf/.Q\xef\xfd\b\xa6\xc4\x1c\x10\xc0\xeb{\x98\r\x1f\xc3{\xb0\x9c.\xad.\xa8ߧޗ\\\x1fW-\xc0'芏\xc1\x1b\xbb8\x97\xc5)qKD\xec\x03\x91DQ\x8a6_\xcbE\x8eq\xd7F\x88\t)\xc9\xdaxu\xe4\xaf8\xa5|\xa7\x80\x8bf\xe0\xc7\xd8\x1fi\x1cKo\xf7\xdb\xea\xbdF\xbd\xab \xb4,Bx\x84\xefAOTB\xcf\xe9tfvvf6gx\xf0\a\v\xc4ww\xc0\x1e\x06\xaa\xa1\xf7:\xe89\xf6\x99\x00\xabf\xc2X\x1c\x0468\x13\xadq:\x8e\xc2\x01\xb8|&-\xf70\xb8\xb1\xfbkk\xf7\xc34Р\x06\xa7\x85\xec\xde\x1d\x12\xb2\xbbv\xff'\xbb\xdb\xfcN\b'\xfc\xdck]9s]\x94z\xdcf3ғ\v\b;9~\x10\xf45\xdc\x00V\xea\x1ba\xad\x83\x0f\f\x0e\x97\x1f\x82\n4?\xd9\x18\x9b\xa5\xd9\xecø\x8e\x1dw\x85-a\xdeQ\x9b\x12\xe9Z\xf7\xa2իԖ\xe5[]p\x0f\xee\xc1)\xe0\x02a\xb0\x13\xa6\x82;D\\\xb8\x90\xb8笐w\xa6\xect\xf5\t\xbaf\xe5Ң\xa5\x1c^\xc10LË\xf8\xe1E\xec\xf7j\x90\x886\xbf\x03\xbb\x0e\x86s0\x02\x94\x97\xa0\xc7\xef⫷\x17\xa1\x1f|\xa8F/Xɂ\xcd\x1e\xe8\xb1\xf5\xad\x90\x13\xa0\xf3\x0fPc\x8d\xbb삧\xb0\x87\x90^]\x93QÕe\x11\x10\f\x9a?`\v\xe4\xa9]\xe1\x1bV\v\xc3\xe6\x83\x0f\xf6{\xf6\x14Ň8\xfc2:Ґ\x8e\x1b\xd8\u007f\x1e\xff\xf2\xf2\x98Xy\xba\xe0\xe9Y\xf5\x0f\xbb_\xaey$\xf8;/^:u\xb7k̲\b\x1f?z\xab\u007f\xa8\x8b\xa7ڧtsm\x80\x00B#\f\xff\xe33\xe8\x9f\x11\x1d\x1f\xf2\xff1\xf7\xe5aM]\xe9\xc3'˽7\x9c\x03I \x89#I\xee\xbdq\xc1}\x87..cъ\x9d\xd6\xddR\xeb.\x88l\xca\"!\x8a\xa0@\xd8\x14\r\xb2\x06Y\x04AD\xabV\xb1-֮v\xa8\xd3N\xa7\xb5\xdb(\xd3ݮ\xd3];\x81־\x81\x83\xf2=7\x04Eƙ\xdf\xef\x8f\xef{\x9e/y\x0e\xe7=\xfb\xbb\x9f7\xdc{\xcf\xcd\xcf\\:\x82\x1aB\x82\x1eUE\x9e\xbb\xb0\xf9M\x93\xba\x11\a8\xe03\xf7\x86\xaf\xf7\x1e8\xf62\x14\xc1\x15\xdd\xfb\xdd-\xee<\x03\x8d\xa0\x93\xe9}t\xa3\xa0\xfb\x81n\xa4\x93\xe1^\x88\x84HɑAԹg\xf33[\xc5̭9\x89\x85\x89\xaa\xea\x84\xcde\xf1&:\x85r4\x90\x8e\xa7\x93\x80\xa5\x010UԽ\x0fS\x80\x85\x00\x98\xf4L\xc13;ψ'ʎW\x1d=\xa8:\xb4ֹj\x95\x91\xce\a04?X\xfd\xc0l#\x9d3\x8f\x8e\xa4c&\n9lff\xd1\xfeL\x11\xd6|x\x06^\x85E\xc6&Ǒ\xbdG\x04\x872\xf6\xb7U\x1d\x8f\xfet%\xfc\x02\x1dW:N\x05\xdf\xfcfH\xd8\xfdhv\xa2\xb0\xc8\xfa\xf8#sM\x11\xd1MǓD]\xfb\xebO\xc2ثe\x9f\xaat?DM\x1d\x156a\x9bjWS\xee\xb1c\xc6\x1f\xff\xfe\xd4'\x87\x85\v\xb5ϟ;\xf2\x8a\xea\xcc\xca\xdaE\x8b\x8dp\x05\xa6\x1b\xc6\xe2\x9b\xe1\xac\xee\xfdQ\xfc\xcdp\x8e\x06\x83\x81\x1a X\xe8\x0e\x0f\xe2\xa5m\xf1\xe5\xee\x15p\xf2Cެ\xecu\x0f5\xac\x1b\x96\x91\xb0[\xb0\xe5\x96DƥX+\xb6\x0eK\x9d@uT\x9d\xfc\xd3\xf0\xad\xd6\\\xab)\xb6\xe4\xd9#\xb9bB鰺\x17\xe0\x04_\\QR\xe1|\x9e\xfa\xe7\xbdi|\xe2\xe4\xc1f\xa1\xb1~W\x93\xa9\xb4Ա\xbfD\xdc\xfbt\xe1\xd3O\x1b[\xf6\xbfT{R\x00r\xe1\xa7\x17\xc0\xdf\x04\xec\x0f\x8bG\xbc$\xd6&\xec_\x13g\x8c.\x8c\xde\x1b-\xec+)\xd9Wr\xb8\xfe`\x93\xe9ȩ\x8c\xad\xe2\x02\xea\xef\\o\xcc-\xc9+\xce\x13\x9e\xbe\xef\xfd\x85ߥ\xa9j\n+r2\x8dP\t\xa5\x06\v\xb6\xac\xe1k\xcd\xecFE\xd7?\xc1\x80隽t\xec\xfc`\xba\\e[\xbdg\xf6c\xc6\x05\xa5\vK\x16\n\xbbs\xec{\xed\x9b\x12\x8e&\x98\xe2\xd6Y7\x89\x93`\xfd\xc1\xfb\x8c\xbb\n2\v2\x85\xb5\xc3'\x05Y6\xa4\xd8S\n\x92\vT\xe9{r\xb2ҍ\x0f\xc1\x9c\xe4\x17\xf2\x85-\x8d\x19s\xfer\xe6\\\xed\xf3\xa6\xc6\xc6}{\x1a\xc54P=/\xc5\v&x\xbc\x15\xf4\r@Ē\x86Ƣ\xc6\xe7\xcef\x9d5\xb5\xedj?\xd9 \xbeP\x0es\x9ex\xcfX\x9fS\x9d^\"$\x97\xa5\x94\xa5\x1c\xa0\xe8\xf9)\xae5\xbf\xa9\nj\njj\xbf\x80u\xe9?\x1b[W\x1f\x89\x17b\x13\xb7ƚr\xed\xc5%yb\xc9\xc5ҷ.\x1a_\xdd\xf3\xa1\xede!\x14\xee\xa5c]\xf4O{U}2x\x1cN\x9c\xc69\xd8=\u0530vXƖ݂-\xaf82>%řb\xe9\x17\xc1\xb0\x14kN\x8a)\xce#\x82-\xa5\xc3\xea^\x84\x13|IE\xb1$\x02\xad$\x82\x13u\xcdB㡝\x87M\xa5\xa5EE\xa5ba\x9f\b\x8a_\xaa}R\x00|\xe1\xa7\x17%\x11p\xdf{D\xb0e\xff\x9axc\xf4\xdeM\x85^\x114\xd5\x1f<\xdc/\x02\xad$\x82\xe2\xbc\x12\x8f\b\x16|\xb7\xa3_\x04ή\xad\x16\xde}\x02ʰ\xe7R\xae\x0f\x82\x06~D\x04\xb5,\xa7\x8b\xe9N\x95\x83\xa3\x8b\xe8N\v-\xdc\x11\xafr\xa4\xc5O\xa7#\x84\x87 \x95\x1ep8\x8c\xb4\x92\xdaiX\xcf~Z/\xd2\xccp*8\x1c\xc6={\xf7\xee\x11\xf7\x15\xed\xdbglJ\xf8~<d\x17\xabJ\xe0I\xd8\xf95<e\x82\x95 ߕ\xfb\x92xh\xcf!\xa7\xb3\x0eV\xc0\xb5\xa1\x155\xbb\xeb2+TE\x85\x85\xfb\xf7\x98\xa6UѸ\xc3\xf4\xfe\xad4>.l\r\xad\x1b\xa5r\xac\xb2\x0e\x8b\x13 \x16\x02\xbeos\x18a90\x90\xfc*\xa4\xe4\xbe\xfc\xe3\x1b {\xf6\x89sO\xc0\xc4w\x9f\xfd\xf2\xd9/\xeaaΑ\xe7\x9aϝ\x04\v<\a\x8f\xa9\xd4\xee\xf7@\x949\xdex\xa5\xdd]\x05\xbc\xbb\xba\xfd\x957\x14ff\\\x97\xdept\xdf\xd1=\xcd\x02\xcd\xc9\x04\xdb\xc7Y\xe7w\xbc\x94r.\x90Ə\x800\bN8\x1b{&\xead \x9dF\xe7w\xc2戣k\x1bVU\a\xfe\x91n\xab\x81\x1c\xc6ZbݟbMٛ\xea;\xb2\x9a\xdag\x83mU\xd6ڴ\bk`'\xddB\xa7\xc1\xfc\xa8\x84\xd8\u0604\x8d\x81\x10F\x83\xe9\xe6_\xad\x11ik\xb3V\x05¶Y4\xb7\x86)\xb4\xeeMq\xa48R\xf6[\xad\xbeA\x90SCm\xb3\xaaW5\xacm\xde\x10\b\x9b\u007f\xa5\xf3鴓\x1b\xcfĜM\f\x84\xa90\u007f$\xddr.\xe5Ŵ\xf3Y\x81\x1f\xc3\xf6L\x9a\xcb4\xef9\xba\xb7\xf9h\xf3\xfefߑ\x99\x90\xff\t\xddq\xbe\xe6\xc5C\xe7\x8e\x06\x8e\x84-0\x95\xce?{\xf2̙\x13g\x03i\x18L\x83\xcd#\x9b\xcf5\xbcX\xfdJ\xa0\x83\xa3\xb6\x8f\xc1\x9eɔ4\x175\x1f5\x9e\x18B?\xa2\xb5\xf4 \xfd\x80~Hk\xe0 |\x04\x1f\x83\x94\u007f\b\x1f\x80T\xff\xb1\xd0\xee\xd6\x19n\x04\xb9u7\x82zt̍r\xae+\xa8G\xd7\x15\xe4\xd617\x82z\x16\x1an\xactOa\xba.r]+ݓ\xbbV\xf6Lfn\\\xe4\xde\xe8\xfa\x97\xe1\x86\xd3\x1d~\xc3\xd9\x13\xce\xdc\xd4s]Ξ\xf0.\xa7;\x9cQ[h`\xbb\x991^\x969.\x9b\x19\xd7e\x05|ofF\x19\xe8\x12:\x81N\xa4K\xc4\x1bU\x1c]\x02\x13\xe8DX\"<\u007f\x99ݼyo\xf9\x16\x91j\x8fN\x05\x05\x04\x18\xbb\xaa8\x18\r\xbe\xe0\a\xa3\x85\xae\x89\xec\xd1\xcb\f\x84@\x92\xa1G{\xf3,]\xe2\xbe\xc2\xd0\x1d,<}#\x85\xa9\x8e/\x8f\x8d5\xc6\xe6\xc7e\xc5\v\xd1#\xe7-\xa6\x8at\x15}\x9c\x83\x97+@\x06\xc5\xdfB\xbe{\xbb\x99Q\x0f\x85\xb4\x9e\v\x06\x181\x15\x86R\xf3\v\x82\xf5O\xcc\xefї\x83(k\xbc\xd9tc\x06\x97\x9d\x92aۙ\xaa\xea:ê\x1b\xa9\xf3\x14Ԝ\xfe\x15\xf2`ͩ\xbcS\xeeT\x98Кv\x1c\xb4\x01\x0e8\x0e\xb9\xa7`\xd8I'h\xd3N\xed~\x12\x86?\t\xc3O|s\x12\xa6\x1c\xd7]\xfa\xbe\xad\xeb\a\xb3\xf2\xa2\xa1\xc5vvSԶ\x8dq\xb1\x87\xa3Z\x9fij=#\xd0\xfb\xcdݍ\x86\xa8\x84\xf8\xa8\xa8Ėg\x04`9]Ϋ\xf6gN\x9cn\x15\xa4Q\xad'\xe37\x89\x94\xe5 tȦ\xc48Xş\x88\xdf$P\x96\xd3MQ!\x98;D\xa7B\xf6\xa8\x84xXş\xf4֫P\x1b\xcc\x1d\xb2)!.**\xa1o\xbegN\x9enm\xf56CcW\xa8!\x87\xae\n\xa6\x8f\xd3\tT\xa7:\x95\x11\xd7\x14m\x8aۜ\x96\x98.\x9eر\xb9!\xce\x14\xb7\x19~\xe1c\xb7\xc0/\xfc\xa6x[|\xbaH\xef\x03>\x04\xb6@\xac\x11̧\xc1\xfcw\xc0B\xe6\xf2\xfceK\x8d\xf4\x03\xb0ї\xe1;ȃM0\x01\xa2\x00\tt\xdcq\xc3ւ\x94\xfc\x14!b\U0005cb61&\x1aA}\xe1\x11Xv\xf5\xc4ն\xcfČ?e=4\xdfHǿ7\x19\xa6\x9d\u007f\xd9Yy^(\xae=X|\xd0t\xfe\xb0u\x95س\xb8k\xb4a\xc1\x86\x87\xe7\xcf\xdf\xf0\xceŷ\x9e{\xe7\xfd\xf7\x9f{x\xa1\xd0\xe5C\xbf7P\xf3R*\x8e\x9a\"\x14\xd7\x16\x1d\xac5\xbe\x17\xd7:?=\xa3\xb00]\xc8Y\xbb6w\x8d\x89ZV|\x04\x81\xdf4]m\xfe\\\xfc\xf0\xd2/m\xd7M:\xad\n\xc1\x06\xf0\xa3\x8fХ\xa2\x87\x1fO\xd2D\xc3\x0f\x19\xe07\xf7m\xe1\xc8\xd6\xd8\xe6\x18S\xea\xf6\xec\xf4]b*\x8cX\b\x02\x95_U\x95\x95WVT\x1a\xeb\xa3\x0e\xed*\x15\x9c\x95gNW\x1bK\xf6\x96\x16\x16\vgbW4\xae4\x85\xaf\xb0m\x8c\x16\x8bӶ\x17m7\xd1\xc5t\xce\x18:\x922b\xdc\xfa\xac\xc4\xe4\r\xbbs\xb2r\xf2swed\xa6g\xedP\xb5\xecJ\xaa\x8b1ѝ\xf3z\x86й\xabJ\x166\xac\x15\x9f\x1d\r~\xd4\xf0\xd1lU\xe2\xea\xe5;\x97\x99RS\xf7\x97\xda\xc4\xe7\x16\xbf\xbd\xae=->;6?v\x9fj\xe5_?H\xfa\xc8\xd4\xfe^\xf3\xb9\xd7\xc4%O\x85\xbf\xd3r\xaa\xe1D\xbd\x90x\xe8ɴ3&\x0f,&\x1e:\x95\xd6b\xd21Z$\x95\x0f\x89\x89\xf5R\xf9\xf5\xe7ο&\x02˕\x14\x81\xcf)\xd8\x0e\xbaO\xbf\x0e\xfc\xfa\xe7V\xd8\x05\x01F\xf5Y|\xf5\x9f\xf1\x0f_\x12F\x8cbZ7>z\xe8Q\x13\r\x1cF\xe5t(\x1dz\x9d\xca!\xd0\xf5\xaf\xbf^?(\xd2D\x18\n7ْ\xed\xfb\xb7o\x03\x15\xbe`\xb0\xe0\x1fy\x98\a!\x8a\x85\xb8\xdbi\x98\xc5\xc7Oܒ\xb7Q\xb0?\xf6h~\xb8)8\xe4D\xf5=b\xe5\xc3\x0f\x97>l\xa2\x1cU\xa5\xa7P\xa5\xf8\x1e\xf7ũ\vW\xbe5\x82\xdf\xd8kԏ\xfa\x8d\x1dK\xfd\xa6^\x98\xfe\xc5f\xc1\xdc}\x1cB\xe0;\xee\t\xf0\x05]\x03h`\x06\xe8\x96\xc28\x13(\xc0\xb7\xe1\xc9\xdf\xc5\xe3\x15\rM'\x8d\x9e\xe3\xd4\a\u007f\xb7\x1e33\xa1\xc7X\xd0c\xd0\x13Ѓ\xde\xd7\xd7\xf3\xd7\xcf\xcc\"\xbd\x99\xfd\xd9Т\x93\xbd-G2\xe4\x87Lh):\x89Z\xd03\xe8\x1c\xfa\x1a}\x87\\\xe87\x99J\xe6'\xf3\x97\x19d\x16Y\x90l\x9cl\x92l\x9a\xec^\xd92\xd9f\xd9V\xd9N\x99]V)\xab\x91\xd5ˮ\xca\\\xb2\xeb2*땳r\xbd<P>Z>E\xbe\\\xbeJ\x1e!\x8f\x91\xa7\xc9w\xca\xed\xf2\x02y\x85\xbcJ^/o\x92\x9f\x96\xb7*X\xc5P\x05\xaf\x18\xa6\x98\xa4\xb8W1S1W1_\xf1\x88b\xb9b\x85b\x8db\x83\"F\xb1Y\x91\xa4\xb0*\xb6+N+\xda\x15_)\xbeU\xfc\xa8\xb8\xa6\x9c\xa6\x9c\xab|H\xb9J\xb9N\xb9I\x99\xa4LU\xeeT\xe6*w+\x8f(\xcf0J\x860\x01\xcct&\x829\xcd<\xcd<Ǵ1\u007fc\xae1\xbf3\xddl\x00;\x94\x1dΎa'\xb2\xd3\xd8\xe9\xec\x1c6\x8c}\x84]\xc2>ήc#\xd9'اس\xec\v\xec\xab\xec\x0f\xec/l\x17\x878\x8e\xf3\xe5\xb4\xdc8\xee\x1en:7\x87{\x84[ʭ\xe0Vs\x1b\xb8M\\#w\x82k\xe1Z\xb9\x17\xb9\v\xdc[ܻ*\xb9\xcaWeV=\xa2Z\xac\x8aQmS\x15\xaajT\xc7T\xa7TϨ\xdeT\xb5\xab>V]\xf1a}\xfc|F\xf9<\xe03\xcfg\x81O\x9aO\xb1O\xa5\xcf\xcb>\xaf\xfa\xbc\xe1\xf3\xb6\xcfG\x18a\x15Vc\x1d\xfe\x03\xe6q\x10\x1e\x8f\x97\xe0p\xbc\x1aG\xe2\x14\xbc\x1d\xe7\xe0b\\\x8e\x8f\xe0'\xf1\v\xf8\x02~\x03\xbf\x83/\xe3\x8f\xf1\x15\xfc+ѓQd\n\x99M\x16\x92\x15$\x82D\x93\xadd\x1b\xc9&\xf9d\x1f)!N\xd2J.\x91\x9f}\xb1\xaf\xce\xd7\xe2;\xcaw\xa2\xefT\xdf\a}W\xf8F\xfaZ}\xd3|\v}\xf7\xfb\x96\xf9V\xf9\xd6\xfa\x9e\xf3}\xc3\xf7\x1dߏ}\xbf\xf0\xfd\xd6\xf7\xaaﯾnߛ~Z\xbfq~\xf3\xfc\x16\xf9-\xf3[\xe7\x17\xe5\x17\xe7\xb7\xd3\xcf\xe9W\xeb\xd7\xea\xf7\x9c\xdfy\xbf\v~o\xfa\xbd\xef\xf7\x0f\xbfO\xfc~\xf0\xfb]-W\xb3j_\xb5\xbfڨ\xb6\xa8\x83ԓ\xd4\xc1\xea\x99\xea\a\xd4+ԛ\xd5I\xea<u\x8d\xfa\x8c\xfau\xf5\xa7\xea/\xd5\xd7\xd4\x1d\xea.u\xaf\x86\xd1\xf8j\x024\u007f\xd0\f\u05cc\xd1\xfcQ\xb3\\\x13\xabI\xd0\xd85ŚF\xcdQ\xcd)\xcdY\xcd˚\xd74oj\xde\xd5|\xa1\xe9\xd42Z\xac\xd5i\x87j\x87iGi\xc7kC\xb43\xb5\x0fh\xe7i\x1f֮\xd1&j\xb3\xb5e\xda&\xedqm\xab\xf6%\xed_\xb5\xefj/k?\xd2\xfe\xa8\xed\xf4W\xf8\xeb\xfd\x83\xfc\xef\xf5\x9f\xe9\x1f\xea\x1f\xe6\xff\x98\xff:\xffh\xffm\xfe\x99\xfe\xf9\xfe\xfb\xfcK\xfdk\xfd\x1b\xfd\x8f\xfa\x9f\xf4\u007f\xc6\xffE\xffW\xfd\xff\xe6\xff\x8e\u007f\xbb\xffG\xfe\x9f\xf9\u007f\xe3\xff\xa3\xff\xbf\xfc\xaf\xfbw\xfb\xdf\fP\x04\xe0\x80\xc0\x801\x01S\x02\x1e\bX\x1c\xb0\" :`K@F\x80=\xa0 `_@u\xc0\xb1\x80'\x03\x9e\r\xf8K\xc0\xdf\x02\xde\x0f\xf8 \xe0\x8b\x80\xef\x03\\\x01\xbf\aP\x9dR\xe7\xa3\v\xd0\r\xd5\t\xba\x11\xba\x89\xba{tsu\x8bt\x8f\xea\"t\xb1\xba-\xba\x14]\x9a.K\x97\xa7+\xd4\xedו\xeb\xeatO\xe8Zu/\xe8.\xe8\xde\xd5]\xd6}\xac\xfbVwMw]\u05ed\x97\xeb\xb1^\xab\xd7\xeb\x8dzQ?R?V?I\x1f\xac\x9f\xa9\x9f\xaf_\xaa_\xa3ߨO\xd4o\xd3\xef\xd4\xdb\xf5\x0e\xfd\x01\xfdA}\xa3\xfe\xa8\xfe\xe4\x1b\xbc{\x16\\\x85\xab\xeeY\x82z\x0e^\xc0>ċ\v\xd6\xe3\x8b[01t;c\xf1M'w\xd3iV\xb6\x19v\xf1]\xce!\xbbx\xb7\xfe\xe2\a\xff\xf9\t\x8b\xb7\xf8Z~!\x86\b\xcfɋ\x8aX\f\x06\xfc3;\x05\x9b\xbbC\xff\x82\xd9\x1e\x16\xc2h#\xac\xa4C\x04\xba\x92\x0e\xa1a\xb4\xb1\x875\x8eg\xd333\xd3\xc5\x05P\x88/rӱ0\x9eu\xb34\f\x1a\xe9J\x18\"\xc0J\x18\x02a\xd0\xe8f\x8d\x84\v\xc2d2/\x90ѼHڱ\x99\x89H\xe2\xcdݍ4\x9c\xcb\xca\xce\xcb\xde-\xee\xce.\xcer\xe6@;m\x1f\xfa#\xae\xa8(.+\x17\xe12\\f\xc8t,\x10\b\xa6\x060\xd0`\x81\xb0\xa301PG\xdf\v#</Z\x97\xf2\xb5|}MM=\x99\xc5\v\x84}\x00\x93pL\xdb'\xf2\xd0>\x1b\x93i8\x91'qIIqd\x02\x16I(/\x12v&O\f\x10\x8bi\x1b-\xa4\x85\xb4m>\x16\xbb\x9c=g\xfb\xafI\x0e\xbe;՝\xe7\x0er\a\xb9\xf3\xc8,\xbc`\x12&i\x18\xf4\x91<\xe8\xd7aR\x9fY\x93\xee\x99Z\xa0z6.\xe9x\x8b\b\xfah\x89\x93\x84\x9d\x86\xc9h^ w\xbc\xda\xc2\xed\xbc\xd1n8\x9dy\xca\xd6$П\xe1g\xe63\xf09\a\x16\x18n\xfcO\xd7\xc0a8X\xce}\x06>\x02\xfcL\u007f\x82\xcf0a\x17\xf0$=\xf30O\x96\xe1EX$\x8fc\x81\xecǻ\xf9\"L\x98\x82\x82=\xb9yƼ\x92ܲ\x02\x81\xd4\xd5\xd6\xd6\x11\xe0\xf1\xf8\xbe\xe3`\u007f\xfe\a&\x12\xb1?\xe0S<\xb9\xc8\xf7\xc4\xf4\xc4\xc0\x10\xde\xed\xf9\xf6\xf0LA\x99sO\x85\xc9s\xa4\xc7\xf9\xf3\xf9\x98\x8c\xc14|\x1c&\xe1<\xb4\x0f\xe7I\x1a\xbf\xfc\xb5K$\x9f\x87:\xec\xfd\xf5\xda\xd5\x1e\xc4\x133\x8bH}Mf:)\xf0,_V\x90[\x92g\xca\xcb\xddSP \x12\xc3dL&`\xe1\"KV\x9c\x8bh\x93\x84\x11>\x91\x87\xf0٘\xb4E\x9c[!\x90\x1b\xed\x86\a\xf8n\xfd\x90x^\xd0\x1d\x83v\xdcݾ\x9e7\xd3%\xecK9\xfb>}\xc8\xfbB\xfe\b\x16\xc2n\\d2\x93\xd6\xceN\x80\xa5|\xb7\x93\xfd\a\u007fs\x02[\xd9P\xd3\xd8dl\xcam\xdesX\xe8>\x0ej\xe6Tɑ'\x9e4\xaao\xdd\xd4F\xe3 \x94\x86B\x1c\xa9\xdbU\x9bA\xd6\xf0\x0eb(\xc1}7\xa9\"^ 0\x8e\xa7\x1c\x1dF\xdaΝk#\xad\x18\xf4{1a\xe7\xf3$\x11\v\xe4~\fz\xe5\xfd\x98\xd4d\xd6\x13\xaa\a=\xb1\xf3\"y\x9c7\xab\xd0[<\xb7\x85'\xdcZL؍\x98\x98\x19;\xb1elۑ+\xd2m=kz\xf6\xcde\xac{\x12\xb2\x13\x8d\xe9\xd5\x19UY\x82\x1b\xd1\xdf\xef\xe9)d\xf6\xe5\xef+\xc87\xa6T\xa7\x1e\xaf\xa9.-\xaf\x16\u070f\x81\x9d\x18\x06i\v\xd7r\xfcx\x8b\x00z\xb6\xe5xR\x9cH\xf5\x9c[\xaf\xac\xc5d\xe0=\x83\x84\xfd#O\xb8\x89\x98p\x8d<1+\xdb \\\xe6\x80p\x85;\xbc\xbb\xfdA\xbe\xfb\xcf=\xed\x06H\xe4ol\xe8Zϼ\xb2r\\\xeb<SO\xc8\xe7|O\b7\xafu\xdc+\xab\x84\xae\r7\"\x18[\x13\xc8p\xd3\x11ѝ\xed\xb6C(&\x92\xb9\n\v\xd8\xf4̚z\xf1\xe2l\x9eL\xc5\xc1<\t~\xf0A\xa8\xe3\x83\x1f\xbc\xfc\x8d\xd8\xd5\xce\xd9$ݝ\xc2\x13ks\x94\xc3j%\xa6\x9exGO|O\xbc\x91\xb4\xf2\xa71\xa1\xc3<\xf7\xfc\x92Y\x98\xea'abV\xdaI\xdf\xf1\xbf\xe1\x9e\xe7G\xa4|\xc0\xf9\xbf\xb5d\"6wۧ\xf3\xb1x\x02O\f\t7\x93\x1c\t\xa6]\xbb\n\v2\xc5\r\x19\xc9\x1b\xd7\x1b\xcd\xdd\xc0\xad\u007fb\xe3s\x19BAMma\xad\xe9\xe4\xf1\xaa\xc6&ѽ\xc8=˽\xa8g\x16\xb3\xbd\xe9\xb8\xfd\xa4\xa9\xb6v\u007fY\x8d\xf8\\\xdd\x13g\x9f7\x9a\x19\x1f\xee\xf9\xe4\xb3\x1bꄲ\xcc]ŻL\xea\x18|\xb3\xbd\x14\x93\xf1<\xe8'H\x1a\xbej\xd5\x1d\xf6 i9t`I\xe7c\xdc1LYY\x89\xb3\xc2H\xe8\xab\xf4«\xa0\xa2\xd3E:\x9b\xa3\xc3\xe0*\xe5\xa0\x12\x97\xf3\x02\xcc樊N\xa7\x17\x1e\xa3\xaf\x9a\xc8C\x80\xa9\xee*\rmV\xc1\x0e~EDĊ\xcc\xe2\xac\xd2l\x81\xd6o\xa2\xc14\x86\x86\x1b{\xd2h(\xb5\xd11\xd4_H-\xdfV\x95&\xd0\xc5\xf4\x9e,\xfb\xbe\xb4\x03\x99\x81_\x8c?\x90ٸ\xaf\xfa@\xa0\x99[jfW\x103\x83\xc8\x1e\xbc\xfdϘ\xa4\xd7d\xd6\v\x17gJ\xa6Ӈ\x8e\xe1=LY`\x97`\xfa%\xfd\x12\x0e\xf3\u007f\xe6{\xc2\xc7\xf3\xeep\xf8\x00\xc3a\x1e\xbe\x84/\xf31qov\xb8\xe3\xddqF2d7&\x86\x81'3C\"\xf6\x1c\xb8\xa1\x15\xd4,\x84\xf0\"\x19\x83\x17\x8c\xc3=\xedsx\x12|\xf9\xc1o\x04B\xd7Q\a\x91\xfc%1|\x81\x87\xc4\xf1\x04\x86A#\xa6\xc3\b\xe7\xc0\x04\xdax\x91\xfc\xfd\xf5\xd7\xffN\xbc\xafg$a˖\x85\x89\xc4p\x80'\x06w\b\xfcF\xb3\xe8Iz\x92\xfeF\xb3zB\"6\x14\x97F\x88\xcb\xcb\"\xa2\xa7?8y\xf9\xa8\xc8\xc0\xa3/4_8t\xe9\xd8\xcfCC\xce3tε\xd10\x1c\xc6\x1a\xe1$\xfc\x06Y\xee\x10\xa1[\xdf\xe34\x90E\x1e\xde\xe7c\xc2M\xc0\xe4\xfaW_]'3\xb0[\xaf\x9c\x81\t8\xf9\x1e=\xdb̋=z8\x88\x05\xb0\xc1[\xfc\x16n5\x16\x16L\xe7/\xb2\xd0\x02\x19\xf0-\x16I\\\x12(x\x02\x1a~!\xeei7\xb801\xcc\xe2\xdbx\x81dW\x1d̯3I&\xe3$\xd7k0\x01\x13\xee\x9a\xc1\xb5$\x1d\x97\xec\xec2\x86\x9d\xfc\xf1\x16\xb1k\xc6KҮ\xe1\xcc\xe4\xc1\t\xa301\xb3v\xb2\x19K&\xa6 \uef1e wPO\x1e\x01\x03\u007f\xbc\x85\xfc}\xd9\xeba\x02y\x88\x17ȥ\xd7^\xbbD\x02\x1c\xa0'\xc2\xc2\x18\xca\xef\x8b^\xac\xca\xe52\xf6\xa7\x17e\b.W\xbd\xcb\x04#\xc0\xb2\xad\x04\x86\x8b\xa7\x8b\x98\xfd\x95E\x95Nc]a\xddރ\xc2\x1f[\xa6\x96=\xf0\x8cj\xdb?\xaa>\xfb\xc8Hd\x0ep\xc2!\xac \x91R|}\xfd\x9e\xaf\x86\x91\xcf?\xff\xfc\xfeg1a\xaa\xaa\xca\x0f\xd6\x19\t\x14\xf0 y\xac\x19\xbc@\xdc\xc3a:\f\x85%2\aL\x87 \xc8s+\xc1\x1f\xae\xc0tE\xf7\xd8.b\xa0\xc9t4\x9dBSh<}\x00\x0e\xf0\xf0\x00\x84B|\xbf\xc8^\xc7d\x0e\xbe\x19\xee\xdd\xff\xbb÷`\xb2{\xf7\xbe\xc2\xddb\x8f\x8d:\x99\x9c\xdd\xf9\xf6l\xa3\xb9;4\x11\x97\x96\x16\x15\x97\nn\x1b8\x99\xca\xd2\xf2\x03UF3\xd3\x06\u007f\xc3$\x06\x8f\x97؈\xb0\xcc\x01k=\x87%\x19pO\xc8}<\u0530$\x8a\a\xe7\x90ż爰\xa9<\xe1\x8a\xf3K\n\xca\n\xbaV\xdfX=\xb4\xd9\xda\xe0l1\x92:\xa8\xe7\x05h\x87v\xa6\xac\xacXR\xe8['MK\x929\xcd\x03\x8f\xc9v\xc7v\x1b&\x1c\xf8\xf2=\xce\x1e\xe7\xd0+\xd8-eYU\a\v\xfe\xc2\x13\xc3u\x9er\xc0\x01\xe2\t8\xcfcr\x1f\xff3\xf9\xfa\aО\x87?\x98$-\xa0\x19\xb4\xe5c\x9e\x06\xd3їA7V\xdc]\x9e[\x92[\xa4\xca\xcd-,\xc8\x17=,}\x97'\x1f\xf1\xde\xf7\xb5\xe6\xef\xcc0\xc20,@\x1e~\x85\a=\xa1\x97h߅P\xcf\x05Q\xb8\xd4\u007f\x99\x93\x18\xc6\xf1\x02\t\xe6a\f\x16\x88\x81N\xef\x1aN5,\r\xa7\x8b\xae\xdc\xf3\xe6\xeb\xd5\xd5\x15\x95\xa5\x15\x81\xe5U\xcc\xfe\x9dlξ\xac,c\xcf<:\x93\xb2\xeex\x8e\\\x9f\tx\x8d@\xef\xa7\xfa'\xa9\xd6\b\x8d\xfc\xa5\xd7^\xbd.\x90\xa9<8\x130\xe9{\xa2\xb3:\xcb\xf38\xa7\x84\xd2\xd0\xec,GZU\x96\xeaVd\xe3\x04'C\x1e\xe1\xdf\xe1I\xc5\x1eX\x8e\t\f\xe3\x05\xc2yN2\xeei\x87 L\xba\r0\xd3@e,\x8d\x80\x97a!40\xe0\x0f\xb3h%K-t\x875rw\xfe\x9e\xfc\xbd\xf9\x81\x85,\t\x96\x9c\xebx\x9e,\xe7\x1d4\x9c\xb0\xa0Ą-\x93\xac\n\xfe\xc8\x13\x8f?\xb6`2\x15C\x1bO\x9a\x9duG\x8e\x19\x8fe\x1c\xb1:\x05\xba\xfeC\x1a\x01\rn\x99\xb1\xdb\xc9\xf6\xc8h\x04m\xa0\xeb\x1f\x10\xd4Q\x12\xb7\xe0\x01<\x13\x13\xab\xd5\x11\xd5l%\x06sw\xe88L\xf5P\x88A\xbf\x84'q\xbc\xdbI\xa0\x94\x96.Ĵ\x14JI#uB\x13/s@\x13\xaf \x86\x19\x98\x18\xa6b\xe1\x86\xd3s8\xfc\x11\xbe\xc7\xc9\x11X\x88\xdd!\\\v\xf1>$\xda\xf7\x88g\xbf~\xe8\t=;\x0e\x13\x98\xc6C!)(\x87Ә\xd4\xe5\x1f̮\"\x8a_1\xe9;\n\xcdL%\x04¹\x8c\x9a]\xf5\xf0\x13O8\x9a9\x8f\xc9ܝ\x9b\x9di\xa4z.\xa74\xa7\xa2@\xd8SV\xb6\xb7\xdcT\\\xec(*\x16\xa5\x8d\xc9\xd9w/>8=\xf7\xe2\x93\xfe\x10YT\xcf\xc1\xe6\xee66\x8473\xa1[$\x8b1w\x85J%\xb6m\v&aح\xa7!P-m\xb4_\x82\x01\xc3D\x9e|\xe7\xf9Y|\xef\x19\x03\xe5b\x81c\xc9\xf7|\xdf\xcf\xe4dϳV\xe4\x14\x04\xf3\x04v\xe2\xe3-\x04VJ\x81\xeaX,\x92\xbeC\xf6\xcdL\xdb\x02\xc3\xe1\xec\xc6\x1dU\u008e\x03\xb6\xa2\xb4\x9c\xfc=\xf6\x82\xac|\x151\xb4CRyCQmqU`q\x03S\xc2\x16\x14\x15\x14\xe5\tUk/\xd3$\xe6\x86/\x81\x130\x8bW\x98Uh\x88\xd9m\x8f\xc5To\xeeFf\x15b\x89\x11\xae\xad3P9\x95\x83'\xf5}\xa9\x1cdT\xce\xc1t\xf2\x92\xc7f7`\xf5Ѧ\x83G*\xc5ʦ\xddO쨗PM\xc7$+ˑV\x9dE\xb8i\x98\xd0pw\xbbA\n]\x05\x1a\x1e\x82!\x9c%w\xbe@\x8f\x1a\xe7\xf0\x04<\x016u,\xc6Đx-q\xec\xd8\xc4k\x89'ƞ\xb8v\xed\x04C\xae\xf0n=\x91T;;;pݺ\xeclI\xb1\x03\x89\f\x920\x89\xc5\x06\"9\xa6PRƫ\x03$%w\xff\x01Z\xe9c\xe0\v\xd5p\x96ƀ\x9f\xeeF\xa8Y\xf9\x84Gi\x9c\xb0\x90\x87\xe5\xbc[\x0f\xed\n\x02\xd3\xf9戀\x1b\xabo\xac.(\xd8SP\x98\x1f(\xf9\xcbR\x9e\x8cg\xe1\xcfR\x1c\xe2hmn&\xd5Վ\x86\xacj\x02\xfa'1\xc9(\xdfY\x95퉔\xdc!\xe0t\x87\xc0\xbfx\xfd\x97;09~\xa8\xa25\xed\x04\x9d\v\xd5Ck\x9fnu\x9e3\xc1<\x18IG\xd2y\xc40\xeb\xb3\xd5 \a\xb5\x11\xd4/\x83\xfc\x93τOf\xbcL\xe5Tm$\xdcr,tK\xf1)\xfb6&\xde{%\xf5p\x00\x93\xba\x83\xe5UU\xc4Y^\xee$4\x8e\x86Jn\x1a\xe2 \x14\x86`8ē'2\x19\xdb\vm\xd9\u007f5\xc1$\x18\u007f\xe5\xf4E\xb1\xbc\xa4\xac\xb8d\xbf\x8a\xcc\xc7\x10\x8b\xc94\xae!\xbd*e\xabQ2\xea\x15\x11\xe7ڎU\x1dm\xa8\x17\xfe\xc9\x11\x98ǫg\xf0?a\x91,\xc6\xd4A\xb2\xaa\xd3\x1cYY\xa4\xd9ꈲZIIY\x99H\xccL(K\xb8cMM\xc7\x04\x17{\xacɶU$;\xd2w'W\xda\x02S+\x9bv\x1e5\x11\xe8\xe4\xcb\xe1\x1d>?\xbbJ$\xdc+\x98L\xc2\x17#y\x12\xcc\xcf\xe0\t\x9dG\xfb\xe8\xa5g\xb9Y\x98\xb8\x9d\xeby\x02ס\x95\xb6\xd2\xebd$\x86\x10\xc245՜:m$?I\x01\xf1\"0\x80\x02\x96\xed\x15\x9f9\xdfx\xf1\xa2\xb1f_\xed\xde\x1a\x81\fx(\xbf\xefk\xc1\x84K\xc1\xa4\xdcQ\xb6\xaf\\\xbc\xc7]\b\xbd\xf0;S]]]WoT/{\u007f^\x92\xad\xc0\x9e.\xd0\x13\\N}\xd3\xee\xe3\xa6K\xaf\xbfOڣ\x81L\x9d\x1aM\xc9ܹOS\xf2\xed\xb7O\x03i\x17HyE\x85\xb3\xcc\x19\xe8f\xdcǘ\u007f\xf6\\\xa4\xe9\xe0\v\x1c\u9ff4\n3\x81\a\x1ef\x1e=\xbc-E 7\xc3\xf3%\x17v\xfbl~\xf21\x16H9\xfe\xfb\xeb\xcb\xc2\x04\xb2\x10\x83^!!\x9aɓ\xe6\xe6VG\xb3\x95\xd4\v\x90\x8f\x89\xb3\xa0L p?\xf6>rGF\xf2n\xfd\x102f\xdeܠ\x91\xeds\\\xc2\xf7o\xbd[d*\xaf\xa8,\xa9\xdcG\x98\xf4\xfa\x9c#G\x8d͕G\xea\xeb\x05\x18\r\xa3\x98\xbazr\xfb\x8d\xb5\x8c\xd3\t[a\x1d\xe4\x1b\x89\xc3\xe1p\x90\xbd\xe5\x85\xe5\x05\xe5\xf9\xd5\xf9\xb5\xb95T\x84\x1dC\xe9q\x02I\xfc\xcdp\x0e\xf4\xdd\xe1\x86\x14\x9eH\xfa<\x1f\xc4\xf7\x81\xbf\x00\x16\x85\x99\x99K\x00a\x05\x812\x1eva\xc2\xc55\xe467\v\x84kIsZ\xad\x12\x97e\x1e\x0f(\x85̤Kr'\x1c\x1dFGR\x8eΓ\xa4X\xce\vd펨\xf8-B\xd5\xf6\xb2\xd4T\xe3:\xdb\xf2H\x9b@\xfeB9j\xfe\x1b\x9di\xa21t;\xf5\x8f^ \x92\x99\xd8\xddN\xc0s30m#\xd0>\x13\x138C\xd3!\x9d\x9e!gp3O\x1aA\xce\x13\xf7L\xf0\xdc5M\xa8\xf6_\xa3AK\x1c\v%m\xe7Z\x88\x01ryr.\xeetdd\\|DdKܳ϶\xb4<+\x10\x98K\xab\x99\x1dU\r9\x8d\xa6\xaa\xea\xe2\x8a\x03\"a\xff\xf1v\xf2}\"\xbcI\xdf6\xd8\xf7\xe6\xe6\xd9M\x04\x04\xb0\x83\xe0v\x82\xf0\x00,\x04=\b\x84:?\x85y\x9e3\xb7\x95;08\x03\xc8x>\x16\xaf\xe3\xfb\xee6\x94\xfe\x12\xb0\xe0~\xb2\xfb\xbeN\x02\a\xbcU\xef\xf2ǉ[\xe2\xc4%\xf0\x81\r\x1f\xc0H\x13h>\x8dZ\xe9\x12\x89\xe2y\xca\x19\xc0\xcc\x13\b\x83\x110\x02\xc2 \x8c\x8e\x80\x114\x8cx~7\xa7\xd33\xf4\xde_i\x10\xdc;\x91\xdc:l\xa1\xefȆ@\xd2\u007f|\x81\x94\xd3it\x05\x01'u\x92\x8bl}\r13m,q\ng\x8bK\x17oܭ\x8aܽ\xae\xd0a$\xa5\x92+-\xe3\xc9H)\x9f\x89I2;\xdc}\x1f\x03\x01\tT\xfd\x13\x1db\"f&\xd5\x00&L<\xafU\xfd\x8446n/剹\xabm/O\n+L%%EE%\xa2T\x8e\xc1\xc4\xf0\x93\xb4+T\xf2\x0e\x02g\xa1\x9d\x9e\xe5\x88\xec\xa3\xee\fE\xf7\x1b\x84\x1a̬\\Fܳ\xa4͉\x80>\x9a'\x19\x05;\xb3\xb3\x05µb\xc2\x06a\xa2|\n\xb8\x18\x96pþ\xba\xe7:\xb9\xd1\xce\xd1`ri\xf9k\xf3\x04\xd2\xed\x04'ف9\"EL\xe4\xb7>\xde\x19(\x17\xf6\x10ew\xac\xaeL\xda#\xa4\x93\xbc\x82\x8a\x8a\"\xf1m\xf7\x04\xe6@Qe\x05a'\xf1 \xc7\"\xa9(;P.\x1e\xa8s\xd6Wթ>x\x95\x1c(*:\\k\xfa\x90\xad(\xcb\xcb\xdb'\x12G\xa5\t\xa6\xee\xc1\xa4\xea`e]\xc5!U\xc3~0\xd4\xd4\x1a\t\xbc.\xed\x821\x8b\xe8\x90P\x8aM\x9b\x13˪SD2\x81\x87\x171q\xeb\xddzRQXa\"\xa6\x95\xb6\x1d\x89\xf1b\xdc\xc6\xc77\x8c5\x11\x1aqs\x11\x03_\xb3\x10ѽ\x88!\f\x10\x9e\xd4\xd7Ԁ\x8c\xcfL\x17IM\x9d\x11\xf4\x9c\xb3\xa4\xa2\xacL(#n\xe9W\x1b|\x8b\xa5(\x8f\xd0v)\xc04P\x8d\x9b\x01\rK\xc6\xcd\xfa\xe4*\x8cŤ\xc7\xf3\x04\x80{&\xe9\xb2\x19\xe6Hj\x9b\x80\t\r\xa3#\xa8\xa4\x00g\xe03\x9e\xb0\xa0q\xb9@#\x05\x16\x17\xbdʥ fj7x\xc0>\u007f\x0e\x9b\xb1\x87\x97)\xb6\x9d\xb6\x1c1'\xb54\xf9P:q\x80\xb3K\xf20\xcby\x02;$:\x83\x83\xf7\x04\x9b\xb6\xd3\x05\xfb\x8b\xf3E\x02!2\a\x84\x10n\x0e&\x87y8ˑF\xf8\x86\x97\x11p\xba%\xbd\xa5\xfaHL\xa4\x8d\x81\x80\x9e\xeaIn~~n\xbfZ\xff\x19\x93.'+y^\x98/\x11\xe4G\t-b\xb3s\x1d\xa4\x95_\x90\x16@\xa0\x91\xf7\x1c\nL\x84\t#\x97R\x9e\x8e7\xd2\xf1\x84\x85ɘHۮH\xba\x1bdߚم\n3[\xe1\x9dRF`\xe2\x1e\xa8v\x18\x89\x01\xaa0aR\x9bNe\x9e6y\x03)\x05iĒ\x8b\xac\xc1\"\xd9SQP&\x12\x0e\x1ay\xd2#\x89\xe7\xb4\xe9\xf4))\"\f'\x90\x01-\x1e\xfb\x93L̚\x93\x9a\x9e.\xd0\xd1tT?\xce\x1b1Q\x9a\xe98ZaV~\xc0J\xdb\xe2\x1a,\x10:*\x91Ja\xc7d\x13\xa1\xbd\x8d\xdb\xe1 O\xe0yO\x14\xe4\xe0Yb\xa2C)\xa2\x88\x0e\x15\tU]\xa3\u007f\x80\x90\x95\xf0\n\xe9\xe1{xb\xa6\xa1,9\xd7\xd6v\x8e\xc0:\xba\x8e \x19\n@\n4\xf0\x83\x11'e\xbd\xbdHu\v\xf2\xb9\x05)\xd8(Y)b\x10R\x16)\x83\x11B\xbb\xfbry*B\xb2}\xe8\xbf|\xb6\xa6[SQ(\xb2\\G,\xeaE\b\xb1H&U\xcbnu\xb0 \x8b'W\xf4%\xd9po\x9b\x19)$H6\x1c!\x19;`B\xd9\x1d\xa3\xef\xa8\xfe\xff\xe1\x83}}\x18\xf6\x19N\xd5\xfa\u007f{b\xa5\xeck\x84\x90\x15\x85\"\x06MC\x16t\x02\xb5\xa0\xb3\xe8\xaf\xe8\xa3aÇ\v\xc3#\\j\x97\xe0\x9a\xedZ\xecZ\xef\x8aqŻ\x92\\Y\xaeb\xd7!W\x8b\xeb\x19\u05fb\xae\xcf]\xbf\xb8nv\xa8;\x84\x8e\xd1\x1dc:&u\xcc\xeeXܱ\xbe#\xa9#\xab\xa3\xb8\xe3PGK\xc7+\x1d\xefv|\xde\xf1K\xc7\xcdNu\xa7\xd09\xbbsq\xe7\xfaΤά\xce\xe2\xceC\x9d-\x9d\xaf\\\x974@\x92\xd4\xf1Ak\"\x97\xd65\xcc\x15\xeaZ\xea\x8apź6\xbb\xb6\xba\xec\xaeRW\xa3\xeb)W\x9b\xeb}ח.W\a\xea\xd0vX:\xc6t\x8c\xed\x98\xd2\x11ڱ\xb4#\xa2ck\x87\xbd\xa3\xb4\xa3\xb1㩎\xb6\x8e\xf7;\xbe\xecpu\xa2Nm\xe7\xb0\xce\xd0Υ\x9d\x11\x9d[;흥\x9d\x8d\x9dOIk\xf6~\x85P\xef\xda\xdee\xbdsz\xa7|\xfe\xfd畟/\xd7\xfai}\xb5X\xeb\xa3Ui9\x84\xb4J\xadB+\xd7ʴHӫ\xb9\xae\xf9M\xf3\xab\xa6Sӡqi\xfe\xa5\xfeL\xf3\x8b\xe6\x9a\xe6\xaa\xe6g\xcdO\x9a\x1f5?h\xbe\xd7|\xa7\xf9V\xf3O\xcd7\x9a\xaf5_i\xbe\xd4|\xa1\xb9\xa2\xf9L\xf3\xa9\xe6\x13\xcdǚ\x8f4\x1fj>\xd0\xfc\x83\x9c\xf5(\xd1\xd6\xff\"\x83\x95\xff\x0f\x14\x86E\xbeH\x81\b\x92{VW\xca\xf6\x8e}\xfb\xe1\xb6\r\x9aYב\xc2c|\xe8\xe2\x92YS\xa4\xfc\xf2\xea\xe1#z{o\xf6\xfa\xc8X\x99\xc7V\xe4\xb7U\xbf϶|$\x9e\xf5\xfa\xc8\x06\x1b\x83ܡ\xb0\xa3\xad\xb26\x84X;\xda\xce\xd8\x11R\"\xb4A\x9a\xfdnIj\xf7\xc2\xeb$\xc3co\x97=\xb0\x94<}\xecw\xb6q\b\xa53v\x94\xd4߇\xed\x1f㝓\xb5##\xeb-sv4\xad\xbf\xcfݒ4N\xd97w\xa8\xd4O\xe1-\xdfZ\xfb\xf6<\x88\xb5\xf7\xf62\xfdx\x0e\xe8\xa3\x1c\xb0>\xf7?%/.Jt;\x1fH/wg]\x063\x10\xf7\x01\xed\xac܃K-\x83\xd0\xea\xffD\xdb]R\xa8w\x9e{\xa4\xc4ݮ\xf7\x1b\xc8\x0f\xc6\xfe\xefr\xbaC6}\xf9jO\xb2\x0f\xc0\xbd\x8f\u007f\xa1R\x1f\xd5 \x1e\xf7\xd3>`M\xa4\xb0\x0f\xa0\xfd\xb6\x1cn\xd1:0g\xbc\xbcfn\xb7O\x90t\x8b\x1d \x0f\xc6~\xc7z\xa1,\xea\xed\x1d\x88\xf3\u007fLvd\x18H7{7\x99ّ֛\xc7\f\xe0\x8b\x92\xfdw:5w\xd0`G&\xae\x9f\xef\xde\xe4\xe3\x9dS镥\u070eB\xe5^\x1e\xf6\xd7)\a\xe1\xcd!4f N\x03y7Pg\xb8\x01\xbc\x18 \xc7Ё\xfcan\xdbU\xe8\x00\xfan\xe1\xa8Dh\xa2\x12!?\xa5\x1d-\x92\x92\u070e&\xca\xed\xb7\xcb\xfdk(\xbd\xf6\xc2ީ\xbf\xa1\xec`\x9d\x1e\xa4;\x03l\xb3\xbf-\xd4k\u007f~\x9c\x1dM\xec\x1f\xcb\f\xe0\x89\x94\xcb\xfb\xd2F\x16!FJ\xfd\xba\xa7\xec\xeb\xeb\xc7\xdc9\xcer7\xb9{y\xe2\xdbo\xf7\xfdu2\xfb\x1d\xbc\xbe\xa7\xbf^9\xc0V\xff[\x1a \xb7\x89\x8a\\\x84\x94\x8a>]\xf5\xe8\tB\xf7\x0f\xd05QJ\x1c\xeaKl\u007f\x1a\xe0\xcbX\x84L\xff\xaeˈ\f\xa6û\xee<\xf6vP\xd3\xcf\xcb[\xbc\xf3\xf6co\x8dCh\x827\xbfE\x17\x87\xd08\x16\xdd\xf2\x81\x16\xce\xdeg7\xb7t\x05!\xc3]l\xe6\x96\xcdJ}T\x83|q\xbf\x0f\xf0\xe9\xeb\x9f\xc8\x0e\xd6go\xdf~?\xc1\xfd\x8e\xc6\x0e\xb6%/\x9e\xfd~\xd9C\x97\xcf\xc01\xb7s\xad\xeav\xbd\xa5_\x1e>\xff{\xbf(\xa5\xd1\xfd8{\xf1\x97\xf4'`\xc0\x1a\xf3\xbc\xf8L\x97\xeao\xf7\xeb\xed\x1d\x80\xf3l\x06\xa1\xa1\xec\xdd|ߝI\n*6\xb1\b=\xe6\xc5{\x9cw\x1d\x15\x87\xd0\b\x1f/\ue0d3\n!\xb5\x94\x18\xfb\xdd\xe7W\r\xca\xfb\xf9;@\x0e\x1e\x98A\x88\xa8\x06ѯ\x1a\x04\xab\x06\xc3^\xf9z\xcb:v\xc0\x9c^\x1d\xef\x97\xd7 \x9f\xdf۫\x1a\xd0\xe6]\xff\x96mpw\x19\xa7\x1a\x84\xc3`\x9dP\xb9\xd1r\x1fo\xf9n|\xea\xd7m\xd5 \x1d\xe8o\xe7\xfe\a\xdd\xe0\x06\xe1qK\a\x9e\xbd\xa3\xed\x96>2\bE{\xc6>\u007f{~\xee\xee\xb2\x19\"\xed\xb5\x1cB\xe6\xbb\xf5\xf1\xfa\xf0 \xd5\xdd\xeb\x97\x0f\xc2/\xcc\xe7N]\x1dL\xdb\xc8\u007f\xa7\xabO\x16\\\x9f\x8dlQ\"4\xa6\xbf~\xf0\xbe\xc7\xf6\xeb\xbb\x1d\x8d\xf6A\xb7m|\xe0:\x9e\xf6^\x14Կ\a1w\xfa\a\xcd@\x1da\xef\xdc\xfb\x83\xb8\xff\x18\xa6.Er\xa7\a\xf8\x83\xccx+\xbe\x9c|뇗\f\x114\xd9\v+\x90?\x9aᅕ\x03\xea\x19\x14\x80\x16xav@=\x87\x96\xa1u^X\x85|Q\x8b\x17\xf6A\x18\xbd\xe8\x85\t2\xa0\u05fc\xb0\x1a\t\xe8c\xe9סR\"c=\x02/,CCd\x8fya9R˶xa\x05\x1a.K\xf7\xc2\xca\x01}\x184Rv\xd0\v\xb3\x03\xea9\xd4$\xfb\xb3\x17V\xa1\xa1\xf2H/\xec\x83\xf4\xf2\xfey\b\x1a'\xdf\xef\x85\xd5h\x96\xfc\xb9\a\x93\xb7\xa6[\xe3c\xe3l\x96y\x91ۣ-\x0fE&mJ\xb7L\x9b2\xf5\xdeI\x969\t\t\x16OS\xaa\xc5\x1a\x9d\x1am\xdd\x1e\xbdi\xd2\xfc\xe4$ۜ\xb4\xe8\xd4\xe4\xc4\xe8\xf9K\x16\x87/\x9c3wƒ\xf0\xf9a+\x97.Y\x1e\xbe\"ښ\x1a\x9f\x9cd\t\x99t\xef\xa4`\xcf\x1cK\x13\xa2#S\xa3-\xd6\xe8\x98h\xabŖl\xb1\xc5E[n/\x98\x1a\x1de\x93\xfa\xc7$[=-1\xc9I6\x8b\xcd\x1a\xb9):1Һ\xc5\x12i\xb3Y\xe37n\xf3tIJ\xb6\xc5GE\xa7N\x9a\x9fl\xb5Y\xbc\xeb\xdf\xc67\xcef\xdb:c\xf2di|d_ۤ\xf8\xe4\xbbVNN\x88\x8f\x8aNJ\x8d\x9e\x8c\x1eD\xc9h+JGV\x14\x8fbQ\x1c\xb2!\v\x9a\x87\"\xd1v\x14\x8d,\xe8!\x14\x89\x92\xd0&\x94\x8e,h\x1a\x9a\x82\xa6\xa2{\xd1$dAsP\x02J@\x96\x01\xa3R=\xa5h\x94\x8a\xa2\x91\xd53z\x13\x9a\x84\xe6\xa3d\x94\x84lh\x0eJ\xf3\xb4%\xa3D\x14\x8d\x96\xa3h\x14\x8b\xb6\xa1\x04\x14\x89\xach>Z\x82\x16\xa3p\xb4\x10\xcdAs\xd1\f\xb4\x04\x85\xa3\xf9(\f\xadDK\xd1\x12\xb4\x1c\x85\xa3\x15\x9e9SQ\xbcg6\v\nA\x93<x\x04\x0f\xc0j)J@\xd1(ҳ~\x1f&1\x9eQ\x16dCɞ\xbfq\x9e\x96\xbb\xd1+\x8d\x89B\xb6[\xf3Ǡd\xefȾ11^*\xa4\x1a+\x8aD\x9bP4J\xf4\xe0\xbe\x05YP$\xb2y\xea\xe3\xd1F\xb4m\xc0,I(\xd9S\x8a\xf2P\xde\xc7\v\xabg\x96;\xb9q7n\xc7y\xe6܊f\xa0\xc9h\xf2\xad\xf5#\xef\x187ɳ\xd2\xff\xbe\xe7d\x94\xe0\xc5&\xc9C\xf1\xe4\xfe\xff\xda\xfc\x97\xcf\xff\t\x00\x00\xff\xff\xcbC\xdf\xc7<\xe8\x01\x00",
@@ -143,25 +143,25 @@ var staticFiles = map[string]*staticFilesFile{
 		size:  0,
 	},
 	"js/controlPanel.js": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xec\\[s\xdb8\xb2~\u05ef\xe8a\xb2+r,Qr2\x93:gm\xb9ʎ';\xde\xc9mb\x9f=\x0f9~\x80HHBB\x02\f\x00\xdaVM\xfc\xdfO\xe1B\x12\xa0H]ffS\xfb\xb0S5\xb1\x05t\u007f\xdd\xe8n4\x1a\x17\xf9\x0eqHJ\xce1\x95?c\xb2\\I\x98\xc1t\xa0Z3\x8cR̝Ɓ\xc0\xf2\x8aJ\xcc\xefP\x16\x96E\x8a$\xfe\xf9\xe6\xcd\xeb\xd1\xf3\xe9t\x1a\x9dh\x1e\x81\xf9\x1d\xe6\xefhF(\x86\x19,P&\xf0`2\x81\xff\x118\x05\xc9\xc0p\x81`9\x06\xb9\"t) \xc3B\xc0\x82\xe3/%\xa62[\x1b\x98Ϥ\xa8$\xd50\x83\xa7\xe1=\xa1)\xbb\x8f⌡4\x1c\x00\x00,J\x9aH\xc2h\x18\xc1o\xba\x01\xa0\xd1,\x8cl\x93\xc0\xf2\x86䘕2\xac\x18\xc0\xe1\xe8\xe5{\x1c\xc1\xb1\x19\x9c\xfe4\x88N\x06\x83\x1a\xc0\xa5\xd7POc\xf4\t=\x84\xc3x2\x1cYlQ&\t\x16\xe2o\x8e\x9e\xbf\xd5:y\xa6\x92\xbc\xc4F\xcaH\xff\xc0\x9c3\xbe\a\x9f\xb1\x8dQ\x0f\xe0Qi\b@\x16\x10~\xe7\x12Vc}\x1a\x06OL\xfbXH$K\x11D\xb1\xc4\x0f2\f^\xa1D\xb2<\x85\xb7L\u0087\x92RB\x97\x811\x03ǲ\xe4T\x81\x03\xce\x04\xde\x1b\xc9Ey\xac\xb4Rl\x84\xa6\xf8\x81\xa2\xbbq\x8e\b\r\xa2x\x85\xc4\xcb\f\t\x11\x06D\x8cQ\"\xc9\x1d\x0e\xa2J\xe3\xc9\x04\xde B\xe1\x06\xcd\a\x8e\x97tT\x86\x118m\xe7Y\xf6\x1ec.\xac\xf7&\x13\xb8dX\x00\xbe\xc3|\r\x882\xb9\xc2\x1c\x92u\x92\x19s\x91E\xf8\x9d\x1bg\x91\x1f?7\x1cQ\x81\xb4\xedE\x13G~\\6>s-\x03\xdd\xe1[\xbb\xc8ВE\xcb\x16\x8c\xe3=lq\x89%\"\x19N}{\xa0K\xf5\u007f\x99\x17F\xd5\xc7\xc1\xe0q\xa0\xa7\x9d\x99n\xf7+L\xe1\x1e\x83\xb8'2Y\x81Ds1x\x1a\x06\xb1\xfae\x9c0*9\xcb\xc6\x05\xa28\x83\x8c\x00\n\xa28\xc9H\xf29\xf4\x83ϙD\x03o\xe2\r\xc0\xeaךA\x8f#x>\x9dF\x83\xc7H\xcd\xdd\xe0IZ\xe6\x85\x16\x87\b\xc5\x1c\x9e,\xca,\x13\tǘ\x8eY\xa1\xb0j\xc1\xad\xb0\x97\x0f\xf2\x9cc\x043\xf8\xf4k\x89\xf9:\x94+\"\xa2X\x90y\xa6RH\x18Ď\xb1\x1a\xfaX\xb2\xe52\xc3֞\x8d4M\xe3!y\x84h.XVJ<\xee\xd0o+\xe3\x82<ഓKY@\xf9\xe3\x86\x15\xda\xfa\xc0(h\xcf\x0f6\xe6\x03\x9cu:@[XM O\xfc\x96h٘\xac\xd2\t\xe8 \x8a9\xce\xd9]\xa5\xf9\x8a\xa4\xd8\xcevE\x9a\xb1\x04e;hR\x1bqA\x14\xa34m\xd3<\xd6N\xf7\x02\xfc[\r\xaeC#\u007fd\xbd\x04ΰ\xbaGoF\xe6/\x02\xee\xf4\xd3Jq,\n\x98\xc1\x175\x9ak\x89$\x0e\x83\x1ax\x04A0\xaaǮ(m\xe6a\xf3O0\x83\u007f\\\xbf{\x1b\x17\x88\vl\xfa\x1a\xcdʼ8\x06\xfd\xe3zŸ\xac\xf2-\x9b\u007f\x8a+\xf9Ǳ\xeeR\xbfv2~@\xf7\xddl\x1fнa\xf2\xb8\x9em\xe5z\xd6\xc3\xf5|+\xd7\xf3\x1e\xae\x1f\f\xd7y)W]l?Ī\x87q\"\t\x16Q\x17\xe7U\x8a\xa9\xecf\xd5]\xfd\x9co\xd6o\x99\xf2n\x17\xab\xe9k\xe9\xfa\xa3\xe1{\xc9h\xcf \u007fl\x06\xd9\xc1w\xdd\xe3\xbd\x1fcՃӊ\xf11Ri\xbcUo\xf8\xebR_\xb4q\x9c`*]\xda`tx\xd8M&v\x95\xba\xbc\xb8\xc8X\xf2٬\xba\x95\xea\x11|7\x03\xad?\xe18\x91\x8c\xaf5Q|ya\xe8\x9a\xfa\xca@\xfc\x82\xd7o>\xd8\fЌ\xdd\xe7\xd54\x91\xc7v\xc1ҵn\xde\xc2V\xd3\xf8\xac\xaf\xca,\xfb\x19\x89\xd5\x16Ί\xa4%S\xf5\xa9\xc5NH\xa4sA\x1f{M\xd3\xc1\xef[k\x9b\xa1\x1c^\xe3\xb8qZQ\x8e\xe7\x8at/\x10\x8bB\x16\x9aLWa$uC@\xf9\x8b\x96YS\xe9h\xe7wQ\xc6\v\xc6\u007fBɪ\xc9\xd1:\xc1\xfa\x153Y\x98\xd6\xf8\x86I\x94]Ѣ\x94p\x06\xd3x:\x9d\x1e\xb7kk\x1bG\x05\xa2V\x9a\x803PY\xfb\xe15\x11\x8aM\xceY\xba\x86'\x01\x1c\x81\x05}\xb8\xba\x8c\xe2\fӥ\\)\xd86b\xab\xe4\xaa\xfe\xdbCJ\x10\xc5\x05\xc7\x05\xa6i\x18\xfc_\x8b\xfdTr \xe9l\xe8\xeb\x01G\x10\f\xcfڴ\x86>=;E\x9ae\xa1\xcbޱ\xc0\x88'\xabqF\xe8\xe7!\xc8u\x81m\x0fIQ\xf2yx\xb6\t|:Ag\xa7\x13\x99\xf6\xe2;,\x8d\xa15\xe3\x81L\xe2P\xaew\xa5\xdc\xcav:\x91\xfc,\x88Z\xadU\x99\xbf\xcb\xd9g y\xe0\xb8\xf8x\xba\xe1\xe4==\ng\x06\t\t\x19V\xebvh7mn\xc4\xf8\x014\xe8\xfa\xfd\xb1\xde\xfa\xf9\xd3\xe9'*9\xc1}S\xc8\xf6nN\x1bL%_\xfb\xa3\xd2e\xa0D\xd9\xc0\x1f\xa2\x9d\xf8\x9aa,\x15A\xbd\xa1\n\x95[\xac\x19*=v\x1b\xf4\b\x82\xc8\xf3\x8d\xe3\x97\nE\xcf7-2\xd6Ip\xcb|\xb3388jȕ\bx\x92\xac\x10\xa1W\x97MZ\xd7낡\xd2}$\x8d:\xa7\xe9\x1eP>J\xaf\xfb\x9a\xb1\xed\xab^\xf0\x9e3\xb51\xd7\xfb\xd3=\xb53\xae\xd1\xff\xaeT\xa3BDR\xf20P\xd3\\\xd5u\xba/خg\x8f\x9a8I\x98\x90\x1d&\xfc\xe9\xe5K&\xe4\xde:z0\x1eB\u007f\xf0we\xd2\xdd\xe1֟F\xdd$\xea+ؕDOe\xaa\xa9[\xe6\x1d\xeeΫ\x9a\xb6Ϊ\xbe\xa4-Y\xb5\x12h#c\x0fA\x9ar\x85Q\xeaJ\xb2Q\t{J3\x9eq\x01\x8c_z\x92kWj\xed\x98\xc0\xbf;\xaf\ue0f3;\xa9\xee̡\x83Μ\xe7\xe4;\xbb6n\xc9x\a\xac!u\xca3\x95\xf3d\x02\xcfA\xed(\t\xe6\x02\b\x85\v$\x93\xd5\xc6\x01^u\x94\xe4\x94\xd2sE\xf8\xabSO\xe7kC6r\x0fEG\tˋ\fW\x10#s\x1c\x96\xb0\x92\xcaQ\xb2B\x94\xe2\xec\xb5V\xec\xe0»\x12\a\xba\xc0\xfe8\xbd\x8d\xcdgݙy}\xc7^\x9f\xd2\xc8\xeb~\xe6u/p*l\xc7\xf3\xdbx\x81S݊J\xb7\x15\x95\xa9=\xf6\x13\xc5+r\x87m\xcf\x0f\xb7\xd6ʃ\xd6\xf1\xdf\x02\xa7z\xc8A\x14ߡ,T\"\xa2\x16\t*=\x12%ϖ\xab\xed\x93gm\x88+*\xc3\xca\x02\r\x14e)\xaeKj\x05Ӑ\x18\xb3\xf8\xc7\xd55R\xe6\x02\x19\x97\xbf\xe7lɱ\x10\x17\x88+\x1d\xd74yE\xb8\x8e\xaa\xb8\xb0]\xe3\x1cK̃\x91\xaf\xe1ȓb \v\xccU$\xeb\x13r\x9b\xe2}Uf\xeeb\xdaP\x1fO\xa7]g\x87\rA艞x\x92\xe1\xfb\x9a\xdfey\x83\xe4*^d\x8c\xf1\xd06F\x83fn>\r\x87\xdb\x06\xbb\xd92V\xb3qh'e%\xe5\b\x82\xbf\xc0\xf5\x9a&8\x05=O}\x1f\xaae\x88-@uxf\xb0s\xd3\xee+;\x1d\xdaĿ?\xb1\\o6\x01\xbeݡ\xd78a4\xed\xf6\xa8?k\xfb]j1\xfe\\\xc7֠\xa1\xaf\xc7n\xff֜\x9b^6]]\xbe\xee\xb3\xc3~ζܛ.\xf7\xfd\xd3\xebs\xd7\xe5pID\x91!\x93Q\xe1\xa5ɏ`s\x8a%I\x18\x15,\xc3qƖa\xa0H\xc0$п\x05\xa3:\x1f\xf5\x9e\x8c\xb8A@\xd2z\xe6\x8e G\x0f\xd5\x11c\x98\xa3\a\xcfq\x9b\xd3m\xa2\xc9\x1b\xfb?\rI\x1a\xc5\xf7$\x95\xab08\x9eN\xffbV\x18\u05f9\x87\x81Xje\xd4\xeaDq\xa0o\xbd\n\x8c\xb9\xaa_\xb0\xca\xc6\x1f\x83\xe0V/a\xcf\xec\x12ֿ\x825\x17\x1fZ\x9f\x8d\xc5K\xe1\xea\xe5W\x8cԯ\"\x18\x81\xb7\x1e}@\xf7ۖ$\xd5]\xaf\b\xef(\xae\x17\xa5\xba\xb1^\x8a\x9a\xf3X%N)\xf5\xd2\xe6}\x1dU\x8a֮\"MX\x18ͪ\x19feT+\xba?\xc7ꛨ*\xcaUE\xa4J4\xb6h\x94\x9bAP\xd2\x14/\bũSۛ\x9c\xa3\xc6_\x15\x10\v\xc6\xf4O5\x17tǗ\x12eD\xae\xeb*dj\xeb\xaf\xd6D>\x1ci\xc1x\x8e䯦Qo'\x95i\xec\xe7\xf3\xbbe\xe4\x9e\x00\xf5\x02\x97\x85\x8fw\xb1\x96X\xd4\x06ӟ\xae1\x95ڞ\xa3\xca\x1e\xf1\x1b,\x04Z\x9a\xae\xfd\xe4\xa4\xec\x9e\xee\x94\xf4\x01'\x98\xdc\xe1\xb4GZ\xd5\x1d\xb99I9\x1b\xcd3\f\x17,]\xbb\x0e\xef\xf6vKMS\xf0\xe9r\x0f{{\xecȭm\xbd\x1b\x84\xaahݬL7\"\xa9c\xee\xd5\xf1\t莑\x14V%M\xb9*\xa0\xd8\x02(\xbe\x87\x95\xcc3\xc0\x19\xce1\x95\xc2N\xc5TMR\x04_J\x92|\x06Q :\x02\"\xe1\x9ed\x19\xcc1d$'\x12\xa7\xb1\x86\xa6\xf8^\xcf\xdaz}Y0\x0e\xa1\xbe.Q z1t\x16\x0f\xcca\xa6\x1b?j\x92[\xa7\xc3\xe8\x1d\x17\xa5P\xc9\x05s\x1d]\xee\xa1f\xb5\xeb\x84#M\xbfu\xaf\x9f0\xaa\xd6[E\xf6\x92Q\x8a\xb5\x8d\a\x1b\xfbl\x1fjA\xd4.\xf0\t)\xecJ\xae\xf7\xaf\xbe*\xde\x0ed\x1b\x846[\x15\u007f\t\xa3\x1a\xe2<MUj\x8f\xf6İj\xb44\x98L\xe0\x9f(\xb3W\xb6\xbb@R\"\x123\xfez\x9b\u007f\xa7\x98\x83Qk`\x83\x1dp\xac\xa4)2\x81:\xd8\xdc+\xed2he\r{\xd0@d\x86\x03m]e\x99\xc6Ao\x99ĭ\xe3Y\x1b\x990\xdb\xc7\xda\xee\xd1\xd8=IV\x86k\x85\xc4Xjk\xea\x98\v-dt\x02\xfe\x98c\xc9Xf\b\xf1\x97P\xf1G\xb1\x9a\x1da\x97\x92'08\xd8\x0e\xd6\x13*\xa17\xf1\xe5\x83\xeb\xb5n\xdf(k\xe3uB\x1d\x14..b\x1d\xb9mȁ\u007fz\xeeN2\x9c\xaa\xec\xa7_\nD\xf0\x9b\x92\xfd\x16\x9b'3*\x85\xa9\x9f\x98\xa6\x9b'4N\x0e\xb7g2Z\x9f\r5;r\xa1\xb7c\xdf\xd3\x0f\xfe\xda\xe6y\xc2Y\xd1\xf6\xf5\xc2&\xda\x06P\x87\x0f\xc8\"\xfc\xaeK\xc5\xfa\xba\xd8_mېQ\x14m\x9et\xb5\xa0ܛ\xdfh\x17q}\x95\xbcCn\x8f\xe1\xf7\xb4\xbc\xc0\xf5\xf69r\x97K\xf8\xfa\x15\xf6\xe3\xaa\x1cU\x17\n\xfb\xba\xc9\x01i\xf1\x1f4C\x84s=\xeb\x96\x15\x1e\xe6H\xab\xd8Y\xb3\xec\x1f\xa5\xdc\x16\x1f\x87۫\xcd\xe9٬\xaei\xf6\xb4[\v\xac\x03\xe7 \xfb9p\xfd6\xac\xb0};\xb6\xaa\xb1Cl\x993\x95\xf1;\xf3o\xabF\xb8!9~\xa5u\x92\xfbۨ\x1b~;\xf2AV\xdb\x14`\xf7\xb7[$l\x18i\xe3\x84ܩ\xdd\xea_\x8f\xe0سi\xddq\nϦ6\xa7_-\x80\xdda\x0eϦ\xba\x82Ի\xb1\x110\x9a\xad!c(\x85g\xd3\x18\xfeW\x15\x8bK,\x81\xe3\x1c\x11J\xe8\x12(~\x90P !\xe2\xf6e\x82\xad\x8d^q\x96߰\xe2F\xbfFr\x17\x92\xaeS\xdf\xcd5c\xaf\xeb\xd0ڶ[oC59)\x86g\xa7\xaa\xb0\x80\x14I4\xb6\xd5\x01$*MΆ\xb6\xaa\x00Ɋ!\xe8\x8af6\x1c\x9e\xbdf(%t\x19\xc7\xf1\xe9D\xb1n\xbd\x135\xe7\xf7\x95S\x87\xbbi\x9d\xa5f\x0f\xeaV\xd0\xec\xc1\xa1\x92\xdb\x10t\x818\x1b\x8e\x8f\xa7{\xb0T\xf3y\u007f\xb6ꢢ)M\x87\x95M祔\x8c\x82$t\r(\xc3\\\x0e\xcf.k\xaa\xdeۉ\xaeK\x86m\xf7ꛑ\x83\x8a\xff\x04\xce\u007f\x02\xa7\xaf\xa8y\xf47\xff/3\x8chY\xc0\aVJB\xf1\xe0wl\xf1U\xf1\xe7m\U0007bdcdjÒde\x8aE\x18\xd8\xf8\bܺO\xc1؇\xa8\"l\xb6\xd0#\xe8ƮV\xbd\xc8˨;\x8e\x1a\xfa\xd6\x0e\xb2\b\xf7\x19\x81>\xc8jb;\x0e\x0e9\xe6\u061c\xc3{\x88\xf4\xa5m\x0edP\x9f\xa0<F`\xef\xd7\xce\xd3\x142\"$\xa6j\xa5\x93\f\x9a\x10\x03\x13Z\xfa\xa5\xb1\xcd\x16\x8c\x86Ü\x95\x02\x97\xc5p\xe4\xf8\x1d\xdc\xddvsWf\x170\xef\x15\xa5C\xe7\x8f\xc9ݢG\xad\x03\xce\xdd\x17n\xf6\x95ǹ~\xad\xafM\x9fbJ\xbc\x03Ī\xc6PtWi\xcf9A\xfd\xac4%\x02\xcd3\x05p\x00\xbbqå\x95<\xe8t\xe5\x1fT\xe3\x10EΥ\xc4y!\x9bo\x02<ڣ\xf7h0\xb8f\\V\xe5\x86y\a\xdf]\x8a\x98\xbe\xc9\x04\x14\x03\xa1\xcb\xeaW\x98\xaf\xe1\xb2\xe4\xfa`dP%\x81qj[ڱ\x02NL\xe8oZ\x84A,\f\xe0\x98\xe4\xcb\xee\a\xbdd\x11\xbaZ\x1aU\xdc/Bx\"\xc7\nςm{\xf7\xdc\xcbd\x02P\xf0$\x18\x05$_N\xca\".\xaao?\xb4\x9f+\xffk%\xa7\xec\x9e6\xb2U2F\x9c\xa3\xb5=\t\xeaʶK,u\xfa\xb8C\xd9\xf9\x0e\xd2\u07ba\xda`8\u0096*)\xa0L\xf9 \xacԾ\x12\xaf\xb1\x107+DG\x86nT\xcbԼ\x9bR\x03{\x94\x84j\x9a\x9e@k|\xad\x03ԉ\xb3\xab\xf7M\x84\xe9d\xfe\xadb\x8b\x14\a\xf9\xd6'?8\x9e\xfeTi\xdf\"\x86\x9a\xf5gk\xec\x90\xe2\x0fGM+ \xae1\x95MH\u0603\x89o\x15\x14J\xdcA\x8ej3\x1c\x1c\x18\u007f\xba\xc4o\x11\x1c\xd6+[##\x17\xcb?\x1c\x1a\xbf#\x9fT\xc7)M\b9g3\xdf*\x8c*\x91\a9\xb6\x8b\xe9\xe0p\xfa\x97I\xfe\x16a\xe5x\xea\xdf&\xb4\xea\xeb|W\x81\xcc\xca~e;]\x1dJl\xb4\xa8\x1e5\xf4\x87\x8bW\xa6U\xdfC\xf4ܺYș:O\x8f\fS\x989\x02\xe3\xfa\xb5Ƃq{U9\x83\xe9\x89\xf9.\x1b\x9cVL\xb6\xe1\xe8\xa8RC\xe6\xc5?\xf5W!\x1b,\xf7\x1aS\xe6\x05\xcc\xcc\xf8\xaa\xe6\xaa*\xef\x1f\x9aQBU\xf4F\xfa\x18\x8eO\xe0\x13\x9c\xc1\xf8\x18\xfe\xfaW\xf8\xaem\xc0Б\xfd\xe96&\x94b~\x83\x1f\xe4\xc8j״D'\xf0i<v\xf7z.\xeb\xd1\xf1\xad?\x90O\xb75\x1drI\x90\xdf\xfb\xd8U\xcfo\x1d¿\xe9\b\x8co6\x01\x8d\x12\x83\r\x14\x99\x176\xa6̭\xbb\xe9\xf5\x1e\xf0x\xb3-D#\x98ױm\x9fw \xfdj[H\xaev#\xf0\xf5+\xd8\xf6\xb9\xdb^\r\xd8ʙZ\xb1d\x11\xa2\xf6\x1d\xc0\xbc\xeb́ǧ\x12\xc3u\x91\x11\xa9\f\x11\v\xf5[\x18\x84:7\xe9\xef\xfb\xa9f\xdd\xff\xf1\xf8\xb6\xea\x06\xd3mb=a\xf4\x0e\xab\xe85g\xf4\x9a\xe9\xe3\xf4vd\xd8?\x1e\xdf\xea,2\xafd\xcc}\x19s+c\xde-c\xde)c^˘\xbb2\x94\x01\x14\xfd\xa9fk\x8d\xf6\xd8w\xcet\xe0y\xc6-\xbe\xfe4ǌ+\x99\xb5]́\xc3\xdc\xfd\xa8\xbaM\x02BMޙ\x9b\x96yӢƦ\x1aOu_\xe7ت|es\x15\x9cj\xe0\x13 GG\xf6d\x80,·e>\xc7<\x9c\u007f$\xb7\xe6\xec\xe5-z\x1b\xb4\x9f\x1e\xd9#\xfe\xe6+ܖ\v\xf9\\-\xa6\xa9;o\xdaL\xa7\xe0J>L\xe0\x99\xcf\xdb#־0\xab]\xba\xb9\x17s\x1c\x8b\xaeq\xe2ƕy\x01(B\xa4\xfd\xd3\xd39\x8f\x06\xed\xd7{h\xa4\xa1F\xc1\xd7`4\x1fiN[\xdb\x18\t3\x95\xe3\xd4<\xac?\xf5\xc5H\xc5r:3(-\x0f\xeb':v\xd9rske\x04\xd5\xff\xaaZ\xf8<;l\fC\x92\x1c\xb7\xc3[\xb5\xed\x13\xc9\xe6\x8f_h\x1c\x95\xf2H\x8e\x9d\xf9zb m\u007f\x95yN\xe1Y7\xda\x00\xec]\x91\\a\x8e\x81\b@0\x85\x9c\xd0ɊORU\x03\x10\tb\xc5\xca,\x05!\xf5u\x11\xc7Hbn\x18\xe5\nQ\xc8\xd8=\xe6\x90b\xcarB\xb5\xbbc8OSB\x97p\f\t\xbb\xd3\xc7u+\fSH\x90\xb6\x8dU\xee\xe3\xf4\xf6\xe8\xc8SW\xa5\x9e\xe64U\xe0\xa4\xf9{\x04V\xed\x86\x15\xbe7S\xae\x0e\xd7N\x8c\x9c\xd0\xed\x18/\xa6\xbbAV|;\xc6\xf3\x17\xd3=PR\xb4\xde\x0e\xf3_/~08ݡc\xd2.ճp\x04&F\xea\x102\x1f\x1di\xbf\\l\b3\xac\xe6\xa5hK\xdf6\xf7\x9b\x1d\xdc;\x01\xfe\xbe\x1f@{\xa4\xe6\x94|\x85\xd6B\xa2\xe4\xf3\b(\xc6iV\x97a*\xf0Uf\xad\xfamt\x9b\xef\x89ܯH\x86!$^-B\x16P\xa3}$\xb70\x9b\xcdZ\x98\xe0\xe51U\xf4\xd5_;q\xaf\x14l\xbf.kO<\xad\x97X^\xbd\xd7OO\xf9:D\xf6\xed\xd8o\x03\x98|\x0fOU\xdd\xff\x8f\xebwo\xc3\xe1J\xca\xe2o\x93\t)\b]\xb0\x98\xb0\xc9\x10\x8e\xc0R\xc3\x11\f\xdd\xfd[\x8a$\xb2\t\xd6Ms\xaa9N\x8c \xf7\xaf\xa5@pu\xfd^?\x95\xd6\x14\x8c/\xf5\x03xx\xc7ɒЦò\x9a\xd7\xf1\xfat\xf5\xfbI\xf5\xb7;\x84\x9a\xac\xf2\x9eAƖDH\x92\xd4ڈf\xa4\xfe\xa3\x93/\xee\x03\x1c\xb2\xa8>\xc3\xd9\xcc\xfd\x16P\xa5\"G\xf4\xf3x\xa9\xff\"\x86\x178\x0e\xd7\xf8\xc7\x1e.\x96\xa5AO\xca5\x14\x1c\x1b\x02\xcf/\ue6c5\xb9\xfaw\x04\xb9}\xa3\xd0\xfc\x11\bݡք\xfa\x19\xafZ(*:\xaf\xa3\xad\xdb\x14\xc2)\xfc27\xa6T\v\x16\xcc\xea%R\xa3N\xe0\x18\x1f=\x8fb\xc9^\x91\a\x9c\x86\xc7Q%\x14N]\x13)ƹ~\xd7\xfe˅g\x1c\b]\xa4\x17\xd1&ۦ\xbc\x17-y.\xfc\x9b\x8b\r3\xf6\xc1\xfc\xf7\x16\x98\xbf_TC\xceaV\xdbʎ-7_\x02\xab\xb5\xcc\x1b\xf8ڪ\x13Cі\xa0ь\x1d\x02\xbfNԭ:\x90\xe76z\x1f\xff?\x00\x00\xff\xffy\xcd;2KJ\x00\x00",
-		hash:  "0869984dd0709d9f41ea5b5698ba8f90842d92dc74e3df452dee73ea597fa817",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xec=k\x93\xdb6\x92\xdf\xf5+:LvE\xeeH\x94\xc6NRw\xf6hR\x1eO\xbc\xf1%v\xb2\xb67Wu\u07b9*\x88\x84$\xd8$\xc0\x10\xe0\xc8Z\xef\xfc\xf7+\xbcH\x80\x0f=&\x89k\xf7jS\xbb\xb6\b\xf4\vݍF\xe3\xe9[TBR\x95%\xa6\xe2;L\xd6\x1b\x01\v\x98\x8fdi\x86Q\x8aK\xa7pıxN\x05.oQ\x16VE\x8a\x04\xfe\xee͋\x1f&\x0f\xe7\xf3y\xf4X\xe1p\\\xde\xe2\xf2G\x9a\x11\x8aa\x01+\x94q<\x9a\xcd\xe0\xaf\x1c\xa7 \x18h,\xe0,\xc7 6\x84\xae9d\x98sX\x95\xf8\x97\nS\x91\xed4\x99\xf7\xa4\xb0\x9cj2\xa3/\xc2-\xa1)\xdbFq\xc6P\x1a\x8e\x00\x00V\x15M\x04a4\x8c\xe0\xa3*\x00h$\v#SıxCr\xcc*\x11Z\x04p0\x06\xf1\xee&p\xae\x1b\xa7\xbeF\xd1\xe3Ѩ&\xe0\xc2+R_\xc4\xe8\x1d\xfa\x10\x8e\xe3\xd9xbh\xf3*I0\xe7\x8f\x1c9?\xd62y\xaa\x12e\x855\x97\x89\xfa\v\x97%+\x8f\xc0Ӻ\xd1\xe2\x01\xdcI\t\x01\xc8\n\xc2\xcf\\@\xdb\xd6/\xc2\xe0s]>\xe5\x02\x89\x8a\aQ,\xf0\a\x11\x06\xcfP\"X\x9e\xc2K&\xe0UE)\xa1\xeb@\xab\xa1Ģ*\xa9$\x0e8\xe3\xf8hJ.\x95;+\x95D#4\xc5\x1f(\xba\x9d\xe6\x88\xd0 \x8a7\x88?\xcd\x10\xe7a@\xf8\x14%\x82\xdc\xe2 \xb2\x12\xcff\xf0\x02\x11\no\xd0r\xe4XIye\x18\x81S\xf6$\xcb~¸\xe4\xc6z\xb3\x19\\3\xcc\x01\xdf\xe2r\a\x882\xb1\xc1%$\xbb$\xd3\xea\"\xab\xf03\xd7\xcf\"\xdf\x7fޔ\x88r\xa4t\xcf\x1b?\xf2\xfd\xb2\xb1\x99\xab\x19\xe8w\xdf\xdaD\x1a\x96\xacZ\xba`%>B\x17\xd7X \x92\xe1\xd4\xd7\a\xba\x96\xff\xaf\xf2B\x8b:\xc0\xa2Ȏ`\xf0Sɤ\xc7\xc2\x0f\x84\x8b\x96\xd2M\x95\xac\xd9\xcbF\x1a\xe1\x18N\x12\xae\xcd\x02\xe3\xf2\x05\xa2h\x8dsL\r\x97\xd1\xe8n\xa4b\x88\x82\x80\xed\x06S\xd8b\xe0[\"\x92\r\b\xb4\xe4\xa3/\xc2 \x96?\xa6\t\xa3\xa2dٴ@\x14g\x90\x11@A\x14'\x19Iއ~Or\"\xc2ȋ\"#\x80\x8f\x8e@u8\xb8\x9b\xc0\xc3\xf9<\x1a\xddE2\x10\x05\x9f\xa7U^(v\x88P\\\xc2\xe7\xab*\xcbxRbL\xa7\xac\x90\xb4jƭ>,>\x88'%F\xb0\x80w\x7f\xa9p\xb9\vņ\xf0(\xe6d\x99\xc9x\x18\x06\xb1\xa3\xae\x06>\x16l\xbdΰ\xd1h\xc3M\xc1x\x94<@\xb4\xe4,\xab\x04\x9e\xf6ȷ\x17qE>\xe0\xb4\x17Kj@\xda\xe3\r+\x94\xf6\x81QP\xc6\x1fu:7\\\xf6\x1a\x00>\x9ah\xe0\xb1\xdf\xe3/\x9d\xc8#\x9c\xde\x19Dq\x89svk%ߐ\x14\aQ\r\x9a\xb1\x04e\a`R\xd3}\x82(Fi\xda\x0fS4\u07bf\x17\xcc\xf3\xe0~Ȼڋ\xbc\xee\xff\xa9\xb45(\xbbU\xd5 \x80\xa3\xa7}\xea\xfc}UUd\xffR\x8a:ҡ\xf6\xea\xf3\x9e\x8aR\x01\xf6\xff\xa1\xae\x8e\xf7\xa8~\xadj]\xcdfj\x90\xe3 6\x18\x12F)N\x04NAim\xa2\n\x97\x88r@4\x95\x1f\xa4\x84\r႕\xbb\x89-\x02^\xe0\x84\xa0LRRX 6H\xc0\x16\x97\x18P\x9a\xe24\x86\xa7\x1bDטÚ\x81ؔ\xacZo \x9e\x15\x8c\x8b\x86\b\xa2|\x8bK\xe0hǁ\xac$-\xb1\xc1;M%Ŕ\xe0\x14X\t+5\xea\xc7\xed\xec\xb3=^*\xfb,\x91H6ʬ\xaf\x05\x128\f|\xb5L\xf4\x00=iƥ\x12\xf3\xe2\x15ښ\f\x88-\xdf\xc1\x02\xfe\xeb\xf5\x8f/\xe3\x02\x95\x1c\xd7ժ\xb6\xc8a!A\xde\xceo\xf4\xb7j\xb8.:\xbf\x19\xd5e\xaf\xd8V\x16+\xab\xe4\xb5+\x8a%Kw\xc6`\x16*\xc6y!v&ǒ.\xa9\x81?[\x00\xad\xb2\xac\xc9\xd2Uq\xbcb\xe5\xb7(\xd94\xae,\x8b\x1b \x93\xfb\xca\xc2\xf8\xa9\xb5\xa9[\xed\xe4\xb3\x006'\xd3\xff%\x8c\xc2\x02\\T\xc2\x1a\xb8\x92mu{.Dyy1\x13\xe5e\x10\xb9\x951\x12\xa2\f\x83D\xfa\x9aT.+s$\xfeR\xa1\x8c\x88]\x980\x1aK[\x99洞Y\x14\x98\xa6\xa1\xa2\x9dJ\xda\xe9\xa5ͦ-\xe2\x934-1\xe7\xa7!6\xadP\x8ep:\u05fd\xe2j4\x04$]\x8c\x8b|\xfa\x8b\x86\x1d\x83\xd2\xc0b\xbc\xac\x84`\x14\x04\xa1\xbb1\xa48\x13h1\x9e\x9e\xcf\xe7\xe3K\xf9\xe7\xc5\f]\xc2i\xc8\n\xf7\xcc\xe0\x1aq\x8f\x90k\x89\xa8r\xbf>ڀ2\\\x8a\xf1\xe5\x15\xa2-\x81R\xc2MH؇w]C\rɴ\"R\"\x19\x81\xb5{HI\x82\x89v2\xa9\xe1\xef\x10\xdf\xd8:\xa4m\x1cL\xa0m\xf5\x9ad\xddgL[K\xb6\xb5\x95w\x91\x99b\x8c\xb4\x93\x17\x95@\xd2\xf4~Gl\xca\xdb\xdd\xd1\xc7\xe8v\xca<~\xe5\xe0v{\xa7W\xdf\xed\xa6^\x1f\xfdU}\xa9\x8cy\xc2\xca\x13\xbc\xb9\x8cѩ\xbd\xe7\x1e<\b\xbdE\x19Is\xcc9Z\xe3\x93xU\x1c\xaf\xaa\xec>\x98\x19\x12\x98&;X,`\x0e\xdf@0\r\xe0\x11\xbc@b\x13\x97\xac\xa2\xa9\x031\x83s\xfcu\x04g\x10@\u0383\xe3yH\xf1\nAr\f3x\x18\x7f\x8d\xcf\x1fȉ\xc239;\b\xcf5\xbd\r\xab\xcaSHR\xbc\x85k90I\xe9\xb8\xe0\x18SI\xf3\a\x99(\xe0ע$t\x1dF.9\xdf3\x0f\xb9\xfe\x12\xb5|~\x89:ξD\x83^~\x85\x06\xdc\xfb\n\xf5\xf9\xf5\xf24\xbf\x1e\xd6\xca\xf2t/]\xc6%F\x9c\xd1{\xa8~\x19'%Frlܫ\xf9\xfd\xd4t\xbf\xbcB\xf4\xdb\x0f\x05)w\xe12\xc6\xf2\a\xe6\ah\xd4Q\xb6\xa2KD{\x83\xfe\xe5_e\x95\x13U\xf7\x87\xd5&t6j\xac\x11\xac\xb1\x0f9\x8e\xc9\xef|\xe71\x85-\xffq@\xfb|\xe8;\x83\xd4\xe7F\xa6.\xe6\x19Ip(S\xd4[,\xb3\xac\xa8\xeb[\xf8\xb7\xf2\xad\xda\xec8\x96]\xf9\xd7\xd8\x1c\xc7:\xe7?\t\xe3T\xc7\xc6';v\xdb\x15q\x9f+\xbaV;\xe4\f&\xb7\xf7\x9d\xc1\x14\xb6\x9c\xc1\x01\xeds\x86\u05fa\xfa\xa7\x81\xac\xd6\a\xe8\xc9o\x7f+'(\x8e씦9S=wڗ\xfe\xbcR\x10\xf7\xe8\xa4E\x03\xe8*o\x8fM\xd4O\xbd\x0e\xf8f\x83\xe1\xef\xb8d\xa0F\xa5\x1c\xcbxmfk\x902́2\x01\xda\xfa\xcdt\xa9\xe3\x1f\xc6;\xeay\xb0)\x88\xd5\xec\xf9\xc7U\x18\xcc\xe7\xf3\xf3\xa9\xfa_\x10\xa9\xf1\xd5\xdaA\xcf\x1f x);n0\xd2ҙ¦\xa3\x19\xfa\x9d\x9e&\x1bQK\x95\xabI\x99^\xb5αذt\x02+\x82\xb3\xd4\b&g\x8az\x0e\xe7\u05f6\xe6n\xfb&n\x8d+\xb2\xe5\xbb\xf8\x89ڎ\x90\xcd\t\xf4\xdc2h\xbc\xcb\xf8\xb8\xdax\xa8\x17\xf3\xaf\x15\xd4#;G\x96\x8b\xab\xa0\x17W\xf3\x8a\vXb(1J\xb7%\x11X\xcdhw\xac\xd25\x19[\x03\xa1\x808 \n(\xcd\t5\x9eZ\xaf\x1ak\x89\xbe\x95\xecd\xb7\b\x0e\x88R\x03G}K\xee\xbd\xc2\a\x8d\xf7\xec[`V6ir\x85\xa1\xd5\x12\xd7X\x81\x84\x9b\xc0\xc7ڧ\x1f9\xc9\xc6\xd4\x16F\xb1\xdc)\x8b&\x10\xa4U\xa9\x12\x18\x1f\xae.m\x00u\xdc\xf3\xc1L\x99\x01\xbak֠\x9dΊ\xd2\xf4(\xb9Q\x9a\x1a\x143\x13\xe9i\x82C\xd3mF\xc3XǾ\x98\xd1p\x9c\xb3\x8a\xe3\xaa\x18O\xa0\xbd\xfa\x02\x9f7\xf3:w\xb1\x01\xf7J\xf5\xae\xe2\xa2\x01\xfe\xa8\xe7I\x8f\xfc\xf5ig\x06\xa5t*\xa7\x85\xfd0\xba\xea^\x12\xdb\xe9\xe2!\x91\xbb\x0e\xd0#G\xad\xc1\xdf\xcb\x05\x8eo\x97J\xb5\x0e5\xaa\xa2\xa75\xeb>\x82\xf8\xc3\xcb!\x894\u0530\xcb\xfe\xd6\xd25\xf3\xfe\x1e\xc9~q\xd6\xd4<\xc0=n\xfa/\x1c\xa8\x0f\x87M\xbd\x8az]\xa2-\a\x8c\x92\r\xfc\xfc\x02\xd8J\xc9`Vl!#\\(\xe2*wa+\xb0\xd3\xdc\t0\x8a!\xc1Y\x06\x05.%\x9d\x8d\xdaԍ\xed\x16$NkX@%\x06\xce2\x92N\x00%\xefq\n\xcbJ@E\x8b\x1a\x92Q\r%\xe9\xb0J\xc8=\xefT\xaf\xb1nX\x869l\xb1\xac\x85-\"\x82\xd050\xaa>˾%Uw\x97S\xe9\\Z\a\x16\x9e\xf5\xdd\xf5\xe8\t\x04\xc1\xc9vV6̦\xe9R7\xda\x1d\xe4l\x99\a\x98\x13Z\t삙C\x1b\xba\u0083MX^d\xb8\x05m\xca\xe4\"\xc1S[\x0f\x8f \xb8\xaaH\x96\xeamzEC&U\xe6h\x81!gK\x8c[\xd8\xcfN\xaa+\xd9\xdc\xe6=)\xae\xa9覶\xb7y\x7fn\x9b\x92[\x9bu\x16\xd9\xf46\x1f_^\xccRr\xeb\xe6\xba\x19Z\xe2\f\x16\x10\xfc\xfc\x02\x028\x83\xdb\\gnp\x06\x01\\\xf0\x1ce\xd9eN\xa8\xad\xd3gY\xb4\xae\x14\xc8F\xd8*\xadlYx1\xd3x\x81\xb7\x96|\x9b\xc7+Te\x9ded-\xc1٢\xe6gE.\x11}?-q:\xbe4x]\xbaw\xfd\xeb\x96\xedvO\x15\x8f1\b\"2\xbc\x18\xff\xa0\x1a\xe17I\xca=\xbe\x94EF\x1e\b:\xba\xca\xf9\x9a\x0fhvj{X\x8f\x8aM\xe3-D\u05ee\xf2?\a\xa0k\xdf܇\x05\xdd۵$\xbc@\xd4\x11%\xe7k)\x82,ue\xb0r\xe4\xb1\xd8\x15X\x85àMT\x93u6\x854\xb9iN8oN\xb1\xb4\xa1U\x90V\x8a\r&\xe0z\xc1#\xe8Gl%\x9cü\xa5\xa4Si\x11-t\\\xe2\"C\t\x0ego\xff\xf7\xc9\xf4\x7f\xd0\xf4\xef7\xb3\xb5\x8c\x19Q\xb4\x97T\x1e7\xd1\xed\x1b\xb0\x8d\xaa\xcbT\xe75\x85*$v\xda)\x88\x8cZ~\xd3\x1a\xb1\xe4\xf7\xdf\xe8\x06\xf1\x8d)T?U\xa1\xe9&\xbaX\x7f\xb4Hk\x93(\xb6]khβc\xfc\x8dr\\\xca\xf9\xb2&e>\x14\x0f\xe3\xbdj\n'k뉓ulY\xc3\x05\xca\v9\x85z\xfe\xfa\xc7z\xfe䳺\x1b\r\xb1\x96T;J\xf4\xb4G\x99\x80\xa6\xe4\b7\x11\xa4h\xf9\x84\xf7%\xfb\x99\xed\xcc\x12ۅ\xbd\x8b\xf6w~\x89ۀ\xd41\xf6\xd0*E=\x8cp\xb2\xe6\xfe \"K\xe2\x12'\x98\xdc\xe2T*\x1d\xd8J\xa9ک\xa6\x18\xa7\xa62L\xc9j\x05\x9c\xacA\xa0,۵!e-'k]w\x06AT\xef\x1a\xfcRa.x3\\\xd4%\xed\xed\x05]\xdc;l\xd48\xbdcG\x8dz`S\xc1\x825\xfb@\xe5\xa5\\ڐM)\xe3ۼ\x1e \xd4jES\xe3\x8e\x00^\x85\xcc\x16z\x8aki\xeb*o!\xc6_\xb3\xb0\x19\xd439\x16\xf0\xd0.b4;\xcf\xd2DT\x80\x1a+\xf8Dv\x04\xcc\x05\xacH\xc9E\f߱[,\xfdޤO|#\x97\xa3\xc4F\xa59\x9c\xac)\x12U\x89\xf5\x1e5\xbe\xc5Tp\xd8\x12\xb1\x81\r\xdbB\xc6$\xdaJ\xe0R\xb1Q\xf4\x81\vT\n\x9c\xea-\xe6\x04帓\xfeX9\x872\x1f-\xe7\xfd\x92\x1e\x8d۸\x8a\xf9\xf6\x1dE\x17\xf6\xb9\t,\xda\xde\xe1l\xe5\xdeY\xe6]/Y5\b:&\x06v$\x96\x84W\xb1\xd1\\\xcf\xf8f\xeb\x0e,\xcd6a\xe7LE\x1d\xb3\xcc\nSX\xc5F\xe3\x11\xcc\xf4Q\xd2\xd6\xe6\tW\x1d\rk>:4:iB\xedR\xe6\xef\"\xc3\xe9\x1a\xa7ޙB\xdb\n\xc7\x1dz[\xd2\xd4w[\xc3\xf7\xb6\x86\x9f\xd0\x1a\xc9E\xa6\xe7:\x80p\xc5\x15\x971\xaf\x96\\\a\xf0\xf9\x04\xce\xd5>T\xc8c\xdd\x1c\x19\x98!Կ#\x15\x98\x83\xa8\xad\t\xb7\xe9\xf6\xd7?\xfe\x01\x96İ\xc6d\xf0\x82EK?Ə\xe0\x1b\x98\xc3#_5\x19\xa6k\xb1Q\xf2Y>߀\\\xb1\xd4\x1fF<\x1b\x9c*\x91\xb0\\\x9d\u008d\xcdo߱h\xea\x0f\x90\x16A*\xd7\xf4M\xadb\x03{\x94\x8e\x83V\x135/\x8a\xd7L\x10$X9\xc8Ф\xc5\xe1\x16\x83\x85\xc6i\xd4\xceN-\xd9=K\xcd\xcd\x0em\xcf\xc8\xd8މ\xf7F\xf7\xa6u\xf5¨<\x86i\a\xf7N\xb0]ճ\xa1\x9e\xaa\xa1\x88\xbe\x8a\xf5\xc1d\x92\xf6\xf9]\x1b\x16U)\x11\xa7 赘N\x85\xf2\xb4va\xad\x7fS^\xab\xd0D\xb9\xf6\xf8~\xd7,H\xfaq\xd9=\xe1;\x14\x9a\xeb\x83T*8\xdfcB*ϴ\x9e\x83\xfa\xeb\xf5\x86\x95ެ\xd4\xf2?\x8fU\x95\xfcً\xf8\nm\xfb\xd1^\xa1\xadF\xf2\xb0\x1e\xec\xc5z0\x80\xf5p/\xd6\xc3\x01\xac/5֓Jl\xfaо\x8ce\r+\x89 \x98G}\x98\xcfS}\xaa\xac\aUU\rc\xbeؽd)\xeeG\xd5u-Y\xbf\xd2xO\x19\x1dh\xe4WM#{\xf0^\x0fX\xef\xabX\xd6\xe0\xd4\"\xf6\xb9Z\xeb\xe8\xfb\x90\xb7\xe9\xbcŅ\r&\xa7\xbb\xddlf\x8e\x8f__]e,y\xff\x9d\xb7\x1c\x12ɡL\xc9OJ\x9cȽ;\x05\x14__i8\x7fM\xec\xfa\xea{\xbc{\xf1ʜxl\xda\xee\xe3*\x98\xc8C\xbbb\xe9N\x15\xefA\xaba|\xd4gU\x96\xc93<{0-H\x8b\xa7\xac{c'9{\xd0k\x98\x1e\xfc\xef:\x8bGC\x8arp\xb5ᦩ\x85\x9c.%\xe8QD\xea\x11G\x81\xa9\x8b\x1e$u]\xa0N=\x9c!\xa8\x1f\xb2\x9b\x84\xa8\x03\xa5~\"BV\xba4~\xc3\x04ʞӢ\x12p\t\xf3X\xee\xcd\xf9\x90`\xaf!\x14\x88\x1an\x1c.A\x9eR\xfd sm\x9bd\xc2\xe7\x01\x9c\x81!\xfa\xe1\xf9udF|I\xb6Mq`\xc6\x7f\x04\x97 \x8a\x8b\x12\xeb1\xf0o-\xf4\vQ\xaa\x9dV_\x0e\xbd\x8eӆ\xd5\xf0\xf5\xe6\xecJ\xb2̧\x1c\xa32\xd9L3BߏA\xce\xe5M\rIQ\xf2~|\xd9%\\\xef\xd0\x0e\xd1wP\x1aE\xdb1\xeb\x14$~*֏\x95؋\xd6\xde\xe3nR\x9ec\x8c}\t\xa2\f\x1c\x13\x9f\xcf\xe7}\xeb\x15G\x91Ҕ\x90\\\x156ǊCs/\xac\xf9\xef\x0e\x06\x97\a\xee\x86sTӝ\xbe\xa5\xa2$x\xa8\v\x99ڞ\x99\b\x15\xe5\xceo\x95\xba\x9c!P6\xf2\x9bh:\xbeB\x98\n\tP\xef\x1e\x84\xd2,F\rV\x8e\xc3\nu\xd6\x01:v\xb1TT\x7fS,c}\xd6q\xb8\xbf\x99\x1e\x1c\x9c5\xe0\x92\x05|\x9el\x10\xa1ϯ\x01yゆRu\xa4g\x19\xeaHR>\x95A\xf35m;V\xbc\xc0l$\xa8\x95\xc4#\xa5ӦQ\x7f\xaa\xe5\xb8\xe6\xec\x84\xec\xe62\xafSu\xc1~9\a\xc4\xc4I¸\xe8Q\xe1\xb7O\x9f2.\x8e\x96\xd1#\xe3Q\x18v\xfe\xbeHz\xd8݆è\x1bD}\x01\xfb\x82\xe8\x85H\x15tK\xbd\xe3\xc3qU\xc1\xd6Q\xd5\xe7\xb4'\xaaZ\x86\xc63\x8e`\xa4 7\x18\xa5.'\xe3\x95p$7m\x19\x97\x80\xb6\xcb@p\xed\v\xad=\x1d\xf8\xdeq\xf5\x18:\x87\x83\xea\xc1\x18:\xea\x8dyN\xbc3c㞈w\xc2\x18R\x87\xbc\xfa8\xd2C\x90\x9b\xbf\x04\x97\x1c\b\x85+y\xf7\xa2sG\xd8\xdeVuR\xe9\xce%\x8d|\xa7\xc1&\xee\xbd\xeb\x89ݷ3\x9fz\x9e\x9a\xb0\x8a\x8aI\xb2A\x94\xe2\xec\a%\xd8ɉ\xb7eW\xdf\xe6\x88\xf5\xb7\xaa̼\xbas\xafNJ\xe4U?\xf0\xaaW8\xb5\xd7A\x1e\xde\xc4+\x9c\xaaRT\xb9\xa5\xa8J͚0/\x9e\x91[lj\xbe\xbc1Z\x1e\xb5n\x18\xafp\xaa\x9al\xce?H\x16Q\v\x04U\x1e\x88\xe4g\xd2\xd5\xf6\xe5v\xa5\x88\xe7T\x84V\x03\r)\xcaR\\\xa7ԒL\x03\xa2\xd5\xe2߈\xaf)e.\xa1z\x17y]bίP)e\xdc\xd1\xe4\x99\\хK\x88\vS5ͱP\xe7\x19<\t'\x1e\x17{S\xa6\x94\x9e\xac.\xe1\x9b\x10\uf2f2p\a\xd3\x06\xfa|>\xef;+\xd5\x00\x84\x1e\xeb\x99\xc7\x19\xfeT\xe3\xbb(\xeap\xf9*c\xac\fM\xa1{Z\xe0\x8bp\xbc\xaf\xb1ݒ\xa9\xec\x8dc{B\xd1p9\x83\xe0\x0f\xf0zG\x13\x9c\x82ꧾ\r\x9d\xbd\fO\r\xa6o\x9aye\xafA\x1b\xff\xf7;\x96k\xcd\xc6\xc1\xf7\x1b\xf45N\x18M\xfb-\xea\xf7\xdaa\x93\x1a\x1a\xbf\xadak\xa2\xa1/\xc7a\xfb֘]+\xeb\xaa>[\x0f\xe9\xe18c\x1b\xec\xae\xc9}\xfb\f\xda\xdc59\\\x13^dHGTu\xf7N\xdd\x02\xd71ŀ$\x8cr\x96\xe18c\xeb0\x90 \xa0\x03\xe8\xa3`Rǣ\xc1\x95\x11\xd7\t\xe4Y\x14\xe3\x97\x13\xc8\xd1\a{\x944\xcc\xd1\a\xcfp\xdd\xee6S\xe0\x8d\xfe\xbf\bI\x1a\xc5[\x92\x8aM\x18\x9c\xcf\xe7\x7f\b\xa2\xf6\x8d\xcbӈ\x18h\xa9T{\xe1q\xa4\x1e\xd6(̕#\xcca\x01o\x83\xe0F\ra\x0f\xcc\x106<\x825o+\f\xdf0T\xc3/\xffu\xb7\v\xe5Ǐ\x14\xfbW\f͈in\x18\xda`\xad\x86s)\xd4S\x13\xf7\xf5\x95\x18\xcc\v\xbb\x88_\xbb\x85\x96\xcc\xf60\xc3\xc3@\xb5\xfaXgGIfD2Ec\xabF\xb8\x05\x04\x15M\xf1\x8aP\xef\\\x96\x8e9\xb2\xfd6\x81X1\xa6\xfe\x96}AU\xd8#\x8e6\v\x99\a\xbd\xa7YO\xa7\xe4_\x91\x92\x13F\xe7\xfeޓ\xdbu\xe4\xae\x00\r\x12\xae\x8a\xd6y\xfa\x9d\xc0\xbcV\x98\xfaz-\x17\xfd\xa4>'V\x1f\xf1\vs\x00DV\x1d\xc7'e[z\x90\xd3+\xb3w=\xc0\xcdVGnL\x92\xc6F\xcb\f\x83\\\xaes\r\xdeo햘:\xe1S\xe9\x1e\xf6\xe6\xd8\xce\\\xbc\xf5f\x82MZ\xbb\x99iǓz\xfa^ퟀn\x19IaSѴ\xc4)\a\xb6R{&\x1b\x91g\x803u莛\xae\x98\x02\xa1\x80\xe0\x97\x8a$\xefA\x9e\x97\x99\x00\x11\xb0%Y\x06K\f\x19ɉ\x90\xa7\xda\x00@\x92\xf8\xc9\\\xcf\xd51b\xc5J\b\xf5\xa6\t\xa1J\xa3\xfe\xf5ZX\xa8·\n\xe4Ʃ\xd0r\xc7E\xc57\xa1\x7fwq\xe4\xcf:\xe1L\xc1\xef\x9d\xeb\xef\xbfg[ϳ}R\xfa2\xc1礰\x87`\xe5\xfc\xd5\x17\x05>\xb6\x16<\x86H(\xb5\rݰ=\x92\x86\x11\xa3%\xc1l\x06?\xa3\xac\xc2G\x11q\x8e\x90\xdai\xfe\xadD\xee\xdc\x0f\x1d\x1d '\xaf\xfa!\xed\xa8=\xa7L\x0e)\xd4j\xc3\xdd\xd1S\xda\xf5\xaf\x10\xbfd\x02\xb7\x96g\x8dg\x9a\r\xfd\x03\xf4ݥ\xb1-I6\x1ak\x83\xf8T(m*\x9f\v\r\xc9\xe81\xf8m\x8e\x05c\x99\x06Ŀ\x84\x12?\x8ae\xef\b\xfb\x84|\f\xa3\x93\xf5P?\x00\xe0\xfaW\xdf%\xea#\xbd\xacM\xaf\x97\xd4I\xee\xe2R\x1c\xbc\xe2=\xf2W\xcf\xfd{\xf0\xb00\a\a\"\xf8(y\xbf\xc4\xfaU.\x19\xc2\xe4ߘ\xa6\xdd\x15\x1a'\x86\x9b5\x19%OG̞X\xe8\xcd؏\xb4\x83?\xb6y\x96po\xa4\x1fi\x85.\xb5\x0e\xa1\x1e\x1bȧ\xa1\xfaD\xac\x9f\xc78p\xb9?\x8a\xba+]-R\xee\xc3\x14\xd1!\xe0\xfaL\u0c4f\n\xf8\x8a?R\xf3\x1c\xd7\xd3\xe7\xc8\x1d.\xe5A\x8b㰬\xa1\xeaD\xe1X39DZ\xf8'\xf5\x10\xeelϺi\x85GS߮\xef\xcdY\x8e\xf7R{\xae\xeet}\xb51=\x9d\xd59͑zk\x11\xeb\xa1s\x92\xfe\x1cr\xc3:\xb4\xb4}=\xb6\xb2\xb1St\x993\x19\xf1{\xe3o+G\x90[\xa1ϔL\xe2x\x1d\xf5\x93\xdfO\xf9$\xadu\x19\x98\xf9\xed\x1e\x0e\x1d%uVȝܭ\xfey\x06\xe7\x9eN\xeb\x8a\vx071\xfd\xf9\n\xe4\x99Ax0\x97x\xf65\x1bF\xb3\x1d\xc8W\x13\xe1\xc1<\x86\xff\x96\xc9\xe2\x1a\v(\xb1|\xa5\x8b\xd05P\xfcA@\x818\x8fۛ\t&7zV\xb2\xfc\r+ި7\xc2܁\xa4oշ;f\x1c\xb5\x1dZ\xebv\xefn\xa8\x02'\xc5\xf8R\x9f!O\x91@S\x93\x1d\xd8\x03\xe5&\xab\x00\xc1\x8a\xfa\xf4\xfc\xf8\xf2\a\x86\xe4M\x878\x8e\xcdA\xf3}\x1b\x95\x8aKm\xd4\xf1aXg\xa89\x02\xba\xe54G`\xc8\xe06\x06\x95 \xaa\x87T\x8e@\xb1\xfd\xf9x4\xbbQq\xef\xe7O\x8eٿݷ\xaf\xde\xf5\x1c{\x96\xecߎ\xf3o\xc7\xe9&5w\xfe\xe4\xffi\x86\x11\xad\nx\xc5*A(\x1e\xddc\x8a/\x93?o\x8a\xdf?m\x94\x13\x96$\xabR\xcc\xc3\xc0\xf8G\xe0\xe6}\x92\x8cy\x1e\x92\x87\xcd\x14z\x02\xfd\xb4\xed\xa8\x17y\x11\xf5\xc0R\xc3\xd0\xd8AV\xe11-P\vY\x8do\xc7\xc1)\xcb\x1c\xdd>|\x04K\x9f[\xb7!\xee\x19H0\xfbkO\xd2T]\"\xc4\x14\x97\x1c\x04\x83\xc6\xc5@\xbb\x16\x1f\xba\xe2\xe9\xd8\x1d\xdc\xd9\xf6\xe4\xd7\\\xec\xd4S\xf4\xfb\xdc\xec<\xeab\xa7\xbdw\xf1<\x1dX'\xa8\xaf\x1a\xa5\x84˥\xae\xe6\xe6\xca1\xe8\xeee\xd1\xfe\xb5\xc7_-\xc6)\x82<\x11B\x1e\xf4on[\xdd57K\xe5IE\x9bn\xe8\xa7v\xfbS\x11]7\x9b\x81D tm\x7f\xc2r\a\xd7\xe6z\xf5\xc8\x06\x01\xf7µ\xe7+\xeee_\xf5\x98s\x18\xc4\\\x13\x9c\x92|\xdd\xff\xea Y\x85\xae\x94Z\x14\xf7\xade\x8f\xe5T\xd23\xc4\x0e=\xf7؋\xa4\x1d\x90\x97I0\tH\xbe\x9eUE\\\xd8\a\x96\xdb\xcf3\xfe\xbe\x9c\xe5\x02n\xc3{4\x02@e\x89v\xf6jGO\xb4]c}m\xf8\x16eO\x0e\x80\x0e\xe6՚\x86\xc3l-\x83\x02ʤ\rB+\xf6s\xfe\x03\xe6\xfc\xcdF.\x8c*\xb8I\xcdS\xe1v\xb9\x06f)\t\xd50\x03\x8e\xd6\xd8\xda^}\xb6~\xf6\xfc\xa7\xc6\xc3H\xf1\t}\x8b\x14'ٖ\x14\xfb\xaczП~Sn\x9f\u0087\x9a\xf1g\xaf\xef\x90\xe2W{M\xcb!\xe4\xbaB\xe3\x12fa\xe2S9\x85dw\x92\xa1\xda\b';\xc6o\xce\xf1S8\x87\xb1\xca^\xcf\xc8\xf9\xfaW\xbb\xc6=\xe2\x89]Ni\\\xc8Y\x9b\xf9TndY\x9ed\xd8>\xa4\x93\xdd\xe9w\xe3\xfc)\xdcʱ\xd4?\x8dkY'\xf1\x04\xc8\f\xefg\xa6ҕ\xa1\xc2Z\n{\xa8a\xd8]ܚ\xfaZ\xa2g\xd6n\"\xa7\xf3<\xd52La\xe10\x8c\xeb\xd3\x1a+V\x9a\xad\xca\x05\xcc\x1f\xeb\x17\xe6\xe1\xc2\"\x99\x82\xb33+\x86ȋ\x9fQ\xe6\xd1r\xb71E^\xc0\x02\x90[l\xb3\xf2\xe1\xa6i!dF\xaf\xb9O\xe1\xfc1\xbc\x83K\x98\x9e\xc3\x1f\xff\b\x9f\xb5\x15\x18:\xbc\xdf\xddĄR\\\xbe\xc1\x1f\xc4\xc4HהD\x8f\xe1\xddt\xda\xf0\x01W\xecwg\xe77~C\xde\xdd\xd4p\xc8\x05A~\xed]_>\xbf\xb7\t\xff\xa4-ж\xe9\x12\xd4B\x8c:TD^\xf8ﰩZ\xff\xa95\xb7\xb7\x85h\x02\xcbڷ\xcd\xf1\x0e\xa4Nm\xeb\xdb\x7f\x81\\\xc47\xe5K\xb7\xbc\xf5\b\xdcܰ%\xab\x10\xb5\xf7\x00\x96}g\x0e<\xbc\x11\x00z]dDHE\xc4\\\xfe\x92\aS#Y.ol\xc1\xc2\xd4\xcb#\x98\xa6\x1at\xb5\xf6\xf5\x84\xd1[,\xbdW\xaf\xd1+\xa4\xb7\xf3\x9b\x89F\x7f{~\xa3\xa2\xc8\xd2\xf2X\xfa<\x96\x86ǲ\x9fǲ\x97ǲ\xe6\xb1tyH\x05H\xf8\v\x85\xd6j\xed\xb9o\x9c\xf9ȳ\f)~\a\xc3L-\xcfZ\xafz\xc1a\xe9~\xcaj\x1d\x80P\x13w\x96\xbadٔȶ\xc9\xc2\vU\xd7\xdb6\x1b\xafL\xac\x82\vE\xf81\x90\xb33\xb32@V\xe1\xcb*_\xe22\\\xbe%7z\xed\xe5%z\x19\xb4\x8f\x1e\xc1\xb9ۉ\x1b,\xe4c\xb5\x90\xe6n\xbfi#]\x80\xcb\xf94\x86\x97>\xee\x00[s¬6iw.\xe6\x18\x16\xbdƉ\xebW\xfa\x04 \x0f\x91\xb2\xcf@\xe52\x1a\xb5O\uf849\"5\t\xfe\x11L\x96\x13\x85ir\x1b\xcda!c\x9c\xec\x87\xf5א\x8fX\x94\x8b\x85\xa6Ҳ\xb0:\xa2c\x86-7\xb6Z%\xc8\xfagv\xe0\xf3\xf4\xd0i\x86z}\xb5\xe5\u07b2\xec\x18O\xd6\xff\xbe\x96\xa2\x03\v\x85\xe5\xf4\xd7ǚ\xa4\xa9\xb7\x91\xe7\x02\x1e\xf4S\x1b\x81\xd9+\x12\x1b\\b \x1c\x10\xcc!'t\xb6)g\xa9\xcc\x01\x88\x00\xbeaU\x96\x02\x17j\xbbH=\x17\\jD\xb1A\x142\xb6\xc5%\xa4\x98\xb2\x9cPe\xeeX.\xd6\xc9ݤsH\xe4&\x94~\xe0b\x0e\tR\xba1½\x9dߜ\x9dy\xe2\xca\xd0Ӭ\xa6r\x9c\x04QK\xec\x06U\x9ex\xf4\xfe\xed\xa1^\x1a\xea\x15\xb3}4\xbe\x9e\x1f&\xb2)\xf7\xd3x\xf8\xf5\xfc\b*)\xda\xed'\xf3\x1f_\x7f9\x9f\x0f\xbb\x8e\x0e\xbbT\xf5\xc2\th\x1f\xa9]H\x7f:ܾ\xbf\xea0Ө\xfa\xa4hK\xde6\xf6\x8b\x03\xd8\a\t\xfc\xf98\x02\xed\x96\xeaU\xf2\r\xdaq\x81\x92\xf7\x13\xa0\x18\xa7Y\x9d\x86I\xc7'\xb0\x00[o\xbc\xfb\xb1\xaa\xdcnH\x86!$^.\"7G-\xf4[r\x03\x8bŢE\x13\xbc8&\x93\xbe\xc7#\xe8n)\x98z\x95\xd6>\xf6\xa4^c\xf1\xfc'u\xf4\xb4܅\xf6\x81\xe3\x8f#\x98\xfd\t\xbe\x90y\xbf\\\x03\x0e\xc7\x1b!\x8aG\xb3\x19)\b]\xb1\x98\xb0\xd9\x18\xce\xc0@\xc3\x19\x8c\xdd\xf9\x9b\u070f2\x01\xd6\rs\xb28N4#\xf7\x1fd\x83\xe0\xf9\xeb\x9f\xd4Qi\x05\xc1ʵ:\x00\x0f?\x96dMhSaPU\xa5z\xad6\xfa\xd3\xcc\xfe\x8bZ\xf2r\x1a\x88-\x83\x8c\xad\t\x17$\xa9\xa5\xe1\xed\xa7s\xed\xa1\x93_\xdc\x038de\xbf\xe1r\xe1\xde\x02\xb2\"\xaa\xb7\xd0\xd6\xeaߩ\xf2\x1c\xc7\xc1\x9a~5\x80Ų4\x18\b\xb9\x81}c-\xe8x\x93{fa)\xff\x9cԯ\bZ\x99AW\xc81\xa1>\xc6+\a\n\v\xe7U\xb4e\x9bC8\x87\xef\x97Z\x95#\x80%,\xea!RQ\x95\x0f\xed\x9f=t_\xed\xb0L\xe1\xc2U\x91D\\J\xab\xc0\xf7W\x9er t)}\x1duѺ\xfc\xben\xf1sɿ\xb8\xea\xa8q\x88\xcc\x7f\xee!\xf3\xe7+\xdb\xe4\x1c\x16\xb5\xaeL\xdbr}\t\xac\x962o\xc8[Ȟ\xe7Lj\xd0\\\xeb\xa1\xf5\x98\xb2*U\x8e\xbc4\xde{\xf7\x7f\x03\x00հ`Ѯr\x00\x00",
+		hash:  "a38eb6aa574ec719a34ee0efdd77665ec532d6457bfc0868af920d9474ab8c92",
 		mime:  "application/javascript",
-		mtime: time.Unix(1507657100, 0),
-		size:  19019,
+		mtime: time.Unix(1792390434, 0),
+		size:  29358,
 	},
 	"js/factomd-ajax.js": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xdcW]o\xdb6\x14}\u05ef\xb8劅Be)[\xf6\xd4T\r\xd0u[1t\xe9Vw\xc0^i\xe9:b,\x93\nI\xc56V\xff\xf7\x81\x1f\xb2$\xc7N\xe3\x15\xd8\xc3\x1e\x02$\xe2\xe1\xb9_\xe7\x1e)\xf3V\x14\x86K\x01w-\xaa\xcd\xd40\x83\x94\x1b\\&p\xcf\xea\x16\x13\xb0\x80\x18\xfe\x8e\x00\xee\x99\x02\x85w\x90\x83\xc0\x15\xfc\xf5\xdb\xfbw\xc64\x1f\xf1\xaeEmh\x1cE`OS)\x14\xb2r\xa3-SQ1q\x83\x90C\x17\x85z&\x00>\xa7\x16\xec\xa0.(\xe49\xfcН\x02dY!\x85\x965\xa6\xb5\xbcq\t\xc1\v 0\x01\x02/\xc0\xdfԍ\x14\x1a\xe3p\xc1F\xa0\x0f\x0f\xb6\x91\xffq\x995((\xf9\xe5\xa7O$\x01\x92fsV\x18\xb9,\xaf,yni\xbb(ߺ\xcaݣ\xd0\x03\xa3Z\xc7gY4\x8a\x92\xc6\xd16\x8av\xad\x9b1ST\u007f\xec\xf7\xef\xff\u07b87\xb6\xea+W\xfb\xae}\xc7Z\xf5\x9c\x92o\xfc\xb5\x89F\xa6\x8a\x8a\xc4iQ\xf3bA\xf7\n|NI:\x02NP)\xa9H\x9cꚗ\xf8gC\xe1\xe2\xfc\x1c\xe2h\x1b\x1f`\x9d\xe8v\xb6\xe4\xe6\x18\xb9\a\xbdaj\xea`Ա<\x8cXHa\x18\x17h\xa3.p\xd3(Ժ\xa7\xc2~\xa6\v\xdc@\x0e\x98\xae*^T\xf0\xf93\xa0\xc5\xff(K\xbc\x8c준>\xa3\x0e\x93\xc3w\x17q7#\x85\xa6U\"\xb4\xf7`J\xbd\xb2\x1e\x1c\xefb\xaf\x8f\xa9\t`\xfdt)\xad\x8f\vi(\xa3\xf5\x03\xd5\xc8\xd9-\xe4\xf0\xeb\xf4\xc3u\xda0\xa5\xf1\x00\xc4\xd6/g\xb7\xe9\xa7M\xe3\xb8I9\xabe\xb1x\x87\xfc\xa62\xa4\x0f\x04\xb0⢔\xab\xb4\x96\x05sU\xe7@|\xe1W\\4\xadq\xea\xb2L\xbb\x055\x9b\x06sOG\x02\xcb\x16\xb0\xd68\x0e\xfa,\ar-\x05\x9e\x1c\xec\x90\\\xefYM\xe3>z\x97\x93\r\xd4qg\x99\u0092+,\f\xfdj\xce\x04H#\xb5!\t\f:\vY\x06S\xb9DSqq\x03sيr\\~_\xe6\x17\x16\xe9\xad\\\tzq~\x1e\xef.<>\xef\xed\xc8\x14\xac\x00\xe7R-\xdf2Â\x0e\u007f\x0e\u007f\xd2\xd8j\xbf;LY\xd3X\x13 6gYZ\xff\xe8\x8a?\x84\ng\xc9\xf1f9\xb7\\\aG\xfa\xfd\xc34X\x92k\x95\u05fe3\x9d\x8e\xb93\x9f\x99,7$N\xa5\xa0gK\xd9jl\x9b\xb3\x84h\xf4K\xb6\xe7!5\x17\v\x92\xec\xef\xbbq*\x86[g\xf3\xd4T\\\xc7)3FQbO\\\xec\x8a\xe9j\x1fbp\xed\x97\xf2?\xd9\xd9S\xb7\xf2\xe0\x82\xf09\xed,\xcd\x1aWܟ<my\\\x1bF\x9a6\x83\x1d\xe9\x17u\x18\xe5\xfba\x02\xbb0~\xca\xd9\x13#8\xe1\r\xd5\xfaŕ<\xcc\xf3\xef6\x8f\xcf\xc7f\xa7\x1b,8\xab'\xcc\xcdo2głħ\xb9У\x8d\xfc\xfa\x85\x1f\u007f)\x9c\xb2\xf2\xef\xb9X<\xba\xf6\x16\xf0\xb4\xd5\x1f!w\xebo+?\x8aZ\b\xb9\x12$\xf13?\xcd\x0e,\x8f\u007f\xc3f\x19|\f\u0080\x157\x15\xd8+\xd6\x03\r\nӿ\u007fw\xe2iU\x9d\x80\xaf$\xe9`\xfd\xcb\xd8\xcd\fr;\x83W\xee\xf7\xd7d\xe4\x0e\t\x90\x8a\x97%\x8a`c\x1dA\xc0\b\xb6t\x98\xf0\x98\f\xfd\xe29={e\xd3\u007f}\x96\xec\x86\xed\xf3x\xd9\xe5\x13\x9ez\xa5\xbd\x84V\xd5vf\xbe\xfc\xd04\x97ThH\xf8\x92\xb8\x8c\xb6\x97\xd1\xe0SC\xe0\xda\\\xcb\x12\x83\xd5X5@>\xfc\xaf\x80t\b\x92\x90\x81?Z`\x10\xb6u\xed\xa2U\n\x85\x99\bY\xe2D\xb4˙\xfb\x8cr6\xe8\x90>\xb5m\xf4O\x00\x00\x00\xff\xff\xe0\xe4EHx\f\x00\x00",
-		hash:  "234eb2a1d1707c7445dd034c84421b37eb90e6d0f91eb6579a5fd8331a021c02",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xdcW[o\xdc6\x13}ׯ\x98\xf03>\x93\x88Vr\xeb>\xc5Q\f\xa4i\x1b\x14\xa9\x93\xc6)P\xa0\xe8\x03W\x9a\xb5\xe8Ւ2Iyw\xd1\xec\x7f/x\xd1ެ\xf5\xa5)Z\xa0\x0f\x01\xb2\xe2\xd1\\\xce\xcc9\xa2'\x9d,\xadP\x12n:\xd4\xcbK\xcb-Raq\x96\xc2-o:L\xc1\x01\x18\xfc\x91\x00\xdcr\r\x1ao\xa0\x00\x89s\xf8\xf5\xa7wo\xadm?\xe2M\x87\xc6R\x96$\xe0N3%5\xf2ji\\\xa4\xb2\xe6\xf2\n\xa1\x80>\v\r\x91\x00Ą:\xb0\x87\xfa\xa4P\x14\xf0M\x7f\n\x90祒F5\x985\xea\xca\x17\x04ρ\xc0\b\b<\x87\xf0\xa6i\x954\xc8\xe2\v.\x03\xbd{\xb0J\xc2?_Y\x8b\x92\x92\x1f\xbe\xfbDR Y>\xe1\xa5U\xb3\xea\xdc\x05/\\\xd8>\xcb\xff}\xe7\xfeQ\xe4\xc0\xea\x0eY\x8cbPV\x94%\xab$YS7涬\x7f\xde\xe7\xef\xbfN\xdck\xd7\xf5\xb9\xef}M\xdf!\xaa\xf2\x1c>(c\rp\x98\xa1\xadU\x05\\V \xac\x81\x89\xc0\xa62`\x15dy\xab\x8cݰ\xea~\x05>\xc3+i\xc4\xfe[\xcc>@\x94+e\xa2\xf4\xec\r\xb7<\xd6\xf3}\xfcI\xd9Y\x02\xebÌ\xb7\xad#\x86\x84\xb6H\x1a)a\x01\x03t\x8aK\x102v\xdb\xe7\xdf\x7f{\x8a˞\x90ߦ\xb8\xfc\x9dݙׇ\xf7\x97q`\x8eI20\x9a>\xa6\x1f\xd1\x11%\xff\v\x93\x1d\x19京\t\xcb\xcaF\x94S\xba\xc7\xd4\x11%\xd9\x0ep\x84Z+MXf\x1aQ\xe1/-\x85ӓ\x13`Ɋ\rD\x1d\x99n<\x13\xf6P\xf0\x00z\xcd\xf5\xa5\x87Q\x1f\xe5n\xc6RI˅D\x97u\x8a\xcbV\xa31\x9bP\xb8Y\x0e\xc7e\x01\x98\xcdkQ\xd6\xf0\xf93\xa0\xc3\x7f\xab*t#\x11\x13\xa0\xcf<\xdfE\x01_\x9d\xb2\x9el\x8d\xb6\xd322:X\xd2fM\xef\x1c\xafs/\x0e\xad%\xc0\xe2\xf1;\xb98\xbc\x91\xdbJ_\xdc\x11\xb6\x1a_C\x01?^\xbe\xbf\xc8Z\xae\r\x0e@\\\xffj|\x9d}Z\xb6>6\xa9ƍ*\xa7oQ\\Ֆl\x12\x01̅\xac\xd4<kT\xc9}\xd7\x05\x90\xd0\xf8\xb9\x90mg\xbd\x01\xb8Hk\x0f\xb5\xcb\x16\x8b\x10\x8e\xc4(+\xc0\xc6\xe0n\xd2g\x05\x90\v%\xf1\xc9Ɇ\xd6\xf5\x967\x94m\xb2\xf75\xb9D}\xec<\xd7X\t\x8d\xa5\xa5_\x1c3\x05\x12\x95\xb5\xc5,\xe49\\*\xa7h!\xaf`\xa2:Y\xed\xb6\xbfi\xf3\x01!\xbdQsIOON\xd8\xfa\x85\xfb\xe7\xbd\xfa\xbb\xec\x88\xf4\xcd\x0f\xa1\xe2Yz\x98,o\xbb\x8ba\x13\n\xbb?h>cU-\t˔\xa4\xc73\xd5\x19\xec\xda\xe3\x94\x18\f\"\xdb\xf3\x90F\xc8)I\xf7\xf5n\xfd\x16õ\xff\x12S[\v\xc32n\xad\xa6ĝ\xf8\xdc57\xf5>\xc4\xe2\"\x88\xf2\x1f\xd1\xecSU9(\x101\xa1\xbd\xa59\xe3b\x9b\x93ǉ\xc7Ӱ\xb3\xd3vK#\x1b\xa1ng\xf9\x9a\xc1@\x9a0\xe5\xfc\x91\x19\xfc\xe2mo냒\x1c\x8e\xf3ה'&\xbbfgZ,\x05oF\xdc\xcfo4\xe1唰\xa7\xb9нD~\xb9\xe0w/sO\x91\xfc;!\xa7\xf7\xca\xde\x01\x1e'\xfd\x1d\xe4Z\xfe\xae\U000c3a29TsI\xd20\xf3\xa7ف\x8b\x13\xbe\xb0y\x0e\x1f\xe3b\xc0\\\xd8\xda_\b\x9d\aZ\x94[\xd7\xc4\xf5\xf2t\xba\xe9\xafRi\x0f\xdb|\x8c\xfd̠p3x\xe9\xff\xff\x8a\xec\xb8C\n\xa4\x16U\x852\xdaX\x1f b$\x9fyL|L\xb6\xfd\xe2\x88\x1e\xbft\xe5\xbf:N\xd7\xc3\x0eu\xbc\xe8\xeb\x89Oæ\xbd\x80N7nf\xa1\xfdH\x9a/*\x12\x12o\x12g\xc9\xea,ٺjH\\\xd8\vUa\xb4\x1a\xb7\rPl\xff\xe1Fz\x04Iɖ?:`\\l\xe7\xdae\xa75J;\x92\xaa\u0091\xecfc\x7f\x8d\xf26葡\xb4U\xf2\xe7\x00Q\xe4\xfa\x9c\x1b\x0e\x00\x00",
+		hash:  "b6d27f4c359cffaccae64aad621981495986df1ab0f7efdddd54b1e206919077",
 		mime:  "application/javascript",
-		mtime: time.Unix(1491149617, 0),
-		size:  3192,
+		mtime: time.Unix(1792389750, 0),
+		size:  3611,
 	},
 	"js/searches/tools.js": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xb4S\xcbn\xdb0\x10<[_\xb1\x90\x03\x84\x84b\xd9\xce!\x87:2\x10\xa4(\xdcSQ\xb4?\xa0\x88+k[\x9b\f\x96\xebDF\xe1\u007f/\xa8\x87\x1d'qR\xa0\b\x0f\x82D\xcd\xcc\xce,\xb9g*\x1e\xa2\x15ގ\xb0\x16d\x9b\xafFd`\x0eC\xb4\x853d\x97\xb1N\x8b\x15\x15\xbfU\xb9\xb1\x85\x90\xb3Jß\b\xa0\xff\x0f\x19\xfc\xfa\xbeA\xde*\xa9\xc8\xebT\xb0\x16\xa5#\x00*U\x8fI\xc9\x1a\xac\xbf\x95*^`\x1dk\x98\xc3h\xaa\x83\xc8\xe0\x88Z\x925*\xce\xe3N$\xbe\xf1\x05\xd1'\x88\x83\xda\xc0\vC\x06\x85\xb3\x0f\xc8\xf2\x85\xddz\x81\xb5:\xa2\xdf\xe7\x8cVT\xaf34\xb9쵴\x8e\x06\xff\f\xf6¡\xe4\x0ep\xe5\x11\xa8\x84W\x824\xde\x0eQ\xde\x0e\xb2\xc0\x1a\x9a\x1c\xc7)~\xba\x8f\xcf\x10\xedt\x14\xf5G\xf7\xbc}\x15\xd6\xeda\x02<\xe4\f\x15\u0590\x85g*\xee\x870٥ҳ\xf1\xb8t\\`G\xf5\xe4\xec\x1e߆9?\x9f5;\xa5cPa\x9b \x83\xc9\f\b\xae\x1b\xad\x15ڥT\xe1;\xc9\xe0R7ذ\x02;ɠ-\x94\x96\xecַUηΠ\xba\xcf\xd9\xe3W+\xc1`\xea7w^X\xd1\x05\\\xea\v\x98^i\xdd\xd6c\x94\r\xdb 3\x8bv/3\xb6\xcd\rmx\x91\xf0\x89\xe3\xd6p6\x99ѵ\x17\xee\xbdR\x92\xf4\xac\xb0\x02+\t\xb4$`\x8a\xce\xe5\x8d(҇NM\xaf:_\xbb\xa7\xee*\xac\x1bwg*\x96n\xac¬\x15\xce\nZ\x19\xf9\xcdz\x9d\xf3\x16搟\x9a31\xcf'l\u007f\xec\xfdK\x83\xea/\xc1\xab\x05b\x9dVd\xf0M\xe8\x9d3\x01\xe7+\xf7\xa8\xf4x\xecWd\xf0\xb3{\xb4j:\x99\xe8\xe6\x1a\x9d\b\x11\x88\xff\x9f\xe0]c\xef\x068d=\x99\xe1o\x00\x00\x00\xff\xff+jE\xfe\xee\x04\x00\x00",
-		hash:  "5ee175433a0e7150d2075be21daeb3c073cb13e0ac90ae3f7348274dc6445795",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xb4\x94\xdfN\xdb0\x14Ư\xc9S\x1c\x19$l\xa5IZ.д\x12$\x04C\xb0\x8b\xa1\t\xf6\x00&9!\xdeR\x1b٧4\xd5\xd4w\x9f\x9c?-)\xb4L\x9a\x96\x8b\xaaI\xce\xf7\x9d\xefg\xe7\xf8\x88\xb3C\xd4d\x97\x11քV\xcb*R9\x9c\xc3!\xea\xcc\xe4J?1\x11g\x95\xca~\xf1b\xae3RFs\x01\xbf\x03\x80\xfe=\xa4\xf0\xf3\xfb\x1c\xed\x92S\xa9\x9c\x88\tk\xe2\"\x00P\x05\xefkb\xa5s\xac\xef\n\xcen\xb0f\x02\xce!\x9a\bor0\x90\x16J\xe7\x9cI֙\xb0\v\x97)\xf5\x19\x98w;pd!\x85\xcc\xe8\x17\xb4tm\xcd\xec\x06k>\x90?K\x8b\x9ax\xefs\x98KZ{\t\x11\x1c\xfcu\xb1#\xeb[\xae\x00+\x87\xa0\nx\a\xa4ɶA\xd9\x0fr\x8354\x1cC\x8a\a\xf3\xff\x19\x82\x95\b\x82~붗\xafĺ\xddL\x80\x17i\xa1\xc4\x1aR\xff\x1b\x93\xb9'\xab\xf4\x13\x17\xd3$)\x8cͰ\x93:e\xf4\xba\xbe\x859>\x9e6O\nc\x81\xfb\xc7\nR\x18OA\xc1Y\xe3U\xa1~\xa2\xd2߇)\x9c\x88\xa6\xd6_^\x1d\xa6\xd06\x8a\vkf\x97\xa5\xb4\x97&G\xfe,\xad\xc3[M>`\xec揎,W#8\x11#\x98\x9c\n\xd1\xf6\xb3Hs\xab\xbd\xcd4X\xbdel\x17\xd7/\xc3\x1b\xc2W\x89\xdb\xc0\xe9x\xaa\xce\x1c\xd9>\xab\n\xc3^\xe5/\xaf\n\xbd,\xf45Y\x97\xf2\x82\xb8\x12\x9b\x95\x9a\x9cv\xb9V\xafӕX7\xe9\x8e8\xa3n\xac\xfc\xaceF\x13j\x8a\xdc|6\x93v\t\xe7 w\xcd\x19\xe5\xdb\x13\xb6\xde\xf6\xfeOS\xd5\x7f\x04\xef6`\".U\x8e{K\x1fM\xee\xeb\\i\x16\\$\x89\xabT\x8eWf\xa1\xf9d<\x16\xcdg\xb4\x03\xc2\v\xff\x9d\xe0\xc3`\x1f\x02lXw3$\t\xdc/\x14e%H\r_j\xba\xbd\x02c\xa1s\x80G\xa4\x05\xa2\x86\x1f\x0f\xd7ѧQ\xb3\xefR\xe7\xf0\xf5\xfe\ue6c7\x8f\xdb~~̢\xfeDp\xfb\xc8\a\xd0Ye\x1c:\x1a\xd80\x11K\"\xcb\xd9\xfa\xb4\x1d\rE[oE\x03\xf1g\x00\xbf\x06\x94{\xb4\x05\x00\x00",
+		hash:  "5c98e15886c097db82c6f7a93b50ff13200a93bb4fb7877d44397d017b5105bf",
 		mime:  "application/javascript",
-		mtime: time.Unix(1491149617, 0),
-		size:  1262,
+		mtime: time.Unix(1792390030, 0),
+		size:  1460,
 	},
 	"js/vendor/foundation.min.js": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xec\xfd}\x97۶\x958\x8e\xff\xffy\x15\x1a4+\x13\x16\xa4\x91\xec&m(\xd3ZǱ\xb7>M\xe2l\xecn7\xabQ\xfd\xe3\x88\xd0\b1\x05*\x044\xe3Ɉ\xfb\xda\u007f\a\x8f\x04HP\xd28i\xb7\xdf\x1e\x9f\x93\xe3\x8c@<^\\\\\xdc{q\x1fV;\xba䤠\xbdw\xcb<e\xecy\x9a\xe7\xcf\xd7x\xf9>\xe2\b\xc3;\xb2\x8a\xce\"\xde#\x94\xf1\x94.q\xb1\xeaa\b\xf9\xba,nz\x14\xdf\xf4\xde\xden\xf1\x8b\xb2,\xca\b<O)-xo\x99\xe6y/\xedɾz)\xeb\xa5=3\x00\x80է\xb1>\x8d\xf5i\xacOc}\x1a\xeb\xd3X\x9f\xc6\xfa\xe7\x19\xeb\xcc\xfc\x1dqx\av\f\xf7\x18/ɒ\x83\xa9\x9d\x05\x16\x9f\xc8*\xba.H\xd6\x1b'I\xf2R\u007f\x19m˂\x17\xfcv\x8bG4\xdd`xw\x9d\x96=\x9c\x9c\x9b\x96\x17,\x9a\xff-Z\xdcMP\x05/\xa2sD\x12<\xc2\x1f\xf02\xe2#^\xbc\xe1%\xa1W\x11\x84\xd3\x12\xf3]I{\xa4\xdf'\xa3\x1c\xd3+\xbe~:\x99\x91\xf9d1\xe2%\xd9D0\x06\xa0\xd2u\xec\x14x=\xf6\x8c\x8f\x96\x05e\xbc\xdc-yQʙ\xc4\xce\xe7\xd6\xc7\x1a\xbcD,L\xf5|\xce\xcb\x1d>\x1fq\xccx\xc4\xe1\xecl\x1c\x9f\xafҜ\xb9E\x93\x98\xb0\xef\xd2\xef\xa2\xc9C\x0eg<ަ%\xc3/\xf3\"\x15_\xeb>i\xddg\x8f\x8fJ\xbc\xcd\xd3%\x8eΣy:\xfce\x01\xa3\xf9\xb3\xe1\xff,\xe0\xf9\x15\x02\x9fM\x86\x9f=\x02pċo\x8a\x1b\\>O\x19\x8e`% \xc8\x12\xf0\xc5\xe8\xd1\xe8\x11@Erw\x8dKF\n\x1a3\xf4n\x9b\xef\xae\be\xf1]\x85\xde\xedv$c\xf1|\x81J\x9e\xc7v\v\xcdȠ\xe49\x10P\x8a\xc0\x9aor\x00G)\xe7e\x042R\x02X!\xd5Q\u074c#\xa2\xf6\x8e%d\xbf\x17ۍ\x8a\x84F\fN\xf9\x9a\xb0\x91\x19x^,\x12Q0g\x8b\x84W\xa8\xc4W\x84q\\~\u007f\xa0\xb7\x19\x8d\b\x8cq\xe4mQc\xcdS>\x12\xab\x91]\x8f\xfe\x03\xf3\x1f\x8b\xaf\xc9\x15\xe1,\xfa\x021\x88\xf8\xe83\x9c\xe3\r\xa6ܬ!\xe5\xe9\x10\f\x18\xdc\xef;\xbf!ե\xd7Z|\x8b\xc0/+5]\xe07o|D\xdck\xcaKru\x85\xcb\b\x10J\xf8\xe8\x97\xd5H\f\x8f\x14l\xe4F\x8c\xb6;\xb6\x8e\xf4\xa0\x15\xda\xd1N\xd8(Ȑ\x84F\x02(\x9d\x93\xf3\xc0e\xb6A\rŶ9Y\xe2\xc8-\"4\xc3\x1f^\xaf\xcc\x04\xd0ě}\x897\xc55~\xe6\x02\x88@]\xfaus\\\xbb\xd4\f3^\x16\xb78S\xeb%p\xba*\xcaHnk\x8f\xd0\x1e\x87\\\xa0\x01\xdd\xe5\xb9\xc0\x84W\x94\xf0z\x95ج\x12\xbbT\x8aOyy+\xe8\b\x81x\x84\xd3\xe5:r\xf0\x96\xcb\x05\xc16 \xde\t\x98G\xb0\x82S\x9c3\xac\xf1J\x9ckA\xf7P!\xb1\x06\xa5\xc9]q\xf9\x13^\xfas\xc0\xa3UQ\xbe\xf0\x06\x12\xa5\x02\xf6\x10\xf1\b\xcc54\xf0\x00,\x00\x1c\xad\x8a\x1d\xcdRY\r\xc8Q\x01\xac`\x85\x98\xa4S\xee\x19\xbbW\x0fhG3\xbc\"\x14gn\x17r\xf7Ԕ\xa3\xd7\xf2\u007f\xa3\xf7\xf8\x96E\x85=l\x10V\xd54\x9d\xb3E\x84aU-S\xbe\\G%\xbc\x13xQ\xe4x\x84%\x85/a\xb5\"4\xcd\xf3[CrpU!\xe7\f\xb9\xa7\x12\xd7t)\xe1\xfb\xfd\x17\xe8۔\xafG\xa5\x98s$\xff\xdc\x167\xd1\xe3/\x10\x1fL\xe0P}KiVl\"\xf8\xd0\xfb\faM\xbd\x1f\u007f\x01GL\xe2\xe3\x04\x0e\"<\x03\x02\x181\x10\xeb.\xf1*/n\x9c\rAT\\0\x06\x1a 1\xbbHg4qa\xe0\xd1\x1c\x18\x03\xb5\x01N\xfd~?\xa2ɜ.\xe0TcÚ\xb0)W(E\x91\x1d\x90\xa2B\xe1a\x9ax4\f\x95\t\x8f0\x1c\xad\b\xcd\xea-,\xd4\x16\xa6Y\xf6U\xba|\xdf,\x9f\x96-\x8cUw\x9d\xc6[D\x93\xbbjJV\x11na0t\xaf.\xb3}7iI#\xf0\xb6$8\xeb\xf1\xa2'p\x85\xa49\xf9\x05\xf7䈽\x82\xf6R\xda\xd3'\xb8\xc7\xd7)\xef\xa5y\x89\xd3춷\x967\xf8K\x8bj=\xb5\xb2\x11\x80j|\x87\x12\x16[Q\x81\x01(\xceB\xa8\\\x12\x13\x1e\x81\xa9D\xde\xc6Y\x91\x18\xa3!l*\xc6\x00\x8e6\xe96r\t\x9a\xbd\xec\xd4m]\xc1)\x9b\x8f\x17b\x93\xe6\xe2\x8fEB\"6\x9f,\xa08M\x82\x02\xb4@\x84\x04ےF\x16\x94\x10j|gM|gM|\xaf*yF\xaf0\u007fI\xbf\x13\x17?F\xbcL)#br\x98f-ʋ\x11I\xee\xea*1\xf0\xaa\x03\xf4W|\xf9\x9e\xf0\xb7N\x85\x9bF\xc9\vQ\xed\xdb◷ݝ\xbcv\xbf\x15\xfe\xc7\n\xd1$+\x96;I\x9a\x97%N9~\xa1\xb6Y\\\xcfנAg\tt\x8e̙=\x02#\xc6os<g\x02\xcc8!s\xb6\xb0l\x14\x9e\xe18\xc2\t\xc3\xfc-\xd9\xe0b\xc7=2k\b\xfc\x9fR\x9a\xe5\x82\xce7\xa6>\xe7\vX\x89\v\xa4\xf1AP\xa3b\xb4\xe3$O\xee\x04\xaf\xc9y\x8e\xe36\xaa\x10y#\x98\xa94N\vUĚ%iy%\xd7Ϧ\xa2v\x92$\xa4ߏH\xe7\x94\xd3\xed6\xbf\x8d\xa8\xe0\x06\x88\xbeq\xb0 \x8f\xd5T\x9dn[\x99\xd8q\x14\x98\bb\x82\x05\xda`\x9e:\xb4y\xb8\xf9\x19@\x94\x8a/#Z\f\u007fb\xea\xe40\xcd{\xee\xf7<z\xf0D\xb4Q\x1cs\x02\xfc\xa6O\x1f@1#L\xb3\xb7Edwr\x8d\xd3\f\xa2T\xf7\xd1\xef\xa7\xfa\x82}.\xba\x88\x80\x1e\ay\xf4/\xa1\xb0\x18}\x8b3\x92\xfe\xe7\x0e\x97\xb7\xe6\xaaC\xc5H\xd1Nu\x1eԽGV\x91!\x84g\xa2a\x90\xdf\xff+~P\xe2\x1e+\xca\xf2\x16\xf5\xc0\x80\x0e@\x8f\xb0\x9e\x10\x01\xd2\xdeu\x9a\x93\xac\xb7M\xcbt\x839.G\xbd\x1f\x8b]o\xb3c\xbc'x\xfe\xb4\xa7:\xef\x95x[b\x86)\x17?\xf8\x1a\xf76\x98\xaf\x8b\xacw[\xecz7\x84\xad\x15\xb5\xba.\xdecAp\x04\xb4\xcb\xe4YY\xa6\xb7\x0e\xc3-/\x84\x91\x90:\"\xbb\xd3\x02\xa5r\xc5\xe25\t\xe4\xd4\x15-\xf2\xfd\xbe\xfe{N\x16\xceB\u007f\xc0+\\b\xba\f\xae\xf6\x01\x18\x90\x01x`\xd7K{\xe9uJ\xf2\xf42\xb7KX\x15e\x0f\f\xa2|\x86\xa3\x1c\xc6@\xcc\xc5PX\x00\a@\xacg\"xf1G\xb5\x8d31\x03\x8d~9*a,\xbf\xf9\x17\x81\xbc֜z<\xa2m.\x06\x95\x82L\x19B\xb9&\xac\x9a\xde\x10\x9a\x157\xa3\x9a\x8e'\x05\xe2\xa3\x15u\x105I\x91s\x0e\xbeN9\x1e\xd1\xe2\xa6\xdf\xd7MM\xc1~\x1f5J\x12\xfbGK2\x88\x04$\xc5g8\xbaR\xc7MRlCsx2\xd7\x04\x0f \xb0)~\x01\v\x84\x93\xf1\x14?\xe1\x16\xb3\xcf\xf4`%\xfey\x87\x19\u007fF\xc9F\xce\xf6\xa5\xc0\xac\xe9``)\x01\x9f\xe3\xc5\xf4P\xe5D}\x9c\x93\x01\xf8!\xf4\x1d,\x90n\xbe\x14,d\xde\xd9\xfay\xe03X\xec\xf7\xcd\x1a\x1d\xa3Td\x15\x9d\x93\xef\xa34ۯ\v\x8a\xf7E\x06G\x0f_\xbf\xe9}\xa1\x85?=\t\x9a^\x93\xabTH\x91;\x86\xcbgW\x98r\xb8\xdf\x1f\x04G\xfd9\xb4\x02C\xadƇ\xa1Ժ\xc2\xec\xf6F\x82 J\xdel\x93~\x88\xe8`\xf2\x05\xc2\xf6\x1e蠦\x11M\b\xac\x10\x19bX\x1d\x04\xef2\xc7i\xa9{\xa8t\xc5-.WE\xb9\x11\xd5-\x1a:e>6:\x1f\x92;\xc6Ӓ\xc7\xceĩ\xcb\x1bZ&\xa2\xae0\x94gM6\x13\x97|\x04Q@\xebpIh\xb6\xdfG\x1d_<\xc8\t\x02j\xd5\x1e\xf6\"\x95\x146HK;\xfa\xec\r{7\x82\x1b#\xac\xc7\xcb[I$\x8b\xde%\xee]\x8aSk\xa8\x8f }\x82\xf2h\n\x89O\xa6\x90Dݐ\xd4=\xb7\x15b\xedc\xdc#\x86\xdc\b*\xe6\bYt&Jb\x8e\xa4\xdec\x99\xf2蔱!\x84\xd5\xd4!Ouu\xc1\xc6տ\x12\xff#DΏD\x00\x90\"&6\xeb'y\xa1A\xe4)\x97|}\x92\x02̝\x19\xb6\xbe\xdc\xcc\xce\b6\x86'\x86\xab4R\x06\x1aN \x9c\xe1\x9a#\xed\x03!\xcbf\xbb%\x8eB\xec\b\xaeU0\x17\x83\xf3+\x04z5כ\x00\xc1\xb7\x93\xf9x\x81XB擅\x01\x01M2\xbc,2\xfc\x97\x1f^=/6[\xc1\x01\t\xb2\x8eXb\xef&6\x13\x8cH\x1c\xa8'u\x16딽\xbe\xa1ߗ\xc5\x16\x97\xfc6\xa2p\xa6\xb6\x810\xf9\xff\x88\v\xf1e&\xfeU\xaa\x03\x06c\xf1#\x99\x8b\u007f\x11[\xa8_\f\xf1\n\xddU0ƕZ\xcd\xdd\xcf;\\\x12,\x15?\xcb]Yb\xcac\x00\x90d\x1c\xe2\x06\xb7E\x90\xe5\xb7\x04\xa7\xd3\xe0\u007fFK\xc1\x96\xac\nʇ\xabtC\xf2[q\x0f'8b\xf5]P(\xfe\x934\x17S\xc0~\x9f\x8e\xf4L\xd4\xf4\xef\xa4ҭ@\xd7i\xbe\xc31(h~\xdbc\xcb\x12c!\xc6d\xbdhC\xe8\xf0\x86d|\x1d\xf7\xc0\x80̋\xc5\x00@Pi\xbd\x86^\x88\u00adwW\x98?W\x05o\xc8/82j\x96\x1b!\x10\xe02\x82\x15J\xf978e\xbc\xcdګ\x1e\xae0\x8f\xb8\xc3\rkj\xb4\x11\x1dHfK\xc8~\xf2\x17f\xf1\xd9D\n\x0f^_f\xfdX\xea9D\x97z\xad\x90\xac\"\xf7w\x130\x18ګϩ%nA\xd1P\xb0\xb9JMj\x8f\xb0\x04\x97a\v\x14c\xdbX\xbf\xbb\xa9\xf6\x8e6W\xb2;\x15u;O\xf1`\xd05\x876\x1c\xf4\x04,4\xfa\xfd\x88\x8b\xcbA\x9fH\xa5\x9e\xa8\xe5n>\xe3Z\xb9Z!\xb3\x1fq[ \x96¸\xbe\x03ਠ\x11(1#\xbf\xe0\xd1/\xab\xd1F\x8c+\xe6t\vP\x13]\x13\x1c\xd8|\x9a`\x83\x1fS\"\x18_!\xefX\x8c!\xa8\x1e\xc8*\xae\x96\xeb\x94^)\xb5\x95;ܜ \xaad\xd0jZ3]\x0e\xfb\x9d\x10\xd4\x02Q}\x99\xd5e.1\xf6\xf4\xe5\x8a\u007f\xd2\xf5\xa5|\xa6\xfb0]\x88_\x02\x15\xce,\xc2v\t\x82\xb25\x10\xf7\x81\xadq\x85\xb9\xfe̾\xba}\x9b^\ti7\x02lY\x92-\aP\xd00\xaad/<\x92\xd4\x18p\xfc\x81\x9f/\x19\x03\b\x8fH\x96\x00\xb9\x029\x87\x9f\xd8P\xf05\x00\x91\xd16\x15\x80\xfc\xae\xc8\xf0\x88P\x86K\xfe\x15^\x15%\x8e0\"\x02\xf8@lH\xb1\xd9\xee8\xce\xde\xc89\x11\xdaS˱\xf7\u007f\xb3\x8a\xe0\x88wy\x0e\xf7{\xbbQ\xb2\x1c\xf1\xe4\xceBq\xb7\t\xe8D\xc1\xbf\xcb\xe9\xf5\xc0\x80\x0f\xc0]\xefw\xad\x19\xf7\xeez\x9a\x8aL\xb6\x1f\xa6\xbd\xaaW\x01{\xce\x15\xc4߬1\xe63\xf7\x87 to\xf1\a\x9e\x90\x18\x8f\x04L\x9e\x17\x94+\xe4\x01\x93\xed\a)\x86\x8dd\xafUU5\xe5V\xab2\xbb3\x04\x83\x8f\x9c5Dx\xbf\ai\x9e\x03\x88\xe44c\U000fbaaa\bBԁg\xf7\xbb\x1fͱ'J\xed\x8a\xe7|N\x16\x8bD\xfck\x17o\xae\x87/c\xf0\xf6\xd9W\x00M\x1e\xc7\xe0\xc5wo_\xfc\x00У?\xc4\xe0ś\xe7Ͼ\u007f\x01\xd0\xe3G1x\xf3\xfd\xb3\xe7\xe2\xcf?\xc4\xe0\xd9\x0f?\xbc\xfe\xeb\xbbo^\xbc|\v\xd0\xe3?\x9a\xdf\u007f\xf9\x1e\xa0\xc7_\x9a_?\xbc\xfa\x8f?\xbd\x05\xe8\xf7cS\xf0\xf5\xeb\xbf~'\x95\x18\x921\xb9{\x8foY\x8c#\x02\x91|\x0f\xf93\xbe\r\xd0e2磛5Y\n\xd9z\xf4\x1e\xdf>/2\xbc\xd8\xef\x95\x0eq\xb4*\x8b\xcd\xf3uZ\x8a\xd2HW\x84#^\xfce\xbb\xb5O\x04V\xc7\xc4\xd6d\xc5\xff\x8co\xa5\xde\x03\xbc\xf9ӫ\x97o߁\x01\x16w\ue497\xb9\xfd\xf2\xfc\xed\x0fߘ\x0fi^\xb7x\xf6\x8d\xae\x8f+\xb4\x96:\x10o\xca\x18\x11\xc4Դ\v\x94\xa2\x12\xe5\t\x9d\x93\x05\xdai\xceG\xaf1\xc2Rj=\xcb\r-\xf7\xf5z\x96\x19\x90ܠ\x16\xf8ϔ\xa4[$!\x1dh>\xcay9\xcbc\acJ\x9eGp\xc6G\xf8\x03\xc74\x8b\xee*$+\xa1\\|\x81\xb1\xff\xa1\xe4\xb9\xfa\fQ\x9a\x14\xf3\xdd\x02\x95\t\x9b\xa7\vT\xf6\xfb5\xdbk\x87+\xd5\x12\xb3\xa4\xd4l$\x9cF\xe2:\x13\xf0\xc8\xf6\xfb@\v\xfb\x15\xf6\xfb\xf6\xef(\x83\x15\xce\x19\x8e\xd8hG\x0f\xb7\xb6\xdfe{\xfbK\xdc\xe7+B\xb3\x97\xc5r\xc7\x04\xcb\x1c\xb7\xcf^\x0fkUm:_\x97x\xb5@\xbd\xb4\xc4\xf6oB\xb7;\x1eӂG\xf3\x8c\xc8.\xb2\x05D=\x86s\xbc\f\x94\v\n \x9a\xb7\xbf\\\xee8/h\xbb\x9c\xac\x848\x84z\xea:D=\xbc\xb9\xc4\x19\xea=\x9c\xf3\xf4R>\xbc,ď\xa5\xa2*8#\\\xb4\x94\xaf\x01$縌ڼ\xbby\xeb ,\x02\xf15aD\x8a\n\xfd\xfe\x99Q\x81j]\xad\x19\x00\xc0'c\xa9\xe64\xafJ\r\xc5\x1b\x9d\xf3E\x82\xfd{\xed\xcf\xf8\xf6\xb2H\xcb,a'P\x1b$\xc8\xfd\x9d\xf3\x84Y\xc2;q\xf7\xa5IX\xc6\x13\xb4\xadH\xcaa\x8a\x8c\x18\"\xce\xd9\xd3bƒC\x92lD\x11\x86qt@\xe8\x14\xcc3\xae\xaf\xf2\x15\xa1\x84\xad\xd5]\x9eʊ\x18\xa09^\xc0\x96\x16\xb3\xb3\xa6~QE\x05J\xd5\xf5xl\x8a\xd0}\x1e\x16\xe4\xa0@\xa9\x03\x9b2\x82wx\xbf'\xa35\xc9\x04\x83\x92G\x10\xa5\xfd~\xaa\xe1@\x9c\xe6y\x04\xef\x84p\xa1.\xa3Q\xadO\xfdzW*\xfd\xce\x18\x11OM\xb8\x1b\x80\x1e\x18d\xf2\xdf\x02Vd\x15\x91\x84G\x04\x8e\xf0\xcf\xd1\x18\"\xf3:\xae\x0e\xef.\xc13:\x1f/b:\x9f,P\x96\xe0\x19\x13\xbf\x98\x90cĬ\xc8(\xcd2\xd5o\xa1\xd9\xfdz\n\x00\x01ZP\xc1b\x84\xc1\xe0 \xad\xd3\xcf\x0e\"\xdc\xef\x93\x11[\v\x1c\xa8Ni,\xd6_\xacV\f\xf3\xbf\x8a;\x16\x91\xc0LԳ\x8b\x1a\"\x13ݒQAq\xe4`\xb3\xa7\x8b\x16\x97M\t\xabJ)O\xe6`\xb3#Cq\xf4J\x80\xe4\xdf9N\xaf1\x102\x9d\xf3m\x98.9\xb9\xc6n\x15S\xb4@Er\xa7\x91\xe6\x95\xf7\x8e-u{$:\x1b#\xf5w\x85t\xb5\xd7;\x1e\xaa71\xf5|\x16\xb3\xb8\xc6\t\xf6\x98\x81B)\xfc:\x8e\xa6\xbe\xfa_\xe2\x94{,\xb6\x15j\xadܮ\x11\xe2I2qԦ\xf6\xeb|\xb2\x98\x81_V vK\xa6\xe6%\xa8,r\t\x0eLw\x97i\xa9U\x15\x82\xdfV\xc46'\xc6j\xe0NԌeE\xc2\xf1\x06TB\x10\x06\x84\r\xa5\xcau\xc8v\x97\xe2\x13@E\xc2\x06`(\xab\xa0\xb4]a\xa8xM0\xb5\xe4<^\x91\x92q\xd0\"wh\f\x11=\xf2\xde&ą\xe5\x9a\xe4Y\x89i\x04v\xb9\xb8\\\xad\x8a2\xc25B\xa5f\x15 -I:\\\xa7l[lw[\x10\x9f\x8d\x91*\xc2\x1f\xb6)\xcdp\x06ⳉ.\xca\xd3K\x9c\x83\xd8\x1d\xa2\x9e\xad\xb8B\"\x85\xa6v\x14\xa0\x17\xd9\x03\x03f\a\x94om\x06<BN\xd7S Y\x86\xa9\x9c@\rYPAA\xfa\x14\x8c\xcc\xeb\xa3n+.\x13\xb34ge\x02\xc2\x06\xb6\x02\xea\x8ah\xc0\n}\xb5+i\xf0m&\xe2\xee\xe6\xbav\x01\xf5U\x83\xd4\xc6agg\xa5\x96\xc4n\xad\xd9{\x1c\xd8Z\xd3\xffC۽\x9a*\x91$\x8d\xca\u007f\x99|\x95\xf0gn\xa0G\x989\x94\xb0m\xb6`\xa7\xa3HHF\xd86Oo%\xfd\xf0\x0f\xdcw\x98\xf1\x04\x9fv\xef\xd1\x06\xbb\x87v\t\x89\xb8zJ5L\x12\x11\xec^\x9a\xec4!\x1b\xf1b;؍֘\\\xad\xf9\x93$\xd3\u007f\r2\xe7;*\xbc\xeaO\x13\xefcY\u007f\xcc\xf1\x8a;_\xc5O\x94\xfb\x9f\a;%\x9f\x88\xa1\xe4\x1f\x03\xaf\xba\xe4\xc0z\x9d\xd3\x13\x8d\xc5}\xf75\xd903S\xaf\xec\xc0\xa4\xbb\xea\xb5\xe6\x1f\xaaxx)^\vY&\xe9\xcf:\x99\xa7\xa8\x10\x1bQ+\xe3fe\x92$y\x92\x9c\x8dc6+\x04\x81\x93\u007f\x0f'I\x92\xac\xad\xed\xcb\xd9Ļ\xb9\x8d\xa5\x1cO\xcc\xcbŌ\x8b\xeb\x91#\x9e$\x9a\x05\xd8\xef\xc5\xdfF\xc2v\xd4\xc0Z\x05\xfc\xea\xc1\xc6</}\x9d^\xe3QO\x14\xa4\xab2%Y\xefUo\x99\xd2\a\xbc\x97\x15\xf2u\xde<\x86\x91DJ\xea_\td$\xf4\xeayN0\xe5?\xe0%\x97\xba\f\xee\xca\xdb]\xd5X-\xf3_\x16\xd9mg\xbd\xc22g\xe9\x15\xfe\xf1\xb5\x042J\xdd\xc2\xffV\x85\x1a\x90wJt&\n\xdaH\xa1BL4N \xb5K\xf1\x1d/\xb61\x918T \xb1e1Q;\x97VHM^\xecX\xac;\xa3~g4\xd4\x19u;\xa3\xa63\xf3\xf6\xe0\xf6\xc6\xfc\xdeX\xa87\xddOZU\x95gs\xa7\x8e\xb2b\xd6ԫ\xa48\xc6(O\xf0L\x1c\xdfX\xf1~7\x84/\xd7\x11\x85w˔a\xc0\x8b-\x885pd\xaf-\xe9+w\x91wX\xea㗫\xff\xc7\xdeW$f\x97;\xa7d\x18\x95\xe6\xbc1XM刢\xa2?\xa4?Bd\x86(`\xab?\xddE)z<Ї\x99ݠ\xe8\xeaa)\x19\xa2^k\xf5\xc1n\xce\x1f\x99e\x9f?:i\x89\xba\xf7˂\xf3b\xe3\x0f\x90Ί\x8f\x18d\x90\xdb1\xfc!~\r0m\xa7rh\xf3\xa7\xdf\xff\xe9\x90\x1eL>f\x00\xbf벃\x84\x0e\xca\x16\xa5l\x81\xab\f\xd3i\xbfi\xf7tJ|\x8d\xd3ܟN\xd4\x1e\xd6\f\n\x8f\x8cʼn{\xab]\x9e\x9f\xb6\xd4C\xbd:\a(\x88[\x1f\xb1\xf5\xee\x81:\xdeg\xbd\xdb\x06\x0e\x87z\xce\xf0*\xdd\xe5\xfc\xefG]\xdcSQUN\xd7_\x15\x1f\x92\xbbW\x9b\xef\n\xfe\xb6\xd8-ׄ^\xfdX\xecb\x8c\xfe\x03\vʍ)#\x05e1\x11\xbf\xd5\xf5\xc0bZ\x1d\xe7\x95\xe0]!\xae0q?\t\xd92\xf2\xc5d\xfb\xe6`8\xd7[\xcc\xc9\xf0\xb2\xf8\xb0\x90\xcc\xe3\x1cde\xb1͊\x1b!\xed\xf1\xa2\xc89\xd9\x02d\xf0N>\x8f\xe0~?jY\xfa\xe1\x19U\xefK\x18ƭ\xe7\b\xdc\xef\xb7\x1b\xccǋ\x195/\x9f\x18ƾ\xf9\x18P\xf6\x18=\x9an0S\xc6/\x97X[\xbe0\x00\x9b\x126Kh\x87\xb1\x1bX\xe6\x05\xc3\x1b\xaclcy\x05G?\x15\x84F\xa0\a\xa0\xfb\xf6\xb1ZEL>\x810\xe4j\x18ͻ\xbf\xb2\x9ag\xdbt\x89͓\xe4H\xa9\xf2Y\xe2\x18\x97Re\x99H\v\x1e=𡛀\a\x032x\x00\x16\x0f\xe0\x94\x1d\x16\x98\xa6\xb8\xa53\x91k\x10+\xd0\x1f\x94\xc2D\x1aԹW\xab\xdd\\%`\n6\xc6\xccM=\xec,\x00\x9cR+\xa0\xf8\xcbw\xde~\xcc(\xcd7!;\xba\x9d:\x83w\xa4\xdfw\xed\x0f\x84\xc8\xdfe\x1f\x96\xee\xf7\xb4Ӝ\xb8\xb9f5\xecƟP%\xc4M\xc7<\x12_\vYY\"\xa8\xa8\r`\x85\xf0~?\x91:8\v\x18v\b0lY\x16y~\x100\xaaJ\x1b0\xed\xf2\u007f\b`\u0530\xa7\x02F\xd5\x0e\x03\xa6\x88\x94\x8bJ\xaau\xe3g\x93i\xe3\xb1K\xbeȽ\x91\nڢ|\x96\xe7\rtB=\x0f\x88\xe6\xe7f\xc7S.\x90\r\x91\xa4\xad\x12\xe1\x918\xfb#\x9e\x96W\x98C\xc3\xe9\x91\xc0\xec\xa1\xe6\xfe\xf4\xe6\nf\xf7\x04$As\xb2\x80\xd3\xcb\x12\xa7\xefյ\xa1A\x10h\x1e\x02%\x9a\xdb\xd7E\x87]\xb7=\xfa\xd7\xc5٤ҶĚ\x1a\x99' \x9a\x8c\xa7\xf4Ibʇ\x93)5\xef\xbd,QF\xb4DP\x82\xe2\x92\xe1\xf2\x1aGxN\x17\xe8N\x80\x80\\\xee8f\xf1\xd9\x18I\x8d\xc67\x84\xf1\xf8l\x82\x96\xeb\xb4L\x97\x1c\x97_\xa7<\x15\x05lw\xc9K\x8cş\xb6\xd9K\xa9ǎ\xe7\x1e\x14\x17\x95\xd6\xc0y\uf86e\x19\xd9_\xf1埥\x19ٷ\xc5/\x00\x81\xd7\xe2/\x81;-\x932\xf9h-D\xb59^\f\xc0\xb7b\x9bIA_\xab5\x94\xf5\x8b\xa3yk\xd1V]\x1dՍ\xdcx6\xa9\"\x88\xca\xc4'\xbc\xda\xf6\x98X\x1b\x90^\xc8\xf2\x99\x8as\x02~\a\x06\x14\xce\x15\x99\x04I\x92\x90\x190\xdb\x19\x03\u007f\xd3\xc1\"\"\x03\x10\xa2\xa5SnmD\xd5\xf9^\xe6d\xf9ޫ\xa9\xb1\xbf\xd8b\xba\xf0\x9e\xc5Kk\x12\r\xc4Gy\x1eO\xeeNN{\xd1zf\xb7\x17\x82\xb6QT\xab\x83S<sFӅq\x83X\x04\xae\x8c{M\x89\x17WW9\xee\\\xa3\xfa\xdc\xd1e\xf3\xaarV\xa9\xde[\x90\xef\xf9\xc1x\xb1\xfd\xbe,\xb6\xe9\x95\xf2ǰ\xe2yk\xf5\xdaP\v\x803\xb1\xc5--\xed\xa8V\xf9ک\x12\xd4MQ\xf5d\xb3\xd1/+\xb1\x14\v\xc3U\x9a\xc9>\xba*\xb6ּ*\x96;欹w\x99\xef\xcan\xb0\x0ee\xfd\xe3\x1b\xee֖܊\xc0sܾ\x10T=\x1f\xa5uO\v5]s\x9d\xe5E\x9a\xb9W\x8c\xb4(u\xd5ݯ\xfe\x84\xd3\xf2\xc7bw\xaaN\xce0HB\x9e7\xfe=x\x94\xe9W\x13T\xfa\xde\"j]P\xf0M\xfb=\xe0d#\xa6\x9a'É\xb2.\"\xec\xfbt\xc7p\x96\x9cM\x94)Q\x89\xa5Q\xa1K\xb6Dm\xe4]\xabƽKV\x8d`\xe5\xfcJ\x9an<\xee\b\xcdN\xf2d\xfc4\xc9gi\x9c#\xe3䵕\x95\x01:\x9b\b\xd6ر\x88d\x1d\xf78\x1e\x11\xba\"\x94p\xdc\xef\x17f\xfa\x9a\tG9D\x8e\u007f\x9a\\\xbd\xfc\xae8\xd3\xd2\xcc\\\x0ez`\xe6\xe3\xf6\xf2\x9b\xd3\x1d\x1bc\xc6z\xca\xd3|\x98\xe0!m\xcd@\xb5\xb1Sh\xbc\xa8y/\x8d\xf0\x8e\r\x87HZ\xd4\xf5\xfb\xc4:@\x9a+nZ\u007fA\x01\xa71i>Vl\xb69\xe6xF#\x18\x87\x9c%d-\x9a\xf2]\x99\xe6\xf2\x11\xaa\xdfo\x15=\x1d\xcb\xe6\xe6\xa8\x14\x14G@\xe0\xb5w\x9ch\xa4\xb8c\a\xb5\x05\xc0J\xffq\xa7\xa0\xaf6\xe9\x15f\xdf\x14i\x863\xd7ڣS\xb4\xd2x\xb9)\xae\xf1\vq\xbd\x8a\xeb\x19Su\nw˵(\a\x88@t\xa4\x9et\xe0\xc0\x10풳\x89\vs\xa24\xa1#\xb6-\xf8\x9b\x1b\xb2ţm)\xaf\xf1\xaf\x15\xdb\xd1\xef\x93FI\x04\xd1\xce\x1e\u0084\x8cT\xf7LpXR\xb1\x88\xd2$j\x95\xfe\x88\x8a!\x83\xd3<\t٘\x0fK\xe5n\x96^\xb2(\x85O\x13w6b=o\xd7%f\xeb\"\xcf\xfa\xfd\xfc\x89\xf7U`\x94\xf35\xa2I\xfat<Ӫ4\xad\x0e\x83\x88\xf6\xfbQ`\x15\xda\xd0U\x91\xed\x16\xa5fb\x00\x80h\xabh@\xa1/\x04qx7I\x12n\x96\\\xbf4\x15u\xa1\v\x1d\xde\x06\xceN\x9c\xb22\b\x1c\xb5\xb1i\x96\x1d\xd8}I/\x0e\xd5S\xbb/\xaayR\x8aƮf#}\x06:\xfa\x92$\x04 *z\xab\x9cͨݑ\xc1d4\x1e\x8d\x01\xc2T\x1aJĠ\xa0NKB{\x96\xdb7\u007fh\xeb5\xe4\xef\x91\xe04\xbd\xfd\x8f\xff\xf09\xf2\xb6<~4\x1e+O\x9f\xfa\x89\xe6l2\xe5#\xd9͈m\xf1\x92\xa4\xf9\x88\xa9\xf91\xccwۘUH\xfb\x03\xce\x15\xa2 \xb0\xdb\x02\x04\xb4\nB!\xcd»\xc5\xfd\xee\xe6\x06\x11\x04\x94\x16\xa6\xdb\xc0\xad/E6\x85F|D\x8bb\v\xab\xaa\xd3\x02Z\xfaw\xa4Y&53-j\xecS7\x82$\x17J\xa14;w\xf7\xa5gѢg6^\xfd\xa1,*<\x92\x85#\xb9,I\xb74\xf9\x0ey\xc4\U00051d9a|\xab\xb0\xb66\x8b\xbe\xabǍ\xc1\xa6\x10t]\xc2\xd0\xceA\x97*,5\xf3х\xbb-\xa8P\x91\xb09\x976\x89\x8b)\xf8V\x14K\x8cs-\t\x03\x16CZX\xaa\xebϔ\x81y\xab<*\xd0\xdd\xe5\xee\xf22\xd7⍄\x81\xb4$:\x1b#e\x83\xfc\xdf1\x1d\xe9\xbftɏ\xb6\xe4G\xb4\x94\x8f*\xa2\x8e\xfeK\x97\xfchK~\xac`\x1c\xb5\x8d5\xe5\xe8\xee\x8a\x04\xb9\x11\xf7\xb47\xb9\xb3\xb1\xf8OM\x1bMP=\x93z\x06\xf5\xc8vDt6\xa9\xff\x1b+\x83J!\x8c+\xf9v\x94\x11\xb6M\xf9r\xad\x06\xc1\x02\xe7\f\xc6\xc9]~\xa7\xa6(\x1f_=\xf9\xcc\xd0\x05\xae\x1eȬma2\x9e\x92'\xf6\xd2%F\xaa\xa4\t\x9e\x93Ŕ\x8e0\xddmp)\xc0\x9a\xb8?\xf6\xfb3\xb1\xa4eAW\xe4j\xa7\xbe\x9f\x8d\x11\x90v\xc5b\x87\xa5\xaf\xee\xe8\xa6TVQ\xc9\xd9\x18\"ͼ\xa9k\xda\xdaNsD\x05?'\x8d'ZV\x98H\x1e\x86:L\x04\x8fp\xedw -Ue\x19\xa2\x10\u1a8a\xe04`N\x11\x82\x02\xae=\x06\xefaRqW\xf9\x06\x15\xad \x1d\x82cŚX\x1b/\xfc\x84\xa8\xdf\xda\xf56q\xcd\xf7\xf0H\xcb\xff\xcco\xa39[\xb1,e\x04\xaf\xfd\x03]E\xb2\x17g@\r\r\x9e]\x92L\xc8Q\x1a`.2D\x18\xcd\xef\xde\xe3\xdbX\xfb\xa7k\x8b\xfd&)\xfaLZ\xd5)\xcf\xeaz:\xeam_~\xaa\r錩\x1d0\x93T\n\x82\bV\x15\xd2#\x19\xb5Qk,\xd7b\xdc\x1bIj\xc8F\xa9Z\x87\xd1\x17b\xc9ԪB\x8fƍ\xe4ǗE)\xbd\x8f\x15I\xde]nHG}kU(]\"S\x8eMK\x04V\x04\xe7\xd9sI\n\x81q\x05\xd4;fk\xbf\xa6\xfa\xee\xd4@R\x93U\xf4\xb3\x1eP˭\x8dR亪\xd6\x13x%:\x8a\xb8`\xa9\x94\xfeJ\nX\xee\xe09\xb9\xc6\xff\xa5k\x87\x86\x97\u007f7Go\x14\xdec\xf0z\xef\x94'j\x17\x9e\x98\xe0\f\xa6z\x89\u007fޑ\x12g\xf2 \xb4\x1a)/\xac3\x13\xac\xc3T\xb6.\xf2gc}E\x9d\x8d\x8d\x02\x8fK\xa5\xde\xed\x16k\xa5\xddRt|Y|\x001N\xe47Y\x803O3\xa7\xf01v~\f\v\x8a\xfd\x82\xcd.\xe7d\x9bc\x10\x9bg{\x85\xdd\n౪%\xe6\xe6\x98\x15I\u007f\x89\b\xee\xf7\x11N\xce&M\xdd\x1dW_\xfb}\xfd\x87u'V\xb5+'@\x83\x02\x96\xb2{-7\xd2\xda \x04,}<F\x8c\\\xe6\x84^\xe9\xc8\b\x06%V\xa6\xa9Ѥ\xd6>/\xee\xd0\xc6\xe6 \xd2\x01\x0f\x8e\xf4!)\xa83\xc1o\xa4%T\xf7\xe4\xc4\x1e\x90L;\xae5H\xc5\x03iF5_\x15e\x02\x1e\f\xb0~\xa8\xb0\xde/\xda,\x83\xc4|$5!\x8cG@\x19^Ao\n?\xa4\x19)\xe4<\xda4\x04\xbb\xde.\xd2[\xc4{\xb0\x91\x86y\xe6)\x87d\xa8H\xc8\xc192\u007f\x8eE\r\xc8\"\x91\xbcXs\xa2\x10\x15\xf3\xf1\xa2\xaa\r\xd4#ZO^0\xd7\x02\xb8\x92\xf2bv\x00\x8a\x02v\x16\xda\x117\x8e\x80#\x0fE\"\x0e\xa78d\b\xe6S\n\xd1E=l\xfd\xa6%\xf07\xdc\xc4b\x81n\xc1;\xeaIz\xe2Tt\x95\xeb\x84Jz\xa2\xad\xb1,9\x10l\xa1ܿ{\x00\xa2\xb1Aq)\xda\xcfi\xba\xc1b\x8b\xb8\xda\"\x17B\x0e\x82D\x18j\xb7\xb7\x06\xec\xb0\u007f\x90]\xe3\xb4\xc3\xe0\xab\x1fphw\xab&\x04qw\xd5\x16\x10[vn\x06\x94M8\x1e\x03!YE@\xc2Jpіn\xba^\x9d\x1d\xfb\x11\x19\x8a,`\f\xa0\x11\x14>\x0e+O\x87\xecI\xfb\xd1\xc6\xcd\xdf\x14\xb2\xde%x\x04/\xbd\xcbM\x81Cp\xbf\x82\xe1\x15\xfc\x9cs\x18t\xafE\t *\xc4m&\xd5/\x84\x99\x87.y\x1f\x0f\xc9\x15-J\xbcP\x91\xa8\b\x8b\x1e̕㖶\x0f]<p\xeeŎ\xebPmw\xac\xf7\xc5,FnpkS\x9d\x1b\xb2\xbeEI\x82\u007f\xc5\xd5Y\xb76\x97`c&o\xf1\a\x19\x1d\x8dI\xbd\x8d\xfc$ݧ4+\xa3\xccS\x19\np\x04\x10y\x10\xc5?\xefҜ\x17\x00*\x95K\x80/+J6\x92\xb5\xde\x16\x11\xd78\x9c&\xd2Hp.\x05\bT,\\cAT&Q:S\b\x00b`QC=\xe3(\x8e\xc9u\x89\x9e\xa7\xb3\xd09lS\xf9E\xc4]\x9dl)C\xaa\xa0\xb4\x85r\xe2\x04u\xf2\xc4\xf3\x85>lS\x8f\xd7k*@\xb12\x8f -fN*'\xa0\x11\xfa\xa9\x82\x03v\xd7\xef\xb9{7X|\x17K\xa5\xe5ĢetKgʁ \x06\x97y\xb1|\x0f\x1a\xe2\x8e]~Dg@\x9ca\x03g\xf1w\x10\xd6h\xeeu\xb0\x80\x88\xb6@&Щ}H\x91\f\xea\x85\xc5)R\x18\xb3M9ǥ\x0e\xf1\xa6m\xcbo\xb7\xd81є\f\x9a8\xba\x93\x16;B}\xec\xd2}\x05|}g\xc1zs\xbcPA#\b\x8c\xf1Y\x92\xf8\x13\x98\xa98&W/>l#\fmų\xb1\nX\xb8u\x8f\xc0~\x1fQ)0\xb7\xe1 \x0f\xf8a^\xe8\xc8-\xaa\x991\t\x02\xc4<84Û\x10x'}P\x9aG\xb4ߏ\x98\x98_\x05\x11\xab\xe7\xba\xdfw\xf7\xa0V\xa89v\xd9\x01\xd5\x1d\xd4Kl\x90\x87\xd0n\xd7\x064\xf2\x80\x90\xe4\xec\x8cLͳ\x81\xf3\xac\xebs\x82V\xee\xa3\x01\xca1ǋ\x88#\x82jf\x19Z\x9eN\x1e\x1d\xe6\xd9\x19\xd7\xf7\xb2\x96;\x0fʶv+\xcc\xfd\xa9ǟJ{\x9f\x01iތ\b+c\x1f\xc06ҷԷ\xa4oߣ\xa6\x9b\xc6\xfdw\xa4\x9b\xe6m\x89\x84\x04ؒ\a\x06\xaa\xeb\xc6-\xdc\xe8\xaa\xcd\xfd\x9cLF\x8c\x17\x12\x8f@L\xd4\x1dl\xa6\x1d+\x87<ԋ\x950\x8fz\xb1\x847\xea\xc5\xea\x86\x14\x05\x02\xa9Q/6\x17\x9a\xb1\x15i\\\xb0\xf2\xbc\x03p\x88#\xa8g\xa0:\xad\xe7\x11\xee\xd1\xc7et69\xb1s{\xf5\xfe\xa6\xfd\xbb>sE\xb9i*K\xa4-\x82AZ\x1d\xd8\xf1\xe3\xd51\xf7\xdfܖ\xba\xc2\xf6վ\xd0\xdaw\xac\xbd\xce\xfcW\xe5f\x90MU\xa9\xaa\x16B\x84\x8d\x04Sj\xb4j\xc9]\xad\xbb\x89=\x1d\x0fj\x1c\xa6\x18\x10f\xc0\xaa\x9d\x80P\xe3\xa0xU4ʶ\x8eM\f\xe4\xa1P\x80q\xbe\xd7=\x18\xb7O\xe4jv\xe2\xb3\t2\x17I|\x97\xe6\xdbu\x1a\x9f\xffm\x9e\x0e\u007fy6\xfc\x9f\xc5\xe0\xb3s$\xcb\xdeI\xfd+Y\xd6\xdf\xc6\xc3/\xe5gB9\xbe¥\xf80\x1c,f\x17\x99(\xa4\xbbͥ[\xf60\x9a\xc5\xf3\x8b\xd1\x05Z\\d\x038\xfb\xec\x1c-\xd32\x8b\xcf\xff\x16\xcd\xe2\xdf\xcfEWw\x93G\x95\xa8$\xff~\\\xc1\xd9\xfe\xf3\xf9d\xf8\xf9B\u007f\xfc}\xb5\xff\"\x9a\xc5\xe3\xc9d\xff\xb9,\x92\xff@\xdbt\xffx\xfe\xfb?\x98ʏ\xab\xfdcQy>\x1e~\xbe\xd8Ͽ\xf8\xa3_{R\xed\xa3Y\xfch\xf2x\xb2\x9f\xfcq<\xde?\xfe\xfc\"\x13C^d\xe2\x1b\x14\x93\xbb\xbe\x16sS\xad\xee\x1e\xa3\xdfW\x9f\x9d#\xbcII\xee\xad\u007ft\xf6\xbb\xcf\xfe\xad\xff\xe0\xe1\xe0\xe2<\x99\xfd\xed\xdd\xff\xefn_\xfd\xefp1\xf8w\aBbI\xf6\xd7pq7F_L*\xe7;\x9cE\xb3\xf8bt\xaf\x16P@xW\x8a\xb9Dkηl\xb6_\xf1\xed~Er\xbcgl\r\xe3\x8b\xf3\x8b\xf3(\x8a\"\xb3\x8b\xfb\x8bl?\xdc_\x8c\xf6\xef\xf6\xff\xbb\x9f_\xec\xc6\xe3g\xe3\xe1\xc5\xee\xeb?\xbc|y\xb1{\xf9\xe5X\xfcx\xf9\xf5s\xf1\xe3\xeb\x97\xf2\xc7\xcb\x17/\x17p\x1f\xfd\xdb\xfc\"K\x87\xab\xc5ݣ\n\xee\xe7g\x17\x9f\xf5\x1f\\D\x17\xf0\xe2\xe1\xc5\x00M\x93\xc5>\x86\x0f\xff\x1d\u03a2(\xba\xc8\xf6\xf3\xc9\xf0\xcb\xc5E\xb6\x9f\\d\x17\xd9\xfe\xd1|<\xfc\xbd\xf8\xf9\xe8s\xb9\v\xf0b\xf4\x0f\xad\x04\xf7\x8d\xf5\xdfg\xd9\x1f\xd9\xf0\xd7\xc2\xfb\xe1G\x8e\v\xe1\xc5\b\x0e\x9cY\u007f\xdcZ\xffO\x16z\xbfU\xce`\x14_d\x0f\xe1\fF\x12\xc3\xff\xfe\b\xbe\xffw8\x90c\xfdC\x86z\b\xc5\xdaf\xd1\xc5\xec\x1f\xb58\xd1ዱ\xea\xe3\x8f/_.\xf6\x17\xe7\xfb\x8b\x99\x98Ft\xf1\xbb\u007f\xd8$젟\x9d\xa3\xacؤ\x84J\xf2\xeb\x10\xc5\xfa\xef\x8b M\x14'\xc0\xcc\xf5\xee\x11\xfa\xa3 \xd8\xe2z\xe3d\x835)\u007f\xb4\xb0\x97\xcb\xc5P\x14L\xf4\xb5\xa0~=ֿ\xdeF\x92\x82\xe8Oq\xf7\xaf\xe8\u007f\xf6\xd1\xfcbx1Xx\x9d\xc5\xe31\x84z\xf4\xf8<\x9aœ/\xf7\x8f\xc6\xfa\xeeyT\r\xa3Y,\xef&A\xbf\xf6\x139/8\xf4K\xbe\\H\x82\xf6\xa5\x80\x9f\xa8}6~\x04\x83m\x1e\x8f\xa1\xae\">>\xfe\xfc\x0f\u007f\x94\x9f\xc5\xd7\xc7\x139\v\xb3\xfe\xb1\xea\xd6\xe9\xfd\xf1\x02F\xb1\xb3\x9c\xbbG\x06f\xaf\u07bc\x8e\xcf\xffv\x91\xdd\xfd\xbe\x9a_\x9c_\f\x17\xe2bD\x8f\xfc\x1f\x9f\x9d\xa3MA\xf9\xfa]\x96\u07be\xbb\xc5i\xa9F13\x9c<Z\xc0\xf9\xb0wq>Z\x98\xd2\xf9D\xef\xc0\xfe\xf1|<\xb1\x9f\xe58r\xe4\xdbw\xaa\xc7Fo\x1d\xed\u0083\x99ޖE^\x88N~7\x13\xa8\xb3z6|)\xe1\xffE\xb5w\u007f>\x16\xb7}\x85j\xa1,\xbe\xd3\xfa\x9c\xb8\xe3%X\xdb.\x06\xf5D\x8a\xe7\x87R\xeb!\xff\xaa\xaa\xca\xe5\x1eU\xd0\xe4\b\u05ef\xa5\x9f\x1e\xd0?\xea\x01]t\xfc\xcf\xf7\x02\xbe\\\x16e&\x13\\\xa0@P\x14\xdb(rj\xa2;\x191)6v\xc8HFJ\xaa\u007f\xd6\xe1\x8fb@\xa5\"\xca\xc4K\x8a\xc1\xb6\xc4פ\xd81P\xfd\xaaWw?\x99\x81\x0e\xd0 6\x9e0\xfb\xbe\xfe\x19O/\x9b\x0f\xf3u\x94\x82\x9cX\x11\xd8,Lz\xd8/\xbc\xe6!\r\x8d֨D\x04\"\x96P\xa7Kmd\x9c^\x0eu\x8c\x9b\x85\xd4j3\xf5\xf2\xb7\xdf;\xf0\xf5\x937\x80\xd4م4!\xb2v1\x00Z\xb0\x9a\xd2p\x1c\b\x1d\xa8A\fV\x169\x03q\xa1\xc3$\xf0\xf4\x12 \x92\xc5iw\xdc\x06\xfbl\x1b\x9fM*\x88\x98\x17\xc0\x82\xa7\x97۔\n\x91\xce\t\xf2\x90\xe3\xec\xf2\x16\xd8>\x9d\xd8\f$\x8b\v\xc7l*\xa4'\x1d\xb9\xf1\n\x0eC\xccy#QA\x90\x8b\x1b\x01xq\x9e\u007f\xbd\xd9D`Ok\xbf\x18\x13\x9f\x9e\x1c\x9b!u\xdec\x9c\xb8\x17@;,Y\xb3~\xbb\xad\xbd\xf7\xf8V\xac\xc3+\x04\r'\x80\xfa\x03\xf2M\xe1Z\xb6\x9a2\xd0d\x1d\xda\xc2\x00v\x16a\xab\xb1K\xf3\xbc\xb8y\x96\xe7ϥ\xe5\xfc~O\xea\xb7q\x18n\r\xfb}<\xdam#\nc\xac\x80N\x8d\x8dHp\xf6\xde$C\xa4\xc3\x06\r\x8b8\U000a81e2\x13^\x9e\x88\x91*\x13C\"A2\x9a\x9b\xc8\x132\xa22\x9a\x889\n\xd2\x1be\xb9cR\u007faV-\x1f\\^Hl\xdf\xef\xb9\xeb?Ђ1\xac\x90\xa1E\xa1\xc1ķ\xdft0\x1d\xc6\xcb#e\xed\x9d\xe5mw\f\x15\xb8\xbf\xc6vCe\x83Ϛ\x8e\rCx\x93\x95]\x89\xab\x8f\xf71\xa5\xdf?s\xfa8\x8a3\x8a\x84\xabõ\xdbF\\E9\xeb\xd5\a\x97;\xea4i\x0e\xd9T\xc57u\xd4\xcd\xe99P\xee\xf7\xcfl\xbe\x9c.\xb2~\x1fB\xc3|B\xb3\xdbF\fV\x86Ir\x89\x9cT*\xfa\x01g\xfc\xael2\fX\xc3\u038b>c\xa6\x84dT\xe1\f\u007f-a\xe3\xaeS\x16\xbf\xd9b\x9cy\x86\xf4\x81\xd4:\x81\xa3\xa8\\\xa8\x90\xcf\xe65I7\xf4\xaf\r\xe7R\x18\xb7/\x85\xb1\x83q\xbbm\xf7\v\n\x0e!\x8b\x13O\xb7c+g\x1d$,\xc6G\x10x\x1a\x1d@\xde\xfd\x9eA\x19\xd3H\x02\xf3/ۈ~\x04xw\xdb0pÈ1vv\xdc\xcbc\xe0n\xfa\xc7\xeeK\xf8\xb2>EA\xdd\xfdV\xd9@\\An\"\xb1\n\x03\xb2q h\x10\nug\xaf\xbb\x06\xa9\xfbX\xcds\xbdC\xf1\xa3\xcf\xc7\xc8A\x16\xe9c\xe9m\xb4\x8cF\x1c\x96Q\x1c\x9a\xfbIN\xf9\xa7\x96S\x1aq\xa8F:\x8a[\xe4\xbf\x04z\xbc\xf1ǉ6ߪ\xd0\\'\x8a7\xb2\xf6i\"\x8e\f\xf9\x1a+\x97SG\xc6\x11\xf4ҕ\x81\xd4\xc5Wǐ\x8d\xb5\xdb(R\xd1f\xf5\xcfgy\x0e\xd0\xdbg_\x99\xfa*b\xab,\xd8m\u007f#q\xc9%\x04N\xc84\xf9\xba\xe6ݜ55@\x01\x81\xab\x16\x13\xa4\xbc\xa5\xa9\x94<\xb1\x8aTIOѸM\xfc_o15\x06\xbb\x9f\x89ѿ!\xf4=; 1\x18ɬ\x11>\xad\xd5EWH\a\xe9\xbbwL\xf4\x1aʮsB\xdfK\x97\xf9C\x82@\r4!\xfb\x9d$\xd8\rud\xb8\"\xa1\x1dW\x1a\xe9\x10\xe7XK|k\nx\xb8\x8e;p\xd7\x16\xd5pSTs\x9ak\xf9\x8ed1\xbb\x87\xe8\xe6\xf9\xda;6\x14Ӷ\xc7!\xd1\xfc\x9f}\x91\xac~;\xa3w'4\xdfAY\xee\xc0\xf696\x8e\xed\xca\a$9EK:\x047E:\x8e\boV\xd6!\x92\xaf\xef\x14\xb06~g\xbe\xab\xafF\xd14),_\xba\xcb=\x8eW@\a\x95Iq\b\x06iS\xbd\x11\n\xbc[\xc0YD\x93t\x84\u007f\x8el\x02\x951\xc2\xc3\t\xf4\xc4#R2\xb1\xba\xff\xc7ܪ\xe2N\x1eLl\xb2\xa7\x8e6\xc7v\xab\x8e\xfc[{\x13\xb2\xa4\xb0h\xa0\xb4\"C\xd9\x1c\x04\x06\xa8G\x90q\x84\xbd\xda3jA\xc84J\xe9V\xed~bz\xaf)҄\xd6S\xcc\xd3\xc33\xbc.H\x16y\xd3t[\xe8\x05\x87\xe7)\xe5bS\xd4\xec\x18\xc2X\xf9\xe6T\xe1\v\xb0\x16\xd2\tj݁\xe2b\xf3RϨ\x99iz\xa2Xmy\xcaK\x88J\xf7Tv\x82ЈѰB\xf2\xd2\xf3{\xb7\x89\x93\x1a\x03ͤb\xa2\x84qq<\xecg$\xebvW\x84\xe8\xe4\xfdv&\xeb\xbb4Z\xfb\xa8f8\xd6\xe1\xc46Bg\xe3\n\t\xe0\x04\x1a\xb2\xa3\r\xdb*\x92C\a\xd91*ִ\xe5Pm\x03\xfbgy\xee\xeb`\xd6$\x13\x85QH[!hY\xd0\xfb\x99H\x11\xe2\xd5F\x86\xf5\xe78\xa0\xba0\x84^w\xff\xb1L\x8aaI:\xa4\xe6S\x14$ʲ8\xd6q*\x94\xe5 o\xa0\x9a\xab\xba\x88k\xbd\xc6\x115\x06o\xdfV-\xc6g\xbf\xb7\xdd\x1dӐ\n\x86̨a\xd8_('\xb9\xdfFj\x17Ĭ<\x17\x04\x97\xa9\xf0C\xf9\x1a\x06`R\xd5z\x8c\xc3\fV\xa7\xaa\xa0\x92J*\xab\xc3\xc0G$l|\x8a\x02C\x91\x1b\x99P\xf1\xa0֡\x01e\x8b\x12\x1f3\x89\x86\x98\xefNa\xea;:ub\xe2\xd8\xd5\xfb\x84\x95\x03Sr/x7\xc0\x8d\\\x13ʏ\x11\xf6\x9b\x93\x96[v\u007f\t\xbf\x8b\rj\x89p_\xedJzH~\xfb\rU\x03\xe2D\tt<\xa2\x06P\x13\xfd\xa4\n\xf8\x97P\x05d%\xc9sI\u007f\xef\xa9\n\xf8\xdaixX\rP״*\x00%\xdek\x05\x80+\xebk\xf1\xbf\xf9\xc4y\\\xfc\xb7\x8f\xa0\xbe\x06\xe0\xef&\xfek2\xf0\x8c.\xd7E\x19\x16\xb7s\"\xe8\x93\x05p\x9b>y\xd2\x11\xf2\xfa5=\xfa\xc3X\xc2'\x99\xac\x03\x82t-ʿ\xe2x\xd35=\xab\xa6\xf8ɝ\xe6e\xba|o\x89\xa7\x13\xa7_\x06`wX:\x8d.\xdb\x12\x8bY\t\xa2`\xf3\xa5\xbd\xd7H\xf0\xa2%\x98:\xb5O\x91N\x1b\xcb?\xfe\xcah\x14\xc7Λ\x92*\xfa\x86\xd0\xf7\xf2m1/(\x8e\xa0`\xbat\x16]z\x88\xaf\x1bݔ\xe96z\xf0$'&\x1f\xaf\x13=^\xf5\xac\x82\xc87\x83\xca\aw^\x82\xb0'`\x9a\x80\xba\xe0\xe9\x93\xf3\x9c<} \xf8>\x15\xf5\x88\xa5\xd78\xfbS\x89W\x00\x99\x18\x82k\xf1\v\xfafت\f\x1dҨ\x84Y\x9612\\r\xdc\xc8\x02\x80\xb0\xd5&\x10\xeb\x06nq\xf2\x04\xf8k\xc6+\x80P\xf6\x85w\xbf'\x06\xfa\x0e\x97!*}%m\xee\xe5$\xc4Ow\n\x06u\xc3/\x19\x0e\x11\x13\xec\xa7l!6n\x8bK=;;\x8e.n\xbc%\xb5\xa8`\xdd\xdc\x1b^b\x83W\xc1y\xabX\x1a\x9f\xc0wW\x98\u007f\x9b~\xf8\x9al\x84\xa4s\\-\x83\x1bz\x1f\x9fAp\xe6\xe6\xebH\xea\x0f\xc8\r\x1eHV\x11\x8fL\xb0\x0e\xe8s\xbc`\x97\x03\xa4N~\x18\x80-\"%\xaf\xd3nq\x04і\xf8\"0\xf2\x9dL҂=z%3\xab\xe8]\x90\xb4\xf95}.\x96b\x9f\x1f#pYd\xb7\xf2\x11\xd1>\x82\xdck\xf12\xf6\x9eZ\xb7\xccwh\xb6M\x06g\xe3\x82i\xe0)\xa1,\xf2\xbe \xd3D\xbaև\x84\xb1wV\x88C\xe1\x99I\x16\xdb\xd9f\x9f\xfc\x9dB\xe7,\xa5VBHH\x96i\x1d\xa9\xde\xd3^*HBC\xd9\x15\x02\xccQE\x97Ĉ\x03Z/\xff\xaa\xfa\xd5J.\xa5\x9a9\xa6ֺ\x97\x9e\xc5e2\x9a\xd6\x0e֡\x9e\xb0\b7/\x15i\xe2\xa1\xf0\xb5h\xe0k\x03>\a\xd3\x02\x15\x10y\x9a\x05\xaf\xa5\xda\xc2]\xde\xcbI/\xad\x93sag\xe3[\x1a\x92\n\na\xc7@ hXa#.H\f\x8d\x0elgk-\xde^\xdfca\xe1 \x81\x87:\xef`Y\x02:\xa1\nMԪ;uC'\xe9\x81\xdcJ-\x85\x98\xb9Z`\x85Z\x9a8\x1fKꝙu \x8eT\x8c\xfdSb\x0e\x8c\xa3\u007f-\xa4P\xbb\xf9\xf7Р\xbd\xebV\xa1)\xbb\xa5.\xc5R\xeb\xca\xf4\xb4M\x1ew!\xb0\x90\xd0+\x00\xa7\xfc0X9\xf4.3\xdea\xfe\xd0s\xfb\xac\xba\x9c\x9f\xeb\b\xaf\xee]U/\\\xb2e\xc7\xd4A\x9d\xac\b\xf2,\x84B\x02\xc4\xd1\xeb\x9a\xc0\xbbC\xfb\x84\f\nso\xbf\xcc\xfb\xe4\xc1˕\x87/W\xfb({@(\xfb\x15\xcc\x17\xee:\n\xdc\xe1!*4\xf6\xd6#\xe8GX\xb1z\x88\xad\x0f\x1aBu\xe0\x81 t\x8d)K\xa5\x9cw\x04\xc2S\b\xa3\xf1\xe9H|\"\x0e#>\xba\xccw\xa5\xbc\xf4\x9c\x89\x8by\x1d\x9cx\xcdgw2YcD\xea<ｆ<\xd3b\xb6N\u007f\xfc\x94\x94˄\x10O\xc8S<#\xf2\xe9\x98\xcc\xc1\x86СJ\x8f\x01\x16\t~\xd8@\xc2\xf9xѕU\xc9$\xd5\x00\xdb\x0f@v\x94~P\x89\xcb\xc1\xc2'C\x87\xfaPy<T\x17\xa7*:\x1d\x0e\xf7\x14\xf5c[Z28\xb7\xa3RB\x82\x9dL+\xea\x8d\xc2\x02\xb4\xf5\x8f\x8e\xe0H`\x11l\xe9\xf0US\x8d3\xeaG\xeb\x18\x03x\x1c\xddz\x1d\r\xddQ[\aΕ\xbb\x1da\xbag\x04i)\xd47\x958]j\v\x1b\xbe3 KT\xddz\xe3c)6\x9a\xca\x03i}\xec\xa8\x0fP\xa0\x8aY\xd9\xd7\xcd\x0f\x1f\xef\xee\\K\xf2\xb1\xab7i_\x11O\x9f\xa4\x16~\t\x18\x83\xa7_\xa5\xcb\xf7O\xceS\xad\x0fAZ\u008e\xc1\x93\x8c\\?}r.\xfe\x05\xa8V\xe7\xc8\x1c\x02\x8e \xd9m\xc1\xe6\xe8*?\xa9\xad\xff\x85<m\xbe6\x89}\x8e\xab\xa0M\x06\xa0\x03\x1a\xe8\x90☧\x97\xefVEy\x93\x96\x99\xa7?\x16\xe5\x02\x89减\xd5$\x87\f\x84\xd4y%\x99`\x16\xe5\x87T\x12\x91Ħ\xdfQO\xb1N\x18\x95\xfd\xde~\x13\xebp\x03\xac\xb8=t\x18Da\xa4\xc390\x1d\x95_\x9a\xc8z\x89~\x1c\xab\xa7\xc3\xf9K\x9bq#\xb7\x85\xe2\n\xd4\U000527ee0\xff\xde-5*\xe3e\xb1\xa3\x1c\x97\xc9\xef\xd5\xcf\x1dÙ\xa9ǒ\xf9\"h1\xe7k5\x01/w\x18\x84\xa6\xee\xa4w\xa9\x97\xe2\x1au\xb9`:n\u007f\x96eCU\xb5&\xd6m\v\xac\xe6:O\x13/dLIQ\xfd\xbbt\x83U\xb0\xaa\xe8<\xe2\xc5v\x9f\xe3\x15\xdf\xcb\xc8\xd1{\x95,\f\x9e_\xc1)O\xb8J,\t\x80\xb66;_\xe5Eʇ\xd1ś\x01\xbc`\xe7#\xfc\x01/\xa3\xe6\xfa\xec\x10p\x8a\x13<\xc3\xf3\x89큈\xdf2S*\x8f\xb9\r\xcb\xe3F\xe44\x9b\x1ad\x18[{\xa7BD\xf1\x19\x8f\x81Nr\x06\xd1\x197\xde\x01^M\x13\xe3FfF\x84OƳƞ[VT~\x8f\xe5\xff\x92$9ҙ\x19\xb5՟\x17\xc9\r\xc6*R\xf7\xf1\xfet\xc8\xf7#\xdd9\xb3\xd5\rL\xb0\xf8\xe3#ȉ\xdcc\x00U?>\t\xaaO\x87\x93\x8f\x1a\xbc5\xda=\xa1\xff\xb1\xe3\x1e[\xf4=w\xed\xe84NƖ\xfbm\xe6o6\ue84f\x9a\x90\xca+Z\xc5S\x17,\x86K]\x87CG\xec\xac\tT\x806\x91U\x04Vi\xce\xea@\xc4\xde-\xd2 \xfd\xd0O\xb6\xc5;I=N\xfc\\\x81#//`C\x14C$\x89N\xa8\xae\xe6\x05Q\x8d\n\x8d\xf4\f^\x91<\x05\x88&\x06\u007f\xc9\fhQ-\x06JԚ\x9a\x82$I\xa8\x1ff\xedZ%\xce\xf2\x8d\xb0\xd7:ϭ4\xe3\x95]<Mp+}\xe5~\u007f\xe6\xeeD\xbf\u007f\xd6XZ3ib\x03\x180\x180Oed\f@I'Wl\bn.\xc0P#W*\n\xad\x14\x85V\x8a\xce\xc6P=v\xe9̹\xed\xd5\x0e\x1f=\f\xb6ԹuA\xba\xe3\x05\xa8:0\xf6l2\x15\xec\xf6o\xb7R~\xfa\xda \x9c\xdeocd\x90\xfc1ԧ[\xef\xee\x14*\xae\xa0\xbe,\xed\xf1t\xcfݯ\x8cRN\xa3;\xabڱ\xa9\xc1\xf4\xa20U\x89\x1bܔZ\xedz\xaaح\xd8λ\xa4j\xear\xb7j(O]\xdcZ\xa3Ӥ\xc9\x1d\xae\x8bkq\x10\xbcc\xacDb\x99\xbeAb\xa7\x12\x8e\x15\xefޓ\xc59N\xaf\xb1[\xac\x15s\xe16^\xe8u/ɐJ\xedR츴\x1eW\u007fv\xa6@\x12\x00\x95\nI3M%G\xcb\x15H\x9b4!\xdax\xeb\xfa\x1a\xe7\xe9\xad1E\xef\x98\xf8o37\xb9\x89\a&7\xe9\x9e\\`?\xbeO\xa9\r\xf1\xee\x05`\xfb\xc7\xee\xc9?9\xe0\x9a\x92U[\x8d\xd8z\x94mM݉z\xa0N\x14KB2\xac\nw\xbc\xdc\xc9LqQmu\t\xa7'<\x89Z\xa1\xd7\x11c\xe3\xb0\x11\xa7R3\xc5:3ۈ\xb0\x88\x8d\xf0\xcf\xd1p\x02\xd5;\x97\x81\x03/ӭ\x9c\xcfLU\x18\xd7F\xd6\xedw\x18\x18[0\xc3\n\xb92\xb3;\x8b\xe8\xf84\xc6\x10\xee\xf7N5\xe5t\xa0*\x1d\x9e\x9fk\x05~l\x82\xcd\x17A\xaa\xdf\xfd4\x8b\xa1\xc71\xa4\xc0\x97\xd8\xc3v\xe7\x81\x11\x83\x8f\x92m\\4\xcf_\xfecU\x9ae_\x15٭I\xfc\xd8y]D^\x16\u007fm\xfc\xdc\xe4\xae:\xacM\xbc#\xdc.\xf7|q\x88\x9d/a\x11\xaf\r)\xear\x15\xf6\xdfZ\xa3\xd4&@\xfe\x96\xb7kDāJ\xd7\x1c]\xe0(%N\x88\x95=\xf4>\xb6i\x90\x96.mL\xfb\xc8kID\x91\x8b\xc3\xc6\xdd\xed\x9b\x1fu\xc9W\x84\rU\xba\xcdNks\x9fj\vVJ\xe2\xbbQ(\x1c'\">\"Ly\xed\xb5\xe5\x1f\xe8\xca\x1b\xc8մ\xea\x1b\xa2\x81\x8eQ\xe7\v\x14[\x177>\x98\x9b\xf1\x96\xed.j\xe5[h\x1b\xcf\xfc\xde=\x03&\x05\xb3Z\x0ei\xedz\xf3Y\xe0\x10\x90힙\xdd\xf6\x1a{\x1b\x1e\xb0.os\xb5\x9e\xae\xa7-\x16My\xf3\xc6\r\tvm\\\xe9ֵi\xf6ܰ\xdb\x00)F\x1dt\xf0ܓ\xe3:8\x8d#ɸ\xea\xd8\xe2\xfa\xad\xee\xe8\x16w8\x93\x04\x8ejh\x8b\xebZ\x81[ۏ\x9e\xa1\xe9\x87\x13?CQ\xef{\xdb\xffۇ\x9a:[\xb6|\x99n\xa0\x89\xf3\x9e\x13\xd2K\xdf\xe7\x11\xa5f7\xa4y\xbe\xfc\x19\x9fM\x90e\xd2\xc4\x0f#\x8fN\xd0\xda\xfe\xe5\xe1\x81\xd8z{!J\x97\u007fC-\xee\xf3vb\xd6\xf2\xe9\xe9\xe4_\xc4\xe2\xdf\"\xe7G=\xb6\x9c\xe4\xfa\xefU\xfe?0\xfb\xff5O2\xfc\xa05\xbfZW\xcbndz(zNg\xab#\xf5<K\x0f\xe5c\xcbv\x97\xa7\xd9\xf7?\x98\xd7v\xe6\xf2\x81\xbd~\x10:\x14\xc4\xedH3k\x90vl\xba\xbe\xca\x05\x97\x9c,\xd3\xdc\x04{\x0f\x13y\xaf\x89\xd4ݩ\xfa\xfb\xbd\xa3\xc9\xf3#Ր+*\xfa\xf0\x9ekJ.\xd3d\x85\xec\xc6Y\x04F\xbc\xd8\x0e/\xd3r\xa8U\xc2R\x94x\b\xe0,\nw\x9d\xe8\xb1=\xa7@\x81\xbal\xa8t\xbb0n\u007f1ya\xd5-Ըf?\xce]\x1f\x91\xa4\x95\xe5T\xa9\xdd\xf6\xfbP\xdea\x9d\xaa\xd2m\x82h\x02\x0e #rȸ\xe6&\x8b\x84G\xec\x90%\xf9\b\f\xa8\xb2$\xb6\xbbH!*\x13\xf5\x18\x97$I\xe1\xa5ibC\xc9\xc3\x038-\x8eb\xbe`\xe1RHVQ\xa9\x98?\x1c\xe4D\xf7{\xef\x03Y\xbe\u007f\xbdŴ\xdf?#B^t\xf2\xf8,\xf1\xcb\"ϋ\x9b~\x9f\x18\x1e\x81\x1d2>c\xa1(\x03ڤRs\x14\x81*\xec\x88=\x9b6\x16=\xb6v\x88\n\xa9T(|\x98c\x87\x8aK\xc8\xc3 t\xa5B\xaa\x11\xd4ɂf\xbf'F[\xe9\x18\xc6\x05E=\xf1\xb9W\xa3O\xf3\x13@\xac!\x8bdD\n\x18\u007f\x12<\x8a9\x80\xfe\x18a\x8dP8,\x03\xb3\x9a\x91\"a.~M\x8b~?j\xe8}2\xa9\x99A\xfa\x8fN\x9d\x8f\xda\x00v|\x03:T?\x87\x15S\xf7]\aYEE\xbf\x8f=YN\"\xb7z\x801g\x88\x85ϐ\xdb\xd2no-\x01}$\x80$\x863o\xfd\xdanJԇUպ|\xbat]\x87\x83m\x90\x03d%x\a\xa5\t\xd6W\x90ԳD\x05|:\x9c\xa02Ig\xba<.\xeapi\xca<O\x1e!8-O\xf7V(\x85\x04\x8c\x87\x13론\n\x06\x13\xd7\x19A\xb2\xbfy\xe2\x99:\xb7\xc2K\xec\xf7\x1e\x9a\xd5aF\x0f\xaa\x86v\x89\xa7~\xba_\xfb\neI\x8b\x95q\x89M\u05ed\xed\xa8\x00\xacE;\x174\xc8p>\xbd\xa7\xbd\x13\x17\xb0\x0e͠\xd3\xf4{\xca\x0f.\xb16\x00\x0e\xaev\x99\xa8h\x1aY\xd0\xcc_\xb6t\xf4\xa5ȵ\x99o\x05\u007f=\x04\xd5@\x98\xc9\xc3\xf6\xe5S\x89\x93-\xfe\x06w\xf0C3\xfbt\x89\xdb\xfc\xc7\xcc\n\x01Kt'}\x1er\xb4\xdb\xc6;\x15\xd23\xab\xfdB֕\xe0D\x0e\xd4]\xd7u\xb3F]\xf9=\xaf\xbf\xef\x94wE&Z\x8b~O\x9e`kRșFhPwR~]t\xb2~[\xc9\x18\xcb\xdfBQ\xdaЊ\xea<\xe2\xe1K\x11ӬE\xee\x9c\xdb\xe1>\xcdPS\x96\xa4I\xa7\x82\xd4\U00063334oZ\xad\"\xbd\xff\x84\x8fۢ72{9$\xd8\x13\v\xa4_\x8a\x9f@\xdeIK\xaa\x16\x81\x8dz\xf7鸂PfL\xad\xc9\xc1aA\xc9%\xed'\bb\n.T\b\xeeX\x85f\x90фHN\xf8-@&ˡ+^\xfd\xe4\xf4yzďc\xd3>\x1eH\xc4\xd7\x15\xebTb\xc7ޠ\xb1Lʮ\xa2a\xac\xa23ˮ\xd7F\x10Aaf\x06\xb4h\x12\x03%\xbd\xc8[Ս\xa6\xd1\r\xd6\xd4ׂJA\a\f\n\xd8\x12~\xc0 <x\xe3\x85\xebȺ\x10\xdb\xefCCvwߚ\b\xa1\x14\x97-)l\\\x85\x10\x024X\xd9\xdfRۭ\x8e\xb8\x97\x06\xab\xc3\xe5\x02\x99\xb36%ҰȤ\x05\xe4\x9a\xf78K\x12<sN\x1d-\x1c\xf6\x8d#R\x1f9A\xab\xab\x86\xbd\xd0\xd4P\x96`ȼ\xfd\x9e\x84\xc2\xe6\x98\xf3*\xf0L9\x17\x13O!\xe2z<\xd9D}\xc1\xb0\xab>\xfb\xaa\x8eQgxWR+\x1aN?\x98\xe3f\x8f\xa1\xa6\x1e:ԋ\xf6P\xc6\v\xc7ǎ\x9f*s\xa8d\xbd)9Mcd \x16\xc0q9\x8d\xde}\xf1\x1d\fXK\xe5p\xaa\xba^\xe3(Y\xc8T\x15\xa7i\xc7\x1d\t\xa0\xa9\xf8\xf6|1\x9e\x85\xa4\x97\xd6\xd6K\x18\x0eӲ,nzD)W\xea_j\a\xe5/G\xc3ҫ\xf50\xbdƑo>\x80v\xcc\xf0Dw\x97_\xab\xcdw\x05c\xa3\x86W<\xeb\x99\xd6\xed+U\xff\xe7cd%9\xa3\xa1גW\xfc\xf9x\x8c\xec\xe6kTk\xa8\xf0\xc7\xc8c,c`~\x02T\xab\xd3b {\xd1\x17\x01rT#ݡ\u007f|\xd5\xef\xa7w\x80\u007f!\x17\x8a\x17?\xefҜ\xfc\"N\xcdo\xed\xc4P\xe7\xc4Q\xfd\xef\xf7\x00t\xe4\xea\x9f\xfbu\x877)_\xaeݼ\xfd:\xe6\x86(\xc6Y\xe2\xa6\xef\xef\f\xd4\xd5\xe8m\x01\x82qw=\xef\x00\x84\x0f\x19\xfe㟭\x19\xc0:e\x82\\\xe0,9a\xfc\x85s\x8d\xaa\xe6$\xdc\xdaӄx\x04\f\x9d\xd2\xe7kZ\xeb\x98/\t\xcd4\xa3\x92\xdc\x15\xf4\a\xb9\xbeo\xb1\xf4\x18\xd4Vzu\xa9k\xd7W\xd0\xef\v\xc6\rVd~\x03\xef\x93k\xda7ժ\x9d 8\xc8\xe6ʰ\xe5\xe6\x163\xebxMg&5\xf7;\x99n\xf4\xdb\xff\x94\xc1K\x95\xf6Z\x9b\xa0\xa8\xcbL\x10o)n\xff,h\x8f6\xd40\x8d\x9c\xb9@\xcd\xf5X5;\x8a,\xf3D\xfb}\x9a$\xc9\xd9d\xbfWeI\x92P\x19\t\xd4 \x94\xb3\xfb\x05}\xb5I\xaf0\xfb\xa6H3\x9cE\xfa\xbc\xbd+\xf1*/n\xdc\x01c\xf7C\xe4\xc6{٦;\x86;\x1d\x95\xdb\xdb\xe6=8\xdf\xc9\xfb\xaa>?qkgG\xa1\xcd:h\x99\xe97\xf6\xb0\u0095\x03\xebO\x9d^\x1ev\xb9n+o6a\x8fb%Ş%-\xc7\x17\xc3e\xb7\xfb\xc5\a\x01\xe8\x029j\x1e\xcfY\xcbf\x16l\vƱ\xc5a\x0f\xc2\xe84\b7\xcd\xf0E\xaf!\x98\xa3\xa30\x87\xee\xd1\x1d\xd7+\xd6X\xdd\xf9>\xe9\xda(\u007f+΄\xbc\x8fG)\xff\x06\xa7\xac\x11\xf0\xa8>ju\xd6\xf5\x99\x1d\xd6\xd8߆\xa0hȭ\x12\x97\xb4U<R\xc6\xdb\x06\xebE\x1fZ\xd5_\x1f8\xee\x84\xc2!y\xcen\b_\xae\x03\x8bq݊Ħ\a\xaahuvǊ\xde\xf0\xb4\x16\xcf\b\x93?q\x16\x99Ǽ\x83\v@g\x13\xa5\xd2\r\x83\xeb\xab\xdb\x1f\x8a\x9b\x99\xb1\xd8\xf9\x93l\xcbd\xa1\xaa\x9fn\xb7\xf9\xad*\x96\xa5m\x82P7k\xb5\xf0\xc8U\r\x05\xbb\x82N@\xf4\xbcu\x1dr\x03\xe7\xc5\xd6\x1e2S}r\xb0\xba뻦'\x1e:\u0086\x8f\xc3\xc9|\x81H2։Ij`kƎ>%\x92\xb9\xf3\xa7@\x16#\xc6os\xac]\xdd\x13\xb5\x19\bk?\xb1f]e\xf6\xaff\x03\xa7<\xc200K\t\xffcj+\u007fv3\xbf\xd0\xc4\x1c\xd1^\x06\x12\x1c\xb1X\xd9|\x81X2\x9e\xd29[$\xf3\xc5Ԭ\xbdH\xc6(\r/;}ZL\v\xc1\xd3\xfak)\x82\xeb\x96צ\rQ\xe6T\xf6\xa72-\xcf\x12\"n\xa9\xc1\x00\xe9\xa9 \x92\x94P\xfeP\x90\x9b7{@\xad\xf1]X.`e֒'c\xb4K\x8cRq\xba{\x9aOsÓg\t\x8f\xe8<_4\xd2\xd9{\xd88\x9f,*(\xf0*\x82h\x9d\x98HO\n\xdb#\xa9\xcd\xc9\xe0Tt\xa2&\xba\x86\x95LIe\xb6\xd19\x15\xdd\xc1HB\xbd\xf2\xa6Ʌ\x95m\xb7%\xee \xf0\a\t\x1b\xeeT\xe8\x1c\xb82\x82\v\xe9D\xc8\xfbOx\xea\x88MR_\xda8]ZC!D'\xfd\t\x15\xf2ל\r'\x8b)YE\x8f\x9e&\f\xf2H\x96\x8d\xc5\u007f\r\xe3@\xed\x8b3\xc59çL\xb0Tʭ\xd0\x1c\xd3d\x8cʄ\r'\xd3\xf2i:M\xc5\xe1W\xe3\xa6\xedq\x8b\xaa{\xff\\p\xb7\x87\xab\xaa_\xb3M\xc7\"a\xdc\xf7\x1e\xfcX\x95@\xe3\x12\x13»w\xf5\b\xf1\xbf\xae\x13\x03\xd0!\x9a\xbb\xf2\xdb'\xb9\xfc\x9fB.7\"x\xb9\xcbq\xed\xbc\xbeܕB\xba\xfb>\xe5\xeb\x04\x00_Ho\xf0O\xc7d\xf6W\x94\xe3R\x89E\xe0\xd7\x04\xb1\x95\x1a\xed\x12\xa7\xef\xb7\x05q\xf0\xfd\xdd\x15\xa6\xb8L9\xfeA,\xc0\x96އ1\xf7\x048\xc5\x1b\x8b\x03I\x9c\x89{\a\x87\x93|\xc4\xd7e\xc1\xb9\xb1Oo\xcbY\xe8\xf3\xb1\xcb,u\xb2\x8c\x92E\xb64\t\xf7\x88\xe6\x9a\xe4v@c=,\u007f\tA\xe1\xf5\r\xb5\x18\x88\xa1\xc74\xc8:s\xbc\x98jc*\xe9\x90/\xf9툌\xa4\x18\nU\x19f\x82\x8fN\b\xac\x8c)w\x89\xb7y\xba\xc42\xe2<_7\x1e&k\x90\a\xa6o&\xce\xc5\xc4\xc3|\xbe\x18\x9a\xa8\xb5\x1c\xac\xd0\\\x1e\xb7\xcb;\xd8l\xce\x17S<z\xf3\xfd\x8b篞}\xf3\xee?\xff\xf2\xe2\x87W/\xde\xccɈ\xa6\x1b\xbcH\xc8HN\xb9r\xa309\xe8\xd2u\x89\x13\xc9MM\x89\xafC\x97\x10\x9e\xb5\x8b\xe2\x80n\v\xb8\xd8\x03Mt\x84\x8b\xf9\xe8\xe1\xecbq~U_CL@\x8e@\xf9Tр\x00\x83楌\b։\xe5d\x89\xa3\x89t\xd9aۜ\xf0\b\xa0\x1eP\x06m\xea\xdbX~\xfb\xa9 4\x02*\xf9˼\xb0!*C`*\x17\xfd~T&\xa1\x0f2X\xaa\xe0\x81\xee\x04N\xc4)\x92(\x14\x97&\x8f\x8f\xa2\x17\xd4\x02V\xe3P\x88\x910X\xec\x10\x95\xb3$\xf1X^D\x13\xd3C\xd6<{S\xf0\xea\xdb\xff\xa8]\xbb\x9d\xe8\x13\xb4\xc8\xf0w\xe9\x06\xcfB\xaa2V.\x05\x9b4ʋ4\xf3\xf3\x12\xb9\xc4\rW\xd0\xde\xc92\xf1\xa9٩QtEV\xfb\x9f\xb6W\xfb\x9f\xb6\xf8j\xbf\xa5W{v}\xb5\xe7d\xb5\x82\xd1|\xf6\xbb\xc5\xe8!\x9c\x9d\x13\xd8\x18[r\r\xe02]\xbe\xbf*\x05\xda\x0e\xc9&\xbd\xc2 \x06\xbb2\x8f\xc0\x00\x0f\x00\x04\xfe\x90\\\xb2\xa3\x18\xb9\xe6\x8c\xce{\xfa\x9ao\xf2\x88\xb9-\x10\x17\xbfW\xf6PHC\x90ƚNy\x17\t_\xf5\n\xa3\x05\xd3*\xae\x89\x06^$wyJ3\xb6L\xb78\x06lYbL{)\xcdzQQ\n\xf1LN'\xee\xd9:\x10\xa0mQ\xf22%\xfc@uS\x05\x02TbNh\x1a\x83\x82\xe6\xb7=\xb7\xc1\xf0\x06_\xbe'|\xb8!t\x98\xe1k\xb2\xc4\xc3-\xf9\x80\xf3a):\x89{\x8f \xea\xb5\x1a\x89\xca\xc3M\xf1\xcb\xe9-\x86E\xf7\b瓮1\xee5\xa1\x12\xb3\"ߩ\x95O\xbe|\x94m\xc9)5\x1fe\xdb\xed\a\xd8\xc5V\xf9W\xec'\xc6\xea_\xe7\xc1\xe3\xdb\xf4\n\xe7yJ\u007f\xc3\xf7\x8e\xe31\x836vP\x9b4\xbb\xbc\xc2\\\x06\xbdV\x9a}Sc\xa8\xbeԯ\x15yg:\xbf4\xfc\xa2q\xd7\nx\xa4\x82\xd9-\xcb\"\xcf\xc5o\x9d\xe4N\xbb.\xc9g\xf1\xc4r\xa1\xaa\xda\xf7\x05K\xb6i\xc9\xf0+jx\xb9\xd16\xbd\xc2?\xea\xd8\t\x93\xee\xcc\xe1\xcb4_~\xdf\xc5\xdcx\xf6\xec\xfe\v\a\xad\u007f\x9b?^h\xbb\x059\x92b\x98,;}C\xa8\x12\xb6\x95r@\xde\fu\x9ci=e\xf9\x1c\xac\xaa\x89\x93$\x15^Z\x99\xa4\xe7\x9f\x15\xcb\x03\xdd\x10\r\x0e\xdd\x05\xf1t(\x8d\x1e\x11\xf5+S_yU;Qȍ?\x1aJߙ\x0e\xf1\x94AC\xc7\xd7y]b\xb6.\xf2\fN\x8d9\xad\x84|B\x11\xd6\xf0R\f\a\xf5\xac\xbcN\x8a5\x11\x01qM\xa2\x9e\nɎ\xee\xb2]\xa9.\x17\xc7\x04P\xe6|\"\x05\xfdZ\u007fC8e\x84^\x85\xaa\xbc\x90_\xaa\xa9+\x19\xe0\b\bN\x02\xa0F\xac\x05mL\x8e\xf1\xf6\x1bB\xdf\x13z\xd5\xef\xe7\xc5R\x9d\xabu\xcadN.\x05\xea\xb7\xc57\xc52\xf2>J{/\x8b\x82ʈt\xb7\xcdR\x8e\x9fIL\x8f\xda\x11\x16e$\x8d\xeeG\x93\xb6 \x02\xd4\xe8\xc1\x17\x16w,/\xf8\x85oco\t\x02z\x90\xce\xd7%^\xfd-\x01\xbf\x03\x8b\aG2\x1dN\x1d\xe9\xe4\n\xf3g\x9c\x97\xe4rǱI\x061\xf5!C\xdc}w>t+K\x1d\xb4\xe3\x11\xf6ՐC\x8fO\xb7\xc8w\xfe\xc8\xffp\x99\x96:\x98J\x13\x8b\xea\xcc\xc8:]Xtg&\xb5\x8dI\xd5plnaW\xf8\xb3\xc2,\xe8p\xcd\x1dr\xa1b\x98]\xd4h\xef\x98+ݺ\xe5]b&\xc2\xc7Ȥ\x8c\v4h\x90,\xcdx[\xe2\x03\xb5˙>\xb3F\xbe\x90:\xb9\x9e\xe8\xe0\x89\xf3y>^@\x9e\x8c\x95\xc2\xce\xc1\aK\xb9\x9f\xe0F\xb2p\xddm\xd3F\x93#V_\xfc3>\xa4\xed-|\x92\xe08\xf8\xc1)\xb3\x88\xf0D\xb0\xc9S\x9e\xd8\xc7\xd4z%\xf1\xb8\xaa\xddv\xd5\xd9\xf0|\x9c\xbd\x9d\x95\xdf=W1sI\xd5\x17\xe2\b\xff\xec\x05\x04;\xd2C\x80\xa8X\x0f'g\x04\xfd\xa0\xd2>Vzoׄ\U00062f15D\xf5\rO9\x9eu}P:j\xf9O\x01c]\xcb#TIQ5.\\ܥ\u007fV\x98蓍\xb9;\xef\xc5)\xfa͖wuӫ\xb9\xe7\x8d`\x03\xf06\r\xd0\x1c\xe0\xdeg\x13;\xb7\x80\xdfk\v<\x18\xd6j\x16\x04\x00\xac>R\x15ۢ4\xca\xd6\xca'01\xc8\tũ|\xd5\xd5\xf8\x1e\u007f>F\xce:c\xa0M\f\x91\xb3\xc4\xf8l\x82졉\xbb\xec\xab\x1c\x9e\xf4\x93\xa8\xf1\xcf/j|\x96\xa7\x8c\xbfU\x87\xa6\xe6\x9c?\xd3ǈ\xd5E\x1f\xa9\xdf}\xbdZ=O\xe9u\xca\xc0\xdf'\xb0l\x8b\x12\x04\x12-*\xef\xb3\xc0\xd2\fs\x0e=\xd3-/\xf2,\xea\xa9Bi\x9d\xd8*m\x05\xb0\rG\xd2\xd0\xf1\a\xbd\xaf6l\xad\xdd\xd6`B!\x99\x01I\x06\x1f/V\xab\xe1R\x82r\x88?\x10^[\xf6\xea\xf5\u007f \\na\xb8\xb2{\xc5Z\xe1Dm\x84\x16M\"\x90\x91k\x99\xf9\x9ay4k\xa9b\xbe\x82P\xaf\xc8\xca|\xce'\xb12L\xb9\x8c\xed\xbf\x95\xa9\xb1\x88E\\3I҈\xc8B\xd8\x0f\xf8\x1a\xa7\xb9\xb1#k\x97\xef\xf7\x14\xdf\xf4~\xc0W/>4\x92\xbc\x96\xb2\x86D'\x04\xae\x00\x1cq\xcc\x1a!\x82\xfcH\xb1\xa8c\bc@\xe2w\xfc\x9a&\xc1҆[v8ޭ\xaa<\\\x15\xe5p\x833\xb2\xdb읒\\\x889\xf0\xfc\n\x8a\xb6Zg;\x04p\xfehQ\x87\xd9\xf9\xf6?%\x01\xc0e\x04\x1b\xf3\xae3\x15\xbc%\x1blr\x85\x85\xbf&\x13\xfc\xf8\xa1\xe4\xee^\xe6Ej\xf9\xbb+̟\x17\x9b\xed\x8e\xe3\xec\r\xbf\xcdq\x14\xdaN\x1d\xba|\x01\xa0|\xf8\xac;6W\f<پ\xe9\xf0u]\xacVKM*\xfe\xcf#\x02:>\xa0\xf5\xb4\xb4Xd\x1d\xb7\x94\x1fWwT\xc0\x80\xe3\x85>\x01u\x98\"\xb7P,\xfa\xff\xd5rUs\xe0\xe6\x82<o'\aS\x8eI\xc3'\x18$:M\x0f\x9bg\xe1ֱ\x803\xac\xff\x16\xd2Q\\\xff\x98(\xc1QK\xca\xfe\x02\u007fՀB\x86\xaeG\xac\\\xf1I\x14\x1e\xce\v\x13\xb6w\x95\xa0^\x008\xe5\xda\xfcʄ\xef҆b\x96X\x99\xa8\xb4>f7\x10\xb7\xd7F<!\xcb\xd7Y\xb9\x95\xe3\x1b\x8c\xa3V\xf7\x13\xf4\xf1\x912OF\xf7ʟ\x8d\xf4\x99\x85G\x82\x91\t\x1e\xe7\xb4@V\xfd\xfeYcY6Z\xa0\xc2G\u07fd\xc17\xae\x95&\xfdo\x8b\xad`\xa6\x80\x91\xbc\x8d\x9c\x1d\x8d=\xfe\xe3\xdb\xe2\x1a\x1f\"\x82>(\xbd\xc4.\a\xc9^#\xa2Y]E\xac\xaf\xa7\xd79\x04\x03\xda\n`\x05\x11=\x18\x17\xadj\xf2$\x1d\xec\x83ǿ\x1cbt\f\x9f\xe1\xe5\xcb\xc1\x8d\xc3v:\x95\xf2\xa6,}\xccdb\x10b#\x9d\xba\xcc#\xe9\x8a\xe6֊\xc1y0\xe3\x8e\x1f\xd9\xcfs!o\xea\x8cQ\xefR\xe6\xe6\x00\xb0\x11\xec\xad}Sn\xf5L\xa2c\fK#\x00\"\x18Nll!ۍ<\x1c\x96\xf4\xda\xe2N\x13\xd7\xfb\x86\xb1C8\xd1\xe1\xeb\x10Q\u007f\r'p\xeaܚ\x8d\x9b2|[y\xea\xb7/ϒ\x84\x8fn\xd6d\xb9\xee\xf7Տ\xf7\xf8vYd\x92q\xa8-\x99\x89\xce/\xc9\xd6d\xc5\xff\x8co\xe5\xd7@\xf4\x10\ri\x99\xe2\xc8$\xa9\xc4\xca\xf2ٶ\x15\xd0\x0e\xa533m]R\x1d\x0e\x95\x87O\x8b\xa3\xd6Ea\x88\xd5\xff\x1e:ݭhz\x9d\a\x9c\x04\x0e89\x16\x96\x0f\x9d*\x9e\x84\x92\x9d}̡mN\xa3>\xb7'\xd1\x1aMB:OБ\x03\xe4\xba\xc9\xd9c\xe4\x1c\x97\x8e\x80yR\xf4?\xba\xd13\xe7.\x16-\xea\x8bO\xfet\\R=\x16-\xc4\x01<\xfaC✈\x88K}\xae\x1f\xe3&\x80\xbcm^\xc0%\x81\x16\xb3O\xb5\xd0\xf3{:\x959F>\xd3\x18 \n\x1fk\xc8\xd7t\xc1\xf3\xef\xcfxl#\xf2\x19\x9f=s=\x8b\xca\xf5\xe9\x8b\xcf&\xc8pg\xd2V\xc0\x8d\xd57F\x8e\xbcfx4)\x0e\xf9\xf1\xfd:\xd4K\xae>\xe1\x93~\xe9_\xe7)\xfbuy)\x15\n\x87C\xf1\xa9Z\xe8.\xe7e|\xd7\x19n\xaf\x11N\xafB%\xcfMu\xf5ͭ\xad;\xa8\xab\xff\xbad\xfa\xe1d\xdba\xfd\xb3\xce\xe2\x8cK\uf140\xe5$\xc3\xec\xa4\x0edU\xd5x\xda\x1d\xeeO:\xad!#\xef\xa8\xee\xcd\v\x8a\xe7!?\xc56\x1e\x87WWqW\a\x125\x9a\xf9\xec\x18\xfe\xf6/\xaf\x1a\xad\xebv\xb4\x18n\nQsGd\xa2ģ\x8ej\xdcO\xcc\xff\xb2(%\x06\x04<\xd6\x1a5\xa2\xc6\xc4.wy\x8e\xb9aE\xdf\t\t\xf4+U\xd4Vi\xb6\xb8\xd8\xef\xf3\xf4\xd6\\\xb2zM\xda_ѤB\xb9\xc2ś[\xbal5^.1\x93\xb7\xafi\xae\xb1\xa3\xc5g\x8e\x9d\xcb˙\\'\x96\xe9\xf5\x9c\x84$\x97Ň\xd7+ݡy\t1\x8c\xb3\xe3\x82\"WpB\x0eRN6\xb8L(\xbeq-:\xc5\xfdЌ\xceY\xbf\xb3\xfb\xbc\x84\xa8*}\xc4\x11\xa1+q\x96\xb0$\xf7^\xa2M\xa5\x97x#\x80\xad\x84zT\x8f=\x92!\xdc\xdc\xe7\xcd\xc6柰\x88w\f\U000ffabdPϖ\x91\x9f\xacV|\x97\xa3미\xa9m\xf1\x1aw\xbeA#\x9a\x8c\xa5\u05cd\u007f\x9c\x1a\xf6\x12\xf5#\xf8\x81L\x9a\xc8\x04\xf8r\\}e\u007f2v\x9dd\xcct^OƢ\xbb\xfa\xa2.q\x9e\xea\xa7\x1c¶yz\x1b\x03ZP\f*\x88hB\x9e\xd2\x19\x89)b\x83A\x05\x11\xb3V\x94\x1e\x96[i\xcf \xaf\xeb\xfb@+\x88p\xe4\xe9\x00}\xd0u\xfb\x8et@\xc3]\x87L\x1bZ;\xb5\xdc\xd7\xf2\xc3\n\x0f\x8dS[\x8b\x15\x96\x90ސ-n\x9cq\xc9Y\xc9\x0f\x82ّ,\x96\xc0\xae\x9e,\x92!\al\x99\x96\xc3ڕ\xc1\x11#\b\x84ۨ^w\xd5\x18\xe4\x9e}M`\xd5I\xcc|\xb8x\xe6\x1cͱ\xbc\xd4\n\xcazY\xd6\xc5\xd9k\n\xd0ف\x8fФ\xae(\xe7\x87j̀tT\x011P\xd1@\x17m\t^VxM\xff\xa4Ҭ\xb4]V\xfdX\x8c\xa1\x15(\xca!\xfb\x89\u0091\x0f\xef\xb5n\x99\xc2\xc1\xa7FM\xfd<M\xafU\xeaP\xe6{\xef\x1d&ւ3\x91\xb7\xe5\x00\xa0^\xf3\xa3\xd8q}ۓ\xc0\x15\x12\xd8G/ZVs\x852i\xf5\x11,2\xa71\x10\xf9\xcdNUJ\xf2\a\xae[s[\xddw~\xf2\x98\x9e[f\xe3\xfc\xcay٩_s\xfc\x84]u~c\x9d\x1fV\x92HE\xeb\xf0A\xe6\xa7р%\xd8\xe5\u007f\x88`\x8e\\\xc0P\xc4\x10\xa95x\xf6b\xb7\xf9S.͍\xdb\xd4дw\xe1hx8\xc3\xf7\xca8s~\xb6\x8d\x06\xf5\xa8c\xd0uW\x9b\x84\x03\x01\xba\x81,U\xb2\x10\xb3\x84~\xbf\xfe\x11\x86\x9d\x9f\xe2\xc3ju\xeaQC\x12?\"\xae&\xf8\xf0\xe6H\x16T\x10\xf4\xf3͎\x18L\xa0\xfe\xbb\x9e\x8f\t26\xa7߯\xf4\x8c\xb5ޮ\xe6RHei\x99\xf0\x19\xf8A\a?\xfaFJ\xb7\xb9(\xfaF%[S_\xd0N],,\xc13\x1cs\xdf\xdb\xc3p3\x825\x98Qy<\x0er\xec\x86\xfd=\xa1j\\ħ\xd4:4\x1d\x81\x17'N\xe7h\xd58\x8dO\xa8\x85\x9a\fD\x8b:D$!\raAE\xe3c\xbeA\x9ba\xd5\t\f\xca\x1b3\xcfuH\n\x18\xc6*\xef\x15\x8dXG\xe0\xba\x06\x9f\x94^J{z\f\x90t\x98n\xdc@s :|E_\x96\xc5\x06\fʅK\xa4\xd8\t,\xd7e^,߃ʳ\x00\xc8e\x15\xb0-r\xc2q3K\xb5\xbf\x8c\xd7;\x1e\xd1\xee\bc홾\xde\xf1\xb7\x05\x18\xe4\v_\x83\xdeJ\xfd\x9d\xab\x0ev\x01\x16\xe1l\xa7/8¾\x17\xd7f\xd6\uf6d2\x12ח^\xdc9\xb1\x1eaCBC\t\xc7ը&\xefDp\x83l\xe3n\x80釪.\x16\xe7̑\x18\xea%8\x85v\x15\xdd\xd1\xef\x04N*\"\xe6P\xee9[\xc0\x96me\x97\xc0v\xf0\xb1\xf1\x14Iͣ\x82]\x180\xba\xccwe\x04\x11I\xb0nƶ)\x95A|\xc5ņ\xb9్\xbd\xbe!\xe4\r\xb3C?\xaa\xa31\xdc\xf8\xf8\xfc\"\x861V\xf3y\b`\xfb\x93JS\xaf\xd1\xe0cS\xb4\xab\xd5\xc4gcTs\\\xe2W}`\u007fP\xde\xe1r7\x87Ć\v\xb3\xe7\xc4\xfb^\xec\xb8[A\xf5 .\x01\xa7\x03\x1doҴw\xbf\x8a\xe6\xfa\xb3\xc6D\xa9H\xb52o\xfc9~\x8c\\\xd2,>Kf_\xfc\xe1\xf2\xb9r\rV\x81 ~\xf9\xea\xa2\x18H0\x0em)@5\xf15\x1f\xb5\x84\xe8\"\x96\xf9ti0\xd6\xf2q\xe6\x8bҎYf\xd3\x14\xd7\xf9)\x14\xd9펱\xa6\x95y\x9f\x14\xb4\u007fo\x05-\xb7\xa6U\xca\xe12\xe4jZb\xb6-(#\xd7x\xa8\x03\x05\xba\xee\x96\xdf\xfe\x9c\xa8\x10\x14\xae\v\xa6\xdcH\xa7\xfc#m\xff~\xb0#\xeb`{\x1f\xa5V%B\n\xe7%\xa1W 1\x19!\x1c'h'\x88\xcb]e\x02\xb8(\x8fhmRՓ\xdc\xfcxʞ\xd8\xc8 \xcc S\x91ȸ#\xb5\xf1\x95\xf4\x975z\x82Y!\x13\x92\xb3M\x9a\xe7\x00\x95\xfe\x97\xc9\"\x16\x9f\xa7\x02L\xf2\xe5T\xba\xcb\xe2y\xba\x90\u007f{\x8e\xb0\xb8\xe2#\xc2^l\xb6\xfcV\xa1\x9a\xe3\xb8m\xf2{\xe8HI\xc6\xe4\x85`v浪\xf74\xed\xc1\xe11a3x\x93\xf3\xb9k\x02\xc8Xs(uN\xbd:Ogq$ԓ\xccJ\x98pi\x8fbxF\x0f%{\x842\x9eҥ\x8f\x02s\xbcдG\xbeV\xcb\t\x10\xe4?-\xd2\xf03\xad\f\x9f\xab\xb8\xd5\xeapn2;\x92m\x1082&u\xae[6\xd2\x17g\x04\x83g\f\xdf\x04W\xd2T\xa5V\xf0\x1e\xaf\x8a]\xc3\xd7\xe8a.\xe2\xe6\t\xfd\xd8K\xb8Ҳ\xf7\x9d\x89/\x1a\xdf\x198\xc5u\xccQ\xa4\xd6\x16;\x83\xbcSElnk)\"\xb5\xd8\uf553qV\x92<o\xf7\xa8\v\x0fu9\xb2\xb5L_\xe9rY\x94\x99`ѝ\xbel\xa1\x1a\xf8\xe0\x1c\x1bu\xcd$\xabi\xf0\x16l\x91\xbfO\xd7\xe1?\xec:\xfc-\x9e,?\xfa\xb6{\xabl\x1c~C\x83\xf7\xd6M\xae\xcd(\xe0\x14\xef\xf7˂\xb2\"\xc7#\\\x96E\x19\x81\x1f\x8b]\xd9\xe3\xe9e\xefR`\a\xc6\x19르\xf7\xea\xeb^\xb1\xea\xa5=\x81\x8a\xbd\x94\xf5\xf8\x1a\xf7\xe4ТXٲ\xa7\x97\xc3˴\x1c\x01\u07fdS4Hx\x04~\a\x06\x16\xdej\xf4\xf2\x80\x01\xa5\xaaa=\u007f\xb5\x90t\x8fH\x88\xaa\xc1\xb7?\x9b\xc0\x9fn\xa9k\xe5x\x8f\xf8\x9a\x8d.\x1b\x8b\xf1\x95\x93es3\x91c:)@\xe2>A6e\xc1\xc0\xa2\xee\x11\xe4P\x88C/\x8b\xd2\x06\xfe\xb3\xf60n\x96\xc6zo\x8c\xb9fܨ\xee\n\xc7numk\xda0\xc8Qy'~\x8bi\x1bCtwL5F4\xee\x14\xb4U\x85,\by\xf8+d\xd0vg\xcdM?Tӿ/\xef\x87[\x1f\x9b+SA1\x06\xca[\xa0+\xa4C\x80\xd6|\xba\\\xba.\x17\xe7.1\x9d\x9c\x93\xef\xa34ۯ\v\x8a\xf7E\x06G\x0f_\xbf9W*e\xed\x19A\xd3kr\x95\xf2\xa2\x1c\xed\x18.\x9f]a\xcaae;\"uG\xcfhV\x16$\xbbOk'\xe8 \x8e\xe0~O\"X\xa9\xd0w\xff_2\xd8Q\xc6^G-vt\xb5Ci3\xfdl\x97\xe8\xed\xb3\xafb\xe0$y\a\xe8͟^\xbd|\xfbΖ\x9b\xbc\xeb\xe0W\x19ꐬө\xccX\xf9+\u007f\xe9:\xa7p*\x03v\xdfm~\x8e\xc3tQ3ޕi\xffmqIāi\xe4\xd6Mxě\xbefzJډLk\xe2\x0fԉ\x1f4\x1dм\x1e\x1a\t\xbe\x9d\x04\x13\xd6\xedLǼ͐6FMٶ\xd8\xee\xb6@\xaa\xab\xf4\xa3\xa2T\x83\xfbd~\xb5\xcb\xf372\x14M3-em\xaf)\xea\xa8\\\xf6\x1dM\xadۄ\xf9T\\\xe32Oo\x13\x9br\xba\xf1\xc1hs?\xb3\xbf#\xef\xb7f\x0f6\xe9{\xfcZ\x95hW\x8a\f\x86㊔E\x8ec\x90\x914/\xae\x00j\xe6\xde\xd0aFn1'\xc3\xcb\xe2\x83\x03+/\x1c\x89.\xb5\x92\xa3\x9eL#\xe6\x93Ѿj\x9d\xea\xdb\u009fz\xeb\xe2\x0e\xd47\xae\x0fݩ\xd6o\b_\x17;>ԝڪ\x1dVMƫ\xb7\xdf\x0f\xbas'\x89`\xf7\xf4\xf2\x04im\x05\xbaPW\xa6:\xda!\xef\x13\x87'r6\xe5@`\xdb\b<\xc9\xc8\xf5\xd3'\xe7\xe2_\xcf\xe7B[\x88ڕ\xd5p\xd1P1\xa1\xa0I\x93\x0f3\x19\xbf;\x95\x16\xc4\x06\xfc\xb5\x9cÎ\xe3\xf2\xaf$\xe3\xeb\b\"\x96\xd4+\xbf\xd1eE\xa8\x816\x12\x92O\x8b\xb6\xc5Z\x17N\xb1\x8e\x90\xdbL\xba\xa2\x13]\xcflԅ\x88\r)<\u007f\x84&c\x18\xdb\xc2P\x13\x19\xb3\x86tt\xab3iϊ\xa7iݵ\n\x03Ch4\x19\x8fQz.\xda{\xa3D鰀\xe7\xbf?0\xf4u3\\\x8e\x1fԌ\x17ۘ\f\xc0\xf6\x03h\x1e\x87~\xff\xe0\xf2-\xbf\xe8u\x97\xe3\x15\x8f\xb1ߟWa\x93\x96BL\acY\xe3\xde\xf9gkK\xb0\x8fr\xadj\xbb\x1cځ\xa8\x13\x89\x82\xd6\xce\x11\xc4u\x14\xdd\xefyD\x9bI#]\xdf3\xc1,)\x87\xcb$I\xd8\fk\xdf?\x152\x18\xdb\xf4\x8d\xf7ri\fF\x85\xf1\"\xdf5\x8eM\xe4d\xc8\xd47\x89\xef\xb6hR\xc5\xfb\xf6\r\x86,\xb8,\xd8\xe4\xb1\xeb\u007f\xf2\xf8Q\xfdK\xaa\xecN\xb2\xbd':\xe3\xfd)n\x96\xad\xeb\xc3\xc3H\x97\xebWLLS\xf8k\xac\x00K\v\xa2\xae\xad4f\xbc,\xf2\xbe \xd3D\xa6\xa32\xf9\xfb;\t\xb1/\xc8n\x8b-\xe3:P\x86\x9aNl\t2r\xddPe\x84\x8e0\xe1u*\x84\x9e%C\x94\xff̣\xfc\xfa\x867\x8c\x90\xeb}\xe1\xba^\x1c\xf1\x14\fZ\xe45\xd7o\xee\x01g\xf4_\x15\xa8\x84t\x04*!6vK\xcdލC\xf4\xa5Ψ\x16\x9b\f{\x95yq\xf6=\x10OA\xb8\xa3]zbd}\x00QH\x05\xd0\xee-@s\xa3#\x13\x10C{\x1a\x85\x00\x1b'_\x8fg~GN\x0ew\xf99\xeej\xcd\xf2\xe2\x06\xc0& \xea\xe6\xea{\x03|\x9b]\xce\xc96ǯ\xa5\xdbb\x93\xc3\xf4\x1d\xa46\xb8ń\x90\fvDVzE\xb5@눆\xc6*8\xecc\x18N\x97X\xb3\xc6\xc3IU\xa7Z5*\xb8\xbc\xb8\x8a\x80,\x04\xb0\x9a\xd2\x04;\xa9\x87\xed\xd6\x1c2V\xc1\x16T\b\xacR\xf9\xda\f\x0e\u0605\xa8\x16\xe6\x95\x00\x87\xd6\xed?\xf8\xac\x8cˡ\x0e\xfa\xc0NpNt\x13\xcfJ\x9b\x8f*\xd2Y\xc3OA}\x89\xdf-\x9eA\xe5\xe5\xf5\f\x87\xd6ōJL=\r\x06\x02<m#:\x10\xc6\\\xeb\x86\xde\xfb\x12\x9aɗ_\x92+BS!\xa3\xe8(F\x810X\xcd0`\x9e\xf9\x84aU\xa5\xb3\x1a\x8ck\x97\xe5\xeeZ\xa83{5\xfe\xc0\xcbT\xeb\x93X\x04+\xcfG\xc0\xfbx\xe4UP\xac\xedc\xf6\xbd\xe1\x94\x1a\xda\xec\xfd\xfe\xac\xf3*֧7(8Z\xc0\x1c\xbew\xa5\x81j\xed_\xday\xefz_\x10\xa9\xef]\xdcu\xefꉾ`\xcb\xe6\xcd{\x84\x939l\xaa\xc9Q\xad\xf0\b$r\x0e\x8d\x1e\xd99\"lY\xaaڑ0\x18\xe2\xef\xe0$k\xa3J\xc5\x11L\x8fZ\x97\xda);Z\x97@\xbe\x16\xdcT\xf5ǚ\xd2)k\xd1\x16\x8ai\xc7e8\xeb\xfaX{l\xa3\xb31\x8c\xc7r\x8f\xdb5\xb56\xe4ll\xd9^W\v\xf4\x1bMt\f%\xba؆2\t\xban6;\xb0\xba\x8f\\\x81L\xf6\x1d65\x0fM\xf9`\x90\n8\xeb\xa2!\rt\xaa\xd0\x04ƴ\x03\b:\a\xbf\xe4\xe7\xd0o\x82\xbbm\x1bc\x0e\xefx\xbf\x1f\xc8K^\x1d\xf7\x05o\xe8q\x89K\xbd\x1b4\xb9\x95\x8e\xd3#\xb8\xa4M\xe8U\\\x80Vx\x89@\xcd`si)\xe3\xd1\xfbc38\xe4\x0e~6\xf6j\x04\xbc\xc2\xcd-V\xd9\xf8\x1b\x86\x995\xf4\xd8Gc\xeb\x01\xde2\x95\xb7\xf7C\x8bqx\xbd3\xa1O\x1a$\u007fv\xd0Hջ\xfb5\vS\xec\xa4\xf3N\xdc0\xf2\xebjl\xb8\x99\xceyٷ\xae\xe6\xcc|\xc6Cr\xb7c3p\x80\x91n=_)\xf6㴛BH\x90m*\f\xd1\xd9\x01\x9e\xa8C`\xad/C\xf9\xccԸ\r\x83\xdeᡑ\x1bA\xaa\x18\xe6b\x88\x82\xe1\xa6Ì\x8c\x19\x1f(\x82\x01\xc57\tH\xaaQC\xfeҁ\v\x83\xb2\x99\xfb-\x02\x00\xd9X/\x9c\xf0\x1c\xa3\xa6\x8c\xb6M\xf9\x9a\xa6\x1b\xdc!\xbd\x01\x00\x8f\x05\x12\xb0\xa9\bO\x95Y\x8f\xbc)\xb6v\xb2\x19n\xaeSI\xeb\xe1\xa1\xda\\C\x18\xda&\xc7n%_\xe5bt\x15 h\xc0\xd3\xd4\r\xdc\xebᑅ\x02G\xbe\xa21\x00\xc8=o\xe2\xb7\xe5\xce\xe31\xb2G%\x1e\xb7\x92\xf6֧E\xfc\xf2\xc59\xc1\xb5k-\xa2NՄ\xd6\xfeϚG\x94\xb1&\xf9F}\xfe~\xc9\xe3\xc9\x18i\x80\xaa\x10\x025z\x8b\xaa\x069;\xe2\x068\xcfU\x9f\xdeIOy'\x95\x8b6\x89\xd1α\xfb\xf2)\xfdv\x9ca\xa4f9\xbdd\x11\xb7QQ\"8ǋ\x01\x9f\xd3E\x04\xcf\x1f\r\x89zޤA\x00\xebHR\xc1\xe7M\xda|\xde\xc4\xddϛ\xf4\xc8\xf3&\xb9\xe7\xf3\xa6tZ*\x8f>o\xeaj\xa1\x88\x04\x84\n\xe4\x12\x9c\x8c*\xfe\xcb\xf7\xed\xb2\xaf_\xff\xf5;A\x82\xfcR\x15\xa3\xa0.U\xef\x9f\xc1\xce\xdfI-\x8cW\xc3\x1d'\xf0\xd9\x1f2P\xc1\x1f]U\b\x84Ph.EO,<k\xbfI`\xd0Fk=j\xc7\x03/=큗nw\x1d\x1e\xf2\xf2\x13\xb0\tV\x05\x97\xca\x0e\x183I#\xfar\xa8*\xd6\xd9\f\xd4\xef\xc4\xedDGmR\xdf\xe5(\x893\x17/a\xa3.\x92\rbe_\xe5v\x1b\f\x15j\xaf\x8d\x15\xc9\xf3\xe3\x13\x16\xb5\x16\xda\xd3\xc9\u007f\xcbѹ\xd4g&\x03\\\f\xe4\xfb\x16@cE\x17\xd5ݯ\xf8Æ\xc2V\xa5\u007f\xcf:_\u007f\x83\xb5\xb5\x9bh\xf3E\xd8|n\xeb`\xc3\xc1\xb0\x1bݡ6l\xcdk\x92\xd9\xfc\b:\x1e\x99\xb2\xb0\x19\x18\x82H\x87{Iqmd\x80W\x94\xc8\b\xa8Q35\x84\xbbC>\xfa\xcc'\x8b\xd6\xea\x8a\xdde\x8eߐ\xcc]\xa0\xaa\xfe\xa8\x855\x13\x0fk\x1e\x05\xd0\xe6餉8\x93\x10\xe2<:\x889\xaa\xf1|\xb2h\x02\xca\xe9\xb9\x01\xb0G\x10\xa2ZK.\xc0\xa3\xf4N\xdf\x17><\x1aQU)\xe1$\xcd\xdf\xf0\xb4\xe4\xe8l\x8c\xfc\x87&\xaf\x13b\xa7\uec1b\xba\xfd\v\x9a!'\xfe\x83\xb79\x93\x03\x9b#'m\f\xeb?n\xca~D\x03\xdbA\xc0\x91\x95 \xf9\xe8w \x1a\xe2!,\xbe#\x89\x13\x99\x95@D\x9e\xf8\x8aQ1\x9fY#٘,\x8c\xc9S?\xdf/ͤOe\xb3P\x9d\xe9\"\xe9\xc2\xce)YE\x05$\xabhl\xdeh\rb*oL\x93k-u'ډs\x12<T*\xf7\xa7$!O\x93t\x96\x0e\x1b\x93\xc7ۘT6\"q\xd9\xd5ow\xb7\xe5ӄ\xcc\xcaA\xa8\xdb \xa5\xeb\xf7\xcfh\x106C\xa2\xa0\xa3ȩ\xf6\xefm5GY\xb2k\x13˵(\xe4\xc5\x16\x98pX˄\x1f\xc8c<\xcf\x16h\xd5ζs\xa0\xf66\xc1\x11\x19\xb67\x1e\xb5VѮ\x03G\xbcxI>\xe0,z\x04\xd1&\x89V\xc3%|\xb8E\xd7I4\x19\x8f\x1f\xe2h\x83V\xb0\xae\xd3x\x94[\x92M\x9a\v8\xbb\x98y\xb8\xb2\x02\xe3UrW\xd9G>qn\xfeKl\x1b\x13\x87\x04\xa2B\xa1\xd1\r\xbaM:1\r]&\xff\xfb\xbfz\x8aK1E\xd1\xdb-\xbc\x9a\xaf\x17\xc9\xf5\x00\xfc\x1b@7\x9dX(\xc3(\xf3\xdb\x1c\xcf\xd7\v8\xbc\x1e\\\"\xd6\xef\x03sPk\xef!\xd6\xef\xb3ȉ\x88\xfdYW\x8f^\x87ӛ\xe4z\x18\x11\xf6]\xfa]\xf4\x19\x9cu\x92\x8e\xf3(:e{\xce'\xe31\x8c?\x83\x83\xcb\xeaj\x0e6\x84\x0e\xc1 [$7b\x8dU;`&X\x11J\xd8Z)\x80\xb4\xce\xc4s\xe6\xc9\x03*#!k\xca\x06L\xb3\xa9s\xbe\x80\x95ک\xe7A\x13\xf3\xacL\xaf\xae\b\xbd\x02p6\xc1\x8fϿ\x18\xfb>\xe5\xa2÷d\x83\xa7Ͱ\xab\xcf\x11o\x04\xf0a,Z#\xb9c\x10\xe5!\xaa3\xcb\x15\x1f#\xab^\xc1\xd8\xfd\x99!\x81\x01[ٺ\x82h\x99\xe3\xb44\x1a\xce\\\xba\xef\x16\xe2&\xb7\u007f&a\rh\b&\x8e\x19\xaf\a\x15g\x92\xba\x8aR\aU\xde5`.\x9f#~\xbe\xf5\x1d͡cXx(\xad\x96\x9e\x8c~\xfdjwpG\xb2\x18\xa3M\xfa!nb\x17ڐF\x88%E$$=lQ\xc8\xca\xe7[\xbc!\x94U\x9c\x01K\xd3`\x10#\x87\x12o\xd2\x0f\xa0=\x13\xb7\x02\xa1 4\xab\x065\x97:s\xde}\x9a\xe2З\x17v('U`c\xb4\x9a\xc75\u007f\x81\x18\xac\x8b\x92\xfcRP._\x8c\x1d#Go\x8f\x15\xc9\n\x87\xa8t\x02\xb9\x84P:H\xd2\xe2qsO\t\x1c]\xa7y$X\xc8\xe0\x15\x17\x88e)\xd38\xb7\xe6D\x113T5E\xa5\xba\xc7ux\x8bwi\xf6ӎ\xa9\xc5D:y\v*\x933\x9dl\x87\x86\xf31\xfdF\xd7\xe0nFիi\xac\xfe\xff\xdfh\x95\xb4(k\xf7\xc5w\xfe\b\xdd㚄h\x9b\xecf\xb5r\xacV\xe0\v\xfe\xd8/\xfd\x06\xaf\x84\xf4\xbfIZJU\x99\x1aj\xbe^LM*\xb4\x1f\x93$ѫ\xe8\xf7\xa3\xe5 \xd9*\x10]\xa3\xabd9\xdc\b`_'\xe3\xa7W\xb3q|\xf5t5[\xc5W\xa80\xea\xaa\x04G\xd7h\x05Q\x9a\x9ct\x17<\xb4\r\a\x81\x13\xe3\xaa*x\x1e\xd9p\xb7m\x1e'J\xdb\xfcM*f\x91\a\xd0!\x95\xe80Ag:>\xd1MB|\xe6x\x8d\xaeQ\x06\xd1m\xa3\xfc\x91\xfe0e\xc9\xed\xd3\xe4f\xe6~\x8b\xbd\x8a:\xbb\xb9\xcf\u007f3\x94\xa2\xd2K\"l\xe7u\xe0\xc8!\x89\xe9(MZ\xf4\fy\xdccz\xfe\xa8\xb6\aM\xb4m\xa5\xbe\xd8;\xb8\xc9\x18#\x9a\x90\u007fK\x11KȐ\xa2\"a\x83\x14\t\xaaDg$\x96\f,\x1b\x94\xb3\"fGm\f\x9d\xa8o\x9e\xb1\x93>\xf8\x8e\x17\x89s\xfb\xb8\x8eK\xee\xa5Զ\x91\xa5\xa6#MX\xb4\xc1ה\x8e\\\x1a\x11aD]\xe2N\xa0\x8d\x1c'hN\xc8|\x8e,\u07ff\xc19^\xf2Vh-\xefA\u009fp\xa3ԛ\xaf5\u007f\xec\xe6.\xce&\xf2-4\xe2\x11v\x83\x0euh\\\xf6\xfb\x88\x06\tns\xed0\x0e@\xc3\b\xec\xed\xb5\xcb\t\taL\xeb\x8c\f\xdc\xd2,{[\xecd\xcc\f\xe5\x99a\xb5\xfbS\xac\xa0\"c\x87\x997\x18\x0f2\xa1/5t\nx\x87}s\x90\x1a(b\xaa\x92\x05:\xf0\xbd\x03\xa0\xea\xd50\xe1Qa\xbc\x10\xde*\xa0\"VOJpo\xc1I\x85\xc3\xc95 \xc9e\xb8\xab\xba\xb7ݶ\xab\xaf@C\x84[o\xa2\x81u\x1f\xaeҹ\xf4\x89\\\xa5\xdd\x15\u007f\x99\xbd\xf6dae\xa3\xecI\xb4\x97d\xd6i!c\x92\xdd\x0fX\x15\xacp\xebi\xceC\x8bvy\xfb\x88K\x8bse\xae[$]\b\xef\xb3\x17\f\xc6c\xe4\t\xe6\xd4a4\ns\xe8\x8f\x18\x81`Tk\xb2\x8d\x0eֳ\rNR'K\x9ed#\x91\xd1\xe66\xea\r\x9a\xf5<\x9dn\xb3\xd3\xc9\xf8aW\xbf\xa1\xfa\x83@\xfd@$3\x1cD\xe6\xc65D\x94\x8eǵ<8\xf2\x16h o_\xdf\xcc\x06\xa3\x16\x99o\u007fm;'\xb2\xc0\x13Á';ZE\x82\xd6\xd7OvJ\a4F\x98f\xf1d<V\xfc\xfe\x04y\xdc\xf3\x18\xd5,\xb3\xac\xa4\xf5\x9d\xf1\xd9\x049\x84?>\x1b#\xc3F\xc8\xf74C\x17\xc5\a\xa3\xaf\x92\x1fjTT\xefnR\xf8\x8f\x1f!#\x19Ə\xc6u\v㮯\u007f\x02D\xa8\x18応\x91\\Y+\xfe|\x1c\x8c(C\xeb\xe7\x98O\xcfw'=\xdf\xd9^\x9a\x19=[\x19\x9f\x1a\x89\x8bwy\x0eG\xab\x82\xf27\xe4\x17\x8c&c\xf8\x90W\nr!\xf0\x129\x99\xf0\xe3\x1d\xb9\xc7\xe3\x1d\xf9\x8d}\x13\xdfp\xb2|\u007f\xdb\xe1\x80O>\xc6\x01_\xf9hX\xf6D\xf6_G^Z\x00\x88\xc8}\xf3h3=I\xc3-\xe2\xc6\x13\xc6M\xcaT\x90\xe1\xac~\x9c\xf8\xcc\x0e\x99\x98\xea3\x1c7<ul\x1d8\xba)\xd3\xed+J\x9b\xa1\x99[\xbdu\xbc\xb7\x84\xe3\x93\x1f~\xa3Q\xcb\xd2!\x05CI\xbcI\xe5\xa5\xe7~^\xec(\xf7\xd9z\x19u\xe6\xc55.o\x8dA\xca\x1b\xbe[\xbe\x97\x8e\x98aW4\rKW\x05\x05\xc0Y\xe2ܡʚbF\x1d'L\xf0;7g\x8f*\x96\xfc\xa3<46\xb3\xae\xba9\xc4y`\x91g*\xfen\x99\xe6K\x1df\x97\xdaW\b\xe2\x04\x13\x92\xe6\x19%\xc3\x11\x1c\xfdT\x10*˼\xf8:\xceH!\x837\x1b\xd9\b\x80\x86\xf3\x14/\xb6\xcfԊ&q\xf8\x03\"\xedV\x97|\xa3[u\xe5'\xf7\xf2~\xc7\xe1ƈ&s\x8c\xc8\x02\xb1\xe4\xaeBE\"\xf8\x0f\x1bY)}Z\xf4\xfbt^,\xa6\x85\xa1\xaeR/\x01\xe8ns\x89\xcbZ\t+\xea\xc02\x91U\xad&6\x97\xbf\r\x04c\x00\x91\x89=\x91\xcf\xc7\v8-\x93\x9d\x97\xc6\x19\xe5\xf2\xdd\r\\\x16\x9c\x17\x1b\x90$\x89(\x18\xf1\xe2\x9b\xe2\x06\x97\xcfS\x01\xfa~?*\a\xc9\xee\x80\x0eA\xbb\xea\xc1\x8a͋ERVn\x8e\xf6ӥ>\xa59\xa1n\x02\xe3o\x04i\x12\x87U'\xac\x16\xa8\n\x06X\xab\x83\xd8kj\xce\xfa2\xa5\x92f\x99'D\xf1M>\xb9y\x16?Tr\x92\xd4cE\xc7\xd2!\xc99J\xb3\xc8\xfb\x99\x90б\"a\x9c&\x16\xa7Q\xdb\xf4]\x05{\xf4:\x1f\x0e\xd1\xe1&uTd\x9f\xfd\t\xf9\xa2)69\xf4\x05\xf9i\x9e\x8fN\x1e\"b!:#\x1a\xd0ĞP\fcU\xd8\xef\x13q\xd8w\f\x9b\x8db*\xbd<\xf4\x8e\xa8\xfb\xbd3\x9a\xb9ڱIc\xc7p\xa7G\x82\xec\xd6!]nЭ4_\x86U!\xc6\"Ǥ1\xb0`0\xb1\xa5̢#\xbc\xdfG8\xe0\xbf\x00\x91\x94\xba\xf1\xd3D\xbb\tn%\xf5\x99\xe1'\xaa@\x1d$U\xe6\xd2^\xe7)U]\xaeƚN\u007f73R\x12\x9c\xaer69\xa5\xd2\x18:\x99\xef\x0e\xd6\x13\x92\x9e\x1ff^\x93\xfd\x03\xe1\xfeM\xda\v\xefzz[\b\xeaȋ\xad\xa0\x18x\x06\x943\xe9[\xa9\xc2T\u007f\u007f\xa5\b\n\xa2n5MebY$\xa9ߔ\xcd\xc9\xc2\x1b`N\x16\x03\x807\x00\xb19^$c\xc4\xe6t\xa1\x1due<ܕI\xd1Q\u07fd\x96\xa0\x89\xaf\x83\xa3\x1c[\xa3\xb9\xa0\x8bs\xb0M3A\xd5T\xe8\xc9E\xed\xa4k\xafΦ\x81E+\x92\xa8$\xed8뉿\xf9\x10\fh\xc3k\x85\x89~\xecW\xac\xacJ\x98\x93\xb5J!\xb2B\xe9\xdd\xf2=/b'b\x90\xeb\xbc\xe0%|\xeb\xdd\xe0\xcb\xf7\x84\xbf\xb5e/h\xd6+\x1a?\xfd\x16\u07fe\xf1>\x03\xff\xe1\xc99\x14\xeeM\xeb\xe2ґǛ\x03\xa8\x82\xa8\xb8\xf2\x98\xd6\xea\xaa+b\xe6泟,\x86~z{u\x00\x14tu\x82|UC@D\x15\xa0\"!\x87p0M\xb8ѯk\f\x9c\x8a[2\x19#\xaaϫA0>\xa3\xe2H'\xe3X\xfd\x9f!\xaaP\x0e\x806'u\x10\x1dZ\xbb퇉m\"K\xaaЁ\x06\xd1aGeo\xab\xb2\xd8Ģ\xaa\u007f\x84\xc5V\x856\xc4#h\xc9=\x02\x11\xa9\x81_\xd3\x06M\xdc\xef\xcd\x1b\aN\xdaG\xa8\x935\x90o\x1c\x88$\xf78\x8d\x88&\xf6\x10\x93\xfah\xaa\xa0\xb2\xf2lN]\v߆7\xb5k#\xe6\xa2M\xe2\xd6:\xce̘\xb48\x1e+\x1b\xf0s\x95\x196\xd4CN\x8c\x87T{\xdc\x1b\xad牏0zL}IX`\xa8\x89OU\xbe\x91$i\x8f\x0et|l \x984\x96\x18\xa2\xd5\xe8 a-q\xc5\xcd@\u008c<Q\x9f(\xd3¿O\x02\x11\x06N$\xc5$D`]\x9b\xa4\xafJ\x9c\xbe\xd7`f\x1e=\x12\x12{3w\x8cS;dM\xe4X\x12\x19\xe4UE\xa4\xf6\xdf01\x83h\xd2\xf0c\xb0dDf0\b}Sd\xc5F\xb2\b\x101C\xb5\x1c\x13\xf4\x9a\xe3N\x93n\xda\x17\x17\x83\x16ޢҜ\x1dB\x1d\x9c\xd0\x145DpgQ1L(J\x87\t\x1fP\x18;\xcc}\xa8v\xbf/\xaa\x97È\x0f\x18\x14\x8dʡ\x91T\rw\x93\x14\xa8\xc9\xdc$)\"\xfd>9\xdd\x0f\xa0͌t\x13\xd0.yx\x00zm\xe2)\x00\x01=\x84\x06*\x04=\x00HMX\xfc\xe5\x1eS\xe9\xbb\xdd\xcdF\xa3\xa3ĥ\x19\xbf\xa1\xf1.e\xf4\x12>+\x1b\x10k\xf4P\xb5\xa2\xa2\x11\xf9fGo\xcatk8E\xe7\x98u\x02\xab\xa1ph\x80\xa5:UUJ\xaa\bN\x89\x9b\xd8\xd0\xf4\x1b\xcb03\xbd\xa0\x16\xc7\x04\x9fA\x1a\xb34\x9f\xa7\xa0\xa4w\xe5\x99\xfda\xc5a\xf1Þ\xbax\x82\xdcS\x16O\x90\xb9\x8elH7\xe4`Dl\xb4@\xad\xd8\xde\xcd\xc9\x01T\x8bo\xf10\xe8\xe5@\x1c\xc5\u05ff\x8c\x9at\xfa\xff>%G\x04o\xd3Kv\xd4\x15AV:\x14g\xad3_\xe2_\xbew\xd2\x1fz\x9e\t\x87\xb2*\xfe\xea\xf0\xa6uz.\x9e^\xbe%<?1\xe9aN\xe8{O!\xc9\xd3\xcb\xe7*\x03mb\x03\xab\xf1\xf4\xd2極\xe1\xd1|\xe5\xac\x17*\xcdN\xa1\x95\x8f\xcc\xcb*\x84hBL\xfai\x19V\x9c\xf8yjmJ\x8e\"\x91\xc9i\xd6)[\x8fXN\x968\x9a@\x94\xaaB\x92\xcd\xf4\xff\xe3b\x00\x86yz\x89exq\xa5\xe9*lr'm\x8f\xb5-1\xb3FNR\xd7\xe8~\xe5\xe9e\xdbT\xab\xd0%\xea}\x13g f\x88dqZAT6[oS*\x86o\x04X`\xba@N.\xc7\xd9\xe5-\x90͙r\x13n\xa5\xf8\xa6]ɷ7)_\xae\xb5\xe4\xe3\xe5\xc1\xaaw\xcdMS9%ǳB\x12\xc7\x14]v\x1c\xc8\ai\xbfE:\x10\xbb\x8d\x84vr\b\xda4\xcb\xfe\x8cou\x90\x05\x1b\xa2E\nBd\xf9\xbeYn\a\xacc\xd6\xd6\x01\xf4\x03\xc00\xaa\xbeP\xc3\xee\xe5\xdd'\xdem\xbbc\xe8\xd9\xddx\xeb8!\x86D\x87Y\b\x97\xc4\xc97\n\x91e\xa8\xfb\xe4\x1e\xcbAFB!\xa1\x9a\x99\xc8\xdc\xf3\xb6\xdfcct\xf06\xbd|.\xc1b-d*\u007f\xd9\xf5\xa6\x1e^t\xcb\xed>'\xb1\xcc!5,VCq\xd3\x00\xe9\xf6ު\x93\xa7n\x95i\x93\xbe4\xad\x04\x1c\xf85K}0\xad\xa2/ϒ\x84\xa8\xb8Y\xfaFF2˕ƍ4)\xecC\xd5.\x17\\\xe5\x9a\xe4Y\x89\xa9\x98\x16\x80ӴA\xdbH\xadQ\u0530%,*\xe0L\xa9\b\xed\xae\t&\xee5\xfd3\xbee\xb3\x88J\xcbm2Kuڬ8\x956FÉ\xa4\x85I\x92\xa4\xfa\xf8\x0e'\xb3\xd4d\xdcҕ\x06\x13\x99-(\x91\xbfT@\xba\xf4C4F\xa2\xb5h\xee\x94\vnf0Aug\x10B\x1b\xf5,|\x11z\x11-\xf4]،\xb2P\xa8Mz0\x17\xc4/\x91\xa4s\xf1\xa0\x0e\xe1\x10@\xa1\xa2#\xa3\x1a\xbdwO\x14V\xa8\x99\xbe\x8dݻ\x17\x16N\xdd\x16:/\xe10\vU˲\xd4v\xde\xfd\xb2\x81\x83\xf3\x14w\xa1\xb9\xe3\x10\xeb\xa0\xea\xb4\x1d2\xf1ȭ>81\xe5Q\x10tn\xb0\xa0\xfa\xf2\xd3I\xed+8\xd5Wl\x87\x8fT\xf7`\xa1 D*o\u007f%\xf6)\x9c\x1a\x99tM\xc74\xecJM\x16\x1cͬ\xa1\xebE\xa1\x96\xe0\x14\xed\x98\xe3\xc5\xff\x9f\xbd\xeb\xebm\xdb\x06\xe2_%҃!mrk\x17ۋTW\xc8P\f\xeb\xcb2\fy\x19\xd2bpb:\x16`[\x86E7\x01b\u007f\xf7\x81w<\xfe\xa7e\xbb\x19Ї\xbe%\x94\xcc?\xc7\x13\x8fw<\xfe~z\xae\xb1\xf1[\xb1W\x88\xc4>+6I[،\x1b4+\xb5\xdc*\x95\xbc`\x98pt3\x17\x12\xccߏ\x80\xab\x03Q\xe1+Ǭ˅NN\xd0b\xcb\xe6b\a\xc6$\xb8-\xadP\xf1m]e\x02\xdci\xddo\x9c\x1bYazYZ\xc2GUd\x9b\xe1\x91j\x8a\xfd\x8f\xe1oR~s\x91\xa6\xf9\t\x9b\xc1\xa09\xaa\xe0\xc0)\x86\xfa\xe6\xd3\u0099\xf1\xb6\xe31\xb6H\xcdF\x9d\xa0\x91\x93\xee\x03\xab\xbb\x92\x1d\x9c1!\xa4\xe6\xf9\x88\xea};q\x9d\xd5$͙\x044@\x86\xaf~\xc1\x9bPt\xc1\xcd\x12\xb2\xec\xc466\x1e4ǹ\x1b\xa3K\xf1\xda\xd5\x0e\xb8Lƅ6\x94\x00~\xa0\xbb/\x1e*Y\xc1\xa6\xbb\x1b\x02\x00FZh!\xc8r\u070eGp\xdf\xd1\x11\xfc\x91\x04\xf5}\xf8\xf6\x8d\xeb\xcf+*Ru\xe0r!\x97\b\xa2\xfao\xbf\x89B$\x04\xec\xc3\xea\xcc9\xe4\x92\xe5&W\x96\f\xe1*<\x90\t\xbb\x1b}qJov|\xc2\xe0\x1a1\xa4\x8c\x95Y\x98\xb1\x84\xd38<\xf9\xbc\x81\xf3\xb4\xbbї\x9a)\xff\xb8tL\x89\xe5\xadW\x1efz#\fJqe\x80ay\xa5\x1a\x1e\xbd\x91\xd6'd\xf8\xbd\\5\xf3-\xf6\xbc\x99\xaegl\x96\x16!h%\xb9\xa2\xe7'{\x95\xb6\x13\xe5\xc3\xf7\xa2#\xe0\x97\x17aX\xdf\x13\xf1p\ue0ba\x90\xfe\x8b?\xba\x96\xd7\tK*\x005\x03\x1en5*\xf3A\xdfЌw]\xb2\xe6J\x9f\xccǮI\x1b/\xf3:\x86\xe7\x88>\x12iW\x19{m>\xb7\u07b3PV\xaf\xff\xfet\x9dqo\x88$\x8ccI\x05\xd5\x11M\xa8\x8f\x81u\x06\x10\xae\"P\x9d\xdc\xea\xa6:o\x88I\xe0\x90\x97\xe7Bk\x99\xdf\xf2\x91\x96\xc7^ˎP\x0f\x1e\xff\x8d\xf8a\xf4H\xb7\xe7\x1bK\xf87н艾Ԝ\xa3\xc4\"0B\xcc\\\x98\u007f\xd8\xe0\xef?\xbe\xeeQx4\x1dļ\xd4\xff'\a\xe0\xdbvɛ\xcdkRz\x19\xea?c\xddö\xb9\x87 \xeb\xd1;\u009c\xbaa\xdbv\xc2]B\xf5\x8b?\xa2$\xaeG\xc6\xff2ˏ\"\xbd\xf2fs˞\x9d\f]Y\xe8\x82\xc0\xe0\x98pgK\xcb\x06[m\x96S\xee\xec:\xa8\xb4vr7\xa8\x9c\xa2\xb8\xf7\xbbf9\xbb\x95\x85*\x9b\x8e\xde\xd2\xe0kV\x16{\xfe\x86\xb3g\xb7b\xecp\x04\xf3\x1a\xfdm\xe87\x1c\xb6z\xb3R2\x8f\x12\x84J\xa4\xddU\xffS\x9a\xb3p\xbd\xec\fi\xb9\x82\x9aG\x17\xbb\x8e\xcdh*\xba\xc9\xdd\x17\xca\u007fح9\xdbN~1vM\xe8\x03ϴ\xe6\xfa\xe1lwZCK0d\x16\xc84\x824\xa5t\x18\x8bF\x1f\xfd\xae\xec\xed\xe7\xfb\x8c\xb7\x9b\xfd\x92\xcd\xf9\x1erW\xf2\xcf\xf7o\x1f\xd5\xedJ6a5\x03z\xd4Tw\xc1\x9a\xafx<ə\x1b\xd4j:\"w\xbdDsH\xfec\xd2\x05x\xcc0\x1fm\x95AR}\x94R\xa4\xb1\xef\xbb\xd3W\x15\xa3\x81\xd1~\xbd\xf0\xe3T\x19\x02\xb4\x8a\xa2f&f\x9b\x04\xb36\x93\xce61\n\x122\x87\x96\x06\x00\x94}\xc6k\xae\x92\x1e\xf2\"\xa1lO\xfbM\x15\x8c\x81\xfc\x81\xf7\xa3\xda\xf92T\\\t\x9e\x97*\xdf\xe2xeԪW\x9fub\x9fˋ\xde\xfd\xf5a\xceS_u&\xd9\v\xfe\xa0\x94\u007f\xf4\xb7\x00\x1d9\xa3\x01|\xbf<I\xaa\x1f\x86\xe3\x8b\x1a\xf7Z;S\xfa\x97\xb6\xdb7\xe83g\xad\xb7\x1b'k\xcby\x93\xf9j\xed\x1e{\x18ZZG\xd6\xf2;\x1cZ\xe1\xc6\x1e2!i #\xb6Uٵ\x82\x99\xa9\x8c\xbf\xb5\xcf\xc2\xc4\u007flVl݉1\xbao7'\xbc\xad\xed\xf6z\xa2g\xb8\xc6?M٫\"\x99\xbeLZ\xb9\xf6 \x1d**\x00\xf6\x99\x10\rP\x19\xa2\xf2\xa9\x9ay\xc60c\xf2\xc3D\xfc\xb1\x9e\xb5O\x1f\x9bU\x87e\x04N,\xe5;\x18$\xce\xe0>\xad\xfel9ܻn֏\xff\xb4;G\x1a9\x1dVY\xd3*\x93\xd3|9a\x9f\x1c\x91چ\xbfH\x1f\x98\xe8ʕԨ\"\xc8x\x14d`JF\xb9LO\x82\xb1\x95\x8d7\xdcở\x82\xbf\xa4t&H\xd9=\xe4E2\xae\x84[\xf0?\x8c*\xfd9\xe3\xfb\xbd6%'\x8f.ϫ3熘\xafqj\xab\x9cr\xe6\x14$(7\xce\xc9M\x82!:\x9fX\xb4O\x11\xee\xf7\xe9r\x99&n\xeeߢ}\xbaY\xdb\x1aԛ\x17\f\xbfq0\xb0M\u007f^\t\x1f\"\xe6:\xba\x9e\x16\x14\xb8w\xd8Z\xac\xa1\x14\xfd\\%j\xa3\xe1\x98\v\x85\x0f\x94\x87\x9e\xbc\xf8\xbb\x90\x91\xbbU\x19\x1f\xf2\x82\xfb\x946\xaa\xa2\x8e\xb7\x9b,7\xf9c\xec\xf1\xa5\xf9\x9b\xf9t\xa6\"\x14\x8a\xb3\x01\xca>\xee\xb6 a3H\x10?\x86\x12\x122\a\xab\xe7X\xb4~BX\xc5\xedt\x90\xff#\xb87;\xe0(T\xbc\xc3\x1c\xc6͎\x87\xc6\xc1m\x1f\xd1r\x10-\xeb0\x18d<bKB\x8b\xbe\xb1j\x19^@xS+\xa6\xcew\x04L/\xc0u\x01\xf2\xb8\xf8\x85\x90\xc3\xe2?Ό\xa6n\xcb8+K2v\x9cMy\x99\xfb\x8f\xf6+ۺ\xfe\x9f\u0086\x80\xf5\xc7Ry\xeb\u0094\x81W/Du\x14Ë\x98\x8c\x0f\x85\x16\xdfB4.\xd1\xdaMH\x8a%\x9b~e\xe1f\x9b\xfc\xc5B\x11\xe3\x1aE,K\xd8~\xaf\xa6~0H\xb8\x8d\x8dr\xb3ak\xb1\xc0\xc9\xcf'\x88\x9f\"ީ#\xc2P)\x1d!Y\xa0\x9a\u007fZ\xc1)\x15g.\v\x9a\xec\x14\xc8I\xe9\xe6\xa80\xbah·\xe8\xa4\xeb\x8cK\x94\xab4\xc71\x92\xdcI\xac\xf9\xc1\x8d\x9e\xbef\xbf'\xc9ȃ\\\xc1\xfe\xfe\xden\xc1\x9a\x84T\x88O7F\xbb\b\xc7\xc1ֳ\x13\xf4\xa9\xa6I*\x95\xda\x04n \xbd\x04\xf8\xfb\x10\xe0A|<:\xb8\x1e\xa4^\x01\xb7/\xaac\xca9\x16K0I\xa1\xce\x02\x1a\x05\x17\xf4\x921\\j\xc3\xcc\x16\xdde\xddP\xbb\xe3ѶBK\x16\xa9\xe8\tw)M\xb9\t\xc5q,\xf3eH\xfb$}H\x16\xc7ќ\x1b\xcd5\x83H\x8e\x19\x83\xb8Nn\x1e6㨮\xa4\x8c`\xc1\x93\x8b\xf3u$\xbef=\xb6c:\x81gĳ\xef?\x91q\x1e\xd7d\x13\xc0\xff\xa5\x11h\xe7\xf3(\x93q\xa1W;\x00װ\x8dr9\xfe\x15\x8b\f\x03\ae\xe6\xba\x00|Yv\xa0\x04\xf3\xd9u\xe0\xc5\b\x83\x98q\xaa2]L\xbb!\x14\xe3\xfe\xa9L\xbb\x95ؑ\xa9\xfa\xa0\"\x8c\xaea\x9d\xbb\x87\x05\xe0\xf2c\xc9\xedT|\xc0W\xf8\xc1\xa5\x85R\u007fa\xc2-+(~L\xce\xc5x\xa4\x98\x01\xc6\uf891x2p*\x12\xff_\x00\x00\x00\xff\xff\x89\xc0T+\x86\xa5\x01\x00",
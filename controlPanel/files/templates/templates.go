@@ -31,11 +31,11 @@ var staticFiles = map[string]*staticFilesFile{
 		size:  0,
 	},
 	"general/header.html": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\x9cT͎\xeb6\x0f\xdd\xcfS\xf0\xd3\xe6\xdbDq\x8b\xa2E1\x13g\xd3\xe2\x16\xdd\x15\xb8\x0fP0\x12m\xb3W\x96|)ʙt0\xef^8N\x1aw\xe2\xb9\xfdYٔt\x0e\x0f\x0f)\xbd\xbcxj8\x12\x98\x8eГ\x98\xd7ׇ\xdd\xff|rz\x1a\b:\xed\xc3\xfea7}\xc0\x05̹61\xd9߲\x81\x80\xb1\xad\rE\x03\x9e\xa56A\xc5\xec\x1f\x00\x00v\x13\xcf\xfc{\x0e{R\x04סd\xd2\xda\x14m\xec\xf7\xe6\xedv\xa7:X\xfa\\x\xacͳ-h]\xea\aT>\x042\xe0RT\x8aZ\x1b\xa6\x9a|Kw\xe8\x88=\xd5fd:\x0eIt\x018\xb2\u05ee\xf64\xb2#{\x0e6\xc0\x91\x951\xd8\xec0P\xfd\xf5\xf6\xab%]\xe0\xf8\t\x84Bmr\x97D]Q`\x97\xa2\x81Ɍ\xdap\x8f-U\xcfv^넚i\xad\xad\xa6\xb8jp\x9c\xbe[viI\xa9\xac\x81\xf6?\xa4\xa8\x92\x02\xfc\x82\x91\x02X\xf8\x80NS\x0f\x1fR\x89\x1e\x95S\xdcU\xf3\xc1U-z\n\x94;\"\xbd&u9W͟\xd8m\xcfq\xebr6\xff\n\x1d\xd5\xe2\x91r\xea\xe9\xbf\xe0q\x18\x16\x90]uk\xfa\xee\x90\xfc\xe9\xc6t\x9b\t\x92\x05\xbf\xe7\xf1:P\x92\x8e\x8b\xccow\x03JK\xf6\x1b\xe8\xc9s\xe9\xed\xb7\xe0R(}\xcc\x10R\x9b\xde\xe0\xceX\xbc\xa8\xac\xcc~\xc7}\vY\xdcܥ\xe6칽\xf9\xf6k\x97\x84\u007fOQ1l\xf3\xd8\x1a\xc0\xa0\xb5\xb9k\x8d\xd9\xef*|#\xb0\xf2<\xfe\x9d\xe6\xef\xee5gBq\x1d\\\x94̑\x9d\xc6\x159\x92\xacU\xb3`\xe58\x14\xb5\xad\xa42\xac\x9c<\x9f\xce\x03ƕ\xe36\xe0\x81\xc2\xe4\xc7u\xb3Ah\xf0\"\xc0\x00\n\xa3\xed\xd8{\x8a\xb5Q)4\x95\xcc\xfb]5\xf1\xbd\x93\xeaL\u007f\xb9\x17W\x9e\xf9\"~6k\x12\x1a\xa6\xe0\r\xb0\x9f\x92/\xca70\x04tԥ\xe0Ij\xf3qv\b\xa1#n;\xdd\x00z/\x94\xf3\x06T0ftSG\xe0\xe7\x1f7@\xea\xb6\xdb\xf7\x8cX\xb7\xcd\x1e\x8a\xea\xd4\xd0U\xd0}Y\xe5г\xaeh\xb6םK\x8a\x99\x16<\xca'\x03#\x86B\xb5\xf9)\xbd\xa7\xed~vn˰>\x01\xe7;X\x9b!e\x9e\xea\u007f\xc4CN\xa1(=\x9d\x1f\xb5G\x8e\x1d\t\xeb?0\xe3\xafe\x90H\x12p\x18B*\n\x18H\x14\x94\x9e\xd5:\x8aJb\xaey\xd3H҄t|\x9cg\xe4\xc9s\x1e\x02\x9e\x1ec\x8a\xf4d\xf6\x1f\x93\xc8i\x03\xa7T\xe4:\xe1\x9e}\xfc\xbf\x82\x90\x16\x89\x80\xf1\x04B\xb9\x04\xcd\xdb/\x96\xff\xa5K\xb6\b\xe7\xe7fzO^^(\xfa\xd7ׇ?\x02\x00\x00\xff\xff\x93P.\x04\xc6\x06\x00\x00",
-		hash:  "c0b8bc411d656fcf964e2c07939211bc9c6ff43d9be8dcd6f5509d89c7981384",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\x9cTݎ\xdb6\x13\xbdߧ\x98\x8f7ߍi\xf5\a-\x8a]\xc9\x05\x9a\"E\xef\n\xe4\x01\x8a19\x92\xa6\xa1He8\xb4\xd7]\xec\xbb\x17\x92\xed\xd8Yk\x93\xa6WҐsΜ\xf9\xe3ӓ\xa7\x96#\x81\xe9\t=\x89y~\xbe\xab\xff\xe7\x93\xd3\xc3H\xd0\xeb\x106w\xf5\xf4\x01\x170\xe7\xc6\xc4d\xff\xca\x06\x02Ʈ1\x14\rx\x96\xc6\x04\x15\xb3\xb9\x03\x00\xa8'\x9e\xe3\xefl\x0e\xa4\b\xaeGɤ\x8d)\xdaڟ\xcc\xcb\xeb^u\xb4\xf4\xa1\xf0\xae1\x8f\xb6\xa0ui\x18Qy\x1bȀKQ)jc\x98\x1a\xf2\x1dݠ#\x0eԘ\x1d\xd3~L\xa2W\x80={\xed\x1bO;vdgc\x05\x1cY\x19\x83\xcd\x0e\x035߮\xbf\xb9\xa6\v\x1c߃PhL\ue4e8+\n\xecR40\x15\xa31<`Gգ=\x9e\xf5B\xedt\xd6U\x93]\xb5\xb8\x9b\xbekv\xe9\x9aRY\x03mޤ\xa8\x92\x02\xfc\x81\x91\x02Xx\x8bN\xd3\x00oS\x89\x1e\x95S\xac\xab\xa3\xe3\xa2\x16=\x04\xca=\x91\x9e\x83\xba\x9c\xab\xf6#v=p\\\xbb\x9c\xcdW\xa1\xa3Z\xdcSN\x03\xfd\x17<\x8e\xe3\x15\xa4\xae.M\xaf\xb7\xc9\x1f.L\x97\x99 \xb9\xe2\xf7\xbc;\x0f\x94\xa4\xfdU䗷\x01\xa5#\xfb=\f\xe4\xb9\f\xf6\ap)\x94!f\b\xa9K/p3\x16O*+\xb3\xa9y\xe8 \x8b;v\xa9\x9dkn/u\xfb\xb3O\xc2\x7f\xa7\xa8\x18\xd6y\xd7\x19\xc0\xa0\x8d\xb9i\x8d\xd9\xd4\x15\xbe\x10Xy\xde}I\U000cfdda3\xa1\xb8\x1eNJ\x8e\x96\x9d\xc6\x159\x92,es\xc5\xcaq,j;Ie\\\xf0\x9c\xbd\xf3\x88q\xc1\xdd\x06\xdcR\x98\xeaq\xbel\x11Z<\t0\x80\xc2h{\xf6\x9ebcT\nM)\xf3\xa6\xae&\xbeWB\xcd\xf4\xa7\xbd8\xf3\x1c\x17\xf1\x83Y\x92\xd02\x05o\x80}c>I\xdf\xc0\x18\xd0Q\x9f\x82'i̻\xf9\x10\x10z\xe2\xae\xd7\x15\xa0\xf7B9\xaf@\x05cF7u\x04~\xffu\x05\xa4n\xbd~\xad\x10\xcbe\xb3ۢ\x9a\xe2+\xa0۴\xcav`]\xd0l\xcf7\xa7\x10GZ\xf0(\xef\r\xec0\x14j\xcco\xe95m\xb7\xb3s9\x86\xe5\t\x98w\xb01c\xca<\xe5\x7f\x8fۜBQz\x98\x1f\xb5{\x8e=\t\xeb\xbf(Ƨi\x90H\x12p\x18B*\n\x18H\x14\x94\x1e\xd5:\x8aJb\xceqӎ\xa4\ri\x7f\x7f\x9c\x91\a\xcfy\fx\xb8\x8f)҃ټK\"\x87\x15\x1cR\x91\xf3\x84{\xf6\xf1\xff\nBZ$\x02\xc6\x03\b\xe5\x124\xaf?\x9b\xfe\xd7/\xd9Ǉ\xe1\xbb\xf3\x92}\xeeM8\xaa\xfbyn\xaf\xeb\x91c^\xec\xe1\xe6\x17I\xfbL\xf0fv\xf9\xd2\xf6_\x99uu~螞(\xfa\xe7\xe7\xbb\x7f\x06\x00W\xc5o\x93_\a\x00\x00",
+		hash:  "4c6a8a39bd1f780cc7e6d36fefed286add93ad202453b3557373446872b027c2",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1491149617, 0),
-		size:  1734,
+		mtime: time.Unix(1792390030, 0),
+		size:  1887,
 	},
 	"general/scripts.html": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\x8c\xcb;\x0e\xc3 \f\x80ὧ\xb0\xd8\x13.\x90\xe6.\x16\x0f\xd5H1\x14\x9b>\x84\xb8{\x87l\x1d\"\xc6_\xfa\xfe\xde}\x88\xc4\x01\x8c\xb8JEŌq\x03\x00\xd8\xce\x06\xa9\xeen\x92\xd8W`\x9f\xabM\xcf\x16\xeawMb\xf6͞d\xbf\xf2\xef\a\xeaB\\\x9a\xce?17\xf6\xa8\x94y=\x88'>,eBEt\x9a\x0f\xbf`\xc2\xcf\x1f\xef=\xb0\x1f\xe3\x17\x00\x00\xff\xff\xb1\x1b \xa8\r\x01\x00\x00",
@@ -59,18 +59,18 @@ var staticFiles = map[string]*staticFilesFile{
 		size:  3757,
 	},
 	"index/index.html": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xfft\x8fA\xaa\x021\f\x86\xd7\uf762v_O .\x04\xf7\x03z\x81\xd0d\xb4\xd0&\xa5͈C\xe9݅q!:\xcc6\xf9\xbe\xe4\xff[C\x1a\x03\x93\xb1\x81\x91\x9e\x03\xdc\xc8\xf6\xfe\xffךR\xca\x11\x94\x8c\xbd\x13 \x95e|\xd89gN\x82\xb3q\xee\xf8M->\xc3c\xa5G\xf1\x10\xaf\x92\xad\xd9\xff\xae\xb4\x00W\xf0\x1a\x84\xeb\x94\x12\x94ye#(\xe0\x94\xf2\xe7\xfd\x99q#B\xf5%d\xad\xab\x1b^X\x8b\xc4\x01\x98\xe2e\x83\x19E\xf4]\xb25b\xec\xfd\x15\x00\x00\xff\xff)\xb2x\xeb\x1a\x01\x00\x00",
-		hash:  "cc3a3da9da68933167db530679efab736e844d7d525da9b7f55fe484cfc74a3f",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xfft\x90Aj\x031\fE\xd7\xed)\\\xef\xdd\x13\x94.\n\xdd\x0f$\x17\x10\x96fb\xb0%ckB\x06\xe3\xbb\a&\x8b\x90\x98\xd9\xea\xfd'>\xbf5\xa490\x19\x1b\x18\xe96\xc1B\xb6\xf7Ϗ֔R\x8e\xa0d\xec\x85\x00\xa9\xec\xe7\x9f/\xe7̟\xe0f\x9c\xfb}M\xed>\xc3uУx\x88g\xc9\xd6|\xbf#-\xc0\x15\xbc\x06Ầ\x04e\x1bl\x04\x05\\S\x1e@.\xe2\xa9\xd6\x18\xaa\x8e\x8c\xa8$`X(\x11\xeb\xb3\xf9?\xe3A\xfb\xeaK\xc8Z\x87O^X\x8b\xc4\t\x98\xe2\xe9 3\x8b\xe8c\x9fֈ\xb1\xf7\xfb\x00\xba\xd8\xe2\x9dU\x01\x00\x00",
+		hash:  "f4109d0467067ec700b0587003ee9ca1a1f1a718f1b1356f58b3da8cffd50e40",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  282,
+		mtime: time.Unix(1792389750, 0),
+		size:  341,
 	},
 	"index/indexnav.html": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\x84\x8e\xb1j\xc40\f\x86\xf7<\x85\xd0n2ui\x1dO]:\\)\xf4\t\x94Xw\b\x14;\xd8J\x9a\x12\xf2\xee\xe5B\x0fz]nѠ\x9f\xff\xfb\xfem\x83\xc8gI\f()\xf2\x9ah\xc1}o|\x94\x05\x06\xa5Z;,\xf9\v\xa1ڷr\x87#\x95\x8b$\xd7g\xb3<>\xc3Ӵ\xbe`h\x00\x00\xfc\xac\xb7\x82Q_\xe1zܐ\x93\x95\xacn\xa2Ċ\x10\xc9\xc8\x1d\xa9\xc4\x0ey\xa5qR>\x1e\xbf\x90\x03\xa4\xf2\x17\xe4LL\x19\xa4:\x1aL\x16ƣ{\xdb\xeaF\x92\x84\xc1\x13P\x11r\x95\x95\a\xe3ء\x95\x991\x9cH\x12|\x1a\xd9\\\xe1\x83.\xec[\n\xbeUyd3\xea\xffo\xbf\xb7\xe6\xc2Wk8\xe5\xc2\xf0\xcaF\xa2\x1c\xe1=G\x86\xb7t\xcee$\x93\x9c\xeeu\xbe\x9d54\xbe\x8d\xb2\x84f\xdb8\xc5}\xff\t\x00\x00\xff\xff\xa7\xffC\x19\u007f\x01\x00\x00",
-		hash:  "dbe5cd4fb16f7f214469c016e8613fc72fd7f0bba689d5a13a5a99fac9615dc8",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xac\x8e\xc1j\xe40\f\x86\xefy\n\xa1\xbb\xc9i/\xbb\x8eO{)t\xca@\x9f@\x13k\x06\x81b\a[I\xa7\x84\xbc{IځN/\xbd\x8c\x0fFH\xfc\xdf\xf7/\vD>Kb@I\x91\xaf\x89f\\\xd7\xc6G\x99\xa1W\xaa\xb5Ò\xdf\x10\xaa\xbd+w8P\xb9Hr\xa7l\x96\x87\xbf\xf0g\xbc\xfe\xc3\xd0\x00\x00\xf8Io\x01\xa3S\x85\xeds}NV\xb2\xba\x91\x12+B$#\xb7_%v\xc8W\x1aF\xe5}\xf1\x05ٞW\xf9\x0er&\xa6\fR\x1d\xf5&3㞽uu\x03I\xc2\xe0\t\xa8\b\xb9\xcaʽq\xec\xd0\xca\xc4\x18\x0e$\t^\x8dl\xaap\xa4\v\xfb\x96\x82oU~\xb3\x19\x9d~v\xbf\xb7\xe6\u009b5\x1cra\xf8\xcfF\xa2\x1c\xe1%G\x86\xa7t\xcee \x93\x9c\x1e\xa5\x1bu\x97\x1dK\xee\xb9Vx\x96j\x0fC3\x97\xfaIߦ{\xaco'\r\x8do\xa3̡Y\x16Nq]?\x06\x00\xe4)\xaaZ1\x02\x00\x00",
+		hash:  "241cec01b30b8ba88c588e9e70ee85983c45f243774ab993d4d0cc53e1daf80c",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  383,
+		mtime: time.Unix(1792389750, 0),
+		size:  561,
 	},
 	"index/localTop.html": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xdcX_\x8f\x1a7\x10\u007fϧp,U:\xa4:\v\xe9\xa9=\x91]Kw\x17\xe5Z\xa9\x89\xa2\x12U\xea\xa3Y\x0f`e\xd7\xdeڳ\x1c\b\xf1\xdd+{w\xe1\x8e\x00\xbbp!\x0f\xbd\x97\x03{\xe673\xbf\xf9g\xb1ZI\x98(\r\x84f&\x15\xd9\x17S\xd0\xf5\xfa\x15\xa9\xffb\a)*\xa3\x89\x92I%@\xf9\xe62\bH5'i&\x9cK\xa85\x8f;\xb7\xbb\x12\xa9\xc9\xca\\\xbb=R\x95\xb1\\d\x19\x8f]!*\x83\x0e\xec\x1c,s(\xb0t\x94Ǒ\xbf\xf1\xff\x82\xdc~\x8cـ8\\f\x90\xd0G%q6\x1c\xf4\xfb?\xbd\xa3\xfc\x1fSZ\xf2\xc9Hh\xac\xccW\xab7\u007f\x83u\xca\xe8\xf5\xba\x81\xac\xee\x1a\x80If\x04\x0e\xad\x9a\xce\xf0\x1d\xe5\x0f\n\xc9]\xa929$\xab՛\a\x85\xe1\xcb\x13\xddh6\xe0d\xbfS\xaf\x19\xab\xed\x12\xa3\xd3L\xa5_\x13\xaaa\x81ޡ\xab\x1e\xe5\xb1\xe0\x9f`\x81\xc1\xc18\x12\x9b\x10\u007fn\xbc\xbd/\xad\x05\x8d\xc3-7iu´\x91\xc0t\x99\x8f\xc1R\xdeߡ\x880\xb6'!\x91T\xf3\x9d,\xee9:)\xb1\x99\xb0S`\xbf\x90\x1c\xa4*svM\x82}6xKZR\xfe\x04#\a\xb4*= \x18\x8431\x86\x8cL\x8cM\xa8\x0f\xfbw\xf0\xa9\xa9s{\x97\x99\xf4+\xa9\x8e\x86q\x14D\x8f@)]\x94HpY@B\x11\x16H\x03\xa9OP\x89\x169<?\x91ʉq\x062\xa1hK\xa0d.\xb2\x12\x12\xca\x0e\xc5\xf6-\xa9/\x0e\xdb-u\xfaAY\x87\x94\x87b\x1e-uJF\xa1?\xc8\xd5\xc0!)\x84s\xbd\x0e\xf1{\aB\x8bm\x00\x1b\u007f\nk\xa6\x16\x9c\xa3\xc4\x1a\xdf\x05\xcd\xf7\xb1\xb0\x94\xa0\x18+-a\x91\xd0>%\xc2*\xc1\x02\t\xda<&\xf4\xed\xb3\xa3\\\xe9\x1d!OsB\a\xfd>)\xc0\xa6\xa0\xf1\x99\xb8X\x84\xbb#<T#\xc2\xd7\xff\x8e\xa7,\a\x04K\x9f\xf7=\xf1\x8d߂\x16\x10\x8b\xc0\x03\x82C\xa5\xa7t?6\v%\xc2=d\xa0\x1c$\xb9\xea\x133!\xfd^\x1c\x15-.W-y8\x15G\xca\xe4B\x154\x82\xd4h\xb9\xaf\x84\xdejyV\tՈ?\xa8\x86n\xae\u007fL\t\xdd\\w\xac\xa0\xffeѴ.\x80C\xd2\xd5\xec\xff\xb5e\xf4\x1f,а\xf4' SSj\xa4\xfc\x03H\xb0\x02A\xb6Vd\xcbp\xdf\x01\xae\a\xfc\xee\xe9\x89C\xbe\x03뗢H\x94\rE\xb7\xa5T\xf8}\xe8ـ\xd6\xf4\\\x8a\x90\xd3\v\xf8\xd0\xf17\xaf\x90\x9b\xe6\x15\xf2\xdb\xc5_!\x05\x80\xfdS\xf9m\xbc}\x98\xa1A\x91}\x06\xb0\xf7Ur\xeaV&\xf7F\xeb\xea1\xed:\fW\xf4\x9c\a\xbc\xad\x8d\xe3|\xe3\f\x84\xec\x90}\xb4\xedB5\xe0\xc6>S\x05\xe5\u007f|&\xb1ʧ$\fG?i7\xe3\xde\x19\xeb\x97'\xf3\xb73%\x81>Ud\xfe\xd6_Q\x12\xf18\xc2Yg\xf3\xbc\xdaJ\xa7\xe9\x9c$\xbd\xf5S\x96V\xf8\xdcP\xfe\xbe\xfetF\xb0\rȹ!\xbfḟ\xd0x\x104\xf7\xbd\xe0[\xa3q~5\xf2\x11h<#\n\xaf|~Ҷ8\x16RPs\x90\x94\xffU\u007f:Ù\x06\xe4\x05Ut[5]7\xa58j\xeb\x0f\x8f\xd3\xdai1\x8e\x8d\\\xb6\x02u\x10\u00891\xf8]\xdbZ\U0008f8c7\xd1\xd5\xfb\xdb/\xb7\xbd8B\xd9]\xef$\xe9M\x0e\xff-E\xa6pI\xf9\xa5\x8d\x95\x05\xe5}\xff\xc6\xfax\xd7;][\x9aG}\xa6~G_;\xd5\xd6\xf1t\xc7QX\f/]\x9c;GqT\xff\xcc\xc3_\xadV\xa0\xe5z\xfd_\x00\x00\x00\xff\xff\xa3\xd5h\x9a\x16\x12\x00\x00",
@@ -79,6 +79,20 @@ var staticFiles = map[string]*staticFilesFile{
 		mtime: time.Unix(1491149617, 0),
 		size:  4630,
 	},
+	"index/peermanagement.html": {
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xffܗ\xe1n\xdb6\x10ǿ\xe7)\xfe\xd0\xe7&\x8a\xbb\xa0+\x06Y@RlX\x80\x15Ȓ\xf5\x01\xce\xe2\xd9\xe2B\x91\x02y\xb2c\x04y\xf7\x81\x92\x9d9\xaa\x1c\xa9C\x80\x0eE\xbe\x04\xbc\xff\x9dxw\xbf#\xe9\xc7G\xc5Km\x19I\xcd\xec+\xb2\xb4⊭$OO'Y\xe0B\xb4\xb3\xd0jޚ?\xffkFa(\x84yRj\xc5I~\x02\x00\x99\xd2\xeb\xfd\xb2w\x9b\xddj\xdfR8\xd3T6\x1cX\x01 +g\xf9\r\xb3\x0f\xc8BE\xc6\xe4\x9fJ\xb2+\x0e\xb0\xcc\nR2\ng\xc5;\x83\x9a,\x1b\x04\x16\x88\x83gR\x1b\xaf\x85AV\x81,HU\xda¸\x95\xb6Y\xdaE\xca\xd2r\xd6\xfbX\xddeT\x9d\xb2\xf7\xce?\xe7\xe2\xc9ޟzVI\x9e\xa5\xf5\xc1\xeeS\xa5\xd7\xf9I\xff\xdfo\xc9\x16\x15+\xddT\xa7\x1f\xbeJ\xfb\"\xbf\x8a۶\xb8T\xcas\bp\x1e\xb71\xf5,-/zbm\xebF ۚ\xe7\x89\xf0\x83$\xfb4\x16dO\xa9\xf3OP\x1b*\xb8tF\xb1\x9f'\xd77\xd8\x19\xde\xc5\xc8\x04\x1fc#4E\t\n\x98\x9d\x9f\xb5\x7f\xe9\xc7\xfe\xc6\x02\x1b.\xe4\xf0\x03\xaa\xf1\x14i\xe8)\x01 su\xb4`M\xa6\xe1y2+\x93|\x86\xd25>K;˨\xcb\xfb\x8b\xd6G\xd1v\xb2\xcb\xec\xc3\xc7\xd6g\xc3|?\xd9\xe9\xe7\xf7\xe7e\x92\xfft\x1e\xbf\x14&{\x9d'\xf9o\xce\xf3\x9a\x8f$\x94\xa5]\xb9\xbe\xa5_\x9e)8\xdbk\xd7m\xb7؋C\a~ϰ.\x1a\x11g\xd12\x0e2\xec%\x89(e)\r\x92\xfb\x1f\xc0\xbc\xab\xb9\xd0d\xd0\xce\xe5\x00\x8e\xf5\xb3\"\x9e\r\x01\xe4\x19d6\xb4\r\xb8\xe7Z\xe2\xc4Z.\x84\xd5\x19n\xb9rkmWp\x96!t\xcf\x01\xbc\\F\xc0H\xda\xe9\xb6\xfc \xf0\x1c\x84\xbc\x9c\xbd\x98\xbe\xd7+\x19\xba\x1d\x1c\xa1\x7f\xb7\xfaK\xedbu\x8e\x14\xf5 \xc4`q\x93\xfcR\xa9\x17e\x05\x80Lha\xb8\x17ch4d\xe1\xd4v`=\x1d0di\x1b\xf5M\x8f\x9e\x81\xc6~\xda7\xe6hk_$\xd7vw0\xb5\x92I}\xbd\xde\xd9\xfc\xb0a\xe7\x98_\xdfd\xa9\x94\xafk\ue104\xc7e\x7f6d\xb4lǅ\x97\xea\xef&\b&\xeb\x8f+\xb2t(\xbf,=R\x91\xff#\x04\xb7\\7\xd2\x1e\xe8\xfb+\xf7ڮ\xc9h\x85\x8aC\xa0x\xf7\xc6;5\x18\xb7A\xad\xed*\xc0\xb8\xc0\xa8\x9d\xb6\x12ޡ\t\xbcl\xccKmS\x8b\xae\x18L\xdeơ\xae\xce:\xbe\x10\n\xe7\xe3\xf0\x8bs\x88\xe1\xe2A\xb1 kYa\xd9^J\x8a\xb6g\a\xd7\xf5\xab4\xfa\xe7}\xbf9\x93\xbb+x\x02\x98\x85\xf3\x13\xc0\xdc\x17\xf4\xf3\xaeH\xe3\x1e_\xba\xaaNw\xf8\x83\x84m1\x81\xe5/mo\xa6\x04\f\x82;f\xfbC\xc3\x7fEv\xf4\xd8[\xd0w$\xac{\b\x8c\xeb\xae\xda1\x1a\xd7\xfd\xfaPk?\x05\xa8\x1f\xbd\xed\xf8]\aq~;\xd6\xfd\xb2\x93\xbd5\x00\x7fM\x1a\xc2\xcb\xf6g\xd7\x04\xdd[\xe34\x8a\xc9w\x86 >\xb4\x8b\xee\xfd\xfd\xf8\xc8V==\x9d\xfc3\x00i0\x9dg\xc1\x0e\x00\x00",
+		hash:  "5856201dabad14f54a23c4303c08e08292b649c2954b23c1a4eeff65471032da",
+		mime:  "text/html; charset=utf-8",
+		mtime: time.Unix(1792390434, 0),
+		size:  3777,
+	},
+	"index/processlist.html": {
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xccUMo\xda@\x10\xbd\xf3+F\x9cZ)Ȋ\x14\xf5\xb4\xb1D\x94T=\x14\x11A՞\x17\xef\xc4\x1e\xb1\xdeu=c*\x84\xf8\xef\x95?\b\x06L\x1ch\x0e=\xe1}\xef\xed\xdb\x19\xcf\xf3\xb2\xd9\x18|!\x870\xccr\x1f!\xb3%\x96\xe1v;P\x8c\x91\x90w@\xe6~\xc7}/9\x88\xacf\xbe\x1f&dp\x18\x0e\x00\x00\x94\xa1\xd5\x0e\xce\xfd\x9f\x06=f\"o\x8b\xd4q\x8b\x05\x00P\xc9m\xf8\\\xdbC\xe9\x0f\x8aSmm\xf8\r)N\xcaU\xa6\x9b\x1a\xec\xc8,\x92\n\x1d\x86*(\xf1\xf0\x06Rr\x85\u086c\xc6Z\xa2\x036\xf2if\xb1ū\xa0>Q\x05\xc9\xedQme\xf5\xcd6\x8b1:sT<\x004\xe6M\x8f\xe5\xe9\x1cC\xfd3j^\x1b\x9a׳\xe0y\a]⣣e\xdbc\\.o\xc0y\x81\xec\x1a\xbb\x94\x98\xc9\xc5{\xc3I\r\\\xd5Z\t\xc8:\xc3\xd1\xd3t\xb2w|\x9aN\xfe\xcd\xed\x91r\x8c\xc4\xe7\xeb\a\xeb\xa3\xe5\x9cb\xa7\xa5\xc8\xf7C\x83Ǉ9\x1dV\xac\x02C\xab\xf3\x03\x14Jђ\xab,\x0e\x84\xade\xfb\xf1\x92LC\x8a\x86\x8at\xf4\xe5$\xdcw\xe1\xb4\x10\x16\xed\f\xb9\x18f\xf8\xbb@\x16VArw\xa4\x14\xbd\xb0\xb8\xab5ot]q\x93\x04\xb59\xc5k.\xef&\x9a\x8d\xe1ω\n$y[S\x7fx\xfd\xba_\x9a\x04>\xf1\xe7~\xe5\x0fJ\x91a\xccK4\xe7\xc5*\xe8*^\x05g\xdaU\xb2\xf0fݹ\xe1\x94PA\xf5v;G~\xc5D\xab\xe8u\xcd0\xdb_TL1\x97I\xcb>4g\x1d\xc5\xcc0B'\xf0U\x17\xb6?W/\x95\xea\xa3S5\x17\x9d\xcb[\xb3\xbd4Z\xef\x89i\xd50\x1a\x98c\xbe¼_?.\fɻ\xd53\xd4\xec]\xbf\xee\xf5b\xe2~\xed\xb4\x90ȧ\xf8\xff}\x02ͣ\n\x9a\xbf\xfcp\xb0٠3\xdb\xed\xe0\xef\x00~\xc9\xd4\xe6\x1e\b\x00\x00",
+		hash:  "5257570fea5e0683a1a2f2fad41435ea23c82f5ab8a2b4007673ad05bee2b279",
+		mime:  "text/html; charset=utf-8",
+		mtime: time.Unix(1792387449, 0),
+		size:  2078,
+	},
 	"index/transactionsummary.html": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xc4V\xddn\xdb:\f\xbeN\x9e\x82P\x81\x83s.\f\x9f\xeerS\f\xaci\x8b\x16\xeb0`\xe8\v(\x163\v\x95%C\xa2\xbb\x1aA\xde}\x90\x1c\x1bn\xfe\xeat\xe8\xcfMe\x92\x9f\xfc\x91\x1fI\aV+\x89Ke\x10\x189a\xbc\xc8IY\xe3\xeb\xb2\x14\xaea\xeb\xf5\x94{\x8c&Pr\xf6,\x84eS\x00\x00.\xd5#\xe4Zx?c\xce\xfe\xdeX\xb7=\xb9\xd5u\xd9c\xfa\x88\xe2<\xbb\x1f\\\t\xff\x88\xb2\xfa\x02W\x86\x9cB\xcf\xd3\xe2<\x9bN&\x13^\xeb\xee\x1e\x12\v\xcf@\n\x12I8FR\xf8$\xcaJc4\xb0\b\x98p\xad\x86\x88\x84\x14i\x04\xe5\x93\xf0\xa2Gd\x19\x17P8\\\xce\xd8Y%̵\xc8\xc9*\xe9\x19\b\xa7D\xe2QcN\x18ӭ\x91e\x9d\x9b\xfbJ\xb4ep\x98\xa3\xa1d\xd9:\x12\xb2$4\xcb\xfe\xfd\xff?\x9e\x86\x98\x8c\xa7\"\xe3\xa9VG\xc8lQؤ̲y!\x94I\xc3c\x03s[\x96\x8a<\xec\xbc\x18\x83\xfb\xd8ka\xebo\f\x85K\xe50'\xeb\x9a\vm\xf3\a\x96\xdd\tO\xd0\x1b!Z!\xd9%#\xbb\x90d\xd1\x02\xf7\x15\x81\xa7\xb5n\x0f\x83\xa6\x88Trk\b\r\rD\xedL\xfb\x95\xdd\xc6W\u00a0\x1eH\x1b\xa9\rE\xddS\r\x12\x8b\xd0\x0emC?\xdd)O{\xa2\xda\xc8\x02\x85\xdc\xefk\xfd\xee\xb0ss\xc1\xb0\xc3\xe1\xf6\x92\xa7T\x8c\xc0\x04m\xe1\xd6T5\x8d\x03\x9c\xb5\xc1\xf0UJ\x87އ\xe9\x19\a\xfbQ\xd3\t8\x9e\x1e\xca8\xe0\x0e֊\xd3\xc2ʦ\xf3\x1d\xc2\x0fc\x9e9\x82\\\x1b\xf9S\xa9\x1e\x8fuB\xaf\u007f?Q\x1f-\u007f;\xcb7\xc2\x17\xe3$\x89\x1b`t\xa3\\\xcdan=\xbd\xa9j\u007f\xaf\xd7N\xccq\xed\xb6W\xd1\aK\b\xb9\xd5a\xa5\xcdا\x03k\xf1\xd6,\xad+E\x18\xf1w\x98\x9fWe!\xb3o\xd8|\xff\xf9\x99\xa7$_\x8c\x8d\x95\xbd\xbc\x88\x88\xf8\x99\b\xcf\xf1sW&\x1e\x85ˋD+\xf3\xc0\x80\x9a\ngL\xf6\x9b?\xac\xfcc\xf7\x1f\xce\u007ft\x1a\x17V6pz.\x01\xd6\xe5\xf3\xd6\x14\xafk\xad\xe3ğ\xc40\xa0\x02\xe8\x1d\bޫ\x12=\x89\xb2:\xad\x84A\xe5\x1e\xfa\x0e4\xdb\xe1\xbaA\xf5\xab\xa0ә\xb6\xb8\xd7\xd3|y\xc3\xed:\xba\xafS\u007f\xea\x0e\x9b\xff<\xdd\xfc\x9cΦ\xab\x15\x1a\xb9^\xff\t\x00\x00\xff\xfff\x97\xc7~\x81\v\x00\x00",
 		hash:  "8a5c3afc8ccb05195c89284ff19c6370e4b5e7ab7889450aa99b31f429396e16",
@@ -86,6 +100,13 @@ var staticFiles = map[string]*staticFilesFile{
 		mtime: time.Unix(1479232354, 0),
 		size:  2945,
 	},
+	"searchresults/entrydata.html": {
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xd4V_o\x9b0\x10\x7fϧ8\xa1io\t\xafS\xea \xb5\x90)\x99\xa6\xee\xa1\xdd\ap\xf0%x5&\xc2\xd7.\x11\xe2\xbbO\xb6\xa1\x81\x86\xac\xb4\xea\x1e\x96\x87\b\xf0\xfd~\xfe\xdd\x1f߹\xaa\x04n\xa5F\bPSy\x14\x9cxP\xd7\x13&\xe4\x13\xa4\x8a\x1b\xb3\xf0\vS\xb7\x02\xa8\xd3BH\xbd[\x04U5Kp\xcb\x1f\x15\xd5u\x10M\x00\x00\x98\xd9s}\x8e\x9a\xb6 \xd3\xd8\xd9_U\xc9-\xcc\xd6\xe6\x1e\x0fT\u05ccw\x98\t\x0f\x14D?\xef\xbfN\xbf\xb0\x90GU\x85Z\xd4\xf53\xb2g\x9b\xe1!\x88Vx\xb0\x86g\xdc\xdf\xee~ܾ\xe0\xfee\n\x1dDv\xe1%5\v\xad\xfah\xd2%h\xc4\xedK\x1c\xf0\xcaˬ\xaa\x8cr\x05\xb3\xc64ܗا\x1d\x06;\xddU5[\xe1\xa1EM\x06\xa4\x0f\x83\xbd\x13\xed\u038diog\x16\n\xf9\x14M\xda\xd7ɋ,\x13\xdf(tivO \xc5\"0\xc8\xcb4\x9b\xfa\xa5&\x9f\xb4)\xc4\xf1\x14WF\xe5\xe9\xc5\x7f\x10\xd1\xd2\x12\u008a\x9bl\xceB\x12\xe7\x06\x8c;\xfe-O\xa9ȧ\xcd6J\xea\x87\x00\xe8\xb8\xc7\xc63\x1f\rn2\xeb\n\x8f\xfaT,\xa4\xf2\x15\x19qƥ\x86u\xf2^\x11\xa9\xc5gȅ\x13\xe2\xd8\xd6\xc9;\xb5\xf8\x90ܨ\"}\xb8 ǧy\xe9L\xea\xfaUq\xb8\xb1\x86N\xd93Ɨ\xaf2X\u05f7\x05\xc1\xb6xԢ\xc9\xf7\x9b\x05'\xb2Ĕ\x8aQ\xa2\x93\xb1\xa2\xc5It\xd2\x11\r\xcc\xe4\\\xa9h\x85r\x97\x11\xb83`\x9f\xec\xaa_\xf90\xb7\xe2\"\xcf%\xfd\xd5\x1bo2&\x05)\x95\\\x1b\x9e\x92\xf4\x87\xef\x04\xfd\xc8L\xf8҉K\x14\x92\xc6TP<\xba\x84\xd2N\r\xc5\xff\xa2\x88\x96\a\xc2Rs\x05\xeb\xc4\\\x10\xdd\xfb\xe0\xdb]\xc9\xf5\x0e\xe1\xd3:\x81\xf9\x02f\t\xa6\x85@\xb1<\xd0:1\x9d\x9e\xdf\xc7\x10\xe6{ũ7\xb3,\xc5\x00\xa0\xf5n\x90\xe9\xb6\xd08\x84荛\xc6\xfb\xb7V\x9e&\xd446\f~p\xda\x04\xfa.\x9fz\xf8\xd4<\xe69\xb7\xbd\xb1\xe1\x83;\xffa\x0e\x8cG\xcd9\xbaˊ\xdfp\xadT{zz\x93\xb0\xfd\xb1M\ta\x04S\xb89\x12\x9a9\xb8\xf2u\x94\xdfQ\xef(\xab\xebːvo\xd7\xe2;H߬/\xe3\x961ą!p\x98el\x9f\x87\xcc;cwLH\xecD\n\xc0\xd0Q\xd9\x16#\xcd^\xf1\xe3\\\x17\x1a\xaf\x82\xe8Z\xa9V\xed\xfc3\xe6f\x7fu\xfe߉\xdcJ\n\xfc\x9f#\x97\x95\xd1d\xe4\xe1h\x0fV#`d\x1e.\x95=\v\x9b\x8b\x01\v\xddu\xe1t\xd1\xf83\x00/<\xb0`N\n\x00\x00",
+		hash:  "740e2dad3273bc3cf52aad62dcca7630cb296b9fe888710b47f8983869a4aebf",
+		mime:  "text/html; charset=utf-8",
+		mtime: time.Unix(1792390030, 0),
+		size:  2638,
+	},
 	"searchresults/tools.html": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xffD\xcbA\n\xc3 \x10\x05\xd0u<\x85\xcc\x01\xe2\x05Ի\x94\xc9\x0f\x9a\x8a)\xf3\xdd\x14\xf1\xee\x85v\xd1\xfd{s\x1e8k\x87\x97qߍ\xb2\x96\xdb\"\xd5\xeakx\x9a&\xb9\x18\x88\x87i\x01×\xec\x17%\xc7\xf03\xd9m\xb1\xd5\xfe\U00106584\xe3\xdd\xc0\x02\f\xf1\xc5p&Q\xfe\xfb\xae\xa4d7'\xfa\xb1\xd6'\x00\x00\xff\xff\x01\xea%yy\x00\x00\x00",
 		hash:  "a98871ca472c8a4b10c9916ad166c30071ff142d7e9e52290254dd7f836e036a",
@@ -115,11 +136,18 @@ var staticFiles = map[string]*staticFilesFile{
 		size:  1781,
 	},
 	"searchresults/type/chainhead.html": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xecV\xcdn\xe20\x10>\x87\xa7\x98\xb5\xd0jW\xda4\xdbkj,\xb5\x80T\xa4\xbd\xf5\tL<4\x16\xc6\xceƦm\x14\xe5\xddWN\x1c\x9a\xa6@iϛ\x03?3\x93o\xbe\xf9\xb3\xa7\xae\x05n\xa4F YΥΑ\v\xd24\x93\xa8\xae\x1d\xee\n\xc5\x1d\x02\xf1B,[1\xfd\x16\xc7pgD\x05q\xcc&\x11P\x8b\x99\x93F\x83\x143\x82/\x852%\x96\x84M <T\xc8'\xc8\x14\xb7vFJ\xf3<Ќ\xb5\x99Q\xfb\x9d\xb6#\x8b\xba.\xb9~D\x98\xca_0E\x85\x90\xce\xe0\xaaiZ\x9b(\x8a\xeaz\xba\xf5\"\xfc\vS\t\xbf\x87\n\xb9\x81\xe9\xf6U\x10Q\xbb\xe3J\xb1y\x1fe\n\x94\xb7\xac7<sf\x17[\xe4e\x96\xc7J\xea-\x01W\x158#\xb8V&\xdb\x12V\xd7\xde\xf5\xd5\xdch\x87\xda]\xdd#\x17MC\x13\xcehҁ\x1e(GQ\x044\xbf\xee\xbc@p\xb9Z\xa4?\x02\xc4J\x17{\xd74?\xfb\x17\xa9-\xb8\x06\xeb*\x853\xb2Q\x86\xbb\xb4\x94\x8f\xb9#\fFN\xff\xa0~ty\xd3\xc0R\xbbR\xa2\xa5\x89\u007f\x95\xd1$\xbf\x1e\xba\xafkT\x16C\u061d\b\xa8\xe3k\x85m\xac!\xc8V0\xcatkL\xddڈ\xea\x88\"\xa2\xae<&\x8e\xa8\x13\xccS\xaa\xe0\x9e\xdb<\xa5\x89\x13'\xed>N\xb8\a:\xe4;$\xabK\xf4qX\x9a\x1c\xa7u\x96\xed\x8b\xc3Rs\x05\xab\x85=\xcfw\x12\x85\x87\xee\xd5\xe1Oו\x10\xdar\xb5\xf0\xed\xf7\xa6R\xcb\x17\xb7ZX\xf0\xd3\xf2\xfaF\xdb\xedJvc⣌1Ј\xa5h#^-|\xa8J2\x18yB-\x06`4٫ㄿ\x96\xa2\x1e\xd6\t\x16\x028\x91\x937s\xfb\x91Ak\xd4\xf6\xf6k\xc0Y\a\x1f\xdb\xfdn\xc7}\x99\x83?x\xe8\x04~ Y\x18\x99\x87\xdc<íR\x879I\xf8\x05\x0e\xd7%$\fb\xb8\xab\x1c\xdat<A\xe1\xbb\x1f\xa4\xcb\xe1z\x9em\x83\x9f@\xf5\xba\xcf`.\xe707\xd6\xc1;\xbc\xe5\xdc\xcb/\x81\xea\x0e\x00\x00\xf8r%\xfc\xac\x93\xfe\xf4\x11\xd2\x16\x8aW\xa96\x1ao\b\xbbU\xaa\x0f<\xfd\x8e;[ܼ\xff\x1c\x14\xec^\n\xfc_\xb0\xf3Py\xf9qJ\x8e\x93\xbd\xbc\x1dΏ\xed\xe7\x8e\b\x9a\x9c\xb8\rh\xd2^ \xa3{G\x8b\x11\xcd {ӟ4\x11\xf2ɟ\xa5\xfd\x0f\x9a\x84\r\x82\x85\xe5b\xa9\xc5`\xc1\x18\xae!6+e\xe1,\tn\x86*g\x8c\xb2\xef\xf6\x96\x8d1\xae\xdb[\x02\x95\u007f\x01\x00\x00\xff\xff\xbf\xbd}\xb8\xed\b\x00\x00",
-		hash:  "9cae6493220b9658790e7c71938d38470ca1f300364642f144844d5511d8de55",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xacS\xc1\x8a\xdb0\x10=\xc7_1\x15ei\xa1\xb6\xd9k\x90]hwa\xf7\xd2\xf6\x17\x14k\x1c\x8bU$#M6\rB\xff^,\xc5\xc6$9\xf4\xb0>\xd9of\xde{\xcc<\x87 \xb1W\x06\x81u\x83Pf@!Y\x8c\xc5&\x04\xc2è\x05!\xb0\tD\x97`\xfe\xa9,ᇕg(˶\xd8\x00\xf7ؑ\xb2\x06\x94l\x18\xfe\x1d\xb5u\xe8X[\xc0\xe5\xe1R\xbdC\xa7\x85\xf7\rs\xf6\xb4\xaa\\W;\xab\x8f\a\xe3\xaf:R\x97?\b\xad۟\xb3\xbf-p\x91\xf4zё=\x94\x1e\x85\xeb\x86R+\xf3ƀ\xce#6\fw\xdavo\xac\r\xa1zA!c\xe4\xb5hy\x9d\x89n\x05\x86\xc7\xcc>K\xbd>m\xbf\x84P%\xec\xf5)Ư\xf3(\xaf\x87\xc7\xdb\xf9\xf5\xae\xd2\x16G\xb1GϠ\x8a\xf1N\xaf\x13f\x8f\xf0\x195¶\x81\xeaِS\xe8\xef\xb4^S\xa3!w&\xb1\xd3\xc8\xd2\xf8]v4\xf2.\xfe_\x0ey-\xd5{[l6\xf3\v\xaf/\xf7m/\xa7\x7f6ru\xfe5\xad\xef\x9c\x1aɳ\v\xe3\xbaD\xd6j\x7f\x93\xaa\xdeZʩ\x9a]\x17Wa\xcc&c,\xf8\xb8\xa4d\xc2\xcb\\h\x7f\xe1\t=\x01\xe6\rB\xaf\x9c\xa7o0\x15!\x84\xea\x8f\xd8\xe3bF\xf5P\xbd\b?M\xb8\x18\xb9\x98\xf9vG\"k\x80\x9493\x18\x1c\xf6\r\xcbq\xfa\x9e\x82\xb4\xfc\x13\x0fʌGj֡x\x98\x84&$\x91f\xb5\xec\xc9Mi[\x9fb\xd1\xff\xad\xe5\xc7\xeb'\xd2Y?}\xac\xf5y=\xb6ˆ\xff\r\x00[\nz\xae\xee\x03\x00\x00",
+		hash:  "c5a1335ba9231ce55d72a739d172d5f9c69802b3ed7e5a797c26df39ad4f46c9",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  2285,
+		mtime: time.Unix(1792392443, 0),
+		size:  1006,
+	},
+	"searchresults/type/chains.html": {
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xa4T\xddj\x1b=\x10\xbd^?\xc5|\"|W\xb5E\xa0WEV!MJ\x02%\xbd\xc9\v\xc8\xd28+*K\x8b4Ij\x84\u07bd\xac\xb4v\xd65.\x94\xdei~vΜÙ\xcd\xd9\xe0\xd6z\x04\xa6{e}b\xa5,\xba\x9c\tw\x83S\x84\xc0zT\x06cM\x8b\xff\x96K\xb8\tf\x0f˥\\t\"\xa1&\x1b<X\xb3f\xf8sp!bdr\xd1u\xc2\xd8W\xd0N\xa5\xb4f1\xbc\xd5\xdcIR\a\xf7\xb2\xf3\xa9\x15:\xd1_\xcb/\x15\x1dD\xda)\xe7䛥\x1e\xd0S\xb4\x98\xc0z06\xa2\xa6\x10\xf7\xb0qA\xffH\x90\xf3\xea)\x94\x02\x14\xc6\xe7\xd7\x18v\xa5|\x80]H\x04\x115z\x82\xad\x8d\x89\x04o\xf3\x04\xef\xaf\x1b֜Z물\a\xf5\x8c\x89\xc1\xaa\x94\xb6\x11\xa9\x8d\xc3J,\xa1\x8a\xba_\xd6Ĵo'hTe\n:A\xf1\xf0\x1c+\x8d\n<\xdc\nN\xfdI\xe1\x9bJ\x047#\x81?\x94\xe0\xc9\xee\xf0\xac~\xe7)\xee[C\x9a\x17\x05?\x82\v>\xdfJ\xd0&\x98\xfd\x14\xe4\x1c\x95\x7fF\xb8\xd2\xf0i\r\xab\xa6\xf5D\xf5\xf7\xfd\x8d\x14\n\xfa\x88\xdb\x03\xf5ϴ\x1fp]U\x1a\xe7\xffo\xfd\xf0B뜯t\x1b\xf4p[\n\x93\xa7\xb1\xe0J\nN\xe6dnm\x19\x89ޣ}\uea54\xcb\x1d\xa3\x06\x97\xeawM\x85\xd3\xf2L\x87\x9c\xd1%\xbc\xc4\x0etpiP~\xcd>2\xf9\x18\xa0\xb9\x1eze\xe6~\xa3\x1e\x13N^\xbb\f\xe3\xcd\x01E\xf0\x99܂W\xb3\xfc\x85\xdf\x047\xf6U.\xde\x1f\x82O\xb7%\xa7\xab\xbb\xf3fvy\xf3\xa1IG;\xd0\xf9\xddR\b\xee<\xbb\r\x81\xda5\x1f\xf6_\xbc\xff\x01\xce\xf6+e!\x86\x8ag\xb7\xb0\xbaW\xe9\x11\xdf0\x96\"\xd4\xe1\x907/D\xc1\x03Y\xbfg\x97\\\x93\x8e\x96Y\xd5\xef۵2Y\x83\xd1)G)\x8f8ߝ\xf9'\x9c\xfa\xfd\x01\xa7\x06s\x1c\xc1\ay\xe4\xffk\x00\x1d\xa7\xb3\x15\x01\x05\x00\x00",
+		hash:  "494677f4cf249cc5244656818d0da93a3382cff378ec7bac7d4a588ee8ef6d09",
+		mime:  "text/html; charset=utf-8",
+		mtime: time.Unix(1792390030, 0),
+		size:  1281,
 	},
 	"searchresults/type/dblock.html": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xd4W\xcbn\xdb:\x10]\xcb_1W\xc8\xf2\xdaB\x90]@\v\xb8\xb1\x13$\xb8(P\xb4\xf9\x01Z\x1cGD(\xd2 鴂\xe0\u007f/\xf8\x88\xad\xf8!?\x9a\xb8\xa9V\x02\xe7px8g8\xe44\r\xc3)\x97\b)\x9b\bU<\xa7\x8bE/i\x1a\x8b\xd5LP\x8b\x90\x96H\x19j?L\xfe\xe9\xf7\xe1F\xb1\x1a\xfa\xfd\xbc\x97\x001XX\xae$p6L\xf1\xe7L(\x8d:\xcd{\x10?\xc2\xf8\v\x14\x82\x1a3L\xb5\xfaѲ\xac[\v%\xe6\x954i\x0eo \x1eV^\xe6c\xae\xb1\xb0J\xd7p\xe38\x92\xac\xbc\xcc7\x81\x96N\x04n\x8e\a\xdbD\xb1z\xbb-\xd8\xf5nc\x00\xb0\xfc\u007f\xac\xbf|\xbb&\x99e\xfb\xb1M3\xf0\xf0Ţ\x1bO\xb2\xae\x95\x0f\xa2\xe5\x059\x92۽\x17uঞ\x85\xe3\xdd\\\b\xb8\xa7\xa6<\x9c\xa2\x9b\xe2f\x9c\x81\xdd#\xaf\xf0\xbb\xa5\xd5\xec\xe8\x00\xde)]Q\x8bl\xe9\xe1\x1cz\xbb#\x00\xf7ȟJ{4\xe1\xf1M\x98x\x06\x9e_5\xbep57\xb0vz\x0f\xe4\xdc\tp\xdfҿ\xcf}\xb8v\xf3\xa8/ESZXU\xf5\rR]\x94}\xc1\xe5s\n\xb6\x9e\xe1\xf0\xb5Ƶ\x02\xe2\xbc,O*\xcd\xc9DCv\xc4\xda\xcb\xc4\xf6\xeb\xbfu\xbb\xca\xe0\xee͞(\x04\xc9v\x145\x92\xed\xa8\x84\xa4\xbc\n\xd9c`\xa4\xa4\xa5\\\"\x03.\x83,@LE\x85hW\a7<Rsi\x17\x8b\x801$\v \x92\x95W\xdbj\xb5_\xd8k\x10\x83\xef\a\xd2M*ɮ\x8a\x9c$[\x93+q\xe3,\u007f\xacg\xb8#\u007f\"\xe2?V\xbd\xeeh\x17n{H\xbb\x17v)\xa2\xbbWޛ|t\x95|\x9e\xa5'\xe9\xaf\t\x1d\x93\xef8\xc2'$\xc0\xc1\xfa\xbcۥ١\xd8:\xf4VZ]\xc3H#\xe3\xb6K\xc1w\xacQ]\xb2\xaec\xf7\xea\x8b\xc5J`\xbf\x97\xb0\x95\x03e\xfe\x90\x03\xff\xa9\xf5\xbes\x91\xe4\xeco\x94z\xbaR:\xee\xe2\x0f\xaa\xdc4\x9a\xca'\x84\v\xfe/\\\xa0@\xb8\x1e\xc2\xe06\x14\xec\xd6ݓ$\x9f\xa1>\x87C\xfe)\xeb3.EuQ\x1c\xb4_\x05G2\x85.\xaa\xfb\xde\xc2\x11\x16Y\xec{\x06\x9fHbTR.\xe1a\xfc\x9b!+\x9c\x1b\xd7!.\xa3\x16\xdf\x0e\xde\xff\xc3\xf8\xb4\xf0uf\x98\xb2T\x80\xcb\"\x8e\xe6\xa0\bFF\xa1\"\x87\xd7̇]\xb4I\xd24(\xd9\xdb\x17\x1f\xc9\x18\u007f\xc9{\xce}\xf8!Yl\x9c\xf3\xd8S\xdfJ\xd6\xea\xab\xdbݷ)4\x9fY\x93F\x8fm\x93UJ\x98\x8dv}\xaa\x94\r\xedzd\xf2+\x00\x00\xff\xffq\xd0\xe5\xf7\xe1\x0f\x00\x00",
@@ -129,11 +157,11 @@ var staticFiles = map[string]*staticFilesFile{
 		size:  4065,
 	},
 	"searchresults/type/eblock.html": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xbcV\xdbN\xe30\x10}N\xbfb\xd6\xe2q\xd3\b\xf1\xd6u#\x01eE\xb5BZ-_\xe0\xc6Sb\xe1\xda\xdd\xd8\x01\xaa\xa8\xff\xbe\xf2\xa5%-\xe9U\vyj\xed\xf1̙3\xe3\xe3i\x1a\x8eS\xa1\x10\bN\xa4.\x9e\xc9r\xd9K\x9a\xc6\xe2l.\x99E %2\x8e\x95_\xa6\xdf\xd2\x14n4_@\x9a\xe6\xbd\x04\xa8\xc1\xc2\n\xad@\xf0!\xc1\xb7\xb9\xd4\x15V$\xefA\xfc(\x17/PHf̐T\xfa\xb5\xb5\xb3\xbd[hYϔ!9l\x98x\xb3\xf22\xbfS\xb6Z\xc0\x8d\xc3G\xb3\xf22\xffhd\xd9D\xe2\xc7\xf5\xb07\xd1|ѽ\x17\xf6\xabݛ\xc1\x80\xe7\xbfp\xf1\xf0g@3\xcb\x0f\xdb6Mߛ/\x97\xfb\xedi\xb6/\xf2Q\xb0~\xd6R\xc2=3\xe5\xf1\xd0\xdc\x11w\xe2\v\xd0ݖL\xa8\xf1\xe8Hl\x94\xf9>\x9a\xb2\xc2\xeaYj\x90UE\x99J\xa1\x9e\t\xd8\xc5\x1c\x87\xa4p\xee\\;\x12\x97ǽ\xef\xcb~\x8c\xe1\xb2a\xf9\xa7g\xe4[\x10\xeeQ<\x95\xf6x\xca#\xd4\xd1M8\xf8\x05\xcc\xff\xae\xf0E\xe8\xda@\xeb\xe6\x1c\x89w\xaf\x81\xfb־}\x93\xfb\xa5\x81;{\xa8|Q_Z\x848O\xeb\x9b\xc2r:\xa9 ;!\xfe\xba\xf9}\xfcM\xb7\xef]\xbe?\xe13\vA\xb3\x1d\xa2B\xb3\x1dJD\xcb+/c\x02\r\xdcje\x99P\xc8A\xa8P\x1b\xa0fƤlq\xe3\vw\xabke\x97K\x88\ai\x16\xachV^u(e\xd3TL=!\\\x88\xefp\x81\x12a0\x84~<\xda\xc1Cӈ)\xe0_o\xda\xf74\x92\a\xa1j\x8b\xf0\xc0\xaa\xe7 \xf9\xd0-\xb4\xbeұ\xc4~\x81|\xa2\xf4n\x80:\xf6\xce\xf9\xa4Z\xda\xf0Uen\x1a\x94\x06[\xcc%\xc9\t\x9c%\xbb\bK\x92N\xaa\x12\xb7\xce\xe3\xf3\xb8\xe7\x19\x88v\x87\xaf\xa8sDV\xf4\xad^\x89]\xba\x9a$\xdd\xcc\x1d\x00\xfbf\xb1RL\xc2xd\xf6\xc3\xed%\xf1\xa3\xb5\\\xff\t$C\xec\xf4\xf1\xc85\xb9G{\xf7f\xc7#\x03nNy\xb7\xf4\xb5\x92\"\f(.\xb9\x14c\xf8T\xf8g\xe4\"\xb4\x87\x149lE@\xc5[\xceh\xe60t\x01=\x8f\x9a\x95[\xf7JjeQ\xd9\xff%\xcf\xd4̙j%\\\x04\xf7\xa9\xa9g3\xe6\xaa\x1b\xe3\xc1cX\x18\x00ey\x94\x9f\xc7R\xbfµ\x94\xefB\xb3R妱U\xad\n7\x11\x86\xab\x15\x9c8\xee\\\xbc\xf3a\xb9~'`\xecB\xe2\x90pa\xe6\x92-\x06J+\xfcA\xf2k)a\xc5\xce6ʈ\xbe\v\xe9\xe9\x00O,\xe2Y\xb2\xa0\xf8\x86*l\xaf\xf8\xd3\\\xbc\xb8F_\xfd\xa0Y\x1c\xb0\xf38{\xdf)ޚ\xbf\xdbS\xba)*1\xb7f\xa5\xd9\xed-\xab\xb54\x1f\xc6\xfa\xa9\xd66h|D\xf2/\x00\x00\xff\xff+Q\x86\xa5\t\f\x00\x00",
-		hash:  "909c9767e9d7b7300eb04417ff26ad99ea315b9e7bad9f5c9f66c0ea34e62167",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xbcW\xddN\xf38\x10\xbdN\x9fb\xd6\xe2r\xd3\bq\xd7u#Qʊj\x97\xd5jy\x027\x9e\x12\v\xd7\xee\xc6.\x10Ey\xf7Ov\x9c\x92\xfe\x87~\x1f\xf4\xaa\xb2Ǔ3g\x8e\xcf$U\xc5q!\x14\x02\xc1\xb9\xd4\xd9\v\xa9\xebATU\x16\x97+\xc9,\x02ɑq,\xfc2\xfd-\x8ea\xa2y\tq\x9c\x0e\"\xa0\x063+\xb4\x02\xc1\xc7\x04\xdfWR\x17X\x90t\x00\x00\x00\x00@\xb9x\x85L2cƤ\xd0o\x9d\x9d\xdd\xddL\xcb\xf5R\x19\x92\xc2V\b\x00\x00ͯ\xd3{e\x8b\x12&\x0e\x1fM\xf2\xebt?Ȳ\xb9\xc4\xfd\xf5fo\xaeyyx\xaf\xd9/\x8eo6\x01<\xfd\v\xcb\xc7\xffF4\xb1\xfc|lU\r}x]\x9f\x8e\xa7\x89-~\x12֟k)ၙ\xbc?4wĝ\xf8\x06tw9\x13j6퉍2\xaf\xa3\x05ˬ^\xc6\x06Y\x91\xe5\xb1\x14ꅀ-W8&\x99K\xe7\xe4H\\\x1d\x0f^\x97\xc3\xf0\fW\rK\xbf\xbc\"/Ax@\xf1\x9c\xdb\xfe\x94\a\xa8\xd3Is\xf0\x1b\x98\xff\xb7\xc0W\xa1\xd7\x06:7\xa7'ޓ\x01\x00\x00\x9b\xdc^\xe4\x00\x000\x02\x80\xb3\xed\v\xfe\xd2!\xc4e\xda\xdc\x14\x96\xd2y\x01\xc9'\x9e\xbf\x11?\x8c\x00`;\xed\x87\xcaO\x17|a#hr\xc4Thrĉh~\xe3mL\xa0\x81;\xad,\x13\n9\b\xd5\xf4\x06\xa8Y2);\xdc\xf8\xc6\xdd鵲u\r\xe1 M\x9a(\x9a\xe47\a\x9c\xb2\xaa\n\xa6\x9e\x11\xae\xc4\xefp\x85\x12a4\x86a8z\x80\x87\xaa\x12\v\xc0\xff}\xe8\xd0\xd3H\x1e\x85Z[\x84GV\xbc4\x96\x0f\x87\x8d\xd6w:\xb4\xd8/\x90/\xb4\xde-P}\xef\x9c/\xaa\xe3\r\xdf\xd5\xe6\xaaBi\xb0\xc3\\\x14}\x82\xb3\xe8\x18aQt\x90\xaaȭ\xf30\x1eO\x8c\x81\x10w\xfe\x8a\xbaD\xa4\xa5\xaf\x9d\x12\xc7|5\x8a\x0e3w\x06\xec\xbb\xc5B1\t\xb3\xa99\r\xf7\xac\x13l\x04?\x9b:\xad{\xd0S\xcc4G~\xffngSS\xd7gs4y>^w<\x05\x9cYF\\\xda\x1e\t\xf6:~\xea\xf7\x8fV\xd8'\xa3\xe2u}\x90\x96\xcb\x1a\x115??\x93\xb5\xb2\xa8\xec\xaf\x1a\x06ԬXx\xffs\xc4\xc5Y\x93>6\xeb\xe5\x929-\x85\xe7\xc1S\xb30\x02\xca\xd2`vO\xb9~\x83[)?l\xad\x9d\x01\x10ä\xb4hF\xd0\xde\xe4&\xcbߨ\x9em^װ\t\xf3\xa2߉ju\xeb\xa0]^\x81\xbb\x88\x04\x8c-%\x8e\t\x17f%Y9RZ\xe1\x1f$\xbd\x95\x12Z\"w\v\n\x85\xee\x17uLe\x1dՆ\x9c\xfd\xc0\x7fR\v\x17yٖ\x0e\xa3hwş\xe6\xe25\x1dDQ\xfb\x87&\xe1\xab \r\x1f\f\xf7\x8aw>\x1a\xba,\x98\xac\x10+k\xdaA\xd3ݲZK\xb3\xf7-\xb2\xd0\xda6\x83) \xf91\x00\r\xec\xa6n\xbe\f\x00\x00",
+		hash:  "ea7f49e8df067f54cb05a21a4dee9e11bceef11f7aae2f06cb12839eaaa76428",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  3081,
+		mtime: time.Unix(1792392443, 0),
+		size:  3262,
 	},
 	"searchresults/type/ecblock.html": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xbcUMo\x1a1\x10=ï\x98Z9vcE\xb9Ef\x0fP\xd4\x1cz\xe8_0\xeb\x01[16\xb2\x1dZ\xb4\xda\xff^\xf9\x83j[\u0605\x10\x89=y\xfdތ\x9e\x9fg<m+p\xad\f\x02\xc1f\xa5m\xf3F\xban:iۀ\u06dd\xe6\x01\x81H\xe4\x02]\xdaf_\xaa\n\xe6V\x1c\xa0\xaa\xea\xe9\x04\x98\xc7&(k@\x89\x19\xc1\xdf;m\x1d:RO\xa1|L\xa8=4\x9a{?#\xce\xfe\xea!\xff\xa3\x8d\xd5\xef[\xe3I\r\xffP\x12M>\xd5K\x13\xdc\x01\x16\x0e\x85\n0\x8f2\x19\x95O\xf5)7\xf0\x95\xc6\xd3\xfd\x8c\xad\xac8\x9c\xc72\xee\x86\xc1L\x10\xf5+\xf7\xf2\x85\xd1 .S\xdb\xf6q\xb9HZ\x1f\xbfc\x88\x81]7\x1e\xc9蘄\xab\xf4\xa5˹]d\xba긊y\xee$\xf9\x15\xd5F\x86O\xea\xfd6\xcfi\xee\xa0\xf7\xa7ý\xb2\xef\x1eN\x8b\xf2\xcaC\x8c\x12\x12\x89\xa7\x8eZ\xf3&\xd8m呻FVZ\x997\x02\xe1\xb0\xc3\xd9\xdf^\x1d\xf4#\x8a\xcc\u007f\xc7[\xe4\x17t\xddh\x1b\xa3\x03m\xc5\xe8P/2\xf9\x9c\x1aZ\xa1\x87\x855\x81+\x83\x02\x949c(0\xbf\xe5Z\xc7c\xfe@\xb3\t\xb2렄2\x9a!F\xe5\xf3\x99W\xa3<\x05\xc9\xc7b`\xda \xb7=\x0em\xeb\xb8\xd9 <\xa8\xaf\xf0\x80\x1a\xe1e\x06}狨\xae\x1bɠ\xd6)\xf4H/\xfd\xf5\xb9b̖}\xa0\xe1/\x97V\xcc\x18\v\xeb\x8c\xd6XEW\x14\n\xc0\x88\vhĨK\xc3\xf8\x87J\x8dQ\xa1\xf6\xf5t29.\x18-ê.sliDo\x96\xf5'\x9eo\x9c\xda\x05O\x8a\x8e>\x14\xac\xd5\xfedD\xae\xad\ryD\x16\xfd\u007f\x02\x00\x00\xff\xff\xfeґ\xadV\a\x00\x00",
@@ -150,11 +178,11 @@ var staticFiles = map[string]*staticFilesFile{
 		size:  1147,
 	},
 	"searchresults/type/entry.html": {
-		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xe4U\xc1n\xa3<\x10>ӧ\x98ߪ\xfe\x1bE\xbdR\a\xa9\rH\x8d\xb4\xb7>\x81\x8b'\xc5Zc#{\xd2\x06!\xde}eC\x14Z\x0eA{]\x0e\xd10\x13\xbe\xef\x9b\x19{f\x18$\x1e\x95A`h\xc8\xf5l\x1c\xef\x92a l;-\b\x815($\xba\xe8\xe6\xff\xa5)\xbcX\xd9C\x9a\x16w\t\xf7X\x93\xb2\x06\x94\xdc1<w\xda:t\xac\xb8K\x12.\xd5'\xd4Zx\xbfc\xce~E\xdf7gm\xf5\xa95~\n$\xbcy,\xaa@\x0e\xa5 \xc1\xb3\xe6q\xf6\x93x\xd78\xd9\t\xa7\xf7\xc0\x1c\xb8<\nW7i\x8c\xce\x10!\xee.f\xc2I\u0380\xaf\xc279\xcfH~\x8b\r\xc3C\b\x8c\xe32³+\xc2O\xb0}#\x94\x81C\xb9B\xe2\"*:\x8a\x9al\x9b\xce´2\xbf\x19P\xdf\xe1\x8e\xd5\xe1\xcbPC\x16X#Ρ\fĢ\xd8J^\x9d\t\x9d\x11\x1a\x0e\xa5_\xe7r\xb1\x13~\xd2\xd7\x17\x00\x80a\x00'\xcc\a\xc2\xfd\xa1\x84|\a\x0fՙ\x0e\xa5\x87\xd0\xca\xc5\xdf\xc2õ\x9a\xba\x18\x8a\x96\xe2L\x98\xaa\xa8\xfa~\x12\xacU\x01?\xf1\xd1\xc8%\x1c\xcf\x16\x1a6\xd7\xd6\x1aBC9\u007fw\x90\x15\xeb\xfc\xe0\xc6\xc3}'\xccB}=\xe1\xa5\xfeԶ\xc2\xf5\xecB\x00o\x93#\a.\n\xee[\xa1u\xf1\xd6\xd8/x֚g\xd3{h\xcbm\xc2(\x14Rx\xe9\t}\x0e\xa1\xaf\x13\xc5/4\x1fԌ\xe3v\x88\x8b\xb6xN\x17H\xd3\xf1\u070eS\xedao=AĨ\xf6\xc1\xde\xf2y\x16jW\x00\xc0_W9\\J\x06\x9ez\x8d;&\x95\xef\xb4\xe8sc\r>\xb1\xe2Y\xebK\x82\xf9\xff\xd8\xfa\xeei\xfd\xbbhƫ\x92\xf8/7\xa3q\xb7ӽ\x8a\xda\xde\xde\x1b\x17\x92gq\xb2\xce#7\xbb\xce\\\x9eI\xf5\x19\xc7\xf9l\xf0l\x9e\xf8ż\v*#\x17\xfb`\xb95|\xedTG~\xb5M\xc8Z\xbd\xf6\x1e\xad\xa5i\xc7\f\x03\x1a9\x8e\u007f\x02\x00\x00\xff\xff\x1a\x98\xf9\x91\x95\x06\x00\x00",
-		hash:  "a9b9fe61fc7a2385e955d54519ae2bb7a9da16621e812e7030e44ee0fc63100b",
+		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xffd\xd0\xc1j\x03!\x10\x80\xe1\xb3>\xc5Ի]r7\x1eJ\xf3 ֙\xb0\x82q\x16\x9d\xa6\r⻗\xec\x06\xba!7\xf9\x05\xfdfzG:\xa7B`\xa8H\xbd\x991\xb4\xea]\xe8\xb2\xe4 \x04f\xa6\x80T\xd7\xecެ\x85\x0f\xc6\x1bX\xeb\xb5r\x8d\xa2$.\x90\xf0h\xe8w\xc9\\\xa9\x1a\xaf\x95r\x98\xae\x10sh\xedh*\xff\xac\xed)F\xceߗҶ\v\xe5\xe6\x83?\xdd?\x87\xcf \xc1M\xf3a\xeb{Ɗ\x93\xf0\x95\xc9\xc0\xfb\x1d\xa3\x94\x9b0]\xbd\xfe?\xb8\xe9!\xf2\x0f\xeb\xa9\xe0λ\x7f\xaeŚ\x16i/\xd3\ns~\xadgf\xd9v\xd0;\x15\x1cC\xff\r\x00\x11\x8c!\f6\x01\x00\x00",
+		hash:  "91586d06ea0ec898647c8cdc0120c1b043c92eeab22cc9fef1f25bea21b644fe",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1479232354, 0),
-		size:  1685,
+		mtime: time.Unix(1792390030, 0),
+		size:  310,
 	},
 	"searchresults/type/entryack.html": {
 		data:  "\x1f\x8b\b\x00\x00\tn\x88\x02\xff\xbcTAO\xf30\f=\xa7\xbf\"_\xee]\xb5\xeb'/\a`\x12w\xf8\x03^\x93\xa9\xd1ڤJ\xbcA\x15忣5\x05\x15\xb1\x8e\x1d\x80\x9c*?\xd7\xcf~\xf2s\x8cJ\xef\x8d\xd5\\hK~\xc0\xfa R*X\x8c\xa4\xbb\xbeE\xd2\\4\x1a\x95\xf6c\x18\xfe\x95%\xbfsj\xe0e)\v\x06A\xd7d\x9c\xe5Fm\x84~\xed[\xe7\xb5\x17\xb2`\f\x949\xf1\xba\xc5\x106»\x971\xf6)X\xbb\xf6\xd8ِ\x01\x06\xcdZn\xcf\xfc\xfc٣\r\x98\xab>\x11\xd21@լe\xc1/< ܵ\xfa2ƀvN\r\v \x03\xf2K\x10\x03R\xb9\x99G\f\xcd\u007f\xa8H]M\x05\x1c\xc7\xdfcM\xae+\x83F_7ek\xecAp\x1az\xbd\xc9\xc2\n\x19\xe3\xea\xa3jJP\xa1\xbcV\x1a\xaa\xa5\x0e\xe7\xf3\u007f\x9b2\xe5)y\xef\xba\xce\xd0$镡.\xfczK\xde\xf8b\\e\x9a\a$\\e\xaa\x94n\xa2\xb9\xa1\x9f\x9fV$\xef\xdbo\v2\xb2\xfc\xb1\x1e\xe7\xe5Y^~\xa8&ۜ\xf7\xb7R\xe64\xfau\xfa\x80j\xb2\xb4\x9c̾\xb5jf\xf8\xf9Y\b\xb57=\x05\xf1>\xd1\x1c#\xe7\xda\xf0\xe5\x90읣|Hb\xd4V\xa5\xf4\x16\x00\x00\xff\xff\xe2\x95\b\x0e}\x04\x00\x00",
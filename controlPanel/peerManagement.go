@@ -0,0 +1,106 @@
+package controlPanel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/p2p"
+)
+
+// Bans, ban history, the special peers added and peer reputations, for the
+// peers page
+
+type PeerManagement struct {
+	Bans         []p2p.Ban
+	History      []p2p.BanEvent
+	SpecialPeers []string
+	Reputations  []p2p.Reputation
+}
+
+type PeerManagementResponse struct {
+	Access string
+	Error  string
+}
+
+func getPeerManagement() []byte {
+	m := new(PeerManagement)
+	if Controller != nil {
+		m.Bans = Controller.Bans()
+		m.History = Controller.BanHistory()
+		m.SpecialPeers = Controller.AddedSpecialPeers()
+		m.Reputations = Controller.Reputations()
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return []byte(`{"Bans":null,"History":null,"SpecialPeers":null,"Reputations":null}`)
+	}
+	return data
+}
+
+// isPeerManagementMethod is true for the post methods handled by
+// managePeers.
+func isPeerManagementMethod(method string) bool {
+	switch method {
+	case "ban", "unban", "adjustquality", "addspecialpeer", "removespecialpeer":
+		return true
+	}
+	return false
+}
+
+// managePeers bans and unbans peers, adjusts their quality and adds and
+// removes special peers.  It needs the control panel to be read/write and
+// the admin scope.
+func managePeers(r *http.Request, method string) []byte {
+	resp := new(PeerManagementResponse)
+	DisplayStateMutex.RLock()
+	CPS := DisplayState.ControlPanelSetting
+	DisplayStateMutex.RUnlock()
+	if CPS != 2 || !controlPanelAllows(r, constants.API_SCOPE_ADMIN) || Controller == nil {
+		resp.Access = "denied"
+	} else {
+		resp.Access = "granted"
+		if err := managePeersAction(r, method); err != nil {
+			resp.Error = err.Error()
+		}
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return []byte(`{"Access":"denied","Error":"` + err.Error() + `"}`)
+	}
+	return data
+}
+
+func managePeersAction(r *http.Request, method string) error {
+	address := strings.TrimSpace(r.FormValue("address"))
+	switch method {
+	case "ban":
+		var duration time.Duration
+		if d := r.FormValue("duration"); d != "" && d != "0" {
+			var err error
+			duration, err = time.ParseDuration(d)
+			if err != nil {
+				return fmt.Errorf("Bad duration %s", d)
+			}
+		}
+		return Controller.Ban(address, duration, r.FormValue("reason"))
+	case "unban":
+		return Controller.Unban(address)
+	case "adjustquality":
+		delta, err := strconv.ParseInt(r.FormValue("delta"), 10, 32)
+		if err != nil {
+			return fmt.Errorf("Bad quality adjustment %s", r.FormValue("delta"))
+		}
+		Controller.AdjustPeerQuality(r.FormValue("peer"), int32(delta))
+		return nil
+	case "addspecialpeer":
+		return Controller.AddSpecialPeer(address)
+	case "removespecialpeer":
+		return Controller.RemoveSpecialPeer(address)
+	}
+	return fmt.Errorf("Unknown method %s", method)
+}
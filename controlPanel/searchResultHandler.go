@@ -27,12 +27,6 @@ var _ = htemp.HTMLEscaper("sdf")
 func HandleSearchResult(content *SearchedStruct, w http.ResponseWriter) {
 	// Functions able to be used within the html
 	funcMap := template.FuncMap{
-		"truncate": func(s string) string {
-			bytes := []byte(s)
-			hash := sha256.Sum256(bytes)
-			str := fmt.Sprintf(" - Bytes: %d <br /> - Hash: %x", len(bytes), hash)
-			return str
-		},
 		"AddressFACorrect": func(s string) string {
 			hash, err := primitives.HexToHash(s)
 			if err != nil {
@@ -85,21 +79,27 @@ func HandleSearchResult(content *SearchedStruct, w http.ResponseWriter) {
 		if entry == nil {
 			break
 		}
+		getEntryLinks(entry)
 		TemplateMutex.Lock()
 		err = templates.ExecuteTemplate(w, content.Type, entry)
 		TemplateMutex.Unlock()
 		return
 	case "chainhead":
-		arr := getAllChainEntries(content.Input)
-		if arr == nil {
+		chain := getChainPage(content.Input, content.Page)
+		if chain == nil {
 			break
 		}
-		arr[0].Content = struct {
-			Head   interface{}
-			Length int
-		}{arr[0].Content, len(arr) - 1}
 		TemplateMutex.Lock()
-		err = templates.ExecuteTemplate(w, content.Type, arr)
+		err = templates.ExecuteTemplate(w, content.Type, chain)
+		TemplateMutex.Unlock()
+		return
+	case "chains":
+		chains := getRecentChains(content.Input)
+		if chains == nil {
+			break
+		}
+		TemplateMutex.Lock()
+		err = templates.ExecuteTemplate(w, content.Type, chains)
 		TemplateMutex.Unlock()
 		return
 	case "eblock":
@@ -690,10 +690,8 @@ func getDblock(hash string) *DblockHolder {
 }
 
 type EntryHolder struct {
-	ChainID string   `json:"chainid"`
-	Content string   `json:"content"`
-	ExtIDs  []string `json:"extids"`
-	Version int      `json:"version"`
+	ChainID string `json:"chainid"`
+	Version int    `json:"version"`
 
 	Height        string
 	Hash          string
@@ -702,6 +700,16 @@ type EntryHolder struct {
 	ECCost        string
 
 	Time string
+
+	DecodedExtIDs  []EntryData
+	DecodedContent EntryData
+
+	// Where the entry was recorded, and the commit that paid for it, as
+	// filled in by getEntryLinks
+	EBlock  string
+	DBlock  string
+	Commit  string
+	ECBlock string
 }
 
 func getEntry(hash string) *EntryHolder {
@@ -723,29 +731,14 @@ func getEntry(hash string) *EntryHolder {
 	holder := new(EntryHolder)
 	holder.Hash = hash
 	holder.ChainID = entry.GetChainID().String()
-	max := byte(0x80)
 	for _, data := range entry.ExternalIDs() {
-		hexString := false
-		for _, bytes := range data {
-			if bytes > max {
-				hexString = true
-				break
-			}
-		}
-		if hexString {
-			str := hex.EncodeToString(data)
-			holder.ExtIDs = append(holder.ExtIDs[:], "<span id='encoding'><a>Hex  : </a></span><span id='data'>"+htemp.HTMLEscaper(str)+"</span>")
-		} else {
-			str := string(data)
-			holder.ExtIDs = append(holder.ExtIDs[:], "<span id='encoding'><a>Ascii: </a></span><span id='data'>"+htemp.HTMLEscaper(str)+"</span>")
-		}
+		holder.DecodedExtIDs = append(holder.DecodedExtIDs, decodeEntryData(data))
 	}
 	holder.Version = 0
 	holder.Height = fmt.Sprintf("%d", entry.GetDatabaseHeight())
 	holder.ContentLength = len(entry.GetContent())
 	data := sha256.Sum256(entry.GetContent())
-	content := string(entry.GetContent())
-	holder.Content = htemp.HTMLEscaper(content)
+	holder.DecodedContent = decodeEntryData(entry.GetContent())
 	if bytes, err := entry.MarshalBinary(); err != nil {
 		holder.ECCost = "Error"
 	} else {
@@ -756,66 +749,6 @@ func getEntry(hash string) *EntryHolder {
 		}
 	}
 
-	//holder.Content = string(entry.GetContent())
 	holder.ContentHash = primitives.NewHash(data[:]).String()
 	return holder
 }
-
-func getAllChainEntries(chainIDString string) []SearchedStruct {
-	arr := make([]SearchedStruct, 0)
-	chainID, err := primitives.HexToHash(chainIDString)
-	if err != nil {
-		return nil
-	}
-	s := new(SearchedStruct)
-	s.Type = "chainhead"
-	s.Input = chainID.String()
-
-	dbase := StatePointer.GetAndLockDB()
-	mr, err := dbase.FetchHeadIndexByChainID(chainID)
-	StatePointer.UnlockDB()
-
-	if err != nil || mr == nil {
-		return nil
-	}
-	s.Content = mr.String()
-	arr = append(arr[:], *s)
-	if err != nil {
-		return nil
-	}
-
-	entries := make([]interfaces.IEBEntry, 0)
-
-	dbase = StatePointer.GetAndLockDB()
-	eblks, err := dbase.FetchAllEBlocksByChain(chainID)
-	if err != nil {
-		StatePointer.UnlockDB()
-		return nil
-	}
-
-	for _, eblk := range eblks {
-		hashes := eblk.GetEntryHashes()
-		for _, hash := range hashes {
-			entry, err := dbase.FetchEntry(hash)
-			if err != nil || entry == nil {
-				continue
-			}
-			entries = append(entries, entry)
-		}
-	}
-	//entries, err := dbase.FetchAllEntriesByChainID(chainID)
-	StatePointer.UnlockDB()
-	if err != nil {
-		return nil
-	}
-
-	for _, entry := range entries {
-		s := new(SearchedStruct)
-		s.Type = "entry"
-		e := getEntry(entry.GetHash().String())
-		s.Content = e
-		s.Input = entry.GetHash().String()
-		arr = append(arr[:], *s)
-	}
-	return arr
-}
@@ -58,7 +58,8 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 	s.AddPrefix(p.prefix)
 	FactomConfigFilename := util.GetConfigFilename("m2")
 	fmt.Println(fmt.Sprintf("factom config: %s", FactomConfigFilename))
-	s.LoadConfig(FactomConfigFilename, p.NetworkName)
+	// The command line flags override factomd.conf, see applyConfigFlags
+	s.LoadConfigWithFlags(FactomConfigFilename, p.NetworkName, func(cfg *util.FactomdConfig) { applyConfigFlags(cfg, p) })
 	s.OneLeader = p.rotate
 	s.TimeOffset = primitives.NewTimestampFromMilliseconds(uint64(p.timeOffset))
 	s.StartDelayLimit = p.StartDelay * 1000
@@ -90,22 +91,9 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 	// Set the wait for entries flag
 	s.WaitForEntries = p.WaitEntries
 
-	if 999 < p.PortOverride { // The command line flag exists and seems reasonable.
-		s.SetPort(p.PortOverride)
-	} else {
-		p.PortOverride = s.GetPort()
-	}
-	if 999 < p.ControlPanelPortOverride { // The command line flag exists and seems reasonable.
-		s.ControlPanelPort = p.ControlPanelPortOverride
-	} else {
-		p.ControlPanelPortOverride = s.ControlPanelPort
-	}
-
-	if p.BlkTime > 0 {
-		s.DirectoryBlockInSeconds = p.BlkTime
-	} else {
-		p.BlkTime = s.DirectoryBlockInSeconds
-	}
+	p.PortOverride = s.GetPort()
+	p.ControlPanelPortOverride = s.ControlPanelPort
+	p.BlkTime = s.DirectoryBlockInSeconds
 
 	s.FaultTimeout = p.FaultTimeout
 
@@ -123,18 +111,6 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 		p.Cnt = 1
 	}
 
-	if p.rpcUser != "" {
-		s.RpcUser = p.rpcUser
-	}
-
-	if p.rpcPassword != "" {
-		s.RpcPass = p.rpcPassword
-	}
-
-	if p.factomdTLS == true {
-		s.FactomdTLSEnable = true
-	}
-
 	if p.factomdLocations != "" {
 		if len(s.FactomdLocations) > 0 {
 			s.FactomdLocations += ","
@@ -142,13 +118,6 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 		s.FactomdLocations += p.factomdLocations
 	}
 
-	if p.fast == false {
-		s.StateSaverStruct.FastBoot = false
-	}
-	if p.fastLocation != "" {
-		s.StateSaverStruct.FastBootLocation = p.fastLocation
-	}
-
 	fmt.Println(">>>>>>>>>>>>>>>>")
 	fmt.Println(">>>>>>>>>>>>>>>> Net Sim Start!")
 	fmt.Println(">>>>>>>>>>>>>>>>")
@@ -172,14 +141,7 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 		os.Exit(0)
 	})
 
-	if p.Journal != "" {
-		if s.DBType != "Map" {
-			fmt.Println("Journal is ALWAYS a Map database")
-			s.DBType = "Map"
-		}
-	}
 	if p.Follower {
-		s.NodeMode = "FULL"
 		leadID := primitives.Sha([]byte(s.Prefix + "FNode0"))
 		if s.IdentityChainID.IsSameAs(leadID) {
 			s.SetIdentityChainID(primitives.Sha([]byte(time.Now().String()))) // Make sure this node is NOT a leader
@@ -188,11 +150,7 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 
 	s.KeepMismatch = p.keepMismatch
 
-	if len(p.Db) > 0 {
-		s.DBType = p.Db
-	} else {
-		p.Db = s.DBType
-	}
+	p.Db = s.DBType
 
 	if len(p.CloneDB) > 0 {
 		s.CloneDBType = p.CloneDB
@@ -313,6 +271,9 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 		if bytes.Compare(p.customNet, []byte("\xe3\xb0\xc4\x42")) == 0 {
 			panic("Please specify a custom network with -customnet=<something unique here>")
 		}
+		if s.CustomGenesis != nil && bytes.Compare(util.CustomNetworkID(s.CustomGenesis.NetworkName), p.customNet) != 0 {
+			panic(fmt.Sprintf("The custom genesis file %s is for -customnet=%s", s.CustomGenesisFile, s.CustomGenesis.NetworkName))
+		}
 		s.CustomNetworkID = p.customNet
 		networkID = p2p.NetworkID(binary.BigEndian.Uint32(p.customNet))
 		for i := range fnodes {
@@ -333,9 +294,12 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 			networkPort = fmt.Sprintf("%d", p.NetworkPortOverride)
 		}
 
+		p2p.NumberPeersToConnect, p2p.MaxNumberIncommingConnections = s.GetPeerLimits()
 		ci := p2p.ControllerInit{
 			Port:                     networkPort,
 			PeersFile:                s.PeersFile,
+			ManagedPeersFile:         s.ManagedPeersFile,
+			PeerReputationFile:       s.PeerReputationFile,
 			Network:                  networkID,
 			Exclusive:                p.Exclusive,
 			SeedURL:                  seedURL,
@@ -494,6 +458,24 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 
 	// Start the webserver
 	go wsapi.Start(fnodes[0].State)
+	AddHangupHandler(func() {
+		reload, err := fnodes[0].State.ReloadConfig()
+		if err != nil {
+			fmt.Println("Could not reload the configuration, keeping the old one:", err)
+			// The certificates are still reloaded, as they are without a config file
+			if err := fnodes[0].State.ReloadTLS(); err != nil {
+				fmt.Println("Could not reload the TLS certificates, keeping the old ones:", err)
+			}
+			return
+		}
+		fmt.Println(reload.String())
+	})
+
+	if p.DebugConsole != "" {
+		if err := StartDebugConsole(p.DebugConsole, fnodes[0].State); err != nil {
+			panic(err)
+		}
+	}
 
 	// Start prometheus on port
 	launchPrometheus(9876)
@@ -12,6 +12,8 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"sync"
+	"syscall"
 )
 
 // interruptChannel is used to receive SIGINT (Ctrl+C) signals.
@@ -78,3 +80,35 @@ func AddInterruptHandler(handler func()) {
 
 	addHandlerChannel <- handler
 }
+
+// hangupChannel is used to receive SIGHUP signals.
+var hangupChannel chan os.Signal
+
+var hangupMutex sync.Mutex
+var hangupCallbacks []func()
+
+// AddHangupHandler adds a handler to call each time a SIGHUP is received, to
+// reload certificates and settings without a restart.
+func AddHangupHandler(handler func()) {
+	hangupMutex.Lock()
+	defer hangupMutex.Unlock()
+
+	hangupCallbacks = append(hangupCallbacks, handler)
+	if hangupChannel == nil {
+		hangupChannel = make(chan os.Signal, 1)
+		signal.Notify(hangupChannel, syscall.SIGHUP)
+		go mainHangupHandler()
+	}
+}
+
+func mainHangupHandler() {
+	for range hangupChannel {
+		fmt.Println("Received SIGHUP.  Reloading...")
+		hangupMutex.Lock()
+		callbacks := hangupCallbacks
+		hangupMutex.Unlock()
+		for _, callback := range callbacks {
+			callback()
+		}
+	}
+}
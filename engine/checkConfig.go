@@ -0,0 +1,206 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package engine
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/util"
+)
+
+// applyConfigFlags overrides the config with the command line flags, and
+// returns the flag used for each setting it changed.  NetStart loads the
+// state through it, so CheckConfig reports what factomd would run with.
+func applyConfigFlags(cfg *util.FactomdConfig, p *FactomParams) map[string]string {
+	flags := make(map[string]string)
+	if p.NetworkName != "" {
+		cfg.App.Network = p.NetworkName
+		flags["App.Network"] = "-network"
+	}
+	if 999 < p.PortOverride {
+		cfg.App.PortNumber = p.PortOverride
+		flags["App.PortNumber"] = "-port"
+	}
+	if 999 < p.ControlPanelPortOverride {
+		cfg.App.ControlPanelPort = p.ControlPanelPortOverride
+		flags["App.ControlPanelPort"] = "-ControlPanelPort"
+	}
+	if 0 < p.NetworkPortOverride {
+		port := strconv.Itoa(p.NetworkPortOverride)
+		switch strings.ToUpper(cfg.App.Network) {
+		case "MAIN":
+			cfg.App.MainNetworkPort = port
+			flags["App.MainNetworkPort"] = "-networkPort"
+		case "TEST":
+			cfg.App.TestNetworkPort = port
+			flags["App.TestNetworkPort"] = "-networkPort"
+		default:
+			cfg.App.LocalNetworkPort = port
+			flags["App.LocalNetworkPort"] = "-networkPort"
+		}
+	}
+	if p.BlkTime > 0 {
+		cfg.App.DirectoryBlockInSeconds = p.BlkTime
+		flags["App.DirectoryBlockInSeconds"] = "-blktime"
+	}
+	if p.Follower {
+		cfg.App.NodeMode = "FULL"
+		flags["App.NodeMode"] = "-follower"
+	}
+	if p.Journal != "" {
+		// A journal is replayed into a Map database, unless -db says otherwise
+		cfg.App.DBType = "Map"
+		flags["App.DBType"] = "-journal"
+	}
+	if len(p.Db) > 0 {
+		cfg.App.DBType = p.Db
+		flags["App.DBType"] = "-db"
+	}
+	if p.rpcUser != "" {
+		cfg.App.FactomdRpcUser = p.rpcUser
+		flags["App.FactomdRpcUser"] = "-rpcuser"
+	}
+	if p.rpcPassword != "" {
+		cfg.App.FactomdRpcPass = p.rpcPassword
+		flags["App.FactomdRpcPass"] = "-rpcpass"
+	}
+	if p.factomdTLS {
+		cfg.App.FactomdTlsEnabled = true
+		flags["App.FactomdTlsEnabled"] = "-tls"
+	}
+	if !p.fast {
+		cfg.App.FastBoot = false
+		flags["App.FastBoot"] = "-fast"
+	}
+	if p.fastLocation != "" {
+		cfg.App.FastBootLocation = p.fastLocation
+		flags["App.FastBootLocation"] = "-fastlocation"
+	}
+	return flags
+}
+
+// apiUserErrors checks each [apiuser] section as the state loads it, along
+// with FactomdRpcUser so a user defined twice is found.
+func apiUserErrors(cfg *util.FactomdConfig) []error {
+	var rpcUser []*state.APICredential
+	if cfg.App.FactomdRpcUser != "" {
+		rpcUser = append(rpcUser, &state.APICredential{User: cfg.App.FactomdRpcUser, Password: cfg.App.FactomdRpcPass, Scopes: state.AllAPIScopes()})
+	}
+	var users []string
+	for user := range cfg.APIUser {
+		users = append(users, user)
+	}
+	sort.Strings(users)
+
+	var errs []error
+	for _, user := range users {
+		u := cfg.APIUser[user]
+		setting := "APIUser." + user
+		scopes, err := state.ParseAPIScopes(u.Scopes)
+		if err != nil {
+			errs = append(errs, &util.ConfigError{Setting: setting + ".Scopes", Problem: err.Error()})
+			continue
+		}
+		creds := append(rpcUser, &state.APICredential{User: user, Password: u.Password, Key: u.Key, Scopes: scopes})
+		if _, err := state.NewAPIAuth(creds); err != nil {
+			errs = append(errs, &util.ConfigError{Setting: setting, Problem: err.Error()})
+		}
+	}
+	return errs
+}
+
+// CheckConfig loads factomd.conf with the environment variables and command
+// line flags that override it, prints every setting factomd would run with
+// and where its value came from, then any problems with them.  It returns the
+// exit code, 1 if there are problems.
+func CheckConfig(p *FactomParams, out io.Writer) int {
+	filename := util.GetConfigFilename("m2")
+	fmt.Fprintf(out, "factom config: %s\n\n", filename)
+	if _, err := os.Stat(filename); os.IsNotExist(err) {
+		// Which is fine, when the settings come from the environment
+		fmt.Fprintf(out, "There is no config file, so the defaults are used\n\n")
+		filename = os.DevNull
+	}
+
+	inFile, errs := util.ConfigFileSettings(filename)
+	inEnv, unknown := util.ConfigEnv(os.Environ())
+	for _, variable := range unknown {
+		errs = append(errs, &util.ConfigError{Setting: variable, Problem: "is not a factomd.conf setting"})
+	}
+	cfg, err := util.ReadConfigFile(filename)
+	if err != nil {
+		// factomd would start with the default settings
+		fmt.Fprintf(out, "Cannot read the config: %v\n", err)
+		return 1
+	}
+	// The exchange rate key is picked by the network in the file or
+	// environment, not the flag
+	keyByNetwork := cfg.App.Network == "MAIN" || cfg.App.Network == "TEST" || cfg.App.Network == "LOCAL"
+	flags := applyConfigFlags(cfg, p)
+	network := strings.ToUpper(cfg.App.Network)
+	if network == "MAIN" {
+		cfg.App.DirectoryBlockInSeconds = 600
+	}
+	// A CUSTOM network takes its block time from the genesis file, unless
+	// -blktime is given
+	fromGenesis := false
+	if network == "CUSTOM" && cfg.App.CustomGenesisFile != "" && flags["App.DirectoryBlockInSeconds"] == "" {
+		genesisFile := cfg.App.CustomGenesisFile
+		if !filepath.IsAbs(genesisFile) {
+			genesisFile = cfg.App.HomeDir + genesisFile
+		}
+		if g, err := util.ReadGenesis(genesisFile); err == nil {
+			cfg.App.DirectoryBlockInSeconds = g.DirectoryBlockInSeconds
+			fromGenesis = true
+		} else if _, statErr := os.Stat(genesisFile); statErr == nil {
+			// A missing file is reported by Validate
+			errs = append(errs, &util.ConfigError{Setting: "App.CustomGenesisFile", Problem: err.Error()})
+		}
+	}
+	errs = append(errs, cfg.Validate()...)
+	errs = append(errs, apiUserErrors(cfg)...)
+
+	for _, s := range cfg.Settings() {
+		var source string
+		switch {
+		case s.Name == "App.DirectoryBlockInSeconds" && network == "MAIN":
+			source = "fixed for MAIN"
+		case flags[s.Name] != "":
+			source = "flag " + flags[s.Name]
+		case s.Name == "App.DirectoryBlockInSeconds" && fromGenesis:
+			source = "genesis file"
+		case s.Name == "App.ExchangeRateAuthorityPublicKey" && keyByNetwork:
+			source = "Network"
+		case inEnv[s.Name] != "":
+			source = "environment " + inEnv[s.Name]
+		case inFile[s.Name]:
+			source = "factomd.conf"
+		case s.Name == "App.HomeDir" && os.Getenv("FACTOM_HOME") != "":
+			source = "FACTOM_HOME"
+		case s.Name == "App.FastBootLocation":
+			source = "HomeDir"
+		default:
+			source = "default"
+		}
+		fmt.Fprintf(out, "%-45s %-66s %s\n", s.Name, s.Value, source)
+	}
+
+	if len(errs) == 0 {
+		fmt.Fprintf(out, "\nNo problems found\n")
+		return 0
+	}
+	fmt.Fprintf(out, "\n%d problems found\n", len(errs))
+	for _, err := range errs {
+		fmt.Fprintf(out, "  %v\n", err)
+	}
+	return 1
+}
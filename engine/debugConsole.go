@@ -0,0 +1,676 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package engine
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/p2p"
+	"github.com/FactomProject/factomd/state"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// The debug console is a telnet console, started with -debugconsole, for
+// looking into and adjusting a running node.  Logging in takes API
+// credentials with the admin scope.  If there are none it can only be
+// reached from this host.
+
+var consoleLogger = packageLogger.WithFields(log.Fields{"subpack": "debugconsole"})
+
+const defaultDebugConsolePort = "8093"
+
+// Telnet commands and options, RFC 854 and 857
+const (
+	telnetSE   = 240
+	telnetSB   = 250
+	telnetWILL = 251
+	telnetWONT = 252
+	telnetDO   = 253
+	telnetDONT = 254
+	telnetIAC  = 255
+
+	telnetEcho            = 1
+	telnetSuppressGoAhead = 3
+)
+
+const maxDebugConsoleLogins = 3
+
+type debugCommand struct {
+	name     string
+	args     string
+	help     string
+	run      func(d *debugSession, args []string)
+	complete func(d *debugSession) []string // Values for the first argument
+}
+
+var debugCommands []*debugCommand
+
+func init() {
+	// Set here, as help refers to the list
+	debugCommands = []*debugCommand{
+		{name: "help", args: "[command]", help: "List the commands, or describe one", run: consoleHelp, complete: commandNames},
+		{name: "status", help: "Summarize the node", run: consoleStatus},
+		{name: "pl", args: "[height]", help: "Print the process list at a height, by default the current one", run: consolePL},
+		{name: "holding", help: "Print the messages in the holding queue", run: consoleHolding},
+		{name: "dbstates", help: "Print the directory block states", run: consoleDBStates},
+		{name: "identities", help: "List the server identities", run: consoleIdentities},
+		{name: "peers", help: "List the connected peers", run: consolePeers},
+		{name: "disconnect", args: "<peer>", help: "Disconnect a peer, by address or address:port", run: consoleDisconnect, complete: peerAddresses},
+		{name: "ban", args: "<address or range> [duration]", help: "Disconnect a peer and ban it, or a CIDR range, for a duration such as 24h, or for good.  Bans last across restarts", run: consoleBan, complete: peerAddresses},
+		{name: "unban", args: "<address or range>", help: "Lift a ban", run: consoleUnban, complete: bannedAddresses},
+		{name: "loglevel", args: "[log level]", help: "Show the log levels, or set one.  The logs are console (standard, debug), api (debug ... none), p2p (0-6) and engine (debug ... panic)", run: consoleLogLevel, complete: logNames},
+		{name: "fastboot", help: "Save the FastBoot file, with the state at the end of the next block", run: consoleFastBoot},
+		{name: "quit", help: "Close the console", run: func(d *debugSession, args []string) { d.quit = true }},
+	}
+}
+
+// StartDebugConsole listens for debug console connections.  The service is
+// localhost[:port] to listen on this host only, remotehost[:port] to listen
+// on every interface or unix:<path> for a unix socket only its owner can use.
+func StartDebugConsole(service string, s *state.State) error {
+	network, address, remote, err := debugConsoleAddress(service)
+	if err != nil {
+		return err
+	}
+	if remote && len(s.APIAuth.Users()) == 0 {
+		return fmt.Errorf("A remotehost debug console needs API credentials with the admin scope")
+	}
+
+	if network == "unix" {
+		// A socket left by an earlier run is removed, but never any other file
+		if fi, err := os.Lstat(address); err == nil {
+			if fi.Mode()&os.ModeSocket == 0 {
+				return fmt.Errorf("%s exists and is not a socket", address)
+			}
+			os.Remove(address)
+		}
+	}
+	ln, err := net.Listen(network, address)
+	if err != nil {
+		return err
+	}
+	if network == "unix" {
+		if err := os.Chmod(address, 0600); err != nil {
+			ln.Close()
+			return err
+		}
+	}
+	fmt.Printf("Debug console listening on %s %s\n", network, address)
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				consoleLogger.WithFields(log.Fields{"error": err}).Error("Debug console stopped")
+				return
+			}
+			go newDebugSession(s, conn, remote).serve()
+		}
+	}()
+	return nil
+}
+
+func debugConsoleAddress(service string) (network string, address string, remote bool, err error) {
+	if strings.HasPrefix(service, "unix:") {
+		path := strings.TrimPrefix(service, "unix:")
+		if path == "" {
+			return "", "", false, fmt.Errorf("Malformed -debugconsole option %q, the unix socket needs a path", service)
+		}
+		return "unix", path, false, nil
+	}
+
+	parts := strings.Split(service, ":")
+	host, port := parts[0], defaultDebugConsolePort
+	if len(parts) == 2 {
+		port = parts[1]
+	}
+	if host == "" {
+		host = "localhost"
+	}
+	if _, badPort := strconv.Atoi(port); len(parts) > 2 || badPort != nil || (host != "localhost" && host != "remotehost") {
+		return "", "", false, fmt.Errorf("Malformed -debugconsole option %q. Should be localhost[:port], remotehost[:port] or unix:<path>", service)
+	}
+	if host == "remotehost" {
+		return "tcp", ":" + port, true, nil
+	}
+	return "tcp", "localhost:" + port, false, nil
+}
+
+type debugSession struct {
+	state  *state.State
+	conn   net.Conn
+	reader *bufio.Reader
+	out    *bufio.Writer
+	user   string
+	remote bool // Not a connection from this host, so it needs credentials
+	raw    bool // The client sends each key, so we echo and edit the line
+	lastCR bool
+	quit   bool
+}
+
+func newDebugSession(s *state.State, conn net.Conn, remote bool) *debugSession {
+	d := new(debugSession)
+	d.state = s
+	d.conn = conn
+	d.remote = remote
+	d.reader = bufio.NewReader(conn)
+	d.out = bufio.NewWriter(conn)
+	return d
+}
+
+func (d *debugSession) serve() {
+	defer d.conn.Close()
+	remote := d.conn.RemoteAddr().String()
+
+	// Ask a telnet client to send each key, so we can complete commands
+	d.out.Write([]byte{telnetIAC, telnetWILL, telnetEcho, telnetIAC, telnetWILL, telnetSuppressGoAhead})
+	d.printf("Factom Debug Console, %s on %s\n", d.state.GetFactomNodeName(), d.state.GetNetworkName())
+	if !d.login() {
+		consoleLogger.WithFields(log.Fields{"remote": remote}).Warn("Debug console login failed")
+		return
+	}
+	logger := consoleLogger.WithFields(log.Fields{"remote": remote, "user": d.user})
+	logger.Info("Debug console login")
+	d.printf("Type help for the commands, tab completes them\n")
+
+	for !d.quit {
+		d.printf("> ")
+		line, err := d.readLine(false)
+		if err != nil {
+			break
+		}
+		args := strings.Fields(line)
+		if len(args) == 0 {
+			continue
+		}
+		c := findDebugCommand(args[0])
+		if c == nil {
+			d.printf("Unknown command %s, type help for the commands\n", args[0])
+			continue
+		}
+		logger.WithFields(log.Fields{"command": line}).Info("Debug console command")
+		c.run(d, args[1:])
+	}
+	d.printf("Bye\n")
+	logger.Info("Debug console closed")
+}
+
+// login asks for a user name and password, or an API key.  With no API
+// credentials configured there is nothing to ask, but only local connections
+// are let in.
+func (d *debugSession) login() bool {
+	if len(d.state.APIAuth.Users()) == 0 {
+		if d.remote {
+			d.printf("The debug console needs API credentials with the admin scope\n")
+			return false
+		}
+		return true
+	}
+	for i := 0; i < maxDebugConsoleLogins; i++ {
+		d.printf("User (none for an API key): ")
+		user, err := d.readLine(false)
+		if err != nil {
+			return false
+		}
+		if user == "" {
+			d.printf("Key: ")
+		} else {
+			d.printf("Password: ")
+		}
+		secret, err := d.readLine(true)
+		if err != nil {
+			return false
+		}
+
+		header := "Bearer " + secret
+		if user != "" {
+			header = "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+secret))
+		}
+		name, scopes, err := d.state.AuthenticateAPI(header)
+		if err == nil && constants.HasAPIScope(scopes, constants.API_SCOPE_ADMIN) {
+			d.user = name
+			return true
+		}
+		time.Sleep(time.Second)
+		d.printf("Login incorrect, the console needs the admin scope\n")
+	}
+	return false
+}
+
+func (d *debugSession) printf(format string, args ...interface{}) {
+	// Raw telnet needs a carriage return with every new line
+	text := strings.Replace(fmt.Sprintf(format, args...), "\n", "\r\n", -1)
+	d.out.WriteString(text)
+	d.out.Flush()
+}
+
+// echo shows what was typed, when the client does not.
+func (d *debugSession) echo(text string) {
+	if d.raw {
+		d.printf("%s", text)
+	}
+}
+
+// readLine reads a line, editing it as the keys are sent.  A secret line is
+// not echoed.
+func (d *debugSession) readLine(secret bool) (string, error) {
+	var line []byte
+	for {
+		b, err := d.reader.ReadByte()
+		if err != nil {
+			return "", err
+		}
+		lastCR := d.lastCR
+		d.lastCR = b == '\r'
+
+		switch {
+		case b == telnetIAC:
+			if err := d.telnetCommand(); err != nil {
+				return "", err
+			}
+		case b == '\n' && lastCR, b == 0:
+			// The rest of a \r\n or \r\0
+		case b == '\r' || b == '\n':
+			d.echo("\n")
+			return string(line), nil
+		case b == 3 || b == 4: // ^C or ^D
+			return "", io.EOF
+		case b == 8 || b == 127: // Backspace or delete
+			if len(line) > 0 {
+				line = line[:len(line)-1]
+				if !secret {
+					d.echo("\b \b")
+				}
+			}
+		case b == '\t' && !secret:
+			line = d.complete(line)
+		case ' ' <= b && b < 127:
+			line = append(line, b)
+			if !secret {
+				d.echo(string(b))
+			}
+		}
+	}
+}
+
+// telnetCommand reads the rest of a telnet command, noting if the client
+// agreed to let us echo.
+func (d *debugSession) telnetCommand() error {
+	cmd, err := d.reader.ReadByte()
+	if err != nil {
+		return err
+	}
+	switch cmd {
+	case telnetWILL, telnetWONT, telnetDO, telnetDONT:
+		option, err := d.reader.ReadByte()
+		if err != nil {
+			return err
+		}
+		if option == telnetEcho {
+			d.raw = cmd == telnetDO
+		}
+	case telnetSB:
+		// Skip the subnegotiation, up to IAC SE
+		for last := byte(0); ; {
+			b, err := d.reader.ReadByte()
+			if err != nil {
+				return err
+			}
+			if last == telnetIAC && b == telnetSE {
+				break
+			}
+			last = b
+		}
+	}
+	return nil
+}
+
+// complete completes the last word of the line, or lists what it could be.
+func (d *debugSession) complete(line []byte) []byte {
+	text := string(line)
+	words := strings.Fields(text)
+	if strings.HasSuffix(text, " ") || len(words) == 0 {
+		words = append(words, "")
+	}
+	partial := words[len(words)-1]
+
+	var values []string
+	switch len(words) {
+	case 1:
+		values = commandNames(d)
+	case 2:
+		if c := findDebugCommand(words[0]); c != nil && c.complete != nil {
+			values = c.complete(d)
+		}
+	}
+	var matches []string
+	for _, v := range values {
+		if strings.HasPrefix(v, partial) {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 0 {
+		return line
+	}
+
+	add := commonPrefix(matches)[len(partial):]
+	if len(matches) == 1 {
+		add += " "
+	}
+	if add != "" {
+		d.echo(add)
+		return append(line, add...)
+	}
+	d.printf("\n%s\n> %s", strings.Join(matches, "  "), text)
+	return line
+}
+
+func commonPrefix(list []string) string {
+	prefix := list[0]
+	for _, s := range list[1:] {
+		for !strings.HasPrefix(s, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
+
+func findDebugCommand(name string) *debugCommand {
+	for _, c := range debugCommands {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func commandNames(d *debugSession) []string {
+	var names []string
+	for _, c := range debugCommands {
+		names = append(names, c.name)
+	}
+	return names
+}
+
+func logNames(d *debugSession) []string {
+	return []string{"api", "console", "engine", "p2p"}
+}
+
+// peerAddresses lists the address:port of each connected peer.
+func peerAddresses(d *debugSession) []string {
+	var addresses []string
+	for hash := range consolePeerMetrics() {
+		addresses = append(addresses, strings.Fields(hash)[0])
+	}
+	sort.Strings(addresses)
+	return addresses
+}
+
+func bannedAddresses(d *debugSession) []string {
+	var addresses []string
+	if p2pNetwork != nil {
+		for _, ban := range p2pNetwork.Bans() {
+			addresses = append(addresses, ban.Address)
+		}
+	}
+	return addresses
+}
+
+func consoleHelp(d *debugSession, args []string) {
+	if len(args) > 0 {
+		c := findDebugCommand(args[0])
+		if c == nil {
+			d.printf("Unknown command %s\n", args[0])
+			return
+		}
+		d.printf("%s %s\n    %s\n", c.name, c.args, c.help)
+		return
+	}
+	for _, c := range debugCommands {
+		d.printf("%-36s %s\n", c.name+" "+c.args, c.help)
+	}
+}
+
+func consoleStatus(d *debugSession, args []string) {
+	s := d.state
+	d.printf("Node:              %s\n", s.GetFactomNodeName())
+	d.printf("Network:           %s\n", s.GetNetworkName())
+	d.printf("Identity:          %s\n", s.GetIdentityChainID().String())
+	d.printf("Leader:            %v\n", s.IsLeader())
+	d.printf("Saved height:      %d\n", s.GetHighestSavedBlk())
+	d.printf("Completed height:  %d\n", s.GetHighestCompletedBlk())
+	d.printf("Leader height:     %d\n", s.GetLeaderHeight())
+	d.printf("Known height:      %d\n", s.GetHighestKnownBlock())
+	d.printf("Holding:           %d\n", len(s.LoadHoldingMap()))
+	d.printf("Peers:             %d\n", len(consolePeerMetrics()))
+}
+
+func consolePL(d *debugSession, args []string) {
+	height := d.state.GetLeaderHeight()
+	if len(args) > 0 {
+		h, err := strconv.ParseUint(args[0], 10, 32)
+		if err != nil {
+			d.printf("Bad height %s\n", args[0])
+			return
+		}
+		height = uint32(h)
+	}
+	pl := d.state.ProcessLists.GetSafe(height)
+	if pl == nil {
+		d.printf("No process list at height %d\n", height)
+		return
+	}
+	d.printf("%s\n", pl.String())
+}
+
+func consoleHolding(d *debugSession, args []string) {
+	holding := d.state.LoadHoldingMap()
+	d.printf("%d messages in holding\n", len(holding))
+	for _, msg := range holding {
+		if msg != nil {
+			d.printf("%s\n", msg.String())
+		}
+	}
+}
+
+func consoleDBStates(d *debugSession, args []string) {
+	d.printf("%s\n", d.state.DBStates.String())
+}
+
+func consoleIdentities(d *debugSession, args []string) {
+	d.printf("%d identities\n", len(d.state.Identities))
+	for i, id := range d.state.Identities {
+		d.printf("%3d %-26s %s\n", i, returnStatString(id.Status), id.IdentityChainID.String())
+	}
+}
+
+// consolePeerMetrics returns the metrics of the connected peers, by peer
+// hash, or none if there's no network.
+func consolePeerMetrics() map[string]p2p.ConnectionMetrics {
+	if p2pNetwork == nil {
+		return nil
+	}
+	return p2pNetwork.ConnectionMetrics()
+}
+
+func consolePeers(d *debugSession, args []string) {
+	if p2pNetwork == nil {
+		d.printf("There is no peer to peer network\n")
+		return
+	}
+	metrics := consolePeerMetrics()
+	var hashes []string
+	for hash := range metrics {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+	d.printf("%-24s %-12s %8s %10s %10s  %s\n", "Peer", "State", "Quality", "Sent", "Received", "Connected")
+	for _, hash := range hashes {
+		m := metrics[hash]
+		d.printf("%-24s %-12s %8d %10d %10d  %s\n", strings.Fields(hash)[0], m.ConnectionState, m.PeerQuality,
+			m.MessagesSent, m.MessagesReceived, time.Since(m.MomentConnected)/time.Second*time.Second)
+	}
+}
+
+// findPeer finds the hash of the one connected peer at an address or
+// address:port.
+func (d *debugSession) findPeer(args []string) (string, bool) {
+	if p2pNetwork == nil {
+		d.printf("There is no peer to peer network\n")
+		return "", false
+	}
+	if len(args) != 1 {
+		d.printf("Which peer?\n")
+		return "", false
+	}
+	var found []string
+	for hash := range consolePeerMetrics() {
+		if strings.HasPrefix(hash, args[0]+":") || strings.HasPrefix(hash, args[0]+" ") {
+			found = append(found, hash)
+		}
+	}
+	switch len(found) {
+	case 0:
+		d.printf("No peer %s is connected\n", args[0])
+		return "", false
+	case 1:
+		return found[0], true
+	}
+	d.printf("There are %d peers at %s, give the port too\n", len(found), args[0])
+	return "", false
+}
+
+func consoleDisconnect(d *debugSession, args []string) {
+	if hash, ok := d.findPeer(args); ok {
+		p2pNetwork.Disconnect(hash)
+		d.printf("Disconnecting %s\n", args[0])
+	}
+}
+
+func consoleBan(d *debugSession, args []string) {
+	if p2pNetwork == nil {
+		d.printf("There is no peer to peer network\n")
+		return
+	}
+	if len(args) < 1 || len(args) > 2 {
+		d.printf("Which address?\n")
+		return
+	}
+	address := args[0]
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		address = host
+	}
+	var duration time.Duration
+	if len(args) == 2 {
+		var err error
+		if duration, err = time.ParseDuration(args[1]); err != nil {
+			d.printf("Bad duration %s, use one such as 30m or 24h\n", args[1])
+			return
+		}
+	}
+	if err := p2pNetwork.Ban(address, duration, "debug console"); err != nil {
+		d.printf("%v\n", err)
+		return
+	}
+	if duration == 0 {
+		d.printf("Banned %s\n", address)
+	} else {
+		d.printf("Banned %s for %s\n", address, duration)
+	}
+}
+
+func consoleUnban(d *debugSession, args []string) {
+	if p2pNetwork == nil {
+		d.printf("There is no peer to peer network\n")
+		return
+	}
+	if len(args) != 1 {
+		d.printf("Which address?\n")
+		return
+	}
+	if err := p2pNetwork.Unban(args[0]); err != nil {
+		d.printf("%v\n", err)
+		return
+	}
+	d.printf("Unbanned %s\n", args[0])
+}
+
+func consoleLogLevel(d *debugSession, args []string) {
+	s := d.state
+	if len(args) == 0 {
+		d.printf("api      %s\n", s.LogLevel)
+		d.printf("console  %s\n", s.ConsoleLogLevel)
+		d.printf("engine   %s\n", log.GetLevel())
+		d.printf("p2p      %d (%s)\n", p2p.CurrentLoggingLevel, p2p.LoggingLevels[p2p.CurrentLoggingLevel])
+		return
+	}
+	if len(args) != 2 {
+		d.printf("Give the log and its level, as in: loglevel api debug\n")
+		return
+	}
+
+	name, level := args[0], args[1]
+	switch name {
+	case "api":
+		switch level {
+		case "debug", "info", "notice", "warning", "error", "critical", "alert", "emergency", "none":
+			s.SetLogLevels(level, s.ConsoleLogLevel)
+		default:
+			d.printf("The api log level is one of debug, info, notice, warning, error, critical, alert, emergency or none\n")
+			return
+		}
+	case "console":
+		switch level {
+		case "debug", "standard":
+			s.SetLogLevels(s.LogLevel, level)
+		default:
+			d.printf("The console log level is debug or standard\n")
+			return
+		}
+	case "engine":
+		l, err := log.ParseLevel(level)
+		if err != nil {
+			d.printf("%v\n", err)
+			return
+		}
+		log.SetLevel(l)
+	case "p2p":
+		l, err := strconv.ParseUint(level, 10, 8)
+		if err != nil || l > uint64(p2p.Verbose) {
+			d.printf("The p2p log level is 0 to %d\n", p2p.Verbose)
+			return
+		}
+		if p2pNetwork == nil {
+			d.printf("There is no peer to peer network\n")
+			return
+		}
+		p2pNetwork.ChangeLogLevel(uint8(l))
+	default:
+		d.printf("Unknown log %s, the logs are %s\n", name, strings.Join(logNames(d), ", "))
+		return
+	}
+	d.printf("The %s log level is %s\n", name, level)
+}
+
+func consoleFastBoot(d *debugSession, args []string) {
+	sss := &d.state.StateSaverStruct
+	if !sss.FastBoot {
+		d.printf("FastBoot is off\n")
+		return
+	}
+	sss.RequestSave()
+	d.printf("The FastBoot file will be saved with the state at the end of the next block, once the block after it is done\n")
+}
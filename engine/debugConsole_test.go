@@ -0,0 +1,65 @@
+package engine_test
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/FactomProject/factomd/engine"
+	"github.com/FactomProject/factomd/state"
+)
+
+func TestDebugConsole(t *testing.T) {
+	dir, err := ioutil.TempDir("", "console")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	socket := filepath.Join(dir, "console")
+
+	s := new(state.State)
+	for _, service := range []string{"otherhost:8093", "localhost:port", "unix:"} {
+		if err := StartDebugConsole(service, s); err == nil {
+			t.Errorf("Started a console on %s", service)
+		}
+	}
+	if err := StartDebugConsole("remotehost:8093", s); err == nil {
+		t.Errorf("Started a remote console without credentials")
+	}
+	notSocket := filepath.Join(dir, "file")
+	if err := ioutil.WriteFile(notSocket, []byte("keep"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := StartDebugConsole("unix:"+notSocket, s); err == nil {
+		t.Errorf("Started a console over a file")
+	}
+	if _, err := os.Stat(notSocket); err != nil {
+		t.Errorf("The file was removed: %v", err)
+	}
+	if err := StartDebugConsole("unix:"+socket, s); err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := net.Dial("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	if _, err := conn.Write([]byte("help\r\nnothing\r\nhel\tban\r\nquit\r\n")); err != nil {
+		t.Fatal(err)
+	}
+	out, err := ioutil.ReadAll(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"loglevel", "Unknown command nothing", "Disconnect a peer and ban it", "Bye"} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("No %q in the console output:\n%s", want, out)
+		}
+	}
+}
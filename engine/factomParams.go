@@ -61,6 +61,7 @@ type FactomParams struct {
 	DebugConsole             string
 	StdoutLog                string
 	StderrLog                string
+	CheckConfig              bool
 }
 
 func (f *FactomParams) Init() { // maybe used by test code
@@ -115,6 +116,7 @@ func (f *FactomParams) Init() { // maybe used by test code
 	f.DebugConsole = "foobar" //TODO: pretty sure this value is overridden by the default in the flag -- clay
 	f.StdoutLog = "out.txt"
 	f.StderrLog = "err.txt"
+	f.CheckConfig = false
 }
 
 func ParseCmdLine(args []string) *FactomParams {
@@ -182,11 +184,13 @@ func ParseCmdLine(args []string) *FactomParams {
 
 	sync2Ptr := flag.Int("sync2", -1, "Set the initial blockheight for the second Sync pass. Used to force a total sync, or skip unnecessary syncing of entries.")
 
-	DebugConsolePtr := flag.String("debugconsole", "", "Enable DebugConsole on port. localhost:8093 open 8093 and spawns a telnet console, remotehost:8093 open 8093")
+	DebugConsolePtr := flag.String("debugconsole", "", "Start the debug console. localhost:8093 listens on port 8093 of this host only, remotehost:8093 on every interface, and unix:<path> on a unix socket")
 
 	StdoutLogPtr := flag.String("stdoutlog", "", "Log stdout to a file")
 	StderrLogPtr := flag.String("stderrlog", "", "Log stderr to a file, optionally the same file as stdout")
 
+	checkConfigPtr := flag.Bool("checkconfig", false, "Check factomd.conf and the flags, print the settings factomd would run with and exit, non-zero if there are problems")
+
 	flag.CommandLine.Parse(args)
 
 	p.AckbalanceHash = *ackBalanceHashPtr
@@ -244,6 +248,7 @@ func ParseCmdLine(args []string) *FactomParams {
 	p.DebugConsole = *DebugConsolePtr
 	p.StdoutLog = *StdoutLogPtr
 	p.StderrLog = *StderrLogPtr
+	p.CheckConfig = *checkConfigPtr
 
 	if *factomHomePtr != "" {
 		os.Setenv("FACTOM_HOME", *factomHomePtr)
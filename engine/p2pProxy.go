@@ -155,6 +155,9 @@ func (f *P2PProxy) Recieve() (interfaces.IMsg, error) {
 
 				if err != nil {
 					proxyLogger.WithField("receive-error", err).Error()
+					if p2pNetwork != nil {
+						p2pNetwork.ReportInvalidMessage(fmessage.PeerHash) // Counts against the peer's reputation
+					}
 				} else {
 					proxyLogger.WithFields(msg.LogFields()).WithField("node-name", f.GetNameFrom()).Info("Receive Message")
 				}
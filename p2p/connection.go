@@ -105,8 +105,12 @@ type ConnectionMetrics struct {
 	// Red: Below -50
 	// Yellow: -50 - 100
 	// Green: > 100
-	ConnectionState string // Basic state of the connection
-	ConnectionNotes string // Connectivity notes for the connection
+	ConnectionState string        // Basic state of the connection
+	ConnectionNotes string        // Connectivity notes for the connection
+	InvalidMessages uint32        // Parcels that failed the checks, for the peer's reputation
+	UsefulMessages  uint32        // Application messages received, for the peer's reputation
+	Latency         time.Duration // Average ping round trip
+	TimeOnline      time.Duration // Time spent online
 }
 
 // ConnectionCommand is used to instruct the Connection to carry out some functionality.
@@ -538,6 +542,7 @@ func (c *Connection) handleParcel(parcel Parcel) {
 	defer func() {
 		if r := recover(); r != nil {
 			c.peer.demerit() /// so someone DDoS or just incompatible will eventually be cut off after 200+ panics
+			c.metrics.InvalidMessages++
 			fmt.Fprintf(os.Stdout, "Caught Exception in connection %s: %v\n", c.peer.PeerFixedIdent(), r)
 			return
 		}
@@ -558,6 +563,7 @@ func (c *Connection) handleParcel(parcel Parcel) {
 		debug(c.peer.PeerIdent(), "Connection.handleParcel() got invalid message")
 		parcel.Print()
 		c.peer.demerit()
+		c.metrics.InvalidMessages++
 		return
 	case ParcelValid:
 		parcel.Trace("Connection.handleParcel()-ParcelValid", "I")
@@ -623,20 +629,22 @@ func (c *Connection) handleParcelTypes(parcel Parcel) {
 		pong := NewParcel(CurrentNetwork, []byte("Pong"))
 		pong.Header.Type = TypePong
 		BlockFreeChannelSend(c.SendChannel, ConnectionParcel{Parcel: *pong})
-	case TypePong: // all we need is the timestamp which is set already
-		return
+	case TypePong: // The timestamp is set already, we just time the round trip
+		c.updateLatency(time.Since(c.timeLastPing))
 	case TypePeerRequest:
 		BlockFreeChannelSend(c.ReceiveChannel, ConnectionParcel{Parcel: parcel}) // Controller handles these.
 	case TypePeerResponse:
 		BlockFreeChannelSend(c.ReceiveChannel, ConnectionParcel{Parcel: parcel}) // Controller handles these.
 	case TypeMessage:
 		c.peer.QualityScore = c.peer.QualityScore + 1
+		c.metrics.UsefulMessages++
 		// Store our connection ID so the controller can direct response to us.
 		parcel.Header.TargetPeer = c.peer.Hash
 		parcel.Header.NodeID = NodeID
 		BlockFreeChannelSend(c.ReceiveChannel, ConnectionParcel{Parcel: parcel}) // Controller handles these.
 	case TypeMessagePart:
 		c.peer.QualityScore = c.peer.QualityScore + 1
+		c.metrics.UsefulMessages++
 		// Store our connection ID so the controller can direct response to us.
 		parcel.Header.TargetPeer = c.peer.Hash
 		parcel.Header.NodeID = NodeID
@@ -663,6 +671,15 @@ func (c *Connection) pingPeer() {
 	}
 }
 
+// updateLatency averages in a ping round trip, weighting the latest a quarter.
+func (c *Connection) updateLatency(roundTrip time.Duration) {
+	if c.metrics.Latency == 0 {
+		c.metrics.Latency = roundTrip
+	} else {
+		c.metrics.Latency = (3*c.metrics.Latency + roundTrip) / 4
+	}
+}
+
 func (c *Connection) updatePeer() {
 	c.timeLastUpdate = time.Now()
 	BlockFreeChannelSend(c.ReceiveChannel, ConnectionCommand{Command: ConnectionUpdatingPeer, Peer: c.peer})
@@ -670,6 +687,9 @@ func (c *Connection) updatePeer() {
 
 func (c *Connection) updateStats() {
 	if time.Second < time.Since(c.timeLastMetrics) {
+		if c.IsOnline() {
+			c.metrics.TimeOnline += time.Since(c.timeLastMetrics)
+		}
 		c.timeLastMetrics = time.Now()
 		c.metrics.PeerAddress = c.peer.Address
 		c.metrics.PeerQuality = c.peer.QualityScore
@@ -15,6 +15,7 @@ import (
 	"math/rand"
 	"net"
 	"strings"
+	"sync"
 	"time"
 	"unicode"
 
@@ -46,6 +47,8 @@ type Controller struct {
 
 	connectionMetrics           map[string]ConnectionMetrics // map of the metrics indexed by peer hash
 	lastConnectionMetricsUpdate time.Time                    // update once a second.
+	lastMetrics                 map[string]ConnectionMetrics // copy of the last metrics sent, for ConnectionMetrics()
+	lastMetricsMutex            sync.RWMutex
 
 	discovery Discovery // Our discovery structure
 
@@ -57,12 +60,16 @@ type Controller struct {
 	lastStatusReport           time.Time
 	lastPeerRequest            time.Time       // Last time we asked peers about the peers they know about.
 	specialPeersString         string          // configuration set special peers
+	managedPeers               *ManagedPeers   // bans and special peers added at runtime
+	reputations                *Reputations    // how peers have behaved, kept across restarts
 	partsAssembler             *PartsAssembler // a data structure that assembles full messages from received message parts
 }
 
 type ControllerInit struct {
 	Port                     string           // Port to listen on
 	PeersFile                string           // Path to file to find / save peers
+	ManagedPeersFile         string           // Path to file to keep bans and special peers added at runtime
+	PeerReputationFile       string           // Path to file to keep peer reputations
 	Network                  NetworkID        // Network - eg MainNet, TestNet etc.
 	Exclusive                bool             // flag to indicate we should only connect to trusted peers
 	SeedURL                  string           // URL to a source of peer info
@@ -108,11 +115,29 @@ func (e *CommandAdjustPeerQuality) String() string {
 	return str
 }
 
-// CommandBan is used to instruct the Controller to disconnect and ban a peer
-type CommandBan struct {
+// CommandDisconnect is used to instruct the Controller to disconnect from a peer
+type CommandDisconnect struct {
 	PeerHash string
 }
 
+func (e *CommandDisconnect) JSONByte() ([]byte, error) {
+	return primitives.EncodeJSON(e)
+}
+
+func (e *CommandDisconnect) JSONString() (string, error) {
+	return primitives.EncodeJSONString(e)
+}
+
+func (e *CommandDisconnect) String() string {
+	str, _ := e.JSONString()
+	return str
+}
+
+// CommandBan is used to instruct the Controller to disconnect from the peers covered by a new ban
+type CommandBan struct {
+	Address string
+}
+
 func (e *CommandBan) JSONByte() ([]byte, error) {
 	return primitives.EncodeJSON(e)
 }
@@ -126,20 +151,20 @@ func (e *CommandBan) String() string {
 	return str
 }
 
-// CommandDisconnect is used to instruct the Controller to disconnect from a peer
-type CommandDisconnect struct {
+// CommandInvalidMessage is used to tell the Controller a peer sent a message the application could not use
+type CommandInvalidMessage struct {
 	PeerHash string
 }
 
-func (e *CommandDisconnect) JSONByte() ([]byte, error) {
+func (e *CommandInvalidMessage) JSONByte() ([]byte, error) {
 	return primitives.EncodeJSON(e)
 }
 
-func (e *CommandDisconnect) JSONString() (string, error) {
+func (e *CommandInvalidMessage) JSONString() (string, error) {
 	return primitives.EncodeJSONString(e)
 }
 
-func (e *CommandDisconnect) String() string {
+func (e *CommandInvalidMessage) String() string {
 	str, _ := e.JSONString()
 	return str
 }
@@ -162,6 +187,25 @@ func (e *CommandChangeLogging) String() string {
 	return str
 }
 
+// CommandChangePeerLimits is used to instruct the Controller to change how many peers it connects to.
+type CommandChangePeerLimits struct {
+	Outgoing int
+	Incoming int
+}
+
+func (e *CommandChangePeerLimits) JSONByte() ([]byte, error) {
+	return primitives.EncodeJSON(e)
+}
+
+func (e *CommandChangePeerLimits) JSONString() (string, error) {
+	return primitives.EncodeJSONString(e)
+}
+
+func (e *CommandChangePeerLimits) String() string {
+	str, _ := e.JSONString()
+	return str
+}
+
 //////////////////////////////////////////////////////////////////////
 // Public (exported) methods.
 //
@@ -196,6 +240,17 @@ func (c *Controller) Init(ci ControllerInit) *Controller {
 	c.partsAssembler = new(PartsAssembler).Init()
 	discovery := new(Discovery).Init(ci.PeersFile, ci.SeedURL)
 	c.discovery = *discovery
+	managedPeers, err := LoadManagedPeers(ci.ManagedPeersFile)
+	if err != nil {
+		logerror("ctrlr", "Controller.Init() could not load the managed peers, bans and special peers added will not be saved: %v", err)
+	}
+	c.managedPeers = managedPeers
+	reputations, err := LoadReputations(ci.PeerReputationFile)
+	if err != nil {
+		logerror("ctrlr", "Controller.Init() could not load the peer reputations, they will not be saved: %v", err)
+	}
+	c.reputations = reputations
+	c.discovery.reputations = reputations
 	// Set this to the past so we will do peer management almost right away after starting up.
 	note("ctrlr", "\n\n\n\n\nController.Init(%s) Controller is: %+v\n\n", ci.Port, c)
 	return c
@@ -209,6 +264,7 @@ func (c *Controller) StartNetwork() {
 	c.listen()
 	// Dial the peers in from configuration
 	c.DialSpecialPeersString(c.specialPeersString)
+	c.DialSpecialPeersString(strings.Join(c.managedPeers.SpecialPeers(), " "))
 	// Start the runloop
 	go c.runloop()
 }
@@ -260,14 +316,89 @@ func (c *Controller) AdjustPeerQuality(peerHash string, adjustment int32) {
 	BlockFreeChannelSend(c.commandChannel, CommandAdjustPeerQuality{PeerHash: peerHash, Adjustment: adjustment})
 }
 
-func (c *Controller) Ban(peerHash string) {
-	BlockFreeChannelSend(c.commandChannel, CommandBan{PeerHash: peerHash})
+// ReportInvalidMessage counts against a peer's reputation a message from it
+// that the application could not decode.
+func (c *Controller) ReportInvalidMessage(peerHash string) {
+	BlockFreeChannelSend(c.commandChannel, CommandInvalidMessage{PeerHash: peerHash})
 }
 
 func (c *Controller) Disconnect(peerHash string) {
 	BlockFreeChannelSend(c.commandChannel, CommandDisconnect{PeerHash: peerHash})
 }
 
+// Ban bans an IP address, or a CIDR range, for the duration, or for good if
+// it is 0, and disconnects the peers it covers.  The ban is saved so it lasts
+// across restarts.  Every ban goes through here, whether from the control
+// panel, the debug console or a peer's reputation.
+func (c *Controller) Ban(address string, duration time.Duration, reason string) error {
+	ban, err := c.managedPeers.Ban(address, duration, reason)
+	if ban == nil {
+		return err
+	}
+	BlockFreeChannelSend(c.commandChannel, CommandBan{Address: ban.Address})
+	return err
+}
+
+// Unban lifts a ban added by Ban.
+func (c *Controller) Unban(address string) error {
+	return c.managedPeers.Unban(address)
+}
+
+// Bans lists the bans added by Ban that are still in force.
+func (c *Controller) Bans() []Ban {
+	return c.managedPeers.Bans()
+}
+
+// BanHistory lists the bans added, lifted and run out, oldest first.
+func (c *Controller) BanHistory() []BanEvent {
+	return c.managedPeers.BanHistory()
+}
+
+// AddSpecialPeer dials a peer, given as address:port, and keeps it connected.
+// It is saved, and dialed again after a restart.
+func (c *Controller) AddSpecialPeer(peer string) error {
+	if err := c.managedPeers.AddSpecialPeer(peer); err != nil {
+		return err
+	}
+	c.DialSpecialPeersString(peer)
+	return nil
+}
+
+// RemoveSpecialPeer removes a peer added by AddSpecialPeer.  It stays
+// connected until a restart.
+func (c *Controller) RemoveSpecialPeer(peer string) error {
+	return c.managedPeers.RemoveSpecialPeer(peer)
+}
+
+// AddedSpecialPeers lists the peers added by AddSpecialPeer, as address:port.
+func (c *Controller) AddedSpecialPeers() []string {
+	return c.managedPeers.SpecialPeers()
+}
+
+// Reputations lists the reputations of the peers we have been connected to,
+// best first.
+func (c *Controller) Reputations() []Reputation {
+	return c.reputations.All()
+}
+
+// SetPeerLimits changes how many peers we dial and how many incoming
+// connections we accept.  Connections over the new limits are not dropped.
+func (c *Controller) SetPeerLimits(outgoing int, incoming int) {
+	BlockFreeChannelSend(c.commandChannel, CommandChangePeerLimits{Outgoing: outgoing, Incoming: incoming})
+}
+
+// ConnectionMetrics returns the metrics of each connection, by peer hash, as
+// of the last update.  It can be called from any goroutine.
+func (c *Controller) ConnectionMetrics() map[string]ConnectionMetrics {
+	c.lastMetricsMutex.RLock()
+	defer c.lastMetricsMutex.RUnlock()
+	metrics := make(map[string]ConnectionMetrics)
+	for key, value := range c.lastMetrics {
+		metrics[key] = value
+	}
+	return metrics
+}
+
 func (c *Controller) GetNumberConnections() int {
 	return len(c.connections)
 }
@@ -305,6 +436,9 @@ func (c *Controller) acceptLoop(listener net.Listener) {
 		switch err {
 		case nil:
 			switch {
+			case c.managedPeers.IsBanned(conn.RemoteAddr().String()):
+				note("ctrlr", "Controller.acceptLoop() new peer, but it is banned. %s", conn.RemoteAddr())
+				conn.Close()
 			case c.numberIncommingConnections < MaxNumberIncommingConnections:
 				c.AddPeer(conn) // Sends command to add the peer to the peers list
 				note("ctrlr", "Controller.acceptLoop() new peer: %+v", conn)
@@ -532,7 +666,9 @@ func (c *Controller) handleParcelReceive(message interface{}, peerHash string, c
 func (c *Controller) handleConnectionCommand(command ConnectionCommand, connection Connection) {
 	switch command.Command {
 	case ConnectionUpdateMetrics:
+		last := c.connectionMetrics[connection.peer.Hash]
 		c.connectionMetrics[connection.peer.Hash] = command.Metrics
+		c.updateReputation(&connection, last, command.Metrics)
 	case ConnectionIsClosed:
 		delete(c.connectionsByAddress, connection.peer.Address)
 		delete(c.connections, connection.peer.Hash)
@@ -549,6 +685,10 @@ func (c *Controller) handleCommand(command interface{}) {
 	switch commandType := command.(type) {
 	case CommandDialPeer: // parameter is the peer address
 		parameters := command.(CommandDialPeer)
+		if c.managedPeers.IsBanned(parameters.peer.Address) {
+			note("ctrlr", "Not dialing %s, it is banned", parameters.peer.AddressPort())
+			break
+		}
 		conn := new(Connection).Init(parameters.peer, parameters.persistent)
 		conn.Start()
 
@@ -576,10 +716,13 @@ func (c *Controller) handleCommand(command interface{}) {
 		parameters := command.(CommandAdjustPeerQuality)
 		peerHash := parameters.PeerHash
 		c.applicationPeerUpdate(parameters.Adjustment, peerHash)
-	case CommandBan:
-		parameters := command.(CommandBan)
-		peerHash := parameters.PeerHash
-		c.applicationPeerUpdate(BannedQualityScore, peerHash)
+	case CommandInvalidMessage:
+		parameters := command.(CommandInvalidMessage)
+		connection, present := c.connections[parameters.PeerHash]
+		if present {
+			score := c.reputations.Record(connection.peer.Address, 1, 0, 0, 0)
+			c.checkReputation(connection, score)
+		}
 	case CommandDisconnect:
 		parameters := command.(CommandDisconnect)
 		peerHash := parameters.PeerHash
@@ -587,6 +730,18 @@ func (c *Controller) handleCommand(command interface{}) {
 		if present {
 			BlockFreeChannelSend(connection.SendChannel, ConnectionCommand{Command: ConnectionShutdownNow})
 		}
+	case CommandBan:
+		parameters := command.(CommandBan)
+		for _, connection := range c.connections {
+			if c.managedPeers.IsBanned(connection.peer.Address) {
+				note("ctrlr", "Disconnecting %s, it is banned by %s", connection.peer.AddressPort(), parameters.Address)
+				BlockFreeChannelSend(connection.SendChannel, ConnectionCommand{Command: ConnectionShutdownNow})
+			}
+		}
+	case CommandChangePeerLimits:
+		parameters := command.(CommandChangePeerLimits)
+		NumberPeersToConnect = parameters.Outgoing
+		MaxNumberIncommingConnections = parameters.Incoming
 	default:
 		logfatal("ctrlr", "Unkown p2p.Controller command recieved: %+v", commandType)
 	}
@@ -599,6 +754,39 @@ func (c *Controller) applicationPeerUpdate(qualityDelta int32, peerHash string)
 	}
 }
 
+// updateReputation adds what a connection's peer has done since its last
+// metrics to the peer's reputation.
+func (c *Controller) updateReputation(connection *Connection, last ConnectionMetrics, metrics ConnectionMetrics) {
+	if metrics.InvalidMessages < last.InvalidMessages || metrics.UsefulMessages < last.UsefulMessages || metrics.TimeOnline < last.TimeOnline {
+		last = ConnectionMetrics{} // The connection started over
+	}
+	score := c.reputations.Record(connection.peer.Address,
+		metrics.InvalidMessages-last.InvalidMessages,
+		metrics.UsefulMessages-last.UsefulMessages,
+		metrics.Latency,
+		metrics.TimeOnline-last.TimeOnline)
+	c.checkReputation(connection, score)
+}
+
+// checkReputation bans a peer for ReputationBanDuration if its score is below
+// ReputationBanScore.  Persistent peers are never banned.
+func (c *Controller) checkReputation(connection *Connection, score int32) {
+	if ReputationBanScore <= score || connection.IsPersistent() || c.managedPeers.IsBanned(connection.peer.Address) {
+		return
+	}
+	c.banPeer(connection, fmt.Sprintf("reputation score %d", score))
+}
+
+// banPeer bans a connection's peer for ReputationBanDuration, which
+// disconnects it.
+func (c *Controller) banPeer(connection *Connection, reason string) {
+	significant("ctrlr", "Banning %s for %s: %s", connection.peer.AddressPort(), ReputationBanDuration.String(), reason)
+	if err := c.Ban(connection.peer.Address, ReputationBanDuration, reason); err != nil {
+		logerror("ctrlr", "Could not ban %s: %v", connection.peer.Address, err)
+		BlockFreeChannelSend(connection.SendChannel, ConnectionCommand{Command: ConnectionShutdownNow})
+	}
+}
+
 func (c *Controller) managePeers() {
 	managementDuration := time.Since(c.lastPeerManagement)
 	if PeerSaveInterval < managementDuration {
@@ -625,6 +813,12 @@ func (c *Controller) managePeers() {
 			note("controller", "Saving peers")
 			c.discovery.SavePeers()
 			c.discovery.PrintPeers() // No-op if debugging off.
+			if err := c.reputations.Save(); err != nil {
+				logerror("ctrlr", "Could not save the peer reputations: %v", err)
+			}
+			if err := c.managedPeers.Expire(); err != nil {
+				logerror("ctrlr", "Could not save the bans that ran out: %v", err)
+			}
 		}
 		dot("&&u\n")
 		duration = time.Since(c.lastPeerRequest)
@@ -680,7 +874,7 @@ func (c *Controller) fillOutgoingSlots(openSlots int) {
 	// To avoid dialing "too many" peers, we are keeping a count and only dialing the number of peers we need to add.
 	newPeers := 0
 	for _, peer := range peers {
-		if c.weAreNotAlreadyConnectedTo(peer) && newPeers < openSlots {
+		if c.weAreNotAlreadyConnectedTo(peer) && !c.managedPeers.IsBanned(peer.Address) && newPeers < openSlots {
 			note("controller", "newPeers: %d < openSlots: %d We think we are not already connected to: %s so dialing.", newPeers, openSlots, peer.AddressPort())
 			newPeers = newPeers + 1
 			c.DialPeer(peer, false)
@@ -708,10 +902,22 @@ func (c *Controller) updateMetrics() {
 					PeerQuality:      metrics.PeerQuality,
 					ConnectionState:  metrics.ConnectionState,
 					ConnectionNotes:  metrics.ConnectionNotes,
+					InvalidMessages:  metrics.InvalidMessages,
+					UsefulMessages:   metrics.UsefulMessages,
+					Latency:          metrics.Latency,
+					TimeOnline:       metrics.TimeOnline,
 				}
 			}
 		}
 		dot("@@9\n")
+		// The receiver may change the map it is sent, so keep our own copy
+		lastMetrics := make(map[string]ConnectionMetrics)
+		for key, value := range newMetrics {
+			lastMetrics[key] = value
+		}
+		c.lastMetricsMutex.Lock()
+		c.lastMetrics = lastMetrics
+		c.lastMetricsMutex.Unlock()
 		BlockFreeChannelSend(c.connectionMetricsChannel, newMetrics)
 		dot("@@10\n")
 	}
@@ -723,6 +929,9 @@ func (c *Controller) shutdown() {
 	for _, connection := range c.connections {
 		BlockFreeChannelSend(connection.SendChannel, ConnectionCommand{Command: ConnectionShutdownNow})
 	}
+	if err := c.reputations.Save(); err != nil {
+		logerror("ctrlr", "Could not save the peer reputations: %v", err)
+	}
 	c.keepRunning = false
 }
 
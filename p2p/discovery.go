@@ -21,10 +21,11 @@ import (
 type Discovery struct {
 	knownPeers map[string]Peer // peers we know about indexed by hash
 
-	peersFilePath string     // the path to the peers.
-	lastPeerSave  time.Time  // Last time we saved known peers.
-	rng           *rand.Rand // RNG = random number generator
-	seedURL       string     // URL to the source of a list of peers
+	peersFilePath string       // the path to the peers.
+	lastPeerSave  time.Time    // Last time we saved known peers.
+	rng           *rand.Rand   // RNG = random number generator
+	seedURL       string       // URL to the source of a list of peers
+	reputations   *Reputations // How peers have behaved, to choose which to dial
 }
 
 var UpdateKnownPeers sync.Mutex
@@ -191,10 +192,35 @@ func (d *Discovery) filterForUniqueIPAdresses(peers []Peer) (filtered []Peer) {
 	return
 }
 
+// filterPeersWithPoorReputations drops the peers scoring less than
+// MinimumOutgoingReputation, other than special peers.
+func (d *Discovery) filterPeersWithPoorReputations(peers []Peer) (filtered []Peer) {
+	for _, peer := range peers {
+		if SpecialPeer == peer.Type || d.reputations == nil || MinimumOutgoingReputation <= d.reputations.Score(peer.Address) {
+			filtered = append(filtered, peer)
+		}
+	}
+	return filtered
+}
+
+// sortByReputation puts the peers with the best reputations first, so they
+// are dialed first.  Peers with the same score stay in the same order.
+func (d *Discovery) sortByReputation(peers []Peer) {
+	if d.reputations == nil {
+		return
+	}
+	scores := map[string]int32{}
+	for _, peer := range peers {
+		scores[peer.Address] = d.reputations.Score(peer.Address)
+	}
+	sort.SliceStable(peers, func(i, j int) bool { return scores[peers[i].Address] > scores[peers[j].Address] })
+}
+
 // GetOutgoingPeers gets a set of peers to connect to on startup
 // For now, this gives a set of 12 of the total known peers.
 // We want peers from diverse networks.  So,method is this:
 //	-- generate list of candidates (if exclusive, only special peers)
+//	-- drop candidates with a reputation below MinimumOutgoingReputation, and dial the best reputations first
 //	-- sort candidates by distance
 //  -- if num canddiates is less than desired set, return all candidates
 //  -- Otherwise,repeatedly take candidates at the 0%, %25, %50, %75, %100 points in the list
@@ -215,12 +241,14 @@ func (d *Discovery) GetOutgoingPeers() []Peer {
 	}
 	UpdateKnownPeers.Unlock()
 	secondPass := d.filterPeersFromOtherNetworks(firstPassPeers)
-	peerPool := d.filterForUniqueIPAdresses(secondPass)
+	thirdPass := d.filterForUniqueIPAdresses(secondPass)
+	peerPool := d.filterPeersWithPoorReputations(thirdPass)
 	sort.Sort(PeerDistanceSort(peerPool))
 	// Get four times as many as who knows how many will be online
 	desiredQuantity := NumberPeersToConnect * 4
 	// If the peer pool isn't at least twice the size of what we need, then location diversity is meaningless.
 	if len(peerPool) < desiredQuantity*2 {
+		d.sortByReputation(peerPool)
 		return peerPool
 	}
 	// Algo is to divide peers up into buckets, sorted by distance.
@@ -242,6 +270,7 @@ func (d *Discovery) GetOutgoingPeers() []Peer {
 	for _, v := range selectedPeers {
 		finalSet = append(finalSet, v)
 	}
+	d.sortByReputation(finalSet)
 	note("discovery", "discovery.GetOutgoingPeers() got the following peers: %+v", finalSet)
 	return finalSet
 }
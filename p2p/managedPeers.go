@@ -0,0 +1,319 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Ban keeps a peer, or a range of addresses, from connecting until it
+// expires.
+type Ban struct {
+	Address string    `json:"address"` // An IP address, or a range in CIDR notation
+	Reason  string    `json:"reason"`
+	Created time.Time `json:"created"`
+	Expires time.Time `json:"expires"` // The zero time for a ban that does not expire
+
+	network *net.IPNet // The addresses covered, parsed once as every dial and accept is checked
+}
+
+// Expired is true once the ban is over.
+func (b *Ban) Expired(now time.Time) bool {
+	return !b.Expires.IsZero() && now.After(b.Expires)
+}
+
+// Covers is true if the ban is for the IP address.
+func (b *Ban) Covers(ip net.IP) bool {
+	return b.network != nil && b.network.Contains(ip)
+}
+
+// parse sets the addresses the ban covers.
+func (b *Ban) parse() error {
+	if _, network, err := net.ParseCIDR(b.Address); err == nil {
+		b.network = network
+		return nil
+	}
+	ip := net.ParseIP(b.Address)
+	if ip == nil {
+		return fmt.Errorf("%s is not an IP address or a CIDR range", b.Address)
+	}
+	bits := 8 * net.IPv6len
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, bits = ip4, 8*net.IPv4len
+	}
+	b.network = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+	return nil
+}
+
+// BanEvent records a ban being added, lifted or running out.
+type BanEvent struct {
+	Time    time.Time `json:"time"`
+	Action  string    `json:"action"` // ban, unban or expired
+	Address string    `json:"address"`
+	Reason  string    `json:"reason"`
+	Expires time.Time `json:"expires"`
+}
+
+// How many ban events are kept
+const maxBanHistory = 1000
+
+// ManagedPeers are the bans and special peers added while the node runs,
+// from the control panel or debug console.  They are kept in a file so they
+// last across restarts.  It has its own lock, as the controller's goroutines
+// and the control panel all use it.  Checking a ban only reads, bans that ran
+// out are dropped from the file by Expire.
+type ManagedPeers struct {
+	mutex        sync.RWMutex
+	filename     string // Not saved if empty
+	bans         []*Ban
+	history      []*BanEvent
+	specialPeers []string // As address:port
+}
+
+// managedPeersFile is what is saved.
+type managedPeersFile struct {
+	Bans         []*Ban      `json:"bans"`
+	History      []*BanEvent `json:"history"`
+	SpecialPeers []string    `json:"specialpeers"`
+}
+
+// LoadManagedPeers reads the managed peers from a file, which need not exist
+// yet.  If the file can't be read the list is empty, and is not saved so the
+// file is left for someone to look at.
+func LoadManagedPeers(filename string) (*ManagedPeers, error) {
+	m := new(ManagedPeers)
+	data, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		m.filename = filename
+		return m, nil
+	}
+	if err != nil {
+		return m, err
+	}
+	var saved managedPeersFile
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return m, fmt.Errorf("%s: %v", filename, err)
+	}
+	for _, ban := range saved.Bans {
+		if err := ban.parse(); err != nil {
+			return m, fmt.Errorf("%s: %v", filename, err)
+		}
+	}
+	m.filename = filename
+	m.bans = saved.Bans
+	m.history = saved.History
+	m.specialPeers = saved.SpecialPeers
+	return m, nil
+}
+
+// save writes the file, with the lock held.
+func (m *ManagedPeers) save() error {
+	if m.filename == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(managedPeersFile{Bans: m.bans, History: m.history, SpecialPeers: m.specialPeers}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(m.filename, data, 0644)
+}
+
+// record adds to the ban history, with the lock held.
+func (m *ManagedPeers) record(action string, ban *Ban, now time.Time) {
+	m.history = append(m.history, &BanEvent{Time: now, Action: action, Address: ban.Address, Reason: ban.Reason, Expires: ban.Expires})
+	if len(m.history) > maxBanHistory {
+		m.history = m.history[len(m.history)-maxBanHistory:]
+	}
+}
+
+// expire drops the bans that are over, with the lock held.  It returns true
+// if there were any.
+func (m *ManagedPeers) expire(now time.Time) bool {
+	var current []*Ban
+	for _, ban := range m.bans {
+		if ban.Expired(now) {
+			m.record("expired", ban, now)
+		} else {
+			current = append(current, ban)
+		}
+	}
+	if len(current) == len(m.bans) {
+		return false
+	}
+	m.bans = current
+	return true
+}
+
+// Expire drops the bans that are over and saves the file if there were any.
+// The controller calls it as it saves the peers.
+func (m *ManagedPeers) Expire() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if !m.expire(time.Now()) {
+		return nil
+	}
+	return m.save()
+}
+
+// BanAddress gives an IP address or CIDR range in its usual form.
+func BanAddress(address string) (string, error) {
+	if _, network, err := net.ParseCIDR(address); err == nil {
+		return network.String(), nil
+	}
+	if ip := net.ParseIP(address); ip != nil {
+		return ip.String(), nil
+	}
+	return "", fmt.Errorf("%s is not an IP address or a CIDR range", address)
+}
+
+// Ban bans an address or range for the duration, or for good if it is 0.  It
+// replaces any ban already on the address.
+func (m *ManagedPeers) Ban(address string, duration time.Duration, reason string) (*Ban, error) {
+	address, err := BanAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	if duration < 0 {
+		return nil, fmt.Errorf("A ban can't have a negative duration")
+	}
+	now := time.Now()
+	ban := &Ban{Address: address, Reason: reason, Created: now}
+	if duration > 0 {
+		ban.Expires = now.Add(duration)
+	}
+	if err := ban.parse(); err != nil {
+		return nil, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	var bans []*Ban
+	for _, b := range m.bans {
+		if b.Address != address {
+			bans = append(bans, b)
+		}
+	}
+	m.bans = append(bans, ban)
+	m.record("ban", ban, now)
+	copy := *ban
+	return &copy, m.save()
+}
+
+// Unban lifts the ban on an address or range.
+func (m *ManagedPeers) Unban(address string) error {
+	address, err := BanAddress(address)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	expired := m.expire(now)
+	for i, ban := range m.bans {
+		if ban.Address == address {
+			m.bans = append(m.bans[:i], m.bans[i+1:]...)
+			m.record("unban", ban, now)
+			return m.save()
+		}
+	}
+	if expired {
+		if err := m.save(); err != nil {
+			return err
+		}
+	}
+	return fmt.Errorf("%s is not banned", address)
+}
+
+// IsBanned is true if a ban covers the address, which may have a port.
+func (m *ManagedPeers) IsBanned(address string) bool {
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		address = host
+	}
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+
+	now := time.Now()
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	for _, ban := range m.bans {
+		if !ban.Expired(now) && ban.Covers(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Bans lists the current bans, by address.
+func (m *ManagedPeers) Bans() []Ban {
+	now := time.Now()
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	var bans []Ban
+	for _, ban := range m.bans {
+		if !ban.Expired(now) {
+			bans = append(bans, *ban)
+		}
+	}
+	sort.Slice(bans, func(i, j int) bool { return bans[i].Address < bans[j].Address })
+	return bans
+}
+
+// BanHistory lists the bans added, lifted and run out, oldest first.  Bans
+// are listed as run out once Expire has dropped them.
+func (m *ManagedPeers) BanHistory() []BanEvent {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	var history []BanEvent
+	for _, event := range m.history {
+		history = append(history, *event)
+	}
+	return history
+}
+
+// AddSpecialPeer adds an address:port to the special peers.
+func (m *ManagedPeers) AddSpecialPeer(peer string) error {
+	if _, _, err := net.SplitHostPort(peer); err != nil {
+		return fmt.Errorf("%s is not a valid peer (%v), use the form 127.0.0.1:8999", peer, err)
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for _, p := range m.specialPeers {
+		if p == peer {
+			return fmt.Errorf("%s is already a special peer", peer)
+		}
+	}
+	m.specialPeers = append(m.specialPeers, peer)
+	return m.save()
+}
+
+// RemoveSpecialPeer removes an address:port from the special peers.
+func (m *ManagedPeers) RemoveSpecialPeer(peer string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for i, p := range m.specialPeers {
+		if p == peer {
+			m.specialPeers = append(m.specialPeers[:i], m.specialPeers[i+1:]...)
+			return m.save()
+		}
+	}
+	return fmt.Errorf("%s was not added as a special peer", peer)
+}
+
+// SpecialPeers lists the special peers that were added, as address:port.
+func (m *ManagedPeers) SpecialPeers() []string {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return append([]string(nil), m.specialPeers...)
+}
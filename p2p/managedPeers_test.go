@@ -0,0 +1,120 @@
+package p2p_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/FactomProject/factomd/p2p"
+)
+
+func TestManagedPeers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "managedpeers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "managedpeers.json")
+
+	m, err := LoadManagedPeers(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Ban("not an address", 0, ""); err == nil {
+		t.Errorf("Banned a bad address")
+	}
+	if _, err := m.Ban("10.1.2.3", 0, "spam"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Ban("192.168.7.9/16", time.Hour, "range"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Ban("172.16.0.1", time.Nanosecond, "short"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Ban("2001:db8::/32", 0, "ipv6"); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Millisecond)
+
+	for address, banned := range map[string]bool{
+		"10.1.2.3":         true,
+		"10.1.2.3:8108":    true,
+		"10.1.2.4":         false,
+		"192.168.200.1":    true,
+		"192.169.0.1":      false,
+		"172.16.0.1":       false, // Expired
+		"[2001:db8::1]:80": true,
+		"2001:db9::1":      false,
+		"seed.factom.com":  false,
+	} {
+		if m.IsBanned(address) != banned {
+			t.Errorf("IsBanned(%s) is %v", address, !banned)
+		}
+	}
+	bans := m.Bans()
+	if len(bans) != 3 || bans[0].Address != "10.1.2.3" || bans[1].Address != "192.168.0.0/16" || !bans[0].Expires.IsZero() {
+		t.Errorf("Wrong bans %v", bans)
+	}
+	if err := m.Expire(); err != nil {
+		t.Error(err)
+	}
+
+	if err := m.Unban("10.1.2.3"); err != nil {
+		t.Error(err)
+	}
+	if err := m.Unban("10.1.2.3"); err == nil {
+		t.Errorf("Unbanned an address twice")
+	}
+	if m.IsBanned("10.1.2.3") {
+		t.Errorf("Still banned after the unban")
+	}
+
+	if err := m.AddSpecialPeer("10.0.0.1"); err == nil {
+		t.Errorf("Added a special peer without a port")
+	}
+	if err := m.AddSpecialPeer("10.0.0.1:8108"); err != nil {
+		t.Error(err)
+	}
+	if err := m.AddSpecialPeer("10.0.0.2:8108"); err != nil {
+		t.Error(err)
+	}
+	if err := m.RemoveSpecialPeer("10.0.0.2:8108"); err != nil {
+		t.Error(err)
+	}
+
+	// Everything is still there after a restart
+	m, err = LoadManagedPeers(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m.IsBanned("192.168.1.1") || m.IsBanned("10.1.2.3") {
+		t.Errorf("Wrong bans after reloading %v", m.Bans())
+	}
+	var actions []string
+	for _, event := range m.BanHistory() {
+		actions = append(actions, event.Action+" "+event.Address)
+	}
+	want := []string{"ban 10.1.2.3", "ban 192.168.0.0/16", "ban 172.16.0.1", "ban 2001:db8::/32", "expired 172.16.0.1", "unban 10.1.2.3"}
+	if len(actions) != len(want) {
+		t.Fatalf("Wrong history %v", actions)
+	}
+	for i := range want {
+		if actions[i] != want[i] {
+			t.Errorf("Wrong history %v", actions)
+			break
+		}
+	}
+	if peers := m.SpecialPeers(); len(peers) != 1 || peers[0] != "10.0.0.1:8108" {
+		t.Errorf("Wrong special peers %v", peers)
+	}
+
+	if err := ioutil.WriteFile(filename, []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadManagedPeers(filename); err == nil {
+		t.Errorf("Loaded a bad file")
+	}
+}
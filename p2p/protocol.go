@@ -42,6 +42,12 @@ func BlockFreeChannelSend(channel chan interface{}, message interface{}) int {
 	return removed
 }
 
+// Defaults for the peer limits, which the config file can change
+const (
+	DefaultNumberPeersToConnect          = 32
+	DefaultMaxNumberIncommingConnections = 150
+)
+
 // Global variables for the p2p protocol
 var (
 	CurrentLoggingLevel                  = Errors // Start at verbose because it takes a few seconds for the controller to adjust to what you set.
@@ -55,9 +61,9 @@ var (
 	MinumumSharingQualityScore    int32  = 20          // if a peer's score is less than this we don't share them.
 	OnlySpecialPeers                     = false
 	NetworkDeadline                      = time.Duration(30) * time.Second
-	NumberPeersToConnect                 = 32
+	NumberPeersToConnect                 = DefaultNumberPeersToConnect
 	NumberPeersToBroadcast               = 100
-	MaxNumberIncommingConnections        = 150
+	MaxNumberIncommingConnections        = DefaultMaxNumberIncommingConnections
 	MaxNumberOfRedialAttempts            = 5 // How many missing pings (and other) before we give up and close.
 	StandardChannelSize                  = 5000
 	NetworkStatusInterval                = time.Second * 9
@@ -68,6 +74,21 @@ var (
 	PeerRequestInterval                  = time.Second * 180
 	PeerDiscoveryInterval                = time.Hour * 4
 
+	// Peer reputation, see reputation.go
+	ReputationInvalidPenalty  int32  = 50               // Lost for each invalid message
+	ReputationUsefulMessages  uint32 = 10               // Application messages for each point gained
+	ReputationMaxUseful       int32  = 500              // Most points from useful messages
+	ReputationUptimeUnit             = time.Minute * 10 // Time online for each point gained
+	ReputationMaxUptime       int32  = 500              // Most points from uptime
+	ReputationFastLatency            = time.Millisecond * 250
+	ReputationSlowLatency            = time.Second * 2
+	ReputationLatencyScore    int32  = 100                 // Gained for a fast ping, lost for a slow one
+	ReputationDecayInterval          = time.Hour * 24      // The counts are halved this often, so old behaviour is forgotten
+	ReputationForgetAfter            = time.Hour * 24 * 30 // Peers not seen for this long are not saved
+	ReputationBanScore        int32  = -500                // Peers scoring less than this are banned
+	ReputationBanDuration            = time.Hour * 24
+	MinimumOutgoingReputation int32  = -100 // We don't dial peers scoring less than this
+
 	// Testing metrics
 	TotalMessagesRecieved       uint64
 	TotalMessagesSent           uint64
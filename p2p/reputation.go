@@ -0,0 +1,192 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Reputation is how a peer, by IP address, has behaved over all its
+// connections.  The counts are halved every ReputationDecayInterval, so a peer
+// can live down a bad patch, and can't coast on a good one.  A latency that
+// has not been measured again in that long is forgotten.
+type Reputation struct {
+	Address         string        `json:"address"`
+	Score           int32         `json:"score"`
+	InvalidMessages uint32        `json:"invalidmessages"` // Messages that failed to check or decode
+	UsefulMessages  uint32        `json:"usefulmessages"`  // Application messages received
+	Latency         time.Duration `json:"latency"`         // Ping round trip, 0 if it has not been measured
+	Uptime          time.Duration `json:"uptime"`          // Time connected and online
+	LastSeen        time.Time     `json:"lastseen"`
+	LastDecay       time.Time     `json:"lastdecay"`
+	LatencyMeasured time.Time     `json:"latencymeasured"`
+}
+
+// score works out the score from the counts.  Invalid messages cost
+// ReputationInvalidPenalty each, useful messages and uptime earn up to
+// ReputationMaxUseful and ReputationMaxUptime, and latency adds or takes away
+// ReputationLatencyScore if it is fast or slow.
+func (r *Reputation) score() int32 {
+	score := -int64(ReputationInvalidPenalty) * int64(r.InvalidMessages)
+	useful := int64(r.UsefulMessages / ReputationUsefulMessages)
+	if useful > int64(ReputationMaxUseful) {
+		useful = int64(ReputationMaxUseful)
+	}
+	uptime := int64(r.Uptime / ReputationUptimeUnit)
+	if uptime > int64(ReputationMaxUptime) {
+		uptime = int64(ReputationMaxUptime)
+	}
+	score += useful + uptime
+	switch {
+	case r.Latency == 0:
+	case r.Latency < ReputationFastLatency:
+		score += int64(ReputationLatencyScore)
+	case r.Latency > ReputationSlowLatency:
+		score -= int64(ReputationLatencyScore)
+	}
+	if score < int64(BannedQualityScore) {
+		score = int64(BannedQualityScore)
+	}
+	return int32(score)
+}
+
+// decay halves the counts for each ReputationDecayInterval since the last
+// time, forgets an old latency, and updates the score.
+func (r *Reputation) decay(now time.Time) {
+	if r.LastDecay.IsZero() {
+		r.LastDecay = now
+	}
+	for ReputationDecayInterval < now.Sub(r.LastDecay) {
+		r.InvalidMessages /= 2
+		r.UsefulMessages /= 2
+		r.Uptime /= 2
+		r.LastDecay = r.LastDecay.Add(ReputationDecayInterval)
+	}
+	if ReputationDecayInterval < now.Sub(r.LatencyMeasured) {
+		r.Latency = 0
+	}
+	r.Score = r.score()
+}
+
+// Reputations keeps the reputation of every peer we have been connected to,
+// and saves them to a file so they last across restarts.  The controller
+// updates them from the connection metrics, and discovery reads them to pick
+// peers to dial, so it has its own lock.
+type Reputations struct {
+	mutex    sync.Mutex
+	filename string // Not saved if empty
+	peers    map[string]*Reputation
+}
+
+// LoadReputations reads the reputations from a file, which need not exist
+// yet.  If the file can't be read we start over, and don't save so the file
+// is left for someone to look at.
+func LoadReputations(filename string) (*Reputations, error) {
+	r := &Reputations{peers: map[string]*Reputation{}}
+	data, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		r.filename = filename
+		return r, nil
+	}
+	if err != nil {
+		return r, err
+	}
+	var saved []*Reputation
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return r, fmt.Errorf("%s: %v", filename, err)
+	}
+	r.filename = filename
+	now := time.Now()
+	for _, reputation := range saved {
+		reputation.decay(now)
+		r.peers[reputation.Address] = reputation
+	}
+	return r, nil
+}
+
+// Save writes the reputations of the peers seen in the last
+// ReputationForgetAfter.
+func (r *Reputations) Save() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.filename == "" {
+		return nil
+	}
+	now := time.Now()
+	saved := []*Reputation{}
+	for address, reputation := range r.peers {
+		if ReputationForgetAfter < now.Sub(reputation.LastSeen) {
+			delete(r.peers, address)
+			continue
+		}
+		saved = append(saved, reputation)
+	}
+	sort.Slice(saved, func(i, j int) bool { return saved[i].Address < saved[j].Address })
+	data, err := json.MarshalIndent(saved, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.filename, data, 0644)
+}
+
+// Record adds what a peer has done since it was last recorded, and returns
+// its new score.  The latency, which the connection averages over its pings,
+// replaces the last one unless it is 0.
+func (r *Reputations) Record(address string, invalid uint32, useful uint32, latency time.Duration, uptime time.Duration) int32 {
+	now := time.Now()
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	reputation, present := r.peers[address]
+	if !present {
+		reputation = &Reputation{Address: address, LastDecay: now}
+		r.peers[address] = reputation
+	}
+	reputation.InvalidMessages += invalid
+	reputation.UsefulMessages += useful
+	reputation.Uptime += uptime
+	if latency > 0 {
+		reputation.Latency = latency
+		reputation.LatencyMeasured = now
+	}
+	reputation.LastSeen = now
+	reputation.decay(now)
+	return reputation.Score
+}
+
+// Score is a peer's score, 0 for a peer we know nothing about.
+func (r *Reputations) Score(address string) int32 {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if reputation, present := r.peers[address]; present {
+		reputation.decay(time.Now())
+		return reputation.Score
+	}
+	return 0
+}
+
+// All lists the reputations, best first.
+func (r *Reputations) All() []Reputation {
+	now := time.Now()
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	all := []Reputation{}
+	for _, reputation := range r.peers {
+		reputation.decay(now)
+		all = append(all, *reputation)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Score != all[j].Score {
+			return all[i].Score > all[j].Score
+		}
+		return all[i].Address < all[j].Address
+	})
+	return all
+}
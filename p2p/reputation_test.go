@@ -0,0 +1,81 @@
+package p2p_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/FactomProject/factomd/p2p"
+)
+
+func TestReputations(t *testing.T) {
+	dir, err := ioutil.TempDir("", "reputation")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "peerreputation.json")
+
+	r, err := LoadReputations(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if score := r.Score("10.0.0.1"); score != 0 {
+		t.Errorf("Unknown peer scored %d", score)
+	}
+
+	// 100 useful messages, 30 minutes online and a fast ping
+	if score := r.Record("10.0.0.1", 0, 100, 100*time.Millisecond, 30*time.Minute); score != 113 {
+		t.Errorf("Good peer scored %d", score)
+	}
+	// Junk and a slow ping
+	if score := r.Record("10.0.0.2", 11, 0, 3*time.Second, 0); score != -650 || score >= ReputationBanScore {
+		t.Errorf("Junk peer scored %d", score)
+	}
+	// Useful messages and uptime only count so far
+	if score := r.Record("10.0.0.3", 0, 1000000, 0, 1000*time.Hour); score != ReputationMaxUseful+ReputationMaxUptime {
+		t.Errorf("Long running peer scored %d", score)
+	}
+	// A latency of 0 keeps the last one
+	if score := r.Record("10.0.0.1", 0, 0, 0, 0); score != 113 {
+		t.Errorf("Good peer scored %d after recording nothing", score)
+	}
+
+	var addresses []string
+	for _, reputation := range r.All() {
+		addresses = append(addresses, reputation.Address)
+	}
+	if len(addresses) != 3 || addresses[0] != "10.0.0.3" || addresses[1] != "10.0.0.1" || addresses[2] != "10.0.0.2" {
+		t.Errorf("Wrong order %v", addresses)
+	}
+
+	// Everything is still there after a restart
+	if err := r.Save(); err != nil {
+		t.Fatal(err)
+	}
+	r, err = LoadReputations(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Score("10.0.0.1") != 113 || r.Score("10.0.0.2") != -650 || len(r.All()) != 3 {
+		t.Errorf("Wrong reputations after reloading %v", r.All())
+	}
+
+	// Old behaviour is forgotten, including a slow ping
+	decayInterval := ReputationDecayInterval
+	defer func() { ReputationDecayInterval = decayInterval }()
+	ReputationDecayInterval = time.Millisecond
+	time.Sleep(20 * time.Millisecond)
+	if score := r.Score("10.0.0.2"); score != 0 {
+		t.Errorf("Junk peer scored %d after the counts decayed", score)
+	}
+
+	if err := ioutil.WriteFile(filename, []byte("["), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadReputations(filename); err == nil {
+		t.Errorf("Loaded a bad file")
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package signer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// Mark is the highest position signed for one kind of message, and the hash
+// of the data signed there.
+type Mark struct {
+	Position interfaces.SignPosition `json:"position"`
+	Hash     string                  `json:"hash"`
+}
+
+// Guard refuses to sign below the high-water mark of a kind, or to sign
+// different data at the mark itself.  Signing the same data again at the mark
+// is allowed, so a message can be re-signed after a restart.
+//
+// If Filename is set, the marks are written to it every time they move, so a
+// restarted leader (from an old FastBoot file, a cloned VM, ...) still knows
+// what it has already signed.
+type Guard struct {
+	mutex    sync.Mutex
+	Marks    map[string]*Mark `json:"marks"`
+	Filename string           `json:"-"`
+}
+
+func NewGuard() *Guard {
+	g := new(Guard)
+	g.Marks = make(map[string]*Mark)
+	return g
+}
+
+// LoadGuard reads the marks saved in filename.  A missing file is a new guard
+// that will save to filename.
+func LoadGuard(filename string) (*Guard, error) {
+	g := NewGuard()
+	g.Filename = filename
+
+	data, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return g, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(data, g)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse signing history in %s: %v", filename, err)
+	}
+	if g.Marks == nil {
+		g.Marks = make(map[string]*Mark)
+	}
+	return g, nil
+}
+
+// GetMarks returns a copy of the current marks
+func (g *Guard) GetMarks() map[string]Mark {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	marks := make(map[string]Mark)
+	for k, v := range g.Marks {
+		marks[k] = *v
+	}
+	return marks
+}
+
+// save writes the marks through a temporary file, so a crash never leaves a
+// half written history behind.  The file and the directory are synced before
+// we return, as a mark that is lost in a crash could let us sign its position
+// again.  Must be called with the mutex held.
+func (g *Guard) save() error {
+	if g.Filename == "" {
+		return nil
+	}
+	data, err := json.Marshal(g)
+	if err != nil {
+		return err
+	}
+	tmp := g.Filename + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	err = os.Rename(tmp, g.Filename)
+	if err != nil {
+		return err
+	}
+	dir, err := os.Open(filepath.Dir(g.Filename))
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
+
+// ComparePositions returns -1, 0 or 1 as a is below, at, or above b.
+func ComparePositions(a, b *interfaces.SignPosition) int {
+	switch {
+	case a.DBHeight != b.DBHeight:
+		return compare(int64(a.DBHeight), int64(b.DBHeight))
+	case a.Minute != b.Minute:
+		return compare(int64(a.Minute), int64(b.Minute))
+	case a.Height != b.Height:
+		return compare(int64(a.Height), int64(b.Height))
+	}
+	return compare(int64(a.Part), int64(b.Part))
+}
+
+func compare(a, b int64) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// Check returns an error if signing msg at pos would equivocate.  Otherwise
+// pos becomes the new mark for its kind.  A nil position is not guarded, and
+// nor is SignOther: messages outside the process lists can't equivocate on
+// consensus, and their positions are only counted in memory, so they start
+// again below the mark after a restart.  Only process list messages passed
+// off as SignOther are refused.
+func (g *Guard) Check(pos *interfaces.SignPosition, msg []byte) error {
+	if pos == nil {
+		return nil
+	}
+	if pos.Kind == SignOther {
+		return checkOther(msg)
+	}
+	hash := primitives.Sha(msg).String()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.Marks == nil {
+		g.Marks = make(map[string]*Mark)
+	}
+	mark := g.Marks[pos.Kind]
+	if mark != nil {
+		switch ComparePositions(pos, &mark.Position) {
+		case -1:
+			return fmt.Errorf("Refusing to sign %s at %d/%d/%d/%d, already signed up to %d/%d/%d/%d",
+				pos.Kind, pos.DBHeight, pos.Minute, pos.Height, pos.Part,
+				mark.Position.DBHeight, mark.Position.Minute, mark.Position.Height, mark.Position.Part)
+		case 0:
+			if mark.Hash != hash {
+				return fmt.Errorf("Refusing to sign different %s at %d/%d/%d/%d",
+					pos.Kind, pos.DBHeight, pos.Minute, pos.Height, pos.Part)
+			}
+			return nil
+		}
+	}
+
+	old := mark
+	mark = new(Mark)
+	mark.Position = *pos
+	mark.Hash = hash
+	g.Marks[pos.Kind] = mark
+
+	// If the mark can't be persisted we must not sign, or a restart could
+	// sign this position again.
+	err := g.save()
+	if err != nil {
+		if old == nil {
+			delete(g.Marks, pos.Kind)
+		} else {
+			g.Marks[pos.Kind] = old
+		}
+		return fmt.Errorf("Cannot save signing history: %v", err)
+	}
+	return nil
+}
+
+// checkOther refuses an ack, EOM or directory block signature signed as
+// SignOther, where it would not be checked against its own marks.
+func checkOther(msg []byte) error {
+	if len(msg) == 0 {
+		return nil
+	}
+	switch msg[0] {
+	case constants.EOM_MSG, constants.ACK_MSG, constants.DIRECTORY_BLOCK_SIGNATURE_MSG:
+		return fmt.Errorf("Refusing to sign an ack, EOM or directory block as %s", SignOther)
+	}
+	return nil
+}
+
+// GuardedSigner checks every position against a Guard before passing the
+// request on to the signer that holds the key.
+type GuardedSigner struct {
+	Signer interfaces.IServerSigner
+	Guard  *Guard
+}
+
+var _ interfaces.IServerSigner = (*GuardedSigner)(nil)
+
+func NewGuardedSigner(signer interfaces.IServerSigner, guard *Guard) *GuardedSigner {
+	g := new(GuardedSigner)
+	g.Signer = signer
+	g.Guard = guard
+	return g
+}
+
+func (g *GuardedSigner) SignAt(pos *interfaces.SignPosition, msg []byte) (interfaces.IFullSignature, error) {
+	err := g.Guard.Check(pos, msg)
+	if err != nil {
+		return nil, err
+	}
+	return g.Signer.SignAt(pos, msg)
+}
+
+func (g *GuardedSigner) GetPublicKey() []byte {
+	return g.Signer.GetPublicKey()
+}
@@ -0,0 +1,114 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package signer_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	. "github.com/FactomProject/factomd/signer"
+)
+
+func TestGuard(t *testing.T) {
+	g := NewGuard()
+
+	pos := interfaces.SignPosition{Kind: SignAck, DBHeight: 10, Minute: 2, Height: 5}
+	if err := g.Check(&pos, []byte("first")); err != nil {
+		t.Errorf("First signature refused: %v", err)
+	}
+	// The same data at the same position may be signed again
+	if err := g.Check(&pos, []byte("first")); err != nil {
+		t.Errorf("Re-signing the same data refused: %v", err)
+	}
+	// Different data at the same position is an equivocation
+	if err := g.Check(&pos, []byte("second")); err == nil {
+		t.Errorf("Different data at the same position was allowed")
+	}
+
+	lower := pos
+	lower.Height = 4
+	if err := g.Check(&lower, []byte("first")); err == nil {
+		t.Errorf("Lower position was allowed")
+	}
+
+	next := pos
+	next.Minute = 3
+	next.Height = 0
+	if err := g.Check(&next, []byte("third")); err != nil {
+		t.Errorf("Next minute refused: %v", err)
+	}
+
+	// Kinds are tracked independently
+	dbsig := interfaces.SignPosition{Kind: SignDBSig, DBHeight: 9}
+	if err := g.Check(&dbsig, []byte("dbsig")); err != nil {
+		t.Errorf("DBSig refused: %v", err)
+	}
+
+	if err := g.Check(nil, []byte("anything")); err != nil {
+		t.Errorf("Unguarded signature refused: %v", err)
+	}
+
+	// Heartbeats and faults are counted from 1 again after a restart
+	other := interfaces.SignPosition{Kind: SignOther, DBHeight: 10, Minute: 2, Height: 7}
+	if err := g.Check(&other, []byte("heartbeat")); err != nil {
+		t.Errorf("Other message refused: %v", err)
+	}
+	other.Height = 1
+	if err := g.Check(&other, []byte("another heartbeat")); err != nil {
+		t.Errorf("Other message below the last one refused: %v", err)
+	}
+	if err := g.Check(&other, []byte{constants.ACK_MSG}); err == nil {
+		t.Errorf("Ack was allowed as another kind of message")
+	}
+}
+
+func TestComparePositions(t *testing.T) {
+	a := &interfaces.SignPosition{DBHeight: 1, Minute: 9, Height: 9, Part: 9}
+	b := &interfaces.SignPosition{DBHeight: 2}
+	if ComparePositions(a, b) != -1 || ComparePositions(b, a) != 1 {
+		t.Errorf("DBHeight does not dominate the comparison")
+	}
+	if ComparePositions(a, a) != 0 {
+		t.Errorf("Position is not equal to itself")
+	}
+}
+
+func TestGuardPersistence(t *testing.T) {
+	dir, err := ioutil.TempDir("", "guard")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "signinghistory.json")
+
+	g, err := LoadGuard(filename)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	pos := interfaces.SignPosition{Kind: SignDBSig, DBHeight: 100}
+	if err := g.Check(&pos, []byte("block 100")); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// A restarted leader loads the history and refuses a conflicting signature
+	restarted, err := LoadGuard(filename)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if err := restarted.Check(&pos, []byte("another block 100")); err == nil {
+		t.Errorf("Restarted guard signed a conflicting DBSig")
+	}
+	if err := restarted.Check(&pos, []byte("block 100")); err != nil {
+		t.Errorf("Restarted guard refused to re-sign the same DBSig: %v", err)
+	}
+	marks := restarted.GetMarks()
+	if marks[SignDBSig].Position.DBHeight != 100 {
+		t.Errorf("Mark not restored, found %v", marks[SignDBSig])
+	}
+}
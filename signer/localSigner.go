@@ -0,0 +1,56 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package signer
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// LocalSigner signs with a private key held in this process, either from
+// factomd.conf or from a key file.
+type LocalSigner struct {
+	Key *primitives.PrivateKey
+}
+
+var _ interfaces.IServerSigner = (*LocalSigner)(nil)
+
+func NewLocalSigner(key *primitives.PrivateKey) *LocalSigner {
+	l := new(LocalSigner)
+	l.Key = key
+	return l
+}
+
+// NewLocalSignerFromFile reads a hex encoded private key from the given file.
+// Surrounding whitespace is ignored.
+func NewLocalSignerFromFile(filename string) (*LocalSigner, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	key, err := primitives.NewPrivateKeyFromHex(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse private key in %s: %v", filename, err)
+	}
+	return NewLocalSigner(key), nil
+}
+
+func (l *LocalSigner) SignAt(pos *interfaces.SignPosition, msg []byte) (interfaces.IFullSignature, error) {
+	if l.Key == nil {
+		return nil, fmt.Errorf("%s", "No private key loaded")
+	}
+	return l.Key.Sign(msg), nil
+}
+
+func (l *LocalSigner) GetPublicKey() []byte {
+	if l.Key == nil {
+		return nil
+	}
+	return l.Key.Pub[:]
+}
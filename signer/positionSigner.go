@@ -0,0 +1,48 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package signer
+
+import (
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// Kinds of positions signed by a server
+const (
+	SignAck   = "ack"
+	SignEOM   = "eom"
+	SignDBSig = "dbsig"
+	SignOther = "other" // Messages outside the process lists, such as heartbeats and faults
+)
+
+// PositionSigner adapts an IServerSigner to the Signer interface used by the
+// messages, for one message at one position.  Each call to Sign is a new Part
+// of that position.  If the signer refuses, Sign returns nil and the reason is
+// kept in Err.
+type PositionSigner struct {
+	Signer   interfaces.IServerSigner
+	Position interfaces.SignPosition
+	Err      error
+}
+
+var _ interfaces.Signer = (*PositionSigner)(nil)
+
+func AtPosition(signer interfaces.IServerSigner, pos interfaces.SignPosition) *PositionSigner {
+	p := new(PositionSigner)
+	p.Signer = signer
+	p.Position = pos
+	return p
+}
+
+func (p *PositionSigner) Sign(msg []byte) interfaces.IFullSignature {
+	pos := p.Position
+	p.Position.Part++
+
+	sig, err := p.Signer.SignAt(&pos, msg)
+	if err != nil {
+		p.Err = err
+		return nil
+	}
+	return sig
+}
@@ -0,0 +1,165 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package signer
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// Requests and responses between factomd and a signing process are JSON
+// objects, one per line.
+type SignRequest struct {
+	Method   string                   `json:"method"` // "sign" or "publickey"
+	Position *interfaces.SignPosition `json:"position,omitempty"`
+	Data     []byte                   `json:"data,omitempty"`
+}
+
+type SignResponse struct {
+	PublicKey []byte `json:"publickey,omitempty"`
+	Signature []byte `json:"signature,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// RemoteSigner asks a separate process to sign, so the server key never has
+// to be loaded into factomd.  The connection is reopened if it drops.
+type RemoteSigner struct {
+	Network string // "unix" or "tcp"
+	Address string
+	Timeout time.Duration
+
+	mutex  sync.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+	pubKey []byte
+}
+
+var _ interfaces.IServerSigner = (*RemoteSigner)(nil)
+
+// NewRemoteSigner connects to the signing process and fetches its public key.
+func NewRemoteSigner(network string, address string) (*RemoteSigner, error) {
+	r := new(RemoteSigner)
+	r.Network = network
+	r.Address = address
+	r.Timeout = 5 * time.Second
+
+	req := new(SignRequest)
+	req.Method = "publickey"
+	resp, err := r.call(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.PublicKey) != 32 {
+		return nil, fmt.Errorf("Remote signer at %s returned an invalid public key", address)
+	}
+	r.pubKey = resp.PublicKey
+	return r, nil
+}
+
+func (r *RemoteSigner) SignAt(pos *interfaces.SignPosition, msg []byte) (interfaces.IFullSignature, error) {
+	req := new(SignRequest)
+	req.Method = "sign"
+	req.Position = pos
+	req.Data = msg
+
+	resp, err := r.call(req)
+	if err != nil {
+		return nil, err
+	}
+
+	sig := new(primitives.Signature)
+	sig.SetPub(r.pubKey)
+	err = sig.SetSignature(resp.Signature)
+	if err != nil {
+		return nil, err
+	}
+	if !sig.Verify(msg) {
+		return nil, fmt.Errorf("Remote signer at %s returned an invalid signature", r.Address)
+	}
+	return sig, nil
+}
+
+func (r *RemoteSigner) GetPublicKey() []byte {
+	return r.pubKey
+}
+
+func (r *RemoteSigner) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.disconnect()
+}
+
+// call sends one request, retrying once on a fresh connection if the old one
+// has gone away.  Errors reported by the signer itself are not retried.
+func (r *RemoteSigner) call(req *SignRequest) (*SignResponse, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var resp *SignResponse
+	var err error
+	for try := 0; try < 2; try++ {
+		resp, err = r.roundTrip(req)
+		if err == nil {
+			break
+		}
+		r.disconnect()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("Remote signer: %s", resp.Error)
+	}
+	return resp, nil
+}
+
+func (r *RemoteSigner) roundTrip(req *SignRequest) (*SignResponse, error) {
+	if r.conn == nil {
+		conn, err := net.DialTimeout(r.Network, r.Address, r.Timeout)
+		if err != nil {
+			return nil, err
+		}
+		r.conn = conn
+		r.reader = bufio.NewReader(conn)
+	}
+	r.conn.SetDeadline(time.Now().Add(r.Timeout))
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	_, err = r.conn.Write(append(data, '\n'))
+	if err != nil {
+		return nil, err
+	}
+
+	line, err := r.reader.ReadBytes('\n')
+	if err != nil {
+		return nil, err
+	}
+	resp := new(SignResponse)
+	err = json.Unmarshal(line, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (r *RemoteSigner) disconnect() error {
+	if r.conn == nil {
+		return nil
+	}
+	err := r.conn.Close()
+	r.conn = nil
+	r.reader = nil
+	return err
+}
@@ -0,0 +1,104 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package signer_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/signer"
+)
+
+func TestRemoteSigner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer os.RemoveAll(dir)
+	socket := filepath.Join(dir, "signer.sock")
+
+	key := primitives.RandomPrivateKey()
+	server := NewSignerServer(NewLocalSigner(key), NewGuard())
+	err = server.Listen("unix", socket)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	go server.Serve()
+	defer server.Close()
+
+	remote, err := NewRemoteSigner("unix", socket)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer remote.Close()
+
+	if !primitives.AreBytesEqual(remote.GetPublicKey(), key.Pub[:]) {
+		t.Errorf("Remote signer returned the wrong public key")
+	}
+
+	pos := interfaces.SignPosition{Kind: SignAck, DBHeight: 1}
+	sig, err := remote.SignAt(&pos, []byte("data"))
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if !sig.Verify([]byte("data")) {
+		t.Errorf("Remote signature does not verify")
+	}
+
+	_, err = remote.SignAt(&pos, []byte("other data"))
+	if err == nil {
+		t.Errorf("Remote signer signed two different messages at one position")
+	}
+
+	_, err = remote.SignAt(nil, []byte("data"))
+	if err == nil {
+		t.Errorf("Remote signer signed without a position")
+	}
+
+	// Messages signed through a position report refusals as errors
+	ack := new(messages.Ack)
+	ack.Timestamp = primitives.NewTimestampNow()
+	ack.MessageHash = primitives.RandomHash()
+	ack.SerialHash = primitives.RandomHash()
+	ack.LeaderChainID = primitives.RandomHash()
+	err = ack.Sign(AtPosition(remote, interfaces.SignPosition{Kind: SignOther, DBHeight: 2}))
+	if err == nil {
+		t.Errorf("Ack was signed as another kind of message")
+	}
+	err = ack.Sign(AtPosition(remote, pos))
+	if err == nil {
+		t.Errorf("Ack was signed at an already used position")
+	}
+	pos.Height++
+	err = ack.Sign(AtPosition(remote, pos))
+	if err != nil {
+		t.Errorf("%v", err)
+	}
+}
+
+func TestLocalSignerFromFile(t *testing.T) {
+	f, err := ioutil.TempFile("", "signerkey")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer os.Remove(f.Name())
+
+	key := primitives.RandomPrivateKey()
+	f.WriteString(key.PrivateKeyString() + "\n")
+	f.Close()
+
+	l, err := NewLocalSignerFromFile(f.Name())
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if !primitives.AreBytesEqual(l.GetPublicKey(), key.Pub[:]) {
+		t.Errorf("Key file loaded the wrong key")
+	}
+}
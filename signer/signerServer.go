@@ -0,0 +1,136 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package signer
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// SignerServer is the signing process side of a RemoteSigner.  Every request
+// passes through a Guard, so the server will not sign two different messages
+// for the same height and minute whatever factomd asks of it.
+type SignerServer struct {
+	Signer interfaces.IServerSigner
+	Guard  *Guard
+
+	mutex    sync.Mutex
+	listener net.Listener
+}
+
+func NewSignerServer(signer interfaces.IServerSigner, guard *Guard) *SignerServer {
+	s := new(SignerServer)
+	s.Signer = signer
+	s.Guard = guard
+	return s
+}
+
+// Listen opens the socket factomd connects to.  A stale unix socket left over
+// from a previous run is removed first.
+func (s *SignerServer) Listen(network string, address string) error {
+	if network == "unix" {
+		os.Remove(address)
+	}
+	l, err := net.Listen(network, address)
+	if err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	s.listener = l
+	s.mutex.Unlock()
+	return nil
+}
+
+// Serve accepts connections until the listener is closed.
+func (s *SignerServer) Serve() error {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return err
+		}
+		go s.serveConn(conn)
+	}
+}
+
+func (s *SignerServer) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Close()
+}
+
+func (s *SignerServer) serveConn(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			return
+		}
+
+		req := new(SignRequest)
+		resp := new(SignResponse)
+		err = json.Unmarshal(line, req)
+		if err != nil {
+			resp.Error = "Malformed request: " + err.Error()
+		} else {
+			resp = s.Handle(req)
+		}
+
+		data, err := json.Marshal(resp)
+		if err != nil {
+			return
+		}
+		_, err = conn.Write(append(data, '\n'))
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (s *SignerServer) Handle(req *SignRequest) *SignResponse {
+	resp := new(SignResponse)
+	switch req.Method {
+	case "publickey":
+		resp.PublicKey = s.Signer.GetPublicKey()
+	case "sign":
+		err := checkRequest(req)
+		if err != nil {
+			resp.Error = err.Error()
+			break
+		}
+		err = s.Guard.Check(req.Position, req.Data)
+		if err != nil {
+			resp.Error = err.Error()
+			break
+		}
+		sig, err := s.Signer.SignAt(req.Position, req.Data)
+		if err != nil {
+			resp.Error = err.Error()
+			break
+		}
+		resp.Signature = sig.GetSignature()[:]
+	default:
+		resp.Error = "Unknown method " + req.Method
+	}
+	return resp
+}
+
+// checkRequest refuses a sign request the Guard can't protect: one with no
+// position.
+func checkRequest(req *SignRequest) error {
+	if req.Position == nil {
+		return fmt.Errorf("A position is needed to sign")
+	}
+	return nil
+}
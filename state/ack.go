@@ -11,6 +11,7 @@ import (
 	"github.com/FactomProject/factomd/common/entryCreditBlock"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
 )
 
 func (s *State) IsStateFullySynced() bool {
@@ -415,6 +416,28 @@ func (s *State) FetchEntryRevealAndCommitFromHolding(hash interfaces.IHash) (rev
 	return
 }
 
+// IsChainCommitPending is true if a commit for the chain is in holding, or has
+// been accepted and is waiting on its reveal.
+func (s *State) IsChainCommitPending(chainID interfaces.IHash) bool {
+	chainIDHash := primitives.NewHash(primitives.DoubleSha(chainID.Bytes()))
+	pending := func(msg interfaces.IMsg) bool {
+		cm, ok := msg.(*messages.CommitChainMsg)
+		return ok && cm.CommitChain != nil && chainIDHash.IsSameAs(cm.CommitChain.ChainIDHash)
+	}
+	for _, h := range s.LoadHoldingMap() {
+		if pending(h) {
+			return true
+		}
+	}
+	commits := s.Commits.Copy()
+	for _, c := range commits.msgmap {
+		if pending(c) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *State) FetchHoldingMessageByHash(hash interfaces.IHash) (int, byte, interfaces.IMsg, error) {
 	q := s.LoadHoldingMap()
 	for _, h := range q {
@@ -0,0 +1,215 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	"github.com/FactomProject/factomd/common/constants"
+)
+
+var (
+	ErrAPIUnauthorized = errors.New("no or bad API credentials")
+)
+
+// APICredential is a user name and password for basic auth, or a key sent
+// as "Authorization: Bearer <key>", and the scopes it is allowed.
+type APICredential struct {
+	User     string   `json:"user"`
+	Password string   `json:"password,omitempty"`
+	Key      string   `json:"key,omitempty"`
+	Scopes   []string `json:"scopes"`
+}
+
+// ParseAPIScopes splits a comma separated list of scopes, checking each.
+func ParseAPIScopes(list string) ([]string, error) {
+	var scopes []string
+	for _, scope := range strings.Split(list, ",") {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		if scope == "" {
+			continue
+		}
+		switch scope {
+		case constants.API_SCOPE_READ, constants.API_SCOPE_SUBMIT, constants.API_SCOPE_ADMIN:
+			scopes = append(scopes, scope)
+		default:
+			return nil, fmt.Errorf("Unknown API scope %s", scope)
+		}
+	}
+	return scopes, nil
+}
+
+// ReadAPICredentials reads a JSON list of credentials from a file.
+func ReadAPICredentials(filename string) ([]*APICredential, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var creds []*APICredential
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return creds, nil
+}
+
+type apiCredential struct {
+	user     string
+	authHash []byte // sha256 of the Authorization header we expect
+	scopes   []string
+}
+
+// APIAuth checks the Authorization headers of API and control panel requests
+// against the configured credentials.  With no credentials the API is open to
+// everyone, with every scope.  It has its own lock so the credentials can be
+// replaced while the API is serving.
+type APIAuth struct {
+	mutex sync.RWMutex
+	creds []*apiCredential
+}
+
+func NewAPIAuth(creds []*APICredential) (*APIAuth, error) {
+	a := new(APIAuth)
+	if err := a.Set(creds); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Set replaces the credentials.  On error the old ones are kept.
+func (a *APIAuth) Set(creds []*APICredential) error {
+	users := make(map[string]bool)
+	var list []*apiCredential
+	for _, c := range creds {
+		if c.User == "" {
+			return fmt.Errorf("API credential without a user name")
+		}
+		if users[c.User] {
+			return fmt.Errorf("API user %s is defined twice", c.User)
+		}
+		users[c.User] = true
+		if (c.Password == "") == (c.Key == "") {
+			return fmt.Errorf("API user %s needs exactly one of a password or a key", c.User)
+		}
+		scopes, err := ParseAPIScopes(strings.Join(c.Scopes, ","))
+		if err != nil {
+			return fmt.Errorf("API user %s: %v", c.User, err)
+		}
+		if len(scopes) == 0 {
+			return fmt.Errorf("API user %s has no scopes", c.User)
+		}
+
+		var header string
+		if c.Key != "" {
+			header = "Bearer " + c.Key
+		} else {
+			header = "Basic " + base64.StdEncoding.EncodeToString([]byte(c.User+":"+c.Password))
+		}
+		h := sha256.Sum256([]byte(header))
+		list = append(list, &apiCredential{user: c.User, authHash: h[:], scopes: scopes})
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.creds = list
+	return nil
+}
+
+// Authenticate returns the user and scopes of the credential an Authorization
+// header matches.
+func (a *APIAuth) Authenticate(authHeader string) (string, []string, error) {
+	if a == nil {
+		return "", AllAPIScopes(), nil
+	}
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	if len(a.creds) == 0 {
+		// No credentials configured, meaning the API is open access
+		return "", AllAPIScopes(), nil
+	}
+	if authHeader == "" {
+		return "", nil, ErrAPIUnauthorized
+	}
+
+	h := sha256.Sum256([]byte(authHeader))
+	var found *apiCredential
+	for _, c := range a.creds {
+		// Compare hashes, and every credential, so the time taken does not
+		// depend on how much of a header matches
+		if subtle.ConstantTimeCompare(h[:], c.authHash) == 1 {
+			found = c
+		}
+	}
+	if found == nil {
+		return "", nil, ErrAPIUnauthorized
+	}
+	return found.user, found.scopes, nil
+}
+
+// Users lists the user names and scopes, without the secrets.
+func (a *APIAuth) Users() map[string][]string {
+	users := make(map[string][]string)
+	if a == nil {
+		return users
+	}
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	for _, c := range a.creds {
+		users[c.user] = c.scopes
+	}
+	return users
+}
+
+func AllAPIScopes() []string {
+	return []string{constants.API_SCOPE_READ, constants.API_SCOPE_SUBMIT, constants.API_SCOPE_ADMIN}
+}
+
+// gatherAPICredentials gathers the credentials from FactomdRpcUser, which is
+// allowed everything, factomd.conf and the credentials file.
+func gatherAPICredentials(rpcUser string, rpcPass string, fromConfig []*APICredential, filename string) ([]*APICredential, error) {
+	var creds []*APICredential
+	if rpcUser != "" {
+		creds = append(creds, &APICredential{User: rpcUser, Password: rpcPass, Scopes: AllAPIScopes()})
+	}
+	creds = append(creds, fromConfig...)
+	if filename != "" {
+		fromFile, err := ReadAPICredentials(filename)
+		if err != nil {
+			return nil, err
+		}
+		creds = append(creds, fromFile...)
+	}
+	return creds, nil
+}
+
+func (s *State) apiCredentials() ([]*APICredential, error) {
+	s.liveConfigMutex.RLock()
+	defer s.liveConfigMutex.RUnlock()
+	return gatherAPICredentials(s.RpcUser, s.RpcPass, s.APICredentials, s.APICredentialsFile)
+}
+
+func (s *State) initAPIAuth() {
+	creds, err := s.apiCredentials()
+	if err != nil {
+		panic(fmt.Sprintf("Error reading the API credentials: %v", err))
+	}
+	s.APIAuth, err = NewAPIAuth(creds)
+	if err != nil {
+		panic(fmt.Sprintf("Bad API credentials: %v", err))
+	}
+}
+
+// AuthenticateAPI is called by the API and control panel with the request's
+// Authorization header.
+func (s *State) AuthenticateAPI(authHeader string) (string, []string, error) {
+	return s.APIAuth.Authenticate(authHeader)
+}
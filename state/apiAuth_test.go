@@ -0,0 +1,82 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	. "github.com/FactomProject/factomd/state"
+)
+
+func basicAuth(user, pass string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+pass))
+}
+
+func TestAPIAuth(t *testing.T) {
+	var open *APIAuth
+	if _, scopes, err := open.Authenticate(""); err != nil || !constants.HasAPIScope(scopes, constants.API_SCOPE_ADMIN) {
+		t.Errorf("An API without credentials should be open")
+	}
+
+	auth, err := NewAPIAuth([]*APICredential{
+		{User: "ops", Password: "secret", Scopes: AllAPIScopes()},
+		{User: "partner", Key: "partnerkey", Scopes: []string{constants.API_SCOPE_READ}},
+		{User: "wallet", Password: "walletpass", Scopes: []string{"read", "submit"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := auth.Authenticate(""); err != ErrAPIUnauthorized {
+		t.Errorf("Missing credentials were accepted")
+	}
+	if _, _, err := auth.Authenticate(basicAuth("ops", "wrong")); err != ErrAPIUnauthorized {
+		t.Errorf("A bad password was accepted")
+	}
+
+	user, scopes, err := auth.Authenticate("Bearer partnerkey")
+	if err != nil || user != "partner" {
+		t.Fatalf("Key not accepted: %v", err)
+	}
+	if !constants.HasAPIScope(scopes, constants.API_SCOPE_READ) || constants.HasAPIScope(scopes, constants.API_SCOPE_SUBMIT) || constants.HasAPIScope(scopes, constants.API_SCOPE_ADMIN) {
+		t.Errorf("Wrong scopes for partner %v", scopes)
+	}
+
+	user, scopes, err = auth.Authenticate(basicAuth("wallet", "walletpass"))
+	if err != nil || user != "wallet" || !constants.HasAPIScope(scopes, constants.API_SCOPE_SUBMIT) || constants.HasAPIScope(scopes, constants.API_SCOPE_ADMIN) {
+		t.Errorf("Wrong result for wallet %s %v %v", user, scopes, err)
+	}
+
+	_, scopes, err = auth.Authenticate(basicAuth("ops", "secret"))
+	if err != nil || !constants.HasAPIScope(scopes, constants.API_SCOPE_ADMIN) {
+		t.Errorf("Wrong result for ops %v %v", scopes, err)
+	}
+}
+
+func TestAPIAuthBadCredentials(t *testing.T) {
+	bad := [][]*APICredential{
+		{{User: "", Password: "x", Scopes: []string{"read"}}},
+		{{User: "a", Password: "x", Key: "y", Scopes: []string{"read"}}},
+		{{User: "a", Scopes: []string{"read"}}},
+		{{User: "a", Password: "x", Scopes: []string{"write"}}},
+		{{User: "a", Password: "x"}},
+		{{User: "a", Password: "x", Scopes: []string{"read"}}, {User: "a", Key: "y", Scopes: []string{"read"}}},
+	}
+	for i, creds := range bad {
+		if _, err := NewAPIAuth(creds); err == nil {
+			t.Errorf("Bad credentials %d were accepted", i)
+		}
+	}
+
+	auth, _ := NewAPIAuth([]*APICredential{{User: "a", Password: "x", Scopes: []string{"read"}}})
+	if err := auth.Set(bad[1]); err == nil {
+		t.Errorf("Set accepted bad credentials")
+	}
+	if _, _, err := auth.Authenticate(basicAuth("a", "x")); err != nil {
+		t.Errorf("A failed Set replaced the credentials")
+	}
+}
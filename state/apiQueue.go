@@ -1,39 +1,66 @@
 package state
 
 import (
+	"sync"
+
 	"github.com/FactomProject/factomd/common/interfaces"
 )
 
 // APIMSGQueue counts incoming and outgoing messages for API queue
-type APIMSGQueue chan interfaces.IMsg
+type APIMSGQueue struct {
+	channel chan interfaces.IMsg
 
-func NewAPIQueue(capacity int) APIMSGQueue {
-	channel := make(chan interfaces.IMsg, capacity)
-	return channel
+	// mutex keeps a batch together in this queue.  Enqueue takes it too, so
+	// single messages wait for a batch to finish going in.
+	mutex sync.Mutex
+}
+
+var _ interfaces.IBatchQueue = (*APIMSGQueue)(nil)
+
+func NewAPIQueue(capacity int) *APIMSGQueue {
+	q := new(APIMSGQueue)
+	q.channel = make(chan interfaces.IMsg, capacity)
+	return q
 }
 
 // Length of underlying channel
-func (q APIMSGQueue) Length() int {
-	return len(chan interfaces.IMsg(q))
+func (q *APIMSGQueue) Length() int {
+	return len(q.channel)
 }
 
 // Cap of underlying channel
-func (q APIMSGQueue) Cap() int {
-	return cap(chan interfaces.IMsg(q))
+func (q *APIMSGQueue) Cap() int {
+	return cap(q.channel)
 }
 
 // Enqueue adds item to channel and instruments based on type
-func (q APIMSGQueue) Enqueue(m interfaces.IMsg) {
+func (q *APIMSGQueue) Enqueue(m interfaces.IMsg) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.enqueue(m)
+}
+
+// EnqueueBatch adds all the items to the channel in order, with nothing else
+// between them.  It blocks until the last one is in.
+func (q *APIMSGQueue) EnqueueBatch(msgs []interfaces.IMsg) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	for _, m := range msgs {
+		q.enqueue(m)
+	}
+}
+
+func (q *APIMSGQueue) enqueue(m interfaces.IMsg) {
 	measureMessage(TotalMessageQueueApiGeneralVec, m, true)
 	measureMessage(CurrentMessageQueueApiGeneralVec, m, true)
-	q <- m
+	q.channel <- m
 }
 
 // Dequeue removes an item from channel and instruments based on type. Returns nil if nothing in
 // queue
-func (q APIMSGQueue) Dequeue() interfaces.IMsg {
+func (q *APIMSGQueue) Dequeue() interfaces.IMsg {
 	select {
-	case v := <-q:
+	case v := <-q.channel:
 		measureMessage(CurrentMessageQueueApiGeneralVec, v, false)
 		return v
 	default:
@@ -42,8 +69,8 @@ func (q APIMSGQueue) Dequeue() interfaces.IMsg {
 }
 
 // BlockingDequeue will block until it retrieves from queue
-func (q APIMSGQueue) BlockingDequeue() interfaces.IMsg {
-	v := <-q
+func (q *APIMSGQueue) BlockingDequeue() interfaces.IMsg {
+	v := <-q.channel
 	measureMessage(CurrentMessageQueueApiGeneralVec, v, false)
 	return v
 }
@@ -150,6 +150,7 @@ func (st *State) UpdateAuthSigningKeys(height uint32) {
 			}
 		}
 	}*/
+	st.completeKeyRotation(height)
 	st.RepairAuthorities()
 }
 
@@ -384,8 +385,7 @@ func addServerSigningKey(chainID interfaces.IHash, key interfaces.IHash, height
 				break
 			}
 			if bytes.Compare(pubData, key.Bytes()) == 0 {
-				st.serverPrivKey = st.serverPendingPrivKeys[i]
-				st.serverPubKey = st.serverPendingPubKeys[i]
+				st.scheduleKeyRotation(st.serverPendingPrivKeys[i], height)
 				if len(st.serverPendingPrivKeys) > i+1 {
 					st.serverPendingPrivKeys = append(st.serverPendingPrivKeys[:i], st.serverPendingPrivKeys[i+1:]...)
 					st.serverPendingPubKeys = append(st.serverPendingPubKeys[:i], st.serverPendingPubKeys[i+1:]...)
@@ -393,6 +393,7 @@ func addServerSigningKey(chainID interfaces.IHash, key interfaces.IHash, height
 					st.serverPendingPrivKeys = st.serverPendingPrivKeys[:i]
 					st.serverPendingPubKeys = st.serverPendingPubKeys[:i]
 				}
+				st.fillKeyRotationStatus()
 				break
 			}
 		}
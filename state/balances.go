@@ -0,0 +1,65 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+// GetFactoidBalanceInfo returns the confirmed and pending balances of a
+// factoid address, and what transactions in holding would spend from it.
+func (s *State) GetFactoidBalanceInfo(adr [32]byte) *interfaces.BalanceInfo {
+	info := new(interfaces.BalanceInfo)
+	info.Confirmed = s.GetF(false, adr)
+	info.Pending = s.GetF(true, adr)
+	for _, msg := range s.LoadHoldingMap() {
+		m, ok := msg.(*messages.FactoidTransaction)
+		if !ok || m.Transaction == nil {
+			continue
+		}
+		spends := false
+		for _, input := range m.Transaction.GetInputs() {
+			if input.GetAddress().Fixed() == adr {
+				info.HoldingSpends += int64(input.GetAmount())
+				spends = true
+			}
+		}
+		if spends {
+			info.HoldingCount++
+		}
+	}
+	info.Available = info.Pending - info.HoldingSpends
+	return info
+}
+
+// GetECBalanceInfo returns the confirmed and pending balances of an entry
+// credit address, and what commits in holding would spend from it.
+func (s *State) GetECBalanceInfo(adr [32]byte) *interfaces.BalanceInfo {
+	info := new(interfaces.BalanceInfo)
+	info.Confirmed = s.GetE(false, adr)
+	info.Pending = s.GetE(true, adr)
+	for _, msg := range s.LoadHoldingMap() {
+		var credits uint8
+		switch m := msg.(type) {
+		case *messages.CommitChainMsg:
+			if m.CommitChain == nil || m.CommitChain.ECPubKey == nil || [32]byte(*m.CommitChain.ECPubKey) != adr {
+				continue
+			}
+			credits = m.CommitChain.Credits
+		case *messages.CommitEntryMsg:
+			if m.CommitEntry == nil || m.CommitEntry.ECPubKey == nil || [32]byte(*m.CommitEntry.ECPubKey) != adr {
+				continue
+			}
+			credits = m.CommitEntry.Credits
+		default:
+			continue
+		}
+		info.HoldingSpends += int64(credits)
+		info.HoldingCount++
+	}
+	info.Available = info.Pending - info.HoldingSpends
+	return info
+}
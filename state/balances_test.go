@@ -0,0 +1,50 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestBalanceInfo(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+
+	fa := testHelper.NewFactoidAddress(1)
+	s.PutF(false, fa.Fixed(), 1000)
+	s.PutF(true, fa.Fixed(), 800)
+
+	tx := new(factoid.Transaction)
+	tx.AddInput(fa, 300)
+	ftx := new(messages.FactoidTransaction)
+	ftx.Transaction = tx
+
+	commit := newHeldCommit(7)
+	commit.CommitEntry.Credits = 1
+	var ec [32]byte
+	ec[0] = 7
+	s.PutE(false, ec, 10)
+
+	s.HoldingMap = map[[32]byte]interfaces.IMsg{{1}: ftx, {2}: commit}
+
+	info := s.GetFactoidBalanceInfo(fa.Fixed())
+	if info.Confirmed != 1000 || info.Pending != 800 || info.HoldingSpends != 300 || info.HoldingCount != 1 || info.Available != 500 {
+		t.Errorf("Wrong factoid balance info %+v", info)
+	}
+
+	info = s.GetECBalanceInfo(ec)
+	if info.Confirmed != 10 || info.Pending != 10 || info.HoldingSpends != 1 || info.HoldingCount != 1 || info.Available != 9 {
+		t.Errorf("Wrong entry credit balance info %+v", info)
+	}
+
+	info = s.GetFactoidBalanceInfo(testHelper.NewFactoidAddress(2).Fixed())
+	if info.HoldingCount != 0 || info.HoldingSpends != 0 {
+		t.Errorf("Spends counted against the wrong address %+v", info)
+	}
+}
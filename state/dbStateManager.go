@@ -1105,9 +1105,9 @@ func (list *DBStateList) ProcessBlocks(d *DBState) (progress bool) {
 
 	fs.ProcessEndOfBlock(list.State)
 
-	// Promote the currently scheduled next FER
+	// Promote the currently scheduled next FER, and let the pricing policy schedule the next one
 
-	list.State.ProcessRecentFERChainEntries()
+	list.State.ProcessECPricePolicy()
 	// Step my counter of Complete blocks
 	i := d.DirectoryBlock.GetHeader().GetDBHeight() - list.Base
 	if uint32(i) > list.Complete {
@@ -0,0 +1,305 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/FactomProject/factomd/common/adminBlock"
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/entryBlock/specialEntries"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var pricingLogger = packageLogger.WithFields(log.Fields{"subpack": "ecpricing"})
+
+// The entry credit pricing policies
+const (
+	ECPriceFER        = "fer"
+	ECPriceFixed      = "fixed"
+	ECPriceGovernance = "governance"
+)
+
+// ECPricePolicy decides the entry credit price.  Policies schedule a change
+// with FERChangePrice and FERChangeHeight, which ProcessECPricePolicy applies
+// when the target block is reached.  Every node on a network must run the
+// same policy, or they will fork.
+type ECPricePolicy interface {
+	Name() string
+	// ProcessBlock is called from the state loop as each block completes.
+	ProcessBlock(s *State)
+	// Proposed returns price changes still waiting to be scheduled.
+	Proposed(s *State) []*interfaces.ECRateChange
+}
+
+// NewECPricePolicy builds a policy by name.  Only the FER chain is allowed on
+// MAIN and TEST.
+func NewECPricePolicy(name string, network int, fixedPrice uint64, governanceChainID string) (ECPricePolicy, error) {
+	if name != "" && name != ECPriceFER && network != constants.NETWORK_LOCAL && network != constants.NETWORK_CUSTOM {
+		return nil, fmt.Errorf("The %s entry credit price policy can only be used on LOCAL and CUSTOM networks", name)
+	}
+	switch name {
+	case "", ECPriceFER:
+		return new(FERPricePolicy), nil
+	case ECPriceFixed:
+		if fixedPrice == 0 {
+			return nil, fmt.Errorf("The fixed entry credit price policy needs ECPriceFixed")
+		}
+		return &FixedPricePolicy{Price: fixedPrice}, nil
+	case ECPriceGovernance:
+		chainID, err := primitives.HexToHash(governanceChainID)
+		if err != nil {
+			return nil, fmt.Errorf("The governance entry credit price policy needs ECPriceGovernanceChainId: %v", err)
+		}
+		return &GovernancePricePolicy{ChainID: chainID}, nil
+	}
+	return nil, fmt.Errorf("Unknown entry credit price policy %s", name)
+}
+
+// initECPricePolicy builds the configured policy once the network is known.
+func (s *State) initECPricePolicy() {
+	policy, err := NewECPricePolicy(s.ECPricePolicyName, s.NetworkNumber, s.ECPriceFixed, s.ECPriceGovernanceChainId)
+	if err != nil {
+		if s.NetworkNumber == constants.NETWORK_LOCAL || s.NetworkNumber == constants.NETWORK_CUSTOM {
+			panic(fmt.Sprintf("Bad value for ECPricePolicy in factomd.conf: %v", err))
+		}
+		pricingLogger.WithField("network", s.Network).Warn("Only the fer entry credit price policy can be used on MAIN and TEST, ignoring ECPricePolicy")
+		policy = new(FERPricePolicy)
+	}
+	s.ECPricePolicy = policy
+	pricingLogger.WithField("policy", policy.Name()).Info("Entry credit price policy")
+}
+
+func (s *State) getECPricePolicy() ECPricePolicy {
+	if s.ECPricePolicy == nil {
+		s.ECPricePolicy = new(FERPricePolicy)
+	}
+	return s.ECPricePolicy
+}
+
+// ProcessECPricePolicy applies a price change that targets the next block,
+// then lets the policy schedule the next one.
+func (s *State) ProcessECPricePolicy() {
+	// Check to see if a price change targets the next block
+	if s.FERChangeHeight == (s.GetDBHeightComplete())+1 {
+		pricingLogger.WithFields(log.Fields{"from": s.FactoshisPerEC, "to": s.FERChangePrice, "height": s.FERChangeHeight}).Info("Entry credit price changed")
+		s.FactoshisPerEC = s.FERChangePrice
+		s.FERChangePrice = 0
+		s.FERChangeHeight = 1
+	}
+	s.getECPricePolicy().ProcessBlock(s)
+}
+
+// scheduleECRate schedules a price change, no sooner than two blocks out so
+// every leader builds the next factoid block with the same price.
+func (s *State) scheduleECRate(price uint64, height uint32) {
+	if height < s.GetDBHeightComplete()+2 {
+		height = s.GetDBHeightComplete() + 2
+	}
+	s.FERChangePrice = price
+	s.FERChangeHeight = height
+}
+
+// GetECRateInfo is called from the APIs to report the price and what will
+// change it.
+func (s *State) GetECRateInfo() *interfaces.ECRateInfo {
+	policy := s.getECPricePolicy()
+	info := new(interfaces.ECRateInfo)
+	info.Policy = policy.Name()
+	info.Rate = s.GetFactoshisPerEC()
+	info.Predictive = s.GetPredictiveFER()
+	info.Upcoming = make([]*interfaces.ECRateChange, 0)
+	if s.FERChangeHeight > 1 && s.FERChangePrice > 0 {
+		info.Upcoming = append(info.Upcoming, &interfaces.ECRateChange{Rate: s.FERChangePrice, ActivationHeight: s.FERChangeHeight, Scheduled: true})
+	}
+	info.Upcoming = append(info.Upcoming, policy.Proposed(s)...)
+	return info
+}
+
+// FERPricePolicy follows the factoid exchange rate chain, signed by the
+// exchange rate authority.
+type FERPricePolicy struct{}
+
+func (p *FERPricePolicy) Name() string { return ECPriceFER }
+
+func (p *FERPricePolicy) ProcessBlock(s *State) {
+	s.ProcessRecentFERChainEntries()
+}
+
+func (p *FERPricePolicy) Proposed(s *State) []*interfaces.ECRateChange {
+	return nil
+}
+
+// FixedPricePolicy keeps the price at a configured value.  If the chain has a
+// different price, it is changed two blocks later.
+type FixedPricePolicy struct {
+	Price uint64
+}
+
+func (p *FixedPricePolicy) Name() string { return ECPriceFixed }
+
+func (p *FixedPricePolicy) ProcessBlock(s *State) {
+	if s.FactoshisPerEC != p.Price && s.FERChangeHeight <= 1 {
+		s.scheduleECRate(p.Price, 0)
+	}
+}
+
+func (p *FixedPricePolicy) Proposed(s *State) []*interfaces.ECRateChange {
+	return nil
+}
+
+// GovernancePricePolicy lets the federated servers set the price.  Each server
+// votes with an entry in the governance chain holding an FEREntry, its
+// identity chain ID as the first external ID, and the signature of the content
+// with its block signing key as the second.  Only the latest vote of each
+// server within the last 12 blocks counts, and a price is scheduled once a
+// majority of the federated servers vote for the same price and activation
+// height.
+type GovernancePricePolicy struct {
+	ChainID interfaces.IHash
+
+	mutex    sync.RWMutex
+	proposed []*interfaces.ECRateChange
+}
+
+// GovernanceVoteWindow is how many blocks back votes are counted.
+var GovernanceVoteWindow uint32 = 12
+
+func (p *GovernancePricePolicy) Name() string { return ECPriceGovernance }
+
+type governanceProposal struct {
+	price  uint64
+	height uint32
+}
+
+// governanceBefore orders proposals by the most votes, then the lowest
+// activation height, then the lowest price, so every node picks the same one.
+func governanceBefore(a governanceProposal, aVotes int, b governanceProposal, bVotes int) bool {
+	if aVotes != bVotes {
+		return aVotes > bVotes
+	}
+	if a.height != b.height {
+		return a.height < b.height
+	}
+	return a.price < b.price
+}
+
+func (p *GovernancePricePolicy) ProcessBlock(s *State) {
+	complete := s.GetDBHeightComplete()
+	if complete == 0 {
+		return
+	}
+	// Only blocks below complete are counted, as the current one may not be
+	// saved yet, and a replay must count the same votes as a live node.
+	fedsAt := make(map[uint32]map[[32]byte][]byte)
+	current := p.feds(s, complete-1, fedsAt)
+	if len(current) == 0 {
+		return
+	}
+	needed := len(current)/2 + 1
+
+	voted := make(map[[32]byte]bool)
+	votes := make(map[governanceProposal]int)
+	eblock, err := s.DB.FetchEBlockHead(p.ChainID)
+	for err == nil && eblock != nil && eblock.GetHeader().GetDBHeight()+GovernanceVoteWindow >= complete {
+		height := eblock.GetHeader().GetDBHeight()
+		entryHashes := eblock.GetEntryHashes()
+		// Newest first, so a server's earlier votes are ignored
+		for i := len(entryHashes) - 1; i >= 0 && height < complete; i-- {
+			if entryHashes[i].IsMinuteMarker() {
+				continue
+			}
+			entry, err := s.DB.FetchEntry(entryHashes[i])
+			if err != nil || entry == nil {
+				continue
+			}
+			signer, ok := p.voter(entry, p.feds(s, height, fedsAt))
+			if !ok || voted[signer] {
+				continue
+			}
+			voted[signer] = true
+			fer := new(specialEntries.FEREntry)
+			if fer.UnmarshalBinary(entry.GetContent()) != nil {
+				continue
+			}
+			if fer.GetExpirationHeight() < complete || fer.GetTargetActivationHeight() < complete+2 {
+				continue
+			}
+			votes[governanceProposal{fer.GetTargetPrice(), fer.GetTargetActivationHeight()}]++
+		}
+		if eblock.GetHeader().GetPrevKeyMR().IsZero() {
+			break
+		}
+		eblock, err = s.DB.FetchEBlock(eblock.GetHeader().GetPrevKeyMR())
+	}
+
+	var keys []governanceProposal
+	for key := range votes {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return governanceBefore(keys[i], votes[keys[i]], keys[j], votes[keys[j]]) })
+
+	var proposed []*interfaces.ECRateChange
+	for i, key := range keys {
+		if i == 0 && votes[key] >= needed {
+			if s.FERChangeHeight != key.height || s.FERChangePrice != key.price {
+				pricingLogger.WithFields(log.Fields{"price": key.price, "height": key.height, "votes": votes[key]}).Info("Governance scheduled a price change")
+				s.scheduleECRate(key.price, key.height)
+			}
+			continue
+		}
+		proposed = append(proposed, &interfaces.ECRateChange{Rate: key.price, ActivationHeight: key.height, Votes: votes[key], Needed: needed})
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.proposed = proposed
+}
+
+// feds returns the signing keys of the federated servers that built a block,
+// taken from the directory block signatures in its admin block.
+func (p *GovernancePricePolicy) feds(s *State, height uint32, cache map[uint32]map[[32]byte][]byte) map[[32]byte][]byte {
+	if feds, ok := cache[height]; ok {
+		return feds
+	}
+	feds := make(map[[32]byte][]byte)
+	ablock, err := s.DB.FetchABlockByHeight(height)
+	if err == nil && ablock != nil {
+		for _, entry := range ablock.GetABEntries() {
+			if dbsig, ok := entry.(*adminBlock.DBSignatureEntry); ok {
+				feds[dbsig.IdentityAdminChainID.Fixed()] = dbsig.PrevDBSig.GetKey()
+			}
+		}
+	}
+	cache[height] = feds
+	return feds
+}
+
+// voter checks a governance entry is signed by one of the federated servers,
+// and returns the server.
+func (p *GovernancePricePolicy) voter(entry interfaces.IEBEntry, feds map[[32]byte][]byte) ([32]byte, bool) {
+	var signer [32]byte
+	extIDs := entry.ExternalIDs()
+	if len(extIDs) < 2 || len(extIDs[0]) != 32 || len(extIDs[1]) != constants.SIGNATURE_LENGTH {
+		return signer, false
+	}
+	copy(signer[:], extIDs[0])
+	key, ok := feds[signer]
+	if !ok || primitives.VerifySignature(entry.GetContent(), key, extIDs[1]) != nil {
+		return signer, false
+	}
+	return signer, true
+}
+
+func (p *GovernancePricePolicy) Proposed(s *State) []*interfaces.ECRateChange {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return p.proposed
+}
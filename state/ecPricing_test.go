@@ -0,0 +1,62 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	. "github.com/FactomProject/factomd/state"
+)
+
+func TestNewECPricePolicy(t *testing.T) {
+	p, err := NewECPricePolicy("", constants.NETWORK_MAIN, 0, "")
+	if err != nil || p.Name() != ECPriceFER {
+		t.Errorf("Expected the fer policy by default, got %v %v", p, err)
+	}
+	if _, err := NewECPricePolicy(ECPriceFixed, constants.NETWORK_MAIN, 5000, ""); err == nil {
+		t.Errorf("The fixed policy should not be allowed on MAIN")
+	}
+	if _, err := NewECPricePolicy(ECPriceFixed, constants.NETWORK_LOCAL, 0, ""); err == nil {
+		t.Errorf("The fixed policy should need a price")
+	}
+	if _, err := NewECPricePolicy(ECPriceGovernance, constants.NETWORK_CUSTOM, 0, "not a chain"); err == nil {
+		t.Errorf("The governance policy should need a chain ID")
+	}
+	if _, err := NewECPricePolicy("auction", constants.NETWORK_LOCAL, 0, ""); err == nil {
+		t.Errorf("Unknown policies should fail")
+	}
+	p, err = NewECPricePolicy(ECPriceGovernance, constants.NETWORK_CUSTOM, 0, "888888f0b7e308974afc34b2c7f703f25ed2699cb05f818e84e8745644896c55")
+	if err != nil || p.Name() != ECPriceGovernance {
+		t.Errorf("Expected the governance policy, got %v %v", p, err)
+	}
+}
+
+func TestFixedPricePolicy(t *testing.T) {
+	s := new(State)
+	s.DBStates = new(DBStateList)
+	s.FactoshisPerEC = 1000
+	s.FERChangeHeight = 0
+	s.ECPricePolicy = &FixedPricePolicy{Price: 5000}
+
+	s.ProcessECPricePolicy()
+	if s.FERChangePrice != 5000 || s.FERChangeHeight != 2 {
+		t.Fatalf("Expected a change to 5000 at 2, got %d at %d", s.FERChangePrice, s.FERChangeHeight)
+	}
+
+	info := s.GetECRateInfo()
+	if info.Policy != ECPriceFixed || info.Rate != 1000 || info.Predictive != 5000 {
+		t.Errorf("Wrong rate info %v", info)
+	}
+	if len(info.Upcoming) != 1 || !info.Upcoming[0].Scheduled || info.Upcoming[0].Rate != 5000 || info.Upcoming[0].ActivationHeight != 2 {
+		t.Errorf("Expected the scheduled change in upcoming, got %v", info.Upcoming)
+	}
+
+	// Nothing changes while the change is pending
+	s.ProcessECPricePolicy()
+	if s.FERChangePrice != 5000 || s.FERChangeHeight != 2 || s.FactoshisPerEC != 1000 {
+		t.Errorf("Pending change was disturbed")
+	}
+}
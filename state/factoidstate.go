@@ -26,6 +26,10 @@ var _ = debug.PrintStack
 
 var FACTOID_CHAINID_HASH = primitives.NewHash(constants.FACTOID_CHAINID)
 
+// errInsufficientFunds is returned by Validate when the inputs spend more than
+// their addresses hold, as opposed to amounts that are out of range.
+var errInsufficientFunds = fmt.Errorf("Not enough funds in input addresses for the transaction")
+
 type FactoidState struct {
 	DBHeight     uint32
 	State        *State
@@ -267,7 +271,7 @@ func (fs *FactoidState) UpdateECTransaction(rt bool, trans interfaces.IECBlockEn
 	case entryCreditBlock.ECIDChainCommit:
 		t := trans.(*entryCreditBlock.CommitChain)
 		v := fs.State.GetE(rt, t.ECPubKey.Fixed()) - int64(t.Credits)
-		if (fs.DBHeight > 97886 || fs.State.GetNetworkID() != constants.MAIN_NETWORK_ID) && v < 0 {
+		if fs.State.IsFeatureActive(FeatureECBalanceCheck, fs.DBHeight) && v < 0 {
 			return fmt.Errorf("Not enough ECs to cover a commit")
 		}
 		fs.State.PutE(rt, t.ECPubKey.Fixed(), v)
@@ -277,7 +281,7 @@ func (fs *FactoidState) UpdateECTransaction(rt bool, trans interfaces.IECBlockEn
 	case entryCreditBlock.ECIDEntryCommit:
 		t := trans.(*entryCreditBlock.CommitEntry)
 		v := fs.State.GetE(rt, t.ECPubKey.Fixed()) - int64(t.Credits)
-		if (fs.DBHeight > 97886 || fs.State.GetNetworkID() != constants.MAIN_NETWORK_ID) && v < 0 {
+		if fs.State.IsFeatureActive(FeatureECBalanceCheck, fs.DBHeight) && v < 0 {
 			return fmt.Errorf("Not enough ECs to cover a commit")
 		}
 		fs.State.PutE(rt, t.ECPubKey.Fixed(), v)
@@ -377,7 +381,7 @@ func (fs *FactoidState) Validate(index int, trans interfaces.ITransaction) error
 			return err
 		}
 		if int64(bal) > fs.State.GetF(true, input.GetAddress().Fixed()) {
-			return fmt.Errorf("%s", "Not enough funds in input addresses for the transaction")
+			return errInsufficientFunds
 		}
 		sums[input.GetAddress().Fixed()] = bal
 	}
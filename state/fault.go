@@ -87,19 +87,26 @@ func markFault(pl *ProcessList, vmIndex int, faultReason int) {
 	now := time.Now().Unix()
 	vm := pl.VMs[vmIndex]
 
+	c := pl.State.CurrentMinute
+	if c > 9 {
+		c = 9
+	}
+	index := pl.ServerMap[c][vmIndex]
+
 	if vm.WhenFaulted == 0 {
 		// if we did not previously consider this VM faulted
 		// we simply mark it as faulted (by assigning it a nonzero WhenFaulted time)
 		// and keep track of the ProcessList height it has faulted at
 		vm.WhenFaulted = now
 		vm.FaultFlag = faultReason
-	}
 
-	c := pl.State.CurrentMinute
-	if c > 9 {
-		c = 9
+		var serverID interfaces.IHash
+		if index < len(pl.FedServers) {
+			serverID = pl.FedServers[index].GetChainID()
+		}
+		pl.State.FaultTraces.Start(pl.DBHeight, vmIndex, serverID, faultReason)
 	}
-	index := pl.ServerMap[c][vmIndex]
+
 	if index < len(pl.FedServers) {
 		pl.FedServers[index].SetOnline(false)
 	}
@@ -108,6 +115,9 @@ func markFault(pl *ProcessList, vmIndex int, faultReason int) {
 func markNoFault(pl *ProcessList, vmIndex int) {
 	vm := pl.VMs[vmIndex]
 
+	if vm.WhenFaulted > 0 {
+		pl.State.FaultTraces.End(pl.DBHeight, vmIndex, FaultRecovered)
+	}
 	vm.WhenFaulted = 0
 	vm.FaultFlag = -1
 
@@ -130,7 +140,10 @@ func markNoFault(pl *ProcessList, vmIndex int) {
 		if cf.AmINegotiator {
 			ff := CraftFullFault(pl, vmIndex, vm.Height)
 			if ff != nil {
-				ff.Sign(pl.State.serverPrivKey)
+				if err := ff.Sign(pl.State); err != nil {
+					pl.State.AddStatus(fmt.Sprintf("Cannot sign full fault: %v", err))
+					return
+				}
 				ff.SendOut(pl.State, ff)
 				ff.FollowerExecute(pl.State)
 			}
@@ -164,9 +177,12 @@ func NegotiationCheck(pl *ProcessList) {
 		//THROTTLE
 		ff := CraftFullFault(pl, prevIdx, prevVM.Height)
 		if ff != nil {
-			ff.Sign(pl.State.serverPrivKey)
-			ff.SendOut(pl.State, ff)
-			ff.FollowerExecute(pl.State)
+			if err := ff.Sign(pl.State); err != nil {
+				pl.State.AddStatus(fmt.Sprintf("Cannot sign full fault: %v", err))
+			} else {
+				ff.SendOut(pl.State, ff)
+				ff.FollowerExecute(pl.State)
+			}
 		}
 		//pl.State.AddStatus(fmt.Sprintf("Sending Negotiation message (because %d) at %d since LFA=%d: %s", prevVM.FaultFlag, now, pl.State.LastFaultAction, ff.String()))
 		pl.State.LastFaultAction = now
@@ -266,13 +282,19 @@ func CraftFault(pl *ProcessList, vmIndex int, height int) *messages.ServerFault
 		if faultedFed >= len(pl.FedServers) {
 			return nil
 		}
-		pl.FedServers[faultedFed].SetOnline(false)
 		faultedFedID := pl.FedServers[faultedFed].GetChainID()
 
 		// Create and send ServerFault (vote) message
 		sf := messages.NewServerFault(faultedFedID, replacementServer.GetChainID(), vmIndex, pl.DBHeight, uint32(height), pl.System.Height, pl.State.GetTimestamp())
 		if sf != nil {
-			sf.Sign(pl.State.serverPrivKey)
+			err := sf.Sign(pl.State)
+			if err != nil {
+				pl.State.AddStatus(fmt.Sprintf("Cannot sign server fault: %v", err))
+				return nil
+			}
+			pl.FedServers[faultedFed].SetOnline(false)
+			pl.State.FaultTraces.Nominate(pl.DBHeight, vmIndex, faultedFedID, replacementServer.GetChainID(), true)
+			pl.State.FaultTraces.Vote(pl.DBHeight, vmIndex, pl.State.GetServerPublicKey()[:], false)
 			return sf
 		}
 	} else {
@@ -340,7 +362,7 @@ func (s *State) FollowerExecuteSFault(m interfaces.IMsg) {
 		// If no such ProcessList exists, or if we don't consider
 		// the VM in this ServerFault message to be at fault,
 		// do not proceed with regularFaultExecution
-		s.Holding[m.GetMsgHash().Fixed()] = m
+		s.AddToHolding(m)
 		return
 	}
 
@@ -384,6 +406,7 @@ func (s *State) FollowerExecuteSFault(m interfaces.IMsg) {
 
 	if err == nil && (sfSigned > 0 || (sfSigned == 0 && isPledge)) {
 		currentFault.AddFaultVote(issuerID, sf.GetSignature())
+		s.FaultTraces.Vote(sf.DBHeight, int(sf.VMIndex), rawIssuerID, isPledge)
 	}
 }
 
@@ -419,7 +442,10 @@ func isMyNegotiation(sf FaultCore, pl *ProcessList) bool {
 // message, it will copy it, sign it, and send it out to the network
 func (s *State) matchFault(sf *messages.ServerFault) {
 	if sf != nil {
-		sf.Sign(s.serverPrivKey)
+		if err := sf.Sign(s); err != nil {
+			s.AddStatus(fmt.Sprintf("Cannot sign server fault: %v", err))
+			return
+		}
 		sf.SendOut(s, sf)
 		s.InMsgQueue().Enqueue(sf)
 	}
@@ -434,7 +460,7 @@ func (s *State) FollowerExecuteFullFault(m interfaces.IMsg) {
 	pl := s.ProcessLists.Get(fullFault.DBHeight)
 
 	if pl == nil {
-		s.Holding[m.GetMsgHash().Fixed()] = m
+		s.AddToHolding(m)
 		return
 	}
 
@@ -451,7 +477,10 @@ func (s *State) FollowerExecuteFullFault(m interfaces.IMsg) {
 	//	fullFault.String()))
 
 	faultLogger.WithField("func", "AddToSystemList").WithFields(fullFault.LogFields()).Warn("Add to System List")
-	pl.AddToSystemList(fullFault)
+	if pl.AddToSystemList(fullFault) {
+		s.FaultTraces.Nominate(fullFault.DBHeight, int(fullFault.VMIndex), fullFault.ServerID, fullFault.AuditServerID, fullFault.GetAmINegotiator())
+		s.FaultTraces.Event(fullFault.DBHeight, int(fullFault.VMIndex), fmt.Sprintf("full fault at system height %d with %d signatures", fullFault.SystemHeight, len(fullFault.SignatureList.List)))
+	}
 }
 
 // If a FullFault message includes a signature from the Audit server
@@ -464,6 +493,7 @@ func (s *State) pledgedByAudit(fullFault *messages.FullServerFault) bool {
 				for _, sig := range fullFault.SignatureList.List {
 					sigVer, err := a.VerifySignature(marshalledSF, sig.GetSignature())
 					if err == nil && sigVer {
+						s.FaultTraces.Vote(fullFault.DBHeight, int(fullFault.VMIndex), sig.GetKey(), true)
 						return true
 					}
 				}
@@ -0,0 +1,224 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// How a fault trace ended
+const (
+	FaultNegotiating = "negotiating"
+	FaultPromoted    = "audit server promoted"
+	FaultCleared     = "cleared"
+	FaultRecovered   = "leader recovered"
+)
+
+// The faultReason passed to markFault
+var faultReasons = map[int]string{
+	0: "VM not synced",
+	1: "negotiation timed out",
+}
+
+// MaxFaultTraces is how many faults we remember before forgetting the oldest.
+var MaxFaultTraces = 100
+
+type faultKey struct {
+	dbheight uint32
+	vmIndex  int
+}
+
+// FaultTraces remembers recent faults, who was faulted, who was nominated to
+// replace them, and the votes we saw along the way.  It is written by the
+// state loop and read by the API, so it has its own lock.  A nil FaultTraces
+// records nothing.
+type FaultTraces struct {
+	mutex  sync.RWMutex
+	max    int
+	traces []*interfaces.FaultTrace // oldest first
+	open   map[faultKey]*interfaces.FaultTrace
+}
+
+func NewFaultTraces(max int) *FaultTraces {
+	t := new(FaultTraces)
+	t.max = max
+	t.open = make(map[faultKey]*interfaces.FaultTrace)
+	return t
+}
+
+func faultNow() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+// get returns the open trace for a VM, creating it if asked.  Must hold the lock.
+func (t *FaultTraces) get(dbheight uint32, vmIndex int, create bool) *interfaces.FaultTrace {
+	key := faultKey{dbheight, vmIndex}
+	if ft := t.open[key]; ft != nil || !create {
+		return ft
+	}
+	ft := new(interfaces.FaultTrace)
+	ft.DBHeight = dbheight
+	ft.VMIndex = vmIndex
+	ft.Started = faultNow()
+	ft.Outcome = FaultNegotiating
+	t.open[key] = ft
+	t.traces = append(t.traces, ft)
+	for t.max > 0 && len(t.traces) > t.max {
+		old := t.traces[0]
+		if t.open[faultKey{old.DBHeight, old.VMIndex}] == old {
+			delete(t.open, faultKey{old.DBHeight, old.VMIndex})
+		}
+		t.traces = t.traces[1:]
+	}
+	return ft
+}
+
+// event adds an event to a trace, unless the trace already has it.  Must hold
+// the lock.
+func (t *FaultTraces) event(ft *interfaces.FaultTrace, event string) {
+	for _, e := range ft.Events {
+		if e.Event == event {
+			return
+		}
+	}
+	ft.Events = append(ft.Events, &interfaces.FaultEvent{Time: faultNow(), Event: event})
+	faultLogger.WithFields(log.Fields{"func": "FaultTrace", "dbht": ft.DBHeight, "vm": ft.VMIndex}).Info(event)
+}
+
+// Start opens a trace when we first consider a VM faulted.
+func (t *FaultTraces) Start(dbheight uint32, vmIndex int, serverID interfaces.IHash, faultReason int) {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	ft := t.get(dbheight, vmIndex, true)
+	if serverID != nil {
+		ft.ServerID = serverID.String()
+	}
+	ft.Reason = faultReasons[faultReason]
+	t.event(ft, "marked faulted, "+ft.Reason)
+}
+
+// Nominate records the audit server put forward to replace the faulted
+// server, opening a trace if we had not faulted the VM ourselves.
+func (t *FaultTraces) Nominate(dbheight uint32, vmIndex int, serverID interfaces.IHash, auditServerID interfaces.IHash, negotiator bool) {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	ft := t.get(dbheight, vmIndex, true)
+	if ft.ServerID == "" && serverID != nil {
+		ft.ServerID = serverID.String()
+	}
+	if ft.Reason == "" {
+		ft.Reason = "fault received"
+	}
+	ft.Negotiator = ft.Negotiator || negotiator
+	if auditServerID != nil && ft.AuditServerID != auditServerID.String() {
+		ft.AuditServerID = auditServerID.String()
+		t.event(ft, "audit server "+ft.AuditServerID+" nominated")
+	}
+}
+
+// Vote records a signature on an open fault.
+func (t *FaultTraces) Vote(dbheight uint32, vmIndex int, signer []byte, pledge bool) {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	ft := t.get(dbheight, vmIndex, false)
+	if ft == nil {
+		return
+	}
+	key := fmt.Sprintf("%x", signer)
+	for _, sig := range ft.Signatures {
+		if sig.Signer == key {
+			if pledge && !sig.Pledge {
+				sig.Pledge = true
+				t.event(ft, "pledged by audit server")
+			}
+			return
+		}
+	}
+	ft.Signatures = append(ft.Signatures, &interfaces.FaultSignature{Signer: key, Pledge: pledge, Time: faultNow()})
+	if pledge {
+		t.event(ft, "pledged by audit server")
+	}
+}
+
+// Event records something that happened to an open fault.  An event is only
+// recorded once per fault.
+func (t *FaultTraces) Event(dbheight uint32, vmIndex int, event string) {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if ft := t.get(dbheight, vmIndex, false); ft != nil {
+		t.event(ft, event)
+	}
+}
+
+// End closes the open fault on a VM, if there is one.
+func (t *FaultTraces) End(dbheight uint32, vmIndex int, outcome string) {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	ft := t.get(dbheight, vmIndex, false)
+	if ft == nil {
+		return
+	}
+	ft.Ended = faultNow()
+	ft.Outcome = outcome
+	t.event(ft, outcome)
+	delete(t.open, faultKey{dbheight, vmIndex})
+}
+
+// Traces returns a copy of the recent faults, newest first.
+func (t *FaultTraces) Traces() []*interfaces.FaultTrace {
+	traces := make([]*interfaces.FaultTrace, 0)
+	if t == nil {
+		return traces
+	}
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	for i := len(t.traces) - 1; i >= 0; i-- {
+		ft := *t.traces[i]
+		ft.Signatures = nil
+		for _, sig := range t.traces[i].Signatures {
+			s := *sig
+			ft.Signatures = append(ft.Signatures, &s)
+		}
+		ft.Events = nil
+		for _, e := range t.traces[i].Events {
+			ev := *e
+			ft.Events = append(ft.Events, &ev)
+		}
+		traces = append(traces, &ft)
+	}
+	return traces
+}
+
+// GetFaultTraces is called from the APIs to show recent faults.
+func (s *State) GetFaultTraces() []*interfaces.FaultTrace {
+	return s.FaultTraces.Traces()
+}
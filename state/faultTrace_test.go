@@ -0,0 +1,64 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
+)
+
+func TestFaultTraces(t *testing.T) {
+	ft := NewFaultTraces(2)
+	server := primitives.RandomHash()
+	audit := primitives.RandomHash()
+
+	ft.Start(10, 1, server, 0)
+	ft.Nominate(10, 1, server, audit, true)
+	ft.Vote(10, 1, []byte{1}, false)
+	ft.Vote(10, 1, []byte{1}, false)
+	ft.Vote(10, 1, []byte{2}, true)
+	ft.End(10, 1, FaultPromoted)
+	// Late votes do not open a new fault
+	ft.Vote(10, 1, []byte{3}, false)
+
+	traces := ft.Traces()
+	if len(traces) != 1 {
+		t.Fatalf("Expected 1 fault, found %d", len(traces))
+	}
+	f := traces[0]
+	if f.ServerID != server.String() || f.AuditServerID != audit.String() {
+		t.Errorf("Wrong servers in fault trace")
+	}
+	if !f.Negotiator || f.Outcome != FaultPromoted || f.Ended == 0 {
+		t.Errorf("Fault trace did not end as promoted: %v", f)
+	}
+	if len(f.Signatures) != 2 || !f.Signatures[1].Pledge {
+		t.Errorf("Expected 2 signatures with a pledge, found %d", len(f.Signatures))
+	}
+
+	// Traces are copies
+	f.Signatures = nil
+	if len(ft.Traces()[0].Signatures) != 2 {
+		t.Errorf("Traces did not return a copy")
+	}
+
+	ft.Start(11, 0, server, 1)
+	ft.Start(12, 0, server, 1)
+	traces = ft.Traces()
+	if len(traces) != 2 || traces[0].DBHeight != 12 || traces[1].DBHeight != 11 {
+		t.Errorf("Expected the 2 newest faults, newest first")
+	}
+	if traces[0].Outcome != FaultNegotiating {
+		t.Errorf("Open fault has outcome %s", traces[0].Outcome)
+	}
+
+	var none *FaultTraces
+	none.Start(1, 0, server, 0)
+	if len(none.Traces()) != 0 {
+		t.Errorf("A nil FaultTraces recorded a fault")
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/util"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var featuresLogger = packageLogger.WithFields(log.Fields{"subpack": "features"})
+
+// The protocol features gated by block height
+const (
+	FeatureECBalanceCheck   = "ec-balance-check"
+	FeatureBlockReplayCheck = "block-replay-check"
+	FeatureChangeAcks       = "change-acks"
+)
+
+// NeverActive is the activation height of a feature that is not on a network.
+const NeverActive uint32 = math.MaxUint32
+
+// Feature is a protocol change that activates at a block height.  Every node
+// on a network must agree on the heights, or they will fork.
+type Feature struct {
+	Name        string
+	Description string
+	Heights     map[int]uint32 // Keyed by network number, missing is NeverActive
+	Local       bool           // Only changes what this node does, so factomd.conf can set it on any network
+}
+
+// Features is the registry of protocol features.  Add new protocol changes
+// here rather than comparing block heights against constants.
+var Features = []*Feature{
+	{
+		Name:        FeatureECBalanceCheck,
+		Description: "Commits that spend more entry credits than their address holds are invalid",
+		Heights: map[int]uint32{
+			constants.NETWORK_MAIN:   97887,
+			constants.NETWORK_TEST:   0,
+			constants.NETWORK_LOCAL:  0,
+			constants.NETWORK_CUSTOM: 0,
+		},
+	},
+	{
+		Name:        FeatureBlockReplayCheck,
+		Description: "Blocks with factoid transactions replayed from earlier blocks are ignored",
+		Heights: map[int]uint32{
+			constants.NETWORK_MAIN:   100001,
+			constants.NETWORK_TEST:   100001,
+			constants.NETWORK_LOCAL:  100001,
+			constants.NETWORK_CUSTOM: 100001,
+		},
+	},
+	{
+		Name:        FeatureChangeAcks,
+		Description: "The server identity is reloaded from factomd.conf, to hand the server over to a new identity",
+		Heights:     map[int]uint32{},
+		Local:       true,
+	},
+}
+
+func getFeature(name string) *Feature {
+	for _, f := range Features {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// configFeatureHeights are the activation heights set in factomd.conf.  The
+// App.ChangeAcksHeight setting is kept as another way to set change-acks, 0
+// leaving it unset.
+func configFeatureHeights(cfg *util.FactomdConfig) map[string]uint32 {
+	heights := make(map[string]uint32)
+	if cfg.App.ChangeAcksHeight > 0 {
+		heights[FeatureChangeAcks] = cfg.App.ChangeAcksHeight
+	}
+	for name, f := range cfg.Feature {
+		heights[name] = f.ActivationHeight
+	}
+	return heights
+}
+
+// SetFeatureOverrides sets activation heights from factomd.conf.  Apart from
+// local features, they are only used on LOCAL and CUSTOM networks; on MAIN
+// and TEST they would fork us from the network.
+func (s *State) SetFeatureOverrides(heights map[string]uint32) error {
+	overrides := make(map[string]uint32)
+	for name, height := range heights {
+		if getFeature(name) == nil {
+			return fmt.Errorf("Unknown feature %s", name)
+		}
+		overrides[name] = height
+	}
+	s.liveConfigMutex.Lock()
+	s.FeatureOverrides = overrides
+	s.liveConfigMutex.Unlock()
+	return nil
+}
+
+// checkFeatureOverrides drops the overrides the network does not allow.
+// Called once the network is known.
+func (s *State) checkFeatureOverrides() {
+	s.liveConfigMutex.Lock()
+	defer s.liveConfigMutex.Unlock()
+	anyNetwork := s.NetworkNumber == constants.NETWORK_LOCAL || s.NetworkNumber == constants.NETWORK_CUSTOM
+	kept := make(map[string]uint32)
+	for name, height := range s.FeatureOverrides {
+		if !anyNetwork && !getFeature(name).Local {
+			featuresLogger.WithFields(log.Fields{"feature": name, "network": s.Network}).Warn("Feature activation heights can only be overridden on LOCAL and CUSTOM networks, ignoring it")
+			continue
+		}
+		featuresLogger.WithFields(log.Fields{"feature": name, "height": height}).Info("Activation height overridden")
+		kept[name] = height
+	}
+	s.FeatureOverrides = kept
+}
+
+// FeatureActivationHeight returns the height a feature activates at on our
+// network, and whether it came from factomd.conf.
+func (s *State) FeatureActivationHeight(name string) (uint32, bool) {
+	s.liveConfigMutex.RLock()
+	height, ok := s.FeatureOverrides[name]
+	s.liveConfigMutex.RUnlock()
+	if ok {
+		return height, true
+	}
+	f := getFeature(name)
+	if f == nil {
+		return NeverActive, false
+	}
+	if height, ok := f.Heights[s.NetworkNumber]; ok {
+		return height, false
+	}
+	return NeverActive, false
+}
+
+// IsFeatureActive is true if a feature applies to the block at dbheight.
+// Unknown features are never active.
+func (s *State) IsFeatureActive(name string, dbheight uint32) bool {
+	height, _ := s.FeatureActivationHeight(name)
+	return height != NeverActive && dbheight >= height
+}
+
+// GetFeatures lists the features and their activation heights on our network.
+func (s *State) GetFeatures() []*interfaces.FeatureActivation {
+	var features []*interfaces.FeatureActivation
+	for _, f := range Features {
+		fa := new(interfaces.FeatureActivation)
+		fa.Name = f.Name
+		fa.Description = f.Description
+		fa.ActivationHeight, fa.Overridden = s.FeatureActivationHeight(f.Name)
+		fa.Never = fa.ActivationHeight == NeverActive
+		fa.Active = s.IsFeatureActive(f.Name, s.LLeaderHeight)
+		features = append(features, fa)
+	}
+	return features
+}
@@ -0,0 +1,50 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	. "github.com/FactomProject/factomd/state"
+)
+
+func TestFeatureActivation(t *testing.T) {
+	s := new(State)
+	s.NetworkNumber = constants.NETWORK_MAIN
+	if s.IsFeatureActive(FeatureECBalanceCheck, 97886) || !s.IsFeatureActive(FeatureECBalanceCheck, 97887) {
+		t.Errorf("%s activates at the wrong height on MAIN", FeatureECBalanceCheck)
+	}
+	if s.IsFeatureActive(FeatureChangeAcks, 1000000) {
+		t.Errorf("%s is active without a height in factomd.conf", FeatureChangeAcks)
+	}
+	if s.IsFeatureActive("no-such-feature", 1000000) {
+		t.Errorf("Unknown feature is active")
+	}
+
+	s.NetworkNumber = constants.NETWORK_LOCAL
+	if !s.IsFeatureActive(FeatureECBalanceCheck, 0) {
+		t.Errorf("%s should be active from the start on LOCAL", FeatureECBalanceCheck)
+	}
+
+	if err := s.SetFeatureOverrides(map[string]uint32{"no-such-feature": 1}); err == nil {
+		t.Errorf("Overriding an unknown feature did not fail")
+	}
+	if err := s.SetFeatureOverrides(map[string]uint32{FeatureECBalanceCheck: 50}); err != nil {
+		t.Fatal(err)
+	}
+	if s.IsFeatureActive(FeatureECBalanceCheck, 49) || !s.IsFeatureActive(FeatureECBalanceCheck, 50) {
+		t.Errorf("Override of %s was not used", FeatureECBalanceCheck)
+	}
+
+	for _, f := range s.GetFeatures() {
+		if f.Name == FeatureECBalanceCheck && (!f.Overridden || f.ActivationHeight != 50) {
+			t.Errorf("GetFeatures does not show the override: %v", f)
+		}
+	}
+	if len(s.GetFeatures()) != len(Features) {
+		t.Errorf("Expected %d features, found %d", len(Features), len(s.GetFeatures()))
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/adminBlock"
+	"github.com/FactomProject/factomd/common/directoryBlock"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/util"
+)
+
+// LoadCustomGenesis reads a genesis file for a CUSTOM network and takes the
+// bootstrap identity and key, block time and exchange rate from it.  The
+// -blktime flag still overrides the block time, applied by
+// LoadConfigWithFlags once the genesis is loaded.
+func (s *State) LoadCustomGenesis(filename string) {
+	g, err := util.ReadGenesis(filename)
+	if err != nil {
+		panic(fmt.Sprintf("Cannot load the custom genesis file: %v", err))
+	}
+	s.CustomGenesis = g
+	s.CustomBootstrapIdentity = g.BootstrapIdentity
+	s.CustomBootstrapKey = g.BootstrapKey
+	s.DirectoryBlockInSeconds = g.DirectoryBlockInSeconds
+	s.FactoshisPerEC = g.ExchangeRate
+}
+
+// GenerateCustomGenesisBlocks builds the genesis blocks of a CUSTOM network
+// from its genesis configuration.  The admin block adds each federated server
+// and its signing key, and the factoid balances are the outputs of the
+// coinbase transaction.
+func GenerateCustomGenesisBlocks(networkID uint32, g *util.GenesisConfig) (interfaces.IDirectoryBlock, interfaces.IAdminBlock, interfaces.IFBlock, interfaces.IEntryCreditBlock) {
+	dblk := directoryBlock.NewDirectoryBlock(nil)
+	ablk := adminBlock.NewAdminBlock(nil)
+	fblk := factoid.NewFBlock(nil)
+	ecblk := entryCreditBlock.NewECBlock()
+
+	feds := g.FederatedServers
+	if len(feds) == 0 {
+		feds = []util.GenesisServer{{Identity: g.BootstrapIdentity, SigningKey: g.BootstrapKey}}
+	}
+	for _, fed := range feds {
+		id, _ := primitives.HexToHash(fed.Identity)
+		ablk.AddFedServer(id)
+		ablk.AddFederatedServerSigningKey(id, primitives.PubKeyFromString(fed.SigningKey))
+	}
+
+	fblk.SetExchRate(g.ExchangeRate)
+	coinbase := new(factoid.Transaction)
+	coinbase.SetTimestamp(primitives.NewTimestampFromSeconds(uint32(g.Timestamp)))
+	for _, b := range g.Balances {
+		coinbase.AddOutput(factoid.NewAddress(primitives.ConvertUserStrToAddress(b.Address)), b.Factoshis)
+	}
+	if err := fblk.AddCoinbase(coinbase); err != nil {
+		panic(err)
+	}
+	fblk.GetBodyMR()
+
+	dblk.SetABlockHash(ablk)
+	dblk.SetECBlockHash(ecblk)
+	dblk.SetFBlockHash(fblk)
+	dblk.GetHeader().SetNetworkID(networkID)
+
+	dblk.GetHeader().SetTimestamp(primitives.NewTimestampFromMinutes(uint32(g.Timestamp / 60)))
+	dblk.BuildBodyMR()
+
+	return dblk, ablk, fblk, ecblk
+}
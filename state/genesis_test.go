@@ -0,0 +1,68 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/adminBlock"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/util"
+)
+
+func TestGenerateCustomGenesisBlocks(t *testing.T) {
+	g := new(util.GenesisConfig)
+	g.NetworkName = "testnet"
+	g.NetworkID = hex.EncodeToString(util.CustomNetworkID("testnet"))
+	g.Timestamp = 1500000000
+	g.BootstrapIdentity = "38bab1455b7bd7e5efd15c53c777c79d0c988e9210f1da49a99d95b3a6417be9"
+	g.BootstrapKey = "cc1985cdfae4e32b5a454dfda8ce5e1361558482684f3367649c3ad852c8e31a"
+	g.DirectoryBlockInSeconds = 60
+	g.ExchangeRate = 5000
+	g.Balances = []util.GenesisBalance{
+		{Address: "FA2jK2HcLnRdS94dEcU27rF3meoJfpUcZPSinpb7AwQvPRY6RL1Q", Factoshis: 100},
+		{Address: "FA2Ucjw1pBzrCxDJP82yCbhzk9BFU2aWdb1VqitBot3mFAseW6Uo", Factoshis: 200},
+	}
+
+	networkID := binary.BigEndian.Uint32(util.CustomNetworkID(g.NetworkName))
+	dblk, ablk, fblk, _ := GenerateCustomGenesisBlocks(networkID, g)
+
+	if dblk.GetHeader().GetNetworkID() != networkID {
+		t.Errorf("Wrong network ID")
+	}
+	if fblk.GetExchRate() != 5000 {
+		t.Errorf("Wrong exchange rate %d", fblk.GetExchRate())
+	}
+	outputs := fblk.GetTransactions()[0].GetOutputs()
+	if len(outputs) != 2 {
+		t.Fatalf("Expected 2 funded addresses, found %d", len(outputs))
+	}
+	if primitives.ConvertFctAddressToUserStr(outputs[1].GetAddress()) != g.Balances[1].Address || outputs[1].GetAmount() != 200 {
+		t.Errorf("Wrong coinbase output %v", outputs[1])
+	}
+	if len(ablk.GetABEntries()) != 2 {
+		t.Errorf("Expected the bootstrap identity and key as the only federated server")
+	}
+
+	g.FederatedServers = []util.GenesisServer{
+		{Identity: g.BootstrapIdentity, SigningKey: g.BootstrapKey},
+		{Identity: "888888d027c59579fc47a6fc6c4a5c0409c7c39bc38a86cb5fc0069978493762", SigningKey: "9a5b1c3bd4a0c1a4e3e32aba26c8a2c9f5b44c5fd3c89bd7d8a6e42f3c1b0d2e"},
+	}
+	_, ablk, _, _ = GenerateCustomGenesisBlocks(networkID, g)
+	keys := make(map[string]string)
+	for _, e := range ablk.GetABEntries() {
+		if k, ok := e.(*adminBlock.AddFederatedServerSigningKey); ok {
+			keys[k.IdentityChainID.String()] = hex.EncodeToString(k.PublicKey[:])
+		}
+	}
+	for _, fed := range g.FederatedServers {
+		if keys[fed.Identity] != fed.SigningKey {
+			t.Errorf("Wrong signing key %s for federated server %s", keys[fed.Identity], fed.Identity)
+		}
+	}
+}
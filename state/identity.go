@@ -562,7 +562,7 @@ func RegisterBlockSigningKey(entry interfaces.IEBEntry, initial bool, height uin
 			if !initial && statusIsFedOrAudit(status) && st.GetLeaderVM() == st.ComputeVMIndex(entry.GetChainID().Bytes()) {
 				key := primitives.NewHash(extIDs[3])
 				msg := messages.NewChangeServerKeyMsg(st, chainID, constants.TYPE_ADD_FED_SERVER_KEY, 0, 0, key)
-				err := msg.(*messages.ChangeServerKeyMsg).Sign(st.serverPrivKey)
+				err := msg.(*messages.ChangeServerKeyMsg).Sign(st)
 				if err != nil {
 					return errors.New("New Block Signing key for identity [" + chainID.String()[:10] + "] Error: cannot sign msg")
 				}
@@ -630,7 +630,7 @@ func UpdateMatryoshkaHash(entry interfaces.IEBEntry, initial bool, height uint32
 			if !initial && statusIsFedOrAudit(status) && st.GetLeaderVM() == st.ComputeVMIndex(entry.GetChainID().Bytes()) {
 				//if st.LeaderPL.VMIndexFor(constants.ADMIN_CHAINID) == st.GetLeaderVM() {
 				msg := messages.NewChangeServerKeyMsg(st, chainID, constants.TYPE_ADD_MATRYOSHKA, 0, 0, mhash)
-				err := msg.(*messages.ChangeServerKeyMsg).Sign(st.serverPrivKey)
+				err := msg.(*messages.ChangeServerKeyMsg).Sign(st)
 				if err != nil {
 					return errors.New("New Block Signing key for identity [" + chainID.String()[:10] + "] Error: cannot sign msg")
 				}
@@ -727,7 +727,7 @@ func RegisterAnchorSigningKey(entry interfaces.IEBEntry, initial bool, height ui
 				extIDs[5] = append(extIDs[5], []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}...)
 				key := primitives.NewHash(extIDs[5])
 				msg := messages.NewChangeServerKeyMsg(st, chainID, constants.TYPE_ADD_BTC_ANCHOR_KEY, extIDs[3][0], extIDs[4][0], key)
-				err := msg.(*messages.ChangeServerKeyMsg).Sign(st.serverPrivKey)
+				err := msg.(*messages.ChangeServerKeyMsg).Sign(st)
 				if err != nil {
 					return errors.New("New Block Signing key for identity [" + chainID.String()[:10] + "] Error: cannot sign msg")
 				}
@@ -0,0 +1,193 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/FactomProject/factomd/common/adminBlock"
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// IdentityRequestTimeout is how long the identity APIs wait on the state loop.
+var IdentityRequestTimeout = 5 * time.Second
+
+func identityStatusString(status uint8) string {
+	switch status {
+	case constants.IDENTITY_UNASSIGNED:
+		return "unassigned"
+	case constants.IDENTITY_FEDERATED_SERVER:
+		return "federated"
+	case constants.IDENTITY_AUDIT_SERVER:
+		return "audit"
+	case constants.IDENTITY_FULL:
+		return "full"
+	case constants.IDENTITY_PENDING_FEDERATED_SERVER:
+		return "pending federated"
+	case constants.IDENTITY_PENDING_AUDIT_SERVER:
+		return "pending audit"
+	case constants.IDENTITY_PENDING_FULL:
+		return "pending full"
+	case constants.IDENTITY_SKELETON:
+		return "skeleton"
+	}
+	return "NA"
+}
+
+func hashString(h interfaces.IHash) string {
+	if h == nil {
+		return ""
+	}
+	return h.String()
+}
+
+// answerIdentityRequests runs the lookups the identity APIs asked for.  It is
+// executed in the state maintenance processes, as only the state loop can read
+// the identities and authorities.
+func (st *State) answerIdentityRequests() {
+	for {
+		select {
+		case request := <-st.identityRequests:
+			request()
+		default:
+			return
+		}
+	}
+}
+
+// askStateLoop runs lookup in the state loop and waits for it.  It returns
+// false if the state loop doesn't run it in time.
+func (st *State) askStateLoop(lookup func()) bool {
+	done := make(chan struct{})
+	select {
+	case st.identityRequests <- func() { lookup(); close(done) }:
+	default:
+		return false
+	}
+	select {
+	case <-done:
+		return true
+	case <-time.After(IdentityRequestTimeout):
+		return false
+	}
+}
+
+// GetIdentityChainIDs is called from the APIs, so the state loop is asked for
+// the chain IDs.
+func (st *State) GetIdentityChainIDs() []interfaces.IHash {
+	var ids []interfaces.IHash
+	st.askStateLoop(func() { ids = st.identityChainIDs() })
+	return ids
+}
+
+// GetIdentityStatus is called from the APIs, so the state loop is asked for
+// the status.  It returns nil if the identity is unknown or the state loop
+// doesn't answer.
+func (st *State) GetIdentityStatus(chainID interfaces.IHash) *interfaces.IdentityStatus {
+	var r *interfaces.IdentityStatus
+	if !st.askStateLoop(func() { r = st.identityStatus(chainID) }) {
+		return nil
+	}
+	return r
+}
+
+// identityChainIDs returns the chain IDs of every identity and authority we
+// are tracking.
+func (st *State) identityChainIDs() []interfaces.IHash {
+	var ids []interfaces.IHash
+	for _, id := range st.Identities {
+		ids = append(ids, id.IdentityChainID)
+	}
+	for _, auth := range st.Authorities {
+		if st.isIdentityChain(auth.AuthorityChainID) == -1 {
+			ids = append(ids, auth.AuthorityChainID)
+		}
+	}
+	return ids
+}
+
+// identityStatus collects the identity record, the authority record and any
+// key changes still waiting on an admin block for the given chain.
+func (st *State) identityStatus(chainID interfaces.IHash) *interfaces.IdentityStatus {
+	index := st.isIdentityChain(chainID)
+	auth, _ := st.GetAuthority(chainID)
+	if index == -1 && auth == nil {
+		return nil
+	}
+
+	r := new(interfaces.IdentityStatus)
+	r.IdentityChainID = chainID.String()
+
+	if index != -1 {
+		id := st.Identities[index]
+		r.IdentityChainID = hashString(id.IdentityChainID)
+		r.Status = identityStatusString(id.Status)
+		r.IdentityCreated = id.IdentityCreated
+		r.IdentityRegistered = id.IdentityRegistered
+		r.ManagementChainID = hashString(id.ManagementChainID)
+		r.ManagementCreated = id.ManagementCreated
+		r.ManagementRegistered = id.ManagementRegistered
+		r.MatryoshkaHash = hashString(id.MatryoshkaHash)
+		for _, k := range []interfaces.IHash{id.Key1, id.Key2, id.Key3, id.Key4} {
+			r.Keys = append(r.Keys, hashString(k))
+		}
+		r.SigningKey = hashString(id.SigningKey)
+		for _, a := range id.AnchorKeys {
+			r.AnchorKeys = append(r.AnchorKeys, interfaces.IdentityAnchorKey{
+				BlockChain: a.BlockChain,
+				KeyLevel:   a.KeyLevel,
+				KeyType:    a.KeyType,
+				SigningKey: fmt.Sprintf("%x", a.SigningKey),
+			})
+		}
+		if err := checkIdentityForFull(index, st); err != nil {
+			r.NotEligibleReason = err.Error()
+		} else {
+			r.Eligible = true
+		}
+	} else {
+		r.NotEligibleReason = "Identity chains have not been loaded"
+	}
+
+	if auth != nil {
+		r.IsAuthority = true
+		r.AuthorityStatus = identityStatusString(auth.Status)
+		if r.SigningKey == "" {
+			r.SigningKey = auth.SigningKey.String()
+		}
+		for _, hk := range auth.KeyHistory {
+			change := interfaces.IdentityKeyChange{Type: "signingkey", DBHeight: hk.ActiveDBHeight, Key: hk.SigningKey.String()}
+			r.KeyHistory = append(r.KeyHistory, change)
+			// The replacement key is only used from ActiveDBHeight on
+			if hk.ActiveDBHeight > st.LLeaderHeight {
+				change.Key = auth.SigningKey.String()
+				r.PendingChanges = append(r.PendingChanges, change)
+			}
+		}
+	}
+
+	// Changes in the admin block we are building now
+	if st.LeaderPL != nil && st.LeaderPL.AdminBlock != nil {
+		for _, entry := range st.LeaderPL.AdminBlock.GetABEntries() {
+			switch e := entry.(type) {
+			case *adminBlock.AddFederatedServerSigningKey:
+				if e.IdentityChainID.IsSameAs(chainID) {
+					r.PendingChanges = append(r.PendingChanges, interfaces.IdentityKeyChange{Type: "signingkey", DBHeight: e.DBHeight, Key: e.PublicKey.String()})
+				}
+			case *adminBlock.AddReplaceMatryoshkaHash:
+				if e.IdentityChainID.IsSameAs(chainID) {
+					r.PendingChanges = append(r.PendingChanges, interfaces.IdentityKeyChange{Type: "matryoshkahash", DBHeight: st.LeaderPL.DBHeight, Key: hashString(e.MHash)})
+				}
+			case *adminBlock.AddFederatedServerBitcoinAnchorKey:
+				if e.IdentityChainID.IsSameAs(chainID) {
+					r.PendingChanges = append(r.PendingChanges, interfaces.IdentityKeyChange{Type: "anchorkey", DBHeight: st.LeaderPL.DBHeight, Key: fmt.Sprintf("%x", e.ECDSAPublicKey[:])})
+				}
+			}
+		}
+	}
+	return r
+}
@@ -0,0 +1,62 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+// runStateLoop runs the state loop until stopped, so the API calls that ask it
+// for answers get them.
+func runStateLoop(s *State) (stop func()) {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				s.UpdateState()
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+func TestGetIdentityStatus(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+	defer runStateLoop(s)()
+
+	if s.GetIdentityStatus(primitives.RandomHash()) != nil {
+		t.Errorf("Found an identity for a random chain")
+	}
+
+	ids := s.GetIdentityChainIDs()
+	if len(ids) == 0 {
+		t.Errorf("No identities listed")
+	}
+	for _, id := range ids {
+		status := s.GetIdentityStatus(id)
+		if status == nil {
+			t.Errorf("Identity %s was listed but not found", id.String())
+			continue
+		}
+		if status.IdentityChainID != id.String() {
+			t.Errorf("Wrong identity returned, found %s expected %s", status.IdentityChainID, id.String())
+		}
+		if !status.Eligible && status.NotEligibleReason == "" {
+			t.Errorf("Identity %s is not eligible but has no reason", id.String())
+		}
+	}
+}
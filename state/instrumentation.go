@@ -137,6 +137,14 @@ var (
 		Name: "factomd_state_holding_queue_total_recycles",
 		Help: "Tally of total messages recycled thru Holding (useful for rating)",
 	})
+	HoldingQueueEvictions = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_holding_queue_evictions",
+		Help: "Tally of messages evicted from Holding because it was full",
+	})
+	HoldingQueueRefused = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_holding_queue_refused",
+		Help: "Tally of messages refused by Holding because their EC address or peer was over quota",
+	})
 	HoldingQueueDBSigInputs = prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "factomd_state_holding_queue_dbsig_inputs",
 		Help: "Tally of DBSig messages gone into Holding (useful for rating)",
@@ -313,6 +321,8 @@ func RegisterPrometheus() {
 	prometheus.MustRegister(TotalHoldingQueueInputs)
 	prometheus.MustRegister(TotalHoldingQueueOutputs)
 	prometheus.MustRegister(TotalHoldingQueueRecycles)
+	prometheus.MustRegister(HoldingQueueEvictions)
+	prometheus.MustRegister(HoldingQueueRefused)
 	prometheus.MustRegister(HoldingQueueDBSigInputs)
 	prometheus.MustRegister(HoldingQueueDBSigOutputs)
 	prometheus.MustRegister(HoldingQueueCommitEntryInputs)
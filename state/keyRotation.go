@@ -0,0 +1,101 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/signer"
+)
+
+// KeyRotation tracks a change of this server's block signing key once the
+// change has been recorded in an admin block.  Both keys are held through the
+// overlap period: heights below the activation height are still signed with
+// the old key, the activation height and above with the new one.
+type KeyRotation struct {
+	OldSigner        interfaces.IServerSigner
+	NewSigner        interfaces.IServerSigner
+	NewKey           *primitives.PrivateKey
+	ActivationHeight uint32 // DBHeight recorded in the AddFederatedServerSigningKey entry
+}
+
+// scheduleKeyRotation is called when the admin block records a new signing key
+// for our identity that matches one of our pending keys.  The swap is deferred
+// until the activation height is reached.
+func (s *State) scheduleKeyRotation(newKey *primitives.PrivateKey, height uint32) {
+	s.keyRotation = new(KeyRotation)
+	s.keyRotation.OldSigner = s.serverSigner
+	s.keyRotation.NewSigner = s.guardSigner(signer.NewLocalSigner(newKey))
+	s.keyRotation.NewKey = newKey
+	s.keyRotation.ActivationHeight = height
+	s.AddStatus(fmt.Sprintf("Server key rotation to %s scheduled for height %d", newKey.Pub.String()[:8], height))
+}
+
+// completeKeyRotation makes the new key the active key once the directory block
+// at the activation height has been processed.  Nothing will be signed for a
+// lower height after that, so the old key can be dropped.
+func (s *State) completeKeyRotation(height uint32) {
+	if s.keyRotation == nil || height < s.keyRotation.ActivationHeight {
+		return
+	}
+	s.serverPrivKey = s.keyRotation.NewKey
+	s.serverPubKey = s.keyRotation.NewKey.Pub
+	s.serverSigner = s.keyRotation.NewSigner
+	s.keyRotation = nil
+	s.fillKeyRotationStatus()
+}
+
+// serverSignerAt returns the signer this server must use for messages at the
+// given directory block height.
+func (s *State) serverSignerAt(dbheight uint32) interfaces.IServerSigner {
+	if s.keyRotation != nil && dbheight >= s.keyRotation.ActivationHeight {
+		return s.keyRotation.NewSigner
+	}
+	return s.serverSigner
+}
+
+// keyRotationStatus reports our keys and any rotation under way.  Only the
+// state loop can read them safely.
+func (s *State) keyRotationStatus() *interfaces.KeyRotationStatus {
+	r := new(interfaces.KeyRotationStatus)
+	r.LeaderHeight = s.LLeaderHeight
+	if s.serverPubKey != nil {
+		r.CurrentKey = s.serverPubKey.String()
+	}
+	for _, pub := range s.serverPendingPubKeys {
+		r.PendingKeys = append(r.PendingKeys, pub.String())
+	}
+	if s.keyRotation != nil {
+		r.Scheduled = true
+		r.NewKey = s.keyRotation.NewKey.Pub.String()
+		r.ActivationHeight = s.keyRotation.ActivationHeight
+		r.SigningKey = fmt.Sprintf("%x", s.serverSignerAt(s.LLeaderHeight).GetPublicKey())
+	} else {
+		r.SigningKey = r.CurrentKey
+	}
+	return r
+}
+
+// fillKeyRotationStatus is executed in the state maintenance processes, and
+// whenever our keys change, so the API can read a copy of the status.
+func (s *State) fillKeyRotationStatus() {
+	status := s.keyRotationStatus()
+	s.KeyRotationStatusMutex.Lock()
+	defer s.KeyRotationStatusMutex.Unlock()
+	s.KeyRotationStatus = status
+}
+
+// GetKeyRotationStatus is called from the debug API, which cannot safely read
+// the keys itself.
+func (s *State) GetKeyRotationStatus() *interfaces.KeyRotationStatus {
+	s.KeyRotationStatusMutex.RLock()
+	defer s.KeyRotationStatusMutex.RUnlock()
+	if s.KeyRotationStatus == nil {
+		return new(interfaces.KeyRotationStatus)
+	}
+	return s.KeyRotationStatus
+}
@@ -0,0 +1,57 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/adminBlock"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestKeyRotation(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+	oldPub := s.GetServerPublicKey()
+	newKey := primitives.RandomPrivateKey()
+	s.SetPendingSigningKey(newKey)
+
+	activation := s.LLeaderHeight + 1
+	entry := adminBlock.NewAddFederatedServerSigningKey(s.IdentityChainID, 0, *newKey.Pub, activation)
+	err := s.UpdateAuthorityFromABEntry(entry)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	status := s.GetKeyRotationStatus()
+	if !status.Scheduled {
+		t.Fatalf("Key rotation was not scheduled")
+	}
+	if status.ActivationHeight != activation {
+		t.Errorf("Wrong activation height, found %d expected %d", status.ActivationHeight, activation)
+	}
+	if len(status.PendingKeys) != 0 {
+		t.Errorf("Pending key should have been consumed, found %d", len(status.PendingKeys))
+	}
+
+	// Before the activation height we still sign with the old key
+	sig := s.Sign([]byte("before"))
+	if !primitives.AreBytesEqual(sig.GetKey(), oldPub[:]) {
+		t.Errorf("Signed with the new key before the activation height")
+	}
+
+	s.UpdateAuthSigningKeys(activation - 1)
+	if !s.GetServerPublicKey().IsSameAs(oldPub) {
+		t.Errorf("Key rotated before the activation height")
+	}
+
+	s.UpdateAuthSigningKeys(activation)
+	if !s.GetServerPublicKey().IsSameAs(newKey.Pub) {
+		t.Errorf("Key did not rotate at the activation height")
+	}
+	if s.GetKeyRotationStatus().Scheduled {
+		t.Errorf("Key rotation still scheduled after activation")
+	}
+}
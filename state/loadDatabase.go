@@ -80,6 +80,9 @@ func LoadDatabase(s *State) {
 		s.Println("***********************************\n")
 
 		dblk, ablk, fblk, ecblk := GenerateGenesisBlocks(s.GetNetworkID())
+		if s.CustomGenesis != nil && s.NetworkNumber == constants.NETWORK_CUSTOM {
+			dblk, ablk, fblk, ecblk = GenerateCustomGenesisBlocks(s.GetNetworkID(), s.CustomGenesis)
+		}
 
 		msg := messages.NewDBStateMsg(s.GetTimestamp(), dblk, ablk, fblk, ecblk, nil, nil, nil)
 		s.InMsgQueue().Enqueue(msg)
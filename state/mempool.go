@@ -0,0 +1,398 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var mempoolLogger = packageLogger.WithFields(log.Fields{"subpack": "mempool"})
+
+// MempoolInfoTimeout is how long GetMempoolInfo waits on the state loop.
+var MempoolInfoTimeout = 5 * time.Second
+
+// MempoolEntry is what we know about a message sitting in Holding.
+type MempoolEntry struct {
+	Added     time.Time
+	Size      int
+	ECAddress string // Commits only
+	Peer      string // Empty if the message came from our own API
+	Valid     int    // What Validate said when it was added, invalid messages are evicted first
+}
+
+// Mempool bounds the Holding map.  Holding is still where messages live, the
+// Mempool keeps the counts the limits need as messages are added with
+// AddToHolding and removed with DeleteFromHolding, and a heap of the entries
+// and transactions in the order they would be evicted.
+//
+// Only entries and transactions are subject to the limits.  Consensus messages
+// (acks, EOMs, DBSigs, faults...) are counted but never refused or evicted.
+type Mempool struct {
+	MaxCount        int // 0 is unlimited
+	MaxBytes        int
+	MaxPerECAddress int
+	MaxPerPeer      int
+
+	Entries map[[32]byte]*MempoolEntry
+	Bytes   int
+	Evicted int
+	Refused int
+
+	perECAddress map[string]int
+	perPeer      map[string]int
+	evictable    evictionQueue
+}
+
+func NewMempool(maxCount, maxBytes, maxPerECAddress, maxPerPeer int) *Mempool {
+	m := new(Mempool)
+	m.MaxCount = maxCount
+	m.MaxBytes = maxBytes
+	m.MaxPerECAddress = maxPerECAddress
+	m.MaxPerPeer = maxPerPeer
+	m.Entries = make(map[[32]byte]*MempoolEntry)
+	m.perECAddress = make(map[string]int)
+	m.perPeer = make(map[string]int)
+	return m
+}
+
+type evictionCandidate struct {
+	hash  [32]byte
+	entry *MempoolEntry
+}
+
+// evictionQueue is a heap of entries and transactions, invalid ones first,
+// then the oldest.  Removing a message from the Mempool leaves it in the heap,
+// it is skipped when it reaches the top.
+type evictionQueue []evictionCandidate
+
+func (q evictionQueue) Len() int { return len(q) }
+func (q evictionQueue) Less(i, j int) bool {
+	if q[i].entry.Valid != q[j].entry.Valid {
+		return q[i].entry.Valid < q[j].entry.Valid
+	}
+	return q[i].entry.Added.Before(q[j].entry.Added)
+}
+func (q evictionQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *evictionQueue) Push(x interface{}) { *q = append(*q, x.(evictionCandidate)) }
+func (q *evictionQueue) Pop() interface{} {
+	old := *q
+	c := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return c
+}
+
+// isMempoolMsg is true for the user submitted messages the limits apply to.
+func isMempoolMsg(msg interfaces.IMsg) bool {
+	switch msg.Type() {
+	case constants.COMMIT_CHAIN_MSG, constants.COMMIT_ENTRY_MSG, constants.REVEAL_ENTRY_MSG, constants.FACTOID_TRANSACTION_MSG:
+		return true
+	}
+	return false
+}
+
+func msgECAddress(msg interfaces.IMsg) string {
+	switch m := msg.(type) {
+	case *messages.CommitChainMsg:
+		return fmt.Sprintf("%x", m.CommitChain.ECPubKey[:])
+	case *messages.CommitEntryMsg:
+		return fmt.Sprintf("%x", m.CommitEntry.ECPubKey[:])
+	}
+	return ""
+}
+
+func (m *Mempool) add(hash [32]byte, msg interfaces.IMsg, valid int) {
+	if _, ok := m.Entries[hash]; ok {
+		return
+	}
+	e := new(MempoolEntry)
+	e.Added = time.Now()
+	e.Valid = valid
+	if data, err := msg.MarshalBinary(); err == nil {
+		e.Size = len(data)
+	}
+	if isMempoolMsg(msg) {
+		e.ECAddress = msgECAddress(msg)
+		if !msg.IsLocal() {
+			e.Peer = msg.GetNetworkOrigin()
+		}
+		m.compact()
+		heap.Push(&m.evictable, evictionCandidate{hash, e})
+	}
+	m.Entries[hash] = e
+	m.Bytes += e.Size
+	if e.ECAddress != "" {
+		m.perECAddress[e.ECAddress]++
+	}
+	if e.Peer != "" {
+		m.perPeer[e.Peer]++
+	}
+}
+
+func (m *Mempool) remove(hash [32]byte) {
+	e, ok := m.Entries[hash]
+	if !ok {
+		return
+	}
+	delete(m.Entries, hash)
+	m.Bytes -= e.Size
+	if e.ECAddress != "" {
+		if m.perECAddress[e.ECAddress]--; m.perECAddress[e.ECAddress] <= 0 {
+			delete(m.perECAddress, e.ECAddress)
+		}
+	}
+	if e.Peer != "" {
+		if m.perPeer[e.Peer]--; m.perPeer[e.Peer] <= 0 {
+			delete(m.perPeer, e.Peer)
+		}
+	}
+}
+
+// held is true if the candidate is still in the Mempool, and wasn't removed
+// and added again since it was pushed.
+func (m *Mempool) held(c evictionCandidate) bool {
+	return m.Entries[c.hash] == c.entry
+}
+
+// compact drops the removed messages from the heap once they are most of it.
+func (m *Mempool) compact() {
+	if len(m.evictable) < 64 || len(m.evictable) < 2*len(m.Entries) {
+		return
+	}
+	q := m.evictable[:0]
+	for _, c := range m.evictable {
+		if m.held(c) {
+			q = append(q, c)
+		}
+	}
+	for i := len(q); i < len(m.evictable); i++ {
+		m.evictable[i] = evictionCandidate{}
+	}
+	m.evictable = q
+	heap.Init(&m.evictable)
+}
+
+func (m *Mempool) overQuota(msg interfaces.IMsg) string {
+	if m.MaxPerECAddress > 0 {
+		if ec := msgECAddress(msg); ec != "" && m.perECAddress[ec] >= m.MaxPerECAddress {
+			return "EC address " + ec + " is over its quota"
+		}
+	}
+	if m.MaxPerPeer > 0 && !msg.IsLocal() {
+		if peer := msg.GetNetworkOrigin(); peer != "" && m.perPeer[peer] >= m.MaxPerPeer {
+			return "peer " + peer + " is over its quota"
+		}
+	}
+	return ""
+}
+
+func (m *Mempool) overLimit() bool {
+	return (m.MaxCount > 0 && len(m.Entries) > m.MaxCount) || (m.MaxBytes > 0 && m.Bytes > m.MaxBytes)
+}
+
+// getMempool is only called from the state loop, which picks up any limits
+// changed by SetMempoolLimits here.
+func (s *State) getMempool() *Mempool {
+	if s.mempool == nil {
+		s.mempool = NewMempool(0, 0, 0, 0)
+		atomic.StoreUint32(&s.mempoolLimitsChanged, 1)
+	}
+	if atomic.CompareAndSwapUint32(&s.mempoolLimitsChanged, 1, 0) {
+		s.liveConfigMutex.RLock()
+		m := s.mempool
+		m.MaxCount = s.MempoolMaxCount
+		m.MaxBytes = s.MempoolMaxBytes
+		m.MaxPerECAddress = s.MempoolMaxPerECAddress
+		m.MaxPerPeer = s.MempoolMaxPerPeer
+		s.liveConfigMutex.RUnlock()
+	}
+	if len(s.mempool.Entries) != len(s.Holding) {
+		s.syncMempool()
+	}
+	return s.mempool
+}
+
+// syncMempool catches the Mempool up with a Holding map that was replaced
+// rather than changed through AddToHolding and DeleteFromHolding.
+func (s *State) syncMempool() {
+	m := s.mempool
+	for k := range m.Entries {
+		if _, ok := s.Holding[k]; !ok {
+			m.remove(k)
+		}
+	}
+	for k, v := range s.Holding {
+		if _, ok := m.Entries[k]; !ok {
+			m.add(k, v, 1)
+		}
+	}
+}
+
+// SetMempoolLimits changes the limits on Holding.  It can be called from any
+// goroutine, the state loop applies them when the next message is added.
+func (s *State) SetMempoolLimits(maxCount, maxBytes, maxPerECAddress, maxPerPeer int) {
+	s.liveConfigMutex.Lock()
+	defer s.liveConfigMutex.Unlock()
+	s.setMempoolLimits(maxCount, maxBytes, maxPerECAddress, maxPerPeer)
+}
+
+func (s *State) setMempoolLimits(maxCount, maxBytes, maxPerECAddress, maxPerPeer int) {
+	s.MempoolMaxCount = maxCount
+	s.MempoolMaxBytes = maxBytes
+	s.MempoolMaxPerECAddress = maxPerECAddress
+	s.MempoolMaxPerPeer = maxPerPeer
+	atomic.StoreUint32(&s.mempoolLimitsChanged, 1)
+}
+
+// AddToHolding puts a message in Holding unless it is an entry or transaction
+// over its quota.  Going over the count or byte limits evicts entries and
+// transactions, invalid ones first, then the oldest.
+func (s *State) AddToHolding(msg interfaces.IMsg) bool {
+	hash := msg.GetMsgHash().Fixed()
+	m := s.getMempool()
+
+	if _, held := m.Entries[hash]; held {
+		s.Holding[hash] = msg
+		return true
+	}
+
+	valid := 1
+	if isMempoolMsg(msg) && s.Acks[hash] == nil {
+		if reason := m.overQuota(msg); reason != "" {
+			m.Refused++
+			HoldingQueueRefused.Inc()
+			mempoolLogger.WithFields(msg.LogFields()).Debugf("Refused, %s", reason)
+			s.RecordRejection(msg, RejectMempool, reason)
+			return false
+		}
+		valid = msg.Validate(s)
+	}
+
+	s.Holding[hash] = msg
+	m.add(hash, msg, valid)
+	s.evictHolding()
+	return true
+}
+
+// DeleteFromHolding takes a message out of Holding and the Mempool.
+func (s *State) DeleteFromHolding(hash [32]byte) {
+	delete(s.Holding, hash)
+	if s.mempool != nil {
+		s.mempool.remove(hash)
+	}
+}
+
+func (s *State) evictHolding() {
+	m := s.mempool
+	var acked []evictionCandidate
+	for m.overLimit() && len(m.evictable) > 0 {
+		c := heap.Pop(&m.evictable).(evictionCandidate)
+		if !m.held(c) {
+			continue
+		}
+		if s.Acks[c.hash] != nil {
+			acked = append(acked, c)
+			continue
+		}
+		msg := s.Holding[c.hash]
+		mempoolLogger.WithFields(msg.LogFields()).Debug("Evicted")
+		s.RecordRejection(msg, RejectMempool, "evicted from holding")
+		s.DeleteFromHolding(c.hash)
+		m.Evicted++
+		TotalHoldingQueueOutputs.Inc()
+		HoldingQueueEvictions.Inc()
+	}
+	for _, c := range acked {
+		heap.Push(&m.evictable, c)
+	}
+}
+
+// mempoolState says what a held message is waiting on.
+func (s *State) mempoolState(hash [32]byte, msg interfaces.IMsg) (string, string) {
+	if s.Acks[hash] != nil {
+		return "acked", "waiting to be added to the process list"
+	}
+	switch msg.Type() {
+	case constants.REVEAL_ENTRY_MSG:
+		if s.Commits.Get(hash) == nil {
+			return "waiting on commit", "no commit has been seen for this entry"
+		}
+		return "holding", "waiting on an ack from the leader"
+	case constants.COMMIT_CHAIN_MSG, constants.COMMIT_ENTRY_MSG, constants.FACTOID_TRANSACTION_MSG:
+		return "holding", "waiting on an ack from the leader"
+	}
+	return "holding", "waiting for the process list to reach its height"
+}
+
+// mempoolInfo is only called from the state loop, where Holding is in scope.
+func (s *State) mempoolInfo() *interfaces.MempoolInfo {
+	m := s.getMempool()
+
+	info := new(interfaces.MempoolInfo)
+	info.MaxCount = m.MaxCount
+	info.MaxBytes = m.MaxBytes
+	info.MaxPerECAddress = m.MaxPerECAddress
+	info.MaxPerPeer = m.MaxPerPeer
+	info.Evicted = m.Evicted
+	info.Refused = m.Refused
+	now := time.Now()
+	for k, v := range s.Holding {
+		mm := new(interfaces.MempoolMessage)
+		mm.Hash = fmt.Sprintf("%x", k[:])
+		mm.Type = messages.MessageName(v.Type())
+		mm.State, mm.Reason = s.mempoolState(k, v)
+		if e := m.Entries[k]; e != nil {
+			mm.Age = now.Sub(e.Added).Seconds()
+			mm.Size = e.Size
+			mm.ECAddress = e.ECAddress
+			mm.Peer = e.Peer
+		}
+		info.Count++
+		info.Bytes += mm.Size
+		info.Messages = append(info.Messages, mm)
+	}
+	sort.Slice(info.Messages, func(i, j int) bool { return info.Messages[i].Age > info.Messages[j].Age })
+	return info
+}
+
+// answerMempoolInfo is executed in the state maintenance processes, and only
+// builds the mempool info when an API has asked for it.
+func (s *State) answerMempoolInfo() {
+	for {
+		select {
+		case reply := <-s.mempoolInfoRequests:
+			reply <- s.mempoolInfo()
+		default:
+			return
+		}
+	}
+}
+
+// GetMempoolInfo is called from the APIs, which do not have access to Holding,
+// so it asks the state loop for the info.  An empty MempoolInfo is returned if
+// the state loop doesn't answer in time.
+func (s *State) GetMempoolInfo() *interfaces.MempoolInfo {
+	reply := make(chan *interfaces.MempoolInfo, 1)
+	select {
+	case s.mempoolInfoRequests <- reply:
+	default:
+		return new(interfaces.MempoolInfo)
+	}
+	select {
+	case info := <-reply:
+		return info
+	case <-time.After(MempoolInfoTimeout):
+		return new(interfaces.MempoolInfo)
+	}
+}
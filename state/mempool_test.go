@@ -0,0 +1,62 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func newHeldCommit(ecKey byte) *messages.CommitEntryMsg {
+	ce := entryCreditBlock.NewCommitEntry()
+	ce.EntryHash = primitives.RandomHash()
+	ce.ECPubKey[0] = ecKey
+	m := new(messages.CommitEntryMsg)
+	m.CommitEntry = ce
+	return m
+}
+
+func TestMempoolLimits(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+	s.Holding = map[[32]byte]interfaces.IMsg{}
+	s.SetMempoolLimits(0, 0, 2, 0)
+
+	for i := 0; i < 2; i++ {
+		if !s.AddToHolding(newHeldCommit(1)) {
+			t.Fatalf("Commit %d refused under quota", i)
+		}
+	}
+	if s.AddToHolding(newHeldCommit(1)) {
+		t.Errorf("Commit accepted over the EC address quota")
+	}
+	if !s.AddToHolding(newHeldCommit(2)) {
+		t.Errorf("Commit from another EC address refused")
+	}
+	if len(s.Holding) != 3 {
+		t.Errorf("Expected 3 held messages, found %d", len(s.Holding))
+	}
+
+	// Leaving Holding frees the quota
+	for k, v := range s.Holding {
+		if v.(*messages.CommitEntryMsg).CommitEntry.ECPubKey[0] == 1 {
+			s.DeleteFromHolding(k)
+			break
+		}
+	}
+	if !s.AddToHolding(newHeldCommit(1)) {
+		t.Errorf("Commit refused after one left Holding")
+	}
+
+	s.SetMempoolLimits(2, 0, 0, 0)
+	s.AddToHolding(newHeldCommit(3))
+	if len(s.Holding) != 2 {
+		t.Errorf("Expected eviction down to 2 held messages, found %d", len(s.Holding))
+	}
+}
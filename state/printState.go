@@ -57,6 +57,8 @@ func PrintState(state *State) {
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "Network", state.Network)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "MainNetworkPort", state.MainNetworkPort)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "PeersFile", state.PeersFile)
+	str = fmt.Sprintf("%s %35s = %+v\n", str, "ManagedPeersFile", state.ManagedPeersFile)
+	str = fmt.Sprintf("%s %35s = %+v\n", str, "PeerReputationFile", state.PeerReputationFile)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "MainSeedURL", state.MainSeedURL)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "MainSpecialPeers", state.MainSpecialPeers)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "TestNetworkPort", state.TestNetworkPort)
@@ -103,6 +105,7 @@ func PrintState(state *State) {
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "serverPubKey", state.serverPubKey)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "serverPendingPrivKeys", state.serverPendingPrivKeys)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "serverPendingPubKeys", state.serverPendingPubKeys)
+	str = fmt.Sprintf("%s %35s = %+v\n", str, "keyRotation", state.keyRotation)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "RpcUser", state.RpcUser)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "RpcPass", state.RpcPass)
 	str = fmt.Sprintf("%s %35s = %+v\n", str, "RpcAuthHash", state.RpcAuthHash)
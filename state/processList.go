@@ -831,7 +831,7 @@ func (p *ProcessList) Process(state *State) (progress bool) {
 					p.State.Replay.IsTSValid_(constants.INTERNAL_REPLAY, msg.GetMsgHash().Fixed(), msg.GetTimestamp(), now)
 
 					delete(p.State.Acks, msg.GetMsgHash().Fixed())
-					delete(p.State.Holding, msg.GetMsgHash().Fixed())
+					p.State.DeleteFromHolding(msg.GetMsgHash().Fixed())
 
 				} else {
 					//p.State.AddStatus(fmt.Sprintf("processList.Process(): Could not process entry dbht: %d VM: %d  msg: [[%s]]", p.DBHeight, i, msg.String()))
@@ -850,7 +850,7 @@ func (p *ProcessList) Process(state *State) (progress bool) {
 func (p *ProcessList) AddToSystemList(m interfaces.IMsg) bool {
 	// Make sure we have a list, and punt if we don't.
 	if p == nil {
-		p.State.Holding[m.GetMsgHash().Fixed()] = m
+		p.State.AddToHolding(m)
 		return false
 	}
 
@@ -875,7 +875,7 @@ func (p *ProcessList) AddToSystemList(m interfaces.IMsg) bool {
 		//	p.System.Height,
 		//	int(fullFault.SystemHeight),
 		//	fullFault.String()))
-		p.State.Holding[m.GetMsgHash().Fixed()] = m
+		p.State.AddToHolding(m)
 		return false
 	}
 
@@ -977,7 +977,7 @@ func (p *ProcessList) AddToProcessList(ack *messages.Ack, m interfaces.IMsg) {
 	toss := func(hint string) {
 		TotalHoldingQueueOutputs.Inc()
 		TotalAcksOutputs.Inc()
-		delete(p.State.Holding, ack.GetHash().Fixed())
+		p.State.DeleteFromHolding(ack.GetHash().Fixed())
 		delete(p.State.Acks, ack.GetHash().Fixed())
 	}
 
@@ -1017,7 +1017,7 @@ func (p *ProcessList) AddToProcessList(ack *messages.Ack, m interfaces.IMsg) {
 	TotalHoldingQueueOutputs.Inc()
 	TotalAcksOutputs.Inc()
 	delete(p.State.Acks, m.GetMsgHash().Fixed())
-	delete(p.State.Holding, m.GetMsgHash().Fixed())
+	p.State.DeleteFromHolding(m.GetMsgHash().Fixed())
 
 	// Both the ack and the message hash to the same GetHash()
 	m.SetLocal(false)
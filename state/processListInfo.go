@@ -0,0 +1,113 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"sort"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+// Info builds the structured version of String().  It reads the process list
+// without locks, so it must be called from the state loop.
+func (p *ProcessList) Info() *interfaces.ProcessListInfo {
+	if p == nil {
+		return nil
+	}
+	info := new(interfaces.ProcessListInfo)
+	info.DBHeight = p.DBHeight
+	info.Complete = p.Complete()
+	info.CurrentMinute = p.State.CurrentMinute
+
+	minute := p.State.CurrentMinute
+	if minute > 9 {
+		minute = 9
+	}
+	for i := 0; i < len(p.FedServers) && i < len(p.VMs); i++ {
+		vm := p.VMs[i]
+		v := new(interfaces.ProcessListVM)
+		v.Index = i
+		if fi := p.ServerMap[minute][i]; fi >= 0 && fi < len(p.FedServers) {
+			v.Leader = p.FedServers[fi].GetChainID().String()
+		}
+		v.LeaderMinute = vm.LeaderMinute
+		v.Height = vm.Height
+		v.Synced = vm.Synced
+		v.Faulted = vm.WhenFaulted > 0
+		for j, msg := range vm.List {
+			m := new(interfaces.ProcessListMessage)
+			m.Height = j
+			m.Processed = j < vm.Height
+			if msg != nil {
+				m.Type = messages.MessageName(msg.Type())
+				m.Hash = msg.GetMsgHash().String()
+			}
+			if j < len(vm.ListAck) && vm.ListAck[j] != nil {
+				ack := vm.ListAck[j]
+				m.Acked = true
+				m.Minute = int(ack.Minute)
+				if ack.SerialHash != nil {
+					m.Serial = ack.SerialHash.String()
+				}
+				if ack.Timestamp != nil {
+					m.LeaderTimestamp = ack.Timestamp.GetTimeMilli()
+				}
+			}
+			v.Messages = append(v.Messages, m)
+		}
+		info.VMs = append(info.VMs, v)
+	}
+
+	for _, r := range p.Requests {
+		info.Requests = append(info.Requests, &interfaces.ProcessListRequest{
+			VMIndex:  r.vmIndex,
+			Height:   int(r.vmheight),
+			Wait:     r.wait,
+			Sent:     r.sent,
+			Requests: r.requestCnt,
+		})
+	}
+	sort.Slice(info.Requests, func(i, j int) bool {
+		if info.Requests[i].VMIndex != info.Requests[j].VMIndex {
+			return info.Requests[i].VMIndex < info.Requests[j].VMIndex
+		}
+		return info.Requests[i].Height < info.Requests[j].Height
+	})
+
+	info.DBSigs.Received = len(p.DBSignatures)
+	info.DBSigs.Needed = len(p.FedServers)
+	info.DBSigs.DiffSigTally = p.diffSigTally
+	for _, sig := range p.DBSignatures {
+		info.DBSigs.Signers = append(info.DBSigs.Signers, sig.ChainID.String())
+	}
+	return info
+}
+
+// fillProcessListInfo is executed in the state maintenance processes, like
+// fillHoldingMap, so the API can read a copy of the leader's process list.
+func (s *State) fillProcessListInfo() {
+	if s.ProcessListInfoLast >= time.Now().Unix() {
+		return
+	}
+	info := s.LeaderPL.Info()
+
+	s.ProcessListInfoLast = time.Now().Unix()
+	s.ProcessListInfoMutex.Lock()
+	defer s.ProcessListInfoMutex.Unlock()
+	s.ProcessListInfo = info
+}
+
+// GetProcessListInfo is called from the APIs, which cannot safely read the
+// process list themselves.
+func (s *State) GetProcessListInfo() *interfaces.ProcessListInfo {
+	s.ProcessListInfoMutex.RLock()
+	defer s.ProcessListInfoMutex.RUnlock()
+	if s.ProcessListInfo == nil {
+		return new(interfaces.ProcessListInfo)
+	}
+	return s.ProcessListInfo
+}
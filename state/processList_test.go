@@ -63,3 +63,25 @@ func TestProcessListMisc(t *testing.T) {
 
 	pl.TrimVMList(0, 0)
 }
+
+func TestProcessListInfo(t *testing.T) {
+	state := testHelper.CreateEmptyTestState()
+	pl := NewProcessList(state, nil, 1)
+	pl.VMs[0].List = append(pl.VMs[0].List, nil)
+	pl.AddFedServer(primitives.NewHash([]byte("one")))
+	pl.AddFedServer(primitives.NewHash([]byte("three")))
+
+	info := pl.Info()
+	if info.DBHeight != 1 {
+		t.Errorf("Expected height 1, got %d", info.DBHeight)
+	}
+	if len(info.VMs) != 2 {
+		t.Fatalf("Expected 2 VMs, got %d", len(info.VMs))
+	}
+	if len(info.VMs[0].Messages) != 1 || info.VMs[0].Messages[0].Type != "" || info.VMs[0].Messages[0].Acked {
+		t.Errorf("Expected one missing message in VM 0, got %+v", info.VMs[0].Messages)
+	}
+	if info.DBSigs.Needed != 2 {
+		t.Errorf("Expected 2 DBSigs needed, got %d", info.DBSigs.Needed)
+	}
+}
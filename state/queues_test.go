@@ -136,6 +136,32 @@ func TestQueues(t *testing.T) {
 	}
 }
 
+func TestAPIQueueBatch(t *testing.T) {
+	a, b := NewAPIQueue(1), NewAPIQueue(1)
+
+	// A batch bigger than the queue waits for it to drain, holding only its
+	// own queue
+	batch := []interfaces.IMsg{new(messages.CommitEntryMsg), new(messages.RevealEntryMsg), new(messages.CommitEntryMsg)}
+	go a.EnqueueBatch(batch)
+
+	done := make(chan struct{})
+	go func() {
+		b.Enqueue(new(messages.FactoidTransaction))
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("A batch in one API queue blocked another")
+	}
+
+	for i, m := range batch {
+		if v := a.BlockingDequeue(); v != m {
+			t.Errorf("Batch message %d out of order", i)
+		}
+	}
+}
+
 func tripAllMessages(q interfaces.IQueue) {
 	EnAndDeQueue(q, new(messages.EOM))
 	EnAndDeQueue(q, new(messages.Ack))
@@ -0,0 +1,172 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+// The reasons we give for dropping a submitted message
+const (
+	RejectMalformed           = "malformed"
+	RejectBadSignature        = "bad signature"
+	RejectInsufficientBalance = "insufficient balance"
+	RejectReplay              = "replay filter"
+	RejectTimestamp           = "timestamp window"
+	RejectMempool             = "mempool full"
+	RejectExpired             = "expired"
+)
+
+// MaxRejections is how many rejections we remember before forgetting the oldest.
+var MaxRejections = 10000
+
+// Rejections remembers why recently dropped entries, commits and transactions
+// were dropped, keyed by the hashes the ack APIs are called with.  It is
+// written by the state loop and read by the API, so it has its own lock.
+type Rejections struct {
+	mutex   sync.RWMutex
+	max     int
+	entries map[[32]byte]*interfaces.Rejection
+	order   [][32]byte // oldest first
+}
+
+func NewRejections(max int) *Rejections {
+	r := new(Rejections)
+	r.max = max
+	r.entries = make(map[[32]byte]*interfaces.Rejection)
+	return r
+}
+
+func (r *Rejections) Add(hash [32]byte, reason string, detail string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	rej := &interfaces.Rejection{Reason: reason, Detail: detail, Time: time.Now().Unix()}
+	if _, ok := r.entries[hash]; ok {
+		r.entries[hash] = rej
+		return
+	}
+	r.entries[hash] = rej
+	r.order = append(r.order, hash)
+	for r.max > 0 && len(r.order) > r.max {
+		delete(r.entries, r.order[0])
+		r.order = r.order[1:]
+	}
+}
+
+func (r *Rejections) Get(hash [32]byte) *interfaces.Rejection {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.entries[hash]
+}
+
+func (r *Rejections) Len() int {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return len(r.entries)
+}
+
+// rejectionHashes are the hashes a user might ask about a message with.  A
+// commit is also recorded under its entry hash, so asking about the entry
+// explains a rejected commit.
+func rejectionHashes(msg interfaces.IMsg) (hashes [][32]byte) {
+	hashes = append(hashes, msg.GetRepeatHash().Fixed())
+	switch m := msg.(type) {
+	case *messages.CommitChainMsg:
+		hashes = append(hashes, m.CommitChain.EntryHash.Fixed())
+	case *messages.CommitEntryMsg:
+		hashes = append(hashes, m.CommitEntry.EntryHash.Fixed())
+	}
+	return
+}
+
+// rejectionReason works out why a message could not be processed.  It runs
+// the same checks Validate does, one at a time, so we can say which failed.
+func (s *State) rejectionReason(msg interfaces.IMsg) (string, string) {
+	if _, ok := s.Replay.Valid(constants.TIME_TEST, msg.GetRepeatHash().Fixed(), msg.GetTimestamp(), s.GetTimestamp()); !ok {
+		return RejectTimestamp, "timestamp is " + msg.GetTimestamp().String()
+	}
+
+	switch m := msg.(type) {
+	case *messages.CommitChainMsg:
+		if !m.CommitChain.IsValid() {
+			return RejectBadSignature, ""
+		}
+		if balance := s.GetFactoidState().GetECBalance(*m.CommitChain.ECPubKey); int(balance) < int(m.CommitChain.Credits) {
+			return RejectInsufficientBalance, fmt.Sprintf("balance is %d entry credits, the commit needs %d", balance, m.CommitChain.Credits)
+		}
+	case *messages.CommitEntryMsg:
+		if !m.CommitEntry.IsValid() {
+			return RejectBadSignature, ""
+		}
+		if balance := s.GetFactoidState().GetECBalance(*m.CommitEntry.ECPubKey); int(balance) < int(m.CommitEntry.Credits) {
+			return RejectInsufficientBalance, fmt.Sprintf("balance is %d entry credits, the commit needs %d", balance, m.CommitEntry.Credits)
+		}
+	case *messages.FactoidTransaction:
+		if err := m.Transaction.Validate(1); err != nil {
+			return RejectMalformed, err.Error()
+		}
+		if err := m.Transaction.ValidateSignatures(); err != nil {
+			return RejectBadSignature, err.Error()
+		}
+		if err := s.GetFactoidState().Validate(1, m.Transaction); err == errInsufficientFunds {
+			return RejectInsufficientBalance, err.Error()
+		} else if err != nil {
+			return RejectMalformed, err.Error()
+		}
+	case *messages.RevealEntryMsg:
+		if m.Entry.KSize() > 10 {
+			return RejectMalformed, "entry is over 10240 bytes"
+		}
+		if s.Commits.Get(m.Entry.GetHash().Fixed()) == nil {
+			return RejectExpired, "no commit was seen for this entry"
+		}
+	}
+	return "", ""
+}
+
+// RecordRejection remembers why an entry, commit or transaction was dropped.
+// Other messages are ignored.  If reason is empty, it is worked out from the
+// message.
+func (s *State) RecordRejection(msg interfaces.IMsg, reason string, detail string) {
+	if s.Rejections == nil || !isMempoolMsg(msg) {
+		return
+	}
+	if reason == "" {
+		reason, detail = s.rejectionReason(msg)
+		if reason == "" {
+			reason = RejectExpired
+		}
+	}
+	for _, h := range rejectionHashes(msg) {
+		s.Rejections.Add(h, reason, detail)
+	}
+}
+
+// GetRejection is called from the APIs to explain an unknown or invalid
+// entry, commit or transaction.  It returns nil if we did not drop it.
+func (s *State) GetRejection(hash interfaces.IHash) *interfaces.Rejection {
+	if hash == nil || s.Rejections == nil {
+		return nil
+	}
+	return s.Rejections.Get(hash.Fixed())
+}
+
+// recordReplayRejection records a message the replay filter turned away.  Every
+// message is turned away once it has been processed, so plain duplicates are
+// only recorded when asked for.
+func (s *State) recordReplayRejection(msg interfaces.IMsg, duplicates bool) {
+	if _, ok := s.Replay.Valid(constants.TIME_TEST, msg.GetRepeatHash().Fixed(), msg.GetTimestamp(), s.GetTimestamp()); !ok {
+		s.RecordRejection(msg, RejectTimestamp, "timestamp is "+msg.GetTimestamp().String())
+	} else if duplicates {
+		s.RecordRejection(msg, RejectReplay, "already submitted")
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestRejectionsBounded(t *testing.T) {
+	r := NewRejections(3)
+	var hashes [][32]byte
+	for i := 0; i < 5; i++ {
+		h := primitives.RandomHash().Fixed()
+		hashes = append(hashes, h)
+		r.Add(h, RejectReplay, "")
+	}
+	if r.Len() != 3 {
+		t.Errorf("Expected 3 rejections, found %d", r.Len())
+	}
+	if r.Get(hashes[0]) != nil || r.Get(hashes[1]) != nil {
+		t.Errorf("Oldest rejections were not forgotten")
+	}
+	if rej := r.Get(hashes[4]); rej == nil || rej.Reason != RejectReplay {
+		t.Errorf("Newest rejection is missing")
+	}
+}
+
+func TestRecordRejection(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+	s.Holding = map[[32]byte]interfaces.IMsg{}
+	s.SetMempoolLimits(0, 0, 1, 0)
+
+	s.AddToHolding(newHeldCommit(1))
+	refused := newHeldCommit(1)
+	if s.AddToHolding(refused) {
+		t.Fatalf("Commit accepted over the EC address quota")
+	}
+
+	for _, h := range []interfaces.IHash{refused.CommitEntry.GetSigHash(), refused.CommitEntry.EntryHash} {
+		rej := s.GetRejection(h)
+		if rej == nil {
+			t.Errorf("No rejection recorded for %s", h.String())
+			continue
+		}
+		if rej.Reason != RejectMempool {
+			t.Errorf("Expected reason %q, found %q", RejectMempool, rej.Reason)
+		}
+	}
+
+	// Anything else falls back to what Validate would complain about
+	stale := newHeldCommit(2)
+	s.RecordRejection(stale, "", "")
+	if rej := s.GetRejection(stale.CommitEntry.GetSigHash()); rej == nil || rej.Reason != RejectTimestamp {
+		t.Errorf("Expected a commit from 1970 to be outside the timestamp window, got %v", rej)
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/log"
+	"github.com/FactomProject/factomd/util"
+	"github.com/FactomProject/factomd/wsapi"
+)
+
+// liveSettings are the factomd.conf settings ReloadConfig can change on a
+// running node.  Everything else is only read at startup.
+var liveSettings = map[string]bool{
+	"Log.LogLevel":               true,
+	"Log.ConsoleLogLevel":        true,
+	"App.ControlPanelSetting":    true,
+	"App.FactomdRpcUser":         true,
+	"App.FactomdRpcPass":         true,
+	"App.APICredentialsFile":     true,
+	"APIUser":                    true,
+	"App.APIReadsPerMinute":      true,
+	"App.APIReadBurst":           true,
+	"App.APISubmitsPerMinute":    true,
+	"App.APISubmitBurst":         true,
+	"App.MempoolMaxCount":        true,
+	"App.MempoolMaxBytes":        true,
+	"App.MempoolMaxPerECAddress": true,
+	"App.MempoolMaxPerPeer":      true,
+	"App.MaxOutgoingPeers":       true,
+	"App.MaxIncomingPeers":       true,
+	"App.MainSpecialPeers":       true,
+	"App.TestSpecialPeers":       true,
+	"App.LocalSpecialPeers":      true,
+	"App.ChangeAcksHeight":       true,
+}
+
+// configSections are the parts of factomd.conf compared on a reload.  The
+// wallet sections are only used by factom-walletd and factom-cli.
+var configSections = []string{"App", "APIUser", "Feature", "Peer", "Log"}
+
+// changedSettings lists the settings that differ between two configs, as
+// section.name, or just the section for the APIUser and Feature maps.
+func changedSettings(cur *util.FactomdConfig, next *util.FactomdConfig) []string {
+	var changed []string
+	c, n := reflect.ValueOf(cur).Elem(), reflect.ValueOf(next).Elem()
+	for _, section := range configSections {
+		cs, ns := c.FieldByName(section), n.FieldByName(section)
+		if cs.Kind() != reflect.Struct {
+			if !reflect.DeepEqual(cs.Interface(), ns.Interface()) {
+				changed = append(changed, section)
+			}
+			continue
+		}
+		for i := 0; i < cs.NumField(); i++ {
+			if !reflect.DeepEqual(cs.Field(i).Interface(), ns.Field(i).Interface()) {
+				changed = append(changed, section+"."+cs.Type().Field(i).Name)
+			}
+		}
+	}
+	return changed
+}
+
+// setting is a setting, named as by changedSettings, of a config.
+func setting(cfg *util.FactomdConfig, name string) reflect.Value {
+	v := reflect.ValueOf(cfg).Elem()
+	for _, field := range strings.Split(name, ".") {
+		v = v.FieldByName(field)
+	}
+	return v
+}
+
+// specialPeersSetting is the special peers setting used on a network.
+func specialPeersSetting(network string) string {
+	switch strings.ToUpper(network) {
+	case "MAIN":
+		return "App.MainSpecialPeers"
+	case "TEST":
+		return "App.TestSpecialPeers"
+	default:
+		return "App.LocalSpecialPeers"
+	}
+}
+
+// diffPeers lists the addresses in a list of special peers that are not in
+// another.
+func diffPeers(peers string, others string) []string {
+	have := make(map[string]bool)
+	for _, p := range strings.Fields(others) {
+		have[p] = true
+	}
+	var diff []string
+	for _, p := range strings.Fields(peers) {
+		if !have[p] {
+			diff = append(diff, p)
+		}
+	}
+	return diff
+}
+
+// initLogs opens the API logs and sets the console log level.
+func (s *State) initLogs() {
+	log.SetLevel(s.ConsoleLogLevel)
+	if s.LogPath == "stdout" {
+		wsapi.InitLogs(s.LogPath, s.LogLevel)
+		//s.Logger = log.NewLogFromConfig(s.LogPath, s.LogLevel, "State")
+	} else {
+		er := os.MkdirAll(s.LogPath, 0777)
+		if er != nil {
+			// fmt.Println("Could not create " + s.LogPath + "\n error: " + er.Error())
+		}
+		wsapi.InitLogs(s.LogPath+s.FactomNodeName+".log", s.LogLevel)
+		//s.Logger = log.NewLogFromConfig(s.LogPath, s.LogLevel, "State")
+	}
+}
+
+// SetLogLevels changes the API log level and the console log level until the
+// next restart, or a reload that changes them.
+func (s *State) SetLogLevels(logLevel string, consoleLogLevel string) {
+	s.reloadMutex.Lock()
+	defer s.reloadMutex.Unlock()
+	s.liveConfigMutex.Lock()
+	defer s.liveConfigMutex.Unlock()
+	s.LogLevel = logLevel
+	s.ConsoleLogLevel = consoleLogLevel
+	s.initLogs()
+}
+
+// ReloadConfig rereads the config file and applies the settings that can
+// change on a running node: log levels, API credentials and rate limits,
+// holding limits, peer limits, special peers, the change-acks height and the
+// control panel mode.
+// The API credentials file and TLS certificates are reread even if their
+// settings have not changed.  Other settings that changed are listed as
+// needing a restart, as are special peers that were removed, since they stay
+// connected, and turning the control panel on or off.  If the file can't be
+// read or has bad API credentials, nothing is changed.  Settings given as
+// command line flags keep the flag's value.  It is safe to call while the node
+// runs, the settings it changes are guarded by liveConfigMutex.
+func (s *State) ReloadConfig() (*interfaces.ConfigReload, error) {
+	s.reloadMutex.Lock()
+	defer s.reloadMutex.Unlock()
+
+	cur, ok := s.Cfg.(*util.FactomdConfig)
+	if s.filename == "" || !ok {
+		return nil, fmt.Errorf("No config file was loaded")
+	}
+	next, err := util.ReadConfigFile(s.filename)
+	if err != nil {
+		return nil, err
+	}
+	resolveConfigPaths(next, s.Network)
+	// The command line flags the node was started with still win over the file
+	flagged := s.flagConfig(next)
+
+	fromConfig, err := configAPICredentials(next)
+	if err != nil {
+		return nil, err
+	}
+	creds, err := gatherAPICredentials(flagged.App.FactomdRpcUser, flagged.App.FactomdRpcPass, fromConfig, next.App.APICredentialsFile)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the API credentials: %v", err)
+	}
+	auth, err := NewAPIAuth(creds)
+	if err != nil {
+		return nil, fmt.Errorf("Bad API credentials: %v", err)
+	}
+
+	r := new(interfaces.ConfigReload)
+	peersSetting := specialPeersSetting(s.Network)
+	oldPeers, newPeers := setting(cur, peersSetting).String(), setting(next, peersSetting).String()
+	// The running config is replaced by a copy, so readers never see it half
+	// changed
+	updated := *cur
+	applied := make(map[string]bool)
+	for _, name := range changedSettings(s.flagConfig(cur), flagged) {
+		live := liveSettings[name]
+		if name == "App.ControlPanelSetting" && (controlPanelSetting(cur) == 0 || controlPanelSetting(next) == 0) {
+			live = false // The control panel server is only started, or not, at startup
+		}
+		if !live {
+			r.RestartRequired = append(r.RestartRequired, name)
+			continue
+		}
+		applied[name] = true
+		r.Applied = append(r.Applied, name)
+		setting(&updated, name).Set(setting(next, name))
+		if name == peersSetting && len(diffPeers(oldPeers, newPeers)) > 0 {
+			r.RestartRequired = append(r.RestartRequired, name)
+		}
+	}
+
+	s.liveConfigMutex.Lock()
+	s.Cfg = &updated
+	if applied["Log.LogLevel"] || applied["Log.ConsoleLogLevel"] {
+		s.LogLevel = next.Log.LogLevel
+		s.ConsoleLogLevel = next.Log.ConsoleLogLevel
+		s.initLogs()
+	}
+
+	if applied["App.FactomdRpcUser"] {
+		s.RpcUser = flagged.App.FactomdRpcUser
+	}
+	if applied["App.FactomdRpcPass"] {
+		s.RpcPass = flagged.App.FactomdRpcPass
+	}
+	s.APICredentials = fromConfig
+	s.APICredentialsFile = next.App.APICredentialsFile
+	if s.APIAuth == nil {
+		s.APIAuth = auth
+	} else {
+		s.APIAuth.Set(creds) // Already checked by NewAPIAuth
+	}
+	s.APIReadsPerMinute = next.App.APIReadsPerMinute
+	s.APIReadBurst = next.App.APIReadBurst
+	s.APISubmitsPerMinute = next.App.APISubmitsPerMinute
+	s.APISubmitBurst = next.App.APISubmitBurst
+	s.setMempoolLimits(next.App.MempoolMaxCount, next.App.MempoolMaxBytes, next.App.MempoolMaxPerECAddress, next.App.MempoolMaxPerPeer)
+
+	if applied["App.ControlPanelSetting"] {
+		s.ControlPanelSetting = controlPanelSetting(next)
+	}
+
+	s.MaxOutgoingPeers = next.App.MaxOutgoingPeers
+	s.MaxIncomingPeers = next.App.MaxIncomingPeers
+	s.MainSpecialPeers = next.App.MainSpecialPeers
+	s.TestSpecialPeers = next.App.TestSpecialPeers
+	s.LocalSpecialPeers = next.App.LocalSpecialPeers
+	if applied["App.ChangeAcksHeight"] {
+		// The overrides are replaced, not changed, as clones share them
+		overrides := make(map[string]uint32)
+		for name, height := range s.FeatureOverrides {
+			overrides[name] = height
+		}
+		delete(overrides, FeatureChangeAcks)
+		if height, ok := configFeatureHeights(next)[FeatureChangeAcks]; ok {
+			overrides[FeatureChangeAcks] = height
+		}
+		s.FeatureOverrides = overrides
+	}
+	s.liveConfigMutex.Unlock()
+
+	if (applied["App.MaxOutgoingPeers"] || applied["App.MaxIncomingPeers"]) && s.NetworkControler != nil {
+		s.NetworkControler.SetPeerLimits(s.GetPeerLimits())
+	}
+	if added := diffPeers(newPeers, oldPeers); len(added) > 0 && s.NetworkControler != nil {
+		s.NetworkControler.DialSpecialPeersString(strings.Join(added, " "))
+	}
+
+	if err := s.ReloadTLS(); err != nil {
+		r.Errors = append(r.Errors, fmt.Sprintf("Could not reload the TLS certificates, keeping the old ones: %v", err))
+	}
+	return r, nil
+}
@@ -0,0 +1,215 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/util"
+)
+
+func writeConfig(t *testing.T, filename string, config string) {
+	if err := ioutil.WriteFile(filename, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
+func TestReloadConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "reload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "factomd.conf")
+
+	writeConfig(t, filename, `
+[app]
+HomeDir = "`+dir+`"
+Network = LOCAL
+PortNumber = 8088
+APIReadsPerMinute = 10
+LocalSpecialPeers = "10.0.0.1:8110"
+`)
+	s := new(State)
+	s.LoadConfig(filename, "")
+
+	writeConfig(t, filename, `
+[app]
+HomeDir = "`+dir+`"
+Network = LOCAL
+PortNumber = 9000
+APIReadsPerMinute = 20
+LocalSpecialPeers = "10.0.0.1:8110 10.0.0.2:8110"
+ChangeAcksHeight = 500
+
+[apiuser "partner"]
+Key = partnerkey
+Scopes = read
+`)
+	reload, err := s.ReloadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, setting := range []string{"App.APIReadsPerMinute", "APIUser", "App.LocalSpecialPeers", "App.ChangeAcksHeight"} {
+		if !contains(reload.Applied, setting) {
+			t.Errorf("%s was not applied: %v", setting, reload.Applied)
+		}
+	}
+	if !contains(reload.RestartRequired, "App.PortNumber") || contains(reload.RestartRequired, "App.LocalSpecialPeers") {
+		t.Errorf("Wrong settings needing a restart %v", reload.RestartRequired)
+	}
+	if s.APIReadsPerMinute != 20 || s.LocalSpecialPeers != "10.0.0.1:8110 10.0.0.2:8110" {
+		t.Errorf("Settings not changed")
+	}
+	if height, overridden := s.FeatureActivationHeight(FeatureChangeAcks); height != 500 || !overridden {
+		t.Errorf("The change-acks height was not reloaded")
+	}
+	if s.PortNumber != 8088 {
+		t.Errorf("The port changed on a running node")
+	}
+	if user, _, err := s.AuthenticateAPI("Bearer partnerkey"); err != nil || user != "partner" {
+		t.Errorf("New API user not accepted: %v", err)
+	}
+
+	// Again, the port still needs a restart but nothing else changed
+	reload, err = s.ReloadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reload.Applied) != 0 || len(reload.RestartRequired) != 1 {
+		t.Errorf("Wrong second reload %v", reload)
+	}
+
+	// Removing a special peer needs a restart to drop the connection
+	writeConfig(t, filename, `
+[app]
+HomeDir = "`+dir+`"
+Network = LOCAL
+PortNumber = 9000
+APIReadsPerMinute = 20
+LocalSpecialPeers = "10.0.0.2:8110"
+
+[apiuser "partner"]
+Key = partnerkey
+Scopes = read
+`)
+	reload, err = s.ReloadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !contains(reload.Applied, "App.LocalSpecialPeers") || !contains(reload.RestartRequired, "App.LocalSpecialPeers") {
+		t.Errorf("Wrong result for a removed special peer %v", reload)
+	}
+
+	// Bad credentials leave everything as it was
+	writeConfig(t, filename, `
+[app]
+HomeDir = "`+dir+`"
+Network = LOCAL
+APIReadsPerMinute = 30
+
+[apiuser "partner"]
+Key = partnerkey
+Scopes = everything
+`)
+	if _, err := s.ReloadConfig(); err == nil {
+		t.Errorf("Bad credentials were accepted")
+	}
+	if s.APIReadsPerMinute != 20 {
+		t.Errorf("A failed reload changed the settings")
+	}
+	if user, _, err := s.AuthenticateAPI("Bearer partnerkey"); err != nil || user != "partner" {
+		t.Errorf("A failed reload changed the API users: %v", err)
+	}
+}
+
+func TestReloadConfigKeepsFlags(t *testing.T) {
+	dir, err := ioutil.TempDir("", "reload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "factomd.conf")
+
+	config := `
+[app]
+HomeDir = "` + dir + `"
+Network = LOCAL
+`
+	writeConfig(t, filename, config)
+	s := new(State)
+	s.LoadConfigWithFlags(filename, "", func(cfg *util.FactomdConfig) {
+		cfg.App.FactomdRpcUser = "flaguser"
+		cfg.App.FactomdRpcPass = "flagpass"
+	})
+	if s.GetRpcUser() != "flaguser" {
+		t.Fatalf("The -rpcuser flag was not used")
+	}
+
+	writeConfig(t, filename, config+"APIReadsPerMinute = 10\n")
+	reload, err := s.ReloadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contains(reload.Applied, "App.FactomdRpcUser") || contains(reload.Applied, "App.FactomdRpcPass") {
+		t.Errorf("The flag credentials were changed by the file: %v", reload.Applied)
+	}
+	if s.GetRpcUser() != "flaguser" || s.GetRpcPass() != "flagpass" {
+		t.Errorf("A reload dropped the flag credentials")
+	}
+	if _, _, err := s.AuthenticateAPI(""); err == nil {
+		t.Errorf("A reload opened the API to requests without credentials")
+	}
+}
+
+func TestReloadConfigWhileRunning(t *testing.T) {
+	dir, err := ioutil.TempDir("", "reload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "factomd.conf")
+
+	writeConfig(t, filename, `
+[app]
+HomeDir = "`+dir+`"
+Network = LOCAL
+APIReadsPerMinute = 10
+MempoolMaxCount = 100
+`)
+	s := new(State)
+	s.LoadConfig(filename, "")
+
+	// Run with -race, the API and p2p goroutines read while the config is reloaded
+	done := make(chan bool)
+	go func() {
+		for i := 0; i < 100; i++ {
+			s.GetAPIRateLimits()
+			s.GetPeerLimits()
+			s.GetCfg()
+			s.GetRpcUser()
+		}
+		close(done)
+	}()
+	for i := 0; i < 10; i++ {
+		if _, err := s.ReloadConfig(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	<-done
+}
@@ -0,0 +1,94 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"bytes"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/signer"
+)
+
+// initServerSigner sets up where acks, EOMs and DBSigs get signed.  A remote
+// signer keeps the private key out of this process, so serverPrivKey is left
+// nil and only the public key is known.  It is called again when the identity
+// changes; the signer is only replaced if the key is different, and the
+// signing history is only loaded once, so every signer shares one Guard.
+func (s *State) initServerSigner() {
+	// A Map database starts again from genesis on every run, so there is no
+	// history worth keeping across restarts.
+	if s.signGuard == nil {
+		s.signGuard = signer.NewGuard()
+		if len(s.SigningHistoryFile) > 0 && s.DBType != "Map" {
+			guard, err := signer.LoadGuard(s.SigningHistoryFile)
+			if err != nil {
+				panic("Cannot load the signing history: " + err.Error())
+			}
+			s.signGuard = guard
+		}
+	}
+
+	var base interfaces.IServerSigner
+	switch s.ServerSigner {
+	case "file":
+		local, err := signer.NewLocalSignerFromFile(s.ServerSignerKeyFile)
+		if err != nil {
+			panic("Cannot load Server Private Key: " + err.Error())
+		}
+		s.serverPrivKey = local.Key
+		s.serverPubKey = local.Key.Pub
+		base = local
+	case "remote":
+		// The remote signer holds the key, so one connection serves every
+		// identity change
+		if s.remoteSigner == nil {
+			network := s.ServerSignerNetwork
+			if network == "" {
+				network = "unix"
+			}
+			remote, err := signer.NewRemoteSigner(network, s.ServerSignerSocket)
+			if err != nil {
+				panic("Cannot connect to the remote signer: " + err.Error())
+			}
+			s.remoteSigner = remote
+		}
+		pubKey := new(primitives.PublicKey)
+		err := pubKey.UnmarshalBinary(s.remoteSigner.GetPublicKey())
+		if err != nil {
+			panic("Bad public key from the remote signer: " + err.Error())
+		}
+		s.serverPrivKey = nil
+		s.serverPubKey = pubKey
+		base = s.remoteSigner
+	default:
+		base = signer.NewLocalSigner(s.serverPrivKey)
+	}
+
+	if s.serverSigner != nil && bytes.Equal(s.serverSigner.GetPublicKey(), base.GetPublicKey()) {
+		return
+	}
+	if s.remoteSigner != nil && base != interfaces.IServerSigner(s.remoteSigner) {
+		s.remoteSigner.Close()
+		s.remoteSigner = nil
+	}
+	s.serverSigner = s.guardSigner(base)
+}
+
+// guardSigner checks every position signed by the given signer against our
+// signing history before the key is used.
+func (s *State) guardSigner(base interfaces.IServerSigner) interfaces.IServerSigner {
+	if s.signGuard == nil {
+		return base
+	}
+	return signer.NewGuardedSigner(base, s.signGuard)
+}
+
+// signerAt returns a Signer for one message at the given process list
+// position, using the key that is active at that height.
+func (s *State) signerAt(kind string, dbheight uint32, minute int, height uint32) *signer.PositionSigner {
+	pos := interfaces.SignPosition{Kind: kind, DBHeight: dbheight, Minute: minute, Height: height}
+	return signer.AtPosition(s.serverSignerAt(dbheight), pos)
+}
@@ -9,11 +9,13 @@ package state
 // identitiy to properly test identities/authorities
 import (
 	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/signer"
 )
 
 func (s *State) SimSetNewKeys(p *primitives.PrivateKey) {
 	s.serverPrivKey = p
 	s.serverPubKey = p.Pub
+	s.serverSigner = s.guardSigner(signer.NewLocalSigner(p))
 }
 
 func (s *State) SimGetSigKey() string {
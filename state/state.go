@@ -10,7 +10,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"sync"
@@ -29,8 +31,8 @@ import (
 	"github.com/FactomProject/factomd/database/leveldb"
 	"github.com/FactomProject/factomd/database/mapdb"
 	"github.com/FactomProject/factomd/p2p"
+	"github.com/FactomProject/factomd/signer"
 	"github.com/FactomProject/factomd/util"
-	"github.com/FactomProject/factomd/wsapi"
 	"github.com/FactomProject/logrustash"
 
 	"errors"
@@ -48,6 +50,7 @@ type State struct {
 	Logger           *log.Entry
 	IsRunning        bool
 	filename         string
+	configFlags      func(cfg *util.FactomdConfig) // The command line flags applied over the config, kept for reloads
 	NetworkControler *p2p.Controller
 	Salt             interfaces.IHash
 	Cfg              interfaces.IFactomConfig
@@ -72,6 +75,7 @@ type State struct {
 	DBStatesReceivedBase    int
 	DBStatesReceived        []*messages.DBStateMsg
 	LocalServerPrivKey      string
+	LocalServerPendingKey   string
 	DirectoryBlockInSeconds int
 	PortNumber              int
 	Replay                  *Replay
@@ -88,6 +92,8 @@ type State struct {
 	Network                 string
 	MainNetworkPort         string
 	PeersFile               string
+	ManagedPeersFile        string
+	PeerReputationFile      string
 	MainSeedURL             string
 	MainSpecialPeers        string
 	TestNetworkPort         string
@@ -99,6 +105,12 @@ type State struct {
 	CustomNetworkID         []byte
 	CustomBootstrapIdentity string
 	CustomBootstrapKey      string
+	CustomGenesisFile       string
+	CustomGenesis           *util.GenesisConfig // Genesis of a CUSTOM network, nil for the built in one
+	MaxOutgoingPeers        int                 // 0 is the p2p default
+	MaxIncomingPeers        int                 // 0 is the p2p default
+	reloadMutex             sync.Mutex          // One configuration reload at a time
+	liveConfigMutex         sync.RWMutex        // Guards the settings a reload can change, see liveSettings
 
 	IdentityChainID      interfaces.IHash // If this node has an identity, this is it
 	Identities           []*Identity      // Identities of all servers in management chain
@@ -123,6 +135,40 @@ type State struct {
 	HoldingLast  int64
 	HoldingMap   map[[32]byte]interfaces.IMsg
 
+	// Limits on Holding, and requests for its contents from the API
+	MempoolMaxCount        int
+	MempoolMaxBytes        int
+	MempoolMaxPerECAddress int
+	MempoolMaxPerPeer      int
+	mempool                *Mempool
+	mempoolLimitsChanged   uint32                            // Set by SetMempoolLimits, the state loop then copies the limits to the mempool
+	mempoolInfoRequests    chan chan *interfaces.MempoolInfo // GetMempoolInfo asks the state loop through this
+	identityRequests       chan func()                       // Identity lookups for the APIs, run in the state loop
+
+	// A structured copy of the leader's process list for the API
+	ProcessListInfoMutex sync.RWMutex
+	ProcessListInfoLast  int64
+	ProcessListInfo      *interfaces.ProcessListInfo
+
+	// A copy of our keys and any rotation under way for the API
+	KeyRotationStatusMutex sync.RWMutex
+	KeyRotationStatus      *interfaces.KeyRotationStatus
+
+	// Why recently dropped entries, commits and transactions were dropped
+	Rejections *Rejections
+
+	// The change-acks height our identity was last reloaded at
+	idChanged      bool
+	idChangeHeight uint32
+
+	// Feature activation heights from factomd.conf, LOCAL and CUSTOM networks
+	// only apart from local features.  Replaced, never changed, under
+	// liveConfigMutex.
+	FeatureOverrides map[string]uint32
+
+	// Recent faults, for the API
+	FaultTraces *FaultTraces
+
 	//  pending entry/transaction api calls for the ack queue do not have proper scope
 	//  This is used to create a temporary, correctly scoped ackqueue snapshot for the calls on demand
 	AcksMutex sync.RWMutex
@@ -149,7 +195,7 @@ type State struct {
 	networkOutMsgQueue     NetOutMsgQueue
 	networkInvalidMsgQueue chan interfaces.IMsg
 	inMsgQueue             InMsgMSGQueue
-	apiQueue               APIMSGQueue
+	apiQueue               *APIMSGQueue
 	ackQueue               chan interfaces.IMsg
 	msgQueue               chan interfaces.IMsg
 
@@ -161,16 +207,45 @@ type State struct {
 	serverPubKey          *primitives.PublicKey
 	serverPendingPrivKeys []*primitives.PrivateKey
 	serverPendingPubKeys  []*primitives.PublicKey
+	keyRotation           *KeyRotation // Old and new keys held while a key change activates
+	serverSigner          interfaces.IServerSigner
+	remoteSigner          *signer.RemoteSigner // The connection behind serverSigner, if it is remote
+
+	// Server signer config.  ServerSigner is local, file or remote
+	ServerSigner        string
+	ServerSignerKeyFile string
+	ServerSignerNetwork string // unix or tcp
+	ServerSignerSocket  string
+
+	// Everything this server has signed, by position, so it never signs two
+	// conflicting messages for the same position.
+	SigningHistoryFile string
+	signGuard          *signer.Guard
+	otherSignatures    uint32 // Count of messages signed outside the process lists, for their positions
 
 	// RPC connection config
 	RpcUser     string
 	RpcPass     string
 	RpcAuthHash []byte
 
-	FactomdTLSEnable   bool
-	factomdTLSKeyFile  string
-	factomdTLSCertFile string
-	FactomdLocations   string
+	// API credentials and scopes, besides RpcUser which is allowed everything
+	APICredentials     []*APICredential
+	APICredentialsFile string
+	APIAuth            *APIAuth
+
+	// API rate limits per client, 0 is unlimited
+	APIReadsPerMinute   int
+	APIReadBurst        int
+	APISubmitsPerMinute int
+	APISubmitBurst      int
+
+	FactomdTLSEnable       bool
+	factomdTLSKeyFile      string
+	factomdTLSCertFile     string
+	factomdTLSClientCAFile string // Client certificates must be signed by these CAs, if set
+	tlsMutex               sync.Mutex
+	tlsReloader            *util.TLSReloader
+	FactomdLocations       string
 
 	// Server State
 	StartDelay      int64 // Time in Milliseconds since the last DBState was applied
@@ -336,7 +411,11 @@ type State struct {
 	FERPriority          uint32
 	FERPrioritySetHeight uint32
 
-	AckChange uint32
+	// Entry credit pricing policy, from factomd.conf
+	ECPricePolicyName        string
+	ECPriceFixed             uint64
+	ECPriceGovernanceChainId string
+	ECPricePolicy            ECPricePolicy
 
 	StateSaverStruct StateSaverStruct
 
@@ -410,6 +489,8 @@ func (s *State) Clone(cloneNumber int) interfaces.IState {
 	newState.Network = s.Network
 	newState.MainNetworkPort = s.MainNetworkPort
 	newState.PeersFile = s.PeersFile
+	newState.ManagedPeersFile = s.ManagedPeersFile
+	newState.PeerReputationFile = s.PeerReputationFile
 	newState.MainSeedURL = s.MainSeedURL
 	newState.MainSpecialPeers = s.MainSpecialPeers
 	newState.TestNetworkPort = s.TestNetworkPort
@@ -420,6 +501,11 @@ func (s *State) Clone(cloneNumber int) interfaces.IState {
 	newState.LocalSpecialPeers = s.LocalSpecialPeers
 	newState.StartDelayLimit = s.StartDelayLimit
 	newState.CustomNetworkID = s.CustomNetworkID
+	newState.CustomBootstrapIdentity = s.CustomBootstrapIdentity
+	newState.CustomBootstrapKey = s.CustomBootstrapKey
+	newState.CustomGenesis = s.CustomGenesis
+	newState.MaxOutgoingPeers = s.MaxOutgoingPeers
+	newState.MaxIncomingPeers = s.MaxIncomingPeers
 
 	newState.DirectoryBlockInSeconds = s.DirectoryBlockInSeconds
 	newState.PortNumber = s.PortNumber
@@ -427,6 +513,15 @@ func (s *State) Clone(cloneNumber int) interfaces.IState {
 	newState.ControlPanelPort = s.ControlPanelPort
 	newState.ControlPanelSetting = s.ControlPanelSetting
 
+	newState.MempoolMaxCount = s.MempoolMaxCount
+	newState.MempoolMaxBytes = s.MempoolMaxBytes
+	newState.MempoolMaxPerECAddress = s.MempoolMaxPerECAddress
+	newState.MempoolMaxPerPeer = s.MempoolMaxPerPeer
+	newState.FeatureOverrides = s.FeatureOverrides
+	newState.ECPricePolicyName = s.ECPricePolicyName
+	newState.ECPriceFixed = s.ECPriceFixed
+	newState.ECPriceGovernanceChainId = s.ECPriceGovernanceChainId
+
 	newState.Identities = s.Identities
 	newState.Authorities = s.Authorities
 	newState.AuthorityServerCount = s.AuthorityServerCount
@@ -458,10 +553,17 @@ func (s *State) Clone(cloneNumber int) interfaces.IState {
 	newState.RpcUser = s.RpcUser
 	newState.RpcPass = s.RpcPass
 	newState.RpcAuthHash = s.RpcAuthHash
+	newState.APICredentials = s.APICredentials
+	newState.APICredentialsFile = s.APICredentialsFile
+	newState.APIReadsPerMinute = s.APIReadsPerMinute
+	newState.APIReadBurst = s.APIReadBurst
+	newState.APISubmitsPerMinute = s.APISubmitsPerMinute
+	newState.APISubmitBurst = s.APISubmitBurst
 
 	newState.FactomdTLSEnable = s.FactomdTLSEnable
 	newState.factomdTLSKeyFile = s.factomdTLSKeyFile
 	newState.factomdTLSCertFile = s.factomdTLSCertFile
+	newState.factomdTLSClientCAFile = s.factomdTLSClientCAFile
 	newState.FactomdLocations = s.FactomdLocations
 
 	switch newState.DBType {
@@ -539,10 +641,14 @@ func (s *State) SetNetStateOff(net bool) {
 }
 
 func (s *State) GetRpcUser() string {
+	s.liveConfigMutex.RLock()
+	defer s.liveConfigMutex.RUnlock()
 	return s.RpcUser
 }
 
 func (s *State) GetRpcPass() string {
+	s.liveConfigMutex.RLock()
+	defer s.liveConfigMutex.RUnlock()
 	return s.RpcPass
 }
 
@@ -554,6 +660,27 @@ func (s *State) GetRpcAuthHash() []byte {
 	return s.RpcAuthHash
 }
 
+func (s *State) GetAPIRateLimits() (readsPerMinute int, readBurst int, submitsPerMinute int, submitBurst int) {
+	s.liveConfigMutex.RLock()
+	defer s.liveConfigMutex.RUnlock()
+	return s.APIReadsPerMinute, s.APIReadBurst, s.APISubmitsPerMinute, s.APISubmitBurst
+}
+
+// GetPeerLimits is how many peers to dial and how many incoming connections
+// to accept, with the p2p defaults for any not configured.
+func (s *State) GetPeerLimits() (outgoing int, incoming int) {
+	s.liveConfigMutex.RLock()
+	outgoing, incoming = s.MaxOutgoingPeers, s.MaxIncomingPeers
+	s.liveConfigMutex.RUnlock()
+	if outgoing <= 0 {
+		outgoing = p2p.DefaultNumberPeersToConnect
+	}
+	if incoming <= 0 {
+		incoming = p2p.DefaultMaxNumberIncommingConnections
+	}
+	return outgoing, incoming
+}
+
 func (s *State) GetTlsInfo() (bool, string, string) {
 	return s.FactomdTLSEnable, s.factomdTLSKeyFile, s.factomdTLSCertFile
 }
@@ -594,17 +721,78 @@ func (s *State) IncECommits() {
 	s.ECommits++
 }
 
-func (s *State) GetAckChange() error {
-	change, err := util.GetChangeAcksHeight(s.filename)
-	if err != nil {
-		return err
+// resolveConfigPaths makes the file paths in a config relative to the home
+// directory, with the network name in front of the ones kept per network.
+func resolveConfigPaths(cfg *util.FactomdConfig, network string) {
+	networkName := strings.ToLower(network) + "-"
+	// TODO: improve the paths after milestone 1
+	cfg.App.LdbPath = cfg.App.HomeDir + networkName + cfg.App.LdbPath
+	cfg.App.BoltDBPath = cfg.App.HomeDir + networkName + cfg.App.BoltDBPath
+	cfg.App.DataStorePath = cfg.App.HomeDir + networkName + cfg.App.DataStorePath
+	cfg.Log.LogPath = cfg.App.HomeDir + networkName + cfg.Log.LogPath
+	cfg.App.ExportDataSubpath = cfg.App.HomeDir + networkName + cfg.App.ExportDataSubpath
+	cfg.App.PeersFile = cfg.App.HomeDir + networkName + cfg.App.PeersFile
+	cfg.App.ManagedPeersFile = cfg.App.HomeDir + networkName + cfg.App.ManagedPeersFile
+	cfg.App.PeerReputationFile = cfg.App.HomeDir + networkName + cfg.App.PeerReputationFile
+	if len(cfg.App.SigningHistoryFile) > 0 {
+		cfg.App.SigningHistoryFile = cfg.App.HomeDir + networkName + cfg.App.SigningHistoryFile
+	}
+	cfg.App.ControlPanelFilesPath = cfg.App.HomeDir + cfg.App.ControlPanelFilesPath
+	if len(cfg.App.APICredentialsFile) > 0 && !filepath.IsAbs(cfg.App.APICredentialsFile) {
+		cfg.App.APICredentialsFile = cfg.App.HomeDir + cfg.App.APICredentialsFile
+	}
+}
+
+// controlPanelSetting is the ControlPanelSetting of a config: 0 disabled,
+// 1 readonly and 2 readwrite.
+func controlPanelSetting(cfg *util.FactomdConfig) int {
+	switch cfg.App.ControlPanelSetting {
+	case "disabled":
+		return 0
+	case "readonly":
+		return 1
+	case "readwrite":
+		return 2
+	default:
+		return 1
 	}
-	s.AckChange = change
-	return nil
+}
+
+// configAPICredentials are the [apiuser] sections of a config.
+func configAPICredentials(cfg *util.FactomdConfig) ([]*APICredential, error) {
+	var creds []*APICredential
+	for user, c := range cfg.APIUser {
+		scopes, err := ParseAPIScopes(c.Scopes)
+		if err != nil {
+			return nil, fmt.Errorf("API user %s: %v", user, err)
+		}
+		creds = append(creds, &APICredential{User: user, Password: c.Password, Key: c.Key, Scopes: scopes})
+	}
+	return creds, nil
+}
+
+// flagConfig is a copy of cfg with the command line flags applied over it, or
+// cfg itself if there are none.
+func (s *State) flagConfig(cfg *util.FactomdConfig) *util.FactomdConfig {
+	if s.configFlags == nil {
+		return cfg
+	}
+	flagged := *cfg
+	s.configFlags(&flagged)
+	return &flagged
 }
 
 func (s *State) LoadConfig(filename string, networkFlag string) {
+	s.LoadConfigWithFlags(filename, networkFlag, nil)
+}
+
+// LoadConfigWithFlags is LoadConfig with the command line flags applied over
+// the config file by flags.  Cfg is left as the file has it, so a reload only
+// compares it with the file, and the flags are kept to apply over the file
+// again on a reload.
+func (s *State) LoadConfigWithFlags(filename string, networkFlag string, flags func(cfg *util.FactomdConfig)) {
 	s.FactomNodeName = s.Prefix + "FNode0" // Default Factom Node Name for Simulation
+	s.configFlags = flags
 
 	if len(filename) > 0 {
 		s.filename = filename
@@ -619,15 +807,9 @@ func (s *State) LoadConfig(filename string, networkFlag string) {
 		}
 		fmt.Printf("\n\nNetwork : %s\n", s.Network)
 
-		networkName := strings.ToLower(s.Network) + "-"
-		// TODO: improve the paths after milestone 1
-		cfg.App.LdbPath = cfg.App.HomeDir + networkName + cfg.App.LdbPath
-		cfg.App.BoltDBPath = cfg.App.HomeDir + networkName + cfg.App.BoltDBPath
-		cfg.App.DataStorePath = cfg.App.HomeDir + networkName + cfg.App.DataStorePath
-		cfg.Log.LogPath = cfg.App.HomeDir + networkName + cfg.Log.LogPath
-		cfg.App.ExportDataSubpath = cfg.App.HomeDir + networkName + cfg.App.ExportDataSubpath
-		cfg.App.PeersFile = cfg.App.HomeDir + networkName + cfg.App.PeersFile
-		cfg.App.ControlPanelFilesPath = cfg.App.HomeDir + cfg.App.ControlPanelFilesPath
+		resolveConfigPaths(cfg, s.Network)
+		file := cfg
+		cfg = s.flagConfig(cfg)
 
 		s.LogPath = cfg.Log.LogPath + s.Prefix
 		s.LdbPath = cfg.App.LdbPath + s.Prefix
@@ -640,6 +822,8 @@ func (s *State) LoadConfig(filename string, networkFlag string) {
 		s.ExportDataSubpath = cfg.App.ExportDataSubpath
 		s.MainNetworkPort = cfg.App.MainNetworkPort
 		s.PeersFile = cfg.App.PeersFile
+		s.ManagedPeersFile = cfg.App.ManagedPeersFile
+		s.PeerReputationFile = cfg.App.PeerReputationFile
 		s.MainSeedURL = cfg.App.MainSeedURL
 		s.MainSpecialPeers = cfg.App.MainSpecialPeers
 		s.TestNetworkPort = cfg.App.TestNetworkPort
@@ -650,13 +834,40 @@ func (s *State) LoadConfig(filename string, networkFlag string) {
 		s.LocalNetworkPort = cfg.App.LocalNetworkPort
 		s.LocalSeedURL = cfg.App.LocalSeedURL
 		s.LocalSpecialPeers = cfg.App.LocalSpecialPeers
+		s.MaxOutgoingPeers = cfg.App.MaxOutgoingPeers
+		s.MaxIncomingPeers = cfg.App.MaxIncomingPeers
 		s.LocalServerPrivKey = cfg.App.LocalServerPrivKey
+		s.LocalServerPendingKey = cfg.App.LocalServerPendingPrivKey
+		s.ServerSigner = cfg.App.ServerSigner
+		s.ServerSignerKeyFile = cfg.App.ServerSignerKeyFile
+		s.ServerSignerNetwork = cfg.App.ServerSignerNetwork
+		s.ServerSignerSocket = cfg.App.ServerSignerSocket
+		if len(cfg.App.SigningHistoryFile) > 0 {
+			s.SigningHistoryFile = cfg.App.SigningHistoryFile + s.Prefix
+		}
+		s.MempoolMaxCount = cfg.App.MempoolMaxCount
+		s.MempoolMaxBytes = cfg.App.MempoolMaxBytes
+		s.MempoolMaxPerECAddress = cfg.App.MempoolMaxPerECAddress
+		s.MempoolMaxPerPeer = cfg.App.MempoolMaxPerPeer
+		if err := s.SetFeatureOverrides(configFeatureHeights(cfg)); err != nil {
+			panic(fmt.Sprintf("Bad feature in factomd.conf: %v", err))
+		}
 		s.FactoshisPerEC = cfg.App.ExchangeRate
 		s.DirectoryBlockInSeconds = cfg.App.DirectoryBlockInSeconds
 		s.PortNumber = cfg.App.PortNumber
 		s.ControlPanelPort = cfg.App.ControlPanelPort
 		s.RpcUser = cfg.App.FactomdRpcUser
 		s.RpcPass = cfg.App.FactomdRpcPass
+		creds, err := configAPICredentials(cfg)
+		if err != nil {
+			panic(fmt.Sprintf("Bad factomd.conf: %v", err))
+		}
+		s.APICredentials = creds
+		s.APIReadsPerMinute = cfg.App.APIReadsPerMinute
+		s.APIReadBurst = cfg.App.APIReadBurst
+		s.APISubmitsPerMinute = cfg.App.APISubmitsPerMinute
+		s.APISubmitBurst = cfg.App.APISubmitBurst
+		s.APICredentialsFile = cfg.App.APICredentialsFile
 		s.StateSaverStruct.FastBoot = cfg.App.FastBoot
 		s.StateSaverStruct.FastBootLocation = cfg.App.FastBootLocation
 		s.FastBoot = cfg.App.FastBoot
@@ -665,33 +876,45 @@ func (s *State) LoadConfig(filename string, networkFlag string) {
 		s.FactomdTLSEnable = cfg.App.FactomdTlsEnabled
 		if cfg.App.FactomdTlsPrivateKey == "/full/path/to/factomdAPIpriv.key" {
 			s.factomdTLSKeyFile = fmt.Sprint(cfg.App.HomeDir, "factomdAPIpriv.key")
+		} else {
+			s.factomdTLSKeyFile = cfg.App.FactomdTlsPrivateKey
 		}
 		if cfg.App.FactomdTlsPublicCert == "/full/path/to/factomdAPIpub.cert" {
 			s.factomdTLSCertFile = fmt.Sprint(cfg.App.HomeDir, "factomdAPIpub.cert")
+		} else {
+			s.factomdTLSCertFile = cfg.App.FactomdTlsPublicCert
 		}
+		s.factomdTLSClientCAFile = cfg.App.FactomdTlsClientCA
 		externalIP := strings.Split(cfg.Walletd.FactomdLocation, ":")[0]
 		if externalIP != "localhost" {
 			s.FactomdLocations = externalIP
 		}
 
-		switch cfg.App.ControlPanelSetting {
-		case "disabled":
-			s.ControlPanelSetting = 0
-		case "readonly":
-			s.ControlPanelSetting = 1
-		case "readwrite":
-			s.ControlPanelSetting = 2
-		default:
-			s.ControlPanelSetting = 1
-		}
+		s.ControlPanelSetting = controlPanelSetting(cfg)
 		s.FERChainId = cfg.App.ExchangeRateChainId
 		s.ExchangeRateAuthorityPublicKey = cfg.App.ExchangeRateAuthorityPublicKey
+		s.ECPricePolicyName = cfg.App.ECPricePolicy
+		s.ECPriceFixed = cfg.App.ECPriceFixed
+		s.ECPriceGovernanceChainId = cfg.App.ECPriceGovernanceChainId
 		identity, err := primitives.HexToHash(cfg.App.IdentityChainID)
 		if err != nil {
 			s.IdentityChainID = primitives.Sha([]byte(s.FactomNodeName))
 		} else {
 			s.IdentityChainID = identity
 		}
+
+		if len(cfg.App.CustomGenesisFile) > 0 && s.Network == "CUSTOM" {
+			s.CustomGenesisFile = cfg.App.CustomGenesisFile
+			if !filepath.IsAbs(s.CustomGenesisFile) {
+				s.CustomGenesisFile = cfg.App.HomeDir + s.CustomGenesisFile
+			}
+			s.LoadCustomGenesis(s.CustomGenesisFile)
+			// The genesis block time replaces the file's, and -blktime
+			// still applies over it
+			withGenesis := *file
+			withGenesis.App.DirectoryBlockInSeconds = s.DirectoryBlockInSeconds
+			s.DirectoryBlockInSeconds = s.flagConfig(&withGenesis).App.DirectoryBlockInSeconds
+		}
 	} else {
 		s.LogPath = "database/"
 		s.LdbPath = "database/ldb"
@@ -705,6 +928,8 @@ func (s *State) LoadConfig(filename string, networkFlag string) {
 		s.Network = "TEST"
 		s.MainNetworkPort = "8108"
 		s.PeersFile = "peers.json"
+		s.ManagedPeersFile = "managedpeers.json"
+		s.PeerReputationFile = "peerreputation.json"
 		s.MainSeedURL = "https://raw.githubusercontent.com/FactomProject/factomproject.github.io/master/seed/mainseed.txt"
 		s.MainSpecialPeers = ""
 		s.TestNetworkPort = "8109"
@@ -767,17 +992,7 @@ func (s *State) Init() {
 	s.IgnoreMissing = true
 	s.BootTime = s.GetTimestamp().GetTimeSeconds()
 
-	if s.LogPath == "stdout" {
-		wsapi.InitLogs(s.LogPath, s.LogLevel)
-		//s.Logger = log.NewLogFromConfig(s.LogPath, s.LogLevel, "State")
-	} else {
-		er := os.MkdirAll(s.LogPath, 0777)
-		if er != nil {
-			// fmt.Println("Could not create " + s.LogPath + "\n error: " + er.Error())
-		}
-		wsapi.InitLogs(s.LogPath+s.FactomNodeName+".log", s.LogLevel)
-		//s.Logger = log.NewLogFromConfig(s.LogPath, s.LogLevel, "State")
-	}
+	s.initLogs()
 
 	s.ControlPanelChannel = make(chan DisplayState, 20)
 	s.tickerQueue = make(chan int, 100)                        //ticks from a clock
@@ -809,6 +1024,11 @@ func (s *State) Init() {
 
 	// Set up maps for the followers
 	s.Holding = make(map[[32]byte]interfaces.IMsg)
+	s.mempool = NewMempool(s.MempoolMaxCount, s.MempoolMaxBytes, s.MempoolMaxPerECAddress, s.MempoolMaxPerPeer)
+	s.mempoolInfoRequests = make(chan chan *interfaces.MempoolInfo, 10)
+	s.identityRequests = make(chan func(), 10)
+	s.Rejections = NewRejections(MaxRejections)
+	s.FaultTraces = NewFaultTraces(MaxFaultTraces)
 	s.Acks = make(map[[32]byte]interfaces.IMsg)
 	s.Commits = NewSafeMsgMap() //make(map[[32]byte]interfaces.IMsg)
 
@@ -881,6 +1101,9 @@ func (s *State) Init() {
 	default:
 		panic("Bad value for Network in factomd.conf")
 	}
+	s.checkFeatureOverrides()
+	s.initECPricePolicy()
+	s.initAPIAuth()
 
 	s.Println("\nRunning on the ", s.Network, "Network")
 	s.Println("\nExchange rate chain id set to ", s.FERChainId)
@@ -1735,6 +1958,10 @@ func (s *State) UpdateState() (progress bool) {
 	// check to see ig a holding queue list request has been made
 	s.fillHoldingMap()
 	s.fillAcksMap()
+	s.answerMempoolInfo()
+	s.answerIdentityRequests()
+	s.fillProcessListInfo()
+	s.fillKeyRotationStatus()
 
 entryHashProcessing:
 	for {
@@ -1889,7 +2116,18 @@ func (s *State) initServerKeys() {
 	if err != nil {
 		//panic("Cannot parse Server Private Key from configuration file: " + err.Error())
 	}
-	s.serverPubKey = s.serverPrivKey.Pub
+	if s.serverPrivKey != nil {
+		s.serverPubKey = s.serverPrivKey.Pub
+	}
+	s.initServerSigner()
+
+	if len(s.LocalServerPendingKey) > 0 {
+		pending, err := primitives.NewPrivateKeyFromHex(s.LocalServerPendingKey)
+		if err != nil {
+			panic("Cannot parse Server Pending Private Key from configuration file: " + err.Error())
+		}
+		s.SetPendingSigningKey(pending)
+	}
 }
 
 func (s *State) Log(level string, message string) {
@@ -1944,8 +2182,17 @@ func (s *State) GetTimeOffset() interfaces.Timestamp {
 	return s.TimeOffset
 }
 
+// Sign signs messages outside the process lists, such as heartbeats and
+// faults.  Each gets the next position of its own kind, so a signer never
+// has to sign data it can't place.
 func (s *State) Sign(b []byte) interfaces.IFullSignature {
-	return s.serverPrivKey.Sign(b)
+	pos := interfaces.SignPosition{Kind: signer.SignOther, DBHeight: s.LLeaderHeight, Minute: s.CurrentMinute, Height: atomic.AddUint32(&s.otherSignatures, 1)}
+	sig, err := s.serverSignerAt(s.LLeaderHeight).SignAt(&pos, b)
+	if err != nil {
+		packageLogger.Errorf("Cannot sign: %v", err)
+		return nil
+	}
+	return sig
 }
 
 func (s *State) GetFactoidState() interfaces.IFactoidState {
@@ -2037,6 +2284,8 @@ func (s *State) GetLeaderPL() interfaces.IProcessList {
 // Getting the cfg state for Factom doesn't force a read of the config file unless
 // it hasn't been read yet.
 func (s *State) GetCfg() interfaces.IFactomConfig {
+	s.liveConfigMutex.RLock()
+	defer s.liveConfigMutex.RUnlock()
 	return s.Cfg
 }
 
@@ -2044,7 +2293,10 @@ func (s *State) GetCfg() interfaces.IFactomConfig {
 // state of any cfg object held by other processes... Only what will be returned by
 // future calls to Cfg().(s.Cfg.(*util.FactomdConfig)).String()
 func (s *State) ReadCfg(filename string) interfaces.IFactomConfig {
-	s.Cfg = util.ReadConfig(filename)
+	cfg := util.ReadConfig(filename)
+	s.liveConfigMutex.Lock()
+	defer s.liveConfigMutex.Unlock()
+	s.Cfg = cfg
 	return s.Cfg
 }
 
@@ -2547,9 +2799,21 @@ func (s *State) ProcessInvalidMsgQueue() {
 	}
 }
 
+// SetPendingSigningKey holds a key to rotate to once the admin block records
+// it.  A key we already hold is not added again, as the identity is reloaded
+// from factomd.conf with the same pending key.
 func (s *State) SetPendingSigningKey(p *primitives.PrivateKey) {
+	if s.serverPubKey != nil && s.serverPubKey.IsSameAs(p.Pub) {
+		return
+	}
+	for _, pub := range s.serverPendingPubKeys {
+		if pub.IsSameAs(p.Pub) {
+			return
+		}
+	}
 	s.serverPendingPrivKeys = append(s.serverPendingPrivKeys, p)
 	s.serverPendingPubKeys = append(s.serverPendingPubKeys, p.Pub)
+	s.fillKeyRotationStatus()
 }
 
 func (s *State) AddStatus(status string) {
@@ -17,6 +17,7 @@ import (
 	"github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/common/primitives"
 	"github.com/FactomProject/factomd/database/databaseOverlay"
+	"github.com/FactomProject/factomd/signer"
 	"github.com/FactomProject/factomd/util"
 
 	log "github.com/sirupsen/logrus"
@@ -40,6 +41,7 @@ func (s *State) executeMsg(vm *VM, msg interfaces.IMsg) (ret bool) {
 	_, ok := s.Replay.Valid(constants.INTERNAL_REPLAY, msg.GetRepeatHash().Fixed(), msg.GetTimestamp(), s.GetTimestamp())
 	if !ok {
 		consenLogger.WithFields(msg.LogFields()).Debug("ExecuteMsg (Replay Invalid)")
+		s.recordReplayRejection(msg, msg.IsLocal())
 		return
 	}
 	s.SetString()
@@ -75,11 +77,12 @@ func (s *State) executeMsg(vm *VM, msg interfaces.IMsg) (ret bool) {
 	case 0:
 		TotalHoldingQueueInputs.Inc()
 		TotalHoldingQueueRecycles.Inc()
-		s.Holding[msg.GetMsgHash().Fixed()] = msg
+		s.AddToHolding(msg)
 	default:
 		TotalHoldingQueueInputs.Inc()
 		TotalHoldingQueueRecycles.Inc()
-		s.Holding[msg.GetMsgHash().Fixed()] = msg
+		s.RecordRejection(msg, "", "")
+		s.AddToHolding(msg)
 		if !msg.SentInvalid() {
 			msg.MarkSentInvalid(true)
 			s.networkInvalidMsgQueue <- msg
@@ -293,7 +296,7 @@ func (s *State) ReviewHolding() {
 
 		if int(highest)-int(saved) > 1000 {
 			TotalHoldingQueueOutputs.Inc()
-			delete(s.Holding, k)
+			s.DeleteFromHolding(k)
 		}
 
 		mm, ok := v.(*messages.MissingMsgResponse)
@@ -301,7 +304,7 @@ func (s *State) ReviewHolding() {
 			ff, ok := mm.MsgResponse.(*messages.FullServerFault)
 			if ok && ff.DBHeight < saved {
 				TotalHoldingQueueOutputs.Inc()
-				delete(s.Holding, k)
+				s.DeleteFromHolding(k)
 			}
 			continue
 		}
@@ -309,50 +312,52 @@ func (s *State) ReviewHolding() {
 		sf, ok := v.(*messages.ServerFault)
 		if ok && sf.DBHeight < saved {
 			TotalHoldingQueueOutputs.Inc()
-			delete(s.Holding, k)
+			s.DeleteFromHolding(k)
 			continue
 		}
 
 		ff, ok := v.(*messages.FullServerFault)
 		if ok && ff.DBHeight < saved {
 			TotalHoldingQueueOutputs.Inc()
-			delete(s.Holding, k)
+			s.DeleteFromHolding(k)
 			continue
 		}
 
 		eom, ok := v.(*messages.EOM)
 		if ok && ((eom.DBHeight <= saved && saved > 0) || (eom.DBHeight < highest-3 && highest > 2)) {
 			TotalHoldingQueueOutputs.Inc()
-			delete(s.Holding, k)
+			s.DeleteFromHolding(k)
 			continue
 		}
 
 		dbsmsg, ok := v.(*messages.DBStateMsg)
 		if ok && (dbsmsg.DirectoryBlock.GetHeader().GetDBHeight() < saved-1 && saved > 0) {
 			TotalHoldingQueueOutputs.Inc()
-			delete(s.Holding, k)
+			s.DeleteFromHolding(k)
 			continue
 		}
 
 		dbsigmsg, ok := v.(*messages.DirectoryBlockSignature)
 		if ok && ((dbsigmsg.DBHeight <= saved && saved > 0) || (dbsigmsg.DBHeight < highest-3 && highest > 2)) {
 			TotalHoldingQueueOutputs.Inc()
-			delete(s.Holding, k)
+			s.DeleteFromHolding(k)
 			continue
 		}
 
 		_, ok = s.Replay.Valid(constants.INTERNAL_REPLAY, v.GetRepeatHash().Fixed(), v.GetTimestamp(), s.GetTimestamp())
 		ok2 := s.FReplay.IsHashUnique(constants.BLOCK_REPLAY, v.GetRepeatHash().Fixed())
 		if !ok || !ok2 {
+			s.recordReplayRejection(v, false)
 			TotalHoldingQueueOutputs.Inc()
-			delete(s.Holding, k)
+			s.DeleteFromHolding(k)
 			continue
 		}
 
 		if v.Expire(s) {
+			s.RecordRejection(v, "", "")
 			s.ExpireCnt++
 			TotalHoldingQueueOutputs.Inc()
-			delete(s.Holding, k)
+			s.DeleteFromHolding(k)
 			continue
 		}
 
@@ -365,8 +370,9 @@ func (s *State) ReviewHolding() {
 		}
 
 		if v.Validate(s) < 0 {
+			s.RecordRejection(v, "", "")
 			TotalHoldingQueueOutputs.Inc()
-			delete(s.Holding, k)
+			s.DeleteFromHolding(k)
 			continue
 		}
 		TotalXReviewQueueInputs.Inc()
@@ -445,7 +451,7 @@ func (s *State) AddDBState(isNew bool,
 func (s *State) FollowerExecuteMsg(m interfaces.IMsg) {
 	FollowerExecutions.Inc()
 	TotalHoldingQueueInputs.Inc()
-	s.Holding[m.GetMsgHash().Fixed()] = m
+	s.AddToHolding(m)
 	ack, _ := s.Acks[m.GetMsgHash().Fixed()].(*messages.Ack)
 
 	if ack != nil {
@@ -478,7 +484,7 @@ func (s *State) FollowerExecuteEOM(m interfaces.IMsg) {
 
 	FollowerEOMExecutions.Inc()
 	TotalHoldingQueueInputs.Inc()
-	s.Holding[m.GetMsgHash().Fixed()] = m
+	s.AddToHolding(m)
 
 	ack, _ := s.Acks[m.GetMsgHash().Fixed()].(*messages.Ack)
 	if ack != nil {
@@ -637,10 +643,10 @@ func (s *State) FollowerExecuteDBState(msg interfaces.IMsg) {
 			fct.GetSigHash().Fixed(),
 			fct.GetTimestamp(),
 			dbstatemsg.DirectoryBlock.GetHeader().GetTimestamp())
-		// If not the coinbase TX, and we are past 100,000, and the TX is not valid,then we don't accept this block.
+		// If not the coinbase TX, and the replay check is active, and the TX is not valid,then we don't accept this block.
 		if i > 0 && // Don't test the coinbase TX
-			((dbheight > 0 && dbheight < 2000) || dbheight > 100000) && // Test the first 2000 blks, so we can unit test, then after
-			!valid { // 100K for the running system.  If a TX isn't valid, ignore.
+			((dbheight > 0 && dbheight < 2000) || s.IsFeatureActive(FeatureBlockReplayCheck, dbheight)) && // Test the first 2000 blks, so we can unit test, then after
+			!valid { // activation for the running system.  If a TX isn't valid, ignore.
 			return //Totally ignore the block if it has a double spend.
 		}
 	}
@@ -914,7 +920,7 @@ func (s *State) FollowerExecuteRevealEntry(m interfaces.IMsg) {
 		}
 	}
 
-	s.Holding[m.GetMsgHash().Fixed()] = m
+	s.AddToHolding(m)
 	ack, _ := s.Acks[m.GetMsgHash().Fixed()].(*messages.Ack)
 
 	if ack != nil {
@@ -957,11 +963,14 @@ func (s *State) LeaderExecute(m interfaces.IMsg) {
 	_, ok := s.Replay.Valid(constants.INTERNAL_REPLAY, m.GetRepeatHash().Fixed(), m.GetTimestamp(), s.GetTimestamp())
 	if !ok {
 		TotalHoldingQueueOutputs.Inc()
-		delete(s.Holding, m.GetMsgHash().Fixed())
+		s.DeleteFromHolding(m.GetMsgHash().Fixed())
 		return
 	}
 
-	ack := s.NewAck(m, nil).(*messages.Ack)
+	ack, ok := s.NewAck(m, nil).(*messages.Ack)
+	if !ok {
+		return
+	}
 	m.SetLeaderChainID(ack.GetLeaderChainID())
 	m.SetMinute(ack.Minute)
 
@@ -1019,10 +1028,17 @@ func (s *State) LeaderExecuteEOM(m interfaces.IMsg) {
 	// eom.Minute is zerobased, while LeaderMinute is 1 based.  So
 	// a simple assignment works.
 	eom.Minute = byte(s.CurrentMinute)
-	vm.EomMinuteIssued = s.CurrentMinute + 1
-	eom.Sign(s)
+	err := eom.Sign(s.signerAt(signer.SignEOM, eom.DBHeight, int(eom.Minute), 0))
+	if err != nil {
+		s.AddStatus(fmt.Sprintf("Cannot sign EOM: %v", err))
+		return
+	}
+	vm.EomMinuteIssued = s.CurrentMinute + 1 // Only once signed, so a failed sign is retried
 	eom.MsgHash = nil
-	ack := s.NewAck(m, nil).(*messages.Ack)
+	ack, ok := s.NewAck(m, nil).(*messages.Ack)
+	if !ok {
+		return
+	}
 
 	TotalAcksInputs.Inc()
 	s.Acks[eom.GetMsgHash().Fixed()] = ack
@@ -1030,7 +1046,7 @@ func (s *State) LeaderExecuteEOM(m interfaces.IMsg) {
 	s.FollowerExecuteEOM(m)
 	s.UpdateState()
 	delete(s.Acks, ack.GetHash().Fixed())
-	delete(s.Holding, m.GetMsgHash().Fixed())
+	s.DeleteFromHolding(m.GetMsgHash().Fixed())
 }
 
 func (s *State) LeaderExecuteDBSig(m interfaces.IMsg) {
@@ -1059,11 +1075,14 @@ func (s *State) LeaderExecuteDBSig(m interfaces.IMsg) {
 	if !ok {
 		TotalHoldingQueueOutputs.Inc()
 		HoldingQueueDBSigOutputs.Inc()
-		delete(s.Holding, m.GetMsgHash().Fixed())
+		s.DeleteFromHolding(m.GetMsgHash().Fixed())
 		return
 	}
 
-	ack := s.NewAck(m, s.Balancehash).(*messages.Ack)
+	ack, ok := s.NewAck(m, s.Balancehash).(*messages.Ack)
+	if !ok {
+		return
+	}
 
 	m.SetLeaderChainID(ack.GetLeaderChainID())
 	m.SetMinute(ack.Minute)
@@ -1123,7 +1142,10 @@ func (s *State) LeaderExecuteRevealEntry(m interfaces.IMsg) {
 		return
 	}
 
-	ack := s.NewAck(m, nil).(*messages.Ack)
+	ack, ok := s.NewAck(m, nil).(*messages.Ack)
+	if !ok {
+		return
+	}
 
 	m.SetLeaderChainID(ack.GetLeaderChainID())
 	m.SetMinute(ack.Minute)
@@ -1141,7 +1163,7 @@ func (s *State) LeaderExecuteRevealEntry(m interfaces.IMsg) {
 		s.Replay.IsTSValid_(constants.REVEAL_REPLAY, eh.Fixed(), m.GetTimestamp(), now)
 		TotalCommitsOutputs.Inc()
 		s.Commits.Delete(eh.Fixed()) // delete(s.Commits, eh.Fixed())
-		delete(s.Holding, eh.Fixed())
+		s.DeleteFromHolding(eh.Fixed())
 	}
 }
 
@@ -1216,7 +1238,7 @@ func (s *State) ProcessCommitChain(dbheight uint32, commitChain interfaces.IMsg)
 			TotalXReviewQueueInputs.Inc()
 			s.XReview = append(s.XReview, entry)
 			TotalHoldingQueueOutputs.Inc()
-			delete(s.Holding, h.Fixed())
+			s.DeleteFromHolding(h.Fixed())
 		}
 		return true
 	}
@@ -1241,7 +1263,7 @@ func (s *State) ProcessCommitEntry(dbheight uint32, commitEntry interfaces.IMsg)
 			TotalXReviewQueueInputs.Inc()
 			s.XReview = append(s.XReview, entry)
 			TotalHoldingQueueOutputs.Inc()
-			delete(s.Holding, h.Fixed())
+			s.DeleteFromHolding(h.Fixed())
 		}
 		return true
 	}
@@ -1354,10 +1376,10 @@ func (s *State) SendDBSig(dbheight uint32, vmIndex int) {
 				dbs.SetVMHash(nil)
 				dbs.SetVMIndex(vmIndex)
 				dbs.SetLocal(true)
-				dbs.Sign(s)
-				err := dbs.Sign(s)
+				err := dbs.Sign(s.signerAt(signer.SignDBSig, dbheight, 0, 0))
 				if err != nil {
-					panic(err)
+					dbslog.Errorf("Cannot sign DBSig: %v", err)
+					return
 				}
 
 				dbslog.WithFields(dbs.LogFields()).WithFields(log.Fields{"lheight": s.GetLeaderHeight(), "node-name": s.GetFactomNodeName()}).Infof("Generate DBSig")
@@ -1524,7 +1546,6 @@ func (s *State) ProcessEOM(dbheight uint32, msg interfaces.IMsg) bool {
 			s.CurrentMinute = 0
 			s.LLeaderHeight++
 
-			s.GetAckChange()
 			s.CheckForIDChange()
 
 			s.LeaderPL = s.ProcessLists.Get(s.LLeaderHeight)
@@ -1550,17 +1571,17 @@ func (s *State) ProcessEOM(dbheight uint32, msg interfaces.IMsg) bool {
 				dbs.SetVMHash(nil)
 				dbs.SetVMIndex(s.LeaderVMIndex)
 				dbs.SetLocal(true)
-				dbs.Sign(s)
-				err := dbs.Sign(s)
-				if err != nil {
-					panic(err)
-				}
-				pldbs.DBSigAlreadySent = true
+				err := dbs.Sign(s.signerAt(signer.SignDBSig, s.LLeaderHeight, 0, 0))
 
 				dbslog := consenLogger.WithFields(log.Fields{"func": "SendDBSig", "lheight": s.GetLeaderHeight(), "node-name": s.GetFactomNodeName()}).WithFields(dbs.LogFields())
-				dbslog.Infof("Generate DBSig")
-
-				dbs.LeaderExecute(s)
+				if err != nil {
+					// Left unsent, so SendDBSig tries to sign it again
+					dbslog.Errorf("Cannot sign DBSig: %v", err)
+				} else {
+					dbslog.Infof("Generate DBSig")
+					dbs.LeaderExecute(s)
+					pldbs.DBSigAlreadySent = true
+				}
 			}
 			s.Saving = true
 		}
@@ -1590,23 +1611,24 @@ func (s *State) ProcessEOM(dbheight uint32, msg interfaces.IMsg) bool {
 	return false
 }
 
+// CheckForIDChange reloads our identity from factomd.conf once, when the
+// change-acks height is reached, to hand the server over to the new identity.
+// A new height set by reloading the config is acted on again.
 func (s *State) CheckForIDChange() {
-	var reloadIdentity bool = false
-	if s.AckChange > 0 {
-		if s.LLeaderHeight >= s.AckChange {
-			reloadIdentity = true
-		}
+	height, _ := s.FeatureActivationHeight(FeatureChangeAcks)
+	if (s.idChanged && s.idChangeHeight == height) || !s.IsFeatureActive(FeatureChangeAcks, s.LLeaderHeight) {
+		return
 	}
-	if reloadIdentity {
-		config := util.ReadConfig(s.filename)
-		var err error
-		s.IdentityChainID, err = primitives.NewShaHashFromStr(config.App.IdentityChainID)
-		if err != nil {
-			panic(err)
-		}
-		s.LocalServerPrivKey = config.App.LocalServerPrivKey
-		s.initServerKeys()
+	s.idChanged, s.idChangeHeight = true, height
+
+	config := util.ReadConfig(s.filename)
+	var err error
+	s.IdentityChainID, err = primitives.NewShaHashFromStr(config.App.IdentityChainID)
+	if err != nil {
+		panic(err)
 	}
+	s.LocalServerPrivKey = config.App.LocalServerPrivKey
+	s.initServerKeys()
 }
 
 // When we process the directory Signature, and we are the leader for said signature, it
@@ -1830,6 +1852,7 @@ func (s *State) ProcessFullServerFault(dbheight uint32, msg interfaces.IMsg) boo
 			// but otherwise do nothing (we do not execute the actual demotion/promotion)
 			//s.AddStatus(fmt.Sprintf("PROCESS Full Fault CLEARING: %s", fullFault.StringWithSigCnt(s)))
 			fullFault.SetAlreadyProcessed()
+			s.FaultTraces.End(fullFault.DBHeight, int(fullFault.VMIndex), FaultCleared)
 			faultLogger.WithField("func", "ClearFault").WithFields(fullFault.LogFields()).Warn("Cleared")
 			return true
 		}
@@ -1909,6 +1932,7 @@ func (s *State) ProcessFullServerFault(dbheight uint32, msg interfaces.IMsg) boo
 				//s.AddStatus(authorityDeltaString)
 
 				pl.State.LastFaultAction = time.Now().Unix()
+				s.FaultTraces.End(fullFault.DBHeight, int(fullFault.VMIndex), FaultPromoted)
 				markNoFault(pl, fullFault.GetVMIndex())
 				nextIndex := (int(fullFault.VMIndex) + 1) % len(pl.FedServers)
 				if pl.VMs[nextIndex].FaultFlag > 0 {
@@ -1958,6 +1982,7 @@ func (s *State) ProcessFullServerFault(dbheight uint32, msg interfaces.IMsg) boo
 
 			if err == nil && (sfSigned > 0 || (sfSigned == 0 && isPledge)) {
 				fullFault.AddFaultVote(issuerID, fullFault.GetSignature())
+				s.FaultTraces.Vote(fullFault.DBHeight, int(fullFault.VMIndex), rawIssuerID, isPledge)
 			}
 
 			if s.Leader || s.IdentityChainID.IsSameAs(fullFault.AuditServerID) {
@@ -2028,7 +2053,7 @@ func (s *State) SendHeartBeat() {
 			hb.SecretNumber = s.GetSalt(hb.Timestamp)
 			hb.DBlockHash = dbstate.DBHash
 			hb.IdentityChainID = s.IdentityChainID
-			hb.Sign(s.GetServerPrivateKey())
+			hb.Sign(s)
 			hb.SendOut(s, hb)
 		}
 	}
@@ -2292,7 +2317,13 @@ func (s *State) NewAck(msg interfaces.IMsg, balanceHash interfaces.IHash) interf
 		ack.SerialHash, _ = primitives.CreateHash(last.MessageHash, ack.MessageHash)
 	}
 
-	ack.Sign(s)
+	// The signer checks the signing history, so a restarted leader can't issue a
+	// second, conflicting ack for a position it has already acked.
+	err := ack.Sign(s.signerAt(signer.SignAck, ack.DBHeight, int(ack.Minute), ack.Height))
+	if err != nil {
+		s.AddStatus(fmt.Sprintf("Cannot sign Ack at %d/%d/%d: %v", ack.DBHeight, ack.VMIndex, ack.Height, err))
+		return nil
+	}
 
 	return ack
 }
@@ -50,6 +50,9 @@ type DisplayState struct {
 	PrintMap     string
 	ProcessList  string
 	ProcessList2 string
+
+	ProcessListInfo *interfaces.ProcessListInfo
+	FaultTraces     []*interfaces.FaultTrace
 }
 
 type FactoidTransaction struct {
@@ -102,7 +105,9 @@ func DeepStateDisplayCopy(s *State) (*DisplayState, error) {
 
 	ds.NodeName = s.GetFactomNodeName()
 	ds.ControlPanelPort = s.ControlPanelPort
+	s.liveConfigMutex.RLock()
 	ds.ControlPanelSetting = s.ControlPanelSetting
+	s.liveConfigMutex.RUnlock()
 
 	// DB Info
 	ds.CurrentNodeHeight = s.GetHighestSavedBlk()
@@ -231,6 +236,7 @@ func DeepStateDisplayCopy(s *State) (*DisplayState, error) {
 	if pl != nil && pl.FedServers != nil {
 		ds.PrintMap = pl.PrintMap()
 		ds.ProcessList = pl.String()
+		ds.ProcessListInfo = pl.Info()
 	} else {
 		ds.PrintMap = ""
 		ds.ProcessList = ""
@@ -239,6 +245,7 @@ func DeepStateDisplayCopy(s *State) (*DisplayState, error) {
 	if pl2 != nil {
 		ds.ProcessList2 = pl2.String()
 	}
+	ds.FaultTraces = s.GetFaultTraces()
 
 	return ds, nil
 }
@@ -273,6 +280,8 @@ func (d *DisplayState) Clone() *DisplayState {
 	ds.RawSummary = d.RawSummary
 	ds.PrintMap = d.PrintMap
 	ds.ProcessList = d.ProcessList
+	ds.ProcessListInfo = d.ProcessListInfo
+	ds.FaultTraces = d.FaultTraces
 
 	return ds
 }
@@ -48,13 +48,6 @@ func (this *State) ProcessRecentFERChainEntries() {
 	this.Println("    FERPrioritySetHeight: ", this.FERPrioritySetHeight)
 	this.Println("    FER current: ", this.GetFactoshisPerEC())
 
-	// Check to see if a price change targets the next block
-	if this.FERChangeHeight == (this.GetDBHeightComplete())+1 {
-		this.FactoshisPerEC = this.FERChangePrice
-		this.FERChangePrice = 0
-		this.FERChangeHeight = 1
-	}
-
 	// Check for the need to clear the priority
 	// (this.GetDBHeightComplete() >= 12) is import because height is a uint and can't break logic if subtracted into false sub-zero
 	if (this.GetDBHeightComplete() >= 12) &&
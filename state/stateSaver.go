@@ -17,9 +17,11 @@ type StateSaverStruct struct {
 	FastBoot         bool
 	FastBootLocation string
 
-	TmpState []byte
-	Mutex    sync.Mutex
-	Stop     bool
+	TmpState      []byte
+	Mutex         sync.Mutex
+	Stop          bool
+	SaveRequested bool // Save at the next block, see RequestSave
+	requestCached bool // TmpState holds the block cached for the requested save
 }
 
 //To be increased whenever the data being saved changes from the last verion
@@ -31,6 +33,15 @@ func (sss *StateSaverStruct) StopSaving() {
 	sss.Stop = true
 }
 
+// RequestSave makes the FastBoot file be written even after booting or between
+// the usual saves every 1000 blocks.  Like those, the state written is cached
+// a block before, so it is the next block but one that writes it.
+func (sss *StateSaverStruct) RequestSave() {
+	sss.Mutex.Lock()
+	defer sss.Mutex.Unlock()
+	sss.SaveRequested = true
+}
+
 func (sss *StateSaverStruct) SaveDBStateList(ss *DBStateList, networkName string) error {
 	//For now, to file. Later - to DB
 	if sss.Stop == true {
@@ -39,6 +50,21 @@ func (sss *StateSaverStruct) SaveDBStateList(ss *DBStateList, networkName string
 	sss.Mutex.Lock()
 	defer sss.Mutex.Unlock()
 
+	if sss.SaveRequested {
+		// Any cached state may be long out of date, so a fresh one is cached
+		// first and written at the next block
+		if sss.requestCached {
+			sss.SaveRequested = false
+			sss.requestCached = false
+			if err := sss.saveTmpState(networkName); err != nil {
+				return err
+			}
+		} else {
+			sss.requestCached = true
+		}
+		return sss.cacheState(ss)
+	}
+
 	//Don't save States after the server has booted - it might start it in a wrong state
 	if ss.State.DBFinished == true {
 		return nil
@@ -50,14 +76,22 @@ func (sss *StateSaverStruct) SaveDBStateList(ss *DBStateList, networkName string
 	}
 
 	//Actually save data from previous cached state to prevent dealing with rollbacks
-	if len(sss.TmpState) > 0 {
-		err := SaveToFile(sss.TmpState, NetworkIDToFilename(networkName, sss.FastBootLocation))
-		if err != nil {
-			return err
-		}
+	if err := sss.saveTmpState(networkName); err != nil {
+		return err
 	}
 
 	//Marshal state for future saving
+	return sss.cacheState(ss)
+}
+
+func (sss *StateSaverStruct) saveTmpState(networkName string) error {
+	if len(sss.TmpState) == 0 {
+		return nil
+	}
+	return SaveToFile(sss.TmpState, NetworkIDToFilename(networkName, sss.FastBootLocation))
+}
+
+func (sss *StateSaverStruct) cacheState(ss *DBStateList) error {
 	b, err := ss.MarshalBinary()
 	if err != nil {
 		return err
@@ -66,7 +100,6 @@ func (sss *StateSaverStruct) SaveDBStateList(ss *DBStateList, networkName string
 	h := primitives.Sha(b)
 	b = append(h.Bytes(), b...)
 	sss.TmpState = b
-
 	return nil
 }
 
@@ -0,0 +1,44 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"crypto/tls"
+	"time"
+
+	"github.com/FactomProject/factomd/util"
+)
+
+// TLSWatchInterval is how often the API certificate files are checked for
+// changes.
+var TLSWatchInterval = 10 * time.Second
+
+// GetTLSConfig is the TLS config shared by the API and control panel.  The
+// certificate files must exist by the first call.  After that they are
+// reloaded when they change, or on ReloadTLS.
+func (s *State) GetTLSConfig() (*tls.Config, error) {
+	s.tlsMutex.Lock()
+	defer s.tlsMutex.Unlock()
+	if s.tlsReloader == nil {
+		r, err := util.NewTLSReloader(s.factomdTLSCertFile, s.factomdTLSKeyFile, s.factomdTLSClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		s.tlsReloader = r
+		go r.Watch(TLSWatchInterval)
+	}
+	return s.tlsReloader.Config(), nil
+}
+
+// ReloadTLS reloads the API certificate files now, as on SIGHUP.
+func (s *State) ReloadTLS() error {
+	s.tlsMutex.Lock()
+	r := s.tlsReloader
+	s.tlsMutex.Unlock()
+	if r == nil {
+		return nil
+	}
+	return r.Reload()
+}
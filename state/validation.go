@@ -93,8 +93,8 @@ func (t *Timer) timer(state *State, min int) {
 	eom := new(messages.EOM)
 	eom.Timestamp = state.GetTimestamp()
 	eom.ChainID = state.GetIdentityChainID()
-	eom.Sign(state)
-	eom.SetLocal(true)
+	eom.SetLocal(true) // Signed at its position when the leader executes it
+
 	consenLogger.WithFields(log.Fields{"func": "GenerateEOM", "lheight": state.GetLeaderHeight()}).WithFields(eom.LogFields()).Debug("Generate EOM")
 
 	state.TimerMsgQueue() <- eom
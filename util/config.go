@@ -34,17 +34,28 @@ type FactomdConfig struct {
 		IdentityChainID                        string
 		LocalServerPrivKey                     string
 		LocalServerPublicKey                   string
+		LocalServerPendingPrivKey              string
+		ServerSigner                           string
+		ServerSignerKeyFile                    string
+		ServerSignerNetwork                    string
+		ServerSignerSocket                     string
+		SigningHistoryFile                     string
 		ExchangeRate                           uint64
 		ExchangeRateChainId                    string
 		ExchangeRateAuthorityPublicKey         string
 		ExchangeRateAuthorityPublicKeyMainNet  string
 		ExchangeRateAuthorityPublicKeyTestNet  string
 		ExchangeRateAuthorityPublicKeyLocalNet string
+		ECPricePolicy                          string
+		ECPriceFixed                           uint64
+		ECPriceGovernanceChainId               string
 
 		// Network Configuration
 		Network                 string
 		MainNetworkPort         string
 		PeersFile               string
+		ManagedPeersFile        string
+		PeerReputationFile      string
 		MainSeedURL             string
 		MainSpecialPeers        string
 		TestNetworkPort         string
@@ -55,13 +66,40 @@ type FactomdConfig struct {
 		LocalSpecialPeers       string
 		CustomBootstrapIdentity string
 		CustomBootstrapKey      string
+		CustomGenesisFile       string
+		MaxOutgoingPeers        int
+		MaxIncomingPeers        int
 		FactomdTlsEnabled       bool
 		FactomdTlsPrivateKey    string
 		FactomdTlsPublicCert    string
+		FactomdTlsClientCA      string
 		FactomdRpcUser          string
 		FactomdRpcPass          string
+		APICredentialsFile      string
+		APIReadsPerMinute       int
+		APIReadBurst            int
+		APISubmitsPerMinute     int
+		APISubmitBurst          int
 
 		ChangeAcksHeight uint32
+
+		// Holding queue limits, 0 is unlimited
+		MempoolMaxCount        int
+		MempoolMaxBytes        int
+		MempoolMaxPerECAddress int
+		MempoolMaxPerPeer      int
+	}
+	// API credentials besides FactomdRpcUser, by user name.  Scopes is a comma
+	// separated list of read, submit and admin.
+	APIUser map[string]*struct {
+		Password string
+		Key      string
+		Scopes   string
+	}
+	// Protocol feature activation heights, by feature name.  Only used on
+	// LOCAL and CUSTOM networks.
+	Feature map[string]*struct {
+		ActivationHeight uint32
 	}
 	Peer struct {
 		AddPeers     []string      `short:"a" long:"addpeer" description:"Add a peer to connect with at startup"`
@@ -99,6 +137,10 @@ type FactomdConfig struct {
 
 // defaultConfig
 const defaultConfig = `
+; Any setting can also be set by an environment variable, which overrides this file.  Command line flags
+; override both.  The variable is FACTOMD_<SECTION>_<SETTING> in capitals, as in FACTOMD_APP_PORTNUMBER, or
+; FACTOMD_<SECTION>_<name>_<SETTING> for a named section, as in FACTOMD_APIUSER_partner_SCOPES.  Add _FILE to
+; read the value from a file instead, for secrets, as in FACTOMD_APP_LOCALSERVERPRIVKEY_FILE=/run/secrets/key.
 ; ------------------------------------------------------------------------------
 ; App settings
 ; ------------------------------------------------------------------------------
@@ -121,6 +163,8 @@ FastBootLocation                      = ""
 ; --------------- Network: MAIN | TEST | LOCAL
 Network                               = MAIN
 PeersFile            = "peers.json"
+ManagedPeersFile     = "managedpeers.json"
+PeerReputationFile   = "peerreputation.json"
 MainNetworkPort      = 8108
 MainSeedURL          = "https://raw.githubusercontent.com/FactomProject/factomproject.github.io/master/seed/mainseed.txt"
 MainSpecialPeers     = ""
@@ -132,15 +176,41 @@ LocalSeedURL         = "https://raw.githubusercontent.com/FactomProject/factompr
 LocalSpecialPeers    = ""
 CustomBootstrapIdentity     = 38bab1455b7bd7e5efd15c53c777c79d0c988e9210f1da49a99d95b3a6417be9
 CustomBootstrapKey          = cc1985cdfae4e32b5a454dfda8ce5e1361558482684f3367649c3ad852c8e31a
+; A genesis file written by the GenesisGenerator utility, relative to the home directory.  When set, a
+; CUSTOM network takes its genesis block, bootstrap identity and key, block time and exchange rate from it.
+CustomGenesisFile           = ""
+; How many peers to dial, and how many incoming connections to accept.  0 keeps the defaults of 32 and 150.
+MaxOutgoingPeers            = 0
+MaxIncomingPeers            = 0
 ; --------------- NodeMode: FULL | SERVER ----------------
 NodeMode                                = FULL
 LocalServerPrivKey                      = 4c38c72fc5cdad68f13b74674d3ffb1f3d63a112710868c9b08946553448d26d
 LocalServerPublicKey                    = cc1985cdfae4e32b5a454dfda8ce5e1361558482684f3367649c3ad852c8e31a
+; The key this server will rotate to.  It is used once an admin block records it for our identity.
+LocalServerPendingPrivKey               = ""
+; --------------- ServerSigner: local | file | remote
+; local signs with LocalServerPrivKey, file reads the key from ServerSignerKeyFile,
+; and remote asks a separate signing process listening on ServerSignerSocket, a unix socket path
+; or a host:port if ServerSignerNetwork is tcp.
+ServerSigner                            = local
+ServerSignerKeyFile                     = ""
+ServerSignerNetwork                     = unix
+ServerSignerSocket                      = ""
+; Positions this server has signed are kept here so a restarted leader never signs a conflicting
+; ack or DBSig.  Do not copy this file between servers, and do not delete it while a server is running.
+SigningHistoryFile                      = "signinghistory.json"
 ExchangeRateChainId                     = 111111118d918a8be684e0dac725493a75862ef96d2d3f43f84b26969329bf03
 ExchangeRateAuthorityPublicKeyMainNet   = daf5815c2de603dbfa3e1e64f88a5cf06083307cf40da4a9b539c41832135b4a
 ExchangeRateAuthorityPublicKeyTestNet   = 1d75de249c2fc0384fb6701b30dc86b39dc72e5a47ba4f79ef250d39e21e7a4f
 ; Private key all zeroes:
 ExchangeRateAuthorityPublicKeyLocalNet  = 3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29
+; --------------- ECPricePolicy: fer | fixed | governance
+; fer follows the exchange rate chain above.  fixed holds the price at ECPriceFixed factoshis per entry credit.
+; governance sets the price when a majority of the federated servers sign the same FER entry in the chain
+; ECPriceGovernanceChainId.  MAIN and TEST always use fer.
+ECPricePolicy                           = fer
+ECPriceFixed                            = 0
+ECPriceGovernanceChainId                = ""
 
 ; These define if the RPC and Control Panel connection to factomd should be encrypted, and if it is, what files
 ; are the secret key and the public certificate.  factom-cli and factom-walletd uses the certificate specified here if TLS is enabled.
@@ -148,15 +218,59 @@ ExchangeRateAuthorityPublicKeyLocalNet  = 3b6a27bcceb6a42d62a3a8d02a6f0d73653215
 FactomdTlsEnabled                     = false
 FactomdTlsPrivateKey                  = "/full/path/to/factomdAPIpriv.key"
 FactomdTlsPublicCert                  = "/full/path/to/factomdAPIpub.cert"
+; If set, clients must present a certificate signed by one of the CAs in this PEM bundle.  The certificate,
+; key and bundle are reloaded when the files change or factomd gets a SIGHUP.
+FactomdTlsClientCA                    = ""
 
 ; These are the username and password that factomd requires for the RPC API and the Control Panel
 ; This file is also used by factom-cli and factom-walletd to determine what login to use
 FactomdRpcUser                        = ""
 FactomdRpcPass                        = ""
-
-; Specifying when to change ACKs for switching leader servers
+; More credentials can be given their own scopes: read for blocks, entries, balances and status, submit for
+; commits, reveals and transactions, and admin for the debug API and control panel actions.  FactomdRpcUser
+; is allowed everything.  Each is an [apiuser "name"] section below, or an entry in APICredentialsFile, a JSON
+; list like [{"user": "partner", "key": "secret", "scopes": ["read"]}].  A user with a Key sends
+; "Authorization: Bearer <key>" instead of a password.  A relative path is in the HomeDir.
+APICredentialsFile                    = ""
+
+; Rate limits on the V1 and V2 API, per client IP, or per API user if credentials are used.  Reads and
+; submits have separate budgets that refill at so many calls a minute, up to the burst.  Expensive calls
+; like receipt and raw-data take more than one call from the budget.  0 means unlimited, and a burst of
+; 0 is the per minute limit.  Admin users are not limited.
+APIReadsPerMinute                     = 0
+APIReadBurst                          = 0
+APISubmitsPerMinute                   = 0
+APISubmitBurst                        = 0
+
+; Specifying when to change ACKs for switching leader servers, the same as the change-acks feature
+; height.  It can be changed on a running node by reloading the config.
 ChangeAcksHeight                      = 0
 
+; Limits on the messages held waiting for an ack or a commit.  Entries and transactions beyond the
+; per address and per peer quotas are refused, and the oldest or invalid ones are evicted once the
+; count or byte limit is reached.  0 means unlimited.
+MempoolMaxCount                       = 100000
+MempoolMaxBytes                       = 134217728
+MempoolMaxPerECAddress                = 5000
+MempoolMaxPerPeer                     = 20000
+
+; ------------------------------------------------------------------------------
+; API credentials besides FactomdRpcUser, one section per user.  Scopes is a comma separated list of
+; read, submit and admin.  Give a user either a Password or a Key.
+; ------------------------------------------------------------------------------
+; [apiuser "partner"]
+; Password                            = ""
+; Key                                 = ""
+; Scopes                              = read
+
+; ------------------------------------------------------------------------------
+; Protocol features activate at block heights fixed for MAIN and TEST.  On LOCAL and CUSTOM networks
+; the heights can be set here, one section per feature, and every node on the network must use the
+; same heights.  The features and their heights are listed by the properties API call.
+; ------------------------------------------------------------------------------
+; [feature "ec-balance-check"]
+; ActivationHeight                    = 0
+
 ; ------------------------------------------------------------------------------
 ; logLevel - allowed values are: debug, info, notice, warning, error, critical, alert, emergency and none
 ; ConsoleLogLevel - allowed values are: debug, standard
@@ -211,6 +325,8 @@ func (s *FactomdConfig) String() string {
 	out.WriteString(fmt.Sprintf("\n    Network                 %v", s.App.Network))
 	out.WriteString(fmt.Sprintf("\n    MainNetworkPort         %v", s.App.MainNetworkPort))
 	out.WriteString(fmt.Sprintf("\n    PeersFile               %v", s.App.PeersFile))
+	out.WriteString(fmt.Sprintf("\n    ManagedPeersFile        %v", s.App.ManagedPeersFile))
+	out.WriteString(fmt.Sprintf("\n    PeerReputationFile      %v", s.App.PeerReputationFile))
 	out.WriteString(fmt.Sprintf("\n    MainSeedURL             %v", s.App.MainSeedURL))
 	out.WriteString(fmt.Sprintf("\n    MainSpecialPeers        %v", s.App.MainSpecialPeers))
 	out.WriteString(fmt.Sprintf("\n    TestNetworkPort         %v", s.App.TestNetworkPort))
@@ -221,19 +337,51 @@ func (s *FactomdConfig) String() string {
 	out.WriteString(fmt.Sprintf("\n    LocalSpecialPeers       %v", s.App.LocalSpecialPeers))
 	out.WriteString(fmt.Sprintf("\n    CustomBootstrapIdentity %v", s.App.CustomBootstrapIdentity))
 	out.WriteString(fmt.Sprintf("\n    CustomBootstrapKey      %v", s.App.CustomBootstrapKey))
+	out.WriteString(fmt.Sprintf("\n    CustomGenesisFile       %v", s.App.CustomGenesisFile))
+	out.WriteString(fmt.Sprintf("\n    MaxOutgoingPeers        %v", s.App.MaxOutgoingPeers))
+	out.WriteString(fmt.Sprintf("\n    MaxIncomingPeers        %v", s.App.MaxIncomingPeers))
 	out.WriteString(fmt.Sprintf("\n    NodeMode                %v", s.App.NodeMode))
 	out.WriteString(fmt.Sprintf("\n    IdentityChainID         %v", s.App.IdentityChainID))
 	out.WriteString(fmt.Sprintf("\n    LocalServerPrivKey      %v", s.App.LocalServerPrivKey))
 	out.WriteString(fmt.Sprintf("\n    LocalServerPublicKey    %v", s.App.LocalServerPublicKey))
+	out.WriteString(fmt.Sprintf("\n    LocalServerPendingPrivKey %v", s.App.LocalServerPendingPrivKey))
+	out.WriteString(fmt.Sprintf("\n    ServerSigner            %v", s.App.ServerSigner))
+	out.WriteString(fmt.Sprintf("\n    ServerSignerKeyFile     %v", s.App.ServerSignerKeyFile))
+	out.WriteString(fmt.Sprintf("\n    ServerSignerNetwork     %v", s.App.ServerSignerNetwork))
+	out.WriteString(fmt.Sprintf("\n    ServerSignerSocket      %v", s.App.ServerSignerSocket))
+	out.WriteString(fmt.Sprintf("\n    SigningHistoryFile      %v", s.App.SigningHistoryFile))
 	out.WriteString(fmt.Sprintf("\n    ExchangeRate            %v", s.App.ExchangeRate))
 	out.WriteString(fmt.Sprintf("\n    ExchangeRateChainId     %v", s.App.ExchangeRateChainId))
 	out.WriteString(fmt.Sprintf("\n    ExchangeRateAuthorityPublicKey   %v", s.App.ExchangeRateAuthorityPublicKey))
+	out.WriteString(fmt.Sprintf("\n    ECPricePolicy           %v", s.App.ECPricePolicy))
+	out.WriteString(fmt.Sprintf("\n    ECPriceFixed            %v", s.App.ECPriceFixed))
+	out.WriteString(fmt.Sprintf("\n    ECPriceGovernanceChainId %v", s.App.ECPriceGovernanceChainId))
 	out.WriteString(fmt.Sprintf("\n    FactomdTlsEnabled        %v", s.App.FactomdTlsEnabled))
 	out.WriteString(fmt.Sprintf("\n    FactomdTlsPrivateKey     %v", s.App.FactomdTlsPrivateKey))
 	out.WriteString(fmt.Sprintf("\n    FactomdTlsPublicCert     %v", s.App.FactomdTlsPublicCert))
+	out.WriteString(fmt.Sprintf("\n    FactomdTlsClientCA       %v", s.App.FactomdTlsClientCA))
 	out.WriteString(fmt.Sprintf("\n    FactomdRpcUser          	%v", s.App.FactomdRpcUser))
 	out.WriteString(fmt.Sprintf("\n    FactomdRpcPass          	%v", s.App.FactomdRpcPass))
+	out.WriteString(fmt.Sprintf("\n    APICredentialsFile       %v", s.App.APICredentialsFile))
+	out.WriteString(fmt.Sprintf("\n    APIReadsPerMinute        %v", s.App.APIReadsPerMinute))
+	out.WriteString(fmt.Sprintf("\n    APIReadBurst             %v", s.App.APIReadBurst))
+	out.WriteString(fmt.Sprintf("\n    APISubmitsPerMinute      %v", s.App.APISubmitsPerMinute))
+	out.WriteString(fmt.Sprintf("\n    APISubmitBurst           %v", s.App.APISubmitBurst))
 	out.WriteString(fmt.Sprintf("\n    ChangeAcksHeight         %v", s.App.ChangeAcksHeight))
+	out.WriteString(fmt.Sprintf("\n    MempoolMaxCount          %v", s.App.MempoolMaxCount))
+	out.WriteString(fmt.Sprintf("\n    MempoolMaxBytes          %v", s.App.MempoolMaxBytes))
+	out.WriteString(fmt.Sprintf("\n    MempoolMaxPerECAddress   %v", s.App.MempoolMaxPerECAddress))
+	out.WriteString(fmt.Sprintf("\n    MempoolMaxPerPeer        %v", s.App.MempoolMaxPerPeer))
+
+	out.WriteString(fmt.Sprintf("\n  APIUser"))
+	for user, u := range s.APIUser {
+		out.WriteString(fmt.Sprintf("\n    %-24s %v", user, u.Scopes))
+	}
+
+	out.WriteString(fmt.Sprintf("\n  Feature"))
+	for name, f := range s.Feature {
+		out.WriteString(fmt.Sprintf("\n    %-24s %v", name, f.ActivationHeight))
+	}
 
 	out.WriteString(fmt.Sprintf("\n  Log"))
 	out.WriteString(fmt.Sprintf("\n    LogPath                 %v", s.Log.LogPath))
@@ -260,39 +408,51 @@ func GetConfigFilename(dir string) string {
 	return GetHomeDir() + "/.factom/" + dir + "/factomd.conf"
 }
 
-func GetChangeAcksHeight(filename string) (change uint32, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("Error getting acks - %v\n", r)
-		}
-	}()
-
-	config := ReadConfig(filename)
-
-	return config.App.ChangeAcksHeight, nil
-}
-
 func ReadConfig(filename string) *FactomdConfig {
 	if filename == "" {
 		filename = ConfigFilename()
 	}
-	cfg := new(FactomdConfig)
+	cfg := defaultFactomdConfig()
+	err := gcfg.FatalOnly(gcfg.ReadFileInto(cfg, filename))
+	if err != nil {
+		log.Printfln("Reading from '%s'", filename)
+		log.Printfln("Cannot open custom config file,\nStarting with default settings.\n%v\n", err)
+		cfg = defaultFactomdConfig()
+	}
+	if err := cfg.ReadEnv(os.Environ()); err != nil {
+		panic(fmt.Sprintf("Bad config environment variable %v", err))
+	}
+	cfg.setDerived()
+	return cfg
+}
 
-	err := gcfg.ReadStringInto(cfg, defaultConfig)
+// ReadConfigFile reads a config file over the defaults, and the environment
+// over that.  Unlike ReadConfig, it returns an error rather than falling back
+// to the defaults if the file can't be read.
+func ReadConfigFile(filename string) (*FactomdConfig, error) {
+	cfg := defaultFactomdConfig()
+	err := gcfg.FatalOnly(gcfg.ReadFileInto(cfg, filename))
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
+	if err := cfg.ReadEnv(os.Environ()); err != nil {
+		return nil, err
+	}
+	cfg.setDerived()
+	return cfg, nil
+}
 
-	err = gcfg.FatalOnly(gcfg.ReadFileInto(cfg, filename))
+func defaultFactomdConfig() *FactomdConfig {
+	cfg := new(FactomdConfig)
+	err := gcfg.ReadStringInto(cfg, defaultConfig)
 	if err != nil {
-		log.Printfln("Reading from '%s'", filename)
-		log.Printfln("Cannot open custom config file,\nStarting with default settings.\n%v\n", err)
-		err = gcfg.ReadStringInto(cfg, defaultConfig)
-		if err != nil {
-			panic(err)
-		}
+		panic(err)
 	}
+	return cfg
+}
 
+// setDerived fills in the settings that default to, or are picked by, others.
+func (cfg *FactomdConfig) setDerived() {
 	// Default to home directory if not set
 	if len(cfg.App.HomeDir) < 1 {
 		cfg.App.HomeDir = GetHomeDir() + "/.factom/m2/"
@@ -315,8 +475,6 @@ func ReadConfig(filename string) *FactomdConfig {
 		cfg.App.ExchangeRateAuthorityPublicKey = cfg.App.ExchangeRateAuthorityPublicKeyLocalNet
 		break
 	}
-
-	return cfg
 }
 
 func GetHomeDir() string {
@@ -0,0 +1,360 @@
+package util
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/FactomProject/factomd/common/primitives"
+
+	"gopkg.in/gcfg.v1"
+)
+
+// ConfigError is a problem with one setting of factomd.conf.
+type ConfigError struct {
+	Setting string
+	Problem string
+}
+
+func (e *ConfigError) Error() string {
+	return e.Setting + ": " + e.Problem
+}
+
+// ConfigSetting is one setting of a config, named Section.Name, or
+// Section.Subsection.Name for the apiuser and feature sections.
+type ConfigSetting struct {
+	Name  string
+	Value string
+}
+
+// secretSettings are shown as set or not, never by value.
+var secretSettings = map[string]bool{
+	"App.LocalServerPrivKey":        true,
+	"App.LocalServerPendingPrivKey": true,
+	"App.FactomdRpcPass":            true,
+	"Password":                      true,
+	"Key":                           true,
+	"Walletd.WalletRpcPass":         true,
+}
+
+// Settings lists every setting of the config, in the order of the config
+// struct, with the secrets hidden.
+func (c *FactomdConfig) Settings() []*ConfigSetting {
+	var settings []*ConfigSetting
+	add := func(name string, field string, v reflect.Value) {
+		value := fmt.Sprint(v.Interface())
+		if secretSettings[name] || secretSettings[field] {
+			if value == "" {
+				value = "<not set>"
+			} else {
+				value = "<set>"
+			}
+		}
+		settings = append(settings, &ConfigSetting{Name: name, Value: value})
+	}
+
+	v := reflect.ValueOf(c).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		section, sectionName := v.Field(i), v.Type().Field(i).Name
+		switch section.Kind() {
+		case reflect.Struct:
+			for j := 0; j < section.NumField(); j++ {
+				field := section.Type().Field(j).Name
+				add(sectionName+"."+field, field, section.Field(j))
+			}
+		case reflect.Map:
+			var keys []string
+			for _, k := range section.MapKeys() {
+				keys = append(keys, k.String())
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				sub := section.MapIndex(reflect.ValueOf(k)).Elem()
+				for j := 0; j < sub.NumField(); j++ {
+					field := sub.Type().Field(j).Name
+					add(sectionName+"."+k+"."+field, field, sub.Field(j))
+				}
+			}
+		}
+	}
+	return settings
+}
+
+// ConfigFileSettings reads which settings a config file sets, named as by
+// Settings.  Any problems gcfg has with the file, including misspelled
+// settings it would otherwise ignore, are returned as errors.
+func ConfigFileSettings(filename string) (map[string]bool, []error) {
+	var errs []error
+	cfg := new(FactomdConfig)
+	if err := gcfg.ReadFileInto(cfg, filename); err != nil {
+		errs = append(errs, &ConfigError{Setting: filename, Problem: err.Error()})
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, append(errs, err)
+	}
+	defer file.Close()
+
+	// gcfg matches names without regard to case, so map them back to the
+	// names in the config struct
+	sections := make(map[string]string)
+	names := make(map[string]map[string]string) // by section
+	t := reflect.TypeOf(*cfg)
+	for i := 0; i < t.NumField(); i++ {
+		section := t.Field(i)
+		sections[strings.ToLower(section.Name)] = section.Name
+		st := section.Type
+		if st.Kind() == reflect.Map {
+			st = st.Elem().Elem()
+		}
+		names[section.Name] = make(map[string]string)
+		for j := 0; j < st.NumField(); j++ {
+			names[section.Name][strings.ToLower(st.Field(j).Name)] = st.Field(j).Name
+		}
+	}
+
+	set := make(map[string]bool)
+	section, subsection := "", ""
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || line[0] == ';' || line[0] == '#':
+		case line[0] == '[':
+			// [section] or [section "subsection"]
+			parts := strings.SplitN(strings.Trim(line, "[]"), " ", 2)
+			section, subsection = sections[strings.ToLower(parts[0])], ""
+			if len(parts) == 2 {
+				subsection = strings.Trim(strings.TrimSpace(parts[1]), `"`) + "."
+			}
+		default:
+			name := strings.ToLower(strings.TrimSpace(strings.SplitN(line, "=", 2)[0]))
+			if n, ok := names[section][name]; ok {
+				set[section+"."+subsection+n] = true
+			}
+		}
+	}
+	return set, errs
+}
+
+type configChecker struct {
+	errs []error
+}
+
+func (c *configChecker) add(setting string, format string, args ...interface{}) {
+	c.errs = append(c.errs, &ConfigError{Setting: setting, Problem: fmt.Sprintf(format, args...)})
+}
+
+// key checks for 32 bytes of hex, as keys and chain IDs are written.
+func (c *configChecker) key(setting string, value string, optional bool) {
+	if value == "" {
+		if !optional {
+			c.add(setting, "is not set")
+		}
+		return
+	}
+	b, err := hex.DecodeString(value)
+	if err != nil || len(b) != 32 {
+		c.add(setting, "%q is not 32 bytes of hex", value)
+	}
+}
+
+func (c *configChecker) port(setting string, port int) {
+	if port < 1 || port > 65535 {
+		c.add(setting, "%d is not a port number", port)
+	}
+}
+
+func (c *configChecker) portString(setting string, port string) int {
+	n, err := strconv.Atoi(port)
+	if err != nil {
+		c.add(setting, "%q is not a port number", port)
+		return 0
+	}
+	c.port(setting, n)
+	return n
+}
+
+func (c *configChecker) oneOf(setting string, value string, allowed ...string) {
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	c.add(setting, "%q is not one of %s", value, strings.Join(allowed, ", "))
+}
+
+func (c *configChecker) notNegative(setting string, value int) {
+	if value < 0 {
+		c.add(setting, "%d is negative", value)
+	}
+}
+
+func (c *configChecker) fileExists(setting string, path string) {
+	if _, err := os.Stat(path); err != nil {
+		c.add(setting, "%v", err)
+	}
+}
+
+// inHome is a path as factomd opens it, relative to the home directory
+// unless absolute.
+func (c *FactomdConfig) inHome(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return c.App.HomeDir + path
+}
+
+// Validate checks the settings are ones factomd can start with: keys and
+// chain IDs are well formed, ports are in range and do not clash, choices are
+// known, files that must exist do, and settings only used on some networks
+// are not set for others.  The [apiuser] sections are left to the state
+// package, which parses them.
+func (c *FactomdConfig) Validate() []error {
+	v := new(configChecker)
+	app := c.App
+	network := strings.ToUpper(app.Network)
+
+	v.oneOf("App.Network", network, "MAIN", "TEST", "LOCAL", "CUSTOM")
+	v.oneOf("App.NodeMode", app.NodeMode, "FULL", "SERVER")
+	v.oneOf("App.DBType", app.DBType, "LDB", "Bolt", "Map")
+	v.oneOf("App.ControlPanelSetting", app.ControlPanelSetting, "disabled", "readonly", "readwrite")
+	v.oneOf("Log.LogLevel", c.Log.LogLevel, "debug", "info", "notice", "warning", "error", "critical", "alert", "emergency", "none")
+	v.oneOf("Log.ConsoleLogLevel", strings.ToLower(c.Log.ConsoleLogLevel), "debug", "standard")
+	if app.DirectoryBlockInSeconds < 1 {
+		v.add("App.DirectoryBlockInSeconds", "must be at least 1")
+	}
+	if info, err := os.Stat(app.HomeDir); err == nil && !info.IsDir() {
+		v.add("App.HomeDir", "%s is not a directory", app.HomeDir)
+	}
+
+	// Ports, none of which may be the same as another
+	ports := make(map[int]string)
+	usePort := func(setting string, port int) {
+		if other, ok := ports[port]; ok && port != 0 {
+			v.add(setting, "port %d is also used by %s", port, other)
+		}
+		ports[port] = setting
+	}
+	v.port("App.PortNumber", app.PortNumber)
+	usePort("App.PortNumber", app.PortNumber)
+	v.port("App.ControlPanelPort", app.ControlPanelPort)
+	if app.ControlPanelSetting != "disabled" {
+		usePort("App.ControlPanelPort", app.ControlPanelPort)
+	}
+	for _, p := range []struct {
+		setting string
+		port    string
+		used    bool
+	}{
+		{"App.MainNetworkPort", app.MainNetworkPort, network == "MAIN"},
+		{"App.TestNetworkPort", app.TestNetworkPort, network == "TEST"},
+		{"App.LocalNetworkPort", app.LocalNetworkPort, network == "LOCAL" || network == "CUSTOM"},
+	} {
+		port := v.portString(p.setting, p.port)
+		if p.used {
+			usePort(p.setting, port)
+		}
+	}
+
+	// Keys and chain IDs
+	v.key("App.LocalServerPrivKey", app.LocalServerPrivKey, false)
+	v.key("App.LocalServerPublicKey", app.LocalServerPublicKey, true)
+	if app.LocalServerPublicKey != "" {
+		if pub, err := primitives.PrivateKeyStringToPublicKeyString(app.LocalServerPrivKey); err == nil && pub != app.LocalServerPublicKey {
+			v.add("App.LocalServerPublicKey", "is not the public key of LocalServerPrivKey, which is %s", pub)
+		}
+	}
+	v.key("App.LocalServerPendingPrivKey", app.LocalServerPendingPrivKey, true)
+	v.key("App.IdentityChainID", app.IdentityChainID, true)
+	v.key("App.ExchangeRateChainId", app.ExchangeRateChainId, false)
+	v.key("App.ExchangeRateAuthorityPublicKeyMainNet", app.ExchangeRateAuthorityPublicKeyMainNet, false)
+	v.key("App.ExchangeRateAuthorityPublicKeyTestNet", app.ExchangeRateAuthorityPublicKeyTestNet, false)
+	v.key("App.ExchangeRateAuthorityPublicKeyLocalNet", app.ExchangeRateAuthorityPublicKeyLocalNet, false)
+	v.key("App.CustomBootstrapIdentity", app.CustomBootstrapIdentity, false)
+	v.key("App.CustomBootstrapKey", app.CustomBootstrapKey, false)
+
+	// Signing
+	v.oneOf("App.ServerSigner", app.ServerSigner, "local", "file", "remote")
+	switch app.ServerSigner {
+	case "file":
+		v.fileExists("App.ServerSignerKeyFile", app.ServerSignerKeyFile)
+	case "remote":
+		v.oneOf("App.ServerSignerNetwork", app.ServerSignerNetwork, "unix", "tcp")
+		if app.ServerSignerSocket == "" {
+			v.add("App.ServerSignerSocket", "must be set for a remote signer")
+		}
+	}
+
+	// Entry credit pricing
+	v.oneOf("App.ECPricePolicy", app.ECPricePolicy, "fer", "fixed", "governance")
+	if (network == "MAIN" || network == "TEST") && app.ECPricePolicy != "fer" {
+		v.add("App.ECPricePolicy", "must be fer on %s", network)
+	}
+	if app.ECPricePolicy == "fixed" && app.ECPriceFixed == 0 {
+		v.add("App.ECPriceFixed", "must be set for the fixed policy")
+	}
+	v.key("App.ECPriceGovernanceChainId", app.ECPriceGovernanceChainId, app.ECPricePolicy != "governance")
+
+	// Settings only used on some networks
+	if app.CustomGenesisFile != "" {
+		if network != "CUSTOM" {
+			v.add("App.CustomGenesisFile", "is only used on CUSTOM networks")
+		} else {
+			v.fileExists("App.CustomGenesisFile", c.inHome(app.CustomGenesisFile))
+		}
+	}
+	if len(c.Feature) > 0 && network != "LOCAL" && network != "CUSTOM" {
+		v.add("Feature", "activation heights are only used on LOCAL and CUSTOM networks")
+	}
+
+	// API
+	if app.FactomdTlsEnabled {
+		key, cert := app.FactomdTlsPrivateKey, app.FactomdTlsPublicCert
+		if key == "/full/path/to/factomdAPIpriv.key" {
+			key = app.HomeDir + "factomdAPIpriv.key"
+		}
+		if cert == "/full/path/to/factomdAPIpub.cert" {
+			cert = app.HomeDir + "factomdAPIpub.cert"
+		}
+		// factomd makes a key and certificate if neither exist
+		_, keyErr := os.Stat(key)
+		_, certErr := os.Stat(cert)
+		if keyErr != nil && certErr == nil {
+			v.add("App.FactomdTlsPrivateKey", "%v", keyErr)
+		}
+		if certErr != nil && keyErr == nil {
+			v.add("App.FactomdTlsPublicCert", "%v", certErr)
+		}
+	}
+	if app.FactomdTlsClientCA != "" {
+		v.fileExists("App.FactomdTlsClientCA", app.FactomdTlsClientCA)
+	}
+	if app.FactomdRpcPass != "" && app.FactomdRpcUser == "" {
+		v.add("App.FactomdRpcPass", "is set without FactomdRpcUser")
+	}
+	if app.APICredentialsFile != "" {
+		v.fileExists("App.APICredentialsFile", c.inHome(app.APICredentialsFile))
+	}
+	v.notNegative("App.APIReadsPerMinute", app.APIReadsPerMinute)
+	v.notNegative("App.APIReadBurst", app.APIReadBurst)
+	v.notNegative("App.APISubmitsPerMinute", app.APISubmitsPerMinute)
+	v.notNegative("App.APISubmitBurst", app.APISubmitBurst)
+
+	// Limits
+	v.notNegative("App.MempoolMaxCount", app.MempoolMaxCount)
+	v.notNegative("App.MempoolMaxBytes", app.MempoolMaxBytes)
+	v.notNegative("App.MempoolMaxPerECAddress", app.MempoolMaxPerECAddress)
+	v.notNegative("App.MempoolMaxPerPeer", app.MempoolMaxPerPeer)
+	v.notNegative("App.MaxOutgoingPeers", app.MaxOutgoingPeers)
+	v.notNegative("App.MaxIncomingPeers", app.MaxIncomingPeers)
+
+	return v.errs
+}
@@ -0,0 +1,109 @@
+package util_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/FactomProject/factomd/util"
+)
+
+func hasConfigError(errs []error, setting string) bool {
+	for _, err := range errs {
+		if e, ok := err.(*ConfigError); ok && e.Setting == setting {
+			return true
+		}
+	}
+	return false
+}
+
+func TestConfigValidate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "factomd.conf")
+
+	if err := ioutil.WriteFile(filename, []byte("[app]\nNetwork = LOCAL\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := ReadConfigFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs := cfg.Validate(); len(errs) != 0 {
+		t.Errorf("The default config has problems %v", errs)
+	}
+
+	cfg.App.LocalServerPrivKey = "4c38c72fc5cdad68f13b74674d3ffb1f3d63a112710868c9b08946553448d2"
+	cfg.App.LocalNetworkPort = "8088"
+	cfg.App.ECPricePolicy = "fixed"
+	cfg.App.ServerSigner = "file"
+	cfg.App.ServerSignerKeyFile = filepath.Join(dir, "missing")
+	errs := cfg.Validate()
+	for _, setting := range []string{"App.LocalServerPrivKey", "App.LocalNetworkPort", "App.ECPriceFixed", "App.ServerSignerKeyFile"} {
+		if !hasConfigError(errs, setting) {
+			t.Errorf("No problem found with %s: %v", setting, errs)
+		}
+	}
+
+	cfg.App.Network = "MAIN"
+	cfg.App.LocalServerPrivKey = "0000000000000000000000000000000000000000000000000000000000000001"
+	errs = cfg.Validate()
+	for _, setting := range []string{"App.ECPricePolicy", "App.LocalServerPublicKey"} {
+		if !hasConfigError(errs, setting) {
+			t.Errorf("No problem found with %s: %v", setting, errs)
+		}
+	}
+}
+
+func TestConfigFileSettings(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "factomd.conf")
+
+	config := `
+[app]
+portnumber = 9000
+; ControlPanelPort = 9001
+PortNumbr = 9002
+
+[apiuser "partner"]
+key = secret
+Scopes = read
+
+[log]
+logLevel = debug
+`
+	if err := ioutil.WriteFile(filename, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+	set, errs := ConfigFileSettings(filename)
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "PortNumbr") {
+		t.Errorf("Misspelled setting not reported %v", errs)
+	}
+	for _, setting := range []string{"App.PortNumber", "APIUser.partner.Key", "APIUser.partner.Scopes", "Log.LogLevel"} {
+		if !set[setting] {
+			t.Errorf("%s not found in the file", setting)
+		}
+	}
+	if set["App.ControlPanelPort"] {
+		t.Errorf("A comment was read as a setting")
+	}
+
+	cfg, err := ReadConfigFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range cfg.Settings() {
+		if s.Name == "APIUser.partner.Key" && s.Value != "<set>" {
+			t.Errorf("The key was shown as %s", s.Value)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package util
+
+import (
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/FactomProject/factomd/log"
+)
+
+// ConfigEnvPrefix starts the names of the environment variables that
+// override factomd.conf.  A setting is FACTOMD_<SECTION>_<NAME>, as in
+// FACTOMD_APP_PORTNUMBER or FACTOMD_LOG_LOGLEVEL, and a setting in a named
+// section is FACTOMD_<SECTION>_<name>_<NAME>, as in
+// FACTOMD_APIUSER_partner_SCOPES.  Adding _FILE to a name reads the value
+// from that file instead, for secrets like FACTOMD_APP_LOCALSERVERPRIVKEY_FILE.
+// Lists are comma separated.
+const ConfigEnvPrefix = "FACTOMD_"
+
+// ConfigEnvSuffixFile marks a variable whose value is the file to read the
+// setting from.
+const ConfigEnvSuffixFile = "_FILE"
+
+// envSetting is a setting named by an environment variable.
+type envSetting struct {
+	name     string // As named by Settings
+	section  string
+	sub      string // Name of the named section, if any
+	field    string
+	variable string
+	value    string
+	fromFile bool
+}
+
+// configEnv finds the settings the FACTOMD_ variables name.  Variables that
+// do not name a setting are returned as unknown.
+func configEnv(environ []string) ([]*envSetting, []string) {
+	t := reflect.TypeOf(FactomdConfig{})
+	var settings []*envSetting
+	var unknown []string
+	for _, kv := range environ {
+		parts := strings.SplitN(kv, "=", 2)
+		variable := parts[0]
+		if !strings.HasPrefix(variable, ConfigEnvPrefix) || len(parts) != 2 {
+			continue
+		}
+		s := &envSetting{variable: variable, value: parts[1]}
+		name := strings.TrimPrefix(variable, ConfigEnvPrefix)
+		if strings.HasSuffix(name, ConfigEnvSuffixFile) {
+			name = strings.TrimSuffix(name, ConfigEnvSuffixFile)
+			s.fromFile = true
+		}
+
+		found := false
+		for i := 0; i < t.NumField() && !found; i++ {
+			section := t.Field(i)
+			prefix := strings.ToUpper(section.Name) + "_"
+			if !strings.HasPrefix(name, prefix) {
+				continue
+			}
+			rest := strings.TrimPrefix(name, prefix)
+			st := section.Type
+			if st.Kind() == reflect.Map {
+				// The section name is everything up to the last _
+				j := strings.LastIndex(rest, "_")
+				if j <= 0 {
+					continue
+				}
+				s.sub, rest = rest[:j], rest[j+1:]
+				st = st.Elem().Elem()
+			}
+			for j := 0; j < st.NumField(); j++ {
+				if strings.ToUpper(st.Field(j).Name) == rest {
+					s.section, s.field = section.Name, st.Field(j).Name
+					s.name = s.section + "." + s.field
+					if s.sub != "" {
+						s.name = s.section + "." + s.sub + "." + s.field
+					}
+					found = true
+					break
+				}
+			}
+		}
+		if !found {
+			unknown = append(unknown, variable)
+			continue
+		}
+		settings = append(settings, s)
+	}
+	return settings, unknown
+}
+
+// ConfigEnv lists the settings environment variables override, with the
+// variable for each, and the FACTOMD_ variables that are not settings.
+func ConfigEnv(environ []string) (map[string]string, []string) {
+	settings, unknown := configEnv(environ)
+	vars := make(map[string]string)
+	for _, s := range settings {
+		vars[s.name] = s.variable
+	}
+	return vars, unknown
+}
+
+// setValue parses a setting from its text.
+func setValue(v reflect.Value, text string) error {
+	switch {
+	case v.Type() == reflect.TypeOf(time.Duration(0)):
+		d, err := time.ParseDuration(text)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+	case v.Kind() == reflect.String:
+		v.SetString(text)
+	case v.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case v.Kind() >= reflect.Int && v.Kind() <= reflect.Int64:
+		n, err := strconv.ParseInt(text, 0, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case v.Kind() >= reflect.Uint && v.Kind() <= reflect.Uint64:
+		n, err := strconv.ParseUint(text, 0, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.String:
+		var list []string
+		for _, s := range strings.Split(text, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				list = append(list, s)
+			}
+		}
+		v.Set(reflect.ValueOf(list))
+	default:
+		return fmt.Errorf("can't set a %s", v.Type())
+	}
+	return nil
+}
+
+// ReadEnv overrides the config with the FACTOMD_ environment variables, as
+// from os.Environ().  Variables that are not settings are logged and
+// ignored.
+func (c *FactomdConfig) ReadEnv(environ []string) error {
+	settings, unknown := configEnv(environ)
+	for _, variable := range unknown {
+		log.Printfln("%s is not a factomd.conf setting, ignoring it", variable)
+	}
+
+	seen := make(map[string]string)
+	for _, s := range settings {
+		if other, ok := seen[s.name]; ok {
+			return fmt.Errorf("%s and %s both set %s", other, s.variable, s.name)
+		}
+		seen[s.name] = s.variable
+
+		text := s.value
+		if s.fromFile {
+			data, err := ioutil.ReadFile(s.value)
+			if err != nil {
+				return fmt.Errorf("%s: %v", s.variable, err)
+			}
+			text = strings.TrimRight(string(data), "\r\n")
+		}
+
+		v := reflect.ValueOf(c).Elem().FieldByName(s.section)
+		if s.sub != "" {
+			if v.IsNil() {
+				v.Set(reflect.MakeMap(v.Type()))
+			}
+			key := reflect.ValueOf(s.sub)
+			entry := v.MapIndex(key)
+			if !entry.IsValid() {
+				entry = reflect.New(v.Type().Elem().Elem())
+				v.SetMapIndex(key, entry)
+			}
+			v = entry.Elem()
+		}
+		if err := setValue(v.FieldByName(s.field), text); err != nil {
+			return fmt.Errorf("%s: %v", s.variable, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package util_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/FactomProject/factomd/util"
+)
+
+func TestConfigReadEnv(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	keyFile := filepath.Join(dir, "key")
+	key := "4c38c72fc5cdad68f13b74674d3ffb1f3d63a112710868c9b08946553448d245"
+	if err := ioutil.WriteFile(keyFile, []byte(key+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := ReadConfigFile(os.DevNull)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = cfg.ReadEnv([]string{
+		"PATH=/bin",
+		"FACTOMD_APP_PORTNUMBER=9000",
+		"FACTOMD_LOG_LOGLEVEL=debug",
+		"FACTOMD_APIUSER_partner_KEY=partnerkey",
+		"FACTOMD_APIUSER_partner_SCOPES=read,submit",
+		"FACTOMD_APP_LOCALSERVERPRIVKEY_FILE=" + keyFile,
+		"FACTOMD_APP_NOSUCHSETTING=1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.App.PortNumber != 9000 || cfg.Log.LogLevel != "debug" {
+		t.Errorf("Settings not read from the environment")
+	}
+	if cfg.App.LocalServerPrivKey != key {
+		t.Errorf("The key file was read as %q", cfg.App.LocalServerPrivKey)
+	}
+	user, ok := cfg.APIUser["partner"]
+	if !ok || user.Key != "partnerkey" || user.Scopes != "read,submit" {
+		t.Errorf("API user not read from the environment %v", user)
+	}
+
+	if err := cfg.ReadEnv([]string{"FACTOMD_APP_PORTNUMBER=port"}); err == nil {
+		t.Errorf("A bad port was accepted")
+	}
+	both := []string{"FACTOMD_APP_LOCALSERVERPRIVKEY=" + key, "FACTOMD_APP_LOCALSERVERPRIVKEY_FILE=" + keyFile}
+	if err := cfg.ReadEnv(both); err == nil {
+		t.Errorf("A setting set twice was accepted")
+	}
+	if err := cfg.ReadEnv([]string{"FACTOMD_APP_LOCALSERVERPRIVKEY_FILE=" + filepath.Join(dir, "missing")}); err == nil {
+		t.Errorf("A missing key file was accepted")
+	}
+}
+
+func TestConfigEnv(t *testing.T) {
+	vars, unknown := ConfigEnv([]string{
+		"HOME=/root",
+		"FACTOMD_APP_NETWORK=TEST",
+		"FACTOMD_WALLET_ADDRESS=localhost",
+		"FACTOMD_APP_NETWROK=TEST",
+	})
+	if vars["App.Network"] != "FACTOMD_APP_NETWORK" || vars["Wallet.Address"] != "FACTOMD_WALLET_ADDRESS" || len(vars) != 2 {
+		t.Errorf("Wrong settings %v", vars)
+	}
+	if len(unknown) != 1 || unknown[0] != "FACTOMD_APP_NETWROK" {
+		t.Errorf("Wrong unknown variables %v", unknown)
+	}
+}
@@ -0,0 +1,115 @@
+package util
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// GenesisConfig describes the genesis block of a CUSTOM network.  It is
+// written by the GenesisGenerator utility and loaded by factomd from the file
+// named by CustomGenesisFile in factomd.conf.  Every node on the network must
+// load the same file.
+type GenesisConfig struct {
+	NetworkName             string           `json:"networkname"` // The -customnet value
+	NetworkID               string           `json:"networkid"`   // First 4 bytes of the sha256 of NetworkName, in hex
+	Timestamp               int64            `json:"timestamp"`   // Unix seconds, truncated to the minute
+	BootstrapIdentity       string           `json:"bootstrapidentity"`
+	BootstrapKey            string           `json:"bootstrapkey"`
+	FederatedServers        []GenesisServer  `json:"federatedservers"` // The bootstrap identity and key if empty
+	DirectoryBlockInSeconds int              `json:"directoryblockinseconds"`
+	ExchangeRate            uint64           `json:"exchangerate"` // Factoshis per entry credit
+	Balances                []GenesisBalance `json:"balances"`
+}
+
+// GenesisServer is one of the initial federated servers.
+type GenesisServer struct {
+	Identity   string `json:"identity"`   // Identity chain ID
+	SigningKey string `json:"signingkey"` // Block signing public key, hex
+}
+
+// GenesisBalance pre-funds a factoid address.
+type GenesisBalance struct {
+	Address   string `json:"address"` // FA...
+	Factoshis uint64 `json:"factoshis"`
+}
+
+// CustomNetworkID is the network ID factomd uses for a -customnet name.
+func CustomNetworkID(name string) []byte {
+	return primitives.Sha([]byte(name)).Bytes()[:4]
+}
+
+// Validate checks the genesis configuration is complete and well formed.
+func (g *GenesisConfig) Validate() error {
+	if g.NetworkName == "" {
+		return fmt.Errorf("No network name")
+	}
+	if g.NetworkID != hex.EncodeToString(CustomNetworkID(g.NetworkName)) {
+		return fmt.Errorf("Network ID %s is not the ID of network %s", g.NetworkID, g.NetworkName)
+	}
+	if g.Timestamp <= 0 {
+		return fmt.Errorf("No timestamp")
+	}
+	if _, err := primitives.HexToHash(g.BootstrapIdentity); err != nil {
+		return fmt.Errorf("Bad bootstrap identity: %v", err)
+	}
+	if _, err := primitives.HexToHash(g.BootstrapKey); err != nil {
+		return fmt.Errorf("Bad bootstrap key: %v", err)
+	}
+	for _, fed := range g.FederatedServers {
+		if _, err := primitives.HexToHash(fed.Identity); err != nil {
+			return fmt.Errorf("Bad federated server %s: %v", fed.Identity, err)
+		}
+		if _, err := primitives.HexToHash(fed.SigningKey); err != nil {
+			return fmt.Errorf("Bad signing key for federated server %s: %v", fed.Identity, err)
+		}
+	}
+	if g.DirectoryBlockInSeconds <= 0 {
+		return fmt.Errorf("Block time must be positive")
+	}
+	if g.ExchangeRate == 0 {
+		return fmt.Errorf("Exchange rate must be positive")
+	}
+	seen := make(map[string]bool)
+	for _, b := range g.Balances {
+		if !primitives.ValidateFUserStr(b.Address) {
+			return fmt.Errorf("Bad factoid address %s", b.Address)
+		}
+		if seen[b.Address] {
+			return fmt.Errorf("Factoid address %s is funded twice", b.Address)
+		}
+		seen[b.Address] = true
+	}
+	return nil
+}
+
+// ReadGenesis reads and validates a genesis configuration.
+func ReadGenesis(filename string) (*GenesisConfig, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	g := new(GenesisConfig)
+	if err := json.Unmarshal(data, g); err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	if err := g.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return g, nil
+}
+
+// WriteGenesis validates a genesis configuration and writes it to a file.
+func WriteGenesis(filename string, g *GenesisConfig) error {
+	if err := g.Validate(); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(g, "", "\t")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, append(data, '\n'), 0644)
+}
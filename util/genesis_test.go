@@ -0,0 +1,66 @@
+package util_test
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/FactomProject/factomd/util"
+)
+
+func testGenesis() *GenesisConfig {
+	g := new(GenesisConfig)
+	g.NetworkName = "testnet"
+	g.NetworkID = hex.EncodeToString(CustomNetworkID("testnet"))
+	g.Timestamp = 1500000000
+	g.BootstrapIdentity = "38bab1455b7bd7e5efd15c53c777c79d0c988e9210f1da49a99d95b3a6417be9"
+	g.BootstrapKey = "cc1985cdfae4e32b5a454dfda8ce5e1361558482684f3367649c3ad852c8e31a"
+	g.DirectoryBlockInSeconds = 60
+	g.ExchangeRate = 1000
+	g.Balances = []GenesisBalance{{Address: "FA2jK2HcLnRdS94dEcU27rF3meoJfpUcZPSinpb7AwQvPRY6RL1Q", Factoshis: 100}}
+	return g
+}
+
+func TestGenesisValidate(t *testing.T) {
+	if err := testGenesis().Validate(); err != nil {
+		t.Errorf("%v", err)
+	}
+
+	g := testGenesis()
+	g.NetworkID = "00000000"
+	if g.Validate() == nil {
+		t.Errorf("Wrong network ID was accepted")
+	}
+	g = testGenesis()
+	g.Balances = append(g.Balances, g.Balances[0])
+	if g.Validate() == nil {
+		t.Errorf("Address funded twice was accepted")
+	}
+	g = testGenesis()
+	g.Balances[0].Address = "FA2jK2HcLnRdS94dEcU27rF3meoJfpUcZPSinpb7AwQvPRY6RL1R"
+	if g.Validate() == nil {
+		t.Errorf("Bad address was accepted")
+	}
+}
+
+func TestGenesisReadWrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "genesis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "genesis.json")
+
+	if err := WriteGenesis(filename, testGenesis()); err != nil {
+		t.Fatal(err)
+	}
+	g, err := ReadGenesis(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.NetworkName != "testnet" || len(g.Balances) != 1 || g.Balances[0].Factoshis != 100 {
+		t.Errorf("Genesis did not survive a round trip: %+v", g)
+	}
+}
@@ -0,0 +1,144 @@
+package util
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/FactomProject/factomd/log"
+)
+
+// TLSReloader serves a certificate, and optionally checks client certificates
+// against a CA bundle, reloading the files when they change or Reload is
+// called.  Connections already open keep the certificate they started with.
+type TLSReloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string // Empty if client certificates are not required
+
+	mutex     sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  map[string]time.Time
+}
+
+func NewTLSReloader(certFile string, keyFile string, clientCAFile string) (*TLSReloader, error) {
+	r := new(TLSReloader)
+	r.certFile = certFile
+	r.keyFile = keyFile
+	r.clientCAFile = clientCAFile
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *TLSReloader) files() []string {
+	files := []string{r.certFile, r.keyFile}
+	if r.clientCAFile != "" {
+		files = append(files, r.clientCAFile)
+	}
+	return files
+}
+
+func modTime(name string) time.Time {
+	info, err := os.Stat(name)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
+// Reload reads the certificate, key and CA bundle again.  On error the old
+// ones are kept.
+func (r *TLSReloader) Reload() error {
+	modTimes := make(map[string]time.Time)
+	for _, f := range r.files() {
+		modTimes[f] = modTime(f)
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("could not load TLS keypair: %v", err)
+	}
+	var pool *x509.CertPool
+	if r.clientCAFile != "" {
+		pem, err := ioutil.ReadFile(r.clientCAFile)
+		if err != nil {
+			return fmt.Errorf("could not read client CA bundle: %v", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in client CA bundle %s", r.clientCAFile)
+		}
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.cert = &cert
+	r.clientCAs = pool
+	r.modTimes = modTimes
+	return nil
+}
+
+// Changed is true if any of the files have been modified since they were
+// last loaded.
+func (r *TLSReloader) Changed() bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	for f, t := range r.modTimes {
+		if !modTime(f).Equal(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Watch reloads the files when they change, checking every interval.  It
+// never returns, so run it as a goroutine.
+func (r *TLSReloader) Watch(interval time.Duration) {
+	for range time.Tick(interval) {
+		if !r.Changed() {
+			continue
+		}
+		if err := r.Reload(); err != nil {
+			log.Printfln("TLS certificates changed but could not be reloaded, keeping the old ones: %v", err)
+			continue
+		}
+		log.Printfln("Reloaded TLS certificates from %s", r.certFile)
+	}
+}
+
+func (r *TLSReloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.cert, nil
+}
+
+func (r *TLSReloader) config() *tls.Config {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	c := &tls.Config{
+		GetCertificate: r.getCertificate,
+		MinVersion:     tls.VersionTLS12,
+	}
+	if r.clientCAs != nil {
+		c.ClientAuth = tls.RequireAndVerifyClientCert
+		c.ClientCAs = r.clientCAs
+	}
+	return c
+}
+
+// Config is the TLS config for a server.  Each connection picks up the
+// certificate and CA bundle loaded at the time.
+func (r *TLSReloader) Config() *tls.Config {
+	c := r.config()
+	c.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		return r.config(), nil
+	}
+	return c
+}
@@ -0,0 +1,89 @@
+package util_test
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/FactomProject/btcutil/certs"
+	. "github.com/FactomProject/factomd/util"
+)
+
+func writeCertPair(t *testing.T, dir string) (string, string) {
+	cert, key, err := certs.NewTLSCertPair("test", time.Now().Add(time.Hour), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	if err := ioutil.WriteFile(certFile, cert, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(keyFile, key, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+func TestTLSReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	certFile, keyFile := writeCertPair(t, dir)
+	r, err := NewTLSReloader(certFile, keyFile, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := r.Config()
+	if c.ClientAuth != tls.NoClientCert {
+		t.Errorf("Client certificates required without a CA bundle")
+	}
+	first, _ := c.GetCertificate(nil)
+	if first == nil {
+		t.Fatalf("No certificate served")
+	}
+	if r.Changed() {
+		t.Errorf("Files reported changed before they were")
+	}
+
+	writeCertPair(t, dir)
+	later := time.Now().Add(time.Minute)
+	os.Chtimes(certFile, later, later)
+	if !r.Changed() {
+		t.Fatalf("Rewritten certificate not noticed")
+	}
+	if err := r.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	second, _ := c.GetCertificate(nil)
+	if string(second.Certificate[0]) == string(first.Certificate[0]) {
+		t.Errorf("The new certificate is not served")
+	}
+
+	// The self signed certificate is its own CA
+	withCA, err := NewTLSReloader(certFile, keyFile, certFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cc, _ := withCA.Config().GetConfigForClient(nil)
+	if cc.ClientAuth != tls.RequireAndVerifyClientCert || cc.ClientCAs == nil {
+		t.Errorf("Client certificates not required with a CA bundle")
+	}
+
+	if _, err := NewTLSReloader(certFile, keyFile, keyFile); err == nil {
+		t.Errorf("A CA bundle without certificates was accepted")
+	}
+	ioutil.WriteFile(keyFile, []byte("garbage"), 0600)
+	if err := r.Reload(); err == nil {
+		t.Errorf("A bad key was loaded")
+	}
+	if cert, _ := c.GetCertificate(nil); cert != second {
+		t.Errorf("A failed reload replaced the certificate")
+	}
+}
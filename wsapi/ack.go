@@ -76,6 +76,7 @@ func HandleV2FactoidACK(state interfaces.IState, params interface{}) (interface{
 	if answer.Status == "na" {
 		return nil, NewInternalError()
 	}
+	explainRejection(state, txhash, &answer.GeneralTransactionData)
 
 	return answer, nil
 }
@@ -150,6 +151,7 @@ func HandleV2ACKWithChain(state interfaces.IState, params interface{}) (interfac
 		}
 
 		answer.CommitData.Status = constants.AckStatusString(status)
+		explainRejection(state, hash, &answer.CommitData)
 		return answer, nil
 	case hex.EncodeToString(constants.FACTOID_CHAINID):
 		// This is a factoid transaction, just use the old implementation for now
@@ -203,6 +205,12 @@ func handleAckByEntryHash(hash interfaces.IHash, state interfaces.IState) (inter
 		answer.CommitData.Status = constants.AckStatusString(status)
 	}
 
+	// Commits are also recorded by their entry hash when they are rejected
+	explainRejection(state, hash, &answer.EntryData)
+	if commit == nil {
+		explainRejection(state, hash, &answer.CommitData)
+	}
+
 	// If we found the commit, either by holding or by other means, set the variables on the response.
 	if commit != nil {
 		answer.CommitData.TransactionDateString = commit.GetTimestamp().String()
@@ -441,6 +449,10 @@ func HandleV2EntryACK(state interfaces.IState, params interface{}) (interface{},
 		//We know nothing about the transaction, so we return unknown status
 		answer.CommitData.Status = AckStatusUnknown
 		answer.EntryData.Status = AckStatusUnknown
+		if h, err := primitives.NewShaHashFromStr(ackReq.TxID); err == nil {
+			explainRejection(state, h, &answer.CommitData)
+			explainRejection(state, h, &answer.EntryData)
+		}
 		return answer, nil
 	}
 
@@ -527,6 +539,7 @@ func HandleV2EntryACK(state interfaces.IState, params interface{}) (interface{},
 			return nil, NewInternalError()
 			break
 		}
+		explainRejection(state, h, &answer.CommitData)
 	}
 
 	if answer.EntryHash == "" {
@@ -579,11 +592,23 @@ func HandleV2EntryACK(state interfaces.IState, params interface{}) (interface{},
 			return nil, NewInternalError()
 			break
 		}
+		explainRejection(state, h, &answer.EntryData)
 	}
 
 	return answer, nil
 }
 
+// explainRejection adds the reason we dropped a message to a status that
+// would otherwise just say Unknown or Invalid.
+func explainRejection(state interfaces.IState, hash interfaces.IHash, data *GeneralTransactionData) {
+	if data.Status != AckStatusUnknown && data.Status != AckStatusInvalid {
+		return
+	}
+	if r := state.GetRejection(hash); r != nil {
+		data.Rejection = r
+	}
+}
+
 func DecodeTransactionToHashes(fullTransaction string) (eTxID string, ecTxID string) {
 	//fmt.Printf("DecodeTransactionToHashes - %v\n", fullTransaction)
 	b, err := hex.DecodeString(fullTransaction)
@@ -656,8 +681,9 @@ type GeneralTransactionData struct {
 	BlockDate             int64  `json:"blockdate,omitempty"`             //Unix time
 	BlockDateString       string `json:"blockdatestring,omitempty"`       //ISO8601 time
 
-	Malleated *Malleated `json:"malleated,omitempty"`
-	Status    string     `json:"status"`
+	Malleated *Malleated            `json:"malleated,omitempty"`
+	Status    string                `json:"status"`
+	Rejection *interfaces.Rejection `json:"rejection,omitempty"` // Why we dropped it, if Unknown or Invalid
 }
 
 type Malleated struct {
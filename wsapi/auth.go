@@ -0,0 +1,42 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/FactomProject/factomd/common/constants"
+)
+
+var errForbidden = errors.New("forbidden")
+
+// v2SubmitMethods are the V2 methods that need the submit scope.  Every other
+// V2 method needs read, and the debug API needs admin.
+var v2SubmitMethods = map[string]bool{
+	"commit-chain":     true,
+	"commit-entry":     true,
+	"reveal-chain":     true,
+	"reveal-entry":     true,
+	"factoid-submit":   true,
+	"submit-batch":     true,
+	"send-raw-message": true,
+}
+
+func v2MethodScope(method string) string {
+	if v2SubmitMethods[method] {
+		return constants.API_SCOPE_SUBMIT
+	}
+	return constants.API_SCOPE_READ
+}
+
+// v1PathScope maps a V1 request path to the scope it needs.
+func v1PathScope(path string) string {
+	path = strings.TrimPrefix(path, "/v1/")
+	if i := strings.Index(path, "/"); i >= 0 {
+		path = path[:i]
+	}
+	return v2MethodScope(path)
+}
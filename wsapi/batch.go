@@ -0,0 +1,188 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// MaxBatchSize is the most commit/reveal pairs a submit-batch call can carry.
+var MaxBatchSize = 1000
+
+// batchItem is one commit/reveal pair after decoding.
+type batchItem struct {
+	commit   interfaces.IMsg
+	reveal   *messages.RevealEntryMsg
+	ecPubKey [32]byte
+	credits  int
+	result   *BatchItemResult
+}
+
+// decodeBatchItem decodes and checks a commit and its reveal the same way
+// commit-chain, commit-entry and reveal-entry do, and that one pays for the
+// other.
+func decodeBatchItem(state interfaces.IState, item BatchItem) (*batchItem, error) {
+	b := new(batchItem)
+	b.result = new(BatchItemResult)
+
+	p, err := hex.DecodeString(item.Commit)
+	if err != nil {
+		return b, fmt.Errorf("Commit is not hex")
+	}
+	eb, err := hex.DecodeString(item.Reveal)
+	if err != nil {
+		return b, fmt.Errorf("Reveal is not hex")
+	}
+	entry := entryBlock.NewEntry()
+	if _, err := entry.UnmarshalBinaryData(eb); err != nil || !entry.IsValid() {
+		return b, fmt.Errorf("Invalid Entry")
+	}
+	b.result.EntryHash = entry.GetHash().String()
+	b.result.ChainID = entry.ChainID.String()
+
+	var entryHash interfaces.IHash
+	ksize := entry.KSize()
+	switch len(p) {
+	case entryCreditBlock.CommitChainSize:
+		commit := entryCreditBlock.NewCommitChain()
+		if _, err := commit.UnmarshalBinaryData(p); err != nil || !commit.IsValid() {
+			return b, fmt.Errorf("Invalid Commit Chain")
+		}
+		if !commit.ChainIDHash.IsSameAs(primitives.NewHash(primitives.DoubleSha(entry.ChainID.Bytes()))) {
+			return b, fmt.Errorf("Commit is for a different chain")
+		}
+		msg := new(messages.CommitChainMsg)
+		msg.CommitChain = commit
+		b.commit = msg
+		b.ecPubKey = *commit.ECPubKey
+		b.credits = int(commit.Credits)
+		b.result.TxID = commit.GetSigHash().String()
+		b.result.NewChain = true
+		entryHash = commit.EntryHash
+		ksize += 10
+	case entryCreditBlock.CommitEntrySize:
+		commit := entryCreditBlock.NewCommitEntry()
+		if _, err := commit.UnmarshalBinaryData(p); err != nil || !commit.IsValid() {
+			return b, fmt.Errorf("Invalid Commit Entry")
+		}
+		msg := new(messages.CommitEntryMsg)
+		msg.CommitEntry = commit
+		b.commit = msg
+		b.ecPubKey = *commit.ECPubKey
+		b.credits = int(commit.Credits)
+		b.result.TxID = commit.GetSigHash().String()
+		entryHash = commit.EntryHash
+	default:
+		return b, fmt.Errorf("Commit is neither a commit chain nor a commit entry")
+	}
+
+	if !entryHash.IsSameAs(entry.GetHash()) {
+		return b, fmt.Errorf("Commit is for a different entry")
+	}
+	if ksize > b.credits {
+		return b, fmt.Errorf("Commit pays %d entry credits, the entry needs %d", b.credits, ksize)
+	}
+	if !state.IsHighestCommit(entryHash, b.commit) {
+		return b, fmt.Errorf("A commit with equal or greater payment already exists")
+	}
+
+	b.reveal = new(messages.RevealEntryMsg)
+	b.reveal.Entry = entry
+	b.reveal.Timestamp = state.GetTimestamp()
+	return b, nil
+}
+
+// HandleV2SubmitBatch takes many commit/reveal pairs in one call.  They are
+// checked together, so the commits from one EC address cannot spend more than
+// its balance between them, then all the valid ones are put in the API queue
+// together.
+func HandleV2SubmitBatch(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	return handleV2SubmitBatch(state, params, nil)
+}
+
+// handleV2SubmitBatch charges each item in the batch against the client's
+// submit budget before submitting any of them.
+func handleV2SubmitBatch(state interfaces.IState, params interface{}, charge func(cost float64) *RateLimitError) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallSubmitBatch.Observe(float64(time.Since(n).Nanoseconds()))
+
+	req := new(BatchRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	if len(req.Items) == 0 {
+		return nil, NewCustomInvalidParamsError("No items to submit")
+	}
+	if len(req.Items) > MaxBatchSize {
+		return nil, NewCustomInvalidParamsError(fmt.Sprintf("A batch can have at most %d items", MaxBatchSize))
+	}
+	if charge != nil {
+		if rl := charge(float64(len(req.Items))); rl != nil {
+			return nil, NewRateLimitError(rl)
+		}
+	}
+
+	resp := new(BatchResponse)
+	var items []*batchItem
+	seen := make(map[[32]byte]bool)
+	spent := make(map[[32]byte]int)
+	for _, item := range req.Items {
+		b, err := decodeBatchItem(state, item)
+		resp.Results = append(resp.Results, b.result)
+		if err == nil && seen[b.reveal.Entry.GetHash().Fixed()] {
+			err = fmt.Errorf("Entry is already in this batch")
+		}
+		if err == nil {
+			balance := int(state.GetFactoidState().GetECBalance(b.ecPubKey))
+			if spent[b.ecPubKey]+b.credits > balance {
+				err = fmt.Errorf("Not enough entry credits, balance is %d", balance)
+			}
+		}
+		if err != nil {
+			b.result.Error = err.Error()
+			resp.Rejected++
+			continue
+		}
+		seen[b.reveal.Entry.GetHash().Fixed()] = true
+		spent[b.ecPubKey] += b.credits
+		items = append(items, b)
+	}
+
+	if req.AllOrNothing && resp.Rejected > 0 {
+		resp.Message = "Nothing submitted, the batch has invalid items"
+		resp.Rejected = len(req.Items)
+		return resp, nil
+	}
+
+	var msgs []interfaces.IMsg
+	for _, b := range items {
+		msgs = append(msgs, b.commit, b.reveal)
+		if b.result.NewChain {
+			state.IncECCommits()
+		} else {
+			state.IncECommits()
+		}
+	}
+	if q, ok := state.APIQueue().(interfaces.IBatchQueue); ok {
+		q.EnqueueBatch(msgs)
+	} else {
+		for _, m := range msgs {
+			state.APIQueue().Enqueue(m)
+		}
+	}
+
+	resp.Accepted = len(items)
+	resp.Message = "Batch Submit Success"
+	return resp, nil
+}
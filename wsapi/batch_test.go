@@ -0,0 +1,61 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/testHelper"
+	. "github.com/FactomProject/factomd/wsapi"
+)
+
+func TestHandleV2SubmitBatch(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+
+	entry := entryBlock.NewEntry()
+	entry.ChainID = primitives.NewHash(testHelper.NewPrivKey(1)[:32])
+	entry.Content = primitives.ByteSlice{Bytes: []byte("batch")}
+	other := entryBlock.NewEntry()
+	other.ChainID = entry.ChainID
+
+	commit := entryCreditBlock.NewCommitEntry()
+	commit.EntryHash = entry.GetHash()
+	commit.Credits = 1
+	testHelper.SignCommit(0, commit)
+
+	c, _ := commit.MarshalBinary()
+	e, _ := entry.MarshalBinary()
+	o, _ := other.MarshalBinary()
+
+	req := new(BatchRequest)
+	req.Items = []BatchItem{
+		{Commit: hex.EncodeToString(c), Reveal: hex.EncodeToString(o)},
+		{Commit: "zz", Reveal: hex.EncodeToString(e)},
+	}
+	req.AllOrNothing = true
+
+	resp, jerr := HandleV2SubmitBatch(state, req)
+	if jerr != nil {
+		t.Fatalf("%v", jerr)
+	}
+	r := resp.(*BatchResponse)
+	if r.Accepted != 0 || r.Rejected != 2 || len(r.Results) != 2 {
+		t.Fatalf("Expected nothing accepted, got %+v", r)
+	}
+	if r.Results[0].Error != "Commit is for a different entry" {
+		t.Errorf("Wrong error for a mismatched pair: %s", r.Results[0].Error)
+	}
+	if r.Results[1].Error != "Commit is not hex" {
+		t.Errorf("Wrong error for bad hex: %s", r.Results[1].Error)
+	}
+
+	if _, jerr := HandleV2SubmitBatch(state, new(BatchRequest)); jerr == nil {
+		t.Errorf("An empty batch was accepted")
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// chainCreation finds the first entry block of a chain, and counts its entry
+// blocks and entries, from the database's index of entry blocks by chain.
+// A chain with no entry blocks in the database returns a nil first block.
+func chainCreation(dbase interfaces.DBOverlaySimple, chainID interfaces.IHash) (first interfaces.IEntryBlock, firstEntry interfaces.IHash, eblocks int64, entries int64, err error) {
+	blocks, err := dbase.FetchAllEBlocksByChain(chainID)
+	if err != nil {
+		return nil, nil, 0, 0, err
+	}
+	for _, eb := range blocks {
+		if eb == nil {
+			continue
+		}
+		eblocks++
+		for _, h := range eb.GetEntryHashes() {
+			if !h.IsMinuteMarker() {
+				entries++
+			}
+		}
+		if first == nil || eb.GetHeader().GetEBSequence() < first.GetHeader().GetEBSequence() {
+			first = eb
+		}
+	}
+	if first != nil {
+		for _, h := range first.GetEntryHashes() {
+			if !h.IsMinuteMarker() {
+				firstEntry = h
+				break
+			}
+		}
+	}
+	return first, firstEntry, eblocks, entries, nil
+}
+
+func HandleV2ChainInfo(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallChainInfo.Observe(float64(time.Since(n).Nanoseconds()))
+
+	chainid := new(ChainIDRequest)
+	err := MapToObject(params, chainid)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	h, err := primitives.HexToHash(chainid.ChainID)
+	if err != nil {
+		return nil, NewInvalidHashError()
+	}
+
+	dbase := state.GetAndLockDB()
+	defer state.UnlockDB()
+
+	c := new(ChainInfoResponse)
+	c.ChainID = h.String()
+
+	lh := state.GetLeaderHeight()
+	c.ChainInProcessList = state.IsNewOrPendingEBlocks(lh, h) || state.IsNewOrPendingEBlocks(lh-1, h)
+	c.CommitPending = state.IsChainCommitPending(h)
+
+	mr, err := dbase.FetchHeadIndexByChainID(h)
+	if err != nil {
+		return nil, NewInternalError()
+	}
+	if mr == nil {
+		return c, nil
+	}
+	c.ChainHead = mr.String()
+
+	first, firstEntry, eblocks, entries, err := chainCreation(dbase, h)
+	if err != nil {
+		return nil, NewInternalError()
+	}
+	if first == nil {
+		return c, nil
+	}
+	c.Exists = true
+	c.EBlockCount = eblocks
+	c.EntryCount = entries
+	keyMR, err := first.KeyMR()
+	if err != nil {
+		return nil, NewInternalError()
+	}
+	c.FirstEBlock = keyMR.String()
+	if firstEntry != nil {
+		c.FirstEntry = firstEntry.String()
+	}
+	c.CreationHeight = int64(first.GetHeader().GetDBHeight())
+	dblock, err := dbase.FetchDBlockByHeight(first.GetHeader().GetDBHeight())
+	if err == nil && dblock != nil {
+		c.CreationTimestamp = dblock.GetHeader().GetTimestamp().GetTimeSeconds()
+	}
+
+	return c, nil
+}
+
+func HandleV2FirstEntry(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallFirstEntry.Observe(float64(time.Since(n).Nanoseconds()))
+
+	chainid := new(ChainIDRequest)
+	err := MapToObject(params, chainid)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	h, err := primitives.HexToHash(chainid.ChainID)
+	if err != nil {
+		return nil, NewInvalidHashError()
+	}
+
+	dbase := state.GetAndLockDB()
+	defer state.UnlockDB()
+
+	first, firstEntry, _, _, err := chainCreation(dbase, h)
+	if err != nil {
+		return nil, NewInternalError()
+	}
+	if first == nil {
+		return nil, NewMissingChainHeadError()
+	}
+	if firstEntry == nil {
+		return nil, NewEntryNotFoundError()
+	}
+	entry, err := dbase.FetchEntry(firstEntry)
+	if err != nil {
+		return nil, NewInternalError()
+	}
+	if entry == nil {
+		return nil, NewEntryNotFoundError()
+	}
+
+	e := new(FirstEntryResponse)
+	e.EntryHash = firstEntry.String()
+	e.ChainID = entry.GetChainIDHash().String()
+	e.Content = hex.EncodeToString(entry.GetContent())
+	for _, v := range entry.ExternalIDs() {
+		e.ExtIDs = append(e.ExtIDs, hex.EncodeToString(v))
+	}
+	if keyMR, err := first.KeyMR(); err == nil {
+		e.EBlock = keyMR.String()
+	}
+	e.DBHeight = int64(first.GetHeader().GetDBHeight())
+
+	return e, nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/testHelper"
+	. "github.com/FactomProject/factomd/wsapi"
+)
+
+func TestHandleV2ChainInfo(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+	blocks := testHelper.CreateFullTestBlockSet()
+
+	chainID := blocks[0].EBlock.GetChainID()
+	var eblocks, entries int64
+	for _, block := range blocks {
+		eblocks++
+		for _, h := range block.EBlock.GetEntryHashes() {
+			if !h.IsMinuteMarker() {
+				entries++
+			}
+		}
+	}
+	firstEntry := blocks[0].EBlock.GetEntryHashes()[0]
+
+	req := new(ChainIDRequest)
+	req.ChainID = chainID.String()
+	resp, jErr := HandleV2ChainInfo(state, req)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	info := resp.(*ChainInfoResponse)
+	if !info.Exists {
+		t.Errorf("Chain %s does not exist", req.ChainID)
+	}
+	if info.EBlockCount != eblocks || info.EntryCount != entries {
+		t.Errorf("Expected %d eblocks and %d entries, got %d and %d", eblocks, entries, info.EBlockCount, info.EntryCount)
+	}
+	if info.FirstEntry != firstEntry.String() {
+		t.Errorf("Wrong first entry %s", info.FirstEntry)
+	}
+	if info.CreationHeight != int64(blocks[0].EBlock.GetHeader().GetDBHeight()) {
+		t.Errorf("Wrong creation height %d", info.CreationHeight)
+	}
+
+	resp, jErr = HandleV2FirstEntry(state, req)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	if resp.(*FirstEntryResponse).EntryHash != firstEntry.String() {
+		t.Errorf("first-entry returned %s", resp.(*FirstEntryResponse).EntryHash)
+	}
+
+	req.ChainID = "0000000000000000000000000000000000000000000000000000000000000123"
+	resp, jErr = HandleV2ChainInfo(state, req)
+	if jErr != nil {
+		t.Fatalf("%v", jErr)
+	}
+	if resp.(*ChainInfoResponse).Exists || resp.(*ChainInfoResponse).CommitPending {
+		t.Errorf("Unknown chain reported as existing")
+	}
+	if _, jErr = HandleV2FirstEntry(state, req); jErr == nil {
+		t.Errorf("first-entry of an unknown chain did not fail")
+	}
+}
@@ -9,11 +9,10 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
-	"strings"
 
+	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
-	"github.com/FactomProject/factomd/util"
 	"github.com/FactomProject/web"
 )
 
@@ -22,9 +21,13 @@ func HandleDebug(ctx *web.Context) {
 	state := ctx.Server.Env["state"].(interfaces.IState)
 	ServersMutex.Unlock()
 
-	if err := checkAuthHeader(state, ctx.Request); err != nil {
-		remoteIP := ""
-		remoteIP += strings.Split(ctx.Request.RemoteAddr, ":")[0]
+	if _, _, err := checkAuthHeader(state, ctx.Request, constants.API_SCOPE_ADMIN); err != nil {
+		remoteIP := remoteHost(ctx.Request)
+		if err == errForbidden {
+			fmt.Printf("Forbidden debug API call from %s\n", remoteIP)
+			http.Error(ctx.ResponseWriter, "403 Forbidden.", http.StatusForbidden)
+			return
+		}
 		fmt.Printf(
 			"Unauthorized V2 API client connection attempt from %s\n",
 			remoteIP,
@@ -102,6 +105,9 @@ func HandleDebugRequest(
 	case "federated-servers":
 		resp, jsonError = HandleFedServers(state, params)
 		break
+	case "key-rotation":
+		resp, jsonError = HandleKeyRotation(state, params)
+		break
 	case "holding-queue":
 		resp, jsonError = HandleHoldingQueue(state, params)
 		break
@@ -120,6 +126,12 @@ func HandleDebugRequest(
 	case "process-list":
 		resp, jsonError = HandleProcessList(state, params)
 		break
+	case "fault-traces":
+		resp, jsonError = HandleFaultTraces(state, params)
+		break
+	case "process-list-info":
+		resp, jsonError = HandleProcessListInfo(state, params)
+		break
 	case "reload-configuration":
 		resp, jsonError = HandleReloadConfig(state, params)
 		break
@@ -310,6 +322,16 @@ func HandleHoldingQueue(
 	return r, nil
 }
 
+func HandleKeyRotation(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	return state.GetKeyRotationStatus(), nil
+}
+
 func HandleMessages(
 	state interfaces.IState,
 	params interface{},
@@ -392,17 +414,47 @@ func HandleProcessList(
 	return r, nil
 }
 
-func HandleReloadConfig(
+// HandleProcessListInfo returns the leader's process list VM by VM, with the
+// outstanding requests for missing messages and the DBSig tally.
+func HandleProcessListInfo(
 	state interfaces.IState,
 	params interface{},
 ) (
 	interface{},
 	*primitives.JSONError,
 ) {
-	// LoacConfig with "" strings should load the default location
-	state.LoadConfig(util.ConfigFilename(), state.GetNetworkName())
+	return state.GetProcessListInfo(), nil
+}
 
-	return state.GetCfg(), nil
+// HandleFaultTraces returns the recent faults, newest first, with the audit
+// server nominated and the signatures gathered for each.
+func HandleFaultTraces(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	type ret struct {
+		Faults []*interfaces.FaultTrace `json:"faults"`
+	}
+	r := new(ret)
+	r.Faults = state.GetFaultTraces()
+	return r, nil
+}
+
+func HandleReloadConfig(
+	state interfaces.IState,
+	params interface{},
+) (
+	interface{},
+	*primitives.JSONError,
+) {
+	reload, err := state.ReloadConfig()
+	if err != nil {
+		return nil, NewCustomInternalError(err.Error())
+	}
+	return reload, nil
 }
 
 type SetDelayRequest struct {
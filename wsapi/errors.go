@@ -97,3 +97,9 @@ func NewReceiptError() *primitives.JSONError {
 func NewRepeatCommitError(data interface{}) *primitives.JSONError {
 	return primitives.NewJSONError(-32011, "Repeated Commit", data)
 }
+func NewForbiddenError(method string) *primitives.JSONError {
+	return primitives.NewJSONError(-32012, "Forbidden", "the credentials used are not allowed to call "+method)
+}
+func NewRateLimitError(data *RateLimitError) *primitives.JSONError {
+	return primitives.NewJSONError(-32013, "Rate limit exceeded", data)
+}
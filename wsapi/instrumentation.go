@@ -164,6 +164,46 @@ var (
 		Name: "factomd_wsapi_v2_api_call_tpsrate_ns",
 		Help: "Time it takes to compelete a tpsrate",
 	})
+
+	HandleV2APICallMempoolInfo = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_mempoolinfo_ns",
+		Help: "Time it takes to compelete a mempoolinfo",
+	})
+
+	HandleV2APICallIdentity = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_identity_ns",
+		Help: "Time it takes to compelete an identity",
+	})
+
+	HandleV2APICallSubmitBatch = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_submitbatch_ns",
+		Help: "Time it takes to compelete a submit-batch",
+	})
+
+	HandleV2APICallChainInfo = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_chaininfo_ns",
+		Help: "Time it takes to compelete a chain-info",
+	})
+
+	HandleV2APICallFirstEntry = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "factomd_wsapi_v2_api_call_firstentry_ns",
+		Help: "Time it takes to compelete a first-entry",
+	})
+
+	APIRateLimitedReads = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_wsapi_rate_limited_reads_count",
+		Help: "Number of read calls refused because the client was over its rate limit",
+	})
+
+	APIRateLimitedSubmits = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_wsapi_rate_limited_submits_count",
+		Help: "Number of submit calls refused because the client was over its rate limit",
+	})
+
+	APIRateLimitClients = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "factomd_wsapi_rate_limit_clients",
+		Help: "Number of clients with a partly used rate limit budget",
+	}, func() float64 { return float64(readLimiter.Clients() + submitLimiter.Clients()) })
 )
 
 var registered = false
@@ -207,4 +247,12 @@ func RegisterPrometheus() {
 	prometheus.MustRegister(HandleV2APICallABlockByHeight)
 	prometheus.MustRegister(HandleV2APICallAuthorities)
 	prometheus.MustRegister(HandleV2APICallTpsRate)
+	prometheus.MustRegister(HandleV2APICallIdentity)
+	prometheus.MustRegister(HandleV2APICallMempoolInfo)
+	prometheus.MustRegister(HandleV2APICallSubmitBatch)
+	prometheus.MustRegister(HandleV2APICallChainInfo)
+	prometheus.MustRegister(HandleV2APICallFirstEntry)
+	prometheus.MustRegister(APIRateLimitedReads)
+	prometheus.MustRegister(APIRateLimitedSubmits)
+	prometheus.MustRegister(APIRateLimitClients)
 }
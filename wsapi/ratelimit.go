@@ -0,0 +1,150 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// v2MethodCosts are how many tokens the expensive calls take from a client's
+// budget.  Everything else takes one.  A submit-batch also takes one for each
+// item, see handleV2SubmitBatch.
+var v2MethodCosts = map[string]float64{
+	"receipt":              10,
+	"raw-data":             5,
+	"chain-info":           10,
+	"first-entry":          5,
+	"pending-entries":      5,
+	"pending-transactions": 5,
+}
+
+func v2MethodCost(method string) float64 {
+	if cost, ok := v2MethodCosts[method]; ok {
+		return cost
+	}
+	return 1
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// RateLimiter keeps a token bucket per client.  Buckets fill at perMinute
+// tokens a minute up to burst, and each call takes its cost out.  The limits
+// are passed in on each call so they can change while the API is serving.
+type RateLimiter struct {
+	mutex     sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastPrune time.Time
+}
+
+func NewRateLimiter() *RateLimiter {
+	l := new(RateLimiter)
+	l.buckets = make(map[string]*tokenBucket)
+	l.lastPrune = time.Now()
+	return l
+}
+
+// Allow takes cost tokens from the client's bucket.  If there are not enough,
+// it returns false and how long until there will be.  A perMinute of 0 is
+// unlimited, and a burst of 0 is the per minute limit.
+func (l *RateLimiter) Allow(client string, cost float64, perMinute int, burst int, now time.Time) (bool, time.Duration) {
+	if perMinute <= 0 {
+		return true, 0
+	}
+	if burst <= 0 {
+		burst = perMinute
+	}
+	rate := float64(perMinute) / 60 // tokens a second
+	max := math.Max(float64(burst), cost)
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.prune(now, rate, max)
+
+	b := l.buckets[client]
+	if b == nil {
+		b = &tokenBucket{tokens: max, last: now}
+		l.buckets[client] = b
+	}
+	b.tokens = math.Min(max, b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+	if b.tokens < cost {
+		wait := (cost - b.tokens) / rate
+		return false, time.Duration(wait * float64(time.Second))
+	}
+	b.tokens -= cost
+	return true, 0
+}
+
+// prune forgets clients whose buckets have filled up again, once a minute.
+func (l *RateLimiter) prune(now time.Time, rate float64, max float64) {
+	if now.Sub(l.lastPrune) < time.Minute {
+		return
+	}
+	l.lastPrune = now
+	for client, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*rate >= max {
+			delete(l.buckets, client)
+		}
+	}
+}
+
+// Clients is how many clients have a partly used budget.
+func (l *RateLimiter) Clients() int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return len(l.buckets)
+}
+
+var (
+	readLimiter   = NewRateLimiter()
+	submitLimiter = NewRateLimiter()
+)
+
+// RateLimitError is the data of the JSON-RPC error returned when a client is
+// over its budget.
+type RateLimitError struct {
+	Budget     string `json:"budget"`     // read or submit
+	RetryAfter int64  `json:"retryafter"` // seconds
+	PerMinute  int    `json:"perminute"`
+	Burst      int    `json:"burst"`
+}
+
+// checkRateLimit takes the cost of a call from the client's read or submit
+// budget.  Clients are the API user if credentials were used, or else the
+// remote IP.  Admin credentials are not limited.
+func checkRateLimit(state interfaces.IState, user string, remoteIP string, scopes []string, scope string, cost float64) *RateLimitError {
+	client := "ip:" + remoteIP
+	if user != "" {
+		if constants.HasAPIScope(scopes, constants.API_SCOPE_ADMIN) {
+			return nil
+		}
+		client = "user:" + user
+	}
+	readsPerMinute, readBurst, submitsPerMinute, submitBurst := state.GetAPIRateLimits()
+	limiter, perMinute, burst := readLimiter, readsPerMinute, readBurst
+	if scope == constants.API_SCOPE_SUBMIT {
+		limiter, perMinute, burst = submitLimiter, submitsPerMinute, submitBurst
+	}
+
+	ok, wait := limiter.Allow(client, cost, perMinute, burst, time.Now())
+	if ok {
+		return nil
+	}
+	if scope == constants.API_SCOPE_SUBMIT {
+		APIRateLimitedSubmits.Inc()
+	} else {
+		APIRateLimitedReads.Inc()
+	}
+	return &RateLimitError{Budget: scope, RetryAfter: int64(math.Ceil(wait.Seconds())), PerMinute: perMinute, Burst: burst}
+}
@@ -0,0 +1,59 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/FactomProject/factomd/wsapi"
+)
+
+func TestRateLimiter(t *testing.T) {
+	l := NewRateLimiter()
+	now := time.Now()
+
+	if ok, _ := l.Allow("a", 1, 0, 0, now); !ok {
+		t.Errorf("A limit of 0 should be unlimited")
+	}
+
+	// 60 a minute is one a second, with a burst of 3
+	for i := 0; i < 3; i++ {
+		if ok, _ := l.Allow("a", 1, 60, 3, now); !ok {
+			t.Fatalf("Call %d refused inside the burst", i)
+		}
+	}
+	ok, wait := l.Allow("a", 1, 60, 3, now)
+	if ok {
+		t.Fatalf("Call allowed over the burst")
+	}
+	if wait <= 0 || wait > time.Second {
+		t.Errorf("Expected to wait up to a second, got %v", wait)
+	}
+	if ok, _ := l.Allow("b", 1, 60, 3, now); !ok {
+		t.Errorf("Another client was limited")
+	}
+
+	if ok, _ := l.Allow("a", 1, 60, 3, now.Add(time.Second)); !ok {
+		t.Errorf("Budget did not refill")
+	}
+
+	// An expensive call waits for enough tokens
+	ok, wait = l.Allow("b", 10, 60, 3, now)
+	if ok || wait < 7*time.Second {
+		t.Errorf("Expensive call should wait for 8 tokens, got %v %v", ok, wait)
+	}
+	if ok, _ := l.Allow("b", 10, 60, 3, now.Add(8*time.Second)); !ok {
+		t.Errorf("Expensive call refused once the tokens were there")
+	}
+
+	if l.Clients() != 2 {
+		t.Errorf("Expected 2 clients, got %d", l.Clients())
+	}
+	l.Allow("c", 1, 60, 3, now.Add(time.Hour))
+	if l.Clients() != 1 {
+		t.Errorf("Idle clients were not pruned, %d left", l.Clients())
+	}
+}
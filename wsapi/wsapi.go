@@ -6,13 +6,11 @@ package wsapi
 
 import (
 	"crypto/sha256"
-	"crypto/subtle"
-	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"os"
 	"strconv"
@@ -21,6 +19,7 @@ import (
 	"time"
 
 	"github.com/FactomProject/btcutil/certs"
+	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
 	"github.com/FactomProject/factomd/log"
@@ -101,13 +100,9 @@ func Start(state interfaces.IState) {
 					panic(fmt.Sprintf("could not start encrypted API server with error: %v", err))
 				}
 			}
-			keypair, err := tls.LoadX509KeyPair(tlsPublic, tlsPrivate)
+			tlsConfig, err := state.GetTLSConfig()
 			if err != nil {
-				panic(fmt.Sprintf("could not create TLS keypair with error: %v", err))
-			}
-			tlsConfig := &tls.Config{
-				Certificates: []tls.Certificate{keypair},
-				MinVersion:   tls.VersionTLS12,
+				panic(fmt.Sprintf("could not start encrypted API server with error: %v", err))
 			}
 			go server.RunTLS(fmt.Sprintf(":%d", state.GetPort()), tlsConfig)
 
@@ -846,39 +841,50 @@ func httpBasicAuth(username, password string) []byte {
 	return output
 }
 
-func checkAuthHeader(state interfaces.IState, r *http.Request) error {
-	if "" == state.GetRpcUser() {
-		//no username was specified in the config file or command line, meaning factomd API is open access
-		return nil
+// checkAuthHeader checks the request's credentials allow scope, and returns
+// the API user and its scopes.  An empty scope only checks the credentials
+// are good.
+func checkAuthHeader(state interfaces.IState, r *http.Request, scope string) (string, []string, error) {
+	user, scopes, err := state.AuthenticateAPI(r.Header.Get("Authorization"))
+	if err != nil {
+		return "", nil, err
 	}
-
-	authhdr := r.Header["Authorization"]
-	if len(authhdr) == 0 {
-		return errors.New("no auth")
+	if scope != "" && !constants.HasAPIScope(scopes, scope) {
+		return "", nil, errForbidden
 	}
+	return user, scopes, nil
+}
 
-	correctAuth := state.GetRpcAuthHash()
-
-	h := sha256.New()
-	h.Write([]byte(authhdr[0]))
-	presentedPassHash := h.Sum(nil)
-
-	cmp := subtle.ConstantTimeCompare(presentedPassHash, correctAuth) //compare hashes because ConstantTimeCompare takes a constant time based on the slice size.  hashing gives a constant slice size.
-	if cmp != 1 {
-		return errors.New("bad auth")
+// remoteHost is the client's IP without the port.  IPv6 addresses are
+// bracketed with the port, so they cannot just be split on a colon.
+func remoteHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
 	}
-	return nil
+	return host
 }
 
 func checkHttpPasswordOkV1(state interfaces.IState, ctx *web.Context) bool {
-	if err := checkAuthHeader(state, ctx.Request); err != nil {
-		remoteIP := ""
-		remoteIP += strings.Split(ctx.Request.RemoteAddr, ":")[0]
+	remoteIP := remoteHost(ctx.Request)
+	scope := v1PathScope(ctx.Request.URL.Path)
+	user, scopes, err := checkAuthHeader(state, ctx.Request, scope)
+	if err != nil {
+		if err == errForbidden {
+			fmt.Printf("Forbidden V1 API call to %s from %s\n", ctx.Request.URL.Path, remoteIP)
+			http.Error(ctx.ResponseWriter, "403 Forbidden.", http.StatusForbidden)
+			return false
+		}
 		fmt.Printf("Unauthorized V1 API client connection attempt from %s\n", remoteIP)
 		ctx.ResponseWriter.Header().Add("WWW-Authenticate", `Basic realm="factomd RPC"`)
 		http.Error(ctx.ResponseWriter, "401 Unauthorized.", http.StatusUnauthorized)
 		return false
 	}
+	if rl := checkRateLimit(state, user, remoteIP, scopes, scope, 1); rl != nil {
+		ctx.ResponseWriter.Header().Set("Retry-After", fmt.Sprint(rl.RetryAfter))
+		http.Error(ctx.ResponseWriter, "429 Too Many Requests.", http.StatusTooManyRequests)
+		return false
+	}
 	return true
 }
 
@@ -112,6 +112,29 @@ type EntryResponse struct {
 	ExtIDs  []string `json:"extids"`
 }
 
+type ChainInfoResponse struct {
+	ChainID            string `json:"chainid"`
+	Exists             bool   `json:"exists"`
+	ChainInProcessList bool   `json:"chaininprocesslist"`
+	CommitPending      bool   `json:"commitpending"`
+	ChainHead          string `json:"chainhead,omitempty"`
+	FirstEBlock        string `json:"firsteblock,omitempty"`
+	FirstEntry         string `json:"firstentry,omitempty"`
+	CreationHeight     int64  `json:"creationheight"`
+	CreationTimestamp  int64  `json:"creationtimestamp"` // seconds
+	EntryCount         int64  `json:"entrycount"`
+	EBlockCount        int64  `json:"eblockcount"`
+}
+
+type FirstEntryResponse struct {
+	EntryHash string   `json:"entryhash"`
+	ChainID   string   `json:"chainid"`
+	Content   string   `json:"content"`
+	ExtIDs    []string `json:"extids"`
+	EBlock    string   `json:"eblock"`
+	DBHeight  int64    `json:"dbheight"`
+}
+
 type ChainHeadResponse struct {
 	ChainHead          string `json:"chainhead"`
 	ChainInProcessList bool   `json:"chaininprocesslist"`
@@ -119,19 +142,25 @@ type ChainHeadResponse struct {
 
 type EntryCreditBalanceResponse struct {
 	Balance int64 `json:"balance"`
+	interfaces.BalanceInfo
 }
 
 type FactoidBalanceResponse struct {
 	Balance int64 `json:"balance"`
+	interfaces.BalanceInfo
 }
 
 type EntryCreditRateResponse struct {
-	Rate int64 `json:"rate"`
+	Rate        int64                      `json:"rate"`
+	CurrentRate int64                      `json:"currentrate"`
+	Policy      string                     `json:"policy"`
+	Upcoming    []*interfaces.ECRateChange `json:"upcoming"`
 }
 
 type PropertiesResponse struct {
-	FactomdVersion string `json:"factomdversion"`
-	ApiVersion     string `json:"factomdapiversion"`
+	FactomdVersion string                          `json:"factomdversion"`
+	ApiVersion     string                          `json:"factomdapiversion"`
+	Features       []*interfaces.FeatureActivation `json:"features,omitempty"`
 }
 
 type SendRawMessageResponse struct {
@@ -143,6 +172,25 @@ type TransactionRateResponse struct {
 	InstantTransactionRate float64 `json:"instanttxrate"`
 }
 
+type IdentitiesResponse struct {
+	Identities []string `json:"identities"`
+}
+
+type BatchResponse struct {
+	Message  string             `json:"message"`
+	Accepted int                `json:"accepted"`
+	Rejected int                `json:"rejected"`
+	Results  []*BatchItemResult `json:"results"`
+}
+
+type BatchItemResult struct {
+	TxID      string `json:"txid,omitempty"`
+	EntryHash string `json:"entryhash,omitempty"`
+	ChainID   string `json:"chainid,omitempty"`
+	NewChain  bool   `json:"newchain"`
+	Error     string `json:"error,omitempty"`
+}
+
 /*********************************************************************/
 
 type DBHead struct {
@@ -265,6 +313,18 @@ type MessageRequest struct {
 	Message string `json:"message"`
 }
 
+type BatchRequest struct {
+	Items []BatchItem `json:"items"`
+	// If set, nothing is submitted unless every item is valid
+	AllOrNothing bool `json:"allornothing"`
+}
+
+// BatchItem is a commit-chain or commit-entry message and the entry it pays for.
+type BatchItem struct {
+	Commit string `json:"commit"`
+	Reveal string `json:"reveal"`
+}
+
 type PendingEntry struct {
 	EntryHash interfaces.IHash `json:"entryhash"`
 	ChainID   interfaces.IHash `json:"chainid"`
@@ -34,9 +34,9 @@ func HandleV2(ctx *web.Context) {
 	state := ctx.Server.Env["state"].(interfaces.IState)
 	ServersMutex.Unlock()
 
-	if err := checkAuthHeader(state, ctx.Request); err != nil {
-		remoteIP := ""
-		remoteIP += strings.Split(ctx.Request.RemoteAddr, ":")[0]
+	remoteIP := remoteHost(ctx.Request)
+	user, scopes, err := checkAuthHeader(state, ctx.Request, "")
+	if err != nil {
 		fmt.Printf("Unauthorized V2 API client connection attempt from %s\n", remoteIP)
 		ctx.ResponseWriter.Header().Add("WWW-Authenticate", `Basic realm="factomd RPC"`)
 		http.Error(ctx.ResponseWriter, "401 Unauthorized.", http.StatusUnauthorized)
@@ -56,7 +56,27 @@ func HandleV2(ctx *web.Context) {
 		return
 	}
 
-	jsonResp, jsonError := HandleV2Request(state, j)
+	scope := v2MethodScope(j.Method)
+	if !constants.HasAPIScope(scopes, scope) {
+		HandleV2Error(ctx, j, NewForbiddenError(j.Method))
+		return
+	}
+	if rl := checkRateLimit(state, user, remoteIP, scopes, scope, v2MethodCost(j.Method)); rl != nil {
+		ctx.ResponseWriter.Header().Set("Retry-After", fmt.Sprint(rl.RetryAfter))
+		HandleV2Error(ctx, j, NewRateLimitError(rl))
+		return
+	}
+
+	// Calls that cost more once their parameters are read, like a batch,
+	// charge the rest of their cost here
+	charge := func(cost float64) *RateLimitError {
+		rl := checkRateLimit(state, user, remoteIP, scopes, scope, cost)
+		if rl != nil {
+			ctx.ResponseWriter.Header().Set("Retry-After", fmt.Sprint(rl.RetryAfter))
+		}
+		return rl
+	}
+	jsonResp, jsonError := handleV2Request(state, j, charge)
 
 	if jsonError != nil {
 		HandleV2Error(ctx, j, jsonError)
@@ -67,6 +87,12 @@ func HandleV2(ctx *web.Context) {
 }
 
 func HandleV2Request(state interfaces.IState, j *primitives.JSON2Request) (*primitives.JSON2Response, *primitives.JSONError) {
+	return handleV2Request(state, j, nil)
+}
+
+// handleV2Request runs a call, with charge taking any further cost from the
+// client's rate limit budget.  A nil charge is unlimited.
+func handleV2Request(state interfaces.IState, j *primitives.JSON2Request, charge func(cost float64) *RateLimitError) (*primitives.JSON2Response, *primitives.JSONError) {
 	var resp interface{}
 	var jsonError *primitives.JSONError
 	params := j.Params
@@ -74,6 +100,12 @@ func HandleV2Request(state interfaces.IState, j *primitives.JSON2Request) (*prim
 	case "chain-head":
 		resp, jsonError = HandleV2ChainHead(state, params)
 		break
+	case "chain-info":
+		resp, jsonError = HandleV2ChainInfo(state, params)
+		break
+	case "first-entry":
+		resp, jsonError = HandleV2FirstEntry(state, params)
+		break
 	case "commit-chain":
 		resp, jsonError = HandleV2CommitChain(state, params)
 		break
@@ -134,6 +166,9 @@ func HandleV2Request(state interfaces.IState, j *primitives.JSON2Request) (*prim
 	case "reveal-entry":
 		resp, jsonError = HandleV2RevealEntry(state, params)
 		break
+	case "submit-batch":
+		resp, jsonError = handleV2SubmitBatch(state, params, charge)
+		break
 	case "factoid-ack":
 		resp, jsonError = HandleV2FactoidACK(state, params)
 		break
@@ -146,6 +181,9 @@ func HandleV2Request(state interfaces.IState, j *primitives.JSON2Request) (*prim
 	case "pending-transactions":
 		resp, jsonError = HandleV2GetPendingTransactions(state, params)
 		break
+	case "mempool-info":
+		resp, jsonError = HandleV2MempoolInfo(state, params)
+		break
 	case "send-raw-message":
 		resp, jsonError = HandleV2SendRawMessage(state, params)
 		break
@@ -170,6 +208,10 @@ func HandleV2Request(state interfaces.IState, j *primitives.JSON2Request) (*prim
 		resp, jsonError = HandleV2TransactionRate(state, params)
 	case "ack":
 		resp, jsonError = HandleV2ACKWithChain(state, params)
+	case "identity":
+		resp, jsonError = HandleV2Identity(state, params)
+	case "identities":
+		resp, jsonError = HandleV2Identities(state, params)
 	default:
 		jsonError = NewMethodNotFoundError()
 		break
@@ -1004,7 +1046,8 @@ func HandleV2EntryCreditBalance(state interfaces.IState, params interface{}) (in
 		return nil, NewInvalidAddressError()
 	}
 	resp := new(EntryCreditBalanceResponse)
-	resp.Balance = state.GetFactoidState().GetECBalance(address.Fixed())
+	resp.BalanceInfo = *state.GetECBalanceInfo(address.Fixed())
+	resp.Balance = resp.Pending
 	return resp, nil
 }
 
@@ -1012,8 +1055,12 @@ func HandleV2EntryCreditRate(state interfaces.IState, params interface{}) (inter
 	n := time.Now()
 	defer HandleV2APICallECRate.Observe(float64(time.Since(n).Nanoseconds()))
 
+	info := state.GetECRateInfo()
 	resp := new(EntryCreditRateResponse)
-	resp.Rate = int64(state.GetPredictiveFER())
+	resp.Rate = int64(info.Predictive)
+	resp.CurrentRate = int64(info.Rate)
+	resp.Policy = info.Policy
+	resp.Upcoming = info.Upcoming
 
 	return resp, nil
 }
@@ -1080,7 +1127,8 @@ func HandleV2FactoidBalance(state interfaces.IState, params interface{}) (interf
 	}
 
 	resp := new(FactoidBalanceResponse)
-	resp.Balance = state.GetFactoidState().GetFactoidBalance(factoid.NewAddress(adr).Fixed())
+	resp.BalanceInfo = *state.GetFactoidBalanceInfo(factoid.NewAddress(adr).Fixed())
+	resp.Balance = resp.Pending
 	return resp, nil
 }
 
@@ -1130,6 +1178,13 @@ func HandleV2GetPendingTransactions(state interfaces.IState, params interface{})
 	return pending, nil
 }
 
+func HandleV2MempoolInfo(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallMempoolInfo.Observe(float64(time.Since(n).Nanoseconds()))
+
+	return state.GetMempoolInfo(), nil
+}
+
 func HandleV2Properties(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
 	n := time.Now()
 	defer HandleV2APICallProp.Observe(float64(time.Since(n).Nanoseconds()))
@@ -1137,6 +1192,7 @@ func HandleV2Properties(state interfaces.IState, params interface{}) (interface{
 	p := new(PropertiesResponse)
 	p.FactomdVersion = state.GetFactomdVersion()
 	p.ApiVersion = API_VERSION
+	p.Features = state.GetFeatures()
 	return p, nil
 }
 
@@ -1275,3 +1331,36 @@ func HandleV2TransactionRate(state interfaces.IState, params interface{}) (inter
 	r.InstantTransactionRate = instant
 	return r, nil
 }
+
+func HandleV2Identity(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallIdentity.Observe(float64(time.Since(n).Nanoseconds()))
+
+	chainid := new(ChainIDRequest)
+	err := MapToObject(params, chainid)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+
+	h, err := primitives.HexToHash(chainid.ChainID)
+	if err != nil {
+		return nil, NewInvalidHashError()
+	}
+
+	status := state.GetIdentityStatus(h)
+	if status == nil {
+		return nil, NewObjectNotFoundError()
+	}
+	return status, nil
+}
+
+func HandleV2Identities(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	n := time.Now()
+	defer HandleV2APICallIdentity.Observe(float64(time.Since(n).Nanoseconds()))
+
+	r := new(IdentitiesResponse)
+	for _, id := range state.GetIdentityChainIDs() {
+		r.Identities = append(r.Identities, id.String())
+	}
+	return r, nil
+}